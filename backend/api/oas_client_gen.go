@@ -27,6 +27,62 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// AdminModerationActionsGet invokes GET /admin/moderation/actions operation.
+	//
+	// 報告の担当・対応を含む、モデレーターが行ったすべての操作を新しい順に返します。
+	// 続きを取得するには、前回の最後の操作のIDをafterに指定します。.
+	//
+	// GET /admin/moderation/actions
+	AdminModerationActionsGet(ctx context.Context, params AdminModerationActionsGetParams) (AdminModerationActionsGetRes, error)
+	// AdminModerationActionsPost invokes POST /admin/moderation/actions operation.
+	//
+	// 投稿・コメント・プロフィールの非表示（hide）と再表示（unhide）、ユーザーへの警告（warn）、
+	// アカウントの停止（suspend）と停止の解除（unsuspend）を行います。操作は監査ログに記録されます。
+	// 警告・停止・停止の解除の対象はユーザーのみです。自分自身は対象にできず、モデレーター・管理者を停止できるのは管理者のみです。
+	// 非表示の内容は作成者本人にのみ、通知（moderation_notice）付きで表示されます。
+	// 停止中のユーザーは認証が必要な操作を行えません（403）。.
+	//
+	// POST /admin/moderation/actions
+	AdminModerationActionsPost(ctx context.Context, request *ModerationActionRequest) (AdminModerationActionsPostRes, error)
+	// AdminReportsGet invokes GET /admin/reports operation.
+	//
+	// 報告を古い順に返します。続きを取得するには、前回の最後の報告のIDをafterに指定します。
+	// 迷惑行為フィルタが保留にした投稿・コメントもsource=filterの報告として含まれます。.
+	//
+	// GET /admin/reports
+	AdminReportsGet(ctx context.Context, params AdminReportsGetParams) (AdminReportsGetRes, error)
+	// AdminReportsReportIDClaimPost invokes POST /admin/reports/{report_id}/claim operation.
+	//
+	// 未対応の報告の担当になり、対応中にします。自分が担当している報告に対しては何もしません。
+	// 他のモデレーターが担当している報告や対応済みの報告は担当できません。.
+	//
+	// POST /admin/reports/{report_id}/claim
+	AdminReportsReportIDClaimPost(ctx context.Context, params AdminReportsReportIDClaimPostParams) (AdminReportsReportIDClaimPostRes, error)
+	// AdminReportsReportIDResolvePost invokes POST /admin/reports/{report_id}/resolve operation.
+	//
+	// 自分が担当している報告を対応済みにします。対象への措置（非表示・警告・アカウント停止）は
+	// POST /admin/moderation/actionsで行い、report_idにこの報告を指定します。.
+	//
+	// POST /admin/reports/{report_id}/resolve
+	AdminReportsReportIDResolvePost(ctx context.Context, request *ReportResolveRequest, params AdminReportsReportIDResolvePostParams) (AdminReportsReportIDResolvePostRes, error)
+	// AdminTemplatesPost invokes POST /admin/templates operation.
+	//
+	// 目標テンプレート作成（管理者）.
+	//
+	// POST /admin/templates
+	AdminTemplatesPost(ctx context.Context, request *GoalTemplateRequest) (AdminTemplatesPostRes, error)
+	// AdminTemplatesTemplateIDDelete invokes DELETE /admin/templates/{template_id} operation.
+	//
+	// 目標テンプレート削除（管理者）.
+	//
+	// DELETE /admin/templates/{template_id}
+	AdminTemplatesTemplateIDDelete(ctx context.Context, params AdminTemplatesTemplateIDDeleteParams) (AdminTemplatesTemplateIDDeleteRes, error)
+	// AdminTemplatesTemplateIDPut invokes PUT /admin/templates/{template_id} operation.
+	//
+	// 目標テンプレート更新（管理者）.
+	//
+	// PUT /admin/templates/{template_id}
+	AdminTemplatesTemplateIDPut(ctx context.Context, request *GoalTemplateRequest, params AdminTemplatesTemplateIDPutParams) (AdminTemplatesTemplateIDPutRes, error)
 	// AuthCallbackGet invokes GET /auth/callback operation.
 	//
 	// OIDCコールバック（コードをトークンに交換）.
@@ -51,15 +107,76 @@ type Invoker interface {
 	//
 	// GET /auth/me
 	AuthMeGet(ctx context.Context) (AuthMeGetRes, error)
+	// BlocksGet invokes GET /blocks operation.
+	//
+	// 自分がブロックしているユーザー一覧取得.
+	//
+	// GET /blocks
+	BlocksGet(ctx context.Context) (BlocksGetRes, error)
+	// BlocksPost invokes POST /blocks operation.
+	//
+	// ブロックすると互いのフォローが解除され、互いの投稿へのコメントやコメントの表示ができなくなります。.
+	//
+	// POST /blocks
+	BlocksPost(ctx context.Context, request *BlocksPostReq) (BlocksPostRes, error)
+	// BlocksUserIDDelete invokes DELETE /blocks/{user_id} operation.
+	//
+	// ブロック解除.
+	//
+	// DELETE /blocks/{user_id}
+	BlocksUserIDDelete(ctx context.Context, params BlocksUserIDDeleteParams) (BlocksUserIDDeleteRes, error)
+	// BookmarksCollectionsCollectionIDDelete invokes DELETE /bookmarks/collections/{collection_id} operation.
+	//
+	// コレクション内のブックマークは削除されず、未分類になります。.
+	//
+	// DELETE /bookmarks/collections/{collection_id}
+	BookmarksCollectionsCollectionIDDelete(ctx context.Context, params BookmarksCollectionsCollectionIDDeleteParams) (BookmarksCollectionsCollectionIDDeleteRes, error)
+	// BookmarksCollectionsCollectionIDPut invokes PUT /bookmarks/collections/{collection_id} operation.
+	//
+	// ブックマークコレクション名の変更.
+	//
+	// PUT /bookmarks/collections/{collection_id}
+	BookmarksCollectionsCollectionIDPut(ctx context.Context, request *BookmarkCollectionRequest, params BookmarksCollectionsCollectionIDPutParams) (BookmarksCollectionsCollectionIDPutRes, error)
+	// BookmarksCollectionsGet invokes GET /bookmarks/collections operation.
+	//
+	// 自分のブックマークコレクション一覧取得.
+	//
+	// GET /bookmarks/collections
+	BookmarksCollectionsGet(ctx context.Context) (BookmarksCollectionsGetRes, error)
+	// BookmarksCollectionsPost invokes POST /bookmarks/collections operation.
+	//
+	// ブックマークコレクション作成.
+	//
+	// POST /bookmarks/collections
+	BookmarksCollectionsPost(ctx context.Context, request *BookmarkCollectionRequest) (BookmarksCollectionsPostRes, error)
+	// BookmarksGet invokes GET /bookmarks operation.
+	//
+	// ブックマークを新しい順に返します。続きを取得するには、前回の最後のブックマークのIDをafterに指定します。
+	// 削除された投稿や、下書きに戻された・ブロック関係になったなどの理由で閲覧できなくなった投稿は含まれません。.
+	//
+	// GET /bookmarks
+	BookmarksGet(ctx context.Context, params BookmarksGetParams) (BookmarksGetRes, error)
+	// CommentsCommentIDDelete invokes DELETE /comments/{comment_id} operation.
+	//
+	// コメントしたユーザー本人、または投稿者が削除できます。返信のスレッドを保つため論理削除されます。.
+	//
+	// DELETE /comments/{comment_id}
+	CommentsCommentIDDelete(ctx context.Context, params CommentsCommentIDDeleteParams) (CommentsCommentIDDeleteRes, error)
+	// CommentsCommentIDPut invokes PUT /comments/{comment_id} operation.
+	//
+	// コメントしたユーザー本人のみ編集できます。.
+	//
+	// PUT /comments/{comment_id}
+	CommentsCommentIDPut(ctx context.Context, request *CommentUpdateRequest, params CommentsCommentIDPutParams) (CommentsCommentIDPutRes, error)
 	// FriendsGet invokes GET /friends operation.
 	//
-	// 自分のフレンド（フォロー）一覧取得.
+	// フォローしているユーザーのIDを、ユーザーの登録日時の新しい順に返します。.
 	//
 	// GET /friends
-	FriendsGet(ctx context.Context) (FriendsGetRes, error)
+	FriendsGet(ctx context.Context, params FriendsGetParams) (FriendsGetRes, error)
 	// FriendsPost invokes POST /friends operation.
 	//
-	// フレンド追加（フォロー）.
+	// 既にフォローしている場合も201を返します。自分自身は400、存在しないユーザーやブロック関係にあるユーザーは404になります。.
 	//
 	// POST /friends
 	FriendsPost(ctx context.Context, request *FriendsPostReq) (FriendsPostRes, error)
@@ -69,18 +186,36 @@ type Invoker interface {
 	//
 	// DELETE /friends/{user_id}
 	FriendsUserIDDelete(ctx context.Context, params FriendsUserIDDeleteParams) (FriendsUserIDDeleteRes, error)
+	// GenresGenreIDTemplatesGet invokes GET /genres/{genre_id}/templates operation.
+	//
+	// ジャンル別の目標テンプレート一覧取得.
+	//
+	// GET /genres/{genre_id}/templates
+	GenresGenreIDTemplatesGet(ctx context.Context, params GenresGenreIDTemplatesGetParams) (GenresGenreIDTemplatesGetRes, error)
 	// GenresGet invokes GET /genres operation.
 	//
 	// 利用可能なジャンル一覧取得.
 	//
 	// GET /genres
 	GenresGet(ctx context.Context) ([]Genre, error)
+	// GoalsFromTemplateTemplateIDPost invokes POST /goals/from-template/{template_id} operation.
+	//
+	// テンプレートのタイトル・期間・マイルストーン・習慣スケジュールを元に目標を作成します。リクエストボディで指定した値はテンプレートの値より優先されます。.
+	//
+	// POST /goals/from-template/{template_id}
+	GoalsFromTemplateTemplateIDPost(ctx context.Context, request OptGoalFromTemplateRequest, params GoalsFromTemplateTemplateIDPostParams) (GoalsFromTemplateTemplateIDPostRes, error)
 	// GoalsGet invokes GET /goals operation.
 	//
 	// 現在のユーザーの目標一覧取得.
 	//
 	// GET /goals
 	GoalsGet(ctx context.Context, params GoalsGetParams) (GoalsGetRes, error)
+	// GoalsGoalIDAnalyticsGet invokes GET /goals/{goal_id}/analytics operation.
+	//
+	// 投稿のヒートマップ、投稿間隔、受け取ったリアクションの推移、期限との比較を返します。日付は目標オーナーのタイムゾーンで集計されます。.
+	//
+	// GET /goals/{goal_id}/analytics
+	GoalsGoalIDAnalyticsGet(ctx context.Context, params GoalsGoalIDAnalyticsGetParams) (GoalsGoalIDAnalyticsGetRes, error)
 	// GoalsGoalIDDelete invokes DELETE /goals/{goal_id} operation.
 	//
 	// 目標削除.
@@ -93,21 +228,77 @@ type Invoker interface {
 	//
 	// GET /goals/{goal_id}
 	GoalsGoalIDGet(ctx context.Context, params GoalsGoalIDGetParams) (GoalsGoalIDGetRes, error)
+	// GoalsGoalIDParticipantsAcceptPost invokes POST /goals/{goal_id}/participants/accept operation.
+	//
+	// 目標への招待を承認.
+	//
+	// POST /goals/{goal_id}/participants/accept
+	GoalsGoalIDParticipantsAcceptPost(ctx context.Context, params GoalsGoalIDParticipantsAcceptPostParams) (GoalsGoalIDParticipantsAcceptPostRes, error)
+	// GoalsGoalIDParticipantsGet invokes GET /goals/{goal_id}/participants operation.
+	//
+	// 参加者ごとの投稿数（貢献数）を含みます。.
+	//
+	// GET /goals/{goal_id}/participants
+	GoalsGoalIDParticipantsGet(ctx context.Context, params GoalsGoalIDParticipantsGetParams) (GoalsGoalIDParticipantsGetRes, error)
+	// GoalsGoalIDParticipantsPost invokes POST /goals/{goal_id}/participants operation.
+	//
+	// 目標のオーナーのみ実行できます。招待されたユーザーが承認すると参加者になります。.
+	//
+	// POST /goals/{goal_id}/participants
+	GoalsGoalIDParticipantsPost(ctx context.Context, request *GoalsGoalIDParticipantsPostReq, params GoalsGoalIDParticipantsPostParams) (GoalsGoalIDParticipantsPostRes, error)
+	// GoalsGoalIDParticipantsUserIDDelete invokes DELETE /goals/{goal_id}/participants/{user_id} operation.
+	//
+	// オーナーは他の参加者を削除できます。参加者本人は招待の辞退や目標からの脱退ができます。オーナーは脱退できません。.
+	//
+	// DELETE /goals/{goal_id}/participants/{user_id}
+	GoalsGoalIDParticipantsUserIDDelete(ctx context.Context, params GoalsGoalIDParticipantsUserIDDeleteParams) (GoalsGoalIDParticipantsUserIDDeleteRes, error)
+	// GoalsGoalIDPostsGet invokes GET /goals/{goal_id}/posts operation.
+	//
+	// 目標の全参加者の投稿を新しい順で返します。閲覧者とブロック関係にあるユーザーの投稿は含めません。.
+	//
+	// GET /goals/{goal_id}/posts
+	GoalsGoalIDPostsGet(ctx context.Context, params GoalsGoalIDPostsGetParams) (GoalsGoalIDPostsGetRes, error)
 	// GoalsGoalIDPut invokes PUT /goals/{goal_id} operation.
 	//
 	// 目標更新.
 	//
 	// PUT /goals/{goal_id}
 	GoalsGoalIDPut(ctx context.Context, request *GoalRequest, params GoalsGoalIDPutParams) (GoalsGoalIDPutRes, error)
+	// GoalsInvitationsGet invokes GET /goals/invitations operation.
+	//
+	// 自分宛ての目標への招待一覧取得.
+	//
+	// GET /goals/invitations
+	GoalsInvitationsGet(ctx context.Context) (GoalsInvitationsGetRes, error)
+	// GoalsOrderPut invokes PUT /goals/order operation.
+	//
+	// 自分が参加している目標のIDをすべて、表示したい順に指定します。並び順は一括で反映され、参加者ごとに保存されるため他の参加者の並び順は変わりません。.
+	//
+	// PUT /goals/order
+	GoalsOrderPut(ctx context.Context, request *GoalOrderRequest) (GoalsOrderPutRes, error)
 	// GoalsPost invokes POST /goals operation.
 	//
 	// 新規目標作成.
 	//
 	// POST /goals
 	GoalsPost(ctx context.Context, request *GoalRequest) (GoalsPostRes, error)
+	// HashtagsTagPostsGet invokes GET /hashtags/{tag}/posts operation.
+	//
+	// 投稿は新しい順で返されます。タグは"#"の有無や全角・半角、英字の大文字・小文字を区別しません。.
+	//
+	// GET /hashtags/{tag}/posts
+	HashtagsTagPostsGet(ctx context.Context, params HashtagsTagPostsGetParams) (HashtagsTagPostsGetRes, error)
+	// HashtagsTrendingGet invokes GET /hashtags/trending operation.
+	//
+	// 直近の集計期間内に付けられた投稿の多い順にハッシュタグを返します。.
+	//
+	// GET /hashtags/trending
+	HashtagsTrendingGet(ctx context.Context, params HashtagsTrendingGetParams) (HashtagsTrendingGetRes, error)
 	// ImagesImageIDGet invokes GET /images/{image_id} operation.
 	//
-	// 画像取得.
+	// 投稿に添付された画像は、その投稿を閲覧できる場合のみ取得できます。
+	// 投稿に紐付いていない画像は、アップロードしたユーザーと、その画像を含む編集履歴を閲覧できるユーザーのみ取得できます。
+	// 閲覧できない画像は存在しない場合と同じく404を返します。.
 	//
 	// GET /images/{image_id}
 	ImagesImageIDGet(ctx context.Context, params ImagesImageIDGetParams) (ImagesImageIDGetRes, error)
@@ -117,18 +308,79 @@ type Invoker interface {
 	//
 	// POST /images
 	ImagesPost(ctx context.Context, request OptImagesPostReq) (ImagesPostRes, error)
+	// NotificationsGet invokes GET /notifications operation.
+	//
+	// 通知を最後にまとめた日時の新しい順に返します。
+	// 未読の間は同じ種類・同じ投稿への通知（フォローの場合は全てのフォロー）を1件にまとめ、actor_countに操作をしたユーザーの人数を返します。
+	// まとめた通知は新しい操作があると先頭に移動するため、ページの間で重複・欠落することがあります。.
+	//
+	// GET /notifications
+	NotificationsGet(ctx context.Context, params NotificationsGetParams) (NotificationsGetRes, error)
+	// NotificationsPreferencesGet invokes GET /notifications/preferences operation.
+	//
+	// 通知の設定取得.
+	//
+	// GET /notifications/preferences
+	NotificationsPreferencesGet(ctx context.Context) (NotificationsPreferencesGetRes, error)
+	// NotificationsPreferencesPut invokes PUT /notifications/preferences operation.
+	//
+	// Falseにした種類の通知は、以降は通知一覧に追加されません。既にある通知はそのまま残ります。.
+	//
+	// PUT /notifications/preferences
+	NotificationsPreferencesPut(ctx context.Context, request *NotificationPreferences) (NotificationsPreferencesPutRes, error)
+	// NotificationsReadPost invokes POST /notifications/read operation.
+	//
+	// Idsを省略した場合は全ての未読の通知を既読にします。自分以外の通知や既読の通知のIDは無視します。.
+	//
+	// POST /notifications/read
+	NotificationsReadPost(ctx context.Context, request OptNotificationReadRequest) (NotificationsReadPostRes, error)
+	// NotificationsUnreadCountGet invokes GET /notifications/unread-count operation.
+	//
+	// まとめた通知は1件として数えます。.
+	//
+	// GET /notifications/unread-count
+	NotificationsUnreadCountGet(ctx context.Context) (NotificationsUnreadCountGetRes, error)
 	// PostsGet invokes GET /posts operation.
 	//
-	// 現在のユーザーの投稿一覧取得.
+	// 自分の投稿を新しい順に返します。下書き・予約投稿を含みます。.
 	//
 	// GET /posts
 	PostsGet(ctx context.Context, params PostsGetParams) (PostsGetRes, error)
 	// PostsPost invokes POST /posts operation.
 	//
-	// 進捗投稿作成.
+	// 本文は迷惑行為フィルタで判定されます。拒否された場合は400を返します。
+	// 確認のために保留になった場合は作成者本人以外には非表示の状態で作成され（moderation_notice付き）、
+	// モデレーションキュー（source=filterの報告）に追加されます。.
 	//
 	// POST /posts
 	PostsPost(ctx context.Context, request *PostRequest) (PostsPostRes, error)
+	// PostsPostIDBookmarkDelete invokes DELETE /posts/{post_id}/bookmark operation.
+	//
+	// ブックマーク解除.
+	//
+	// DELETE /posts/{post_id}/bookmark
+	PostsPostIDBookmarkDelete(ctx context.Context, params PostsPostIDBookmarkDeleteParams) (PostsPostIDBookmarkDeleteRes, error)
+	// PostsPostIDBookmarkPost invokes POST /posts/{post_id}/bookmark operation.
+	//
+	// 既にブックマークしている場合は保存先のコレクションを変更します。collection_idを省略すると未分類として保存します。.
+	//
+	// POST /posts/{post_id}/bookmark
+	PostsPostIDBookmarkPost(ctx context.Context, request OptBookmarkRequest, params PostsPostIDBookmarkPostParams) (PostsPostIDBookmarkPostRes, error)
+	// PostsPostIDCommentsGet invokes GET /posts/{post_id}/comments operation.
+	//
+	// コメントを古い順に返します。parent_idを指定するとそのコメントへの返信を、省略すると投稿への直接のコメントを返します。
+	// 続きを取得するには、前回の最後のコメントのIDをafterに指定します。
+	// ブロック関係にあるユーザーのコメントは含まれません。削除済みのコメントは返信が残っている場合のみ、本文を空にして返します。.
+	//
+	// GET /posts/{post_id}/comments
+	PostsPostIDCommentsGet(ctx context.Context, params PostsPostIDCommentsGetParams) (PostsPostIDCommentsGetRes, error)
+	// PostsPostIDCommentsPost invokes POST /posts/{post_id}/comments operation.
+	//
+	// Parent_idを指定すると、そのコメントへの返信になります。投稿者またはコメント先のユーザーとブロック関係にある場合はコメントできません。
+	// 本文は投稿と同じく迷惑行為フィルタで判定され、保留になったコメントはモデレーションキューに追加されます。.
+	//
+	// POST /posts/{post_id}/comments
+	PostsPostIDCommentsPost(ctx context.Context, request *CommentRequest, params PostsPostIDCommentsPostParams) (PostsPostIDCommentsPostRes, error)
 	// PostsPostIDDelete invokes DELETE /posts/{post_id} operation.
 	//
 	// 投稿を削除します。紐づいている画像も同時に削除されます。.
@@ -149,25 +401,82 @@ type Invoker interface {
 	PostsPostIDPut(ctx context.Context, request *PostRequest, params PostsPostIDPutParams) (PostsPostIDPutRes, error)
 	// PostsPostIDReactionsDelete invokes DELETE /posts/{post_id}/reactions operation.
 	//
-	// 認証済みの現在のユーザー自身のリアクションを指定した投稿から削除します。`user_id` パラメータは不要で、認証されたユーザーのリアクションのみが削除されます。.
+	// 認証済みの現在のユーザー自身のリアクションを指定した投稿から削除します。`user_id` パラメータは不要で、認証されたユーザーのリアクションのみが削除されます。`kind`を省略すると、すべての種類のリアクションを削除します。.
 	//
 	// DELETE /posts/{post_id}/reactions
 	PostsPostIDReactionsDelete(ctx context.Context, params PostsPostIDReactionsDeleteParams) (PostsPostIDReactionsDeleteRes, error)
 	// PostsPostIDReactionsGet invokes GET /posts/{post_id}/reactions operation.
 	//
-	// 投稿にリアクションしたユーザーの一覧取得.
+	// リアクションを新しい順に返します。閲覧者とブロック関係にあるユーザーのリアクションは含まれません。.
 	//
 	// GET /posts/{post_id}/reactions
 	PostsPostIDReactionsGet(ctx context.Context, params PostsPostIDReactionsGetParams) (PostsPostIDReactionsGetRes, error)
 	// PostsPostIDReactionsPost invokes POST /posts/{post_id}/reactions operation.
 	//
-	// 投稿にリアクション（いいね）を追加します。リアクションしたユーザーを記録するために認証が必要です。.
+	// 投稿に指定した種類のリアクションを追加します。1ユーザーにつき種類ごとに1回までリアクションできます。種類を省略した場合は最初の種類になります。.
 	//
 	// POST /posts/{post_id}/reactions
-	PostsPostIDReactionsPost(ctx context.Context, params PostsPostIDReactionsPostParams) (PostsPostIDReactionsPostRes, error)
+	PostsPostIDReactionsPost(ctx context.Context, request OptReactionRequest, params PostsPostIDReactionsPostParams) (PostsPostIDReactionsPostRes, error)
+	// PostsPostIDRepostDelete invokes DELETE /posts/{post_id}/repost operation.
+	//
+	// リポストを取り消し.
+	//
+	// DELETE /posts/{post_id}/repost
+	PostsPostIDRepostDelete(ctx context.Context, params PostsPostIDRepostDeleteParams) (PostsPostIDRepostDeleteRes, error)
+	// PostsPostIDRepostPost invokes POST /posts/{post_id}/repost operation.
+	//
+	// 投稿をコメントなしで自分のフォロワーのタイムラインに共有します。コメントを付けて共有する場合は、quote_post_idを指定して投稿を作成します。
+	// 閲覧できない投稿や公開前の投稿はリポストできません。既にリポストしている場合は何もしません。.
+	//
+	// POST /posts/{post_id}/repost
+	PostsPostIDRepostPost(ctx context.Context, params PostsPostIDRepostPostParams) (PostsPostIDRepostPostRes, error)
+	// PostsPostIDRevisionsGet invokes GET /posts/{post_id}/revisions operation.
+	//
+	// 編集前の版を新しい順に返します。現在の内容は含みません。投稿者本人のほか、サーバー設定で公開されている場合は誰でも閲覧できます。.
+	//
+	// GET /posts/{post_id}/revisions
+	PostsPostIDRevisionsGet(ctx context.Context, params PostsPostIDRevisionsGetParams) (PostsPostIDRevisionsGetRes, error)
+	// PostsPostIDRevisionsRevisionRestorePost invokes POST /posts/{post_id}/revisions/{revision}/restore operation.
+	//
+	// 指定した版の本文・進捗量・画像で投稿を更新します。復元前の内容は新しい版として履歴に残ります。投稿者本人のみ実行できます。.
+	//
+	// POST /posts/{post_id}/revisions/{revision}/restore
+	PostsPostIDRevisionsRevisionRestorePost(ctx context.Context, params PostsPostIDRevisionsRevisionRestorePostParams) (PostsPostIDRevisionsRevisionRestorePostRes, error)
+	// ReactionsKindsGet invokes GET /reactions/kinds operation.
+	//
+	// サーバー設定で有効なリアクションの種類を表示順で返します。.
+	//
+	// GET /reactions/kinds
+	ReactionsKindsGet(ctx context.Context) ([]ReactionKind, error)
+	// ReportsPost invokes POST /reports operation.
+	//
+	// 不適切な投稿・コメント・プロフィールを報告します。報告はモデレーターの対応待ちの一覧に追加されます。
+	// 閲覧できない対象や自分自身の投稿・コメント・プロフィールは報告できません。同じ対象は1回だけ報告できます。.
+	//
+	// POST /reports
+	ReportsPost(ctx context.Context, request *ReportRequest) (ReportsPostRes, error)
+	// SearchGet invokes GET /search operation.
+	//
+	// 投稿の本文または目標のタイトルを検索し、作成日時の新しい順に返します。続きを取得するには、前回の最後の結果のIDをafterに指定します。
+	// 検索語は空白で区切ったすべての語を含むものが一致します。漢字・かなは2文字ずつの組で、英数字は単語の前方一致で検索します。
+	// 全角・半角、英字の大文字・小文字は区別しません。
+	// 投稿は公開済みのものと自分の下書き・予約投稿が対象です。ブロック関係にあるユーザーの投稿・目標は含まれません。.
+	//
+	// GET /search
+	SearchGet(ctx context.Context, params SearchGetParams) (SearchGetRes, error)
+	// TimelineDiscoverGet invokes GET /timeline/discover operation.
+	//
+	// フォローしていないユーザーの最近の公開済みの投稿から、興味のあるジャンルが共通のユーザーの投稿、フォローしているユーザーがフォローしているユーザーの投稿、最近投稿の多い目標への投稿を集め、おすすめ順に返します。
+	// おすすめ順は新しさ、1時間あたりのリアクション数、共通のジャンルの割合、おすすめの理由から求めた点数の高い順で、1ページに同じユーザーの投稿は一定件数までしか含めません。
+	// 次のページはLinkヘッダーのcursorで取得します。cursorは最初のページを取得した時点の並び順を引き継ぎます。.
+	//
+	// GET /timeline/discover
+	TimelineDiscoverGet(ctx context.Context, params TimelineDiscoverGetParams) (TimelineDiscoverGetRes, error)
 	// TimelineGet invokes GET /timeline operation.
 	//
-	// 投稿は新しい順（降順、最新が最初）で返されます。.
+	// 自分とフォローしているユーザーの投稿と、それらのユーザーのリポストを新しい順（降順、最新が最初）で返します。
+	// 同じ投稿は最も新しい投稿・リポストの位置に1回だけ含まれ、リポストによって含まれた場合はreposted_byとreposted_atが設定されます。
+	// タイムラインは投稿・リポスト・フォローの時点で非同期に書き込まれるため、反映まで少し遅れることがあります。.
 	//
 	// GET /timeline
 	TimelineGet(ctx context.Context, params TimelineGetParams) (TimelineGetRes, error)
@@ -185,7 +494,8 @@ type Invoker interface {
 	UsersUserIDDelete(ctx context.Context, params UsersUserIDDeleteParams) (UsersUserIDDeleteRes, error)
 	// UsersUserIDFriendsGet invokes GET /users/{user_id}/friends operation.
 	//
-	// ユーザーのフレンド（フォロー）一覧取得.
+	// ユーザーがフォローしているユーザーのIDを、ユーザーの登録日時の新しい順に返します。
+	// 閲覧者とブロック関係にあるユーザーは含まれず、閲覧者とブロック関係にあるユーザーの一覧は404になります。.
 	//
 	// GET /users/{user_id}/friends
 	UsersUserIDFriendsGet(ctx context.Context, params UsersUserIDFriendsGetParams) (UsersUserIDFriendsGetRes, error)
@@ -282,20 +592,21 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
-// AuthCallbackGet invokes GET /auth/callback operation.
+// AdminModerationActionsGet invokes GET /admin/moderation/actions operation.
 //
-// OIDCコールバック（コードをトークンに交換）.
+// 報告の担当・対応を含む、モデレーターが行ったすべての操作を新しい順に返します。
+// 続きを取得するには、前回の最後の操作のIDをafterに指定します。.
 //
-// GET /auth/callback
-func (c *Client) AuthCallbackGet(ctx context.Context, params AuthCallbackGetParams) (AuthCallbackGetRes, error) {
-	res, err := c.sendAuthCallbackGet(ctx, params)
+// GET /admin/moderation/actions
+func (c *Client) AdminModerationActionsGet(ctx context.Context, params AdminModerationActionsGetParams) (AdminModerationActionsGetRes, error) {
+	res, err := c.sendAdminModerationActionsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendAuthCallbackGet(ctx context.Context, params AuthCallbackGetParams) (res AuthCallbackGetRes, err error) {
+func (c *Client) sendAdminModerationActionsGet(ctx context.Context, params AdminModerationActionsGetParams) (res AdminModerationActionsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/auth/callback"),
+		semconv.URLTemplateKey.String("/admin/moderation/actions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -311,7 +622,7 @@ func (c *Client) sendAuthCallbackGet(ctx context.Context, params AuthCallbackGet
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AuthCallbackGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AdminModerationActionsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -329,35 +640,109 @@ func (c *Client) sendAuthCallbackGet(ctx context.Context, params AuthCallbackGet
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/auth/callback"
+	pathParts[0] = "/admin/moderation/actions"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "code" parameter.
+		// Encode "target_type" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "code",
+			Name:    "target_type",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Code))
+			if val, ok := params.TargetType.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	{
-		// Encode "state" parameter.
+		// Encode "target_id" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "state",
+			Name:    "target_id",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.State))
+			if val, ok := params.TargetID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "moderator_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "moderator_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ModeratorID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "after" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.After.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
@@ -370,6 +755,39 @@ func (c *Client) sendAuthCallbackGet(ctx context.Context, params AuthCallbackGet
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, AdminModerationActionsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -378,7 +796,7 @@ func (c *Client) sendAuthCallbackGet(ctx context.Context, params AuthCallbackGet
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAuthCallbackGetResponse(resp)
+	result, err := decodeAdminModerationActionsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -386,20 +804,24 @@ func (c *Client) sendAuthCallbackGet(ctx context.Context, params AuthCallbackGet
 	return result, nil
 }
 
-// AuthLoginGet invokes GET /auth/login operation.
+// AdminModerationActionsPost invokes POST /admin/moderation/actions operation.
 //
-// OIDCログインを開始.
+// 投稿・コメント・プロフィールの非表示（hide）と再表示（unhide）、ユーザーへの警告（warn）、
+// アカウントの停止（suspend）と停止の解除（unsuspend）を行います。操作は監査ログに記録されます。
+// 警告・停止・停止の解除の対象はユーザーのみです。自分自身は対象にできず、モデレーター・管理者を停止できるのは管理者のみです。
+// 非表示の内容は作成者本人にのみ、通知（moderation_notice）付きで表示されます。
+// 停止中のユーザーは認証が必要な操作を行えません（403）。.
 //
-// GET /auth/login
-func (c *Client) AuthLoginGet(ctx context.Context) error {
-	_, err := c.sendAuthLoginGet(ctx)
-	return err
+// POST /admin/moderation/actions
+func (c *Client) AdminModerationActionsPost(ctx context.Context, request *ModerationActionRequest) (AdminModerationActionsPostRes, error) {
+	res, err := c.sendAdminModerationActionsPost(ctx, request)
+	return res, err
 }
 
-func (c *Client) sendAuthLoginGet(ctx context.Context) (res *AuthLoginGetMovedPermanently, err error) {
+func (c *Client) sendAdminModerationActionsPost(ctx context.Context, request *ModerationActionRequest) (res AdminModerationActionsPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/auth/login"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/admin/moderation/actions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -415,7 +837,7 @@ func (c *Client) sendAuthLoginGet(ctx context.Context) (res *AuthLoginGetMovedPe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AuthLoginGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AdminModerationActionsPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -433,14 +855,50 @@ func (c *Client) sendAuthLoginGet(ctx context.Context) (res *AuthLoginGetMovedPe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/auth/login"
+	pathParts[0] = "/admin/moderation/actions"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeAdminModerationActionsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, AdminModerationActionsPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -450,7 +908,7 @@ func (c *Client) sendAuthLoginGet(ctx context.Context) (res *AuthLoginGetMovedPe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAuthLoginGetResponse(resp)
+	result, err := decodeAdminModerationActionsPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -458,20 +916,21 @@ func (c *Client) sendAuthLoginGet(ctx context.Context) (res *AuthLoginGetMovedPe
 	return result, nil
 }
 
-// AuthLogoutPost invokes POST /auth/logout operation.
+// AdminReportsGet invokes GET /admin/reports operation.
 //
-// ログアウト.
+// 報告を古い順に返します。続きを取得するには、前回の最後の報告のIDをafterに指定します。
+// 迷惑行為フィルタが保留にした投稿・コメントもsource=filterの報告として含まれます。.
 //
-// POST /auth/logout
-func (c *Client) AuthLogoutPost(ctx context.Context) error {
-	_, err := c.sendAuthLogoutPost(ctx)
-	return err
+// GET /admin/reports
+func (c *Client) AdminReportsGet(ctx context.Context, params AdminReportsGetParams) (AdminReportsGetRes, error) {
+	res, err := c.sendAdminReportsGet(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendAuthLogoutPost(ctx context.Context) (res *AuthLogoutPostNoContent, err error) {
+func (c *Client) sendAdminReportsGet(ctx context.Context, params AdminReportsGetParams) (res AdminReportsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/auth/logout"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/admin/reports"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -487,7 +946,7 @@ func (c *Client) sendAuthLogoutPost(ctx context.Context) (res *AuthLogoutPostNoC
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AuthLogoutPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AdminReportsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -505,15 +964,154 @@ func (c *Client) sendAuthLogoutPost(ctx context.Context) (res *AuthLogoutPostNoC
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/auth/logout"
+	pathParts[0] = "/admin/reports"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "source" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "source",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Source.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "target_type" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "target_type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.TargetType.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "after" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.After.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, AdminReportsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -522,7 +1120,7 @@ func (c *Client) sendAuthLogoutPost(ctx context.Context) (res *AuthLogoutPostNoC
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAuthLogoutPostResponse(resp)
+	result, err := decodeAdminReportsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -530,20 +1128,21 @@ func (c *Client) sendAuthLogoutPost(ctx context.Context) (res *AuthLogoutPostNoC
 	return result, nil
 }
 
-// AuthMeGet invokes GET /auth/me operation.
+// AdminReportsReportIDClaimPost invokes POST /admin/reports/{report_id}/claim operation.
 //
-// 現在のユーザー情報を取得.
+// 未対応の報告の担当になり、対応中にします。自分が担当している報告に対しては何もしません。
+// 他のモデレーターが担当している報告や対応済みの報告は担当できません。.
 //
-// GET /auth/me
-func (c *Client) AuthMeGet(ctx context.Context) (AuthMeGetRes, error) {
-	res, err := c.sendAuthMeGet(ctx)
+// POST /admin/reports/{report_id}/claim
+func (c *Client) AdminReportsReportIDClaimPost(ctx context.Context, params AdminReportsReportIDClaimPostParams) (AdminReportsReportIDClaimPostRes, error) {
+	res, err := c.sendAdminReportsReportIDClaimPost(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendAuthMeGet(ctx context.Context) (res AuthMeGetRes, err error) {
+func (c *Client) sendAdminReportsReportIDClaimPost(ctx context.Context, params AdminReportsReportIDClaimPostParams) (res AdminReportsReportIDClaimPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/auth/me"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/admin/reports/{report_id}/claim"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -559,7 +1158,7 @@ func (c *Client) sendAuthMeGet(ctx context.Context) (res AuthMeGetRes, err error
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AuthMeGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AdminReportsReportIDClaimPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -576,12 +1175,31 @@ func (c *Client) sendAuthMeGet(ctx context.Context) (res AuthMeGetRes, err error
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/auth/me"
+	var pathParts [3]string
+	pathParts[0] = "/admin/reports/"
+	{
+		// Encode "report_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "report_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ReportID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/claim"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -591,7 +1209,7 @@ func (c *Client) sendAuthMeGet(ctx context.Context) (res AuthMeGetRes, err error
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, AuthMeGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, AdminReportsReportIDClaimPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -627,7 +1245,7 @@ func (c *Client) sendAuthMeGet(ctx context.Context) (res AuthMeGetRes, err error
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAuthMeGetResponse(resp)
+	result, err := decodeAdminReportsReportIDClaimPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -635,20 +1253,21 @@ func (c *Client) sendAuthMeGet(ctx context.Context) (res AuthMeGetRes, err error
 	return result, nil
 }
 
-// FriendsGet invokes GET /friends operation.
+// AdminReportsReportIDResolvePost invokes POST /admin/reports/{report_id}/resolve operation.
 //
-// 自分のフレンド（フォロー）一覧取得.
+// 自分が担当している報告を対応済みにします。対象への措置（非表示・警告・アカウント停止）は
+// POST /admin/moderation/actionsで行い、report_idにこの報告を指定します。.
 //
-// GET /friends
-func (c *Client) FriendsGet(ctx context.Context) (FriendsGetRes, error) {
-	res, err := c.sendFriendsGet(ctx)
+// POST /admin/reports/{report_id}/resolve
+func (c *Client) AdminReportsReportIDResolvePost(ctx context.Context, request *ReportResolveRequest, params AdminReportsReportIDResolvePostParams) (AdminReportsReportIDResolvePostRes, error) {
+	res, err := c.sendAdminReportsReportIDResolvePost(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendFriendsGet(ctx context.Context) (res FriendsGetRes, err error) {
+func (c *Client) sendAdminReportsReportIDResolvePost(ctx context.Context, request *ReportResolveRequest, params AdminReportsReportIDResolvePostParams) (res AdminReportsReportIDResolvePostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/friends"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/admin/reports/{report_id}/resolve"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -664,7 +1283,7 @@ func (c *Client) sendFriendsGet(ctx context.Context) (res FriendsGetRes, err err
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, FriendsGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AdminReportsReportIDResolvePostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -681,22 +1300,44 @@ func (c *Client) sendFriendsGet(ctx context.Context) (res FriendsGetRes, err err
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/friends"
+	var pathParts [3]string
+	pathParts[0] = "/admin/reports/"
+	{
+		// Encode "report_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "report_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ReportID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/resolve"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeAdminReportsReportIDResolvePostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, FriendsGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, AdminReportsReportIDResolvePostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -732,7 +1373,7 @@ func (c *Client) sendFriendsGet(ctx context.Context) (res FriendsGetRes, err err
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeFriendsGetResponse(resp)
+	result, err := decodeAdminReportsReportIDResolvePostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -740,20 +1381,20 @@ func (c *Client) sendFriendsGet(ctx context.Context) (res FriendsGetRes, err err
 	return result, nil
 }
 
-// FriendsPost invokes POST /friends operation.
+// AdminTemplatesPost invokes POST /admin/templates operation.
 //
-// フレンド追加（フォロー）.
+// 目標テンプレート作成（管理者）.
 //
-// POST /friends
-func (c *Client) FriendsPost(ctx context.Context, request *FriendsPostReq) (FriendsPostRes, error) {
-	res, err := c.sendFriendsPost(ctx, request)
+// POST /admin/templates
+func (c *Client) AdminTemplatesPost(ctx context.Context, request *GoalTemplateRequest) (AdminTemplatesPostRes, error) {
+	res, err := c.sendAdminTemplatesPost(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendFriendsPost(ctx context.Context, request *FriendsPostReq) (res FriendsPostRes, err error) {
+func (c *Client) sendAdminTemplatesPost(ctx context.Context, request *GoalTemplateRequest) (res AdminTemplatesPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/friends"),
+		semconv.URLTemplateKey.String("/admin/templates"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -769,7 +1410,7 @@ func (c *Client) sendFriendsPost(ctx context.Context, request *FriendsPostReq) (
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, FriendsPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AdminTemplatesPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -787,7 +1428,7 @@ func (c *Client) sendFriendsPost(ctx context.Context, request *FriendsPostReq) (
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/friends"
+	pathParts[0] = "/admin/templates"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -795,7 +1436,7 @@ func (c *Client) sendFriendsPost(ctx context.Context, request *FriendsPostReq) (
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeFriendsPostRequest(request, r); err != nil {
+	if err := encodeAdminTemplatesPostRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -804,7 +1445,7 @@ func (c *Client) sendFriendsPost(ctx context.Context, request *FriendsPostReq) (
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, FriendsPostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, AdminTemplatesPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -840,7 +1481,7 @@ func (c *Client) sendFriendsPost(ctx context.Context, request *FriendsPostReq) (
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeFriendsPostResponse(resp)
+	result, err := decodeAdminTemplatesPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -848,20 +1489,20 @@ func (c *Client) sendFriendsPost(ctx context.Context, request *FriendsPostReq) (
 	return result, nil
 }
 
-// FriendsUserIDDelete invokes DELETE /friends/{user_id} operation.
+// AdminTemplatesTemplateIDDelete invokes DELETE /admin/templates/{template_id} operation.
 //
-// フレンド削除（フォロー解除）.
+// 目標テンプレート削除（管理者）.
 //
-// DELETE /friends/{user_id}
-func (c *Client) FriendsUserIDDelete(ctx context.Context, params FriendsUserIDDeleteParams) (FriendsUserIDDeleteRes, error) {
-	res, err := c.sendFriendsUserIDDelete(ctx, params)
+// DELETE /admin/templates/{template_id}
+func (c *Client) AdminTemplatesTemplateIDDelete(ctx context.Context, params AdminTemplatesTemplateIDDeleteParams) (AdminTemplatesTemplateIDDeleteRes, error) {
+	res, err := c.sendAdminTemplatesTemplateIDDelete(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendFriendsUserIDDelete(ctx context.Context, params FriendsUserIDDeleteParams) (res FriendsUserIDDeleteRes, err error) {
+func (c *Client) sendAdminTemplatesTemplateIDDelete(ctx context.Context, params AdminTemplatesTemplateIDDeleteParams) (res AdminTemplatesTemplateIDDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/friends/{user_id}"),
+		semconv.URLTemplateKey.String("/admin/templates/{template_id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -877,7 +1518,7 @@ func (c *Client) sendFriendsUserIDDelete(ctx context.Context, params FriendsUser
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, FriendsUserIDDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AdminTemplatesTemplateIDDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -895,16 +1536,16 @@ func (c *Client) sendFriendsUserIDDelete(ctx context.Context, params FriendsUser
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/friends/"
+	pathParts[0] = "/admin/templates/"
 	{
-		// Encode "user_id" parameter.
+		// Encode "template_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "user_id",
+			Param:   "template_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.UserID))
+			return e.EncodeValue(conv.UUIDToString(params.TemplateID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -927,7 +1568,7 @@ func (c *Client) sendFriendsUserIDDelete(ctx context.Context, params FriendsUser
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, FriendsUserIDDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, AdminTemplatesTemplateIDDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -963,7 +1604,7 @@ func (c *Client) sendFriendsUserIDDelete(ctx context.Context, params FriendsUser
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeFriendsUserIDDeleteResponse(resp)
+	result, err := decodeAdminTemplatesTemplateIDDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -971,20 +1612,20 @@ func (c *Client) sendFriendsUserIDDelete(ctx context.Context, params FriendsUser
 	return result, nil
 }
 
-// GenresGet invokes GET /genres operation.
+// AdminTemplatesTemplateIDPut invokes PUT /admin/templates/{template_id} operation.
 //
-// 利用可能なジャンル一覧取得.
+// 目標テンプレート更新（管理者）.
 //
-// GET /genres
-func (c *Client) GenresGet(ctx context.Context) ([]Genre, error) {
-	res, err := c.sendGenresGet(ctx)
+// PUT /admin/templates/{template_id}
+func (c *Client) AdminTemplatesTemplateIDPut(ctx context.Context, request *GoalTemplateRequest, params AdminTemplatesTemplateIDPutParams) (AdminTemplatesTemplateIDPutRes, error) {
+	res, err := c.sendAdminTemplatesTemplateIDPut(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGenresGet(ctx context.Context) (res []Genre, err error) {
+func (c *Client) sendAdminTemplatesTemplateIDPut(ctx context.Context, request *GoalTemplateRequest, params AdminTemplatesTemplateIDPutParams) (res AdminTemplatesTemplateIDPutRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/genres"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/admin/templates/{template_id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1000,7 +1641,7 @@ func (c *Client) sendGenresGet(ctx context.Context) (res []Genre, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GenresGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AdminTemplatesTemplateIDPutOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1017,25 +1658,5583 @@ func (c *Client) sendGenresGet(ctx context.Context) (res []Genre, err error) {
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/genres"
+	var pathParts [2]string
+	pathParts[0] = "/admin/templates/"
+	{
+		// Encode "template_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "template_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.TemplateID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeAdminTemplatesTemplateIDPutRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
-	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, AdminTemplatesTemplateIDPutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAdminTemplatesTemplateIDPutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AuthCallbackGet invokes GET /auth/callback operation.
+//
+// OIDCコールバック（コードをトークンに交換）.
+//
+// GET /auth/callback
+func (c *Client) AuthCallbackGet(ctx context.Context, params AuthCallbackGetParams) (AuthCallbackGetRes, error) {
+	res, err := c.sendAuthCallbackGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAuthCallbackGet(ctx context.Context, params AuthCallbackGetParams) (res AuthCallbackGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/auth/callback"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AuthCallbackGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auth/callback"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "code" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Code))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "state" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "state",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.State))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAuthCallbackGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AuthLoginGet invokes GET /auth/login operation.
+//
+// OIDCログインを開始.
+//
+// GET /auth/login
+func (c *Client) AuthLoginGet(ctx context.Context) error {
+	_, err := c.sendAuthLoginGet(ctx)
+	return err
+}
+
+func (c *Client) sendAuthLoginGet(ctx context.Context) (res *AuthLoginGetMovedPermanently, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/auth/login"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AuthLoginGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auth/login"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAuthLoginGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AuthLogoutPost invokes POST /auth/logout operation.
+//
+// ログアウト.
+//
+// POST /auth/logout
+func (c *Client) AuthLogoutPost(ctx context.Context) error {
+	_, err := c.sendAuthLogoutPost(ctx)
+	return err
+}
+
+func (c *Client) sendAuthLogoutPost(ctx context.Context) (res *AuthLogoutPostNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/auth/logout"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AuthLogoutPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auth/logout"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAuthLogoutPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AuthMeGet invokes GET /auth/me operation.
+//
+// 現在のユーザー情報を取得.
+//
+// GET /auth/me
+func (c *Client) AuthMeGet(ctx context.Context) (AuthMeGetRes, error) {
+	res, err := c.sendAuthMeGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendAuthMeGet(ctx context.Context) (res AuthMeGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/auth/me"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AuthMeGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auth/me"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, AuthMeGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAuthMeGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BlocksGet invokes GET /blocks operation.
+//
+// 自分がブロックしているユーザー一覧取得.
+//
+// GET /blocks
+func (c *Client) BlocksGet(ctx context.Context) (BlocksGetRes, error) {
+	res, err := c.sendBlocksGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendBlocksGet(ctx context.Context) (res BlocksGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/blocks"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BlocksGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/blocks"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, BlocksGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBlocksGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BlocksPost invokes POST /blocks operation.
+//
+// ブロックすると互いのフォローが解除され、互いの投稿へのコメントやコメントの表示ができなくなります。.
+//
+// POST /blocks
+func (c *Client) BlocksPost(ctx context.Context, request *BlocksPostReq) (BlocksPostRes, error) {
+	res, err := c.sendBlocksPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendBlocksPost(ctx context.Context, request *BlocksPostReq) (res BlocksPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/blocks"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BlocksPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/blocks"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeBlocksPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, BlocksPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBlocksPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BlocksUserIDDelete invokes DELETE /blocks/{user_id} operation.
+//
+// ブロック解除.
+//
+// DELETE /blocks/{user_id}
+func (c *Client) BlocksUserIDDelete(ctx context.Context, params BlocksUserIDDeleteParams) (BlocksUserIDDeleteRes, error) {
+	res, err := c.sendBlocksUserIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendBlocksUserIDDelete(ctx context.Context, params BlocksUserIDDeleteParams) (res BlocksUserIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/blocks/{user_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BlocksUserIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/blocks/"
+	{
+		// Encode "user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.UserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, BlocksUserIDDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBlocksUserIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BookmarksCollectionsCollectionIDDelete invokes DELETE /bookmarks/collections/{collection_id} operation.
+//
+// コレクション内のブックマークは削除されず、未分類になります。.
+//
+// DELETE /bookmarks/collections/{collection_id}
+func (c *Client) BookmarksCollectionsCollectionIDDelete(ctx context.Context, params BookmarksCollectionsCollectionIDDeleteParams) (BookmarksCollectionsCollectionIDDeleteRes, error) {
+	res, err := c.sendBookmarksCollectionsCollectionIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendBookmarksCollectionsCollectionIDDelete(ctx context.Context, params BookmarksCollectionsCollectionIDDeleteParams) (res BookmarksCollectionsCollectionIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/bookmarks/collections/{collection_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BookmarksCollectionsCollectionIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/bookmarks/collections/"
+	{
+		// Encode "collection_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "collection_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CollectionID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, BookmarksCollectionsCollectionIDDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBookmarksCollectionsCollectionIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BookmarksCollectionsCollectionIDPut invokes PUT /bookmarks/collections/{collection_id} operation.
+//
+// ブックマークコレクション名の変更.
+//
+// PUT /bookmarks/collections/{collection_id}
+func (c *Client) BookmarksCollectionsCollectionIDPut(ctx context.Context, request *BookmarkCollectionRequest, params BookmarksCollectionsCollectionIDPutParams) (BookmarksCollectionsCollectionIDPutRes, error) {
+	res, err := c.sendBookmarksCollectionsCollectionIDPut(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendBookmarksCollectionsCollectionIDPut(ctx context.Context, request *BookmarkCollectionRequest, params BookmarksCollectionsCollectionIDPutParams) (res BookmarksCollectionsCollectionIDPutRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/bookmarks/collections/{collection_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BookmarksCollectionsCollectionIDPutOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/bookmarks/collections/"
+	{
+		// Encode "collection_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "collection_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CollectionID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeBookmarksCollectionsCollectionIDPutRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, BookmarksCollectionsCollectionIDPutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBookmarksCollectionsCollectionIDPutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BookmarksCollectionsGet invokes GET /bookmarks/collections operation.
+//
+// 自分のブックマークコレクション一覧取得.
+//
+// GET /bookmarks/collections
+func (c *Client) BookmarksCollectionsGet(ctx context.Context) (BookmarksCollectionsGetRes, error) {
+	res, err := c.sendBookmarksCollectionsGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendBookmarksCollectionsGet(ctx context.Context) (res BookmarksCollectionsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/bookmarks/collections"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BookmarksCollectionsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/bookmarks/collections"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, BookmarksCollectionsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBookmarksCollectionsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BookmarksCollectionsPost invokes POST /bookmarks/collections operation.
+//
+// ブックマークコレクション作成.
+//
+// POST /bookmarks/collections
+func (c *Client) BookmarksCollectionsPost(ctx context.Context, request *BookmarkCollectionRequest) (BookmarksCollectionsPostRes, error) {
+	res, err := c.sendBookmarksCollectionsPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendBookmarksCollectionsPost(ctx context.Context, request *BookmarkCollectionRequest) (res BookmarksCollectionsPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/bookmarks/collections"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BookmarksCollectionsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/bookmarks/collections"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeBookmarksCollectionsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, BookmarksCollectionsPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBookmarksCollectionsPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// BookmarksGet invokes GET /bookmarks operation.
+//
+// ブックマークを新しい順に返します。続きを取得するには、前回の最後のブックマークのIDをafterに指定します。
+// 削除された投稿や、下書きに戻された・ブロック関係になったなどの理由で閲覧できなくなった投稿は含まれません。.
+//
+// GET /bookmarks
+func (c *Client) BookmarksGet(ctx context.Context, params BookmarksGetParams) (BookmarksGetRes, error) {
+	res, err := c.sendBookmarksGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendBookmarksGet(ctx context.Context, params BookmarksGetParams) (res BookmarksGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/bookmarks"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, BookmarksGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/bookmarks"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "collection" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "collection",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Collection.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "after" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.After.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, BookmarksGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeBookmarksGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CommentsCommentIDDelete invokes DELETE /comments/{comment_id} operation.
+//
+// コメントしたユーザー本人、または投稿者が削除できます。返信のスレッドを保つため論理削除されます。.
+//
+// DELETE /comments/{comment_id}
+func (c *Client) CommentsCommentIDDelete(ctx context.Context, params CommentsCommentIDDeleteParams) (CommentsCommentIDDeleteRes, error) {
+	res, err := c.sendCommentsCommentIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendCommentsCommentIDDelete(ctx context.Context, params CommentsCommentIDDeleteParams) (res CommentsCommentIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/comments/{comment_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CommentsCommentIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/comments/"
+	{
+		// Encode "comment_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "comment_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CommentID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CommentsCommentIDDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCommentsCommentIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CommentsCommentIDPut invokes PUT /comments/{comment_id} operation.
+//
+// コメントしたユーザー本人のみ編集できます。.
+//
+// PUT /comments/{comment_id}
+func (c *Client) CommentsCommentIDPut(ctx context.Context, request *CommentUpdateRequest, params CommentsCommentIDPutParams) (CommentsCommentIDPutRes, error) {
+	res, err := c.sendCommentsCommentIDPut(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendCommentsCommentIDPut(ctx context.Context, request *CommentUpdateRequest, params CommentsCommentIDPutParams) (res CommentsCommentIDPutRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/comments/{comment_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CommentsCommentIDPutOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/comments/"
+	{
+		// Encode "comment_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "comment_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CommentID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCommentsCommentIDPutRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, CommentsCommentIDPutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCommentsCommentIDPutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// FriendsGet invokes GET /friends operation.
+//
+// フォローしているユーザーのIDを、ユーザーの登録日時の新しい順に返します。.
+//
+// GET /friends
+func (c *Client) FriendsGet(ctx context.Context, params FriendsGetParams) (FriendsGetRes, error) {
+	res, err := c.sendFriendsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendFriendsGet(ctx context.Context, params FriendsGetParams) (res FriendsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/friends"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, FriendsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/friends"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, FriendsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeFriendsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// FriendsPost invokes POST /friends operation.
+//
+// 既にフォローしている場合も201を返します。自分自身は400、存在しないユーザーやブロック関係にあるユーザーは404になります。.
+//
+// POST /friends
+func (c *Client) FriendsPost(ctx context.Context, request *FriendsPostReq) (FriendsPostRes, error) {
+	res, err := c.sendFriendsPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendFriendsPost(ctx context.Context, request *FriendsPostReq) (res FriendsPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/friends"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, FriendsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/friends"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeFriendsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, FriendsPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeFriendsPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// FriendsUserIDDelete invokes DELETE /friends/{user_id} operation.
+//
+// フレンド削除（フォロー解除）.
+//
+// DELETE /friends/{user_id}
+func (c *Client) FriendsUserIDDelete(ctx context.Context, params FriendsUserIDDeleteParams) (FriendsUserIDDeleteRes, error) {
+	res, err := c.sendFriendsUserIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendFriendsUserIDDelete(ctx context.Context, params FriendsUserIDDeleteParams) (res FriendsUserIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/friends/{user_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, FriendsUserIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/friends/"
+	{
+		// Encode "user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.UserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, FriendsUserIDDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeFriendsUserIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GenresGenreIDTemplatesGet invokes GET /genres/{genre_id}/templates operation.
+//
+// ジャンル別の目標テンプレート一覧取得.
+//
+// GET /genres/{genre_id}/templates
+func (c *Client) GenresGenreIDTemplatesGet(ctx context.Context, params GenresGenreIDTemplatesGetParams) (GenresGenreIDTemplatesGetRes, error) {
+	res, err := c.sendGenresGenreIDTemplatesGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGenresGenreIDTemplatesGet(ctx context.Context, params GenresGenreIDTemplatesGetParams) (res GenresGenreIDTemplatesGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/genres/{genre_id}/templates"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GenresGenreIDTemplatesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/genres/"
+	{
+		// Encode "genre_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "genre_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GenreID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/templates"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGenresGenreIDTemplatesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GenresGet invokes GET /genres operation.
+//
+// 利用可能なジャンル一覧取得.
+//
+// GET /genres
+func (c *Client) GenresGet(ctx context.Context) ([]Genre, error) {
+	res, err := c.sendGenresGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendGenresGet(ctx context.Context) (res []Genre, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/genres"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GenresGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/genres"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGenresGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsFromTemplateTemplateIDPost invokes POST /goals/from-template/{template_id} operation.
+//
+// テンプレートのタイトル・期間・マイルストーン・習慣スケジュールを元に目標を作成します。リクエストボディで指定した値はテンプレートの値より優先されます。.
+//
+// POST /goals/from-template/{template_id}
+func (c *Client) GoalsFromTemplateTemplateIDPost(ctx context.Context, request OptGoalFromTemplateRequest, params GoalsFromTemplateTemplateIDPostParams) (GoalsFromTemplateTemplateIDPostRes, error) {
+	res, err := c.sendGoalsFromTemplateTemplateIDPost(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendGoalsFromTemplateTemplateIDPost(ctx context.Context, request OptGoalFromTemplateRequest, params GoalsFromTemplateTemplateIDPostParams) (res GoalsFromTemplateTemplateIDPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/goals/from-template/{template_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsFromTemplateTemplateIDPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/goals/from-template/"
+	{
+		// Encode "template_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "template_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.TemplateID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGoalsFromTemplateTemplateIDPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GoalsFromTemplateTemplateIDPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsFromTemplateTemplateIDPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsGet invokes GET /goals operation.
+//
+// 現在のユーザーの目標一覧取得.
+//
+// GET /goals
+func (c *Client) GoalsGet(ctx context.Context, params GoalsGetParams) (GoalsGetRes, error) {
+	res, err := c.sendGoalsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGoalsGet(ctx context.Context, params GoalsGetParams) (res GoalsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/goals"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/goals"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GoalsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsGoalIDAnalyticsGet invokes GET /goals/{goal_id}/analytics operation.
+//
+// 投稿のヒートマップ、投稿間隔、受け取ったリアクションの推移、期限との比較を返します。日付は目標オーナーのタイムゾーンで集計されます。.
+//
+// GET /goals/{goal_id}/analytics
+func (c *Client) GoalsGoalIDAnalyticsGet(ctx context.Context, params GoalsGoalIDAnalyticsGetParams) (GoalsGoalIDAnalyticsGetRes, error) {
+	res, err := c.sendGoalsGoalIDAnalyticsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGoalsGoalIDAnalyticsGet(ctx context.Context, params GoalsGoalIDAnalyticsGetParams) (res GoalsGoalIDAnalyticsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/goals/{goal_id}/analytics"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDAnalyticsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/goals/"
+	{
+		// Encode "goal_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "goal_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/analytics"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsGoalIDAnalyticsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsGoalIDDelete invokes DELETE /goals/{goal_id} operation.
+//
+// 目標削除.
+//
+// DELETE /goals/{goal_id}
+func (c *Client) GoalsGoalIDDelete(ctx context.Context, params GoalsGoalIDDeleteParams) (GoalsGoalIDDeleteRes, error) {
+	res, err := c.sendGoalsGoalIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGoalsGoalIDDelete(ctx context.Context, params GoalsGoalIDDeleteParams) (res GoalsGoalIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/goals/{goal_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/goals/"
+	{
+		// Encode "goal_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "goal_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GoalsGoalIDDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsGoalIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsGoalIDGet invokes GET /goals/{goal_id} operation.
+//
+// 目標詳細取得.
+//
+// GET /goals/{goal_id}
+func (c *Client) GoalsGoalIDGet(ctx context.Context, params GoalsGoalIDGetParams) (GoalsGoalIDGetRes, error) {
+	res, err := c.sendGoalsGoalIDGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGoalsGoalIDGet(ctx context.Context, params GoalsGoalIDGetParams) (res GoalsGoalIDGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/goals/{goal_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/goals/"
+	{
+		// Encode "goal_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "goal_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsGoalIDGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsGoalIDParticipantsAcceptPost invokes POST /goals/{goal_id}/participants/accept operation.
+//
+// 目標への招待を承認.
+//
+// POST /goals/{goal_id}/participants/accept
+func (c *Client) GoalsGoalIDParticipantsAcceptPost(ctx context.Context, params GoalsGoalIDParticipantsAcceptPostParams) (GoalsGoalIDParticipantsAcceptPostRes, error) {
+	res, err := c.sendGoalsGoalIDParticipantsAcceptPost(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGoalsGoalIDParticipantsAcceptPost(ctx context.Context, params GoalsGoalIDParticipantsAcceptPostParams) (res GoalsGoalIDParticipantsAcceptPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/goals/{goal_id}/participants/accept"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDParticipantsAcceptPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/goals/"
+	{
+		// Encode "goal_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "goal_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/participants/accept"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GoalsGoalIDParticipantsAcceptPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsGoalIDParticipantsAcceptPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsGoalIDParticipantsGet invokes GET /goals/{goal_id}/participants operation.
+//
+// 参加者ごとの投稿数（貢献数）を含みます。.
+//
+// GET /goals/{goal_id}/participants
+func (c *Client) GoalsGoalIDParticipantsGet(ctx context.Context, params GoalsGoalIDParticipantsGetParams) (GoalsGoalIDParticipantsGetRes, error) {
+	res, err := c.sendGoalsGoalIDParticipantsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGoalsGoalIDParticipantsGet(ctx context.Context, params GoalsGoalIDParticipantsGetParams) (res GoalsGoalIDParticipantsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/goals/{goal_id}/participants"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDParticipantsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/goals/"
+	{
+		// Encode "goal_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "goal_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/participants"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsGoalIDParticipantsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsGoalIDParticipantsPost invokes POST /goals/{goal_id}/participants operation.
+//
+// 目標のオーナーのみ実行できます。招待されたユーザーが承認すると参加者になります。.
+//
+// POST /goals/{goal_id}/participants
+func (c *Client) GoalsGoalIDParticipantsPost(ctx context.Context, request *GoalsGoalIDParticipantsPostReq, params GoalsGoalIDParticipantsPostParams) (GoalsGoalIDParticipantsPostRes, error) {
+	res, err := c.sendGoalsGoalIDParticipantsPost(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendGoalsGoalIDParticipantsPost(ctx context.Context, request *GoalsGoalIDParticipantsPostReq, params GoalsGoalIDParticipantsPostParams) (res GoalsGoalIDParticipantsPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/goals/{goal_id}/participants"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDParticipantsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/goals/"
+	{
+		// Encode "goal_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "goal_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/participants"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGoalsGoalIDParticipantsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GoalsGoalIDParticipantsPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsGoalIDParticipantsPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsGoalIDParticipantsUserIDDelete invokes DELETE /goals/{goal_id}/participants/{user_id} operation.
+//
+// オーナーは他の参加者を削除できます。参加者本人は招待の辞退や目標からの脱退ができます。オーナーは脱退できません。.
+//
+// DELETE /goals/{goal_id}/participants/{user_id}
+func (c *Client) GoalsGoalIDParticipantsUserIDDelete(ctx context.Context, params GoalsGoalIDParticipantsUserIDDeleteParams) (GoalsGoalIDParticipantsUserIDDeleteRes, error) {
+	res, err := c.sendGoalsGoalIDParticipantsUserIDDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGoalsGoalIDParticipantsUserIDDelete(ctx context.Context, params GoalsGoalIDParticipantsUserIDDeleteParams) (res GoalsGoalIDParticipantsUserIDDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/goals/{goal_id}/participants/{user_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDParticipantsUserIDDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/goals/"
+	{
+		// Encode "goal_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "goal_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/participants/"
+	{
+		// Encode "user_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "user_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.UserID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GoalsGoalIDParticipantsUserIDDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsGoalIDParticipantsUserIDDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsGoalIDPostsGet invokes GET /goals/{goal_id}/posts operation.
+//
+// 目標の全参加者の投稿を新しい順で返します。閲覧者とブロック関係にあるユーザーの投稿は含めません。.
+//
+// GET /goals/{goal_id}/posts
+func (c *Client) GoalsGoalIDPostsGet(ctx context.Context, params GoalsGoalIDPostsGetParams) (GoalsGoalIDPostsGetRes, error) {
+	res, err := c.sendGoalsGoalIDPostsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGoalsGoalIDPostsGet(ctx context.Context, params GoalsGoalIDPostsGetParams) (res GoalsGoalIDPostsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/goals/{goal_id}/posts"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDPostsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/goals/"
+	{
+		// Encode "goal_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "goal_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/posts"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GoalsGoalIDPostsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{},
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsGoalIDPostsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsGoalIDPut invokes PUT /goals/{goal_id} operation.
+//
+// 目標更新.
+//
+// PUT /goals/{goal_id}
+func (c *Client) GoalsGoalIDPut(ctx context.Context, request *GoalRequest, params GoalsGoalIDPutParams) (GoalsGoalIDPutRes, error) {
+	res, err := c.sendGoalsGoalIDPut(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendGoalsGoalIDPut(ctx context.Context, request *GoalRequest, params GoalsGoalIDPutParams) (res GoalsGoalIDPutRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/goals/{goal_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDPutOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/goals/"
+	{
+		// Encode "goal_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "goal_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGoalsGoalIDPutRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GoalsGoalIDPutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsGoalIDPutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsInvitationsGet invokes GET /goals/invitations operation.
+//
+// 自分宛ての目標への招待一覧取得.
+//
+// GET /goals/invitations
+func (c *Client) GoalsInvitationsGet(ctx context.Context) (GoalsInvitationsGetRes, error) {
+	res, err := c.sendGoalsInvitationsGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendGoalsInvitationsGet(ctx context.Context) (res GoalsInvitationsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/goals/invitations"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsInvitationsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/goals/invitations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GoalsInvitationsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsInvitationsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsOrderPut invokes PUT /goals/order operation.
+//
+// 自分が参加している目標のIDをすべて、表示したい順に指定します。並び順は一括で反映され、参加者ごとに保存されるため他の参加者の並び順は変わりません。.
+//
+// PUT /goals/order
+func (c *Client) GoalsOrderPut(ctx context.Context, request *GoalOrderRequest) (GoalsOrderPutRes, error) {
+	res, err := c.sendGoalsOrderPut(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGoalsOrderPut(ctx context.Context, request *GoalOrderRequest) (res GoalsOrderPutRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/goals/order"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsOrderPutOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/goals/order"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGoalsOrderPutRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GoalsOrderPutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsOrderPutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GoalsPost invokes POST /goals operation.
+//
+// 新規目標作成.
+//
+// POST /goals
+func (c *Client) GoalsPost(ctx context.Context, request *GoalRequest) (GoalsPostRes, error) {
+	res, err := c.sendGoalsPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGoalsPost(ctx context.Context, request *GoalRequest) (res GoalsPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/goals"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GoalsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/goals"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGoalsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, GoalsPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGoalsPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// HashtagsTagPostsGet invokes GET /hashtags/{tag}/posts operation.
+//
+// 投稿は新しい順で返されます。タグは"#"の有無や全角・半角、英字の大文字・小文字を区別しません。.
+//
+// GET /hashtags/{tag}/posts
+func (c *Client) HashtagsTagPostsGet(ctx context.Context, params HashtagsTagPostsGetParams) (HashtagsTagPostsGetRes, error) {
+	res, err := c.sendHashtagsTagPostsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendHashtagsTagPostsGet(ctx context.Context, params HashtagsTagPostsGetParams) (res HashtagsTagPostsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/hashtags/{tag}/posts"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, HashtagsTagPostsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/hashtags/"
+	{
+		// Encode "tag" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "tag",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Tag))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/posts"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, HashtagsTagPostsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{},
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeHashtagsTagPostsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// HashtagsTrendingGet invokes GET /hashtags/trending operation.
+//
+// 直近の集計期間内に付けられた投稿の多い順にハッシュタグを返します。.
+//
+// GET /hashtags/trending
+func (c *Client) HashtagsTrendingGet(ctx context.Context, params HashtagsTrendingGetParams) (HashtagsTrendingGetRes, error) {
+	res, err := c.sendHashtagsTrendingGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendHashtagsTrendingGet(ctx context.Context, params HashtagsTrendingGetParams) (res HashtagsTrendingGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/hashtags/trending"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, HashtagsTrendingGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/hashtags/trending"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "hours" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "hours",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Hours.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeHashtagsTrendingGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ImagesImageIDGet invokes GET /images/{image_id} operation.
+//
+// 投稿に添付された画像は、その投稿を閲覧できる場合のみ取得できます。
+// 投稿に紐付いていない画像は、アップロードしたユーザーと、その画像を含む編集履歴を閲覧できるユーザーのみ取得できます。
+// 閲覧できない画像は存在しない場合と同じく404を返します。.
+//
+// GET /images/{image_id}
+func (c *Client) ImagesImageIDGet(ctx context.Context, params ImagesImageIDGetParams) (ImagesImageIDGetRes, error) {
+	res, err := c.sendImagesImageIDGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendImagesImageIDGet(ctx context.Context, params ImagesImageIDGetParams) (res ImagesImageIDGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/images/{image_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ImagesImageIDGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/images/"
+	{
+		// Encode "image_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "image_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ImageID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeImagesImageIDGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ImagesPost invokes POST /images operation.
+//
+// 画像をアップロードしてIDを発行します。このIDを使用して投稿と紐付けます。紐付けられた投稿が削除されると、画像も削除されます。.
+//
+// POST /images
+func (c *Client) ImagesPost(ctx context.Context, request OptImagesPostReq) (ImagesPostRes, error) {
+	res, err := c.sendImagesPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendImagesPost(ctx context.Context, request OptImagesPostReq) (res ImagesPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/images"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ImagesPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/images"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeImagesPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, ImagesPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeImagesPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NotificationsGet invokes GET /notifications operation.
+//
+// 通知を最後にまとめた日時の新しい順に返します。
+// 未読の間は同じ種類・同じ投稿への通知（フォローの場合は全てのフォロー）を1件にまとめ、actor_countに操作をしたユーザーの人数を返します。
+// まとめた通知は新しい操作があると先頭に移動するため、ページの間で重複・欠落することがあります。.
+//
+// GET /notifications
+func (c *Client) NotificationsGet(ctx context.Context, params NotificationsGetParams) (NotificationsGetRes, error) {
+	res, err := c.sendNotificationsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendNotificationsGet(ctx context.Context, params NotificationsGetParams) (res NotificationsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/notifications"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NotificationsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/notifications"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "unread" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "unread",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Unread.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, NotificationsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeNotificationsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NotificationsPreferencesGet invokes GET /notifications/preferences operation.
+//
+// 通知の設定取得.
+//
+// GET /notifications/preferences
+func (c *Client) NotificationsPreferencesGet(ctx context.Context) (NotificationsPreferencesGetRes, error) {
+	res, err := c.sendNotificationsPreferencesGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendNotificationsPreferencesGet(ctx context.Context) (res NotificationsPreferencesGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/notifications/preferences"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NotificationsPreferencesGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/notifications/preferences"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, NotificationsPreferencesGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeNotificationsPreferencesGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NotificationsPreferencesPut invokes PUT /notifications/preferences operation.
+//
+// Falseにした種類の通知は、以降は通知一覧に追加されません。既にある通知はそのまま残ります。.
+//
+// PUT /notifications/preferences
+func (c *Client) NotificationsPreferencesPut(ctx context.Context, request *NotificationPreferences) (NotificationsPreferencesPutRes, error) {
+	res, err := c.sendNotificationsPreferencesPut(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendNotificationsPreferencesPut(ctx context.Context, request *NotificationPreferences) (res NotificationsPreferencesPutRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/notifications/preferences"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NotificationsPreferencesPutOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/notifications/preferences"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeNotificationsPreferencesPutRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, NotificationsPreferencesPutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeNotificationsPreferencesPutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NotificationsReadPost invokes POST /notifications/read operation.
+//
+// Idsを省略した場合は全ての未読の通知を既読にします。自分以外の通知や既読の通知のIDは無視します。.
+//
+// POST /notifications/read
+func (c *Client) NotificationsReadPost(ctx context.Context, request OptNotificationReadRequest) (NotificationsReadPostRes, error) {
+	res, err := c.sendNotificationsReadPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendNotificationsReadPost(ctx context.Context, request OptNotificationReadRequest) (res NotificationsReadPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/notifications/read"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NotificationsReadPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/notifications/read"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeNotificationsReadPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, NotificationsReadPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeNotificationsReadPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// NotificationsUnreadCountGet invokes GET /notifications/unread-count operation.
+//
+// まとめた通知は1件として数えます。.
+//
+// GET /notifications/unread-count
+func (c *Client) NotificationsUnreadCountGet(ctx context.Context) (NotificationsUnreadCountGetRes, error) {
+	res, err := c.sendNotificationsUnreadCountGet(ctx)
+	return res, err
+}
+
+func (c *Client) sendNotificationsUnreadCountGet(ctx context.Context) (res NotificationsUnreadCountGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/notifications/unread-count"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, NotificationsUnreadCountGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/notifications/unread-count"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, NotificationsUnreadCountGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeNotificationsUnreadCountGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostsGet invokes GET /posts operation.
+//
+// 自分の投稿を新しい順に返します。下書き・予約投稿を含みます。.
+//
+// GET /posts
+func (c *Client) PostsGet(ctx context.Context, params PostsGetParams) (PostsGetRes, error) {
+	res, err := c.sendPostsGet(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPostsGet(ctx context.Context, params PostsGetParams) (res PostsGetRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/posts"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsGetOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/posts"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "goal_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "goal_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.GoalID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PostsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePostsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostsPost invokes POST /posts operation.
+//
+// 本文は迷惑行為フィルタで判定されます。拒否された場合は400を返します。
+// 確認のために保留になった場合は作成者本人以外には非表示の状態で作成され（moderation_notice付き）、
+// モデレーションキュー（source=filterの報告）に追加されます。.
+//
+// POST /posts
+func (c *Client) PostsPost(ctx context.Context, request *PostRequest) (PostsPostRes, error) {
+	res, err := c.sendPostsPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPostsPost(ctx context.Context, request *PostRequest) (res PostsPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/posts"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/posts"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PostsPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePostsPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostsPostIDBookmarkDelete invokes DELETE /posts/{post_id}/bookmark operation.
+//
+// ブックマーク解除.
+//
+// DELETE /posts/{post_id}/bookmark
+func (c *Client) PostsPostIDBookmarkDelete(ctx context.Context, params PostsPostIDBookmarkDeleteParams) (PostsPostIDBookmarkDeleteRes, error) {
+	res, err := c.sendPostsPostIDBookmarkDelete(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPostsPostIDBookmarkDelete(ctx context.Context, params PostsPostIDBookmarkDeleteParams) (res PostsPostIDBookmarkDeleteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/posts/{post_id}/bookmark"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDBookmarkDeleteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/posts/"
+	{
+		// Encode "post_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "post_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PostID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/bookmark"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PostsPostIDBookmarkDeleteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePostsPostIDBookmarkDeleteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostsPostIDBookmarkPost invokes POST /posts/{post_id}/bookmark operation.
+//
+// 既にブックマークしている場合は保存先のコレクションを変更します。collection_idを省略すると未分類として保存します。.
+//
+// POST /posts/{post_id}/bookmark
+func (c *Client) PostsPostIDBookmarkPost(ctx context.Context, request OptBookmarkRequest, params PostsPostIDBookmarkPostParams) (PostsPostIDBookmarkPostRes, error) {
+	res, err := c.sendPostsPostIDBookmarkPost(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostsPostIDBookmarkPost(ctx context.Context, request OptBookmarkRequest, params PostsPostIDBookmarkPostParams) (res PostsPostIDBookmarkPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/posts/{post_id}/bookmark"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDBookmarkPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/posts/"
+	{
+		// Encode "post_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "post_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PostID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/bookmark"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostsPostIDBookmarkPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PostsPostIDBookmarkPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
 		return res, errors.Wrap(err, "do request")
 	}
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGenresGetResponse(resp)
+	result, err := decodePostsPostIDBookmarkPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1043,20 +7242,22 @@ func (c *Client) sendGenresGet(ctx context.Context) (res []Genre, err error) {
 	return result, nil
 }
 
-// GoalsGet invokes GET /goals operation.
+// PostsPostIDCommentsGet invokes GET /posts/{post_id}/comments operation.
 //
-// 現在のユーザーの目標一覧取得.
+// コメントを古い順に返します。parent_idを指定するとそのコメントへの返信を、省略すると投稿への直接のコメントを返します。
+// 続きを取得するには、前回の最後のコメントのIDをafterに指定します。
+// ブロック関係にあるユーザーのコメントは含まれません。削除済みのコメントは返信が残っている場合のみ、本文を空にして返します。.
 //
-// GET /goals
-func (c *Client) GoalsGet(ctx context.Context, params GoalsGetParams) (GoalsGetRes, error) {
-	res, err := c.sendGoalsGet(ctx, params)
+// GET /posts/{post_id}/comments
+func (c *Client) PostsPostIDCommentsGet(ctx context.Context, params PostsPostIDCommentsGetParams) (PostsPostIDCommentsGetRes, error) {
+	res, err := c.sendPostsPostIDCommentsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGoalsGet(ctx context.Context, params GoalsGetParams) (res GoalsGetRes, err error) {
+func (c *Client) sendPostsPostIDCommentsGet(ctx context.Context, params PostsPostIDCommentsGetParams) (res PostsPostIDCommentsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/goals"),
+		semconv.URLTemplateKey.String("/posts/{post_id}/comments"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1072,7 +7273,7 @@ func (c *Client) sendGoalsGet(ctx context.Context, params GoalsGetParams) (res G
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDCommentsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1089,23 +7290,76 @@ func (c *Client) sendGoalsGet(ctx context.Context, params GoalsGetParams) (res G
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/goals"
+	var pathParts [3]string
+	pathParts[0] = "/posts/"
+	{
+		// Encode "post_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "post_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PostID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/comments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "page" parameter.
+		// Encode "parent_id" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page",
+			Name:    "parent_id",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Page.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
+			if val, ok := params.ParentID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "after" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.After.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -1142,7 +7396,7 @@ func (c *Client) sendGoalsGet(ctx context.Context, params GoalsGetParams) (res G
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GoalsGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PostsPostIDCommentsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1155,6 +7409,7 @@ func (c *Client) sendGoalsGet(ctx context.Context, params GoalsGetParams) (res G
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
+				{},
 				{0b00000001},
 			} {
 				for i, mask := range requirement {
@@ -1178,7 +7433,7 @@ func (c *Client) sendGoalsGet(ctx context.Context, params GoalsGetParams) (res G
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGoalsGetResponse(resp)
+	result, err := decodePostsPostIDCommentsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1186,20 +7441,148 @@ func (c *Client) sendGoalsGet(ctx context.Context, params GoalsGetParams) (res G
 	return result, nil
 }
 
-// GoalsGoalIDDelete invokes DELETE /goals/{goal_id} operation.
+// PostsPostIDCommentsPost invokes POST /posts/{post_id}/comments operation.
 //
-// 目標削除.
+// Parent_idを指定すると、そのコメントへの返信になります。投稿者またはコメント先のユーザーとブロック関係にある場合はコメントできません。
+// 本文は投稿と同じく迷惑行為フィルタで判定され、保留になったコメントはモデレーションキューに追加されます。.
 //
-// DELETE /goals/{goal_id}
-func (c *Client) GoalsGoalIDDelete(ctx context.Context, params GoalsGoalIDDeleteParams) (GoalsGoalIDDeleteRes, error) {
-	res, err := c.sendGoalsGoalIDDelete(ctx, params)
+// POST /posts/{post_id}/comments
+func (c *Client) PostsPostIDCommentsPost(ctx context.Context, request *CommentRequest, params PostsPostIDCommentsPostParams) (PostsPostIDCommentsPostRes, error) {
+	res, err := c.sendPostsPostIDCommentsPost(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPostsPostIDCommentsPost(ctx context.Context, request *CommentRequest, params PostsPostIDCommentsPostParams) (res PostsPostIDCommentsPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/posts/{post_id}/comments"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDCommentsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/posts/"
+	{
+		// Encode "post_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "post_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PostID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/comments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePostsPostIDCommentsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PostsPostIDCommentsPostOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePostsPostIDCommentsPostResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PostsPostIDDelete invokes DELETE /posts/{post_id} operation.
+//
+// 投稿を削除します。紐づいている画像も同時に削除されます。.
+//
+// DELETE /posts/{post_id}
+func (c *Client) PostsPostIDDelete(ctx context.Context, params PostsPostIDDeleteParams) (PostsPostIDDeleteRes, error) {
+	res, err := c.sendPostsPostIDDelete(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGoalsGoalIDDelete(ctx context.Context, params GoalsGoalIDDeleteParams) (res GoalsGoalIDDeleteRes, err error) {
+func (c *Client) sendPostsPostIDDelete(ctx context.Context, params PostsPostIDDeleteParams) (res PostsPostIDDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/goals/{goal_id}"),
+		semconv.URLTemplateKey.String("/posts/{post_id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1215,7 +7598,7 @@ func (c *Client) sendGoalsGoalIDDelete(ctx context.Context, params GoalsGoalIDDe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1233,16 +7616,16 @@ func (c *Client) sendGoalsGoalIDDelete(ctx context.Context, params GoalsGoalIDDe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/goals/"
+	pathParts[0] = "/posts/"
 	{
-		// Encode "goal_id" parameter.
+		// Encode "post_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "goal_id",
+			Param:   "post_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+			return e.EncodeValue(conv.UUIDToString(params.PostID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1265,7 +7648,7 @@ func (c *Client) sendGoalsGoalIDDelete(ctx context.Context, params GoalsGoalIDDe
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GoalsGoalIDDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PostsPostIDDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1301,7 +7684,7 @@ func (c *Client) sendGoalsGoalIDDelete(ctx context.Context, params GoalsGoalIDDe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGoalsGoalIDDeleteResponse(resp)
+	result, err := decodePostsPostIDDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1309,20 +7692,20 @@ func (c *Client) sendGoalsGoalIDDelete(ctx context.Context, params GoalsGoalIDDe
 	return result, nil
 }
 
-// GoalsGoalIDGet invokes GET /goals/{goal_id} operation.
+// PostsPostIDGet invokes GET /posts/{post_id} operation.
 //
-// 目標詳細取得.
+// 投稿詳細取得.
 //
-// GET /goals/{goal_id}
-func (c *Client) GoalsGoalIDGet(ctx context.Context, params GoalsGoalIDGetParams) (GoalsGoalIDGetRes, error) {
-	res, err := c.sendGoalsGoalIDGet(ctx, params)
+// GET /posts/{post_id}
+func (c *Client) PostsPostIDGet(ctx context.Context, params PostsPostIDGetParams) (PostsPostIDGetRes, error) {
+	res, err := c.sendPostsPostIDGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGoalsGoalIDGet(ctx context.Context, params GoalsGoalIDGetParams) (res GoalsGoalIDGetRes, err error) {
+func (c *Client) sendPostsPostIDGet(ctx context.Context, params PostsPostIDGetParams) (res PostsPostIDGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/goals/{goal_id}"),
+		semconv.URLTemplateKey.String("/posts/{post_id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1338,7 +7721,7 @@ func (c *Client) sendGoalsGoalIDGet(ctx context.Context, params GoalsGoalIDGetPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1356,16 +7739,16 @@ func (c *Client) sendGoalsGoalIDGet(ctx context.Context, params GoalsGoalIDGetPa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/goals/"
+	pathParts[0] = "/posts/"
 	{
-		// Encode "goal_id" parameter.
+		// Encode "post_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "goal_id",
+			Param:   "post_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+			return e.EncodeValue(conv.UUIDToString(params.PostID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1383,6 +7766,40 @@ func (c *Client) sendGoalsGoalIDGet(ctx context.Context, params GoalsGoalIDGetPa
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PostsPostIDGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{},
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -1391,7 +7808,7 @@ func (c *Client) sendGoalsGoalIDGet(ctx context.Context, params GoalsGoalIDGetPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGoalsGoalIDGetResponse(resp)
+	result, err := decodePostsPostIDGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1399,20 +7816,20 @@ func (c *Client) sendGoalsGoalIDGet(ctx context.Context, params GoalsGoalIDGetPa
 	return result, nil
 }
 
-// GoalsGoalIDPut invokes PUT /goals/{goal_id} operation.
+// PostsPostIDPut invokes PUT /posts/{post_id} operation.
 //
-// 目標更新.
+// 投稿更新.
 //
-// PUT /goals/{goal_id}
-func (c *Client) GoalsGoalIDPut(ctx context.Context, request *GoalRequest, params GoalsGoalIDPutParams) (GoalsGoalIDPutRes, error) {
-	res, err := c.sendGoalsGoalIDPut(ctx, request, params)
+// PUT /posts/{post_id}
+func (c *Client) PostsPostIDPut(ctx context.Context, request *PostRequest, params PostsPostIDPutParams) (PostsPostIDPutRes, error) {
+	res, err := c.sendPostsPostIDPut(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGoalsGoalIDPut(ctx context.Context, request *GoalRequest, params GoalsGoalIDPutParams) (res GoalsGoalIDPutRes, err error) {
+func (c *Client) sendPostsPostIDPut(ctx context.Context, request *PostRequest, params PostsPostIDPutParams) (res PostsPostIDPutRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/goals/{goal_id}"),
+		semconv.URLTemplateKey.String("/posts/{post_id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1428,7 +7845,7 @@ func (c *Client) sendGoalsGoalIDPut(ctx context.Context, request *GoalRequest, p
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GoalsGoalIDPutOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDPutOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1446,16 +7863,16 @@ func (c *Client) sendGoalsGoalIDPut(ctx context.Context, request *GoalRequest, p
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/goals/"
+	pathParts[0] = "/posts/"
 	{
-		// Encode "goal_id" parameter.
+		// Encode "post_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "goal_id",
+			Param:   "post_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.GoalID))
+			return e.EncodeValue(conv.UUIDToString(params.PostID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1472,7 +7889,7 @@ func (c *Client) sendGoalsGoalIDPut(ctx context.Context, request *GoalRequest, p
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGoalsGoalIDPutRequest(request, r); err != nil {
+	if err := encodePostsPostIDPutRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1481,7 +7898,7 @@ func (c *Client) sendGoalsGoalIDPut(ctx context.Context, request *GoalRequest, p
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GoalsGoalIDPutOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PostsPostIDPutOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1517,7 +7934,7 @@ func (c *Client) sendGoalsGoalIDPut(ctx context.Context, request *GoalRequest, p
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGoalsGoalIDPutResponse(resp)
+	result, err := decodePostsPostIDPutResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1525,20 +7942,20 @@ func (c *Client) sendGoalsGoalIDPut(ctx context.Context, request *GoalRequest, p
 	return result, nil
 }
 
-// GoalsPost invokes POST /goals operation.
+// PostsPostIDReactionsDelete invokes DELETE /posts/{post_id}/reactions operation.
 //
-// 新規目標作成.
+// 認証済みの現在のユーザー自身のリアクションを指定した投稿から削除します。`user_id` パラメータは不要で、認証されたユーザーのリアクションのみが削除されます。`kind`を省略すると、すべての種類のリアクションを削除します。.
 //
-// POST /goals
-func (c *Client) GoalsPost(ctx context.Context, request *GoalRequest) (GoalsPostRes, error) {
-	res, err := c.sendGoalsPost(ctx, request)
+// DELETE /posts/{post_id}/reactions
+func (c *Client) PostsPostIDReactionsDelete(ctx context.Context, params PostsPostIDReactionsDeleteParams) (PostsPostIDReactionsDeleteRes, error) {
+	res, err := c.sendPostsPostIDReactionsDelete(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGoalsPost(ctx context.Context, request *GoalRequest) (res GoalsPostRes, err error) {
+func (c *Client) sendPostsPostIDReactionsDelete(ctx context.Context, params PostsPostIDReactionsDeleteParams) (res PostsPostIDReactionsDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/goals"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/posts/{post_id}/reactions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1554,7 +7971,7 @@ func (c *Client) sendGoalsPost(ctx context.Context, request *GoalRequest) (res G
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GoalsPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDReactionsDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1571,25 +7988,62 @@ func (c *Client) sendGoalsPost(ctx context.Context, request *GoalRequest) (res G
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/goals"
+	var pathParts [3]string
+	pathParts[0] = "/posts/"
+	{
+		// Encode "post_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "post_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PostID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/reactions"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "kind" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Kind.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGoalsPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, GoalsPostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PostsPostIDReactionsDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1625,7 +8079,7 @@ func (c *Client) sendGoalsPost(ctx context.Context, request *GoalRequest) (res G
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGoalsPostResponse(resp)
+	result, err := decodePostsPostIDReactionsDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1633,20 +8087,20 @@ func (c *Client) sendGoalsPost(ctx context.Context, request *GoalRequest) (res G
 	return result, nil
 }
 
-// ImagesImageIDGet invokes GET /images/{image_id} operation.
+// PostsPostIDReactionsGet invokes GET /posts/{post_id}/reactions operation.
 //
-// 画像取得.
+// リアクションを新しい順に返します。閲覧者とブロック関係にあるユーザーのリアクションは含まれません。.
 //
-// GET /images/{image_id}
-func (c *Client) ImagesImageIDGet(ctx context.Context, params ImagesImageIDGetParams) (ImagesImageIDGetRes, error) {
-	res, err := c.sendImagesImageIDGet(ctx, params)
+// GET /posts/{post_id}/reactions
+func (c *Client) PostsPostIDReactionsGet(ctx context.Context, params PostsPostIDReactionsGetParams) (PostsPostIDReactionsGetRes, error) {
+	res, err := c.sendPostsPostIDReactionsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendImagesImageIDGet(ctx context.Context, params ImagesImageIDGetParams) (res ImagesImageIDGetRes, err error) {
+func (c *Client) sendPostsPostIDReactionsGet(ctx context.Context, params PostsPostIDReactionsGetParams) (res PostsPostIDReactionsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/images/{image_id}"),
+		semconv.URLTemplateKey.String("/posts/{post_id}/reactions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1662,7 +8116,7 @@ func (c *Client) sendImagesImageIDGet(ctx context.Context, params ImagesImageIDG
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ImagesImageIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDReactionsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1679,17 +8133,17 @@ func (c *Client) sendImagesImageIDGet(ctx context.Context, params ImagesImageIDG
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/images/"
+	var pathParts [3]string
+	pathParts[0] = "/posts/"
 	{
-		// Encode "image_id" parameter.
+		// Encode "post_id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "image_id",
+			Param:   "post_id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.ImageID))
+			return e.EncodeValue(conv.UUIDToString(params.PostID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1699,95 +8153,76 @@ func (c *Client) sendImagesImageIDGet(ctx context.Context, params ImagesImageIDG
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/reactions"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
-	stage = "SendRequest"
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
-		return res, errors.Wrap(err, "do request")
-	}
-	defer resp.Body.Close()
-
-	stage = "DecodeResponse"
-	result, err := decodeImagesImageIDGetResponse(resp)
-	if err != nil {
-		return res, errors.Wrap(err, "decode response")
-	}
-
-	return result, nil
-}
-
-// ImagesPost invokes POST /images operation.
-//
-// 画像をアップロードしてIDを発行します。このIDを使用して投稿と紐付けます。紐付けられた投稿が削除されると、画像も削除されます。.
-//
-// POST /images
-func (c *Client) ImagesPost(ctx context.Context, request OptImagesPostReq) (ImagesPostRes, error) {
-	res, err := c.sendImagesPost(ctx, request)
-	return res, err
-}
-
-func (c *Client) sendImagesPost(ctx context.Context, request OptImagesPostReq) (res ImagesPostRes, err error) {
-	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/images"),
-	}
-	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
-
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		elapsedDuration := time.Since(startTime)
-		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
-	}()
-
-	// Increment request counter.
-	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "kind" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ImagesPostOperation,
-		trace.WithAttributes(otelAttrs...),
-		clientSpanKind,
-	)
-	// Track stage for error reporting.
-	var stage string
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, stage)
-			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Kind.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		span.End()
-	}()
 
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/images"
-	uri.AddPathParts(u, pathParts[:]...)
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeImagesPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, ImagesPostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PostsPostIDReactionsGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1800,6 +8235,7 @@ func (c *Client) sendImagesPost(ctx context.Context, request OptImagesPostReq) (
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
+				{},
 				{0b00000001},
 			} {
 				for i, mask := range requirement {
@@ -1823,7 +8259,7 @@ func (c *Client) sendImagesPost(ctx context.Context, request OptImagesPostReq) (
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeImagesPostResponse(resp)
+	result, err := decodePostsPostIDReactionsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1831,20 +8267,20 @@ func (c *Client) sendImagesPost(ctx context.Context, request OptImagesPostReq) (
 	return result, nil
 }
 
-// PostsGet invokes GET /posts operation.
+// PostsPostIDReactionsPost invokes POST /posts/{post_id}/reactions operation.
 //
-// 現在のユーザーの投稿一覧取得.
+// 投稿に指定した種類のリアクションを追加します。1ユーザーにつき種類ごとに1回までリアクションできます。種類を省略した場合は最初の種類になります。.
 //
-// GET /posts
-func (c *Client) PostsGet(ctx context.Context, params PostsGetParams) (PostsGetRes, error) {
-	res, err := c.sendPostsGet(ctx, params)
+// POST /posts/{post_id}/reactions
+func (c *Client) PostsPostIDReactionsPost(ctx context.Context, request OptReactionRequest, params PostsPostIDReactionsPostParams) (PostsPostIDReactionsPostRes, error) {
+	res, err := c.sendPostsPostIDReactionsPost(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPostsGet(ctx context.Context, params PostsGetParams) (res PostsGetRes, err error) {
+func (c *Client) sendPostsPostIDReactionsPost(ctx context.Context, request OptReactionRequest, params PostsPostIDReactionsPostParams) (res PostsPostIDReactionsPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/posts"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/posts/{post_id}/reactions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1860,7 +8296,7 @@ func (c *Client) sendPostsGet(ctx context.Context, params PostsGetParams) (res P
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PostsGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDReactionsPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1877,77 +8313,44 @@ func (c *Client) sendPostsGet(ctx context.Context, params PostsGetParams) (res P
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/posts"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "goal_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "goal_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.GoalID.Get(); ok {
-				return e.EncodeValue(conv.UUIDToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "page" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Page.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [3]string
+	pathParts[0] = "/posts/"
 	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "post_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "post_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PostID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/reactions"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePostsPostIDReactionsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PostsGetOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PostsPostIDReactionsPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1983,7 +8386,7 @@ func (c *Client) sendPostsGet(ctx context.Context, params PostsGetParams) (res P
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePostsGetResponse(resp)
+	result, err := decodePostsPostIDReactionsPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1991,20 +8394,20 @@ func (c *Client) sendPostsGet(ctx context.Context, params PostsGetParams) (res P
 	return result, nil
 }
 
-// PostsPost invokes POST /posts operation.
+// PostsPostIDRepostDelete invokes DELETE /posts/{post_id}/repost operation.
 //
-// 進捗投稿作成.
+// リポストを取り消し.
 //
-// POST /posts
-func (c *Client) PostsPost(ctx context.Context, request *PostRequest) (PostsPostRes, error) {
-	res, err := c.sendPostsPost(ctx, request)
+// DELETE /posts/{post_id}/repost
+func (c *Client) PostsPostIDRepostDelete(ctx context.Context, params PostsPostIDRepostDeleteParams) (PostsPostIDRepostDeleteRes, error) {
+	res, err := c.sendPostsPostIDRepostDelete(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostsPost(ctx context.Context, request *PostRequest) (res PostsPostRes, err error) {
+func (c *Client) sendPostsPostIDRepostDelete(ctx context.Context, params PostsPostIDRepostDeleteParams) (res PostsPostIDRepostDeleteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/posts"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/posts/{post_id}/repost"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2020,7 +8423,7 @@ func (c *Client) sendPostsPost(ctx context.Context, request *PostRequest) (res P
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDRepostDeleteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2037,25 +8440,41 @@ func (c *Client) sendPostsPost(ctx context.Context, request *PostRequest) (res P
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/posts"
+	var pathParts [3]string
+	pathParts[0] = "/posts/"
+	{
+		// Encode "post_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "post_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.PostID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/repost"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostsPostRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PostsPostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PostsPostIDRepostDeleteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2091,7 +8510,7 @@ func (c *Client) sendPostsPost(ctx context.Context, request *PostRequest) (res P
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePostsPostResponse(resp)
+	result, err := decodePostsPostIDRepostDeleteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2099,20 +8518,21 @@ func (c *Client) sendPostsPost(ctx context.Context, request *PostRequest) (res P
 	return result, nil
 }
 
-// PostsPostIDDelete invokes DELETE /posts/{post_id} operation.
+// PostsPostIDRepostPost invokes POST /posts/{post_id}/repost operation.
 //
-// 投稿を削除します。紐づいている画像も同時に削除されます。.
+// 投稿をコメントなしで自分のフォロワーのタイムラインに共有します。コメントを付けて共有する場合は、quote_post_idを指定して投稿を作成します。
+// 閲覧できない投稿や公開前の投稿はリポストできません。既にリポストしている場合は何もしません。.
 //
-// DELETE /posts/{post_id}
-func (c *Client) PostsPostIDDelete(ctx context.Context, params PostsPostIDDeleteParams) (PostsPostIDDeleteRes, error) {
-	res, err := c.sendPostsPostIDDelete(ctx, params)
+// POST /posts/{post_id}/repost
+func (c *Client) PostsPostIDRepostPost(ctx context.Context, params PostsPostIDRepostPostParams) (PostsPostIDRepostPostRes, error) {
+	res, err := c.sendPostsPostIDRepostPost(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostsPostIDDelete(ctx context.Context, params PostsPostIDDeleteParams) (res PostsPostIDDeleteRes, err error) {
+func (c *Client) sendPostsPostIDRepostPost(ctx context.Context, params PostsPostIDRepostPostParams) (res PostsPostIDRepostPostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/posts/{post_id}"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/posts/{post_id}/repost"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2128,7 +8548,7 @@ func (c *Client) sendPostsPostIDDelete(ctx context.Context, params PostsPostIDDe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDRepostPostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2145,7 +8565,7 @@ func (c *Client) sendPostsPostIDDelete(ctx context.Context, params PostsPostIDDe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [3]string
 	pathParts[0] = "/posts/"
 	{
 		// Encode "post_id" parameter.
@@ -2165,10 +8585,11 @@ func (c *Client) sendPostsPostIDDelete(ctx context.Context, params PostsPostIDDe
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/repost"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2178,7 +8599,7 @@ func (c *Client) sendPostsPostIDDelete(ctx context.Context, params PostsPostIDDe
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PostsPostIDDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PostsPostIDRepostPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2214,7 +8635,7 @@ func (c *Client) sendPostsPostIDDelete(ctx context.Context, params PostsPostIDDe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePostsPostIDDeleteResponse(resp)
+	result, err := decodePostsPostIDRepostPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2222,20 +8643,20 @@ func (c *Client) sendPostsPostIDDelete(ctx context.Context, params PostsPostIDDe
 	return result, nil
 }
 
-// PostsPostIDGet invokes GET /posts/{post_id} operation.
+// PostsPostIDRevisionsGet invokes GET /posts/{post_id}/revisions operation.
 //
-// 投稿詳細取得.
+// 編集前の版を新しい順に返します。現在の内容は含みません。投稿者本人のほか、サーバー設定で公開されている場合は誰でも閲覧できます。.
 //
-// GET /posts/{post_id}
-func (c *Client) PostsPostIDGet(ctx context.Context, params PostsPostIDGetParams) (PostsPostIDGetRes, error) {
-	res, err := c.sendPostsPostIDGet(ctx, params)
+// GET /posts/{post_id}/revisions
+func (c *Client) PostsPostIDRevisionsGet(ctx context.Context, params PostsPostIDRevisionsGetParams) (PostsPostIDRevisionsGetRes, error) {
+	res, err := c.sendPostsPostIDRevisionsGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostsPostIDGet(ctx context.Context, params PostsPostIDGetParams) (res PostsPostIDGetRes, err error) {
+func (c *Client) sendPostsPostIDRevisionsGet(ctx context.Context, params PostsPostIDRevisionsGetParams) (res PostsPostIDRevisionsGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/posts/{post_id}"),
+		semconv.URLTemplateKey.String("/posts/{post_id}/revisions"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2251,7 +8672,7 @@ func (c *Client) sendPostsPostIDGet(ctx context.Context, params PostsPostIDGetPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDRevisionsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2268,7 +8689,7 @@ func (c *Client) sendPostsPostIDGet(ctx context.Context, params PostsPostIDGetPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [3]string
 	pathParts[0] = "/posts/"
 	{
 		// Encode "post_id" parameter.
@@ -2286,14 +8707,49 @@ func (c *Client) sendPostsPostIDGet(ctx context.Context, params PostsPostIDGetPa
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[1] = encoded
-	}
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/revisions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, PostsPostIDRevisionsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{},
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
 	}
 
 	stage = "SendRequest"
@@ -2304,7 +8760,7 @@ func (c *Client) sendPostsPostIDGet(ctx context.Context, params PostsPostIDGetPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePostsPostIDGetResponse(resp)
+	result, err := decodePostsPostIDRevisionsGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2312,20 +8768,20 @@ func (c *Client) sendPostsPostIDGet(ctx context.Context, params PostsPostIDGetPa
 	return result, nil
 }
 
-// PostsPostIDPut invokes PUT /posts/{post_id} operation.
+// PostsPostIDRevisionsRevisionRestorePost invokes POST /posts/{post_id}/revisions/{revision}/restore operation.
 //
-// 投稿更新.
+// 指定した版の本文・進捗量・画像で投稿を更新します。復元前の内容は新しい版として履歴に残ります。投稿者本人のみ実行できます。.
 //
-// PUT /posts/{post_id}
-func (c *Client) PostsPostIDPut(ctx context.Context, request *PostRequest, params PostsPostIDPutParams) (PostsPostIDPutRes, error) {
-	res, err := c.sendPostsPostIDPut(ctx, request, params)
+// POST /posts/{post_id}/revisions/{revision}/restore
+func (c *Client) PostsPostIDRevisionsRevisionRestorePost(ctx context.Context, params PostsPostIDRevisionsRevisionRestorePostParams) (PostsPostIDRevisionsRevisionRestorePostRes, error) {
+	res, err := c.sendPostsPostIDRevisionsRevisionRestorePost(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostsPostIDPut(ctx context.Context, request *PostRequest, params PostsPostIDPutParams) (res PostsPostIDPutRes, err error) {
+func (c *Client) sendPostsPostIDRevisionsRevisionRestorePost(ctx context.Context, params PostsPostIDRevisionsRevisionRestorePostParams) (res PostsPostIDRevisionsRevisionRestorePostRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.URLTemplateKey.String("/posts/{post_id}"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/posts/{post_id}/revisions/{revision}/restore"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2341,7 +8797,7 @@ func (c *Client) sendPostsPostIDPut(ctx context.Context, request *PostRequest, p
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDPutOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDRevisionsRevisionRestorePostOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2358,7 +8814,7 @@ func (c *Client) sendPostsPostIDPut(ctx context.Context, request *PostRequest, p
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
+	var pathParts [5]string
 	pathParts[0] = "/posts/"
 	{
 		// Encode "post_id" parameter.
@@ -2378,23 +8834,40 @@ func (c *Client) sendPostsPostIDPut(ctx context.Context, request *PostRequest, p
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/revisions/"
+	{
+		// Encode "revision" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "revision",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.Revision))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/restore"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePostsPostIDPutRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PostsPostIDPutOperation, r); {
+			switch err := c.securityBearerAuth(ctx, PostsPostIDRevisionsRevisionRestorePostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2430,7 +8903,7 @@ func (c *Client) sendPostsPostIDPut(ctx context.Context, request *PostRequest, p
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePostsPostIDPutResponse(resp)
+	result, err := decodePostsPostIDRevisionsRevisionRestorePostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2438,20 +8911,20 @@ func (c *Client) sendPostsPostIDPut(ctx context.Context, request *PostRequest, p
 	return result, nil
 }
 
-// PostsPostIDReactionsDelete invokes DELETE /posts/{post_id}/reactions operation.
+// ReactionsKindsGet invokes GET /reactions/kinds operation.
 //
-// 認証済みの現在のユーザー自身のリアクションを指定した投稿から削除します。`user_id` パラメータは不要で、認証されたユーザーのリアクションのみが削除されます。.
+// サーバー設定で有効なリアクションの種類を表示順で返します。.
 //
-// DELETE /posts/{post_id}/reactions
-func (c *Client) PostsPostIDReactionsDelete(ctx context.Context, params PostsPostIDReactionsDeleteParams) (PostsPostIDReactionsDeleteRes, error) {
-	res, err := c.sendPostsPostIDReactionsDelete(ctx, params)
+// GET /reactions/kinds
+func (c *Client) ReactionsKindsGet(ctx context.Context) ([]ReactionKind, error) {
+	res, err := c.sendReactionsKindsGet(ctx)
 	return res, err
 }
 
-func (c *Client) sendPostsPostIDReactionsDelete(ctx context.Context, params PostsPostIDReactionsDeleteParams) (res PostsPostIDReactionsDeleteRes, err error) {
+func (c *Client) sendReactionsKindsGet(ctx context.Context) (res []ReactionKind, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/posts/{post_id}/reactions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/reactions/kinds"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2467,7 +8940,7 @@ func (c *Client) sendPostsPostIDReactionsDelete(ctx context.Context, params Post
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDReactionsDeleteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ReactionsKindsGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2484,41 +8957,98 @@ func (c *Client) sendPostsPostIDReactionsDelete(ctx context.Context, params Post
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/posts/"
-	{
-		// Encode "post_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "post_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.PostID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
+	var pathParts [1]string
+	pathParts[0] = "/reactions/kinds"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeReactionsKindsGetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ReportsPost invokes POST /reports operation.
+//
+// 不適切な投稿・コメント・プロフィールを報告します。報告はモデレーターの対応待ちの一覧に追加されます。
+// 閲覧できない対象や自分自身の投稿・コメント・プロフィールは報告できません。同じ対象は1回だけ報告できます。.
+//
+// POST /reports
+func (c *Client) ReportsPost(ctx context.Context, request *ReportRequest) (ReportsPostRes, error) {
+	res, err := c.sendReportsPost(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendReportsPost(ctx context.Context, request *ReportRequest) (res ReportsPostRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/reports"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ReportsPostOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
 		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/reactions"
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/reports"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeReportsPostRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PostsPostIDReactionsDeleteOperation, r); {
+			switch err := c.securityBearerAuth(ctx, ReportsPostOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2554,7 +9084,7 @@ func (c *Client) sendPostsPostIDReactionsDelete(ctx context.Context, params Post
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePostsPostIDReactionsDeleteResponse(resp)
+	result, err := decodeReportsPostResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2562,20 +9092,23 @@ func (c *Client) sendPostsPostIDReactionsDelete(ctx context.Context, params Post
 	return result, nil
 }
 
-// PostsPostIDReactionsGet invokes GET /posts/{post_id}/reactions operation.
+// SearchGet invokes GET /search operation.
 //
-// 投稿にリアクションしたユーザーの一覧取得.
+// 投稿の本文または目標のタイトルを検索し、作成日時の新しい順に返します。続きを取得するには、前回の最後の結果のIDをafterに指定します。
+// 検索語は空白で区切ったすべての語を含むものが一致します。漢字・かなは2文字ずつの組で、英数字は単語の前方一致で検索します。
+// 全角・半角、英字の大文字・小文字は区別しません。
+// 投稿は公開済みのものと自分の下書き・予約投稿が対象です。ブロック関係にあるユーザーの投稿・目標は含まれません。.
 //
-// GET /posts/{post_id}/reactions
-func (c *Client) PostsPostIDReactionsGet(ctx context.Context, params PostsPostIDReactionsGetParams) (PostsPostIDReactionsGetRes, error) {
-	res, err := c.sendPostsPostIDReactionsGet(ctx, params)
+// GET /search
+func (c *Client) SearchGet(ctx context.Context, params SearchGetParams) (SearchGetRes, error) {
+	res, err := c.sendSearchGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostsPostIDReactionsGet(ctx context.Context, params PostsPostIDReactionsGetParams) (res PostsPostIDReactionsGetRes, err error) {
+func (c *Client) sendSearchGet(ctx context.Context, params SearchGetParams) (res SearchGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/posts/{post_id}/reactions"),
+		semconv.URLTemplateKey.String("/search"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2591,7 +9124,7 @@ func (c *Client) sendPostsPostIDReactionsGet(ctx context.Context, params PostsPo
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDReactionsGetOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2603,33 +9136,168 @@ func (c *Client) sendPostsPostIDReactionsGet(ctx context.Context, params PostsPo
 			span.SetStatus(codes.Error, stage)
 			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		span.End()
-	}()
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/search"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "q" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Q))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "type" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Type.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "user_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "user_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.UserID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "goal_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "goal_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.GoalID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "from" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.From.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "to" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.To.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "after" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.After.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/posts/"
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
-		// Encode "post_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "post_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.PostID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	pathParts[2] = "/reactions"
-	uri.AddPathParts(u, pathParts[:]...)
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -2637,6 +9305,40 @@ func (c *Client) sendPostsPostIDReactionsGet(ctx context.Context, params PostsPo
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, SearchGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{},
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -2645,7 +9347,7 @@ func (c *Client) sendPostsPostIDReactionsGet(ctx context.Context, params PostsPo
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePostsPostIDReactionsGetResponse(resp)
+	result, err := decodeSearchGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2653,20 +9355,22 @@ func (c *Client) sendPostsPostIDReactionsGet(ctx context.Context, params PostsPo
 	return result, nil
 }
 
-// PostsPostIDReactionsPost invokes POST /posts/{post_id}/reactions operation.
+// TimelineDiscoverGet invokes GET /timeline/discover operation.
 //
-// 投稿にリアクション（いいね）を追加します。リアクションしたユーザーを記録するために認証が必要です。.
+// フォローしていないユーザーの最近の公開済みの投稿から、興味のあるジャンルが共通のユーザーの投稿、フォローしているユーザーがフォローしているユーザーの投稿、最近投稿の多い目標への投稿を集め、おすすめ順に返します。
+// おすすめ順は新しさ、1時間あたりのリアクション数、共通のジャンルの割合、おすすめの理由から求めた点数の高い順で、1ページに同じユーザーの投稿は一定件数までしか含めません。
+// 次のページはLinkヘッダーのcursorで取得します。cursorは最初のページを取得した時点の並び順を引き継ぎます。.
 //
-// POST /posts/{post_id}/reactions
-func (c *Client) PostsPostIDReactionsPost(ctx context.Context, params PostsPostIDReactionsPostParams) (PostsPostIDReactionsPostRes, error) {
-	res, err := c.sendPostsPostIDReactionsPost(ctx, params)
+// GET /timeline/discover
+func (c *Client) TimelineDiscoverGet(ctx context.Context, params TimelineDiscoverGetParams) (TimelineDiscoverGetRes, error) {
+	res, err := c.sendTimelineDiscoverGet(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPostsPostIDReactionsPost(ctx context.Context, params PostsPostIDReactionsPostParams) (res PostsPostIDReactionsPostRes, err error) {
+func (c *Client) sendTimelineDiscoverGet(ctx context.Context, params TimelineDiscoverGetParams) (res TimelineDiscoverGetRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/posts/{post_id}/reactions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/timeline/discover"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2682,7 +9386,7 @@ func (c *Client) sendPostsPostIDReactionsPost(ctx context.Context, params PostsP
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PostsPostIDReactionsPostOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, TimelineDiscoverGetOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2699,31 +9403,50 @@ func (c *Client) sendPostsPostIDReactionsPost(ctx context.Context, params PostsP
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/posts/"
+	var pathParts [1]string
+	pathParts[0] = "/timeline/discover"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "post_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "post_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.PostID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	pathParts[2] = "/reactions"
-	uri.AddPathParts(u, pathParts[:]...)
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -2733,7 +9456,7 @@ func (c *Client) sendPostsPostIDReactionsPost(ctx context.Context, params PostsP
 		var satisfied bitset
 		{
 			stage = "Security:BearerAuth"
-			switch err := c.securityBearerAuth(ctx, PostsPostIDReactionsPostOperation, r); {
+			switch err := c.securityBearerAuth(ctx, TimelineDiscoverGetOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2769,7 +9492,7 @@ func (c *Client) sendPostsPostIDReactionsPost(ctx context.Context, params PostsP
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePostsPostIDReactionsPostResponse(resp)
+	result, err := decodeTimelineDiscoverGetResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2779,7 +9502,9 @@ func (c *Client) sendPostsPostIDReactionsPost(ctx context.Context, params PostsP
 
 // TimelineGet invokes GET /timeline operation.
 //
-// 投稿は新しい順（降順、最新が最初）で返されます。.
+// 自分とフォローしているユーザーの投稿と、それらのユーザーのリポストを新しい順（降順、最新が最初）で返します。
+// 同じ投稿は最も新しい投稿・リポストの位置に1回だけ含まれ、リポストによって含まれた場合はreposted_byとreposted_atが設定されます。
+// タイムラインは投稿・リポスト・フォローの時点で非同期に書き込まれるため、反映まで少し遅れることがあります。.
 //
 // GET /timeline
 func (c *Client) TimelineGet(ctx context.Context, params TimelineGetParams) (TimelineGetRes, error) {
@@ -2863,6 +9588,23 @@ func (c *Client) sendTimelineGet(ctx context.Context, params TimelineGetParams)
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
 		// Encode "limit" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -3137,7 +9879,8 @@ func (c *Client) sendUsersUserIDDelete(ctx context.Context, params UsersUserIDDe
 
 // UsersUserIDFriendsGet invokes GET /users/{user_id}/friends operation.
 //
-// ユーザーのフレンド（フォロー）一覧取得.
+// ユーザーがフォローしているユーザーのIDを、ユーザーの登録日時の新しい順に返します。
+// 閲覧者とブロック関係にあるユーザーは含まれず、閲覧者とブロック関係にあるユーザーの一覧は404になります。.
 //
 // GET /users/{user_id}/friends
 func (c *Client) UsersUserIDFriendsGet(ctx context.Context, params UsersUserIDFriendsGetParams) (UsersUserIDFriendsGetRes, error) {
@@ -3204,6 +9947,44 @@ func (c *Client) sendUsersUserIDFriendsGet(ctx context.Context, params UsersUser
 	pathParts[2] = "/friends"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
@@ -3453,6 +10234,23 @@ func (c *Client) sendUsersUserIDGoalsGet(ctx context.Context, params UsersUserID
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
 		// Encode "page" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -3470,6 +10268,23 @@ func (c *Client) sendUsersUserIDGoalsGet(ctx context.Context, params UsersUserID
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
 		// Encode "limit" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -3958,6 +10773,23 @@ func (c *Client) sendUsersUserIDPostsGet(ctx context.Context, params UsersUserID
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
 		// Encode "limit" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -3983,6 +10815,40 @@ func (c *Client) sendUsersUserIDPostsGet(ctx context.Context, params UsersUserID
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BearerAuth"
+			switch err := c.securityBearerAuth(ctx, UsersUserIDPostsGetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BearerAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{},
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -0,0 +1,11 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// setDefaults set default value of fields.
+func (s *SearchResult) setDefaults() {
+	{
+		val := SearchType("posts")
+		s.Type = val
+	}
+}
@@ -1,6 +1,38 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AdminModerationActionsGetRes interface {
+	adminModerationActionsGetRes()
+}
+
+type AdminModerationActionsPostRes interface {
+	adminModerationActionsPostRes()
+}
+
+type AdminReportsGetRes interface {
+	adminReportsGetRes()
+}
+
+type AdminReportsReportIDClaimPostRes interface {
+	adminReportsReportIDClaimPostRes()
+}
+
+type AdminReportsReportIDResolvePostRes interface {
+	adminReportsReportIDResolvePostRes()
+}
+
+type AdminTemplatesPostRes interface {
+	adminTemplatesPostRes()
+}
+
+type AdminTemplatesTemplateIDDeleteRes interface {
+	adminTemplatesTemplateIDDeleteRes()
+}
+
+type AdminTemplatesTemplateIDPutRes interface {
+	adminTemplatesTemplateIDPutRes()
+}
+
 type AuthCallbackGetRes interface {
 	authCallbackGetRes()
 }
@@ -9,6 +41,46 @@ type AuthMeGetRes interface {
 	authMeGetRes()
 }
 
+type BlocksGetRes interface {
+	blocksGetRes()
+}
+
+type BlocksPostRes interface {
+	blocksPostRes()
+}
+
+type BlocksUserIDDeleteRes interface {
+	blocksUserIDDeleteRes()
+}
+
+type BookmarksCollectionsCollectionIDDeleteRes interface {
+	bookmarksCollectionsCollectionIDDeleteRes()
+}
+
+type BookmarksCollectionsCollectionIDPutRes interface {
+	bookmarksCollectionsCollectionIDPutRes()
+}
+
+type BookmarksCollectionsGetRes interface {
+	bookmarksCollectionsGetRes()
+}
+
+type BookmarksCollectionsPostRes interface {
+	bookmarksCollectionsPostRes()
+}
+
+type BookmarksGetRes interface {
+	bookmarksGetRes()
+}
+
+type CommentsCommentIDDeleteRes interface {
+	commentsCommentIDDeleteRes()
+}
+
+type CommentsCommentIDPutRes interface {
+	commentsCommentIDPutRes()
+}
+
 type FriendsGetRes interface {
 	friendsGetRes()
 }
@@ -21,10 +93,22 @@ type FriendsUserIDDeleteRes interface {
 	friendsUserIDDeleteRes()
 }
 
+type GenresGenreIDTemplatesGetRes interface {
+	genresGenreIDTemplatesGetRes()
+}
+
+type GoalsFromTemplateTemplateIDPostRes interface {
+	goalsFromTemplateTemplateIDPostRes()
+}
+
 type GoalsGetRes interface {
 	goalsGetRes()
 }
 
+type GoalsGoalIDAnalyticsGetRes interface {
+	goalsGoalIDAnalyticsGetRes()
+}
+
 type GoalsGoalIDDeleteRes interface {
 	goalsGoalIDDeleteRes()
 }
@@ -33,14 +117,50 @@ type GoalsGoalIDGetRes interface {
 	goalsGoalIDGetRes()
 }
 
+type GoalsGoalIDParticipantsAcceptPostRes interface {
+	goalsGoalIDParticipantsAcceptPostRes()
+}
+
+type GoalsGoalIDParticipantsGetRes interface {
+	goalsGoalIDParticipantsGetRes()
+}
+
+type GoalsGoalIDParticipantsPostRes interface {
+	goalsGoalIDParticipantsPostRes()
+}
+
+type GoalsGoalIDParticipantsUserIDDeleteRes interface {
+	goalsGoalIDParticipantsUserIDDeleteRes()
+}
+
+type GoalsGoalIDPostsGetRes interface {
+	goalsGoalIDPostsGetRes()
+}
+
 type GoalsGoalIDPutRes interface {
 	goalsGoalIDPutRes()
 }
 
+type GoalsInvitationsGetRes interface {
+	goalsInvitationsGetRes()
+}
+
+type GoalsOrderPutRes interface {
+	goalsOrderPutRes()
+}
+
 type GoalsPostRes interface {
 	goalsPostRes()
 }
 
+type HashtagsTagPostsGetRes interface {
+	hashtagsTagPostsGetRes()
+}
+
+type HashtagsTrendingGetRes interface {
+	hashtagsTrendingGetRes()
+}
+
 type ImagesImageIDGetRes interface {
 	imagesImageIDGetRes()
 }
@@ -49,10 +169,46 @@ type ImagesPostRes interface {
 	imagesPostRes()
 }
 
+type NotificationsGetRes interface {
+	notificationsGetRes()
+}
+
+type NotificationsPreferencesGetRes interface {
+	notificationsPreferencesGetRes()
+}
+
+type NotificationsPreferencesPutRes interface {
+	notificationsPreferencesPutRes()
+}
+
+type NotificationsReadPostRes interface {
+	notificationsReadPostRes()
+}
+
+type NotificationsUnreadCountGetRes interface {
+	notificationsUnreadCountGetRes()
+}
+
 type PostsGetRes interface {
 	postsGetRes()
 }
 
+type PostsPostIDBookmarkDeleteRes interface {
+	postsPostIDBookmarkDeleteRes()
+}
+
+type PostsPostIDBookmarkPostRes interface {
+	postsPostIDBookmarkPostRes()
+}
+
+type PostsPostIDCommentsGetRes interface {
+	postsPostIDCommentsGetRes()
+}
+
+type PostsPostIDCommentsPostRes interface {
+	postsPostIDCommentsPostRes()
+}
+
 type PostsPostIDDeleteRes interface {
 	postsPostIDDeleteRes()
 }
@@ -77,10 +233,38 @@ type PostsPostIDReactionsPostRes interface {
 	postsPostIDReactionsPostRes()
 }
 
+type PostsPostIDRepostDeleteRes interface {
+	postsPostIDRepostDeleteRes()
+}
+
+type PostsPostIDRepostPostRes interface {
+	postsPostIDRepostPostRes()
+}
+
+type PostsPostIDRevisionsGetRes interface {
+	postsPostIDRevisionsGetRes()
+}
+
+type PostsPostIDRevisionsRevisionRestorePostRes interface {
+	postsPostIDRevisionsRevisionRestorePostRes()
+}
+
 type PostsPostRes interface {
 	postsPostRes()
 }
 
+type ReportsPostRes interface {
+	reportsPostRes()
+}
+
+type SearchGetRes interface {
+	searchGetRes()
+}
+
+type TimelineDiscoverGetRes interface {
+	timelineDiscoverGetRes()
+}
+
 type TimelineGetRes interface {
 	timelineGetRes()
 }
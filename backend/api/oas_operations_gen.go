@@ -6,38 +6,85 @@ package api
 type OperationName = string
 
 const (
-	AuthCallbackGetOperation            OperationName = "AuthCallbackGet"
-	AuthLoginGetOperation               OperationName = "AuthLoginGet"
-	AuthLogoutPostOperation             OperationName = "AuthLogoutPost"
-	AuthMeGetOperation                  OperationName = "AuthMeGet"
-	FriendsGetOperation                 OperationName = "FriendsGet"
-	FriendsPostOperation                OperationName = "FriendsPost"
-	FriendsUserIDDeleteOperation        OperationName = "FriendsUserIDDelete"
-	GenresGetOperation                  OperationName = "GenresGet"
-	GoalsGetOperation                   OperationName = "GoalsGet"
-	GoalsGoalIDDeleteOperation          OperationName = "GoalsGoalIDDelete"
-	GoalsGoalIDGetOperation             OperationName = "GoalsGoalIDGet"
-	GoalsGoalIDPutOperation             OperationName = "GoalsGoalIDPut"
-	GoalsPostOperation                  OperationName = "GoalsPost"
-	ImagesImageIDGetOperation           OperationName = "ImagesImageIDGet"
-	ImagesPostOperation                 OperationName = "ImagesPost"
-	PostsGetOperation                   OperationName = "PostsGet"
-	PostsPostOperation                  OperationName = "PostsPost"
-	PostsPostIDDeleteOperation          OperationName = "PostsPostIDDelete"
-	PostsPostIDGetOperation             OperationName = "PostsPostIDGet"
-	PostsPostIDPutOperation             OperationName = "PostsPostIDPut"
-	PostsPostIDReactionsDeleteOperation OperationName = "PostsPostIDReactionsDelete"
-	PostsPostIDReactionsGetOperation    OperationName = "PostsPostIDReactionsGet"
-	PostsPostIDReactionsPostOperation   OperationName = "PostsPostIDReactionsPost"
-	TimelineGetOperation                OperationName = "TimelineGet"
-	UsersPostOperation                  OperationName = "UsersPost"
-	UsersUserIDDeleteOperation          OperationName = "UsersUserIDDelete"
-	UsersUserIDFriendsGetOperation      OperationName = "UsersUserIDFriendsGet"
-	UsersUserIDGetOperation             OperationName = "UsersUserIDGet"
-	UsersUserIDGoalsGetOperation        OperationName = "UsersUserIDGoalsGet"
-	UsersUserIDIconDeleteOperation      OperationName = "UsersUserIDIconDelete"
-	UsersUserIDIconGetOperation         OperationName = "UsersUserIDIconGet"
-	UsersUserIDIconPostOperation        OperationName = "UsersUserIDIconPost"
-	UsersUserIDPostsGetOperation        OperationName = "UsersUserIDPostsGet"
-	UsersUserIDPutOperation             OperationName = "UsersUserIDPut"
+	AdminModerationActionsGetOperation               OperationName = "AdminModerationActionsGet"
+	AdminModerationActionsPostOperation              OperationName = "AdminModerationActionsPost"
+	AdminReportsGetOperation                         OperationName = "AdminReportsGet"
+	AdminReportsReportIDClaimPostOperation           OperationName = "AdminReportsReportIDClaimPost"
+	AdminReportsReportIDResolvePostOperation         OperationName = "AdminReportsReportIDResolvePost"
+	AdminTemplatesPostOperation                      OperationName = "AdminTemplatesPost"
+	AdminTemplatesTemplateIDDeleteOperation          OperationName = "AdminTemplatesTemplateIDDelete"
+	AdminTemplatesTemplateIDPutOperation             OperationName = "AdminTemplatesTemplateIDPut"
+	AuthCallbackGetOperation                         OperationName = "AuthCallbackGet"
+	AuthLoginGetOperation                            OperationName = "AuthLoginGet"
+	AuthLogoutPostOperation                          OperationName = "AuthLogoutPost"
+	AuthMeGetOperation                               OperationName = "AuthMeGet"
+	BlocksGetOperation                               OperationName = "BlocksGet"
+	BlocksPostOperation                              OperationName = "BlocksPost"
+	BlocksUserIDDeleteOperation                      OperationName = "BlocksUserIDDelete"
+	BookmarksCollectionsCollectionIDDeleteOperation  OperationName = "BookmarksCollectionsCollectionIDDelete"
+	BookmarksCollectionsCollectionIDPutOperation     OperationName = "BookmarksCollectionsCollectionIDPut"
+	BookmarksCollectionsGetOperation                 OperationName = "BookmarksCollectionsGet"
+	BookmarksCollectionsPostOperation                OperationName = "BookmarksCollectionsPost"
+	BookmarksGetOperation                            OperationName = "BookmarksGet"
+	CommentsCommentIDDeleteOperation                 OperationName = "CommentsCommentIDDelete"
+	CommentsCommentIDPutOperation                    OperationName = "CommentsCommentIDPut"
+	FriendsGetOperation                              OperationName = "FriendsGet"
+	FriendsPostOperation                             OperationName = "FriendsPost"
+	FriendsUserIDDeleteOperation                     OperationName = "FriendsUserIDDelete"
+	GenresGenreIDTemplatesGetOperation               OperationName = "GenresGenreIDTemplatesGet"
+	GenresGetOperation                               OperationName = "GenresGet"
+	GoalsFromTemplateTemplateIDPostOperation         OperationName = "GoalsFromTemplateTemplateIDPost"
+	GoalsGetOperation                                OperationName = "GoalsGet"
+	GoalsGoalIDAnalyticsGetOperation                 OperationName = "GoalsGoalIDAnalyticsGet"
+	GoalsGoalIDDeleteOperation                       OperationName = "GoalsGoalIDDelete"
+	GoalsGoalIDGetOperation                          OperationName = "GoalsGoalIDGet"
+	GoalsGoalIDParticipantsAcceptPostOperation       OperationName = "GoalsGoalIDParticipantsAcceptPost"
+	GoalsGoalIDParticipantsGetOperation              OperationName = "GoalsGoalIDParticipantsGet"
+	GoalsGoalIDParticipantsPostOperation             OperationName = "GoalsGoalIDParticipantsPost"
+	GoalsGoalIDParticipantsUserIDDeleteOperation     OperationName = "GoalsGoalIDParticipantsUserIDDelete"
+	GoalsGoalIDPostsGetOperation                     OperationName = "GoalsGoalIDPostsGet"
+	GoalsGoalIDPutOperation                          OperationName = "GoalsGoalIDPut"
+	GoalsInvitationsGetOperation                     OperationName = "GoalsInvitationsGet"
+	GoalsOrderPutOperation                           OperationName = "GoalsOrderPut"
+	GoalsPostOperation                               OperationName = "GoalsPost"
+	HashtagsTagPostsGetOperation                     OperationName = "HashtagsTagPostsGet"
+	HashtagsTrendingGetOperation                     OperationName = "HashtagsTrendingGet"
+	ImagesImageIDGetOperation                        OperationName = "ImagesImageIDGet"
+	ImagesPostOperation                              OperationName = "ImagesPost"
+	NotificationsGetOperation                        OperationName = "NotificationsGet"
+	NotificationsPreferencesGetOperation             OperationName = "NotificationsPreferencesGet"
+	NotificationsPreferencesPutOperation             OperationName = "NotificationsPreferencesPut"
+	NotificationsReadPostOperation                   OperationName = "NotificationsReadPost"
+	NotificationsUnreadCountGetOperation             OperationName = "NotificationsUnreadCountGet"
+	PostsGetOperation                                OperationName = "PostsGet"
+	PostsPostOperation                               OperationName = "PostsPost"
+	PostsPostIDBookmarkDeleteOperation               OperationName = "PostsPostIDBookmarkDelete"
+	PostsPostIDBookmarkPostOperation                 OperationName = "PostsPostIDBookmarkPost"
+	PostsPostIDCommentsGetOperation                  OperationName = "PostsPostIDCommentsGet"
+	PostsPostIDCommentsPostOperation                 OperationName = "PostsPostIDCommentsPost"
+	PostsPostIDDeleteOperation                       OperationName = "PostsPostIDDelete"
+	PostsPostIDGetOperation                          OperationName = "PostsPostIDGet"
+	PostsPostIDPutOperation                          OperationName = "PostsPostIDPut"
+	PostsPostIDReactionsDeleteOperation              OperationName = "PostsPostIDReactionsDelete"
+	PostsPostIDReactionsGetOperation                 OperationName = "PostsPostIDReactionsGet"
+	PostsPostIDReactionsPostOperation                OperationName = "PostsPostIDReactionsPost"
+	PostsPostIDRepostDeleteOperation                 OperationName = "PostsPostIDRepostDelete"
+	PostsPostIDRepostPostOperation                   OperationName = "PostsPostIDRepostPost"
+	PostsPostIDRevisionsGetOperation                 OperationName = "PostsPostIDRevisionsGet"
+	PostsPostIDRevisionsRevisionRestorePostOperation OperationName = "PostsPostIDRevisionsRevisionRestorePost"
+	ReactionsKindsGetOperation                       OperationName = "ReactionsKindsGet"
+	ReportsPostOperation                             OperationName = "ReportsPost"
+	SearchGetOperation                               OperationName = "SearchGet"
+	TimelineDiscoverGetOperation                     OperationName = "TimelineDiscoverGet"
+	TimelineGetOperation                             OperationName = "TimelineGet"
+	UsersPostOperation                               OperationName = "UsersPost"
+	UsersUserIDDeleteOperation                       OperationName = "UsersUserIDDelete"
+	UsersUserIDFriendsGetOperation                   OperationName = "UsersUserIDFriendsGet"
+	UsersUserIDGetOperation                          OperationName = "UsersUserIDGet"
+	UsersUserIDGoalsGetOperation                     OperationName = "UsersUserIDGoalsGet"
+	UsersUserIDIconDeleteOperation                   OperationName = "UsersUserIDIconDelete"
+	UsersUserIDIconGetOperation                      OperationName = "UsersUserIDIconGet"
+	UsersUserIDIconPostOperation                     OperationName = "UsersUserIDIconPost"
+	UsersUserIDPostsGetOperation                     OperationName = "UsersUserIDPostsGet"
+	UsersUserIDPutOperation                          OperationName = "UsersUserIDPut"
 )
@@ -5,6 +5,7 @@ package api
 import (
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
@@ -15,237 +16,293 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
-// AuthCallbackGetParams is parameters of GET /auth/callback operation.
-type AuthCallbackGetParams struct {
-	// OIDCプロバイダーからの認可コード.
-	Code string
-	// CSRF防止のための状態パラメータ.
-	State string
+// AdminModerationActionsGetParams is parameters of GET /admin/moderation/actions operation.
+type AdminModerationActionsGetParams struct {
+	// 対象の種類で絞り込みます.
+	TargetType OptModerationTargetType `json:",omitempty,omitzero"`
+	// 対象のIDで絞り込みます.
+	TargetID OptUUID `json:",omitempty,omitzero"`
+	// 操作したモデレーターで絞り込みます.
+	ModeratorID OptUUID `json:",omitempty,omitzero"`
+	// このIDの操作より後（古い）の操作を取得します（非推奨。cursorを使用してください）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	After OptUUID `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackAuthCallbackGetParams(packed middleware.Parameters) (params AuthCallbackGetParams) {
+func unpackAdminModerationActionsGetParams(packed middleware.Parameters) (params AdminModerationActionsGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "code",
+			Name: "target_type",
 			In:   "query",
 		}
-		params.Code = packed[key].(string)
+		if v, ok := packed[key]; ok {
+			params.TargetType = v.(OptModerationTargetType)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "state",
+			Name: "target_id",
 			In:   "query",
 		}
-		params.State = packed[key].(string)
+		if v, ok := packed[key]; ok {
+			params.TargetID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "moderator_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ModeratorID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "after",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.After = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
 	}
 	return params
 }
 
-func decodeAuthCallbackGetParams(args [0]string, argsEscaped bool, r *http.Request) (params AuthCallbackGetParams, _ error) {
+func decodeAdminModerationActionsGetParams(args [0]string, argsEscaped bool, r *http.Request) (params AdminModerationActionsGetParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: code.
+	// Decode query: target_type.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "code",
+			Name:    "target_type",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotTargetTypeVal ModerationTargetType
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTargetTypeVal = ModerationTargetType(c)
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Code = c
+				params.TargetType.SetTo(paramsDotTargetTypeVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
+			if err := func() error {
+				if value, ok := params.TargetType.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "code",
+			Name: "target_type",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: state.
+	// Decode query: target_id.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "state",
+			Name:    "target_id",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotTargetIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTargetIDVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.State = c
+				params.TargetID.SetTo(paramsDotTargetIDVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "state",
+			Name: "target_id",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// FriendsUserIDDeleteParams is parameters of DELETE /friends/{user_id} operation.
-type FriendsUserIDDeleteParams struct {
-	UserID uuid.UUID
-}
-
-func unpackFriendsUserIDDeleteParams(packed middleware.Parameters) (params FriendsUserIDDeleteParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "user_id",
-			In:   "path",
-		}
-		params.UserID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeFriendsUserIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params FriendsUserIDDeleteParams, _ error) {
-	// Decode path: user_id.
+	// Decode query: moderator_id.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "moderator_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "user_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotModeratorIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotModeratorIDVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UserID = c
+				params.ModeratorID.SetTo(paramsDotModeratorIDVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "user_id",
-			In:   "path",
+			Name: "moderator_id",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: after.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// GoalsGetParams is parameters of GET /goals operation.
-type GoalsGetParams struct {
-	Page  OptInt `json:",omitempty,omitzero"`
-	Limit OptInt `json:",omitempty,omitzero"`
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAfterVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackGoalsGetParams(packed middleware.Parameters) (params GoalsGetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "page",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Page = v.(OptInt)
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAfterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.After.SetTo(paramsDotAfterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "after",
 			In:   "query",
+			Err:  err,
 		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	return params
-}
-
-func decodeGoalsGetParams(args [0]string, argsEscaped bool, r *http.Request) (params GoalsGetParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: page.
-	{
-		val := int(1)
-		params.Page.SetTo(val)
 	}
-	// Decode query: page.
+	// Decode query: cursor.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
+			Name:    "cursor",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageVal int
+				var paramsDotCursorVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPageVal = c
+					paramsDotCursorVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Page.SetTo(paramsDotPageVal)
+				params.Cursor.SetTo(paramsDotCursorVal)
 				return nil
 			}); err != nil {
 				return err
@@ -254,7 +311,7 @@ func decodeGoalsGetParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
+			Name: "cursor",
 			In:   "query",
 			Err:  err,
 		}
@@ -296,6 +353,31 @@ func decodeGoalsGetParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
@@ -308,24 +390,5171 @@ func decodeGoalsGetParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// GoalsGoalIDDeleteParams is parameters of DELETE /goals/{goal_id} operation.
-type GoalsGoalIDDeleteParams struct {
-	GoalID uuid.UUID
+// AdminReportsGetParams is parameters of GET /admin/reports operation.
+type AdminReportsGetParams struct {
+	// 報告の経路で絞り込みます.
+	Source OptReportSource `json:",omitempty,omitzero"`
+	// 対応状況で絞り込みます.
+	Status OptReportStatus `json:",omitempty,omitzero"`
+	// 対象の種類で絞り込みます.
+	TargetType OptReportTargetType `json:",omitempty,omitzero"`
+	// このIDの報告より後（新しい）の報告を取得します（非推奨。cursorを使用してください）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	After OptUUID `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGoalsGoalIDDeleteParams(packed middleware.Parameters) (params GoalsGoalIDDeleteParams) {
+func unpackAdminReportsGetParams(packed middleware.Parameters) (params AdminReportsGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "goal_id",
-			In:   "path",
+			Name: "source",
+			In:   "query",
 		}
-		params.GoalID = packed[key].(uuid.UUID)
+		if v, ok := packed[key]; ok {
+			params.Source = v.(OptReportSource)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptReportStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "target_type",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.TargetType = v.(OptReportTargetType)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "after",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.After = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeAdminReportsGetParams(args [0]string, argsEscaped bool, r *http.Request) (params AdminReportsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: source.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "source",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSourceVal ReportSource
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSourceVal = ReportSource(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Source.SetTo(paramsDotSourceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Source.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "source",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal ReportStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = ReportStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: target_type.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "target_type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTargetTypeVal ReportTargetType
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTargetTypeVal = ReportTargetType(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TargetType.SetTo(paramsDotTargetTypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.TargetType.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "target_type",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: after.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAfterVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAfterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.After.SetTo(paramsDotAfterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "after",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AdminReportsReportIDClaimPostParams is parameters of POST /admin/reports/{report_id}/claim operation.
+type AdminReportsReportIDClaimPostParams struct {
+	ReportID uuid.UUID
+}
+
+func unpackAdminReportsReportIDClaimPostParams(packed middleware.Parameters) (params AdminReportsReportIDClaimPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "report_id",
+			In:   "path",
+		}
+		params.ReportID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeAdminReportsReportIDClaimPostParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminReportsReportIDClaimPostParams, _ error) {
+	// Decode path: report_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "report_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ReportID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "report_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AdminReportsReportIDResolvePostParams is parameters of POST /admin/reports/{report_id}/resolve operation.
+type AdminReportsReportIDResolvePostParams struct {
+	ReportID uuid.UUID
+}
+
+func unpackAdminReportsReportIDResolvePostParams(packed middleware.Parameters) (params AdminReportsReportIDResolvePostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "report_id",
+			In:   "path",
+		}
+		params.ReportID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeAdminReportsReportIDResolvePostParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminReportsReportIDResolvePostParams, _ error) {
+	// Decode path: report_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "report_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ReportID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "report_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AdminTemplatesTemplateIDDeleteParams is parameters of DELETE /admin/templates/{template_id} operation.
+type AdminTemplatesTemplateIDDeleteParams struct {
+	TemplateID uuid.UUID
+}
+
+func unpackAdminTemplatesTemplateIDDeleteParams(packed middleware.Parameters) (params AdminTemplatesTemplateIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "template_id",
+			In:   "path",
+		}
+		params.TemplateID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeAdminTemplatesTemplateIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminTemplatesTemplateIDDeleteParams, _ error) {
+	// Decode path: template_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "template_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.TemplateID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "template_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AdminTemplatesTemplateIDPutParams is parameters of PUT /admin/templates/{template_id} operation.
+type AdminTemplatesTemplateIDPutParams struct {
+	TemplateID uuid.UUID
+}
+
+func unpackAdminTemplatesTemplateIDPutParams(packed middleware.Parameters) (params AdminTemplatesTemplateIDPutParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "template_id",
+			In:   "path",
+		}
+		params.TemplateID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeAdminTemplatesTemplateIDPutParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminTemplatesTemplateIDPutParams, _ error) {
+	// Decode path: template_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "template_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.TemplateID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "template_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AuthCallbackGetParams is parameters of GET /auth/callback operation.
+type AuthCallbackGetParams struct {
+	// OIDCプロバイダーからの認可コード.
+	Code string
+	// CSRF防止のための状態パラメータ.
+	State string
+}
+
+func unpackAuthCallbackGetParams(packed middleware.Parameters) (params AuthCallbackGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "query",
+		}
+		params.Code = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "state",
+			In:   "query",
+		}
+		params.State = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAuthCallbackGetParams(args [0]string, argsEscaped bool, r *http.Request) (params AuthCallbackGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: code.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: state.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "state",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.State = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "state",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// BlocksUserIDDeleteParams is parameters of DELETE /blocks/{user_id} operation.
+type BlocksUserIDDeleteParams struct {
+	UserID uuid.UUID
+}
+
+func unpackBlocksUserIDDeleteParams(packed middleware.Parameters) (params BlocksUserIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "user_id",
+			In:   "path",
+		}
+		params.UserID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeBlocksUserIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params BlocksUserIDDeleteParams, _ error) {
+	// Decode path: user_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "user_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "user_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// BookmarksCollectionsCollectionIDDeleteParams is parameters of DELETE /bookmarks/collections/{collection_id} operation.
+type BookmarksCollectionsCollectionIDDeleteParams struct {
+	CollectionID uuid.UUID
+}
+
+func unpackBookmarksCollectionsCollectionIDDeleteParams(packed middleware.Parameters) (params BookmarksCollectionsCollectionIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "collection_id",
+			In:   "path",
+		}
+		params.CollectionID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeBookmarksCollectionsCollectionIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params BookmarksCollectionsCollectionIDDeleteParams, _ error) {
+	// Decode path: collection_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "collection_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CollectionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "collection_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// BookmarksCollectionsCollectionIDPutParams is parameters of PUT /bookmarks/collections/{collection_id} operation.
+type BookmarksCollectionsCollectionIDPutParams struct {
+	CollectionID uuid.UUID
+}
+
+func unpackBookmarksCollectionsCollectionIDPutParams(packed middleware.Parameters) (params BookmarksCollectionsCollectionIDPutParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "collection_id",
+			In:   "path",
+		}
+		params.CollectionID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeBookmarksCollectionsCollectionIDPutParams(args [1]string, argsEscaped bool, r *http.Request) (params BookmarksCollectionsCollectionIDPutParams, _ error) {
+	// Decode path: collection_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "collection_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CollectionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "collection_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// BookmarksGetParams is parameters of GET /bookmarks operation.
+type BookmarksGetParams struct {
+	// フィルターとして使用され、指定したコレクションのブックマークのみを取得します。.
+	Collection OptUUID `json:",omitempty,omitzero"`
+	// このIDのブックマークより後（古い）のブックマークを取得します（非推奨。cursorを使用してください）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	After OptUUID `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackBookmarksGetParams(packed middleware.Parameters) (params BookmarksGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "collection",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Collection = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "after",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.After = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeBookmarksGetParams(args [0]string, argsEscaped bool, r *http.Request) (params BookmarksGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: collection.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "collection",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCollectionVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCollectionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Collection.SetTo(paramsDotCollectionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "collection",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: after.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAfterVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAfterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.After.SetTo(paramsDotAfterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "after",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// CommentsCommentIDDeleteParams is parameters of DELETE /comments/{comment_id} operation.
+type CommentsCommentIDDeleteParams struct {
+	CommentID uuid.UUID
+}
+
+func unpackCommentsCommentIDDeleteParams(packed middleware.Parameters) (params CommentsCommentIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "comment_id",
+			In:   "path",
+		}
+		params.CommentID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeCommentsCommentIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params CommentsCommentIDDeleteParams, _ error) {
+	// Decode path: comment_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "comment_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CommentID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "comment_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// CommentsCommentIDPutParams is parameters of PUT /comments/{comment_id} operation.
+type CommentsCommentIDPutParams struct {
+	CommentID uuid.UUID
+}
+
+func unpackCommentsCommentIDPutParams(packed middleware.Parameters) (params CommentsCommentIDPutParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "comment_id",
+			In:   "path",
+		}
+		params.CommentID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeCommentsCommentIDPutParams(args [1]string, argsEscaped bool, r *http.Request) (params CommentsCommentIDPutParams, _ error) {
+	// Decode path: comment_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "comment_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CommentID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "comment_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FriendsGetParams is parameters of GET /friends operation.
+type FriendsGetParams struct {
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackFriendsGetParams(packed middleware.Parameters) (params FriendsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeFriendsGetParams(args [0]string, argsEscaped bool, r *http.Request) (params FriendsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FriendsUserIDDeleteParams is parameters of DELETE /friends/{user_id} operation.
+type FriendsUserIDDeleteParams struct {
+	UserID uuid.UUID
+}
+
+func unpackFriendsUserIDDeleteParams(packed middleware.Parameters) (params FriendsUserIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "user_id",
+			In:   "path",
+		}
+		params.UserID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeFriendsUserIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params FriendsUserIDDeleteParams, _ error) {
+	// Decode path: user_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "user_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "user_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GenresGenreIDTemplatesGetParams is parameters of GET /genres/{genre_id}/templates operation.
+type GenresGenreIDTemplatesGetParams struct {
+	GenreID uuid.UUID
+}
+
+func unpackGenresGenreIDTemplatesGetParams(packed middleware.Parameters) (params GenresGenreIDTemplatesGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "genre_id",
+			In:   "path",
+		}
+		params.GenreID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGenresGenreIDTemplatesGetParams(args [1]string, argsEscaped bool, r *http.Request) (params GenresGenreIDTemplatesGetParams, _ error) {
+	// Decode path: genre_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "genre_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GenreID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "genre_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GoalsFromTemplateTemplateIDPostParams is parameters of POST /goals/from-template/{template_id} operation.
+type GoalsFromTemplateTemplateIDPostParams struct {
+	TemplateID uuid.UUID
+}
+
+func unpackGoalsFromTemplateTemplateIDPostParams(packed middleware.Parameters) (params GoalsFromTemplateTemplateIDPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "template_id",
+			In:   "path",
+		}
+		params.TemplateID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGoalsFromTemplateTemplateIDPostParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsFromTemplateTemplateIDPostParams, _ error) {
+	// Decode path: template_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "template_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.TemplateID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "template_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GoalsGetParams is parameters of GET /goals operation.
+type GoalsGetParams struct {
+	// 目標の並び順
+	// - position: ピン留めした目標を先頭に、ユーザーが指定した順
+	// - deadline: 期限の近い順（期限なしは最後）
+	// - created: 作成日の新しい順
+	// - recent_activity: 最後に投稿された日時の新しい順
+	// 省略した場合はposition、cursorを指定した場合はcreatedです。
+	// カーソルによるページング（Linkヘッダー）はcreatedでのみ使えます。ほかの並び順ではpage・limitを使ってください。.
+	Sort OptGoalSort `json:",omitempty,omitzero"`
+	// ページ番号（非推奨。cursorを使用してください。cursorと同時には指定できません）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	Page OptInt `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGoalsGetParams(packed middleware.Parameters) (params GoalsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptGoalSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGoalsGetParams(args [0]string, argsEscaped bool, r *http.Request) (params GoalsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal GoalSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = GoalSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GoalsGoalIDAnalyticsGetParams is parameters of GET /goals/{goal_id}/analytics operation.
+type GoalsGoalIDAnalyticsGetParams struct {
+	GoalID uuid.UUID
+	// 集計開始日（この日を含む）。省略時は目標の作成日.
+	From OptDate `json:",omitempty,omitzero"`
+	// 集計終了日（この日を含む）。省略時は今日。期間は最大366日です.
+	To OptDate `json:",omitempty,omitzero"`
+}
+
+func unpackGoalsGoalIDAnalyticsGetParams(packed middleware.Parameters) (params GoalsGoalIDAnalyticsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "goal_id",
+			In:   "path",
+		}
+		params.GoalID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.From = v.(OptDate)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptDate)
+		}
+	}
+	return params
+}
+
+func decodeGoalsGoalIDAnalyticsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDAnalyticsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: goal_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "goal_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GoalID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "goal_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFromVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDate(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFromVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.From.SetTo(paramsDotFromVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotToVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDate(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotToVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.To.SetTo(paramsDotToVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GoalsGoalIDDeleteParams is parameters of DELETE /goals/{goal_id} operation.
+type GoalsGoalIDDeleteParams struct {
+	GoalID uuid.UUID
+}
+
+func unpackGoalsGoalIDDeleteParams(packed middleware.Parameters) (params GoalsGoalIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "goal_id",
+			In:   "path",
+		}
+		params.GoalID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGoalsGoalIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDDeleteParams, _ error) {
+	// Decode path: goal_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "goal_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GoalID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "goal_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GoalsGoalIDGetParams is parameters of GET /goals/{goal_id} operation.
+type GoalsGoalIDGetParams struct {
+	GoalID uuid.UUID
+}
+
+func unpackGoalsGoalIDGetParams(packed middleware.Parameters) (params GoalsGoalIDGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "goal_id",
+			In:   "path",
+		}
+		params.GoalID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGoalsGoalIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDGetParams, _ error) {
+	// Decode path: goal_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "goal_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GoalID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "goal_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GoalsGoalIDParticipantsAcceptPostParams is parameters of POST /goals/{goal_id}/participants/accept operation.
+type GoalsGoalIDParticipantsAcceptPostParams struct {
+	GoalID uuid.UUID
+}
+
+func unpackGoalsGoalIDParticipantsAcceptPostParams(packed middleware.Parameters) (params GoalsGoalIDParticipantsAcceptPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "goal_id",
+			In:   "path",
+		}
+		params.GoalID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGoalsGoalIDParticipantsAcceptPostParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDParticipantsAcceptPostParams, _ error) {
+	// Decode path: goal_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "goal_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GoalID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "goal_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GoalsGoalIDParticipantsGetParams is parameters of GET /goals/{goal_id}/participants operation.
+type GoalsGoalIDParticipantsGetParams struct {
+	GoalID uuid.UUID
+}
+
+func unpackGoalsGoalIDParticipantsGetParams(packed middleware.Parameters) (params GoalsGoalIDParticipantsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "goal_id",
+			In:   "path",
+		}
+		params.GoalID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGoalsGoalIDParticipantsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDParticipantsGetParams, _ error) {
+	// Decode path: goal_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "goal_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GoalID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "goal_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GoalsGoalIDParticipantsPostParams is parameters of POST /goals/{goal_id}/participants operation.
+type GoalsGoalIDParticipantsPostParams struct {
+	GoalID uuid.UUID
+}
+
+func unpackGoalsGoalIDParticipantsPostParams(packed middleware.Parameters) (params GoalsGoalIDParticipantsPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "goal_id",
+			In:   "path",
+		}
+		params.GoalID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGoalsGoalIDParticipantsPostParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDParticipantsPostParams, _ error) {
+	// Decode path: goal_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "goal_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GoalID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "goal_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GoalsGoalIDParticipantsUserIDDeleteParams is parameters of DELETE /goals/{goal_id}/participants/{user_id} operation.
+type GoalsGoalIDParticipantsUserIDDeleteParams struct {
+	GoalID uuid.UUID
+	UserID uuid.UUID
+}
+
+func unpackGoalsGoalIDParticipantsUserIDDeleteParams(packed middleware.Parameters) (params GoalsGoalIDParticipantsUserIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "goal_id",
+			In:   "path",
+		}
+		params.GoalID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "user_id",
+			In:   "path",
+		}
+		params.UserID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGoalsGoalIDParticipantsUserIDDeleteParams(args [2]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDParticipantsUserIDDeleteParams, _ error) {
+	// Decode path: goal_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "goal_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GoalID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "goal_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: user_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "user_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "user_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GoalsGoalIDPostsGetParams is parameters of GET /goals/{goal_id}/posts operation.
+type GoalsGoalIDPostsGetParams struct {
+	GoalID uuid.UUID
+	// ページ番号（非推奨。cursorを使用してください。cursorと同時には指定できません）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	Page OptInt `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGoalsGoalIDPostsGetParams(packed middleware.Parameters) (params GoalsGoalIDPostsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "goal_id",
+			In:   "path",
+		}
+		params.GoalID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGoalsGoalIDPostsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDPostsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: goal_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "goal_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GoalID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "goal_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GoalsGoalIDPutParams is parameters of PUT /goals/{goal_id} operation.
+type GoalsGoalIDPutParams struct {
+	GoalID uuid.UUID
+}
+
+func unpackGoalsGoalIDPutParams(packed middleware.Parameters) (params GoalsGoalIDPutParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "goal_id",
+			In:   "path",
+		}
+		params.GoalID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGoalsGoalIDPutParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDPutParams, _ error) {
+	// Decode path: goal_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "goal_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.GoalID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "goal_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// HashtagsTagPostsGetParams is parameters of GET /hashtags/{tag}/posts operation.
+type HashtagsTagPostsGetParams struct {
+	Tag string
+	// ページ番号（非推奨。cursorを使用してください。cursorと同時には指定できません）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	Page OptInt `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackHashtagsTagPostsGetParams(packed middleware.Parameters) (params HashtagsTagPostsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "tag",
+			In:   "path",
+		}
+		params.Tag = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeHashtagsTagPostsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params HashtagsTagPostsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: tag.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "tag",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Tag = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "tag",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// HashtagsTrendingGetParams is parameters of GET /hashtags/trending operation.
+type HashtagsTrendingGetParams struct {
+	// 集計期間（時間）.
+	Hours OptInt `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackHashtagsTrendingGetParams(packed middleware.Parameters) (params HashtagsTrendingGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "hours",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Hours = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeHashtagsTrendingGetParams(args [0]string, argsEscaped bool, r *http.Request) (params HashtagsTrendingGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: hours.
+	{
+		val := int(24)
+		params.Hours.SetTo(val)
+	}
+	// Decode query: hours.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "hours",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotHoursVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotHoursVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Hours.SetTo(paramsDotHoursVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Hours.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           720,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hours",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ImagesImageIDGetParams is parameters of GET /images/{image_id} operation.
+type ImagesImageIDGetParams struct {
+	ImageID uuid.UUID
+}
+
+func unpackImagesImageIDGetParams(packed middleware.Parameters) (params ImagesImageIDGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "image_id",
+			In:   "path",
+		}
+		params.ImageID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeImagesImageIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params ImagesImageIDGetParams, _ error) {
+	// Decode path: image_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "image_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ImageID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "image_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// NotificationsGetParams is parameters of GET /notifications operation.
+type NotificationsGetParams struct {
+	// Trueの場合は未読の通知のみを取得します.
+	Unread OptBool `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackNotificationsGetParams(packed middleware.Parameters) (params NotificationsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "unread",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Unread = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeNotificationsGetParams(args [0]string, argsEscaped bool, r *http.Request) (params NotificationsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: unread.
+	{
+		val := bool(false)
+		params.Unread.SetTo(val)
+	}
+	// Decode query: unread.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "unread",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUnreadVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUnreadVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Unread.SetTo(paramsDotUnreadVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "unread",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsGetParams is parameters of GET /posts operation.
+type PostsGetParams struct {
+	// フィルターとして使用され、指定したゴールの投稿のみを取得します。.
+	GoalID OptUUID `json:",omitempty,omitzero"`
+	// フィルターとして使用され、指定した公開状態の投稿のみを取得します。.
+	Status OptPostStatus `json:",omitempty,omitzero"`
+	// ページ番号（非推奨。cursorを使用してください。cursorと同時には指定できません）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	Page OptInt `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackPostsGetParams(packed middleware.Parameters) (params PostsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "goal_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.GoalID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptPostStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodePostsGetParams(args [0]string, argsEscaped bool, r *http.Request) (params PostsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: goal_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "goal_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotGoalIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotGoalIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.GoalID.SetTo(paramsDotGoalIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "goal_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal PostStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = PostStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDBookmarkDeleteParams is parameters of DELETE /posts/{post_id}/bookmark operation.
+type PostsPostIDBookmarkDeleteParams struct {
+	PostID uuid.UUID
+}
+
+func unpackPostsPostIDBookmarkDeleteParams(packed middleware.Parameters) (params PostsPostIDBookmarkDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePostsPostIDBookmarkDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDBookmarkDeleteParams, _ error) {
+	// Decode path: post_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "post_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PostID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "post_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDBookmarkPostParams is parameters of POST /posts/{post_id}/bookmark operation.
+type PostsPostIDBookmarkPostParams struct {
+	PostID uuid.UUID
+}
+
+func unpackPostsPostIDBookmarkPostParams(packed middleware.Parameters) (params PostsPostIDBookmarkPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePostsPostIDBookmarkPostParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDBookmarkPostParams, _ error) {
+	// Decode path: post_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "post_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PostID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "post_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDCommentsGetParams is parameters of GET /posts/{post_id}/comments operation.
+type PostsPostIDCommentsGetParams struct {
+	PostID uuid.UUID
+	// 返信を取得する親コメントのID.
+	ParentID OptUUID `json:",omitempty,omitzero"`
+	// このIDのコメントより後のコメントを取得します（非推奨。cursorを使用してください）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	After OptUUID `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackPostsPostIDCommentsGetParams(packed middleware.Parameters) (params PostsPostIDCommentsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "parent_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ParentID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "after",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.After = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodePostsPostIDCommentsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDCommentsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: post_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "post_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PostID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "post_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: parent_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "parent_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotParentIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotParentIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ParentID.SetTo(paramsDotParentIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "parent_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: after.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAfterVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAfterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.After.SetTo(paramsDotAfterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "after",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDCommentsPostParams is parameters of POST /posts/{post_id}/comments operation.
+type PostsPostIDCommentsPostParams struct {
+	PostID uuid.UUID
+}
+
+func unpackPostsPostIDCommentsPostParams(packed middleware.Parameters) (params PostsPostIDCommentsPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePostsPostIDCommentsPostParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDCommentsPostParams, _ error) {
+	// Decode path: post_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "post_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PostID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "post_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDDeleteParams is parameters of DELETE /posts/{post_id} operation.
+type PostsPostIDDeleteParams struct {
+	PostID uuid.UUID
+}
+
+func unpackPostsPostIDDeleteParams(packed middleware.Parameters) (params PostsPostIDDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePostsPostIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDDeleteParams, _ error) {
+	// Decode path: post_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "post_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PostID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "post_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDGetParams is parameters of GET /posts/{post_id} operation.
+type PostsPostIDGetParams struct {
+	PostID uuid.UUID
+}
+
+func unpackPostsPostIDGetParams(packed middleware.Parameters) (params PostsPostIDGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePostsPostIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDGetParams, _ error) {
+	// Decode path: post_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "post_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PostID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "post_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDPutParams is parameters of PUT /posts/{post_id} operation.
+type PostsPostIDPutParams struct {
+	PostID uuid.UUID
+}
+
+func unpackPostsPostIDPutParams(packed middleware.Parameters) (params PostsPostIDPutParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePostsPostIDPutParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDPutParams, _ error) {
+	// Decode path: post_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "post_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PostID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "post_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDReactionsDeleteParams is parameters of DELETE /posts/{post_id}/reactions operation.
+type PostsPostIDReactionsDeleteParams struct {
+	PostID uuid.UUID
+	// 削除するリアクションの種類.
+	Kind OptString `json:",omitempty,omitzero"`
+}
+
+func unpackPostsPostIDReactionsDeleteParams(packed middleware.Parameters) (params PostsPostIDReactionsDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "kind",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Kind = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodePostsPostIDReactionsDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDReactionsDeleteParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: post_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "post_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PostID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "post_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: kind.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotKindVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotKindVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Kind.SetTo(paramsDotKindVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "kind",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDReactionsGetParams is parameters of GET /posts/{post_id}/reactions operation.
+type PostsPostIDReactionsGetParams struct {
+	PostID uuid.UUID
+	// フィルターとして使用され、指定した種類のリアクションのみを取得します。.
+	Kind OptString `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackPostsPostIDReactionsGetParams(packed middleware.Parameters) (params PostsPostIDReactionsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "kind",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Kind = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodePostsPostIDReactionsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDReactionsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: post_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "post_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PostID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "post_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: kind.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotKindVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotKindVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Kind.SetTo(paramsDotKindVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "kind",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDReactionsPostParams is parameters of POST /posts/{post_id}/reactions operation.
+type PostsPostIDReactionsPostParams struct {
+	PostID uuid.UUID
+}
+
+func unpackPostsPostIDReactionsPostParams(packed middleware.Parameters) (params PostsPostIDReactionsPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePostsPostIDReactionsPostParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDReactionsPostParams, _ error) {
+	// Decode path: post_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "post_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PostID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "post_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDRepostDeleteParams is parameters of DELETE /posts/{post_id}/repost operation.
+type PostsPostIDRepostDeleteParams struct {
+	PostID uuid.UUID
+}
+
+func unpackPostsPostIDRepostDeleteParams(packed middleware.Parameters) (params PostsPostIDRepostDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePostsPostIDRepostDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDRepostDeleteParams, _ error) {
+	// Decode path: post_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "post_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PostID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "post_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PostsPostIDRepostPostParams is parameters of POST /posts/{post_id}/repost operation.
+type PostsPostIDRepostPostParams struct {
+	PostID uuid.UUID
+}
+
+func unpackPostsPostIDRepostPostParams(packed middleware.Parameters) (params PostsPostIDRepostPostParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "post_id",
+			In:   "path",
+		}
+		params.PostID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGoalsGoalIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDDeleteParams, _ error) {
-	// Decode path: goal_id.
+func decodePostsPostIDRepostPostParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDRepostPostParams, _ error) {
+	// Decode path: post_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -337,7 +5566,7 @@ func decodeGoalsGoalIDDeleteParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "goal_id",
+				Param:   "post_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -354,7 +5583,7 @@ func decodeGoalsGoalIDDeleteParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.GoalID = c
+				params.PostID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -365,7 +5594,7 @@ func decodeGoalsGoalIDDeleteParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "goal_id",
+			Name: "post_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -373,24 +5602,24 @@ func decodeGoalsGoalIDDeleteParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GoalsGoalIDGetParams is parameters of GET /goals/{goal_id} operation.
-type GoalsGoalIDGetParams struct {
-	GoalID uuid.UUID
+// PostsPostIDRevisionsGetParams is parameters of GET /posts/{post_id}/revisions operation.
+type PostsPostIDRevisionsGetParams struct {
+	PostID uuid.UUID
 }
 
-func unpackGoalsGoalIDGetParams(packed middleware.Parameters) (params GoalsGoalIDGetParams) {
+func unpackPostsPostIDRevisionsGetParams(packed middleware.Parameters) (params PostsPostIDRevisionsGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "goal_id",
+			Name: "post_id",
 			In:   "path",
 		}
-		params.GoalID = packed[key].(uuid.UUID)
+		params.PostID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGoalsGoalIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDGetParams, _ error) {
-	// Decode path: goal_id.
+func decodePostsPostIDRevisionsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDRevisionsGetParams, _ error) {
+	// Decode path: post_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -402,7 +5631,7 @@ func decodeGoalsGoalIDGetParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "goal_id",
+				Param:   "post_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -419,7 +5648,7 @@ func decodeGoalsGoalIDGetParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.GoalID = c
+				params.PostID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -430,7 +5659,7 @@ func decodeGoalsGoalIDGetParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "goal_id",
+			Name: "post_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -438,24 +5667,32 @@ func decodeGoalsGoalIDGetParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GoalsGoalIDPutParams is parameters of PUT /goals/{goal_id} operation.
-type GoalsGoalIDPutParams struct {
-	GoalID uuid.UUID
+// PostsPostIDRevisionsRevisionRestorePostParams is parameters of POST /posts/{post_id}/revisions/{revision}/restore operation.
+type PostsPostIDRevisionsRevisionRestorePostParams struct {
+	PostID   uuid.UUID
+	Revision int
 }
 
-func unpackGoalsGoalIDPutParams(packed middleware.Parameters) (params GoalsGoalIDPutParams) {
+func unpackPostsPostIDRevisionsRevisionRestorePostParams(packed middleware.Parameters) (params PostsPostIDRevisionsRevisionRestorePostParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "goal_id",
+			Name: "post_id",
 			In:   "path",
 		}
-		params.GoalID = packed[key].(uuid.UUID)
+		params.PostID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "revision",
+			In:   "path",
+		}
+		params.Revision = packed[key].(int)
 	}
 	return params
 }
 
-func decodeGoalsGoalIDPutParams(args [1]string, argsEscaped bool, r *http.Request) (params GoalsGoalIDPutParams, _ error) {
-	// Decode path: goal_id.
+func decodePostsPostIDRevisionsRevisionRestorePostParams(args [2]string, argsEscaped bool, r *http.Request) (params PostsPostIDRevisionsRevisionRestorePostParams, _ error) {
+	// Decode path: post_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -467,7 +5704,7 @@ func decodeGoalsGoalIDPutParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "goal_id",
+				Param:   "post_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -484,7 +5721,7 @@ func decodeGoalsGoalIDPutParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.GoalID = c
+				params.PostID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -495,36 +5732,16 @@ func decodeGoalsGoalIDPutParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "goal_id",
+			Name: "post_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// ImagesImageIDGetParams is parameters of GET /images/{image_id} operation.
-type ImagesImageIDGetParams struct {
-	ImageID uuid.UUID
-}
-
-func unpackImagesImageIDGetParams(packed middleware.Parameters) (params ImagesImageIDGetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "image_id",
-			In:   "path",
-		}
-		params.ImageID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeImagesImageIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params ImagesImageIDGetParams, _ error) {
-	// Decode path: image_id.
+	// Decode path: revision.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -532,7 +5749,7 @@ func decodeImagesImageIDGetParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "image_id",
+				Param:   "revision",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -544,12 +5761,30 @@ func decodeImagesImageIDGetParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.ImageID = c
+				params.Revision = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+					Pattern:       nil,
+				}).Validate(int64(params.Revision)); err != nil {
+					return errors.Wrap(err, "int")
+				}
 				return nil
 			}(); err != nil {
 				return err
@@ -560,7 +5795,7 @@ func decodeImagesImageIDGetParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "image_id",
+			Name: "revision",
 			In:   "path",
 			Err:  err,
 		}
@@ -568,15 +5803,58 @@ func decodeImagesImageIDGetParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// PostsGetParams is parameters of GET /posts operation.
-type PostsGetParams struct {
-	// フィルターとして使用され、指定したゴールの投稿のみを取得します。.
+// SearchGetParams is parameters of GET /search operation.
+type SearchGetParams struct {
+	// 検索語.
+	Q string
+	// 検索対象.
+	Type OptSearchType `json:",omitempty,omitzero"`
+	// 作成したユーザーで絞り込みます.
+	UserID OptUUID `json:",omitempty,omitzero"`
+	// 投稿の目標で絞り込みます（typeがpostsの場合のみ指定できます）.
 	GoalID OptUUID `json:",omitempty,omitzero"`
-	Page   OptInt  `json:",omitempty,omitzero"`
-	Limit  OptInt  `json:",omitempty,omitzero"`
+	// この日時以降に作成されたものに絞り込みます.
+	From OptDateTime `json:",omitempty,omitzero"`
+	// この日時より前に作成されたものに絞り込みます.
+	To OptDateTime `json:",omitempty,omitzero"`
+	// このIDの結果より後（古い）の結果を取得します（非推奨。cursorを使用してください）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	After OptUUID `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackPostsGetParams(packed middleware.Parameters) (params PostsGetParams) {
+func unpackSearchGetParams(packed middleware.Parameters) (params SearchGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "type",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Type = v.(OptSearchType)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "user_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UserID = v.(OptUUID)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "goal_id",
@@ -588,11 +5866,38 @@ func unpackPostsGetParams(packed middleware.Parameters) (params PostsGetParams)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "page",
+			Name: "from",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Page = v.(OptInt)
+			params.From = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.To = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "after",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.After = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
 		}
 	}
 	{
@@ -607,8 +5912,166 @@ func unpackPostsGetParams(packed middleware.Parameters) (params PostsGetParams)
 	return params
 }
 
-func decodePostsGetParams(args [0]string, argsEscaped bool, r *http.Request) (params PostsGetParams, _ error) {
+func decodeSearchGetParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchGetParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     100,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: type.
+	{
+		val := SearchType("posts")
+		params.Type.SetTo(val)
+	}
+	// Decode query: type.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTypeVal SearchType
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTypeVal = SearchType(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Type.SetTo(paramsDotTypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Type.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "type",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: user_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "user_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUserIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUserIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UserID.SetTo(paramsDotUserIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "user_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: goal_id.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -650,39 +6113,34 @@ func decodePostsGetParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 			Err:  err,
 		}
 	}
-	// Set default value for query: page.
-	{
-		val := int(1)
-		params.Page.SetTo(val)
-	}
-	// Decode query: page.
+	// Decode query: from.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
+			Name:    "from",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageVal int
+				var paramsDotFromVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPageVal = c
+					paramsDotFromVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Page.SetTo(paramsDotPageVal)
+				params.From.SetTo(paramsDotFromVal)
 				return nil
 			}); err != nil {
 				return err
@@ -691,44 +6149,39 @@ func decodePostsGetParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
+			Name: "from",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode query: to.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "to",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotToVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotToVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.To.SetTo(paramsDotToVal)
 				return nil
 			}); err != nil {
 				return err
@@ -737,398 +6190,310 @@ func decodePostsGetParams(args [0]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "to",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// PostsPostIDDeleteParams is parameters of DELETE /posts/{post_id} operation.
-type PostsPostIDDeleteParams struct {
-	PostID uuid.UUID
-}
-
-func unpackPostsPostIDDeleteParams(packed middleware.Parameters) (params PostsPostIDDeleteParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "post_id",
-			In:   "path",
-		}
-		params.PostID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodePostsPostIDDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDDeleteParams, _ error) {
-	// Decode path: post_id.
+	// Decode query: after.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "post_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToUUID(val)
-				if err != nil {
-					return err
-				}
-
-				params.PostID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "post_id",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// PostsPostIDGetParams is parameters of GET /posts/{post_id} operation.
-type PostsPostIDGetParams struct {
-	PostID uuid.UUID
-}
-
-func unpackPostsPostIDGetParams(packed middleware.Parameters) (params PostsPostIDGetParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "post_id",
-			In:   "path",
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		params.PostID = packed[key].(uuid.UUID)
-	}
-	return params
-}
 
-func decodePostsPostIDGetParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDGetParams, _ error) {
-	// Decode path: post_id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "post_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAfterVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					paramsDotAfterVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.PostID = c
+				params.After.SetTo(paramsDotAfterVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "post_id",
-			In:   "path",
+			Name: "after",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// PostsPostIDPutParams is parameters of PUT /posts/{post_id} operation.
-type PostsPostIDPutParams struct {
-	PostID uuid.UUID
-}
-
-func unpackPostsPostIDPutParams(packed middleware.Parameters) (params PostsPostIDPutParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "post_id",
-			In:   "path",
-		}
-		params.PostID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodePostsPostIDPutParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDPutParams, _ error) {
-	// Decode path: post_id.
+	// Decode query: cursor.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "post_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.PostID = c
+				params.Cursor.SetTo(paramsDotCursorVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "post_id",
-			In:   "path",
+			Name: "cursor",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// PostsPostIDReactionsDeleteParams is parameters of DELETE /posts/{post_id}/reactions operation.
-type PostsPostIDReactionsDeleteParams struct {
-	PostID uuid.UUID
-}
-
-func unpackPostsPostIDReactionsDeleteParams(packed middleware.Parameters) (params PostsPostIDReactionsDeleteParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "post_id",
-			In:   "path",
-		}
-		params.PostID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodePostsPostIDReactionsDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDReactionsDeleteParams, _ error) {
-	// Decode path: post_id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "post_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.PostID = c
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "post_id",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// PostsPostIDReactionsGetParams is parameters of GET /posts/{post_id}/reactions operation.
-type PostsPostIDReactionsGetParams struct {
-	PostID uuid.UUID
+// TimelineDiscoverGetParams is parameters of GET /timeline/discover operation.
+type TimelineDiscoverGetParams struct {
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackPostsPostIDReactionsGetParams(packed middleware.Parameters) (params PostsPostIDReactionsGetParams) {
+func unpackTimelineDiscoverGetParams(packed middleware.Parameters) (params TimelineDiscoverGetParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "post_id",
-			In:   "path",
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
 		}
-		params.PostID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodePostsPostIDReactionsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDReactionsGetParams, _ error) {
-	// Decode path: post_id.
+func decodeTimelineDiscoverGetParams(args [0]string, argsEscaped bool, r *http.Request) (params TimelineDiscoverGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: cursor.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "post_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.PostID = c
+				params.Cursor.SetTo(paramsDotCursorVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "post_id",
-			In:   "path",
+			Name: "cursor",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// PostsPostIDReactionsPostParams is parameters of POST /posts/{post_id}/reactions operation.
-type PostsPostIDReactionsPostParams struct {
-	PostID uuid.UUID
-}
-
-func unpackPostsPostIDReactionsPostParams(packed middleware.Parameters) (params PostsPostIDReactionsPostParams) {
+	// Set default value for query: limit.
 	{
-		key := middleware.ParameterKey{
-			Name: "post_id",
-			In:   "path",
-		}
-		params.PostID = packed[key].(uuid.UUID)
+		val := int(20)
+		params.Limit.SetTo(val)
 	}
-	return params
-}
-
-func decodePostsPostIDReactionsPostParams(args [1]string, argsEscaped bool, r *http.Request) (params PostsPostIDReactionsPostParams, _ error) {
-	// Decode path: post_id.
+	// Decode query: limit.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "post_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.PostID = c
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "post_id",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
@@ -1139,8 +6504,16 @@ func decodePostsPostIDReactionsPostParams(args [1]string, argsEscaped bool, r *h
 type TimelineGetParams struct {
 	// フィルターとして使用され、指定したゴールのタイムライン投稿のみを取得します。.
 	GoalID OptUUID `json:",omitempty,omitzero"`
-	Page   OptInt  `json:",omitempty,omitzero"`
-	Limit  OptInt  `json:",omitempty,omitzero"`
+	// ページ番号（非推奨。cursorを使用してください。cursorと同時には指定できません）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	Page OptInt `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
 func unpackTimelineGetParams(packed middleware.Parameters) (params TimelineGetParams) {
@@ -1162,6 +6535,15 @@ func unpackTimelineGetParams(packed middleware.Parameters) (params TimelineGetPa
 			params.Page = v.(OptInt)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "limit",
@@ -1263,6 +6645,47 @@ func decodeTimelineGetParams(args [0]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Set default value for query: limit.
 	{
 		val := int(20)
@@ -1300,6 +6723,31 @@ func decodeTimelineGetParams(args [0]string, argsEscaped bool, r *http.Request)
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
@@ -1380,6 +6828,12 @@ func decodeUsersUserIDDeleteParams(args [1]string, argsEscaped bool, r *http.Req
 // UsersUserIDFriendsGetParams is parameters of GET /users/{user_id}/friends operation.
 type UsersUserIDFriendsGetParams struct {
 	UserID uuid.UUID
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
 func unpackUsersUserIDFriendsGetParams(packed middleware.Parameters) (params UsersUserIDFriendsGetParams) {
@@ -1390,10 +6844,29 @@ func unpackUsersUserIDFriendsGetParams(packed middleware.Parameters) (params Use
 		}
 		params.UserID = packed[key].(uuid.UUID)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
 	return params
 }
 
 func decodeUsersUserIDFriendsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params UsersUserIDFriendsGetParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: user_id.
 	if err := func() error {
 		param := args[0]
@@ -1439,6 +6912,118 @@ func decodeUsersUserIDFriendsGetParams(args [1]string, argsEscaped bool, r *http
 			Err:  err,
 		}
 	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
@@ -1510,8 +7095,24 @@ func decodeUsersUserIDGetParams(args [1]string, argsEscaped bool, r *http.Reques
 // UsersUserIDGoalsGetParams is parameters of GET /users/{user_id}/goals operation.
 type UsersUserIDGoalsGetParams struct {
 	UserID uuid.UUID
-	Page   OptInt `json:",omitempty,omitzero"`
-	Limit  OptInt `json:",omitempty,omitzero"`
+	// 目標の並び順
+	// - position: ピン留めした目標を先頭に、ユーザーが指定した順
+	// - deadline: 期限の近い順（期限なしは最後）
+	// - created: 作成日の新しい順
+	// - recent_activity: 最後に投稿された日時の新しい順
+	// 省略した場合はposition、cursorを指定した場合はcreatedです。
+	// カーソルによるページング（Linkヘッダー）はcreatedでのみ使えます。ほかの並び順ではpage・limitを使ってください。.
+	Sort OptGoalSort `json:",omitempty,omitzero"`
+	// ページ番号（非推奨。cursorを使用してください。cursorと同時には指定できません）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	Page OptInt `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
 func unpackUsersUserIDGoalsGetParams(packed middleware.Parameters) (params UsersUserIDGoalsGetParams) {
@@ -1522,6 +7123,15 @@ func unpackUsersUserIDGoalsGetParams(packed middleware.Parameters) (params Users
 		}
 		params.UserID = packed[key].(uuid.UUID)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptGoalSort)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "page",
@@ -1531,6 +7141,15 @@ func unpackUsersUserIDGoalsGetParams(packed middleware.Parameters) (params Users
 			params.Page = v.(OptInt)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "limit",
@@ -1590,6 +7209,62 @@ func decodeUsersUserIDGoalsGetParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal GoalSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = GoalSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Set default value for query: page.
 	{
 		val := int(1)
@@ -1636,6 +7311,47 @@ func decodeUsersUserIDGoalsGetParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Set default value for query: limit.
 	{
 		val := int(20)
@@ -1673,6 +7389,31 @@ func decodeUsersUserIDGoalsGetParams(args [1]string, argsEscaped bool, r *http.R
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
@@ -1885,8 +7626,16 @@ type UsersUserIDPostsGetParams struct {
 	UserID uuid.UUID
 	// フィルターとして使用され、指定したゴールの投稿のみを取得します。.
 	GoalID OptUUID `json:",omitempty,omitzero"`
-	Page   OptInt  `json:",omitempty,omitzero"`
-	Limit  OptInt  `json:",omitempty,omitzero"`
+	// ページ番号（非推奨。cursorを使用してください。cursorと同時には指定できません）.
+	//
+	// Deprecated: schema marks this parameter as deprecated.
+	Page OptInt `json:",omitempty,omitzero"`
+	// 続きを取得するためのカーソル。前回のレスポンスのLinkヘッダー（rel="next"）に含まれる値をそのまま指定します。
+	// カーソルは一覧ごとに署名されており、別の一覧のカーソルや改ざんされたカーソルは400になります。
+	// page・afterとは同時に指定できません。目標一覧のカーソルは作成日の新しい順（sort=created）でのみ使え、sortを省略してcursorを指定した場合はcreatedになります。.
+	Cursor OptString `json:",omitempty,omitzero"`
+	// 1ページあたりの件数.
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
 func unpackUsersUserIDPostsGetParams(packed middleware.Parameters) (params UsersUserIDPostsGetParams) {
@@ -1915,6 +7664,15 @@ func unpackUsersUserIDPostsGetParams(packed middleware.Parameters) (params Users
 			params.Page = v.(OptInt)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "limit",
@@ -2061,6 +7819,47 @@ func decodeUsersUserIDPostsGetParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Set default value for query: limit.
 	{
 		val := int(20)
@@ -2098,6 +7897,31 @@ func decodeUsersUserIDPostsGetParams(args [1]string, argsEscaped bool, r *http.R
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
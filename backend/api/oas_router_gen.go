@@ -40,7 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [1]string{}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -61,9 +61,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "auth/"
+			case 'a': // Prefix: "a"
 
-				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 					elem = elem[l:]
 				} else {
 					break
@@ -73,29 +73,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'c': // Prefix: "callback"
+				case 'd': // Prefix: "dmin/"
 
-					if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleAuthCallbackGetRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
-
-						return
-					}
-
-				case 'l': // Prefix: "log"
-
-					if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+					if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -105,9 +85,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'i': // Prefix: "in"
+					case 'm': // Prefix: "moderation/actions"
 
-						if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+						if l := len("moderation/actions"); len(elem) >= l && elem[0:l] == "moderation/actions" {
 							elem = elem[l:]
 						} else {
 							break
@@ -117,115 +97,289 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "GET":
-								s.handleAuthLoginGetRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleAdminModerationActionsGetRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleAdminModerationActionsPostRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+
+					case 'r': // Prefix: "reports"
+
+						if l := len("reports"); len(elem) >= l && elem[0:l] == "reports" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleAdminReportsGetRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "GET")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "report_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "claim"
+
+									if l := len("claim"); len(elem) >= l && elem[0:l] == "claim" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleAdminReportsReportIDClaimPostRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'r': // Prefix: "resolve"
+
+									if l := len("resolve"); len(elem) >= l && elem[0:l] == "resolve" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleAdminReportsReportIDResolvePostRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
 
-					case 'o': // Prefix: "out"
+					case 't': // Prefix: "templates"
 
-						if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+						if l := len("templates"); len(elem) >= l && elem[0:l] == "templates" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
 							case "POST":
-								s.handleAuthLogoutPostRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleAdminTemplatesPostRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "POST")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "template_id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleAdminTemplatesTemplateIDDeleteRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleAdminTemplatesTemplateIDPutRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,PUT")
+								}
+
+								return
+							}
+
+						}
 
 					}
 
-				case 'm': // Prefix: "me"
+				case 'u': // Prefix: "uth/"
 
-					if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+					if l := len("uth/"); len(elem) >= l && elem[0:l] == "uth/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleAuthMeGetRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "callback"
+
+						if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+							elem = elem[l:]
+						} else {
+							break
 						}
 
-						return
-					}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleAuthCallbackGetRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
 
-				}
+							return
+						}
 
-			case 'f': // Prefix: "friends"
+					case 'l': // Prefix: "log"
 
-				if l := len("friends"); len(elem) >= l && elem[0:l] == "friends" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleFriendsGetRequest([0]string{}, elemIsEscaped, w, r)
-					case "POST":
-						s.handleFriendsPostRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET,POST")
-					}
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'i': // Prefix: "in"
 
-					return
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+							if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleAuthLoginGetRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
 
-					// Param: "user_id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
+								return
+							}
+
+						case 'o': // Prefix: "out"
+
+							if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleAuthLogoutPostRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "DELETE":
-							s.handleFriendsUserIDDeleteRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "DELETE")
 						}
 
-						return
+					case 'm': // Prefix: "me"
+
+						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleAuthMeGetRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
 					}
 
 				}
 
-			case 'g': // Prefix: "g"
+			case 'b': // Prefix: "b"
 
-				if l := len("g"); len(elem) >= l && elem[0:l] == "g" {
+				if l := len("b"); len(elem) >= l && elem[0:l] == "b" {
 					elem = elem[l:]
 				} else {
 					break
@@ -235,29 +389,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'e': // Prefix: "enres"
-
-					if l := len("enres"); len(elem) >= l && elem[0:l] == "enres" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGenresGetRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
-
-						return
-					}
-
-				case 'o': // Prefix: "oals"
+				case 'l': // Prefix: "locks"
 
-					if l := len("oals"); len(elem) >= l && elem[0:l] == "oals" {
+					if l := len("locks"); len(elem) >= l && elem[0:l] == "locks" {
 						elem = elem[l:]
 					} else {
 						break
@@ -266,9 +400,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					if len(elem) == 0 {
 						switch r.Method {
 						case "GET":
-							s.handleGoalsGetRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleBlocksGetRequest([0]string{}, elemIsEscaped, w, r)
 						case "POST":
-							s.handleGoalsPostRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleBlocksPostRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "GET,POST")
 						}
@@ -284,7 +418,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 
-						// Param: "goal_id"
+						// Param: "user_id"
 						// Leaf parameter, slashes are prohibited
 						idx := strings.IndexByte(elem, '/')
 						if idx >= 0 {
@@ -297,19 +431,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "DELETE":
-								s.handleGoalsGoalIDDeleteRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							case "GET":
-								s.handleGoalsGoalIDGetRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							case "PUT":
-								s.handleGoalsGoalIDPutRequest([1]string{
+								s.handleBlocksUserIDDeleteRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "DELETE,GET,PUT")
+								s.notAllowed(w, r, "DELETE")
 							}
 
 							return
@@ -317,176 +443,125 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					}
 
-				}
-
-			case 'i': // Prefix: "images"
-
-				if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+				case 'o': // Prefix: "ookmarks"
 
-				if len(elem) == 0 {
-					switch r.Method {
-					case "POST":
-						s.handleImagesPostRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "POST")
+					if l := len("ookmarks"); len(elem) >= l && elem[0:l] == "ookmarks" {
+						elem = elem[l:]
+					} else {
+						break
 					}
 
-					return
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
-
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "image_id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
-
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "GET":
-							s.handleImagesImageIDGetRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
+							s.handleBookmarksGetRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "GET")
 						}
 
 						return
 					}
-
-				}
-
-			case 'p': // Prefix: "posts"
-
-				if l := len("posts"); len(elem) >= l && elem[0:l] == "posts" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handlePostsGetRequest([0]string{}, elemIsEscaped, w, r)
-					case "POST":
-						s.handlePostsPostRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET,POST")
-					}
-
-					return
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
-
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "post_id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
-					if len(elem) == 0 {
-						switch r.Method {
-						case "DELETE":
-							s.handlePostsPostIDDeleteRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "GET":
-							s.handlePostsPostIDGetRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "PUT":
-							s.handlePostsPostIDPutRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "DELETE,GET,PUT")
-						}
-
-						return
-					}
 					switch elem[0] {
-					case '/': // Prefix: "/reactions"
+					case '/': // Prefix: "/collections"
 
-						if l := len("/reactions"); len(elem) >= l && elem[0:l] == "/reactions" {
+						if l := len("/collections"); len(elem) >= l && elem[0:l] == "/collections" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
-							case "DELETE":
-								s.handlePostsPostIDReactionsDeleteRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
 							case "GET":
-								s.handlePostsPostIDReactionsGetRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
+								s.handleBookmarksCollectionsGetRequest([0]string{}, elemIsEscaped, w, r)
 							case "POST":
-								s.handlePostsPostIDReactionsPostRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
+								s.handleBookmarksCollectionsPostRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "DELETE,GET,POST")
+								s.notAllowed(w, r, "GET,POST")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "collection_id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleBookmarksCollectionsCollectionIDDeleteRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleBookmarksCollectionsCollectionIDPutRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,PUT")
+								}
+
+								return
+							}
+
+						}
 
 					}
 
 				}
 
-			case 't': // Prefix: "timeline"
+			case 'c': // Prefix: "comments/"
 
-				if l := len("timeline"); len(elem) >= l && elem[0:l] == "timeline" {
+				if l := len("comments/"); len(elem) >= l && elem[0:l] == "comments/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
+				// Param: "comment_id"
+				// Leaf parameter, slashes are prohibited
+				idx := strings.IndexByte(elem, '/')
+				if idx >= 0 {
+					break
+				}
+				args[0] = elem
+				elem = ""
+
 				if len(elem) == 0 {
 					// Leaf node.
 					switch r.Method {
-					case "GET":
-						s.handleTimelineGetRequest([0]string{}, elemIsEscaped, w, r)
+					case "DELETE":
+						s.handleCommentsCommentIDDeleteRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
+					case "PUT":
+						s.handleCommentsCommentIDPutRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET")
+						s.notAllowed(w, r, "DELETE,PUT")
 					}
 
 					return
 				}
 
-			case 'u': // Prefix: "users"
+			case 'f': // Prefix: "friends"
 
-				if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
+				if l := len("friends"); len(elem) >= l && elem[0:l] == "friends" {
 					elem = elem[l:]
 				} else {
 					break
@@ -494,10 +569,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				if len(elem) == 0 {
 					switch r.Method {
+					case "GET":
+						s.handleFriendsGetRequest([0]string{}, elemIsEscaped, w, r)
 					case "POST":
-						s.handleUsersPostRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleFriendsPostRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "POST")
+						s.notAllowed(w, r, "GET,POST")
 					}
 
 					return
@@ -512,30 +589,56 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					// Param: "user_id"
-					// Match until "/"
+					// Leaf parameter, slashes are prohibited
 					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
+					if idx >= 0 {
+						break
 					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+					args[0] = elem
+					elem = ""
 
 					if len(elem) == 0 {
+						// Leaf node.
 						switch r.Method {
 						case "DELETE":
-							s.handleUsersUserIDDeleteRequest([1]string{
+							s.handleFriendsUserIDDeleteRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE")
+						}
+
+						return
+					}
+
+				}
+
+			case 'g': // Prefix: "g"
+
+				if l := len("g"); len(elem) >= l && elem[0:l] == "g" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "enres"
+
+					if l := len("enres"); len(elem) >= l && elem[0:l] == "enres" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
 						case "GET":
-							s.handleUsersUserIDGetRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "PUT":
-							s.handleUsersUserIDPutRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
+							s.handleGenresGetRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE,GET,PUT")
+							s.notAllowed(w, r, "GET")
 						}
 
 						return
@@ -549,13 +652,22 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 
+						// Param: "genre_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
 							break
 						}
 						switch elem[0] {
-						case 'f': // Prefix: "friends"
+						case '/': // Prefix: "/templates"
 
-							if l := len("friends"); len(elem) >= l && elem[0:l] == "friends" {
+							if l := len("/templates"); len(elem) >= l && elem[0:l] == "/templates" {
 								elem = elem[l:]
 							} else {
 								break
@@ -565,7 +677,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleUsersUserIDFriendsGetRequest([1]string{
+									s.handleGenresGenreIDTemplatesGetRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
@@ -575,31 +687,78 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								return
 							}
 
-						case 'g': // Prefix: "goals"
+						}
 
-							if l := len("goals"); len(elem) >= l && elem[0:l] == "goals" {
+					}
+
+				case 'o': // Prefix: "oals"
+
+					if l := len("oals"); len(elem) >= l && elem[0:l] == "oals" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGoalsGetRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleGoalsPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'f': // Prefix: "from-template/"
+							origElem := elem
+							if l := len("from-template/"); len(elem) >= l && elem[0:l] == "from-template/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							// Param: "template_id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "GET":
-									s.handleUsersUserIDGoalsGetRequest([1]string{
+								case "POST":
+									s.handleGoalsFromTemplateTemplateIDPostRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, "POST")
 								}
 
 								return
 							}
 
-						case 'i': // Prefix: "icon"
-
-							if l := len("icon"); len(elem) >= l && elem[0:l] == "icon" {
+							elem = origElem
+						case 'i': // Prefix: "invitations"
+							origElem := elem
+							if l := len("invitations"); len(elem) >= l && elem[0:l] == "invitations" {
 								elem = elem[l:]
 							} else {
 								break
@@ -608,28 +767,19 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "DELETE":
-									s.handleUsersUserIDIconDeleteRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
 								case "GET":
-									s.handleUsersUserIDIconGetRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleUsersUserIDIconPostRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+									s.handleGoalsInvitationsGetRequest([0]string{}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "DELETE,GET,POST")
+									s.notAllowed(w, r, "GET")
 								}
 
 								return
 							}
 
-						case 'p': // Prefix: "posts"
-
-							if l := len("posts"); len(elem) >= l && elem[0:l] == "posts" {
+							elem = origElem
+						case 'o': // Prefix: "order"
+							origElem := elem
+							if l := len("order"); len(elem) >= l && elem[0:l] == "order" {
 								elem = elem[l:]
 							} else {
 								break
@@ -638,126 +788,2206 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "GET":
-									s.handleUsersUserIDPostsGetRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleGoalsOrderPutRequest([0]string{}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, "PUT")
 								}
 
 								return
 							}
 
+							elem = origElem
+						}
+						// Param: "goal_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleGoalsGoalIDDeleteRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGoalsGoalIDGetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleGoalsGoalIDPutRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "analytics"
+
+								if l := len("analytics"); len(elem) >= l && elem[0:l] == "analytics" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGoalsGoalIDAnalyticsGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'p': // Prefix: "p"
+
+								if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "articipants"
+
+									if l := len("articipants"); len(elem) >= l && elem[0:l] == "articipants" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleGoalsGoalIDParticipantsGetRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleGoalsGoalIDParticipantsPostRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,POST")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'a': // Prefix: "accept"
+											origElem := elem
+											if l := len("accept"); len(elem) >= l && elem[0:l] == "accept" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleGoalsGoalIDParticipantsAcceptPostRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+											elem = origElem
+										}
+										// Param: "user_id"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[1] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleGoalsGoalIDParticipantsUserIDDeleteRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE")
+											}
+
+											return
+										}
+
+									}
+
+								case 'o': // Prefix: "osts"
+
+									if l := len("osts"); len(elem) >= l && elem[0:l] == "osts" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGoalsGoalIDPostsGetRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
 						}
 
 					}
 
 				}
 
-			}
+			case 'h': // Prefix: "hashtags/"
 
-		}
-	}
-	s.notFound(w, r)
-}
+				if l := len("hashtags/"); len(elem) >= l && elem[0:l] == "hashtags/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-// Route is route object.
-type Route struct {
-	name           string
-	summary        string
-	operationID    string
-	operationGroup string
-	pathPattern    string
-	count          int
-	args           [1]string
-}
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 't': // Prefix: "trending"
+					origElem := elem
+					if l := len("trending"); len(elem) >= l && elem[0:l] == "trending" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleHashtagsTrendingGetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
 
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
+						return
+					}
 
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
+					elem = origElem
+				}
+				// Param: "tag"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
 
-// OperationGroup returns the x-ogen-operation-group value.
-func (r Route) OperationGroup() string {
-	return r.operationGroup
-}
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/posts"
 
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
+					if l := len("/posts"); len(elem) >= l && elem[0:l] == "/posts" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleHashtagsTagPostsGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
 
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
+						return
+					}
 
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
 				}
-			}
-		}()
-	}
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+			case 'i': // Prefix: "images"
+
+				if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "POST":
+						s.handleImagesPostRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "image_id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleImagesImageIDGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
+
+			case 'n': // Prefix: "notifications"
+
+				if l := len("notifications"); len(elem) >= l && elem[0:l] == "notifications" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleNotificationsGetRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'p': // Prefix: "preferences"
+
+						if l := len("preferences"); len(elem) >= l && elem[0:l] == "preferences" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleNotificationsPreferencesGetRequest([0]string{}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleNotificationsPreferencesPutRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PUT")
+							}
+
+							return
+						}
+
+					case 'r': // Prefix: "read"
+
+						if l := len("read"); len(elem) >= l && elem[0:l] == "read" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleNotificationsReadPostRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'u': // Prefix: "unread-count"
+
+						if l := len("unread-count"); len(elem) >= l && elem[0:l] == "unread-count" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleNotificationsUnreadCountGetRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			case 'p': // Prefix: "posts"
+
+				if l := len("posts"); len(elem) >= l && elem[0:l] == "posts" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handlePostsGetRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handlePostsPostRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "post_id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "DELETE":
+							s.handlePostsPostIDDeleteRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handlePostsPostIDGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handlePostsPostIDPutRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET,PUT")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'b': // Prefix: "bookmark"
+
+							if l := len("bookmark"); len(elem) >= l && elem[0:l] == "bookmark" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handlePostsPostIDBookmarkDeleteRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handlePostsPostIDBookmarkPostRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,POST")
+								}
+
+								return
+							}
+
+						case 'c': // Prefix: "comments"
+
+							if l := len("comments"); len(elem) >= l && elem[0:l] == "comments" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handlePostsPostIDCommentsGetRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handlePostsPostIDCommentsPostRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,POST")
+								}
+
+								return
+							}
+
+						case 'r': // Prefix: "re"
+
+							if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "actions"
+
+								if l := len("actions"); len(elem) >= l && elem[0:l] == "actions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handlePostsPostIDReactionsDeleteRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handlePostsPostIDReactionsGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handlePostsPostIDReactionsPostRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,GET,POST")
+									}
+
+									return
+								}
+
+							case 'p': // Prefix: "post"
+
+								if l := len("post"); len(elem) >= l && elem[0:l] == "post" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handlePostsPostIDRepostDeleteRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handlePostsPostIDRepostPostRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,POST")
+									}
+
+									return
+								}
+
+							case 'v': // Prefix: "visions"
+
+								if l := len("visions"); len(elem) >= l && elem[0:l] == "visions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handlePostsPostIDRevisionsGetRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "revision"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/restore"
+
+										if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handlePostsPostIDRevisionsRevisionRestorePostRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'r': // Prefix: "re"
+
+				if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "actions/kinds"
+
+					if l := len("actions/kinds"); len(elem) >= l && elem[0:l] == "actions/kinds" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleReactionsKindsGetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 'p': // Prefix: "ports"
+
+					if l := len("ports"); len(elem) >= l && elem[0:l] == "ports" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleReportsPostRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+				}
+
+			case 's': // Prefix: "search"
+
+				if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleSearchGetRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+			case 't': // Prefix: "timeline"
+
+				if l := len("timeline"); len(elem) >= l && elem[0:l] == "timeline" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleTimelineGetRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/discover"
+
+					if l := len("/discover"); len(elem) >= l && elem[0:l] == "/discover" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleTimelineDiscoverGetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
+
+			case 'u': // Prefix: "users"
+
+				if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "POST":
+						s.handleUsersPostRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "user_id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "DELETE":
+							s.handleUsersUserIDDeleteRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleUsersUserIDGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleUsersUserIDPutRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET,PUT")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'f': // Prefix: "friends"
+
+							if l := len("friends"); len(elem) >= l && elem[0:l] == "friends" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleUsersUserIDFriendsGetRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 'g': // Prefix: "goals"
+
+							if l := len("goals"); len(elem) >= l && elem[0:l] == "goals" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleUsersUserIDGoalsGetRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 'i': // Prefix: "icon"
+
+							if l := len("icon"); len(elem) >= l && elem[0:l] == "icon" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleUsersUserIDIconDeleteRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleUsersUserIDIconGetRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleUsersUserIDIconPostRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,POST")
+								}
+
+								return
+							}
+
+						case 'p': // Prefix: "posts"
+
+							if l := len("posts"); len(elem) >= l && elem[0:l] == "posts" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleUsersUserIDPostsGetRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name           string
+	summary        string
+	operationID    string
+	operationGroup string
+	pathPattern    string
+	count          int
+	args           [2]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// OperationGroup returns the x-ogen-operation-group value.
+func (r Route) OperationGroup() string {
+	return r.operationGroup
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "a"
+
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dmin/"
+
+					if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'm': // Prefix: "moderation/actions"
+
+						if l := len("moderation/actions"); len(elem) >= l && elem[0:l] == "moderation/actions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = AdminModerationActionsGetOperation
+								r.summary = "モデレーションの監査ログ取得（モデレーター）"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/admin/moderation/actions"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = AdminModerationActionsPostOperation
+								r.summary = "投稿・コメント・ユーザーへの措置（モデレーター）"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/admin/moderation/actions"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'r': // Prefix: "reports"
+
+						if l := len("reports"); len(elem) >= l && elem[0:l] == "reports" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = AdminReportsGetOperation
+								r.summary = "報告の一覧取得（モデレーター）"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/admin/reports"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "report_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "claim"
+
+									if l := len("claim"); len(elem) >= l && elem[0:l] == "claim" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = AdminReportsReportIDClaimPostOperation
+											r.summary = "報告の担当になる（モデレーター）"
+											r.operationID = ""
+											r.operationGroup = ""
+											r.pathPattern = "/admin/reports/{report_id}/claim"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "resolve"
+
+									if l := len("resolve"); len(elem) >= l && elem[0:l] == "resolve" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = AdminReportsReportIDResolvePostOperation
+											r.summary = "報告を対応済みにする（モデレーター）"
+											r.operationID = ""
+											r.operationGroup = ""
+											r.pathPattern = "/admin/reports/{report_id}/resolve"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					case 't': // Prefix: "templates"
+
+						if l := len("templates"); len(elem) >= l && elem[0:l] == "templates" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								r.name = AdminTemplatesPostOperation
+								r.summary = "目標テンプレート作成（管理者）"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/admin/templates"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "template_id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = AdminTemplatesTemplateIDDeleteOperation
+									r.summary = "目標テンプレート削除（管理者）"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/admin/templates/{template_id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = AdminTemplatesTemplateIDPutOperation
+									r.summary = "目標テンプレート更新（管理者）"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/admin/templates/{template_id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				case 'u': // Prefix: "uth/"
+
+					if l := len("uth/"); len(elem) >= l && elem[0:l] == "uth/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "callback"
+
+						if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = AuthCallbackGetOperation
+								r.summary = "OIDCコールバック（コードをトークンに交換）"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/auth/callback"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'l': // Prefix: "log"
+
+						if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'i': // Prefix: "in"
+
+							if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = AuthLoginGetOperation
+									r.summary = "OIDCログインを開始"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/auth/login"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'o': // Prefix: "out"
+
+							if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = AuthLogoutPostOperation
+									r.summary = "ログアウト"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/auth/logout"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'm': // Prefix: "me"
+
+						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = AuthMeGetOperation
+								r.summary = "現在のユーザー情報を取得"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/auth/me"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
+			case 'b': // Prefix: "b"
+
+				if l := len("b"); len(elem) >= l && elem[0:l] == "b" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'l': // Prefix: "locks"
+
+					if l := len("locks"); len(elem) >= l && elem[0:l] == "locks" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = BlocksGetOperation
+							r.summary = "自分がブロックしているユーザー一覧取得"
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/blocks"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = BlocksPostOperation
+							r.summary = "ユーザーをブロック"
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/blocks"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "user_id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = BlocksUserIDDeleteOperation
+								r.summary = "ブロック解除"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/blocks/{user_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'o': // Prefix: "ookmarks"
+
+					if l := len("ookmarks"); len(elem) >= l && elem[0:l] == "ookmarks" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = BookmarksGetOperation
+							r.summary = "自分のブックマーク一覧取得"
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/bookmarks"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/collections"
+
+						if l := len("/collections"); len(elem) >= l && elem[0:l] == "/collections" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = BookmarksCollectionsGetOperation
+								r.summary = "自分のブックマークコレクション一覧取得"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/bookmarks/collections"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = BookmarksCollectionsPostOperation
+								r.summary = "ブックマークコレクション作成"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/bookmarks/collections"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "collection_id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = BookmarksCollectionsCollectionIDDeleteOperation
+									r.summary = "ブックマークコレクション削除"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/bookmarks/collections/{collection_id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = BookmarksCollectionsCollectionIDPutOperation
+									r.summary = "ブックマークコレクション名の変更"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/bookmarks/collections/{collection_id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'c': // Prefix: "comments/"
+
+				if l := len("comments/"); len(elem) >= l && elem[0:l] == "comments/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "comment_id"
+				// Leaf parameter, slashes are prohibited
+				idx := strings.IndexByte(elem, '/')
+				if idx >= 0 {
+					break
+				}
+				args[0] = elem
+				elem = ""
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "DELETE":
+						r.name = CommentsCommentIDDeleteOperation
+						r.summary = "コメント削除"
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/comments/{comment_id}"
+						r.args = args
+						r.count = 1
+						return r, true
+					case "PUT":
+						r.name = CommentsCommentIDPutOperation
+						r.summary = "コメント編集"
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/comments/{comment_id}"
+						r.args = args
+						r.count = 1
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'f': // Prefix: "friends"
+
+				if l := len("friends"); len(elem) >= l && elem[0:l] == "friends" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = FriendsGetOperation
+						r.summary = "自分のフレンド（フォロー）一覧取得"
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/friends"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = FriendsPostOperation
+						r.summary = "フレンド追加（フォロー）"
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/friends"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "user_id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = FriendsUserIDDeleteOperation
+							r.summary = "フレンド削除（フォロー解除）"
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/friends/{user_id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'g': // Prefix: "g"
+
+				if l := len("g"); len(elem) >= l && elem[0:l] == "g" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "enres"
+
+					if l := len("enres"); len(elem) >= l && elem[0:l] == "enres" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GenresGetOperation
+							r.summary = "利用可能なジャンル一覧取得"
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/genres"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "genre_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/templates"
+
+							if l := len("/templates"); len(elem) >= l && elem[0:l] == "/templates" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GenresGenreIDTemplatesGetOperation
+									r.summary = "ジャンル別の目標テンプレート一覧取得"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/genres/{genre_id}/templates"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				case 'o': // Prefix: "oals"
+
+					if l := len("oals"); len(elem) >= l && elem[0:l] == "oals" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GoalsGetOperation
+							r.summary = "現在のユーザーの目標一覧取得"
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/goals"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = GoalsPostOperation
+							r.summary = "新規目標作成"
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/goals"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'f': // Prefix: "from-template/"
+							origElem := elem
+							if l := len("from-template/"); len(elem) >= l && elem[0:l] == "from-template/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "template_id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = GoalsFromTemplateTemplateIDPostOperation
+									r.summary = "テンプレートから目標を作成"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/goals/from-template/{template_id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'i': // Prefix: "invitations"
+							origElem := elem
+							if l := len("invitations"); len(elem) >= l && elem[0:l] == "invitations" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GoalsInvitationsGetOperation
+									r.summary = "自分宛ての目標への招待一覧取得"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/goals/invitations"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'o': // Prefix: "order"
+							origElem := elem
+							if l := len("order"); len(elem) >= l && elem[0:l] == "order" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "PUT":
+									r.name = GoalsOrderPutOperation
+									r.summary = "目標の並び順を更新"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/goals/order"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+						// Param: "goal_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = GoalsGoalIDDeleteOperation
+								r.summary = "目標削除"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/goals/{goal_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GoalsGoalIDGetOperation
+								r.summary = "目標詳細取得"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/goals/{goal_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = GoalsGoalIDPutOperation
+								r.summary = "目標更新"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/goals/{goal_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "analytics"
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
+								if l := len("analytics"); len(elem) >= l && elem[0:l] == "analytics" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GoalsGoalIDAnalyticsGetOperation
+										r.summary = "目標の進捗分析取得"
+										r.operationID = ""
+										r.operationGroup = ""
+										r.pathPattern = "/goals/{goal_id}/analytics"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "auth/"
+							case 'p': // Prefix: "p"
+
+								if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "articipants"
+
+									if l := len("articipants"); len(elem) >= l && elem[0:l] == "articipants" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GoalsGoalIDParticipantsGetOperation
+											r.summary = "目標の参加者一覧取得"
+											r.operationID = ""
+											r.operationGroup = ""
+											r.pathPattern = "/goals/{goal_id}/participants"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = GoalsGoalIDParticipantsPostOperation
+											r.summary = "目標にユーザーを招待"
+											r.operationID = ""
+											r.operationGroup = ""
+											r.pathPattern = "/goals/{goal_id}/participants"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'a': // Prefix: "accept"
+											origElem := elem
+											if l := len("accept"); len(elem) >= l && elem[0:l] == "accept" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = GoalsGoalIDParticipantsAcceptPostOperation
+													r.summary = "目標への招待を承認"
+													r.operationID = ""
+													r.operationGroup = ""
+													r.pathPattern = "/goals/{goal_id}/participants/accept"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										}
+										// Param: "user_id"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[1] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = GoalsGoalIDParticipantsUserIDDeleteOperation
+												r.summary = "目標の参加者を削除"
+												r.operationID = ""
+												r.operationGroup = ""
+												r.pathPattern = "/goals/{goal_id}/participants/{user_id}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'o': // Prefix: "osts"
+
+									if l := len("osts"); len(elem) >= l && elem[0:l] == "osts" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GoalsGoalIDPostsGetOperation
+											r.summary = "目標の投稿フィード取得"
+											r.operationID = ""
+											r.operationGroup = ""
+											r.pathPattern = "/goals/{goal_id}/posts"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
 
-				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'h': // Prefix: "hashtags/"
+
+				if l := len("hashtags/"); len(elem) >= l && elem[0:l] == "hashtags/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -767,9 +2997,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'c': // Prefix: "callback"
-
-					if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+				case 't': // Prefix: "trending"
+					origElem := elem
+					if l := len("trending"); len(elem) >= l && elem[0:l] == "trending" {
 						elem = elem[l:]
 					} else {
 						break
@@ -779,11 +3009,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						// Leaf node.
 						switch method {
 						case "GET":
-							r.name = AuthCallbackGetOperation
-							r.summary = "OIDCコールバック（コードをトークンに交換）"
+							r.name = HashtagsTrendingGetOperation
+							r.summary = "トレンドのハッシュタグ取得"
 							r.operationID = ""
 							r.operationGroup = ""
-							r.pathPattern = "/auth/callback"
+							r.pathPattern = "/hashtags/trending"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -792,73 +3022,24 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 
-				case 'l': // Prefix: "log"
-
-					if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'i': // Prefix: "in"
-
-						if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = AuthLoginGetOperation
-								r.summary = "OIDCログインを開始"
-								r.operationID = ""
-								r.operationGroup = ""
-								r.pathPattern = "/auth/login"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
-
-					case 'o': // Prefix: "out"
-
-						if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = AuthLogoutPostOperation
-								r.summary = "ログアウト"
-								r.operationID = ""
-								r.operationGroup = ""
-								r.pathPattern = "/auth/logout"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
-
-					}
+					elem = origElem
+				}
+				// Param: "tag"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
 
-				case 'm': // Prefix: "me"
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/posts"
 
-					if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+					if l := len("/posts"); len(elem) >= l && elem[0:l] == "/posts" {
 						elem = elem[l:]
 					} else {
 						break
@@ -868,13 +3049,13 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						// Leaf node.
 						switch method {
 						case "GET":
-							r.name = AuthMeGetOperation
-							r.summary = "現在のユーザー情報を取得"
+							r.name = HashtagsTagPostsGetOperation
+							r.summary = "ハッシュタグの付いた投稿一覧取得"
 							r.operationID = ""
 							r.operationGroup = ""
-							r.pathPattern = "/auth/me"
+							r.pathPattern = "/hashtags/{tag}/posts"
 							r.args = args
-							r.count = 0
+							r.count = 1
 							return r, true
 						default:
 							return
@@ -883,9 +3064,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
-			case 'f': // Prefix: "friends"
+			case 'i': // Prefix: "images"
 
-				if l := len("friends"); len(elem) >= l && elem[0:l] == "friends" {
+				if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
 					elem = elem[l:]
 				} else {
 					break
@@ -893,21 +3074,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				if len(elem) == 0 {
 					switch method {
-					case "GET":
-						r.name = FriendsGetOperation
-						r.summary = "自分のフレンド（フォロー）一覧取得"
-						r.operationID = ""
-						r.operationGroup = ""
-						r.pathPattern = "/friends"
-						r.args = args
-						r.count = 0
-						return r, true
 					case "POST":
-						r.name = FriendsPostOperation
-						r.summary = "フレンド追加（フォロー）"
+						r.name = ImagesPostOperation
+						r.summary = "画像アップロード"
 						r.operationID = ""
 						r.operationGroup = ""
-						r.pathPattern = "/friends"
+						r.pathPattern = "/images"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -924,7 +3096,7 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 
-					// Param: "user_id"
+					// Param: "image_id"
 					// Leaf parameter, slashes are prohibited
 					idx := strings.IndexByte(elem, '/')
 					if idx >= 0 {
@@ -936,12 +3108,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						// Leaf node.
 						switch method {
-						case "DELETE":
-							r.name = FriendsUserIDDeleteOperation
-							r.summary = "フレンド削除（フォロー解除）"
+						case "GET":
+							r.name = ImagesImageIDGetOperation
+							r.summary = "画像取得"
 							r.operationID = ""
 							r.operationGroup = ""
-							r.pathPattern = "/friends/{user_id}"
+							r.pathPattern = "/images/{image_id}"
 							r.args = args
 							r.count = 1
 							return r, true
@@ -952,188 +3124,126 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
-			case 'g': // Prefix: "g"
+			case 'n': // Prefix: "notifications"
 
-				if l := len("g"); len(elem) >= l && elem[0:l] == "g" {
+				if l := len("notifications"); len(elem) >= l && elem[0:l] == "notifications" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					break
+					switch method {
+					case "GET":
+						r.name = NotificationsGetOperation
+						r.summary = "自分の通知一覧取得"
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/notifications"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
 				}
 				switch elem[0] {
-				case 'e': // Prefix: "enres"
+				case '/': // Prefix: "/"
 
-					if l := len("enres"); len(elem) >= l && elem[0:l] == "enres" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "GET":
-							r.name = GenresGetOperation
-							r.summary = "利用可能なジャンル一覧取得"
-							r.operationID = ""
-							r.operationGroup = ""
-							r.pathPattern = "/genres"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-
-				case 'o': // Prefix: "oals"
-
-					if l := len("oals"); len(elem) >= l && elem[0:l] == "oals" {
-						elem = elem[l:]
-					} else {
 						break
 					}
-
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = GoalsGetOperation
-							r.summary = "現在のユーザーの目標一覧取得"
-							r.operationID = ""
-							r.operationGroup = ""
-							r.pathPattern = "/goals"
-							r.args = args
-							r.count = 0
-							return r, true
-						case "POST":
-							r.name = GoalsPostOperation
-							r.summary = "新規目標作成"
-							r.operationID = ""
-							r.operationGroup = ""
-							r.pathPattern = "/goals"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case 'p': // Prefix: "preferences"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("preferences"); len(elem) >= l && elem[0:l] == "preferences" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "goal_id"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
-
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "DELETE":
-								r.name = GoalsGoalIDDeleteOperation
-								r.summary = "目標削除"
-								r.operationID = ""
-								r.operationGroup = ""
-								r.pathPattern = "/goals/{goal_id}"
-								r.args = args
-								r.count = 1
-								return r, true
 							case "GET":
-								r.name = GoalsGoalIDGetOperation
-								r.summary = "目標詳細取得"
+								r.name = NotificationsPreferencesGetOperation
+								r.summary = "通知の設定取得"
 								r.operationID = ""
 								r.operationGroup = ""
-								r.pathPattern = "/goals/{goal_id}"
+								r.pathPattern = "/notifications/preferences"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							case "PUT":
-								r.name = GoalsGoalIDPutOperation
-								r.summary = "目標更新"
+								r.name = NotificationsPreferencesPutOperation
+								r.summary = "通知の設定更新"
 								r.operationID = ""
 								r.operationGroup = ""
-								r.pathPattern = "/goals/{goal_id}"
+								r.pathPattern = "/notifications/preferences"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
 							}
 						}
 
-					}
-
-				}
-
-			case 'i': // Prefix: "images"
+					case 'r': // Prefix: "read"
 
-				if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						if l := len("read"); len(elem) >= l && elem[0:l] == "read" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-				if len(elem) == 0 {
-					switch method {
-					case "POST":
-						r.name = ImagesPostOperation
-						r.summary = "画像アップロード"
-						r.operationID = ""
-						r.operationGroup = ""
-						r.pathPattern = "/images"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = NotificationsReadPostOperation
+								r.summary = "通知を既読にする"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/notifications/read"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+					case 'u': // Prefix: "unread-count"
 
-					// Param: "image_id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
+						if l := len("unread-count"); len(elem) >= l && elem[0:l] == "unread-count" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "GET":
-							r.name = ImagesImageIDGetOperation
-							r.summary = "画像取得"
-							r.operationID = ""
-							r.operationGroup = ""
-							r.pathPattern = "/images/{image_id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = NotificationsUnreadCountGetOperation
+								r.summary = "未読の通知の件数取得"
+								r.operationID = ""
+								r.operationGroup = ""
+								r.pathPattern = "/notifications/unread-count"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
+
 					}
 
 				}
@@ -1222,53 +3332,346 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/reactions"
+					case '/': // Prefix: "/"
 
-						if l := len("/reactions"); len(elem) >= l && elem[0:l] == "/reactions" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "DELETE":
-								r.name = PostsPostIDReactionsDeleteOperation
-								r.summary = "投稿からリアクションを削除"
-								r.operationID = ""
-								r.operationGroup = ""
-								r.pathPattern = "/posts/{post_id}/reactions"
-								r.args = args
-								r.count = 1
-								return r, true
-							case "GET":
-								r.name = PostsPostIDReactionsGetOperation
-								r.summary = "投稿にリアクションしたユーザーの一覧取得"
-								r.operationID = ""
-								r.operationGroup = ""
-								r.pathPattern = "/posts/{post_id}/reactions"
-								r.args = args
-								r.count = 1
-								return r, true
-							case "POST":
-								r.name = PostsPostIDReactionsPostOperation
-								r.summary = "投稿にリアクション（いいね）を追加"
-								r.operationID = ""
-								r.operationGroup = ""
-								r.pathPattern = "/posts/{post_id}/reactions"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
+							break
+						}
+						switch elem[0] {
+						case 'b': // Prefix: "bookmark"
+
+							if l := len("bookmark"); len(elem) >= l && elem[0:l] == "bookmark" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = PostsPostIDBookmarkDeleteOperation
+									r.summary = "ブックマーク解除"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/posts/{post_id}/bookmark"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "POST":
+									r.name = PostsPostIDBookmarkPostOperation
+									r.summary = "投稿をブックマーク"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/posts/{post_id}/bookmark"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'c': // Prefix: "comments"
+
+							if l := len("comments"); len(elem) >= l && elem[0:l] == "comments" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = PostsPostIDCommentsGetOperation
+									r.summary = "投稿のコメント一覧取得"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/posts/{post_id}/comments"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "POST":
+									r.name = PostsPostIDCommentsPostOperation
+									r.summary = "投稿にコメント"
+									r.operationID = ""
+									r.operationGroup = ""
+									r.pathPattern = "/posts/{post_id}/comments"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'r': // Prefix: "re"
+
+							if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "actions"
+
+								if l := len("actions"); len(elem) >= l && elem[0:l] == "actions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = PostsPostIDReactionsDeleteOperation
+										r.summary = "投稿からリアクションを削除"
+										r.operationID = ""
+										r.operationGroup = ""
+										r.pathPattern = "/posts/{post_id}/reactions"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "GET":
+										r.name = PostsPostIDReactionsGetOperation
+										r.summary = "投稿にリアクションしたユーザーの一覧取得"
+										r.operationID = ""
+										r.operationGroup = ""
+										r.pathPattern = "/posts/{post_id}/reactions"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = PostsPostIDReactionsPostOperation
+										r.summary = "投稿にリアクションを追加"
+										r.operationID = ""
+										r.operationGroup = ""
+										r.pathPattern = "/posts/{post_id}/reactions"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'p': // Prefix: "post"
+
+								if l := len("post"); len(elem) >= l && elem[0:l] == "post" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = PostsPostIDRepostDeleteOperation
+										r.summary = "リポストを取り消し"
+										r.operationID = ""
+										r.operationGroup = ""
+										r.pathPattern = "/posts/{post_id}/repost"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = PostsPostIDRepostPostOperation
+										r.summary = "投稿をリポスト"
+										r.operationID = ""
+										r.operationGroup = ""
+										r.pathPattern = "/posts/{post_id}/repost"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'v': // Prefix: "visions"
+
+								if l := len("visions"); len(elem) >= l && elem[0:l] == "visions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = PostsPostIDRevisionsGetOperation
+										r.summary = "投稿の編集履歴取得"
+										r.operationID = ""
+										r.operationGroup = ""
+										r.pathPattern = "/posts/{post_id}/revisions"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "revision"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/restore"
+
+										if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = PostsPostIDRevisionsRevisionRestorePostOperation
+												r.summary = "投稿を以前の版に戻す"
+												r.operationID = ""
+												r.operationGroup = ""
+												r.pathPattern = "/posts/{post_id}/revisions/{revision}/restore"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
 							}
+
+						}
+
+					}
+
+				}
+
+			case 'r': // Prefix: "re"
+
+				if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "actions/kinds"
+
+					if l := len("actions/kinds"); len(elem) >= l && elem[0:l] == "actions/kinds" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = ReactionsKindsGetOperation
+							r.summary = "使用できるリアクションの種類一覧取得"
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/reactions/kinds"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
 						}
+					}
+
+				case 'p': // Prefix: "ports"
+
+					if l := len("ports"); len(elem) >= l && elem[0:l] == "ports" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = ReportsPostOperation
+							r.summary = "投稿・コメント・プロフィールを運営に報告"
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/reports"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
 					}
 
 				}
 
+			case 's': // Prefix: "search"
+
+				if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = SearchGetOperation
+						r.summary = "投稿・目標の全文検索"
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/search"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
 			case 't': // Prefix: "timeline"
 
 				if l := len("timeline"); len(elem) >= l && elem[0:l] == "timeline" {
@@ -1278,7 +3681,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch method {
 					case "GET":
 						r.name = TimelineGetOperation
@@ -1293,6 +3695,33 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						return
 					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/discover"
+
+					if l := len("/discover"); len(elem) >= l && elem[0:l] == "/discover" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = TimelineDiscoverGetOperation
+							r.summary = "おすすめフィード取得"
+							r.operationID = ""
+							r.operationGroup = ""
+							r.pathPattern = "/timeline/discover"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
 
 			case 'u': // Prefix: "users"
 
@@ -7,6 +7,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/go-faster/errors"
 	"github.com/google/uuid"
 	ht "github.com/ogen-go/ogen/http"
 )
@@ -15,6 +16,246 @@ func (s *GeneralErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+type AdminModerationActionsGetBadRequest Error
+
+func (*AdminModerationActionsGetBadRequest) adminModerationActionsGetRes() {}
+
+type AdminModerationActionsGetForbidden Error
+
+func (*AdminModerationActionsGetForbidden) adminModerationActionsGetRes() {}
+
+// AdminModerationActionsGetOKHeaders wraps []ModerationAction with response headers.
+type AdminModerationActionsGetOKHeaders struct {
+	Link     OptString
+	Response []ModerationAction
+}
+
+// GetLink returns the value of Link.
+func (s *AdminModerationActionsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *AdminModerationActionsGetOKHeaders) GetResponse() []ModerationAction {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *AdminModerationActionsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *AdminModerationActionsGetOKHeaders) SetResponse(val []ModerationAction) {
+	s.Response = val
+}
+
+func (*AdminModerationActionsGetOKHeaders) adminModerationActionsGetRes() {}
+
+type AdminModerationActionsGetUnauthorized Error
+
+func (*AdminModerationActionsGetUnauthorized) adminModerationActionsGetRes() {}
+
+type AdminModerationActionsPostBadRequest Error
+
+func (*AdminModerationActionsPostBadRequest) adminModerationActionsPostRes() {}
+
+type AdminModerationActionsPostForbidden Error
+
+func (*AdminModerationActionsPostForbidden) adminModerationActionsPostRes() {}
+
+type AdminModerationActionsPostNotFound Error
+
+func (*AdminModerationActionsPostNotFound) adminModerationActionsPostRes() {}
+
+type AdminModerationActionsPostUnauthorized Error
+
+func (*AdminModerationActionsPostUnauthorized) adminModerationActionsPostRes() {}
+
+type AdminReportsGetBadRequest Error
+
+func (*AdminReportsGetBadRequest) adminReportsGetRes() {}
+
+type AdminReportsGetForbidden Error
+
+func (*AdminReportsGetForbidden) adminReportsGetRes() {}
+
+// AdminReportsGetOKHeaders wraps []Report with response headers.
+type AdminReportsGetOKHeaders struct {
+	Link     OptString
+	Response []Report
+}
+
+// GetLink returns the value of Link.
+func (s *AdminReportsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *AdminReportsGetOKHeaders) GetResponse() []Report {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *AdminReportsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *AdminReportsGetOKHeaders) SetResponse(val []Report) {
+	s.Response = val
+}
+
+func (*AdminReportsGetOKHeaders) adminReportsGetRes() {}
+
+type AdminReportsGetUnauthorized Error
+
+func (*AdminReportsGetUnauthorized) adminReportsGetRes() {}
+
+type AdminReportsReportIDClaimPostConflict Error
+
+func (*AdminReportsReportIDClaimPostConflict) adminReportsReportIDClaimPostRes() {}
+
+type AdminReportsReportIDClaimPostForbidden Error
+
+func (*AdminReportsReportIDClaimPostForbidden) adminReportsReportIDClaimPostRes() {}
+
+type AdminReportsReportIDClaimPostNotFound Error
+
+func (*AdminReportsReportIDClaimPostNotFound) adminReportsReportIDClaimPostRes() {}
+
+type AdminReportsReportIDClaimPostUnauthorized Error
+
+func (*AdminReportsReportIDClaimPostUnauthorized) adminReportsReportIDClaimPostRes() {}
+
+type AdminReportsReportIDResolvePostBadRequest Error
+
+func (*AdminReportsReportIDResolvePostBadRequest) adminReportsReportIDResolvePostRes() {}
+
+type AdminReportsReportIDResolvePostConflict Error
+
+func (*AdminReportsReportIDResolvePostConflict) adminReportsReportIDResolvePostRes() {}
+
+type AdminReportsReportIDResolvePostForbidden Error
+
+func (*AdminReportsReportIDResolvePostForbidden) adminReportsReportIDResolvePostRes() {}
+
+type AdminReportsReportIDResolvePostNotFound Error
+
+func (*AdminReportsReportIDResolvePostNotFound) adminReportsReportIDResolvePostRes() {}
+
+type AdminReportsReportIDResolvePostUnauthorized Error
+
+func (*AdminReportsReportIDResolvePostUnauthorized) adminReportsReportIDResolvePostRes() {}
+
+type AdminTemplatesPostBadRequest Error
+
+func (*AdminTemplatesPostBadRequest) adminTemplatesPostRes() {}
+
+type AdminTemplatesPostForbidden Error
+
+func (*AdminTemplatesPostForbidden) adminTemplatesPostRes() {}
+
+type AdminTemplatesPostUnauthorized Error
+
+func (*AdminTemplatesPostUnauthorized) adminTemplatesPostRes() {}
+
+type AdminTemplatesTemplateIDDeleteForbidden Error
+
+func (*AdminTemplatesTemplateIDDeleteForbidden) adminTemplatesTemplateIDDeleteRes() {}
+
+// AdminTemplatesTemplateIDDeleteNoContent is response for AdminTemplatesTemplateIDDelete operation.
+type AdminTemplatesTemplateIDDeleteNoContent struct{}
+
+func (*AdminTemplatesTemplateIDDeleteNoContent) adminTemplatesTemplateIDDeleteRes() {}
+
+type AdminTemplatesTemplateIDDeleteNotFound Error
+
+func (*AdminTemplatesTemplateIDDeleteNotFound) adminTemplatesTemplateIDDeleteRes() {}
+
+type AdminTemplatesTemplateIDDeleteUnauthorized Error
+
+func (*AdminTemplatesTemplateIDDeleteUnauthorized) adminTemplatesTemplateIDDeleteRes() {}
+
+type AdminTemplatesTemplateIDPutBadRequest Error
+
+func (*AdminTemplatesTemplateIDPutBadRequest) adminTemplatesTemplateIDPutRes() {}
+
+type AdminTemplatesTemplateIDPutForbidden Error
+
+func (*AdminTemplatesTemplateIDPutForbidden) adminTemplatesTemplateIDPutRes() {}
+
+type AdminTemplatesTemplateIDPutNotFound Error
+
+func (*AdminTemplatesTemplateIDPutNotFound) adminTemplatesTemplateIDPutRes() {}
+
+type AdminTemplatesTemplateIDPutUnauthorized Error
+
+func (*AdminTemplatesTemplateIDPutUnauthorized) adminTemplatesTemplateIDPutRes() {}
+
+// Ref: #/components/schemas/AnalyticsDay
+type AnalyticsDay struct {
+	Date      time.Time `json:"date"`
+	PostCount int       `json:"post_count"`
+	Amount    float64   `json:"amount"`
+}
+
+// GetDate returns the value of Date.
+func (s *AnalyticsDay) GetDate() time.Time {
+	return s.Date
+}
+
+// GetPostCount returns the value of PostCount.
+func (s *AnalyticsDay) GetPostCount() int {
+	return s.PostCount
+}
+
+// GetAmount returns the value of Amount.
+func (s *AnalyticsDay) GetAmount() float64 {
+	return s.Amount
+}
+
+// SetDate sets the value of Date.
+func (s *AnalyticsDay) SetDate(val time.Time) {
+	s.Date = val
+}
+
+// SetPostCount sets the value of PostCount.
+func (s *AnalyticsDay) SetPostCount(val int) {
+	s.PostCount = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *AnalyticsDay) SetAmount(val float64) {
+	s.Amount = val
+}
+
+// Ref: #/components/schemas/AnalyticsReactionDay
+type AnalyticsReactionDay struct {
+	Date  time.Time `json:"date"`
+	Count int       `json:"count"`
+}
+
+// GetDate returns the value of Date.
+func (s *AnalyticsReactionDay) GetDate() time.Time {
+	return s.Date
+}
+
+// GetCount returns the value of Count.
+func (s *AnalyticsReactionDay) GetCount() int {
+	return s.Count
+}
+
+// SetDate sets the value of Date.
+func (s *AnalyticsReactionDay) SetDate(val time.Time) {
+	s.Date = val
+}
+
+// SetCount sets the value of Count.
+func (s *AnalyticsReactionDay) SetCount(val int) {
+	s.Count = val
+}
+
 // AuthLoginGetMovedPermanently is response for AuthLoginGet operation.
 type AuthLoginGetMovedPermanently struct{}
 
@@ -97,896 +338,5867 @@ func (s *BearerAuth) SetRoles(val []string) {
 	s.Roles = val
 }
 
-// Ref: #/components/schemas/Error
-type Error struct {
-	Message string `json:"message"`
-}
-
-// GetMessage returns the value of Message.
-func (s *Error) GetMessage() string {
-	return s.Message
-}
-
-// SetMessage sets the value of Message.
-func (s *Error) SetMessage(val string) {
-	s.Message = val
-}
-
-func (*Error) authCallbackGetRes()         {}
-func (*Error) authMeGetRes()               {}
-func (*Error) friendsGetRes()              {}
-func (*Error) goalsGoalIDGetRes()          {}
-func (*Error) imagesImageIDGetRes()        {}
-func (*Error) postsPostIDGetRes()          {}
-func (*Error) postsPostIDReactionsGetRes() {}
-func (*Error) usersPostRes()               {}
-func (*Error) usersUserIDIconGetRes()      {}
-
-type FriendsGetOKApplicationJSON []uuid.UUID
+type BlocksGetOKApplicationJSON []uuid.UUID
 
-func (*FriendsGetOKApplicationJSON) friendsGetRes() {}
+func (*BlocksGetOKApplicationJSON) blocksGetRes() {}
 
-type FriendsPostBadRequest Error
+type BlocksPostBadRequest Error
 
-func (*FriendsPostBadRequest) friendsPostRes() {}
+func (*BlocksPostBadRequest) blocksPostRes() {}
 
-// FriendsPostCreated is response for FriendsPost operation.
-type FriendsPostCreated struct{}
+// BlocksPostCreated is response for BlocksPost operation.
+type BlocksPostCreated struct{}
 
-func (*FriendsPostCreated) friendsPostRes() {}
+func (*BlocksPostCreated) blocksPostRes() {}
 
-type FriendsPostNotFound Error
+type BlocksPostNotFound Error
 
-func (*FriendsPostNotFound) friendsPostRes() {}
+func (*BlocksPostNotFound) blocksPostRes() {}
 
-type FriendsPostReq struct {
+type BlocksPostReq struct {
 	// 対象ユーザーID.
 	UserID uuid.UUID `json:"user_id"`
 }
 
 // GetUserID returns the value of UserID.
-func (s *FriendsPostReq) GetUserID() uuid.UUID {
+func (s *BlocksPostReq) GetUserID() uuid.UUID {
 	return s.UserID
 }
 
 // SetUserID sets the value of UserID.
-func (s *FriendsPostReq) SetUserID(val uuid.UUID) {
+func (s *BlocksPostReq) SetUserID(val uuid.UUID) {
 	s.UserID = val
 }
 
-type FriendsPostUnauthorized Error
+type BlocksPostUnauthorized Error
 
-func (*FriendsPostUnauthorized) friendsPostRes() {}
+func (*BlocksPostUnauthorized) blocksPostRes() {}
 
-// FriendsUserIDDeleteNoContent is response for FriendsUserIDDelete operation.
-type FriendsUserIDDeleteNoContent struct{}
+// BlocksUserIDDeleteNoContent is response for BlocksUserIDDelete operation.
+type BlocksUserIDDeleteNoContent struct{}
 
-func (*FriendsUserIDDeleteNoContent) friendsUserIDDeleteRes() {}
+func (*BlocksUserIDDeleteNoContent) blocksUserIDDeleteRes() {}
 
-type FriendsUserIDDeleteNotFound Error
+type BlocksUserIDDeleteNotFound Error
 
-func (*FriendsUserIDDeleteNotFound) friendsUserIDDeleteRes() {}
+func (*BlocksUserIDDeleteNotFound) blocksUserIDDeleteRes() {}
 
-type FriendsUserIDDeleteUnauthorized Error
+type BlocksUserIDDeleteUnauthorized Error
 
-func (*FriendsUserIDDeleteUnauthorized) friendsUserIDDeleteRes() {}
+func (*BlocksUserIDDeleteUnauthorized) blocksUserIDDeleteRes() {}
 
-// GeneralErrorStatusCode wraps Error with StatusCode.
-type GeneralErrorStatusCode struct {
-	StatusCode int
-	Response   Error
+// Ref: #/components/schemas/Bookmark
+type Bookmark struct {
+	ID uuid.UUID `json:"id"`
+	// 保存先のコレクションID（未分類の場合は省略）.
+	CollectionID OptUUID   `json:"collection_id"`
+	Post         Post      `json:"post"`
+	CreatedAt    time.Time `json:"created_at"`
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *GeneralErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// GetID returns the value of ID.
+func (s *Bookmark) GetID() uuid.UUID {
+	return s.ID
 }
 
-// GetResponse returns the value of Response.
-func (s *GeneralErrorStatusCode) GetResponse() Error {
-	return s.Response
+// GetCollectionID returns the value of CollectionID.
+func (s *Bookmark) GetCollectionID() OptUUID {
+	return s.CollectionID
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *GeneralErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// GetPost returns the value of Post.
+func (s *Bookmark) GetPost() Post {
+	return s.Post
 }
 
-// SetResponse sets the value of Response.
-func (s *GeneralErrorStatusCode) SetResponse(val Error) {
-	s.Response = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Bookmark) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// Ref: #/components/schemas/Genre
-type Genre struct {
-	ID   uuid.UUID `json:"id"`
-	Name string    `json:"name"`
+// SetID sets the value of ID.
+func (s *Bookmark) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// GetID returns the value of ID.
-func (s *Genre) GetID() uuid.UUID {
-	return s.ID
+// SetCollectionID sets the value of CollectionID.
+func (s *Bookmark) SetCollectionID(val OptUUID) {
+	s.CollectionID = val
 }
 
-// GetName returns the value of Name.
-func (s *Genre) GetName() string {
-	return s.Name
+// SetPost sets the value of Post.
+func (s *Bookmark) SetPost(val Post) {
+	s.Post = val
 }
 
-// SetID sets the value of ID.
-func (s *Genre) SetID(val uuid.UUID) {
-	s.ID = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Bookmark) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// SetName sets the value of Name.
-func (s *Genre) SetName(val string) {
-	s.Name = val
-}
+func (*Bookmark) postsPostIDBookmarkPostRes() {}
 
-// Ref: #/components/schemas/Goal
-type Goal struct {
-	ID        uuid.UUID `json:"id"`
-	UserID    uuid.UUID `json:"user_id"`
-	Title     string    `json:"title"`
-	CreatedAt time.Time `json:"created_at"`
-	Deadline  OptDate   `json:"deadline"`
+// Ref: #/components/schemas/BookmarkCollection
+type BookmarkCollection struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// コレクション内の閲覧できる投稿のブックマーク数.
+	BookmarkCount int       `json:"bookmark_count"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
 // GetID returns the value of ID.
-func (s *Goal) GetID() uuid.UUID {
+func (s *BookmarkCollection) GetID() uuid.UUID {
 	return s.ID
 }
 
-// GetUserID returns the value of UserID.
-func (s *Goal) GetUserID() uuid.UUID {
-	return s.UserID
+// GetName returns the value of Name.
+func (s *BookmarkCollection) GetName() string {
+	return s.Name
 }
 
-// GetTitle returns the value of Title.
-func (s *Goal) GetTitle() string {
-	return s.Title
+// GetBookmarkCount returns the value of BookmarkCount.
+func (s *BookmarkCollection) GetBookmarkCount() int {
+	return s.BookmarkCount
 }
 
 // GetCreatedAt returns the value of CreatedAt.
-func (s *Goal) GetCreatedAt() time.Time {
+func (s *BookmarkCollection) GetCreatedAt() time.Time {
 	return s.CreatedAt
 }
 
-// GetDeadline returns the value of Deadline.
-func (s *Goal) GetDeadline() OptDate {
-	return s.Deadline
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *BookmarkCollection) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
 }
 
 // SetID sets the value of ID.
-func (s *Goal) SetID(val uuid.UUID) {
+func (s *BookmarkCollection) SetID(val uuid.UUID) {
 	s.ID = val
 }
 
-// SetUserID sets the value of UserID.
-func (s *Goal) SetUserID(val uuid.UUID) {
-	s.UserID = val
+// SetName sets the value of Name.
+func (s *BookmarkCollection) SetName(val string) {
+	s.Name = val
 }
 
-// SetTitle sets the value of Title.
-func (s *Goal) SetTitle(val string) {
-	s.Title = val
+// SetBookmarkCount sets the value of BookmarkCount.
+func (s *BookmarkCollection) SetBookmarkCount(val int) {
+	s.BookmarkCount = val
 }
 
 // SetCreatedAt sets the value of CreatedAt.
-func (s *Goal) SetCreatedAt(val time.Time) {
+func (s *BookmarkCollection) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
-// SetDeadline sets the value of Deadline.
-func (s *Goal) SetDeadline(val OptDate) {
-	s.Deadline = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *BookmarkCollection) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
 }
 
-func (*Goal) goalsGoalIDGetRes() {}
-func (*Goal) goalsGoalIDPutRes() {}
-func (*Goal) goalsPostRes()      {}
+func (*BookmarkCollection) bookmarksCollectionsCollectionIDPutRes() {}
+func (*BookmarkCollection) bookmarksCollectionsPostRes()            {}
 
-// Ref: #/components/schemas/GoalRequest
-type GoalRequest struct {
-	Title    string  `json:"title"`
-	Deadline OptDate `json:"deadline"`
+// Ref: #/components/schemas/BookmarkCollectionRequest
+type BookmarkCollectionRequest struct {
+	Name string `json:"name"`
 }
 
-// GetTitle returns the value of Title.
-func (s *GoalRequest) GetTitle() string {
-	return s.Title
+// GetName returns the value of Name.
+func (s *BookmarkCollectionRequest) GetName() string {
+	return s.Name
 }
 
-// GetDeadline returns the value of Deadline.
-func (s *GoalRequest) GetDeadline() OptDate {
-	return s.Deadline
+// SetName sets the value of Name.
+func (s *BookmarkCollectionRequest) SetName(val string) {
+	s.Name = val
 }
 
-// SetTitle sets the value of Title.
-func (s *GoalRequest) SetTitle(val string) {
-	s.Title = val
+// Ref: #/components/schemas/BookmarkRequest
+type BookmarkRequest struct {
+	// 保存先のコレクションID.
+	CollectionID OptUUID `json:"collection_id"`
 }
 
-// SetDeadline sets the value of Deadline.
-func (s *GoalRequest) SetDeadline(val OptDate) {
-	s.Deadline = val
+// GetCollectionID returns the value of CollectionID.
+func (s *BookmarkRequest) GetCollectionID() OptUUID {
+	return s.CollectionID
 }
 
-type GoalsGetBadRequest Error
+// SetCollectionID sets the value of CollectionID.
+func (s *BookmarkRequest) SetCollectionID(val OptUUID) {
+	s.CollectionID = val
+}
 
-func (*GoalsGetBadRequest) goalsGetRes() {}
+// BookmarksCollectionsCollectionIDDeleteNoContent is response for BookmarksCollectionsCollectionIDDelete operation.
+type BookmarksCollectionsCollectionIDDeleteNoContent struct{}
 
-type GoalsGetOKApplicationJSON []Goal
+func (*BookmarksCollectionsCollectionIDDeleteNoContent) bookmarksCollectionsCollectionIDDeleteRes() {}
 
-func (*GoalsGetOKApplicationJSON) goalsGetRes() {}
+type BookmarksCollectionsCollectionIDDeleteNotFound Error
 
-type GoalsGetUnauthorized Error
+func (*BookmarksCollectionsCollectionIDDeleteNotFound) bookmarksCollectionsCollectionIDDeleteRes() {}
 
-func (*GoalsGetUnauthorized) goalsGetRes() {}
+type BookmarksCollectionsCollectionIDDeleteUnauthorized Error
 
-// GoalsGoalIDDeleteNoContent is response for GoalsGoalIDDelete operation.
-type GoalsGoalIDDeleteNoContent struct{}
+func (*BookmarksCollectionsCollectionIDDeleteUnauthorized) bookmarksCollectionsCollectionIDDeleteRes() {
+}
+
+type BookmarksCollectionsCollectionIDPutBadRequest Error
+
+func (*BookmarksCollectionsCollectionIDPutBadRequest) bookmarksCollectionsCollectionIDPutRes() {}
+
+type BookmarksCollectionsCollectionIDPutConflict Error
+
+func (*BookmarksCollectionsCollectionIDPutConflict) bookmarksCollectionsCollectionIDPutRes() {}
+
+type BookmarksCollectionsCollectionIDPutNotFound Error
+
+func (*BookmarksCollectionsCollectionIDPutNotFound) bookmarksCollectionsCollectionIDPutRes() {}
+
+type BookmarksCollectionsCollectionIDPutUnauthorized Error
+
+func (*BookmarksCollectionsCollectionIDPutUnauthorized) bookmarksCollectionsCollectionIDPutRes() {}
+
+type BookmarksCollectionsGetOKApplicationJSON []BookmarkCollection
+
+func (*BookmarksCollectionsGetOKApplicationJSON) bookmarksCollectionsGetRes() {}
+
+type BookmarksCollectionsPostBadRequest Error
+
+func (*BookmarksCollectionsPostBadRequest) bookmarksCollectionsPostRes() {}
+
+type BookmarksCollectionsPostConflict Error
+
+func (*BookmarksCollectionsPostConflict) bookmarksCollectionsPostRes() {}
+
+type BookmarksCollectionsPostUnauthorized Error
+
+func (*BookmarksCollectionsPostUnauthorized) bookmarksCollectionsPostRes() {}
+
+type BookmarksGetBadRequest Error
+
+func (*BookmarksGetBadRequest) bookmarksGetRes() {}
+
+type BookmarksGetNotFound Error
+
+func (*BookmarksGetNotFound) bookmarksGetRes() {}
+
+// BookmarksGetOKHeaders wraps []Bookmark with response headers.
+type BookmarksGetOKHeaders struct {
+	Link     OptString
+	Response []Bookmark
+}
+
+// GetLink returns the value of Link.
+func (s *BookmarksGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *BookmarksGetOKHeaders) GetResponse() []Bookmark {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *BookmarksGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *BookmarksGetOKHeaders) SetResponse(val []Bookmark) {
+	s.Response = val
+}
+
+func (*BookmarksGetOKHeaders) bookmarksGetRes() {}
+
+type BookmarksGetUnauthorized Error
+
+func (*BookmarksGetUnauthorized) bookmarksGetRes() {}
+
+// Ref: #/components/schemas/Comment
+type Comment struct {
+	ID     uuid.UUID `json:"id"`
+	PostID uuid.UUID `json:"post_id"`
+	UserID uuid.UUID `json:"user_id"`
+	// 返信先のコメントID（投稿への直接のコメントでは省略）.
+	ParentID OptUUID `json:"parent_id"`
+	// 削除済みのコメントでは空文字列.
+	Content string `json:"content"`
+	Deleted bool   `json:"deleted"`
+	// 表示できる返信の数.
+	ReplyCount       int                 `json:"reply_count"`
+	ModerationNotice OptModerationNotice `json:"moderation_notice"`
+	CreatedAt        time.Time           `json:"created_at"`
+	UpdatedAt        time.Time           `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Comment) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetPostID returns the value of PostID.
+func (s *Comment) GetPostID() uuid.UUID {
+	return s.PostID
+}
+
+// GetUserID returns the value of UserID.
+func (s *Comment) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetParentID returns the value of ParentID.
+func (s *Comment) GetParentID() OptUUID {
+	return s.ParentID
+}
+
+// GetContent returns the value of Content.
+func (s *Comment) GetContent() string {
+	return s.Content
+}
+
+// GetDeleted returns the value of Deleted.
+func (s *Comment) GetDeleted() bool {
+	return s.Deleted
+}
+
+// GetReplyCount returns the value of ReplyCount.
+func (s *Comment) GetReplyCount() int {
+	return s.ReplyCount
+}
+
+// GetModerationNotice returns the value of ModerationNotice.
+func (s *Comment) GetModerationNotice() OptModerationNotice {
+	return s.ModerationNotice
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Comment) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Comment) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Comment) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetPostID sets the value of PostID.
+func (s *Comment) SetPostID(val uuid.UUID) {
+	s.PostID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *Comment) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetParentID sets the value of ParentID.
+func (s *Comment) SetParentID(val OptUUID) {
+	s.ParentID = val
+}
+
+// SetContent sets the value of Content.
+func (s *Comment) SetContent(val string) {
+	s.Content = val
+}
+
+// SetDeleted sets the value of Deleted.
+func (s *Comment) SetDeleted(val bool) {
+	s.Deleted = val
+}
+
+// SetReplyCount sets the value of ReplyCount.
+func (s *Comment) SetReplyCount(val int) {
+	s.ReplyCount = val
+}
+
+// SetModerationNotice sets the value of ModerationNotice.
+func (s *Comment) SetModerationNotice(val OptModerationNotice) {
+	s.ModerationNotice = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Comment) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Comment) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*Comment) commentsCommentIDPutRes()    {}
+func (*Comment) postsPostIDCommentsPostRes() {}
+
+// Ref: #/components/schemas/CommentRequest
+type CommentRequest struct {
+	Content string `json:"content"`
+	// 返信先のコメントID.
+	ParentID OptUUID `json:"parent_id"`
+}
+
+// GetContent returns the value of Content.
+func (s *CommentRequest) GetContent() string {
+	return s.Content
+}
+
+// GetParentID returns the value of ParentID.
+func (s *CommentRequest) GetParentID() OptUUID {
+	return s.ParentID
+}
+
+// SetContent sets the value of Content.
+func (s *CommentRequest) SetContent(val string) {
+	s.Content = val
+}
+
+// SetParentID sets the value of ParentID.
+func (s *CommentRequest) SetParentID(val OptUUID) {
+	s.ParentID = val
+}
+
+// Ref: #/components/schemas/CommentUpdateRequest
+type CommentUpdateRequest struct {
+	Content string `json:"content"`
+}
+
+// GetContent returns the value of Content.
+func (s *CommentUpdateRequest) GetContent() string {
+	return s.Content
+}
+
+// SetContent sets the value of Content.
+func (s *CommentUpdateRequest) SetContent(val string) {
+	s.Content = val
+}
+
+type CommentsCommentIDDeleteForbidden Error
+
+func (*CommentsCommentIDDeleteForbidden) commentsCommentIDDeleteRes() {}
+
+// CommentsCommentIDDeleteNoContent is response for CommentsCommentIDDelete operation.
+type CommentsCommentIDDeleteNoContent struct{}
+
+func (*CommentsCommentIDDeleteNoContent) commentsCommentIDDeleteRes() {}
+
+type CommentsCommentIDDeleteNotFound Error
+
+func (*CommentsCommentIDDeleteNotFound) commentsCommentIDDeleteRes() {}
+
+type CommentsCommentIDDeleteUnauthorized Error
+
+func (*CommentsCommentIDDeleteUnauthorized) commentsCommentIDDeleteRes() {}
+
+type CommentsCommentIDPutBadRequest Error
+
+func (*CommentsCommentIDPutBadRequest) commentsCommentIDPutRes() {}
+
+type CommentsCommentIDPutForbidden Error
+
+func (*CommentsCommentIDPutForbidden) commentsCommentIDPutRes() {}
+
+type CommentsCommentIDPutNotFound Error
+
+func (*CommentsCommentIDPutNotFound) commentsCommentIDPutRes() {}
+
+type CommentsCommentIDPutUnauthorized Error
+
+func (*CommentsCommentIDPutUnauthorized) commentsCommentIDPutRes() {}
+
+// Ref: #/components/schemas/DeadlineProgress
+type DeadlineProgress struct {
+	Deadline time.Time `json:"deadline"`
+	// 今日から期限までの日数（期限切れの場合は負の値）.
+	DaysRemaining int `json:"days_remaining"`
+	// 目標作成から期限までの期間のうち経過した割合（0〜1）.
+	ElapsedRatio float64 `json:"elapsed_ratio"`
+	Overdue      bool    `json:"overdue"`
+}
+
+// GetDeadline returns the value of Deadline.
+func (s *DeadlineProgress) GetDeadline() time.Time {
+	return s.Deadline
+}
+
+// GetDaysRemaining returns the value of DaysRemaining.
+func (s *DeadlineProgress) GetDaysRemaining() int {
+	return s.DaysRemaining
+}
+
+// GetElapsedRatio returns the value of ElapsedRatio.
+func (s *DeadlineProgress) GetElapsedRatio() float64 {
+	return s.ElapsedRatio
+}
+
+// GetOverdue returns the value of Overdue.
+func (s *DeadlineProgress) GetOverdue() bool {
+	return s.Overdue
+}
+
+// SetDeadline sets the value of Deadline.
+func (s *DeadlineProgress) SetDeadline(val time.Time) {
+	s.Deadline = val
+}
+
+// SetDaysRemaining sets the value of DaysRemaining.
+func (s *DeadlineProgress) SetDaysRemaining(val int) {
+	s.DaysRemaining = val
+}
+
+// SetElapsedRatio sets the value of ElapsedRatio.
+func (s *DeadlineProgress) SetElapsedRatio(val float64) {
+	s.ElapsedRatio = val
+}
+
+// SetOverdue sets the value of Overdue.
+func (s *DeadlineProgress) SetOverdue(val bool) {
+	s.Overdue = val
+}
+
+// Ref: #/components/schemas/Error
+type Error struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *Error) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*Error) authCallbackGetRes()             {}
+func (*Error) authMeGetRes()                   {}
+func (*Error) blocksGetRes()                   {}
+func (*Error) bookmarksCollectionsGetRes()     {}
+func (*Error) genresGenreIDTemplatesGetRes()   {}
+func (*Error) goalsGoalIDGetRes()              {}
+func (*Error) goalsGoalIDParticipantsGetRes()  {}
+func (*Error) goalsInvitationsGetRes()         {}
+func (*Error) hashtagsTagPostsGetRes()         {}
+func (*Error) hashtagsTrendingGetRes()         {}
+func (*Error) imagesImageIDGetRes()            {}
+func (*Error) notificationsPreferencesGetRes() {}
+func (*Error) notificationsUnreadCountGetRes() {}
+func (*Error) postsPostIDGetRes()              {}
+func (*Error) searchGetRes()                   {}
+func (*Error) usersPostRes()                   {}
+func (*Error) usersUserIDIconGetRes()          {}
+
+type FriendsGetBadRequest Error
+
+func (*FriendsGetBadRequest) friendsGetRes() {}
+
+// FriendsGetOKHeaders wraps []uuid.UUID with response headers.
+type FriendsGetOKHeaders struct {
+	Link     OptString
+	Response []uuid.UUID
+}
+
+// GetLink returns the value of Link.
+func (s *FriendsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *FriendsGetOKHeaders) GetResponse() []uuid.UUID {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *FriendsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *FriendsGetOKHeaders) SetResponse(val []uuid.UUID) {
+	s.Response = val
+}
+
+func (*FriendsGetOKHeaders) friendsGetRes() {}
+
+type FriendsGetUnauthorized Error
+
+func (*FriendsGetUnauthorized) friendsGetRes() {}
+
+type FriendsPostBadRequest Error
+
+func (*FriendsPostBadRequest) friendsPostRes() {}
+
+// FriendsPostCreated is response for FriendsPost operation.
+type FriendsPostCreated struct{}
+
+func (*FriendsPostCreated) friendsPostRes() {}
+
+type FriendsPostNotFound Error
+
+func (*FriendsPostNotFound) friendsPostRes() {}
+
+type FriendsPostReq struct {
+	// 対象ユーザーID.
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *FriendsPostReq) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// SetUserID sets the value of UserID.
+func (s *FriendsPostReq) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+type FriendsPostUnauthorized Error
+
+func (*FriendsPostUnauthorized) friendsPostRes() {}
+
+// FriendsUserIDDeleteNoContent is response for FriendsUserIDDelete operation.
+type FriendsUserIDDeleteNoContent struct{}
+
+func (*FriendsUserIDDeleteNoContent) friendsUserIDDeleteRes() {}
+
+type FriendsUserIDDeleteNotFound Error
+
+func (*FriendsUserIDDeleteNotFound) friendsUserIDDeleteRes() {}
+
+type FriendsUserIDDeleteUnauthorized Error
+
+func (*FriendsUserIDDeleteUnauthorized) friendsUserIDDeleteRes() {}
+
+// GeneralErrorStatusCode wraps Error with StatusCode.
+type GeneralErrorStatusCode struct {
+	StatusCode int
+	Response   Error
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *GeneralErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *GeneralErrorStatusCode) GetResponse() Error {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *GeneralErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GeneralErrorStatusCode) SetResponse(val Error) {
+	s.Response = val
+}
+
+// Ref: #/components/schemas/Genre
+type Genre struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+// GetID returns the value of ID.
+func (s *Genre) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Genre) GetName() string {
+	return s.Name
+}
+
+// SetID sets the value of ID.
+func (s *Genre) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Genre) SetName(val string) {
+	s.Name = val
+}
+
+type GenresGenreIDTemplatesGetOKApplicationJSON []GoalTemplate
+
+func (*GenresGenreIDTemplatesGetOKApplicationJSON) genresGenreIDTemplatesGetRes() {}
+
+// Ref: #/components/schemas/Goal
+type Goal struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	Title  string    `json:"title"`
+	// プロフィールの先頭に表示するか.
+	Pinned bool `json:"pinned"`
+	// 表示順。目標一覧と並び替えでは一覧のユーザーが指定した順、それ以外ではオーナーが指定した順.
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	Deadline  OptDate   `json:"deadline"`
+	// 習慣として取り組む曜日.
+	HabitDays  []Weekday   `json:"habit_days"`
+	Milestones []Milestone `json:"milestones"`
+	// 目標の参加者と貢献数（目標詳細でのみ返されます）.
+	Participants []GoalParticipant `json:"participants"`
+}
+
+// GetID returns the value of ID.
+func (s *Goal) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *Goal) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetTitle returns the value of Title.
+func (s *Goal) GetTitle() string {
+	return s.Title
+}
+
+// GetPinned returns the value of Pinned.
+func (s *Goal) GetPinned() bool {
+	return s.Pinned
+}
+
+// GetPosition returns the value of Position.
+func (s *Goal) GetPosition() int {
+	return s.Position
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Goal) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetDeadline returns the value of Deadline.
+func (s *Goal) GetDeadline() OptDate {
+	return s.Deadline
+}
+
+// GetHabitDays returns the value of HabitDays.
+func (s *Goal) GetHabitDays() []Weekday {
+	return s.HabitDays
+}
+
+// GetMilestones returns the value of Milestones.
+func (s *Goal) GetMilestones() []Milestone {
+	return s.Milestones
+}
+
+// GetParticipants returns the value of Participants.
+func (s *Goal) GetParticipants() []GoalParticipant {
+	return s.Participants
+}
+
+// SetID sets the value of ID.
+func (s *Goal) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *Goal) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *Goal) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetPinned sets the value of Pinned.
+func (s *Goal) SetPinned(val bool) {
+	s.Pinned = val
+}
+
+// SetPosition sets the value of Position.
+func (s *Goal) SetPosition(val int) {
+	s.Position = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Goal) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetDeadline sets the value of Deadline.
+func (s *Goal) SetDeadline(val OptDate) {
+	s.Deadline = val
+}
+
+// SetHabitDays sets the value of HabitDays.
+func (s *Goal) SetHabitDays(val []Weekday) {
+	s.HabitDays = val
+}
+
+// SetMilestones sets the value of Milestones.
+func (s *Goal) SetMilestones(val []Milestone) {
+	s.Milestones = val
+}
+
+// SetParticipants sets the value of Participants.
+func (s *Goal) SetParticipants(val []GoalParticipant) {
+	s.Participants = val
+}
+
+func (*Goal) goalsFromTemplateTemplateIDPostRes() {}
+func (*Goal) goalsGoalIDGetRes()                  {}
+func (*Goal) goalsGoalIDPutRes()                  {}
+func (*Goal) goalsPostRes()                       {}
+
+// Ref: #/components/schemas/GoalAnalytics
+type GoalAnalytics struct {
+	GoalID uuid.UUID `json:"goal_id"`
+	// 集計に使用したタイムゾーン.
+	TimeZone  string    `json:"time_zone"`
+	From      time.Time `json:"from"`
+	To        time.Time `json:"to"`
+	PostCount int       `json:"post_count"`
+	// 期間内の進捗量の合計.
+	TotalAmount float64 `json:"total_amount"`
+	// 投稿のあった日数.
+	ActiveDays int `json:"active_days"`
+	// 日ごとの投稿数と進捗量（投稿のない日も含む）.
+	Heatmap []AnalyticsDay `json:"heatmap"`
+	// 日ごとに受け取ったリアクション数（リアクションのない日も含む）.
+	Reactions []AnalyticsReactionDay `json:"reactions"`
+	// 投稿の平均間隔（時間）。投稿が2件未満の場合は省略されます.
+	AverageGapHours OptFloat64 `json:"average_gap_hours"`
+	// 投稿の最長間隔（時間）。投稿が2件未満の場合は省略されます.
+	LongestGapHours OptFloat64          `json:"longest_gap_hours"`
+	Deadline        OptDeadlineProgress `json:"deadline"`
+}
+
+// GetGoalID returns the value of GoalID.
+func (s *GoalAnalytics) GetGoalID() uuid.UUID {
+	return s.GoalID
+}
+
+// GetTimeZone returns the value of TimeZone.
+func (s *GoalAnalytics) GetTimeZone() string {
+	return s.TimeZone
+}
+
+// GetFrom returns the value of From.
+func (s *GoalAnalytics) GetFrom() time.Time {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *GoalAnalytics) GetTo() time.Time {
+	return s.To
+}
+
+// GetPostCount returns the value of PostCount.
+func (s *GoalAnalytics) GetPostCount() int {
+	return s.PostCount
+}
+
+// GetTotalAmount returns the value of TotalAmount.
+func (s *GoalAnalytics) GetTotalAmount() float64 {
+	return s.TotalAmount
+}
+
+// GetActiveDays returns the value of ActiveDays.
+func (s *GoalAnalytics) GetActiveDays() int {
+	return s.ActiveDays
+}
+
+// GetHeatmap returns the value of Heatmap.
+func (s *GoalAnalytics) GetHeatmap() []AnalyticsDay {
+	return s.Heatmap
+}
+
+// GetReactions returns the value of Reactions.
+func (s *GoalAnalytics) GetReactions() []AnalyticsReactionDay {
+	return s.Reactions
+}
+
+// GetAverageGapHours returns the value of AverageGapHours.
+func (s *GoalAnalytics) GetAverageGapHours() OptFloat64 {
+	return s.AverageGapHours
+}
+
+// GetLongestGapHours returns the value of LongestGapHours.
+func (s *GoalAnalytics) GetLongestGapHours() OptFloat64 {
+	return s.LongestGapHours
+}
+
+// GetDeadline returns the value of Deadline.
+func (s *GoalAnalytics) GetDeadline() OptDeadlineProgress {
+	return s.Deadline
+}
+
+// SetGoalID sets the value of GoalID.
+func (s *GoalAnalytics) SetGoalID(val uuid.UUID) {
+	s.GoalID = val
+}
+
+// SetTimeZone sets the value of TimeZone.
+func (s *GoalAnalytics) SetTimeZone(val string) {
+	s.TimeZone = val
+}
+
+// SetFrom sets the value of From.
+func (s *GoalAnalytics) SetFrom(val time.Time) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *GoalAnalytics) SetTo(val time.Time) {
+	s.To = val
+}
+
+// SetPostCount sets the value of PostCount.
+func (s *GoalAnalytics) SetPostCount(val int) {
+	s.PostCount = val
+}
+
+// SetTotalAmount sets the value of TotalAmount.
+func (s *GoalAnalytics) SetTotalAmount(val float64) {
+	s.TotalAmount = val
+}
+
+// SetActiveDays sets the value of ActiveDays.
+func (s *GoalAnalytics) SetActiveDays(val int) {
+	s.ActiveDays = val
+}
+
+// SetHeatmap sets the value of Heatmap.
+func (s *GoalAnalytics) SetHeatmap(val []AnalyticsDay) {
+	s.Heatmap = val
+}
+
+// SetReactions sets the value of Reactions.
+func (s *GoalAnalytics) SetReactions(val []AnalyticsReactionDay) {
+	s.Reactions = val
+}
+
+// SetAverageGapHours sets the value of AverageGapHours.
+func (s *GoalAnalytics) SetAverageGapHours(val OptFloat64) {
+	s.AverageGapHours = val
+}
+
+// SetLongestGapHours sets the value of LongestGapHours.
+func (s *GoalAnalytics) SetLongestGapHours(val OptFloat64) {
+	s.LongestGapHours = val
+}
+
+// SetDeadline sets the value of Deadline.
+func (s *GoalAnalytics) SetDeadline(val OptDeadlineProgress) {
+	s.Deadline = val
+}
+
+func (*GoalAnalytics) goalsGoalIDAnalyticsGetRes() {}
+
+// Ref: #/components/schemas/GoalFromTemplateRequest
+type GoalFromTemplateRequest struct {
+	// 指定しない場合はテンプレートのタイトルを使用します.
+	Title OptString `json:"title"`
+	// 指定しない場合はテンプレートの推奨期間から算出します.
+	Deadline OptDate `json:"deadline"`
+}
+
+// GetTitle returns the value of Title.
+func (s *GoalFromTemplateRequest) GetTitle() OptString {
+	return s.Title
+}
+
+// GetDeadline returns the value of Deadline.
+func (s *GoalFromTemplateRequest) GetDeadline() OptDate {
+	return s.Deadline
+}
+
+// SetTitle sets the value of Title.
+func (s *GoalFromTemplateRequest) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// SetDeadline sets the value of Deadline.
+func (s *GoalFromTemplateRequest) SetDeadline(val OptDate) {
+	s.Deadline = val
+}
+
+// Ref: #/components/schemas/GoalOrderRequest
+type GoalOrderRequest struct {
+	// 自分が参加している目標（オーナーの目標と招待を承認した目標）のIDをすべて、表示したい順に並べたもの.
+	GoalIds []uuid.UUID `json:"goal_ids"`
+}
+
+// GetGoalIds returns the value of GoalIds.
+func (s *GoalOrderRequest) GetGoalIds() []uuid.UUID {
+	return s.GoalIds
+}
+
+// SetGoalIds sets the value of GoalIds.
+func (s *GoalOrderRequest) SetGoalIds(val []uuid.UUID) {
+	s.GoalIds = val
+}
+
+// Ref: #/components/schemas/GoalParticipant
+type GoalParticipant struct {
+	UserID uuid.UUID           `json:"user_id"`
+	Role   GoalParticipantRole `json:"role"`
+	// 招待承認待ちはinvited、参加中はactive.
+	Status GoalParticipantStatus `json:"status"`
+	// この目標への投稿数（貢献数）.
+	PostCount int `json:"post_count"`
+	// この目標への投稿の進捗量の合計（進捗量のない投稿は数えません）.
+	TotalAmount float64     `json:"total_amount"`
+	JoinedAt    OptDateTime `json:"joined_at"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *GoalParticipant) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetRole returns the value of Role.
+func (s *GoalParticipant) GetRole() GoalParticipantRole {
+	return s.Role
+}
+
+// GetStatus returns the value of Status.
+func (s *GoalParticipant) GetStatus() GoalParticipantStatus {
+	return s.Status
+}
+
+// GetPostCount returns the value of PostCount.
+func (s *GoalParticipant) GetPostCount() int {
+	return s.PostCount
+}
+
+// GetTotalAmount returns the value of TotalAmount.
+func (s *GoalParticipant) GetTotalAmount() float64 {
+	return s.TotalAmount
+}
+
+// GetJoinedAt returns the value of JoinedAt.
+func (s *GoalParticipant) GetJoinedAt() OptDateTime {
+	return s.JoinedAt
+}
+
+// SetUserID sets the value of UserID.
+func (s *GoalParticipant) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetRole sets the value of Role.
+func (s *GoalParticipant) SetRole(val GoalParticipantRole) {
+	s.Role = val
+}
+
+// SetStatus sets the value of Status.
+func (s *GoalParticipant) SetStatus(val GoalParticipantStatus) {
+	s.Status = val
+}
+
+// SetPostCount sets the value of PostCount.
+func (s *GoalParticipant) SetPostCount(val int) {
+	s.PostCount = val
+}
+
+// SetTotalAmount sets the value of TotalAmount.
+func (s *GoalParticipant) SetTotalAmount(val float64) {
+	s.TotalAmount = val
+}
+
+// SetJoinedAt sets the value of JoinedAt.
+func (s *GoalParticipant) SetJoinedAt(val OptDateTime) {
+	s.JoinedAt = val
+}
+
+func (*GoalParticipant) goalsGoalIDParticipantsAcceptPostRes() {}
+func (*GoalParticipant) goalsGoalIDParticipantsPostRes()       {}
+
+type GoalParticipantRole string
+
+const (
+	GoalParticipantRoleOwner  GoalParticipantRole = "owner"
+	GoalParticipantRoleMember GoalParticipantRole = "member"
+)
+
+// AllValues returns all GoalParticipantRole values.
+func (GoalParticipantRole) AllValues() []GoalParticipantRole {
+	return []GoalParticipantRole{
+		GoalParticipantRoleOwner,
+		GoalParticipantRoleMember,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GoalParticipantRole) MarshalText() ([]byte, error) {
+	switch s {
+	case GoalParticipantRoleOwner:
+		return []byte(s), nil
+	case GoalParticipantRoleMember:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GoalParticipantRole) UnmarshalText(data []byte) error {
+	switch GoalParticipantRole(data) {
+	case GoalParticipantRoleOwner:
+		*s = GoalParticipantRoleOwner
+		return nil
+	case GoalParticipantRoleMember:
+		*s = GoalParticipantRoleMember
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// 招待承認待ちはinvited、参加中はactive.
+type GoalParticipantStatus string
+
+const (
+	GoalParticipantStatusInvited GoalParticipantStatus = "invited"
+	GoalParticipantStatusActive  GoalParticipantStatus = "active"
+)
+
+// AllValues returns all GoalParticipantStatus values.
+func (GoalParticipantStatus) AllValues() []GoalParticipantStatus {
+	return []GoalParticipantStatus{
+		GoalParticipantStatusInvited,
+		GoalParticipantStatusActive,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GoalParticipantStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case GoalParticipantStatusInvited:
+		return []byte(s), nil
+	case GoalParticipantStatusActive:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GoalParticipantStatus) UnmarshalText(data []byte) error {
+	switch GoalParticipantStatus(data) {
+	case GoalParticipantStatusInvited:
+		*s = GoalParticipantStatusInvited
+		return nil
+	case GoalParticipantStatusActive:
+		*s = GoalParticipantStatusActive
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/GoalRequest
+type GoalRequest struct {
+	Title    string  `json:"title"`
+	Deadline OptDate `json:"deadline"`
+	// ピン留めできる目標の数には上限があります.
+	Pinned OptBool `json:"pinned"`
+}
+
+// GetTitle returns the value of Title.
+func (s *GoalRequest) GetTitle() string {
+	return s.Title
+}
+
+// GetDeadline returns the value of Deadline.
+func (s *GoalRequest) GetDeadline() OptDate {
+	return s.Deadline
+}
+
+// GetPinned returns the value of Pinned.
+func (s *GoalRequest) GetPinned() OptBool {
+	return s.Pinned
+}
+
+// SetTitle sets the value of Title.
+func (s *GoalRequest) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDeadline sets the value of Deadline.
+func (s *GoalRequest) SetDeadline(val OptDate) {
+	s.Deadline = val
+}
+
+// SetPinned sets the value of Pinned.
+func (s *GoalRequest) SetPinned(val OptBool) {
+	s.Pinned = val
+}
+
+type GoalSort string
+
+const (
+	GoalSortPosition       GoalSort = "position"
+	GoalSortDeadline       GoalSort = "deadline"
+	GoalSortCreated        GoalSort = "created"
+	GoalSortRecentActivity GoalSort = "recent_activity"
+)
+
+// AllValues returns all GoalSort values.
+func (GoalSort) AllValues() []GoalSort {
+	return []GoalSort{
+		GoalSortPosition,
+		GoalSortDeadline,
+		GoalSortCreated,
+		GoalSortRecentActivity,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GoalSort) MarshalText() ([]byte, error) {
+	switch s {
+	case GoalSortPosition:
+		return []byte(s), nil
+	case GoalSortDeadline:
+		return []byte(s), nil
+	case GoalSortCreated:
+		return []byte(s), nil
+	case GoalSortRecentActivity:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GoalSort) UnmarshalText(data []byte) error {
+	switch GoalSort(data) {
+	case GoalSortPosition:
+		*s = GoalSortPosition
+		return nil
+	case GoalSortDeadline:
+		*s = GoalSortDeadline
+		return nil
+	case GoalSortCreated:
+		*s = GoalSortCreated
+		return nil
+	case GoalSortRecentActivity:
+		*s = GoalSortRecentActivity
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/GoalTemplate
+type GoalTemplate struct {
+	ID          uuid.UUID `json:"id"`
+	GenreID     uuid.UUID `json:"genre_id"`
+	Title       string    `json:"title"`
+	Description OptString `json:"description"`
+	// 推奨される目標期間（日数）.
+	SuggestedDurationDays OptInt              `json:"suggested_duration_days"`
+	Milestones            []MilestoneTemplate `json:"milestones"`
+	HabitDays             []Weekday           `json:"habit_days"`
+}
+
+// GetID returns the value of ID.
+func (s *GoalTemplate) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetGenreID returns the value of GenreID.
+func (s *GoalTemplate) GetGenreID() uuid.UUID {
+	return s.GenreID
+}
+
+// GetTitle returns the value of Title.
+func (s *GoalTemplate) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *GoalTemplate) GetDescription() OptString {
+	return s.Description
+}
+
+// GetSuggestedDurationDays returns the value of SuggestedDurationDays.
+func (s *GoalTemplate) GetSuggestedDurationDays() OptInt {
+	return s.SuggestedDurationDays
+}
+
+// GetMilestones returns the value of Milestones.
+func (s *GoalTemplate) GetMilestones() []MilestoneTemplate {
+	return s.Milestones
+}
+
+// GetHabitDays returns the value of HabitDays.
+func (s *GoalTemplate) GetHabitDays() []Weekday {
+	return s.HabitDays
+}
+
+// SetID sets the value of ID.
+func (s *GoalTemplate) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetGenreID sets the value of GenreID.
+func (s *GoalTemplate) SetGenreID(val uuid.UUID) {
+	s.GenreID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *GoalTemplate) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *GoalTemplate) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetSuggestedDurationDays sets the value of SuggestedDurationDays.
+func (s *GoalTemplate) SetSuggestedDurationDays(val OptInt) {
+	s.SuggestedDurationDays = val
+}
+
+// SetMilestones sets the value of Milestones.
+func (s *GoalTemplate) SetMilestones(val []MilestoneTemplate) {
+	s.Milestones = val
+}
+
+// SetHabitDays sets the value of HabitDays.
+func (s *GoalTemplate) SetHabitDays(val []Weekday) {
+	s.HabitDays = val
+}
+
+func (*GoalTemplate) adminTemplatesPostRes()          {}
+func (*GoalTemplate) adminTemplatesTemplateIDPutRes() {}
+
+// Ref: #/components/schemas/GoalTemplateRequest
+type GoalTemplateRequest struct {
+	GenreID               uuid.UUID           `json:"genre_id"`
+	Title                 string              `json:"title"`
+	Description           OptString           `json:"description"`
+	SuggestedDurationDays OptInt              `json:"suggested_duration_days"`
+	Milestones            []MilestoneTemplate `json:"milestones"`
+	HabitDays             []Weekday           `json:"habit_days"`
+}
+
+// GetGenreID returns the value of GenreID.
+func (s *GoalTemplateRequest) GetGenreID() uuid.UUID {
+	return s.GenreID
+}
+
+// GetTitle returns the value of Title.
+func (s *GoalTemplateRequest) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *GoalTemplateRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// GetSuggestedDurationDays returns the value of SuggestedDurationDays.
+func (s *GoalTemplateRequest) GetSuggestedDurationDays() OptInt {
+	return s.SuggestedDurationDays
+}
+
+// GetMilestones returns the value of Milestones.
+func (s *GoalTemplateRequest) GetMilestones() []MilestoneTemplate {
+	return s.Milestones
+}
+
+// GetHabitDays returns the value of HabitDays.
+func (s *GoalTemplateRequest) GetHabitDays() []Weekday {
+	return s.HabitDays
+}
+
+// SetGenreID sets the value of GenreID.
+func (s *GoalTemplateRequest) SetGenreID(val uuid.UUID) {
+	s.GenreID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *GoalTemplateRequest) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *GoalTemplateRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetSuggestedDurationDays sets the value of SuggestedDurationDays.
+func (s *GoalTemplateRequest) SetSuggestedDurationDays(val OptInt) {
+	s.SuggestedDurationDays = val
+}
+
+// SetMilestones sets the value of Milestones.
+func (s *GoalTemplateRequest) SetMilestones(val []MilestoneTemplate) {
+	s.Milestones = val
+}
+
+// SetHabitDays sets the value of HabitDays.
+func (s *GoalTemplateRequest) SetHabitDays(val []Weekday) {
+	s.HabitDays = val
+}
+
+type GoalsFromTemplateTemplateIDPostBadRequest Error
+
+func (*GoalsFromTemplateTemplateIDPostBadRequest) goalsFromTemplateTemplateIDPostRes() {}
+
+type GoalsFromTemplateTemplateIDPostNotFound Error
+
+func (*GoalsFromTemplateTemplateIDPostNotFound) goalsFromTemplateTemplateIDPostRes() {}
+
+type GoalsFromTemplateTemplateIDPostUnauthorized Error
+
+func (*GoalsFromTemplateTemplateIDPostUnauthorized) goalsFromTemplateTemplateIDPostRes() {}
+
+type GoalsGetBadRequest Error
+
+func (*GoalsGetBadRequest) goalsGetRes() {}
+
+// GoalsGetOKHeaders wraps []Goal with response headers.
+type GoalsGetOKHeaders struct {
+	Link     OptString
+	Response []Goal
+}
+
+// GetLink returns the value of Link.
+func (s *GoalsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *GoalsGetOKHeaders) GetResponse() []Goal {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *GoalsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GoalsGetOKHeaders) SetResponse(val []Goal) {
+	s.Response = val
+}
+
+func (*GoalsGetOKHeaders) goalsGetRes() {}
+
+type GoalsGetUnauthorized Error
+
+func (*GoalsGetUnauthorized) goalsGetRes() {}
+
+type GoalsGoalIDAnalyticsGetBadRequest Error
+
+func (*GoalsGoalIDAnalyticsGetBadRequest) goalsGoalIDAnalyticsGetRes() {}
+
+type GoalsGoalIDAnalyticsGetNotFound Error
+
+func (*GoalsGoalIDAnalyticsGetNotFound) goalsGoalIDAnalyticsGetRes() {}
+
+// GoalsGoalIDDeleteNoContent is response for GoalsGoalIDDelete operation.
+type GoalsGoalIDDeleteNoContent struct{}
+
+func (*GoalsGoalIDDeleteNoContent) goalsGoalIDDeleteRes() {}
+
+type GoalsGoalIDDeleteNotFound Error
+
+func (*GoalsGoalIDDeleteNotFound) goalsGoalIDDeleteRes() {}
+
+type GoalsGoalIDDeleteUnauthorized Error
+
+func (*GoalsGoalIDDeleteUnauthorized) goalsGoalIDDeleteRes() {}
+
+type GoalsGoalIDParticipantsAcceptPostNotFound Error
+
+func (*GoalsGoalIDParticipantsAcceptPostNotFound) goalsGoalIDParticipantsAcceptPostRes() {}
+
+type GoalsGoalIDParticipantsAcceptPostUnauthorized Error
+
+func (*GoalsGoalIDParticipantsAcceptPostUnauthorized) goalsGoalIDParticipantsAcceptPostRes() {}
+
+type GoalsGoalIDParticipantsGetOKApplicationJSON []GoalParticipant
+
+func (*GoalsGoalIDParticipantsGetOKApplicationJSON) goalsGoalIDParticipantsGetRes() {}
+
+type GoalsGoalIDParticipantsPostBadRequest Error
+
+func (*GoalsGoalIDParticipantsPostBadRequest) goalsGoalIDParticipantsPostRes() {}
+
+type GoalsGoalIDParticipantsPostForbidden Error
+
+func (*GoalsGoalIDParticipantsPostForbidden) goalsGoalIDParticipantsPostRes() {}
+
+type GoalsGoalIDParticipantsPostNotFound Error
+
+func (*GoalsGoalIDParticipantsPostNotFound) goalsGoalIDParticipantsPostRes() {}
+
+type GoalsGoalIDParticipantsPostReq struct {
+	// 招待するユーザーID.
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *GoalsGoalIDParticipantsPostReq) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// SetUserID sets the value of UserID.
+func (s *GoalsGoalIDParticipantsPostReq) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+type GoalsGoalIDParticipantsPostUnauthorized Error
+
+func (*GoalsGoalIDParticipantsPostUnauthorized) goalsGoalIDParticipantsPostRes() {}
+
+type GoalsGoalIDParticipantsUserIDDeleteBadRequest Error
+
+func (*GoalsGoalIDParticipantsUserIDDeleteBadRequest) goalsGoalIDParticipantsUserIDDeleteRes() {}
+
+type GoalsGoalIDParticipantsUserIDDeleteForbidden Error
+
+func (*GoalsGoalIDParticipantsUserIDDeleteForbidden) goalsGoalIDParticipantsUserIDDeleteRes() {}
+
+// GoalsGoalIDParticipantsUserIDDeleteNoContent is response for GoalsGoalIDParticipantsUserIDDelete operation.
+type GoalsGoalIDParticipantsUserIDDeleteNoContent struct{}
+
+func (*GoalsGoalIDParticipantsUserIDDeleteNoContent) goalsGoalIDParticipantsUserIDDeleteRes() {}
+
+type GoalsGoalIDParticipantsUserIDDeleteNotFound Error
+
+func (*GoalsGoalIDParticipantsUserIDDeleteNotFound) goalsGoalIDParticipantsUserIDDeleteRes() {}
+
+type GoalsGoalIDParticipantsUserIDDeleteUnauthorized Error
+
+func (*GoalsGoalIDParticipantsUserIDDeleteUnauthorized) goalsGoalIDParticipantsUserIDDeleteRes() {}
+
+type GoalsGoalIDPostsGetBadRequest Error
+
+func (*GoalsGoalIDPostsGetBadRequest) goalsGoalIDPostsGetRes() {}
+
+type GoalsGoalIDPostsGetNotFound Error
+
+func (*GoalsGoalIDPostsGetNotFound) goalsGoalIDPostsGetRes() {}
+
+// GoalsGoalIDPostsGetOKHeaders wraps []Post with response headers.
+type GoalsGoalIDPostsGetOKHeaders struct {
+	Link     OptString
+	Response []Post
+}
+
+// GetLink returns the value of Link.
+func (s *GoalsGoalIDPostsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *GoalsGoalIDPostsGetOKHeaders) GetResponse() []Post {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *GoalsGoalIDPostsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GoalsGoalIDPostsGetOKHeaders) SetResponse(val []Post) {
+	s.Response = val
+}
+
+func (*GoalsGoalIDPostsGetOKHeaders) goalsGoalIDPostsGetRes() {}
+
+type GoalsGoalIDPutBadRequest Error
+
+func (*GoalsGoalIDPutBadRequest) goalsGoalIDPutRes() {}
+
+type GoalsGoalIDPutNotFound Error
+
+func (*GoalsGoalIDPutNotFound) goalsGoalIDPutRes() {}
+
+type GoalsGoalIDPutUnauthorized Error
+
+func (*GoalsGoalIDPutUnauthorized) goalsGoalIDPutRes() {}
+
+type GoalsInvitationsGetOKApplicationJSON []Goal
+
+func (*GoalsInvitationsGetOKApplicationJSON) goalsInvitationsGetRes() {}
+
+type GoalsOrderPutBadRequest Error
+
+func (*GoalsOrderPutBadRequest) goalsOrderPutRes() {}
+
+type GoalsOrderPutOKApplicationJSON []Goal
+
+func (*GoalsOrderPutOKApplicationJSON) goalsOrderPutRes() {}
+
+type GoalsOrderPutUnauthorized Error
+
+func (*GoalsOrderPutUnauthorized) goalsOrderPutRes() {}
+
+type GoalsPostBadRequest Error
+
+func (*GoalsPostBadRequest) goalsPostRes() {}
+
+type GoalsPostUnauthorized Error
+
+func (*GoalsPostUnauthorized) goalsPostRes() {}
+
+// Ref: #/components/schemas/Hashtag
+type Hashtag struct {
+	Name string `json:"name"`
+	// 集計期間内にこのタグが付いた投稿の数.
+	PostCount int `json:"post_count"`
+}
+
+// GetName returns the value of Name.
+func (s *Hashtag) GetName() string {
+	return s.Name
+}
+
+// GetPostCount returns the value of PostCount.
+func (s *Hashtag) GetPostCount() int {
+	return s.PostCount
+}
+
+// SetName sets the value of Name.
+func (s *Hashtag) SetName(val string) {
+	s.Name = val
+}
+
+// SetPostCount sets the value of PostCount.
+func (s *Hashtag) SetPostCount(val int) {
+	s.PostCount = val
+}
+
+// HashtagsTagPostsGetOKHeaders wraps []Post with response headers.
+type HashtagsTagPostsGetOKHeaders struct {
+	Link     OptString
+	Response []Post
+}
+
+// GetLink returns the value of Link.
+func (s *HashtagsTagPostsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *HashtagsTagPostsGetOKHeaders) GetResponse() []Post {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *HashtagsTagPostsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *HashtagsTagPostsGetOKHeaders) SetResponse(val []Post) {
+	s.Response = val
+}
+
+func (*HashtagsTagPostsGetOKHeaders) hashtagsTagPostsGetRes() {}
+
+type HashtagsTrendingGetOKApplicationJSON []Hashtag
+
+func (*HashtagsTrendingGetOKApplicationJSON) hashtagsTrendingGetRes() {}
+
+// Ref: #/components/schemas/Image
+type Image struct {
+	ID  uuid.UUID `json:"id"`
+	URL string    `json:"url"`
+}
+
+// GetID returns the value of ID.
+func (s *Image) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetURL returns the value of URL.
+func (s *Image) GetURL() string {
+	return s.URL
+}
+
+// SetID sets the value of ID.
+func (s *Image) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetURL sets the value of URL.
+func (s *Image) SetURL(val string) {
+	s.URL = val
+}
+
+func (*Image) imagesPostRes() {}
+
+type ImagesImageIDGetOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ImagesImageIDGetOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ImagesImageIDGetOK) imagesImageIDGetRes() {}
+
+type ImagesPostBadRequest Error
+
+func (*ImagesPostBadRequest) imagesPostRes() {}
+
+type ImagesPostReq struct {
+	Image OptMultipartFile `json:"image"`
+}
+
+// GetImage returns the value of Image.
+func (s *ImagesPostReq) GetImage() OptMultipartFile {
+	return s.Image
+}
+
+// SetImage sets the value of Image.
+func (s *ImagesPostReq) SetImage(val OptMultipartFile) {
+	s.Image = val
+}
+
+type ImagesPostUnauthorized Error
+
+func (*ImagesPostUnauthorized) imagesPostRes() {}
+
+// 本文の最初のURLのリンクプレビュー（OpenGraph/Twitterカードの情報）。
+// プレビューは投稿の保存後に非同期で取得されるため、保存直後のレスポンスには含まれないことがあります。取得できなかった場合は省略されます。.
+// Ref: #/components/schemas/LinkPreview
+type LinkPreview struct {
+	// 本文に書かれたURL.
+	URL         string    `json:"url"`
+	Title       OptString `json:"title"`
+	Description OptString `json:"description"`
+	// 画像の絶対URL.
+	ImageURL OptString `json:"image_url"`
+	SiteName OptString `json:"site_name"`
+}
+
+// GetURL returns the value of URL.
+func (s *LinkPreview) GetURL() string {
+	return s.URL
+}
+
+// GetTitle returns the value of Title.
+func (s *LinkPreview) GetTitle() OptString {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *LinkPreview) GetDescription() OptString {
+	return s.Description
+}
+
+// GetImageURL returns the value of ImageURL.
+func (s *LinkPreview) GetImageURL() OptString {
+	return s.ImageURL
+}
+
+// GetSiteName returns the value of SiteName.
+func (s *LinkPreview) GetSiteName() OptString {
+	return s.SiteName
+}
+
+// SetURL sets the value of URL.
+func (s *LinkPreview) SetURL(val string) {
+	s.URL = val
+}
+
+// SetTitle sets the value of Title.
+func (s *LinkPreview) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *LinkPreview) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetImageURL sets the value of ImageURL.
+func (s *LinkPreview) SetImageURL(val OptString) {
+	s.ImageURL = val
+}
+
+// SetSiteName sets the value of SiteName.
+func (s *LinkPreview) SetSiteName(val OptString) {
+	s.SiteName = val
+}
+
+// Ref: #/components/schemas/Milestone
+type Milestone struct {
+	ID       uuid.UUID `json:"id"`
+	Title    string    `json:"title"`
+	DueDate  OptDate   `json:"due_date"`
+	Position int       `json:"position"`
+}
+
+// GetID returns the value of ID.
+func (s *Milestone) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetTitle returns the value of Title.
+func (s *Milestone) GetTitle() string {
+	return s.Title
+}
+
+// GetDueDate returns the value of DueDate.
+func (s *Milestone) GetDueDate() OptDate {
+	return s.DueDate
+}
+
+// GetPosition returns the value of Position.
+func (s *Milestone) GetPosition() int {
+	return s.Position
+}
+
+// SetID sets the value of ID.
+func (s *Milestone) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *Milestone) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDueDate sets the value of DueDate.
+func (s *Milestone) SetDueDate(val OptDate) {
+	s.DueDate = val
+}
+
+// SetPosition sets the value of Position.
+func (s *Milestone) SetPosition(val int) {
+	s.Position = val
+}
+
+// Ref: #/components/schemas/MilestoneTemplate
+type MilestoneTemplate struct {
+	Title string `json:"title"`
+	// 目標開始日から何日後を期日とするか.
+	OffsetDays int `json:"offset_days"`
+}
+
+// GetTitle returns the value of Title.
+func (s *MilestoneTemplate) GetTitle() string {
+	return s.Title
+}
+
+// GetOffsetDays returns the value of OffsetDays.
+func (s *MilestoneTemplate) GetOffsetDays() int {
+	return s.OffsetDays
+}
+
+// SetTitle sets the value of Title.
+func (s *MilestoneTemplate) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetOffsetDays sets the value of OffsetDays.
+func (s *MilestoneTemplate) SetOffsetDays(val int) {
+	s.OffsetDays = val
+}
+
+// Ref: #/components/schemas/ModerationAction
+type ModerationAction struct {
+	ID         uuid.UUID            `json:"id"`
+	Action     ModerationActionType `json:"action"`
+	TargetType ModerationTargetType `json:"target_type"`
+	TargetID   uuid.UUID            `json:"target_id"`
+	// 操作したモデレーターのID（モデレーターが削除された場合は省略）.
+	ModeratorID    OptUUID     `json:"moderator_id"`
+	ReportID       OptUUID     `json:"report_id"`
+	Note           OptString   `json:"note"`
+	SuspendedUntil OptDateTime `json:"suspended_until"`
+	CreatedAt      time.Time   `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *ModerationAction) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetAction returns the value of Action.
+func (s *ModerationAction) GetAction() ModerationActionType {
+	return s.Action
+}
+
+// GetTargetType returns the value of TargetType.
+func (s *ModerationAction) GetTargetType() ModerationTargetType {
+	return s.TargetType
+}
+
+// GetTargetID returns the value of TargetID.
+func (s *ModerationAction) GetTargetID() uuid.UUID {
+	return s.TargetID
+}
+
+// GetModeratorID returns the value of ModeratorID.
+func (s *ModerationAction) GetModeratorID() OptUUID {
+	return s.ModeratorID
+}
+
+// GetReportID returns the value of ReportID.
+func (s *ModerationAction) GetReportID() OptUUID {
+	return s.ReportID
+}
+
+// GetNote returns the value of Note.
+func (s *ModerationAction) GetNote() OptString {
+	return s.Note
+}
+
+// GetSuspendedUntil returns the value of SuspendedUntil.
+func (s *ModerationAction) GetSuspendedUntil() OptDateTime {
+	return s.SuspendedUntil
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ModerationAction) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ModerationAction) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetAction sets the value of Action.
+func (s *ModerationAction) SetAction(val ModerationActionType) {
+	s.Action = val
+}
+
+// SetTargetType sets the value of TargetType.
+func (s *ModerationAction) SetTargetType(val ModerationTargetType) {
+	s.TargetType = val
+}
+
+// SetTargetID sets the value of TargetID.
+func (s *ModerationAction) SetTargetID(val uuid.UUID) {
+	s.TargetID = val
+}
+
+// SetModeratorID sets the value of ModeratorID.
+func (s *ModerationAction) SetModeratorID(val OptUUID) {
+	s.ModeratorID = val
+}
+
+// SetReportID sets the value of ReportID.
+func (s *ModerationAction) SetReportID(val OptUUID) {
+	s.ReportID = val
+}
+
+// SetNote sets the value of Note.
+func (s *ModerationAction) SetNote(val OptString) {
+	s.Note = val
+}
+
+// SetSuspendedUntil sets the value of SuspendedUntil.
+func (s *ModerationAction) SetSuspendedUntil(val OptDateTime) {
+	s.SuspendedUntil = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ModerationAction) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*ModerationAction) adminModerationActionsPostRes() {}
+
+// Ref: #/components/schemas/ModerationActionRequest
+type ModerationActionRequest struct {
+	Action     ModerationActionRequestAction `json:"action"`
+	TargetType ReportTargetType              `json:"target_type"`
+	TargetID   uuid.UUID                     `json:"target_id"`
+	// 操作のきっかけとなった報告のID.
+	ReportID OptUUID   `json:"report_id"`
+	Note     OptString `json:"note"`
+	// アカウント停止の終了日時（suspendのみ。省略すると無期限）.
+	SuspendedUntil OptDateTime `json:"suspended_until"`
+}
+
+// GetAction returns the value of Action.
+func (s *ModerationActionRequest) GetAction() ModerationActionRequestAction {
+	return s.Action
+}
+
+// GetTargetType returns the value of TargetType.
+func (s *ModerationActionRequest) GetTargetType() ReportTargetType {
+	return s.TargetType
+}
+
+// GetTargetID returns the value of TargetID.
+func (s *ModerationActionRequest) GetTargetID() uuid.UUID {
+	return s.TargetID
+}
+
+// GetReportID returns the value of ReportID.
+func (s *ModerationActionRequest) GetReportID() OptUUID {
+	return s.ReportID
+}
+
+// GetNote returns the value of Note.
+func (s *ModerationActionRequest) GetNote() OptString {
+	return s.Note
+}
+
+// GetSuspendedUntil returns the value of SuspendedUntil.
+func (s *ModerationActionRequest) GetSuspendedUntil() OptDateTime {
+	return s.SuspendedUntil
+}
+
+// SetAction sets the value of Action.
+func (s *ModerationActionRequest) SetAction(val ModerationActionRequestAction) {
+	s.Action = val
+}
+
+// SetTargetType sets the value of TargetType.
+func (s *ModerationActionRequest) SetTargetType(val ReportTargetType) {
+	s.TargetType = val
+}
+
+// SetTargetID sets the value of TargetID.
+func (s *ModerationActionRequest) SetTargetID(val uuid.UUID) {
+	s.TargetID = val
+}
+
+// SetReportID sets the value of ReportID.
+func (s *ModerationActionRequest) SetReportID(val OptUUID) {
+	s.ReportID = val
+}
+
+// SetNote sets the value of Note.
+func (s *ModerationActionRequest) SetNote(val OptString) {
+	s.Note = val
+}
+
+// SetSuspendedUntil sets the value of SuspendedUntil.
+func (s *ModerationActionRequest) SetSuspendedUntil(val OptDateTime) {
+	s.SuspendedUntil = val
+}
+
+type ModerationActionRequestAction string
+
+const (
+	ModerationActionRequestActionHide      ModerationActionRequestAction = "hide"
+	ModerationActionRequestActionUnhide    ModerationActionRequestAction = "unhide"
+	ModerationActionRequestActionWarn      ModerationActionRequestAction = "warn"
+	ModerationActionRequestActionSuspend   ModerationActionRequestAction = "suspend"
+	ModerationActionRequestActionUnsuspend ModerationActionRequestAction = "unsuspend"
+)
+
+// AllValues returns all ModerationActionRequestAction values.
+func (ModerationActionRequestAction) AllValues() []ModerationActionRequestAction {
+	return []ModerationActionRequestAction{
+		ModerationActionRequestActionHide,
+		ModerationActionRequestActionUnhide,
+		ModerationActionRequestActionWarn,
+		ModerationActionRequestActionSuspend,
+		ModerationActionRequestActionUnsuspend,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ModerationActionRequestAction) MarshalText() ([]byte, error) {
+	switch s {
+	case ModerationActionRequestActionHide:
+		return []byte(s), nil
+	case ModerationActionRequestActionUnhide:
+		return []byte(s), nil
+	case ModerationActionRequestActionWarn:
+		return []byte(s), nil
+	case ModerationActionRequestActionSuspend:
+		return []byte(s), nil
+	case ModerationActionRequestActionUnsuspend:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ModerationActionRequestAction) UnmarshalText(data []byte) error {
+	switch ModerationActionRequestAction(data) {
+	case ModerationActionRequestActionHide:
+		*s = ModerationActionRequestActionHide
+		return nil
+	case ModerationActionRequestActionUnhide:
+		*s = ModerationActionRequestActionUnhide
+		return nil
+	case ModerationActionRequestActionWarn:
+		*s = ModerationActionRequestActionWarn
+		return nil
+	case ModerationActionRequestActionSuspend:
+		*s = ModerationActionRequestActionSuspend
+		return nil
+	case ModerationActionRequestActionUnsuspend:
+		*s = ModerationActionRequestActionUnsuspend
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ModerationActionType
+type ModerationActionType string
+
+const (
+	ModerationActionTypeClaim     ModerationActionType = "claim"
+	ModerationActionTypeResolve   ModerationActionType = "resolve"
+	ModerationActionTypeHide      ModerationActionType = "hide"
+	ModerationActionTypeUnhide    ModerationActionType = "unhide"
+	ModerationActionTypeWarn      ModerationActionType = "warn"
+	ModerationActionTypeSuspend   ModerationActionType = "suspend"
+	ModerationActionTypeUnsuspend ModerationActionType = "unsuspend"
+)
+
+// AllValues returns all ModerationActionType values.
+func (ModerationActionType) AllValues() []ModerationActionType {
+	return []ModerationActionType{
+		ModerationActionTypeClaim,
+		ModerationActionTypeResolve,
+		ModerationActionTypeHide,
+		ModerationActionTypeUnhide,
+		ModerationActionTypeWarn,
+		ModerationActionTypeSuspend,
+		ModerationActionTypeUnsuspend,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ModerationActionType) MarshalText() ([]byte, error) {
+	switch s {
+	case ModerationActionTypeClaim:
+		return []byte(s), nil
+	case ModerationActionTypeResolve:
+		return []byte(s), nil
+	case ModerationActionTypeHide:
+		return []byte(s), nil
+	case ModerationActionTypeUnhide:
+		return []byte(s), nil
+	case ModerationActionTypeWarn:
+		return []byte(s), nil
+	case ModerationActionTypeSuspend:
+		return []byte(s), nil
+	case ModerationActionTypeUnsuspend:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ModerationActionType) UnmarshalText(data []byte) error {
+	switch ModerationActionType(data) {
+	case ModerationActionTypeClaim:
+		*s = ModerationActionTypeClaim
+		return nil
+	case ModerationActionTypeResolve:
+		*s = ModerationActionTypeResolve
+		return nil
+	case ModerationActionTypeHide:
+		*s = ModerationActionTypeHide
+		return nil
+	case ModerationActionTypeUnhide:
+		*s = ModerationActionTypeUnhide
+		return nil
+	case ModerationActionTypeWarn:
+		*s = ModerationActionTypeWarn
+		return nil
+	case ModerationActionTypeSuspend:
+		*s = ModerationActionTypeSuspend
+		return nil
+	case ModerationActionTypeUnsuspend:
+		*s = ModerationActionTypeUnsuspend
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// 運営により非表示にされた内容に付く、作成者本人向けの通知。非表示の内容は作成者本人にのみ表示されます.
+// Ref: #/components/schemas/ModerationNotice
+type ModerationNotice struct {
+	HiddenAt time.Time `json:"hidden_at"`
+	Message  string    `json:"message"`
+}
+
+// GetHiddenAt returns the value of HiddenAt.
+func (s *ModerationNotice) GetHiddenAt() time.Time {
+	return s.HiddenAt
+}
+
+// GetMessage returns the value of Message.
+func (s *ModerationNotice) GetMessage() string {
+	return s.Message
+}
+
+// SetHiddenAt sets the value of HiddenAt.
+func (s *ModerationNotice) SetHiddenAt(val time.Time) {
+	s.HiddenAt = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ModerationNotice) SetMessage(val string) {
+	s.Message = val
+}
+
+// 操作の対象の種類。報告の担当（claim）と対応（resolve）はreportです.
+// Ref: #/components/schemas/ModerationTargetType
+type ModerationTargetType string
+
+const (
+	ModerationTargetTypePost    ModerationTargetType = "post"
+	ModerationTargetTypeComment ModerationTargetType = "comment"
+	ModerationTargetTypeUser    ModerationTargetType = "user"
+	ModerationTargetTypeReport  ModerationTargetType = "report"
+)
+
+// AllValues returns all ModerationTargetType values.
+func (ModerationTargetType) AllValues() []ModerationTargetType {
+	return []ModerationTargetType{
+		ModerationTargetTypePost,
+		ModerationTargetTypeComment,
+		ModerationTargetTypeUser,
+		ModerationTargetTypeReport,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ModerationTargetType) MarshalText() ([]byte, error) {
+	switch s {
+	case ModerationTargetTypePost:
+		return []byte(s), nil
+	case ModerationTargetTypeComment:
+		return []byte(s), nil
+	case ModerationTargetTypeUser:
+		return []byte(s), nil
+	case ModerationTargetTypeReport:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ModerationTargetType) UnmarshalText(data []byte) error {
+	switch ModerationTargetType(data) {
+	case ModerationTargetTypePost:
+		*s = ModerationTargetTypePost
+		return nil
+	case ModerationTargetTypeComment:
+		*s = ModerationTargetTypeComment
+		return nil
+	case ModerationTargetTypeUser:
+		*s = ModerationTargetTypeUser
+		return nil
+	case ModerationTargetTypeReport:
+		*s = ModerationTargetTypeReport
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Notification
+type Notification struct {
+	ID   uuid.UUID        `json:"id"`
+	Type NotificationType `json:"type"`
+	// 最後に操作をしたユーザーのID（ユーザーが削除された場合と、期限のリマインダーでは省略）.
+	ActorID OptUUID `json:"actor_id"`
+	// まとめた通知のきっかけとなった操作をしたユーザーの人数（「actor_idのユーザーと他actor_count-1人」のように表示します）.
+	ActorCount int `json:"actor_count"`
+	// 通知の対象の投稿ID（フォロー・警告・期限のリマインダーでは省略）.
+	PostID OptUUID `json:"post_id"`
+	// 通知の対象の目標ID（期限のリマインダーのみ）.
+	GoalID    OptUUID     `json:"goal_id"`
+	Read      bool        `json:"read"`
+	ReadAt    OptDateTime `json:"read_at"`
+	CreatedAt time.Time   `json:"created_at"`
+	// 最後に通知をまとめた日時（一覧の並び順）.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Notification) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *Notification) GetType() NotificationType {
+	return s.Type
+}
+
+// GetActorID returns the value of ActorID.
+func (s *Notification) GetActorID() OptUUID {
+	return s.ActorID
+}
+
+// GetActorCount returns the value of ActorCount.
+func (s *Notification) GetActorCount() int {
+	return s.ActorCount
+}
+
+// GetPostID returns the value of PostID.
+func (s *Notification) GetPostID() OptUUID {
+	return s.PostID
+}
+
+// GetGoalID returns the value of GoalID.
+func (s *Notification) GetGoalID() OptUUID {
+	return s.GoalID
+}
+
+// GetRead returns the value of Read.
+func (s *Notification) GetRead() bool {
+	return s.Read
+}
+
+// GetReadAt returns the value of ReadAt.
+func (s *Notification) GetReadAt() OptDateTime {
+	return s.ReadAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Notification) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Notification) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Notification) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetType sets the value of Type.
+func (s *Notification) SetType(val NotificationType) {
+	s.Type = val
+}
+
+// SetActorID sets the value of ActorID.
+func (s *Notification) SetActorID(val OptUUID) {
+	s.ActorID = val
+}
+
+// SetActorCount sets the value of ActorCount.
+func (s *Notification) SetActorCount(val int) {
+	s.ActorCount = val
+}
+
+// SetPostID sets the value of PostID.
+func (s *Notification) SetPostID(val OptUUID) {
+	s.PostID = val
+}
+
+// SetGoalID sets the value of GoalID.
+func (s *Notification) SetGoalID(val OptUUID) {
+	s.GoalID = val
+}
+
+// SetRead sets the value of Read.
+func (s *Notification) SetRead(val bool) {
+	s.Read = val
+}
+
+// SetReadAt sets the value of ReadAt.
+func (s *Notification) SetReadAt(val OptDateTime) {
+	s.ReadAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Notification) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Notification) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// 種類ごとに通知を受け取るかどうか。運営からの警告は常に通知します。.
+// Ref: #/components/schemas/NotificationPreferences
+type NotificationPreferences struct {
+	Mention  bool `json:"mention"`
+	Reaction bool `json:"reaction"`
+	Follow   bool `json:"follow"`
+	Comment  bool `json:"comment"`
+}
+
+// GetMention returns the value of Mention.
+func (s *NotificationPreferences) GetMention() bool {
+	return s.Mention
+}
+
+// GetReaction returns the value of Reaction.
+func (s *NotificationPreferences) GetReaction() bool {
+	return s.Reaction
+}
+
+// GetFollow returns the value of Follow.
+func (s *NotificationPreferences) GetFollow() bool {
+	return s.Follow
+}
+
+// GetComment returns the value of Comment.
+func (s *NotificationPreferences) GetComment() bool {
+	return s.Comment
+}
+
+// SetMention sets the value of Mention.
+func (s *NotificationPreferences) SetMention(val bool) {
+	s.Mention = val
+}
+
+// SetReaction sets the value of Reaction.
+func (s *NotificationPreferences) SetReaction(val bool) {
+	s.Reaction = val
+}
+
+// SetFollow sets the value of Follow.
+func (s *NotificationPreferences) SetFollow(val bool) {
+	s.Follow = val
+}
+
+// SetComment sets the value of Comment.
+func (s *NotificationPreferences) SetComment(val bool) {
+	s.Comment = val
+}
+
+func (*NotificationPreferences) notificationsPreferencesGetRes() {}
+func (*NotificationPreferences) notificationsPreferencesPutRes() {}
+
+// Ref: #/components/schemas/NotificationReadRequest
+type NotificationReadRequest struct {
+	// 既読にする通知のID（省略した場合は全ての未読の通知）.
+	Ids []uuid.UUID `json:"ids"`
+}
+
+// GetIds returns the value of Ids.
+func (s *NotificationReadRequest) GetIds() []uuid.UUID {
+	return s.Ids
+}
+
+// SetIds sets the value of Ids.
+func (s *NotificationReadRequest) SetIds(val []uuid.UUID) {
+	s.Ids = val
+}
+
+// 通知の種類
+// - mention: 投稿でメンションされた
+// - reaction: 自分の投稿にリアクションが付いた
+// - follow: フォローされた
+// - comment: 自分の投稿にコメント、または自分のコメントに返信が付いた
+// - moderation_warning: 運営から警告を受けた
+// - goal_reminder: 自分が参加している目標の期限が近い、または期限を過ぎた.
+// Ref: #/components/schemas/NotificationType
+type NotificationType string
+
+const (
+	NotificationTypeMention           NotificationType = "mention"
+	NotificationTypeReaction          NotificationType = "reaction"
+	NotificationTypeFollow            NotificationType = "follow"
+	NotificationTypeComment           NotificationType = "comment"
+	NotificationTypeModerationWarning NotificationType = "moderation_warning"
+	NotificationTypeGoalReminder      NotificationType = "goal_reminder"
+)
+
+// AllValues returns all NotificationType values.
+func (NotificationType) AllValues() []NotificationType {
+	return []NotificationType{
+		NotificationTypeMention,
+		NotificationTypeReaction,
+		NotificationTypeFollow,
+		NotificationTypeComment,
+		NotificationTypeModerationWarning,
+		NotificationTypeGoalReminder,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s NotificationType) MarshalText() ([]byte, error) {
+	switch s {
+	case NotificationTypeMention:
+		return []byte(s), nil
+	case NotificationTypeReaction:
+		return []byte(s), nil
+	case NotificationTypeFollow:
+		return []byte(s), nil
+	case NotificationTypeComment:
+		return []byte(s), nil
+	case NotificationTypeModerationWarning:
+		return []byte(s), nil
+	case NotificationTypeGoalReminder:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NotificationType) UnmarshalText(data []byte) error {
+	switch NotificationType(data) {
+	case NotificationTypeMention:
+		*s = NotificationTypeMention
+		return nil
+	case NotificationTypeReaction:
+		*s = NotificationTypeReaction
+		return nil
+	case NotificationTypeFollow:
+		*s = NotificationTypeFollow
+		return nil
+	case NotificationTypeComment:
+		*s = NotificationTypeComment
+		return nil
+	case NotificationTypeModerationWarning:
+		*s = NotificationTypeModerationWarning
+		return nil
+	case NotificationTypeGoalReminder:
+		*s = NotificationTypeGoalReminder
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/NotificationUnreadCount
+type NotificationUnreadCount struct {
+	Count int `json:"count"`
+}
+
+// GetCount returns the value of Count.
+func (s *NotificationUnreadCount) GetCount() int {
+	return s.Count
+}
+
+// SetCount sets the value of Count.
+func (s *NotificationUnreadCount) SetCount(val int) {
+	s.Count = val
+}
+
+func (*NotificationUnreadCount) notificationsUnreadCountGetRes() {}
+
+type NotificationsGetBadRequest Error
+
+func (*NotificationsGetBadRequest) notificationsGetRes() {}
+
+// NotificationsGetOKHeaders wraps []Notification with response headers.
+type NotificationsGetOKHeaders struct {
+	Link     OptString
+	Response []Notification
+}
+
+// GetLink returns the value of Link.
+func (s *NotificationsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *NotificationsGetOKHeaders) GetResponse() []Notification {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *NotificationsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *NotificationsGetOKHeaders) SetResponse(val []Notification) {
+	s.Response = val
+}
+
+func (*NotificationsGetOKHeaders) notificationsGetRes() {}
+
+type NotificationsGetUnauthorized Error
+
+func (*NotificationsGetUnauthorized) notificationsGetRes() {}
+
+type NotificationsPreferencesPutBadRequest Error
+
+func (*NotificationsPreferencesPutBadRequest) notificationsPreferencesPutRes() {}
+
+type NotificationsPreferencesPutUnauthorized Error
+
+func (*NotificationsPreferencesPutUnauthorized) notificationsPreferencesPutRes() {}
+
+type NotificationsReadPostBadRequest Error
+
+func (*NotificationsReadPostBadRequest) notificationsReadPostRes() {}
+
+// NotificationsReadPostNoContent is response for NotificationsReadPost operation.
+type NotificationsReadPostNoContent struct{}
+
+func (*NotificationsReadPostNoContent) notificationsReadPostRes() {}
+
+type NotificationsReadPostUnauthorized Error
+
+func (*NotificationsReadPostUnauthorized) notificationsReadPostRes() {}
+
+// NewOptBookmarkRequest returns new OptBookmarkRequest with value set to v.
+func NewOptBookmarkRequest(v BookmarkRequest) OptBookmarkRequest {
+	return OptBookmarkRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBookmarkRequest is optional BookmarkRequest.
+type OptBookmarkRequest struct {
+	Value BookmarkRequest
+	Set   bool
+}
+
+// IsSet returns true if OptBookmarkRequest was set.
+func (o OptBookmarkRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBookmarkRequest) Reset() {
+	var v BookmarkRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBookmarkRequest) SetTo(v BookmarkRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBookmarkRequest) Get() (v BookmarkRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBookmarkRequest) Or(d BookmarkRequest) BookmarkRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDeadlineProgress returns new OptDeadlineProgress with value set to v.
+func NewOptDeadlineProgress(v DeadlineProgress) OptDeadlineProgress {
+	return OptDeadlineProgress{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDeadlineProgress is optional DeadlineProgress.
+type OptDeadlineProgress struct {
+	Value DeadlineProgress
+	Set   bool
+}
+
+// IsSet returns true if OptDeadlineProgress was set.
+func (o OptDeadlineProgress) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDeadlineProgress) Reset() {
+	var v DeadlineProgress
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDeadlineProgress) SetTo(v DeadlineProgress) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDeadlineProgress) Get() (v DeadlineProgress, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDeadlineProgress) Or(d DeadlineProgress) DeadlineProgress {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGoal returns new OptGoal with value set to v.
+func NewOptGoal(v Goal) OptGoal {
+	return OptGoal{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGoal is optional Goal.
+type OptGoal struct {
+	Value Goal
+	Set   bool
+}
+
+// IsSet returns true if OptGoal was set.
+func (o OptGoal) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGoal) Reset() {
+	var v Goal
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGoal) SetTo(v Goal) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGoal) Get() (v Goal, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGoal) Or(d Goal) Goal {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGoalFromTemplateRequest returns new OptGoalFromTemplateRequest with value set to v.
+func NewOptGoalFromTemplateRequest(v GoalFromTemplateRequest) OptGoalFromTemplateRequest {
+	return OptGoalFromTemplateRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGoalFromTemplateRequest is optional GoalFromTemplateRequest.
+type OptGoalFromTemplateRequest struct {
+	Value GoalFromTemplateRequest
+	Set   bool
+}
+
+// IsSet returns true if OptGoalFromTemplateRequest was set.
+func (o OptGoalFromTemplateRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGoalFromTemplateRequest) Reset() {
+	var v GoalFromTemplateRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGoalFromTemplateRequest) SetTo(v GoalFromTemplateRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGoalFromTemplateRequest) Get() (v GoalFromTemplateRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGoalFromTemplateRequest) Or(d GoalFromTemplateRequest) GoalFromTemplateRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGoalSort returns new OptGoalSort with value set to v.
+func NewOptGoalSort(v GoalSort) OptGoalSort {
+	return OptGoalSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGoalSort is optional GoalSort.
+type OptGoalSort struct {
+	Value GoalSort
+	Set   bool
+}
+
+// IsSet returns true if OptGoalSort was set.
+func (o OptGoalSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGoalSort) Reset() {
+	var v GoalSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGoalSort) SetTo(v GoalSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGoalSort) Get() (v GoalSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGoalSort) Or(d GoalSort) GoalSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptImagesPostReq returns new OptImagesPostReq with value set to v.
+func NewOptImagesPostReq(v ImagesPostReq) OptImagesPostReq {
+	return OptImagesPostReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptImagesPostReq is optional ImagesPostReq.
+type OptImagesPostReq struct {
+	Value ImagesPostReq
+	Set   bool
+}
+
+// IsSet returns true if OptImagesPostReq was set.
+func (o OptImagesPostReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptImagesPostReq) Reset() {
+	var v ImagesPostReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptImagesPostReq) SetTo(v ImagesPostReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptImagesPostReq) Get() (v ImagesPostReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptImagesPostReq) Or(d ImagesPostReq) ImagesPostReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLinkPreview returns new OptLinkPreview with value set to v.
+func NewOptLinkPreview(v LinkPreview) OptLinkPreview {
+	return OptLinkPreview{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLinkPreview is optional LinkPreview.
+type OptLinkPreview struct {
+	Value LinkPreview
+	Set   bool
+}
+
+// IsSet returns true if OptLinkPreview was set.
+func (o OptLinkPreview) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLinkPreview) Reset() {
+	var v LinkPreview
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLinkPreview) SetTo(v LinkPreview) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLinkPreview) Get() (v LinkPreview, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLinkPreview) Or(d LinkPreview) LinkPreview {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptModerationNotice returns new OptModerationNotice with value set to v.
+func NewOptModerationNotice(v ModerationNotice) OptModerationNotice {
+	return OptModerationNotice{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptModerationNotice is optional ModerationNotice.
+type OptModerationNotice struct {
+	Value ModerationNotice
+	Set   bool
+}
+
+// IsSet returns true if OptModerationNotice was set.
+func (o OptModerationNotice) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptModerationNotice) Reset() {
+	var v ModerationNotice
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptModerationNotice) SetTo(v ModerationNotice) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptModerationNotice) Get() (v ModerationNotice, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptModerationNotice) Or(d ModerationNotice) ModerationNotice {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptModerationTargetType returns new OptModerationTargetType with value set to v.
+func NewOptModerationTargetType(v ModerationTargetType) OptModerationTargetType {
+	return OptModerationTargetType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptModerationTargetType is optional ModerationTargetType.
+type OptModerationTargetType struct {
+	Value ModerationTargetType
+	Set   bool
+}
+
+// IsSet returns true if OptModerationTargetType was set.
+func (o OptModerationTargetType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptModerationTargetType) Reset() {
+	var v ModerationTargetType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptModerationTargetType) SetTo(v ModerationTargetType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptModerationTargetType) Get() (v ModerationTargetType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptModerationTargetType) Or(d ModerationTargetType) ModerationTargetType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMultipartFile returns new OptMultipartFile with value set to v.
+func NewOptMultipartFile(v ht.MultipartFile) OptMultipartFile {
+	return OptMultipartFile{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMultipartFile is optional ht.MultipartFile.
+type OptMultipartFile struct {
+	Value ht.MultipartFile
+	Set   bool
+}
+
+// IsSet returns true if OptMultipartFile was set.
+func (o OptMultipartFile) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMultipartFile) Reset() {
+	var v ht.MultipartFile
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMultipartFile) SetTo(v ht.MultipartFile) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMultipartFile) Or(d ht.MultipartFile) ht.MultipartFile {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNotificationReadRequest returns new OptNotificationReadRequest with value set to v.
+func NewOptNotificationReadRequest(v NotificationReadRequest) OptNotificationReadRequest {
+	return OptNotificationReadRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNotificationReadRequest is optional NotificationReadRequest.
+type OptNotificationReadRequest struct {
+	Value NotificationReadRequest
+	Set   bool
+}
+
+// IsSet returns true if OptNotificationReadRequest was set.
+func (o OptNotificationReadRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNotificationReadRequest) Reset() {
+	var v NotificationReadRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNotificationReadRequest) SetTo(v NotificationReadRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNotificationReadRequest) Get() (v NotificationReadRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNotificationReadRequest) Or(d NotificationReadRequest) NotificationReadRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPost returns new OptPost with value set to v.
+func NewOptPost(v Post) OptPost {
+	return OptPost{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPost is optional Post.
+type OptPost struct {
+	Value Post
+	Set   bool
+}
+
+// IsSet returns true if OptPost was set.
+func (o OptPost) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPost) Reset() {
+	var v Post
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPost) SetTo(v Post) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPost) Get() (v Post, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPost) Or(d Post) Post {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPostFormat returns new OptPostFormat with value set to v.
+func NewOptPostFormat(v PostFormat) OptPostFormat {
+	return OptPostFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPostFormat is optional PostFormat.
+type OptPostFormat struct {
+	Value PostFormat
+	Set   bool
+}
+
+// IsSet returns true if OptPostFormat was set.
+func (o OptPostFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPostFormat) Reset() {
+	var v PostFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPostFormat) SetTo(v PostFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPostFormat) Get() (v PostFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPostFormat) Or(d PostFormat) PostFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPostStatus returns new OptPostStatus with value set to v.
+func NewOptPostStatus(v PostStatus) OptPostStatus {
+	return OptPostStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPostStatus is optional PostStatus.
+type OptPostStatus struct {
+	Value PostStatus
+	Set   bool
+}
+
+// IsSet returns true if OptPostStatus was set.
+func (o OptPostStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPostStatus) Reset() {
+	var v PostStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPostStatus) SetTo(v PostStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPostStatus) Get() (v PostStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPostStatus) Or(d PostStatus) PostStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptQuotedPost returns new OptQuotedPost with value set to v.
+func NewOptQuotedPost(v QuotedPost) OptQuotedPost {
+	return OptQuotedPost{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptQuotedPost is optional QuotedPost.
+type OptQuotedPost struct {
+	Value QuotedPost
+	Set   bool
+}
+
+// IsSet returns true if OptQuotedPost was set.
+func (o OptQuotedPost) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptQuotedPost) Reset() {
+	var v QuotedPost
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptQuotedPost) SetTo(v QuotedPost) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptQuotedPost) Get() (v QuotedPost, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptQuotedPost) Or(d QuotedPost) QuotedPost {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReactionRequest returns new OptReactionRequest with value set to v.
+func NewOptReactionRequest(v ReactionRequest) OptReactionRequest {
+	return OptReactionRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReactionRequest is optional ReactionRequest.
+type OptReactionRequest struct {
+	Value ReactionRequest
+	Set   bool
+}
+
+// IsSet returns true if OptReactionRequest was set.
+func (o OptReactionRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReactionRequest) Reset() {
+	var v ReactionRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReactionRequest) SetTo(v ReactionRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReactionRequest) Get() (v ReactionRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReactionRequest) Or(d ReactionRequest) ReactionRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReportResolution returns new OptReportResolution with value set to v.
+func NewOptReportResolution(v ReportResolution) OptReportResolution {
+	return OptReportResolution{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReportResolution is optional ReportResolution.
+type OptReportResolution struct {
+	Value ReportResolution
+	Set   bool
+}
+
+// IsSet returns true if OptReportResolution was set.
+func (o OptReportResolution) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReportResolution) Reset() {
+	var v ReportResolution
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReportResolution) SetTo(v ReportResolution) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReportResolution) Get() (v ReportResolution, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReportResolution) Or(d ReportResolution) ReportResolution {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReportSource returns new OptReportSource with value set to v.
+func NewOptReportSource(v ReportSource) OptReportSource {
+	return OptReportSource{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReportSource is optional ReportSource.
+type OptReportSource struct {
+	Value ReportSource
+	Set   bool
+}
+
+// IsSet returns true if OptReportSource was set.
+func (o OptReportSource) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReportSource) Reset() {
+	var v ReportSource
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReportSource) SetTo(v ReportSource) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReportSource) Get() (v ReportSource, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReportSource) Or(d ReportSource) ReportSource {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReportStatus returns new OptReportStatus with value set to v.
+func NewOptReportStatus(v ReportStatus) OptReportStatus {
+	return OptReportStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReportStatus is optional ReportStatus.
+type OptReportStatus struct {
+	Value ReportStatus
+	Set   bool
+}
+
+// IsSet returns true if OptReportStatus was set.
+func (o OptReportStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReportStatus) Reset() {
+	var v ReportStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReportStatus) SetTo(v ReportStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReportStatus) Get() (v ReportStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReportStatus) Or(d ReportStatus) ReportStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReportTargetType returns new OptReportTargetType with value set to v.
+func NewOptReportTargetType(v ReportTargetType) OptReportTargetType {
+	return OptReportTargetType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReportTargetType is optional ReportTargetType.
+type OptReportTargetType struct {
+	Value ReportTargetType
+	Set   bool
+}
+
+// IsSet returns true if OptReportTargetType was set.
+func (o OptReportTargetType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReportTargetType) Reset() {
+	var v ReportTargetType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReportTargetType) SetTo(v ReportTargetType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReportTargetType) Get() (v ReportTargetType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReportTargetType) Or(d ReportTargetType) ReportTargetType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchType returns new OptSearchType with value set to v.
+func NewOptSearchType(v SearchType) OptSearchType {
+	return OptSearchType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchType is optional SearchType.
+type OptSearchType struct {
+	Value SearchType
+	Set   bool
+}
+
+// IsSet returns true if OptSearchType was set.
+func (o OptSearchType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchType) Reset() {
+	var v SearchType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchType) SetTo(v SearchType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchType) Get() (v SearchType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchType) Or(d SearchType) SearchType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUsersUserIDIconPostReq returns new OptUsersUserIDIconPostReq with value set to v.
+func NewOptUsersUserIDIconPostReq(v UsersUserIDIconPostReq) OptUsersUserIDIconPostReq {
+	return OptUsersUserIDIconPostReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUsersUserIDIconPostReq is optional UsersUserIDIconPostReq.
+type OptUsersUserIDIconPostReq struct {
+	Value UsersUserIDIconPostReq
+	Set   bool
+}
+
+// IsSet returns true if OptUsersUserIDIconPostReq was set.
+func (o OptUsersUserIDIconPostReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUsersUserIDIconPostReq) Reset() {
+	var v UsersUserIDIconPostReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUsersUserIDIconPostReq) SetTo(v UsersUserIDIconPostReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUsersUserIDIconPostReq) Get() (v UsersUserIDIconPostReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUsersUserIDIconPostReq) Or(d UsersUserIDIconPostReq) UsersUserIDIconPostReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Post
+type Post struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	GoalID uuid.UUID `json:"goal_id"`
+	// 変換前の本文.
+	Content string     `json:"content"`
+	Format  PostFormat `json:"format"`
+	// 本文を書式に従って変換し、無害化したHTML。生のHTMLは出力されず、リンクにはrel="nofollow"が付きます.
+	ContentHTML string     `json:"content_html"`
+	Status      PostStatus `json:"status"`
+	// 予約投稿の公開予定日時.
+	PublishAt OptDateTime `json:"publish_at"`
+	// 進捗量（勉強時間の分数など。単位は利用者が目標ごとに決める）.
+	Amount    OptFloat64 `json:"amount"`
+	ImageUrls []string   `json:"image_urls"`
+	// 種類ごとのリアクション数。有効な種類をすべて表示順で含みます.
+	Reactions []ReactionSummary `json:"reactions"`
+	// 閲覧者がブックマークしているか（未認証の場合はfalse）.
+	Bookmarked bool `json:"bookmarked"`
+	// リポストされた数.
+	RepostCount int `json:"repost_count"`
+	// 公開済みの引用投稿の数.
+	QuoteCount int `json:"quote_count"`
+	// 閲覧者がリポストしているか（未認証の場合はfalse）.
+	Reposted   bool          `json:"reposted"`
+	QuotedPost OptQuotedPost `json:"quoted_post"`
+	// 引用元の投稿を閲覧者が閲覧できない場合にtrue（quoted_postは省略されます）。引用元が削除された場合は省略されます.
+	QuoteUnavailable OptBool `json:"quote_unavailable"`
+	// タイムラインでリポストによって含まれた場合の、リポストしたユーザーのID.
+	RepostedBy OptUUID `json:"reposted_by"`
+	// タイムラインでリポストによって含まれた場合の、リポストした日時.
+	RepostedAt       OptDateTime         `json:"reposted_at"`
+	LinkPreview      OptLinkPreview      `json:"link_preview"`
+	ModerationNotice OptModerationNotice `json:"moderation_notice"`
+	// 削除されていないコメント（返信を含む）の数.
+	CommentCount int `json:"comment_count"`
+	// 本文中のメンションとハッシュタグ（出現順）.
+	Entities []PostEntity `json:"entities"`
+	// 本文・書式・進捗量・画像が一度でも編集されたか.
+	Edited bool `json:"edited"`
+	// 編集回数.
+	EditCount int       `json:"edit_count"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Post) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *Post) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetGoalID returns the value of GoalID.
+func (s *Post) GetGoalID() uuid.UUID {
+	return s.GoalID
+}
+
+// GetContent returns the value of Content.
+func (s *Post) GetContent() string {
+	return s.Content
+}
+
+// GetFormat returns the value of Format.
+func (s *Post) GetFormat() PostFormat {
+	return s.Format
+}
+
+// GetContentHTML returns the value of ContentHTML.
+func (s *Post) GetContentHTML() string {
+	return s.ContentHTML
+}
+
+// GetStatus returns the value of Status.
+func (s *Post) GetStatus() PostStatus {
+	return s.Status
+}
+
+// GetPublishAt returns the value of PublishAt.
+func (s *Post) GetPublishAt() OptDateTime {
+	return s.PublishAt
+}
+
+// GetAmount returns the value of Amount.
+func (s *Post) GetAmount() OptFloat64 {
+	return s.Amount
+}
+
+// GetImageUrls returns the value of ImageUrls.
+func (s *Post) GetImageUrls() []string {
+	return s.ImageUrls
+}
+
+// GetReactions returns the value of Reactions.
+func (s *Post) GetReactions() []ReactionSummary {
+	return s.Reactions
+}
+
+// GetBookmarked returns the value of Bookmarked.
+func (s *Post) GetBookmarked() bool {
+	return s.Bookmarked
+}
+
+// GetRepostCount returns the value of RepostCount.
+func (s *Post) GetRepostCount() int {
+	return s.RepostCount
+}
+
+// GetQuoteCount returns the value of QuoteCount.
+func (s *Post) GetQuoteCount() int {
+	return s.QuoteCount
+}
+
+// GetReposted returns the value of Reposted.
+func (s *Post) GetReposted() bool {
+	return s.Reposted
+}
+
+// GetQuotedPost returns the value of QuotedPost.
+func (s *Post) GetQuotedPost() OptQuotedPost {
+	return s.QuotedPost
+}
+
+// GetQuoteUnavailable returns the value of QuoteUnavailable.
+func (s *Post) GetQuoteUnavailable() OptBool {
+	return s.QuoteUnavailable
+}
+
+// GetRepostedBy returns the value of RepostedBy.
+func (s *Post) GetRepostedBy() OptUUID {
+	return s.RepostedBy
+}
+
+// GetRepostedAt returns the value of RepostedAt.
+func (s *Post) GetRepostedAt() OptDateTime {
+	return s.RepostedAt
+}
+
+// GetLinkPreview returns the value of LinkPreview.
+func (s *Post) GetLinkPreview() OptLinkPreview {
+	return s.LinkPreview
+}
+
+// GetModerationNotice returns the value of ModerationNotice.
+func (s *Post) GetModerationNotice() OptModerationNotice {
+	return s.ModerationNotice
+}
+
+// GetCommentCount returns the value of CommentCount.
+func (s *Post) GetCommentCount() int {
+	return s.CommentCount
+}
+
+// GetEntities returns the value of Entities.
+func (s *Post) GetEntities() []PostEntity {
+	return s.Entities
+}
+
+// GetEdited returns the value of Edited.
+func (s *Post) GetEdited() bool {
+	return s.Edited
+}
+
+// GetEditCount returns the value of EditCount.
+func (s *Post) GetEditCount() int {
+	return s.EditCount
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Post) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Post) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Post) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *Post) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetGoalID sets the value of GoalID.
+func (s *Post) SetGoalID(val uuid.UUID) {
+	s.GoalID = val
+}
+
+// SetContent sets the value of Content.
+func (s *Post) SetContent(val string) {
+	s.Content = val
+}
+
+// SetFormat sets the value of Format.
+func (s *Post) SetFormat(val PostFormat) {
+	s.Format = val
+}
+
+// SetContentHTML sets the value of ContentHTML.
+func (s *Post) SetContentHTML(val string) {
+	s.ContentHTML = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Post) SetStatus(val PostStatus) {
+	s.Status = val
+}
+
+// SetPublishAt sets the value of PublishAt.
+func (s *Post) SetPublishAt(val OptDateTime) {
+	s.PublishAt = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *Post) SetAmount(val OptFloat64) {
+	s.Amount = val
+}
+
+// SetImageUrls sets the value of ImageUrls.
+func (s *Post) SetImageUrls(val []string) {
+	s.ImageUrls = val
+}
+
+// SetReactions sets the value of Reactions.
+func (s *Post) SetReactions(val []ReactionSummary) {
+	s.Reactions = val
+}
+
+// SetBookmarked sets the value of Bookmarked.
+func (s *Post) SetBookmarked(val bool) {
+	s.Bookmarked = val
+}
+
+// SetRepostCount sets the value of RepostCount.
+func (s *Post) SetRepostCount(val int) {
+	s.RepostCount = val
+}
+
+// SetQuoteCount sets the value of QuoteCount.
+func (s *Post) SetQuoteCount(val int) {
+	s.QuoteCount = val
+}
+
+// SetReposted sets the value of Reposted.
+func (s *Post) SetReposted(val bool) {
+	s.Reposted = val
+}
+
+// SetQuotedPost sets the value of QuotedPost.
+func (s *Post) SetQuotedPost(val OptQuotedPost) {
+	s.QuotedPost = val
+}
+
+// SetQuoteUnavailable sets the value of QuoteUnavailable.
+func (s *Post) SetQuoteUnavailable(val OptBool) {
+	s.QuoteUnavailable = val
+}
+
+// SetRepostedBy sets the value of RepostedBy.
+func (s *Post) SetRepostedBy(val OptUUID) {
+	s.RepostedBy = val
+}
+
+// SetRepostedAt sets the value of RepostedAt.
+func (s *Post) SetRepostedAt(val OptDateTime) {
+	s.RepostedAt = val
+}
+
+// SetLinkPreview sets the value of LinkPreview.
+func (s *Post) SetLinkPreview(val OptLinkPreview) {
+	s.LinkPreview = val
+}
+
+// SetModerationNotice sets the value of ModerationNotice.
+func (s *Post) SetModerationNotice(val OptModerationNotice) {
+	s.ModerationNotice = val
+}
+
+// SetCommentCount sets the value of CommentCount.
+func (s *Post) SetCommentCount(val int) {
+	s.CommentCount = val
+}
+
+// SetEntities sets the value of Entities.
+func (s *Post) SetEntities(val []PostEntity) {
+	s.Entities = val
+}
+
+// SetEdited sets the value of Edited.
+func (s *Post) SetEdited(val bool) {
+	s.Edited = val
+}
+
+// SetEditCount sets the value of EditCount.
+func (s *Post) SetEditCount(val int) {
+	s.EditCount = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Post) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Post) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*Post) postsPostIDGetRes()                          {}
+func (*Post) postsPostIDPutRes()                          {}
+func (*Post) postsPostIDRepostPostRes()                   {}
+func (*Post) postsPostIDRevisionsRevisionRestorePostRes() {}
+func (*Post) postsPostRes()                               {}
+
+// Ref: #/components/schemas/PostEntity
+type PostEntity struct {
+	Type PostEntityType `json:"type"`
+	// 本文中の開始位置（Unicodeコードポイント単位、"@"や"#"を含む）.
+	Start int `json:"start"`
+	// 本文中の終了位置（この位置の文字は含まない）.
+	End int `json:"end"`
+	// メンションはハンドル、ハッシュタグは正規化したタグ名（記号を含まない）.
+	Value string `json:"value"`
+	// メンションされたユーザーのID（mentionのみ）.
+	UserID OptUUID `json:"user_id"`
+}
+
+// GetType returns the value of Type.
+func (s *PostEntity) GetType() PostEntityType {
+	return s.Type
+}
+
+// GetStart returns the value of Start.
+func (s *PostEntity) GetStart() int {
+	return s.Start
+}
+
+// GetEnd returns the value of End.
+func (s *PostEntity) GetEnd() int {
+	return s.End
+}
+
+// GetValue returns the value of Value.
+func (s *PostEntity) GetValue() string {
+	return s.Value
+}
+
+// GetUserID returns the value of UserID.
+func (s *PostEntity) GetUserID() OptUUID {
+	return s.UserID
+}
+
+// SetType sets the value of Type.
+func (s *PostEntity) SetType(val PostEntityType) {
+	s.Type = val
+}
+
+// SetStart sets the value of Start.
+func (s *PostEntity) SetStart(val int) {
+	s.Start = val
+}
+
+// SetEnd sets the value of End.
+func (s *PostEntity) SetEnd(val int) {
+	s.End = val
+}
+
+// SetValue sets the value of Value.
+func (s *PostEntity) SetValue(val string) {
+	s.Value = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *PostEntity) SetUserID(val OptUUID) {
+	s.UserID = val
+}
+
+type PostEntityType string
+
+const (
+	PostEntityTypeMention PostEntityType = "mention"
+	PostEntityTypeHashtag PostEntityType = "hashtag"
+)
+
+// AllValues returns all PostEntityType values.
+func (PostEntityType) AllValues() []PostEntityType {
+	return []PostEntityType{
+		PostEntityTypeMention,
+		PostEntityTypeHashtag,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PostEntityType) MarshalText() ([]byte, error) {
+	switch s {
+	case PostEntityTypeMention:
+		return []byte(s), nil
+	case PostEntityTypeHashtag:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PostEntityType) UnmarshalText(data []byte) error {
+	switch PostEntityType(data) {
+	case PostEntityTypeMention:
+		*s = PostEntityTypeMention
+		return nil
+	case PostEntityTypeHashtag:
+		*s = PostEntityTypeHashtag
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// 本文の書式。作成時に省略するとplain、更新時に省略すると現在の書式を維持します.
+// Ref: #/components/schemas/PostFormat
+type PostFormat string
+
+const (
+	PostFormatPlain    PostFormat = "plain"
+	PostFormatMarkdown PostFormat = "markdown"
+)
+
+// AllValues returns all PostFormat values.
+func (PostFormat) AllValues() []PostFormat {
+	return []PostFormat{
+		PostFormatPlain,
+		PostFormatMarkdown,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PostFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case PostFormatPlain:
+		return []byte(s), nil
+	case PostFormatMarkdown:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PostFormat) UnmarshalText(data []byte) error {
+	switch PostFormat(data) {
+	case PostFormatPlain:
+		*s = PostFormatPlain
+		return nil
+	case PostFormatMarkdown:
+		*s = PostFormatMarkdown
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/PostRequest
+type PostRequest struct {
+	GoalID uuid.UUID `json:"goal_id"`
+	// 本文（文字数の上限は変換前の本文に対して適用されます）.
+	Content string        `json:"content"`
+	Format  OptPostFormat `json:"format"`
+	Status  OptPostStatus `json:"status"`
+	// 公開予定日時。statusがscheduledの場合は必須で、未来の日時を指定します.
+	PublishAt OptDateTime `json:"publish_at"`
+	// 引用する投稿のID（引用投稿として作成する場合）。閲覧できる公開済みの投稿のみ指定できます。作成後は変更できません.
+	QuotePostID OptUUID `json:"quote_post_id"`
+	// 進捗量（勉強時間の分数など）.
+	Amount OptFloat64 `json:"amount"`
+	// 自分がアップロードし、他の投稿に紐付いていない画像のID。枚数の上限はサーバー設定に従います（既定4枚）。.
+	ImageIds []uuid.UUID `json:"image_ids"`
+}
+
+// GetGoalID returns the value of GoalID.
+func (s *PostRequest) GetGoalID() uuid.UUID {
+	return s.GoalID
+}
+
+// GetContent returns the value of Content.
+func (s *PostRequest) GetContent() string {
+	return s.Content
+}
+
+// GetFormat returns the value of Format.
+func (s *PostRequest) GetFormat() OptPostFormat {
+	return s.Format
+}
+
+// GetStatus returns the value of Status.
+func (s *PostRequest) GetStatus() OptPostStatus {
+	return s.Status
+}
+
+// GetPublishAt returns the value of PublishAt.
+func (s *PostRequest) GetPublishAt() OptDateTime {
+	return s.PublishAt
+}
+
+// GetQuotePostID returns the value of QuotePostID.
+func (s *PostRequest) GetQuotePostID() OptUUID {
+	return s.QuotePostID
+}
+
+// GetAmount returns the value of Amount.
+func (s *PostRequest) GetAmount() OptFloat64 {
+	return s.Amount
+}
+
+// GetImageIds returns the value of ImageIds.
+func (s *PostRequest) GetImageIds() []uuid.UUID {
+	return s.ImageIds
+}
+
+// SetGoalID sets the value of GoalID.
+func (s *PostRequest) SetGoalID(val uuid.UUID) {
+	s.GoalID = val
+}
+
+// SetContent sets the value of Content.
+func (s *PostRequest) SetContent(val string) {
+	s.Content = val
+}
+
+// SetFormat sets the value of Format.
+func (s *PostRequest) SetFormat(val OptPostFormat) {
+	s.Format = val
+}
+
+// SetStatus sets the value of Status.
+func (s *PostRequest) SetStatus(val OptPostStatus) {
+	s.Status = val
+}
+
+// SetPublishAt sets the value of PublishAt.
+func (s *PostRequest) SetPublishAt(val OptDateTime) {
+	s.PublishAt = val
+}
+
+// SetQuotePostID sets the value of QuotePostID.
+func (s *PostRequest) SetQuotePostID(val OptUUID) {
+	s.QuotePostID = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *PostRequest) SetAmount(val OptFloat64) {
+	s.Amount = val
+}
+
+// SetImageIds sets the value of ImageIds.
+func (s *PostRequest) SetImageIds(val []uuid.UUID) {
+	s.ImageIds = val
+}
+
+// Ref: #/components/schemas/PostRevision
+type PostRevision struct {
+	// 版番号（最初の投稿内容が1）.
+	Revision    int        `json:"revision"`
+	Content     string     `json:"content"`
+	Format      PostFormat `json:"format"`
+	ContentHTML string     `json:"content_html"`
+	Amount      OptFloat64 `json:"amount"`
+	ImageUrls   []string   `json:"image_urls"`
+	// この版が書かれた日時.
+	CreatedAt time.Time `json:"created_at"`
+	// 次の版に置き換えられた日時.
+	ReplacedAt time.Time `json:"replaced_at"`
+}
+
+// GetRevision returns the value of Revision.
+func (s *PostRevision) GetRevision() int {
+	return s.Revision
+}
+
+// GetContent returns the value of Content.
+func (s *PostRevision) GetContent() string {
+	return s.Content
+}
+
+// GetFormat returns the value of Format.
+func (s *PostRevision) GetFormat() PostFormat {
+	return s.Format
+}
+
+// GetContentHTML returns the value of ContentHTML.
+func (s *PostRevision) GetContentHTML() string {
+	return s.ContentHTML
+}
+
+// GetAmount returns the value of Amount.
+func (s *PostRevision) GetAmount() OptFloat64 {
+	return s.Amount
+}
+
+// GetImageUrls returns the value of ImageUrls.
+func (s *PostRevision) GetImageUrls() []string {
+	return s.ImageUrls
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PostRevision) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetReplacedAt returns the value of ReplacedAt.
+func (s *PostRevision) GetReplacedAt() time.Time {
+	return s.ReplacedAt
+}
+
+// SetRevision sets the value of Revision.
+func (s *PostRevision) SetRevision(val int) {
+	s.Revision = val
+}
+
+// SetContent sets the value of Content.
+func (s *PostRevision) SetContent(val string) {
+	s.Content = val
+}
+
+// SetFormat sets the value of Format.
+func (s *PostRevision) SetFormat(val PostFormat) {
+	s.Format = val
+}
+
+// SetContentHTML sets the value of ContentHTML.
+func (s *PostRevision) SetContentHTML(val string) {
+	s.ContentHTML = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *PostRevision) SetAmount(val OptFloat64) {
+	s.Amount = val
+}
+
+// SetImageUrls sets the value of ImageUrls.
+func (s *PostRevision) SetImageUrls(val []string) {
+	s.ImageUrls = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PostRevision) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetReplacedAt sets the value of ReplacedAt.
+func (s *PostRevision) SetReplacedAt(val time.Time) {
+	s.ReplacedAt = val
+}
+
+// 投稿の公開状態。下書き（draft）と予約投稿（scheduled）は作成者本人にのみ表示され、タイムラインや集計に含まれません。
+// 予約投稿は公開予定日時を過ぎると自動で公開されます。作成時に省略するとpublished、更新時に省略すると現在の状態を維持します。
+// 公開済みの投稿を下書き・予約投稿に戻すことはできません。.
+// Ref: #/components/schemas/PostStatus
+type PostStatus string
+
+const (
+	PostStatusDraft     PostStatus = "draft"
+	PostStatusScheduled PostStatus = "scheduled"
+	PostStatusPublished PostStatus = "published"
+)
+
+// AllValues returns all PostStatus values.
+func (PostStatus) AllValues() []PostStatus {
+	return []PostStatus{
+		PostStatusDraft,
+		PostStatusScheduled,
+		PostStatusPublished,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PostStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case PostStatusDraft:
+		return []byte(s), nil
+	case PostStatusScheduled:
+		return []byte(s), nil
+	case PostStatusPublished:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PostStatus) UnmarshalText(data []byte) error {
+	switch PostStatus(data) {
+	case PostStatusDraft:
+		*s = PostStatusDraft
+		return nil
+	case PostStatusScheduled:
+		*s = PostStatusScheduled
+		return nil
+	case PostStatusPublished:
+		*s = PostStatusPublished
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PostsGetBadRequest Error
+
+func (*PostsGetBadRequest) postsGetRes() {}
+
+// PostsGetOKHeaders wraps []Post with response headers.
+type PostsGetOKHeaders struct {
+	Link     OptString
+	Response []Post
+}
+
+// GetLink returns the value of Link.
+func (s *PostsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
 
-func (*GoalsGoalIDDeleteNoContent) goalsGoalIDDeleteRes() {}
+// GetResponse returns the value of Response.
+func (s *PostsGetOKHeaders) GetResponse() []Post {
+	return s.Response
+}
 
-type GoalsGoalIDDeleteNotFound Error
+// SetLink sets the value of Link.
+func (s *PostsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
 
-func (*GoalsGoalIDDeleteNotFound) goalsGoalIDDeleteRes() {}
+// SetResponse sets the value of Response.
+func (s *PostsGetOKHeaders) SetResponse(val []Post) {
+	s.Response = val
+}
 
-type GoalsGoalIDDeleteUnauthorized Error
+func (*PostsGetOKHeaders) postsGetRes() {}
 
-func (*GoalsGoalIDDeleteUnauthorized) goalsGoalIDDeleteRes() {}
+type PostsGetUnauthorized Error
 
-type GoalsGoalIDPutBadRequest Error
+func (*PostsGetUnauthorized) postsGetRes() {}
 
-func (*GoalsGoalIDPutBadRequest) goalsGoalIDPutRes() {}
+type PostsPostBadRequest Error
 
-type GoalsGoalIDPutNotFound Error
+func (*PostsPostBadRequest) postsPostRes() {}
 
-func (*GoalsGoalIDPutNotFound) goalsGoalIDPutRes() {}
+type PostsPostForbidden Error
 
-type GoalsGoalIDPutUnauthorized Error
+func (*PostsPostForbidden) postsPostRes() {}
 
-func (*GoalsGoalIDPutUnauthorized) goalsGoalIDPutRes() {}
+// PostsPostIDBookmarkDeleteNoContent is response for PostsPostIDBookmarkDelete operation.
+type PostsPostIDBookmarkDeleteNoContent struct{}
 
-type GoalsPostBadRequest Error
+func (*PostsPostIDBookmarkDeleteNoContent) postsPostIDBookmarkDeleteRes() {}
 
-func (*GoalsPostBadRequest) goalsPostRes() {}
+type PostsPostIDBookmarkDeleteNotFound Error
 
-type GoalsPostUnauthorized Error
+func (*PostsPostIDBookmarkDeleteNotFound) postsPostIDBookmarkDeleteRes() {}
 
-func (*GoalsPostUnauthorized) goalsPostRes() {}
+type PostsPostIDBookmarkDeleteUnauthorized Error
 
-// Ref: #/components/schemas/Image
-type Image struct {
-	ID  uuid.UUID `json:"id"`
-	URL string    `json:"url"`
+func (*PostsPostIDBookmarkDeleteUnauthorized) postsPostIDBookmarkDeleteRes() {}
+
+type PostsPostIDBookmarkPostBadRequest Error
+
+func (*PostsPostIDBookmarkPostBadRequest) postsPostIDBookmarkPostRes() {}
+
+type PostsPostIDBookmarkPostNotFound Error
+
+func (*PostsPostIDBookmarkPostNotFound) postsPostIDBookmarkPostRes() {}
+
+type PostsPostIDBookmarkPostUnauthorized Error
+
+func (*PostsPostIDBookmarkPostUnauthorized) postsPostIDBookmarkPostRes() {}
+
+type PostsPostIDCommentsGetBadRequest Error
+
+func (*PostsPostIDCommentsGetBadRequest) postsPostIDCommentsGetRes() {}
+
+type PostsPostIDCommentsGetNotFound Error
+
+func (*PostsPostIDCommentsGetNotFound) postsPostIDCommentsGetRes() {}
+
+// PostsPostIDCommentsGetOKHeaders wraps []Comment with response headers.
+type PostsPostIDCommentsGetOKHeaders struct {
+	Link     OptString
+	Response []Comment
+}
+
+// GetLink returns the value of Link.
+func (s *PostsPostIDCommentsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *PostsPostIDCommentsGetOKHeaders) GetResponse() []Comment {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *PostsPostIDCommentsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *PostsPostIDCommentsGetOKHeaders) SetResponse(val []Comment) {
+	s.Response = val
+}
+
+func (*PostsPostIDCommentsGetOKHeaders) postsPostIDCommentsGetRes() {}
+
+type PostsPostIDCommentsPostBadRequest Error
+
+func (*PostsPostIDCommentsPostBadRequest) postsPostIDCommentsPostRes() {}
+
+type PostsPostIDCommentsPostForbidden Error
+
+func (*PostsPostIDCommentsPostForbidden) postsPostIDCommentsPostRes() {}
+
+type PostsPostIDCommentsPostNotFound Error
+
+func (*PostsPostIDCommentsPostNotFound) postsPostIDCommentsPostRes() {}
+
+type PostsPostIDCommentsPostUnauthorized Error
+
+func (*PostsPostIDCommentsPostUnauthorized) postsPostIDCommentsPostRes() {}
+
+type PostsPostIDDeleteForbidden Error
+
+func (*PostsPostIDDeleteForbidden) postsPostIDDeleteRes() {}
+
+// PostsPostIDDeleteNoContent is response for PostsPostIDDelete operation.
+type PostsPostIDDeleteNoContent struct{}
+
+func (*PostsPostIDDeleteNoContent) postsPostIDDeleteRes() {}
+
+type PostsPostIDDeleteNotFound Error
+
+func (*PostsPostIDDeleteNotFound) postsPostIDDeleteRes() {}
+
+type PostsPostIDDeleteUnauthorized Error
+
+func (*PostsPostIDDeleteUnauthorized) postsPostIDDeleteRes() {}
+
+type PostsPostIDPutBadRequest Error
+
+func (*PostsPostIDPutBadRequest) postsPostIDPutRes() {}
+
+type PostsPostIDPutConflict Error
+
+func (*PostsPostIDPutConflict) postsPostIDPutRes() {}
+
+type PostsPostIDPutForbidden Error
+
+func (*PostsPostIDPutForbidden) postsPostIDPutRes() {}
+
+type PostsPostIDPutNotFound Error
+
+func (*PostsPostIDPutNotFound) postsPostIDPutRes() {}
+
+type PostsPostIDPutUnauthorized Error
+
+func (*PostsPostIDPutUnauthorized) postsPostIDPutRes() {}
+
+// PostsPostIDReactionsDeleteNoContent is response for PostsPostIDReactionsDelete operation.
+type PostsPostIDReactionsDeleteNoContent struct{}
+
+func (*PostsPostIDReactionsDeleteNoContent) postsPostIDReactionsDeleteRes() {}
+
+type PostsPostIDReactionsDeleteNotFound Error
+
+func (*PostsPostIDReactionsDeleteNotFound) postsPostIDReactionsDeleteRes() {}
+
+type PostsPostIDReactionsDeleteUnauthorized Error
+
+func (*PostsPostIDReactionsDeleteUnauthorized) postsPostIDReactionsDeleteRes() {}
+
+type PostsPostIDReactionsGetBadRequest Error
+
+func (*PostsPostIDReactionsGetBadRequest) postsPostIDReactionsGetRes() {}
+
+type PostsPostIDReactionsGetNotFound Error
+
+func (*PostsPostIDReactionsGetNotFound) postsPostIDReactionsGetRes() {}
+
+// PostsPostIDReactionsGetOKHeaders wraps []Reaction with response headers.
+type PostsPostIDReactionsGetOKHeaders struct {
+	Link     OptString
+	Response []Reaction
+}
+
+// GetLink returns the value of Link.
+func (s *PostsPostIDReactionsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *PostsPostIDReactionsGetOKHeaders) GetResponse() []Reaction {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *PostsPostIDReactionsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *PostsPostIDReactionsGetOKHeaders) SetResponse(val []Reaction) {
+	s.Response = val
+}
+
+func (*PostsPostIDReactionsGetOKHeaders) postsPostIDReactionsGetRes() {}
+
+type PostsPostIDReactionsPostBadRequest Error
+
+func (*PostsPostIDReactionsPostBadRequest) postsPostIDReactionsPostRes() {}
+
+type PostsPostIDReactionsPostConflict Error
+
+func (*PostsPostIDReactionsPostConflict) postsPostIDReactionsPostRes() {}
+
+// PostsPostIDReactionsPostCreated is response for PostsPostIDReactionsPost operation.
+type PostsPostIDReactionsPostCreated struct{}
+
+func (*PostsPostIDReactionsPostCreated) postsPostIDReactionsPostRes() {}
+
+type PostsPostIDReactionsPostNotFound Error
+
+func (*PostsPostIDReactionsPostNotFound) postsPostIDReactionsPostRes() {}
+
+type PostsPostIDReactionsPostUnauthorized Error
+
+func (*PostsPostIDReactionsPostUnauthorized) postsPostIDReactionsPostRes() {}
+
+// PostsPostIDRepostDeleteNoContent is response for PostsPostIDRepostDelete operation.
+type PostsPostIDRepostDeleteNoContent struct{}
+
+func (*PostsPostIDRepostDeleteNoContent) postsPostIDRepostDeleteRes() {}
+
+type PostsPostIDRepostDeleteNotFound Error
+
+func (*PostsPostIDRepostDeleteNotFound) postsPostIDRepostDeleteRes() {}
+
+type PostsPostIDRepostDeleteUnauthorized Error
+
+func (*PostsPostIDRepostDeleteUnauthorized) postsPostIDRepostDeleteRes() {}
+
+type PostsPostIDRepostPostNotFound Error
+
+func (*PostsPostIDRepostPostNotFound) postsPostIDRepostPostRes() {}
+
+type PostsPostIDRepostPostUnauthorized Error
+
+func (*PostsPostIDRepostPostUnauthorized) postsPostIDRepostPostRes() {}
+
+type PostsPostIDRevisionsGetForbidden Error
+
+func (*PostsPostIDRevisionsGetForbidden) postsPostIDRevisionsGetRes() {}
+
+type PostsPostIDRevisionsGetNotFound Error
+
+func (*PostsPostIDRevisionsGetNotFound) postsPostIDRevisionsGetRes() {}
+
+type PostsPostIDRevisionsGetOKApplicationJSON []PostRevision
+
+func (*PostsPostIDRevisionsGetOKApplicationJSON) postsPostIDRevisionsGetRes() {}
+
+type PostsPostIDRevisionsRevisionRestorePostConflict Error
+
+func (*PostsPostIDRevisionsRevisionRestorePostConflict) postsPostIDRevisionsRevisionRestorePostRes() {
+}
+
+type PostsPostIDRevisionsRevisionRestorePostForbidden Error
+
+func (*PostsPostIDRevisionsRevisionRestorePostForbidden) postsPostIDRevisionsRevisionRestorePostRes() {
+}
+
+type PostsPostIDRevisionsRevisionRestorePostNotFound Error
+
+func (*PostsPostIDRevisionsRevisionRestorePostNotFound) postsPostIDRevisionsRevisionRestorePostRes() {
+}
+
+type PostsPostIDRevisionsRevisionRestorePostUnauthorized Error
+
+func (*PostsPostIDRevisionsRevisionRestorePostUnauthorized) postsPostIDRevisionsRevisionRestorePostRes() {
+}
+
+type PostsPostUnauthorized Error
+
+func (*PostsPostUnauthorized) postsPostRes() {}
+
+// 引用元の投稿の概要.
+// Ref: #/components/schemas/QuotedPost
+type QuotedPost struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Content     string     `json:"content"`
+	Format      PostFormat `json:"format"`
+	ContentHTML string     `json:"content_html"`
+	CreatedAt   time.Time  `json:"created_at"`
 }
 
 // GetID returns the value of ID.
-func (s *Image) GetID() uuid.UUID {
+func (s *QuotedPost) GetID() uuid.UUID {
 	return s.ID
 }
 
-// GetURL returns the value of URL.
-func (s *Image) GetURL() string {
-	return s.URL
+// GetUserID returns the value of UserID.
+func (s *QuotedPost) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetContent returns the value of Content.
+func (s *QuotedPost) GetContent() string {
+	return s.Content
+}
+
+// GetFormat returns the value of Format.
+func (s *QuotedPost) GetFormat() PostFormat {
+	return s.Format
+}
+
+// GetContentHTML returns the value of ContentHTML.
+func (s *QuotedPost) GetContentHTML() string {
+	return s.ContentHTML
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *QuotedPost) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
 // SetID sets the value of ID.
-func (s *Image) SetID(val uuid.UUID) {
+func (s *QuotedPost) SetID(val uuid.UUID) {
 	s.ID = val
 }
 
-// SetURL sets the value of URL.
-func (s *Image) SetURL(val string) {
-	s.URL = val
+// SetUserID sets the value of UserID.
+func (s *QuotedPost) SetUserID(val uuid.UUID) {
+	s.UserID = val
 }
 
-func (*Image) imagesPostRes() {}
+// SetContent sets the value of Content.
+func (s *QuotedPost) SetContent(val string) {
+	s.Content = val
+}
 
-type ImagesImageIDGetOK struct {
-	Data io.Reader
+// SetFormat sets the value of Format.
+func (s *QuotedPost) SetFormat(val PostFormat) {
+	s.Format = val
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s ImagesImageIDGetOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// SetContentHTML sets the value of ContentHTML.
+func (s *QuotedPost) SetContentHTML(val string) {
+	s.ContentHTML = val
 }
 
-func (*ImagesImageIDGetOK) imagesImageIDGetRes() {}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *QuotedPost) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
 
-type ImagesPostBadRequest Error
+// Ref: #/components/schemas/Reaction
+type Reaction struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Kind      string    `json:"kind"`
+	CreatedAt time.Time `json:"created_at"`
+}
 
-func (*ImagesPostBadRequest) imagesPostRes() {}
+// GetUserID returns the value of UserID.
+func (s *Reaction) GetUserID() uuid.UUID {
+	return s.UserID
+}
 
-type ImagesPostReq struct {
-	Image OptMultipartFile `json:"image"`
+// GetKind returns the value of Kind.
+func (s *Reaction) GetKind() string {
+	return s.Kind
 }
 
-// GetImage returns the value of Image.
-func (s *ImagesPostReq) GetImage() OptMultipartFile {
-	return s.Image
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Reaction) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetImage sets the value of Image.
-func (s *ImagesPostReq) SetImage(val OptMultipartFile) {
-	s.Image = val
+// SetUserID sets the value of UserID.
+func (s *Reaction) SetUserID(val uuid.UUID) {
+	s.UserID = val
 }
 
-type ImagesPostUnauthorized Error
+// SetKind sets the value of Kind.
+func (s *Reaction) SetKind(val string) {
+	s.Kind = val
+}
 
-func (*ImagesPostUnauthorized) imagesPostRes() {}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Reaction) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
 
-// NewOptDate returns new OptDate with value set to v.
-func NewOptDate(v time.Time) OptDate {
-	return OptDate{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/components/schemas/ReactionKind
+type ReactionKind struct {
+	Kind  string `json:"kind"`
+	Emoji string `json:"emoji"`
 }
 
-// OptDate is optional time.Time.
-type OptDate struct {
-	Value time.Time
-	Set   bool
+// GetKind returns the value of Kind.
+func (s *ReactionKind) GetKind() string {
+	return s.Kind
 }
 
-// IsSet returns true if OptDate was set.
-func (o OptDate) IsSet() bool { return o.Set }
+// GetEmoji returns the value of Emoji.
+func (s *ReactionKind) GetEmoji() string {
+	return s.Emoji
+}
 
-// Reset unsets value.
-func (o *OptDate) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// SetKind sets the value of Kind.
+func (s *ReactionKind) SetKind(val string) {
+	s.Kind = val
 }
 
-// SetTo sets value to v.
-func (o *OptDate) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// SetEmoji sets the value of Emoji.
+func (s *ReactionKind) SetEmoji(val string) {
+	s.Emoji = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDate) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// Ref: #/components/schemas/ReactionRequest
+type ReactionRequest struct {
+	// リアクションの種類（`GET /reactions/kinds`で取得できる値）.
+	Kind OptString `json:"kind"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDate) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetKind returns the value of Kind.
+func (s *ReactionRequest) GetKind() OptString {
+	return s.Kind
 }
 
-// NewOptImagesPostReq returns new OptImagesPostReq with value set to v.
-func NewOptImagesPostReq(v ImagesPostReq) OptImagesPostReq {
-	return OptImagesPostReq{
-		Value: v,
-		Set:   true,
-	}
+// SetKind sets the value of Kind.
+func (s *ReactionRequest) SetKind(val OptString) {
+	s.Kind = val
 }
 
-// OptImagesPostReq is optional ImagesPostReq.
-type OptImagesPostReq struct {
-	Value ImagesPostReq
-	Set   bool
+// Ref: #/components/schemas/ReactionSummary
+type ReactionSummary struct {
+	Kind string `json:"kind"`
+	// この種類のリアクションの数.
+	Count int `json:"count"`
+	// 閲覧者がこの種類のリアクションをしているか（未認証の場合はfalse）.
+	Reacted bool `json:"reacted"`
 }
 
-// IsSet returns true if OptImagesPostReq was set.
-func (o OptImagesPostReq) IsSet() bool { return o.Set }
+// GetKind returns the value of Kind.
+func (s *ReactionSummary) GetKind() string {
+	return s.Kind
+}
 
-// Reset unsets value.
-func (o *OptImagesPostReq) Reset() {
-	var v ImagesPostReq
-	o.Value = v
-	o.Set = false
+// GetCount returns the value of Count.
+func (s *ReactionSummary) GetCount() int {
+	return s.Count
 }
 
-// SetTo sets value to v.
-func (o *OptImagesPostReq) SetTo(v ImagesPostReq) {
-	o.Set = true
-	o.Value = v
+// GetReacted returns the value of Reacted.
+func (s *ReactionSummary) GetReacted() bool {
+	return s.Reacted
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptImagesPostReq) Get() (v ImagesPostReq, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetKind sets the value of Kind.
+func (s *ReactionSummary) SetKind(val string) {
+	s.Kind = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptImagesPostReq) Or(d ImagesPostReq) ImagesPostReq {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetCount sets the value of Count.
+func (s *ReactionSummary) SetCount(val int) {
+	s.Count = val
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// SetReacted sets the value of Reacted.
+func (s *ReactionSummary) SetReacted(val bool) {
+	s.Reacted = val
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// Ref: #/components/schemas/Report
+type Report struct {
+	ID         uuid.UUID        `json:"id"`
+	Source     ReportSource     `json:"source"`
+	TargetType ReportTargetType `json:"target_type"`
+	TargetID   uuid.UUID        `json:"target_id"`
+	Reason     ReportReason     `json:"reason"`
+	// 報告者が書いた詳細。source=filterの場合は判定した規則と理由です.
+	Text           OptString           `json:"text"`
+	Status         ReportStatus        `json:"status"`
+	Resolution     OptReportResolution `json:"resolution"`
+	ResolutionNote OptString           `json:"resolution_note"`
+	// 報告したユーザーのID（source=filterの場合はありません）.
+	ReporterID OptUUID `json:"reporter_id"`
+	// 担当しているモデレーターのID.
+	ModeratorID OptUUID     `json:"moderator_id"`
+	CreatedAt   time.Time   `json:"created_at"`
+	ClaimedAt   OptDateTime `json:"claimed_at"`
+	ResolvedAt  OptDateTime `json:"resolved_at"`
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// GetID returns the value of ID.
+func (s *Report) GetID() uuid.UUID {
+	return s.ID
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// GetSource returns the value of Source.
+func (s *Report) GetSource() ReportSource {
+	return s.Source
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// GetTargetType returns the value of TargetType.
+func (s *Report) GetTargetType() ReportTargetType {
+	return s.TargetType
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetTargetID returns the value of TargetID.
+func (s *Report) GetTargetID() uuid.UUID {
+	return s.TargetID
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetReason returns the value of Reason.
+func (s *Report) GetReason() ReportReason {
+	return s.Reason
 }
 
-// NewOptMultipartFile returns new OptMultipartFile with value set to v.
-func NewOptMultipartFile(v ht.MultipartFile) OptMultipartFile {
-	return OptMultipartFile{
-		Value: v,
-		Set:   true,
-	}
+// GetText returns the value of Text.
+func (s *Report) GetText() OptString {
+	return s.Text
 }
 
-// OptMultipartFile is optional ht.MultipartFile.
-type OptMultipartFile struct {
-	Value ht.MultipartFile
-	Set   bool
+// GetStatus returns the value of Status.
+func (s *Report) GetStatus() ReportStatus {
+	return s.Status
 }
 
-// IsSet returns true if OptMultipartFile was set.
-func (o OptMultipartFile) IsSet() bool { return o.Set }
+// GetResolution returns the value of Resolution.
+func (s *Report) GetResolution() OptReportResolution {
+	return s.Resolution
+}
 
-// Reset unsets value.
-func (o *OptMultipartFile) Reset() {
-	var v ht.MultipartFile
-	o.Value = v
-	o.Set = false
+// GetResolutionNote returns the value of ResolutionNote.
+func (s *Report) GetResolutionNote() OptString {
+	return s.ResolutionNote
 }
 
-// SetTo sets value to v.
-func (o *OptMultipartFile) SetTo(v ht.MultipartFile) {
-	o.Set = true
-	o.Value = v
+// GetReporterID returns the value of ReporterID.
+func (s *Report) GetReporterID() OptUUID {
+	return s.ReporterID
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetModeratorID returns the value of ModeratorID.
+func (s *Report) GetModeratorID() OptUUID {
+	return s.ModeratorID
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMultipartFile) Or(d ht.MultipartFile) ht.MultipartFile {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Report) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetClaimedAt returns the value of ClaimedAt.
+func (s *Report) GetClaimedAt() OptDateTime {
+	return s.ClaimedAt
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetResolvedAt returns the value of ResolvedAt.
+func (s *Report) GetResolvedAt() OptDateTime {
+	return s.ResolvedAt
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// SetID sets the value of ID.
+func (s *Report) SetID(val uuid.UUID) {
+	s.ID = val
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// SetSource sets the value of Source.
+func (s *Report) SetSource(val ReportSource) {
+	s.Source = val
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// SetTargetType sets the value of TargetType.
+func (s *Report) SetTargetType(val ReportTargetType) {
+	s.TargetType = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetTargetID sets the value of TargetID.
+func (s *Report) SetTargetID(val uuid.UUID) {
+	s.TargetID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetReason sets the value of Reason.
+func (s *Report) SetReason(val ReportReason) {
+	s.Reason = val
 }
 
-// NewOptUUID returns new OptUUID with value set to v.
-func NewOptUUID(v uuid.UUID) OptUUID {
-	return OptUUID{
-		Value: v,
-		Set:   true,
-	}
+// SetText sets the value of Text.
+func (s *Report) SetText(val OptString) {
+	s.Text = val
 }
 
-// OptUUID is optional uuid.UUID.
-type OptUUID struct {
-	Value uuid.UUID
-	Set   bool
+// SetStatus sets the value of Status.
+func (s *Report) SetStatus(val ReportStatus) {
+	s.Status = val
 }
 
-// IsSet returns true if OptUUID was set.
-func (o OptUUID) IsSet() bool { return o.Set }
+// SetResolution sets the value of Resolution.
+func (s *Report) SetResolution(val OptReportResolution) {
+	s.Resolution = val
+}
 
-// Reset unsets value.
-func (o *OptUUID) Reset() {
-	var v uuid.UUID
-	o.Value = v
-	o.Set = false
+// SetResolutionNote sets the value of ResolutionNote.
+func (s *Report) SetResolutionNote(val OptString) {
+	s.ResolutionNote = val
 }
 
-// SetTo sets value to v.
-func (o *OptUUID) SetTo(v uuid.UUID) {
-	o.Set = true
-	o.Value = v
+// SetReporterID sets the value of ReporterID.
+func (s *Report) SetReporterID(val OptUUID) {
+	s.ReporterID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUUID) Get() (v uuid.UUID, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetModeratorID sets the value of ModeratorID.
+func (s *Report) SetModeratorID(val OptUUID) {
+	s.ModeratorID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Report) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// NewOptUsersUserIDIconPostReq returns new OptUsersUserIDIconPostReq with value set to v.
-func NewOptUsersUserIDIconPostReq(v UsersUserIDIconPostReq) OptUsersUserIDIconPostReq {
-	return OptUsersUserIDIconPostReq{
-		Value: v,
-		Set:   true,
-	}
+// SetClaimedAt sets the value of ClaimedAt.
+func (s *Report) SetClaimedAt(val OptDateTime) {
+	s.ClaimedAt = val
 }
 
-// OptUsersUserIDIconPostReq is optional UsersUserIDIconPostReq.
-type OptUsersUserIDIconPostReq struct {
-	Value UsersUserIDIconPostReq
-	Set   bool
+// SetResolvedAt sets the value of ResolvedAt.
+func (s *Report) SetResolvedAt(val OptDateTime) {
+	s.ResolvedAt = val
 }
 
-// IsSet returns true if OptUsersUserIDIconPostReq was set.
-func (o OptUsersUserIDIconPostReq) IsSet() bool { return o.Set }
+func (*Report) adminReportsReportIDClaimPostRes()   {}
+func (*Report) adminReportsReportIDResolvePostRes() {}
+func (*Report) reportsPostRes()                     {}
 
-// Reset unsets value.
-func (o *OptUsersUserIDIconPostReq) Reset() {
-	var v UsersUserIDIconPostReq
-	o.Value = v
-	o.Set = false
-}
+// Ref: #/components/schemas/ReportReason
+type ReportReason string
 
-// SetTo sets value to v.
-func (o *OptUsersUserIDIconPostReq) SetTo(v UsersUserIDIconPostReq) {
-	o.Set = true
-	o.Value = v
-}
+const (
+	ReportReasonSpam           ReportReason = "spam"
+	ReportReasonHarassment     ReportReason = "harassment"
+	ReportReasonHate           ReportReason = "hate"
+	ReportReasonViolence       ReportReason = "violence"
+	ReportReasonSexual         ReportReason = "sexual"
+	ReportReasonMisinformation ReportReason = "misinformation"
+	ReportReasonOther          ReportReason = "other"
+)
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUsersUserIDIconPostReq) Get() (v UsersUserIDIconPostReq, ok bool) {
-	if !o.Set {
-		return v, false
+// AllValues returns all ReportReason values.
+func (ReportReason) AllValues() []ReportReason {
+	return []ReportReason{
+		ReportReasonSpam,
+		ReportReasonHarassment,
+		ReportReasonHate,
+		ReportReasonViolence,
+		ReportReasonSexual,
+		ReportReasonMisinformation,
+		ReportReasonOther,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUsersUserIDIconPostReq) Or(d UsersUserIDIconPostReq) UsersUserIDIconPostReq {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportReason) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportReasonSpam:
+		return []byte(s), nil
+	case ReportReasonHarassment:
+		return []byte(s), nil
+	case ReportReasonHate:
+		return []byte(s), nil
+	case ReportReasonViolence:
+		return []byte(s), nil
+	case ReportReasonSexual:
+		return []byte(s), nil
+	case ReportReasonMisinformation:
+		return []byte(s), nil
+	case ReportReasonOther:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// Ref: #/components/schemas/Post
-type Post struct {
-	ID        uuid.UUID `json:"id"`
-	UserID    uuid.UUID `json:"user_id"`
-	GoalID    uuid.UUID `json:"goal_id"`
-	Content   string    `json:"content"`
-	ImageUrls []string  `json:"image_urls"`
-	// リアクション（いいね）の数.
-	ReactionCount int       `json:"reaction_count"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportReason) UnmarshalText(data []byte) error {
+	switch ReportReason(data) {
+	case ReportReasonSpam:
+		*s = ReportReasonSpam
+		return nil
+	case ReportReasonHarassment:
+		*s = ReportReasonHarassment
+		return nil
+	case ReportReasonHate:
+		*s = ReportReasonHate
+		return nil
+	case ReportReasonViolence:
+		*s = ReportReasonViolence
+		return nil
+	case ReportReasonSexual:
+		*s = ReportReasonSexual
+		return nil
+	case ReportReasonMisinformation:
+		*s = ReportReasonMisinformation
+		return nil
+	case ReportReasonOther:
+		*s = ReportReasonOther
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetID returns the value of ID.
-func (s *Post) GetID() uuid.UUID {
-	return s.ID
+// Ref: #/components/schemas/ReportRequest
+type ReportRequest struct {
+	TargetType ReportTargetType `json:"target_type"`
+	TargetID   uuid.UUID        `json:"target_id"`
+	Reason     ReportReason     `json:"reason"`
+	// 報告の詳細.
+	Text OptString `json:"text"`
 }
 
-// GetUserID returns the value of UserID.
-func (s *Post) GetUserID() uuid.UUID {
-	return s.UserID
+// GetTargetType returns the value of TargetType.
+func (s *ReportRequest) GetTargetType() ReportTargetType {
+	return s.TargetType
 }
 
-// GetGoalID returns the value of GoalID.
-func (s *Post) GetGoalID() uuid.UUID {
-	return s.GoalID
+// GetTargetID returns the value of TargetID.
+func (s *ReportRequest) GetTargetID() uuid.UUID {
+	return s.TargetID
+}
+
+// GetReason returns the value of Reason.
+func (s *ReportRequest) GetReason() ReportReason {
+	return s.Reason
 }
 
-// GetContent returns the value of Content.
-func (s *Post) GetContent() string {
-	return s.Content
+// GetText returns the value of Text.
+func (s *ReportRequest) GetText() OptString {
+	return s.Text
 }
 
-// GetImageUrls returns the value of ImageUrls.
-func (s *Post) GetImageUrls() []string {
-	return s.ImageUrls
+// SetTargetType sets the value of TargetType.
+func (s *ReportRequest) SetTargetType(val ReportTargetType) {
+	s.TargetType = val
 }
 
-// GetReactionCount returns the value of ReactionCount.
-func (s *Post) GetReactionCount() int {
-	return s.ReactionCount
+// SetTargetID sets the value of TargetID.
+func (s *ReportRequest) SetTargetID(val uuid.UUID) {
+	s.TargetID = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Post) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// SetReason sets the value of Reason.
+func (s *ReportRequest) SetReason(val ReportReason) {
+	s.Reason = val
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Post) GetUpdatedAt() time.Time {
-	return s.UpdatedAt
+// SetText sets the value of Text.
+func (s *ReportRequest) SetText(val OptString) {
+	s.Text = val
 }
 
-// SetID sets the value of ID.
-func (s *Post) SetID(val uuid.UUID) {
-	s.ID = val
+// 対応結果。action_taken（対象に措置を行った）、dismissed（措置は不要と判断した）.
+// Ref: #/components/schemas/ReportResolution
+type ReportResolution string
+
+const (
+	ReportResolutionActionTaken ReportResolution = "action_taken"
+	ReportResolutionDismissed   ReportResolution = "dismissed"
+)
+
+// AllValues returns all ReportResolution values.
+func (ReportResolution) AllValues() []ReportResolution {
+	return []ReportResolution{
+		ReportResolutionActionTaken,
+		ReportResolutionDismissed,
+	}
 }
 
-// SetUserID sets the value of UserID.
-func (s *Post) SetUserID(val uuid.UUID) {
-	s.UserID = val
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportResolution) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportResolutionActionTaken:
+		return []byte(s), nil
+	case ReportResolutionDismissed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetGoalID sets the value of GoalID.
-func (s *Post) SetGoalID(val uuid.UUID) {
-	s.GoalID = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportResolution) UnmarshalText(data []byte) error {
+	switch ReportResolution(data) {
+	case ReportResolutionActionTaken:
+		*s = ReportResolutionActionTaken
+		return nil
+	case ReportResolutionDismissed:
+		*s = ReportResolutionDismissed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetContent sets the value of Content.
-func (s *Post) SetContent(val string) {
-	s.Content = val
+// Ref: #/components/schemas/ReportResolveRequest
+type ReportResolveRequest struct {
+	Resolution ReportResolution `json:"resolution"`
+	Note       OptString        `json:"note"`
 }
 
-// SetImageUrls sets the value of ImageUrls.
-func (s *Post) SetImageUrls(val []string) {
-	s.ImageUrls = val
+// GetResolution returns the value of Resolution.
+func (s *ReportResolveRequest) GetResolution() ReportResolution {
+	return s.Resolution
 }
 
-// SetReactionCount sets the value of ReactionCount.
-func (s *Post) SetReactionCount(val int) {
-	s.ReactionCount = val
+// GetNote returns the value of Note.
+func (s *ReportResolveRequest) GetNote() OptString {
+	return s.Note
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Post) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetResolution sets the value of Resolution.
+func (s *ReportResolveRequest) SetResolution(val ReportResolution) {
+	s.Resolution = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *Post) SetUpdatedAt(val time.Time) {
-	s.UpdatedAt = val
+// SetNote sets the value of Note.
+func (s *ReportResolveRequest) SetNote(val OptString) {
+	s.Note = val
 }
 
-func (*Post) postsPostIDGetRes() {}
-func (*Post) postsPostIDPutRes() {}
-func (*Post) postsPostRes()      {}
+// 報告の経路。user（ユーザーからの報告）、filter（迷惑行為フィルタによる保留）.
+// Ref: #/components/schemas/ReportSource
+type ReportSource string
 
-// Ref: #/components/schemas/PostRequest
-type PostRequest struct {
-	GoalID   uuid.UUID   `json:"goal_id"`
-	Content  string      `json:"content"`
-	ImageIds []uuid.UUID `json:"image_ids"`
-}
+const (
+	ReportSourceUser   ReportSource = "user"
+	ReportSourceFilter ReportSource = "filter"
+)
 
-// GetGoalID returns the value of GoalID.
-func (s *PostRequest) GetGoalID() uuid.UUID {
-	return s.GoalID
+// AllValues returns all ReportSource values.
+func (ReportSource) AllValues() []ReportSource {
+	return []ReportSource{
+		ReportSourceUser,
+		ReportSourceFilter,
+	}
 }
 
-// GetContent returns the value of Content.
-func (s *PostRequest) GetContent() string {
-	return s.Content
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportSource) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportSourceUser:
+		return []byte(s), nil
+	case ReportSourceFilter:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetImageIds returns the value of ImageIds.
-func (s *PostRequest) GetImageIds() []uuid.UUID {
-	return s.ImageIds
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportSource) UnmarshalText(data []byte) error {
+	switch ReportSource(data) {
+	case ReportSourceUser:
+		*s = ReportSourceUser
+		return nil
+	case ReportSourceFilter:
+		*s = ReportSourceFilter
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetGoalID sets the value of GoalID.
-func (s *PostRequest) SetGoalID(val uuid.UUID) {
-	s.GoalID = val
+// 対応状況。open（未対応）、claimed（担当者が対応中）、resolved（対応済み）.
+// Ref: #/components/schemas/ReportStatus
+type ReportStatus string
+
+const (
+	ReportStatusOpen     ReportStatus = "open"
+	ReportStatusClaimed  ReportStatus = "claimed"
+	ReportStatusResolved ReportStatus = "resolved"
+)
+
+// AllValues returns all ReportStatus values.
+func (ReportStatus) AllValues() []ReportStatus {
+	return []ReportStatus{
+		ReportStatusOpen,
+		ReportStatusClaimed,
+		ReportStatusResolved,
+	}
 }
 
-// SetContent sets the value of Content.
-func (s *PostRequest) SetContent(val string) {
-	s.Content = val
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportStatusOpen:
+		return []byte(s), nil
+	case ReportStatusClaimed:
+		return []byte(s), nil
+	case ReportStatusResolved:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetImageIds sets the value of ImageIds.
-func (s *PostRequest) SetImageIds(val []uuid.UUID) {
-	s.ImageIds = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportStatus) UnmarshalText(data []byte) error {
+	switch ReportStatus(data) {
+	case ReportStatusOpen:
+		*s = ReportStatusOpen
+		return nil
+	case ReportStatusClaimed:
+		*s = ReportStatusClaimed
+		return nil
+	case ReportStatusResolved:
+		*s = ReportStatusResolved
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-type PostsGetBadRequest Error
+// 報告の対象の種類。userはプロフィールを表します.
+// Ref: #/components/schemas/ReportTargetType
+type ReportTargetType string
 
-func (*PostsGetBadRequest) postsGetRes() {}
+const (
+	ReportTargetTypePost    ReportTargetType = "post"
+	ReportTargetTypeComment ReportTargetType = "comment"
+	ReportTargetTypeUser    ReportTargetType = "user"
+)
 
-type PostsGetOKApplicationJSON []Post
+// AllValues returns all ReportTargetType values.
+func (ReportTargetType) AllValues() []ReportTargetType {
+	return []ReportTargetType{
+		ReportTargetTypePost,
+		ReportTargetTypeComment,
+		ReportTargetTypeUser,
+	}
+}
 
-func (*PostsGetOKApplicationJSON) postsGetRes() {}
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportTargetType) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportTargetTypePost:
+		return []byte(s), nil
+	case ReportTargetTypeComment:
+		return []byte(s), nil
+	case ReportTargetTypeUser:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-type PostsGetUnauthorized Error
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportTargetType) UnmarshalText(data []byte) error {
+	switch ReportTargetType(data) {
+	case ReportTargetTypePost:
+		*s = ReportTargetTypePost
+		return nil
+	case ReportTargetTypeComment:
+		*s = ReportTargetTypeComment
+		return nil
+	case ReportTargetTypeUser:
+		*s = ReportTargetTypeUser
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-func (*PostsGetUnauthorized) postsGetRes() {}
+type ReportsPostBadRequest Error
 
-type PostsPostBadRequest Error
+func (*ReportsPostBadRequest) reportsPostRes() {}
 
-func (*PostsPostBadRequest) postsPostRes() {}
+type ReportsPostConflict Error
 
-// PostsPostIDDeleteNoContent is response for PostsPostIDDelete operation.
-type PostsPostIDDeleteNoContent struct{}
+func (*ReportsPostConflict) reportsPostRes() {}
 
-func (*PostsPostIDDeleteNoContent) postsPostIDDeleteRes() {}
+type ReportsPostNotFound Error
 
-type PostsPostIDDeleteNotFound Error
+func (*ReportsPostNotFound) reportsPostRes() {}
 
-func (*PostsPostIDDeleteNotFound) postsPostIDDeleteRes() {}
+type ReportsPostUnauthorized Error
 
-type PostsPostIDDeleteUnauthorized Error
+func (*ReportsPostUnauthorized) reportsPostRes() {}
 
-func (*PostsPostIDDeleteUnauthorized) postsPostIDDeleteRes() {}
+// SearchGetOKHeaders wraps []SearchResult with response headers.
+type SearchGetOKHeaders struct {
+	Link     OptString
+	Response []SearchResult
+}
 
-type PostsPostIDPutBadRequest Error
+// GetLink returns the value of Link.
+func (s *SearchGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
 
-func (*PostsPostIDPutBadRequest) postsPostIDPutRes() {}
+// GetResponse returns the value of Response.
+func (s *SearchGetOKHeaders) GetResponse() []SearchResult {
+	return s.Response
+}
 
-type PostsPostIDPutNotFound Error
+// SetLink sets the value of Link.
+func (s *SearchGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
 
-func (*PostsPostIDPutNotFound) postsPostIDPutRes() {}
+// SetResponse sets the value of Response.
+func (s *SearchGetOKHeaders) SetResponse(val []SearchResult) {
+	s.Response = val
+}
 
-type PostsPostIDPutUnauthorized Error
+func (*SearchGetOKHeaders) searchGetRes() {}
 
-func (*PostsPostIDPutUnauthorized) postsPostIDPutRes() {}
+// Ref: #/components/schemas/SearchResult
+type SearchResult struct {
+	Type SearchType `json:"type"`
+	ID   uuid.UUID  `json:"id"`
+	// 一致した箇所の周辺の抜粋。一致した箇所は<mark>と</mark>で囲まれます。
+	// HTMLとしてエスケープ済みで、<mark>以外のタグは含みません。抜粋の前後が省略された場合は「…」が付きます。.
+	Snippet string  `json:"snippet"`
+	Post    OptPost `json:"post"`
+	Goal    OptGoal `json:"goal"`
+}
 
-// PostsPostIDReactionsDeleteNoContent is response for PostsPostIDReactionsDelete operation.
-type PostsPostIDReactionsDeleteNoContent struct{}
+// GetType returns the value of Type.
+func (s *SearchResult) GetType() SearchType {
+	return s.Type
+}
 
-func (*PostsPostIDReactionsDeleteNoContent) postsPostIDReactionsDeleteRes() {}
+// GetID returns the value of ID.
+func (s *SearchResult) GetID() uuid.UUID {
+	return s.ID
+}
 
-type PostsPostIDReactionsDeleteNotFound Error
+// GetSnippet returns the value of Snippet.
+func (s *SearchResult) GetSnippet() string {
+	return s.Snippet
+}
 
-func (*PostsPostIDReactionsDeleteNotFound) postsPostIDReactionsDeleteRes() {}
+// GetPost returns the value of Post.
+func (s *SearchResult) GetPost() OptPost {
+	return s.Post
+}
 
-type PostsPostIDReactionsDeleteUnauthorized Error
+// GetGoal returns the value of Goal.
+func (s *SearchResult) GetGoal() OptGoal {
+	return s.Goal
+}
 
-func (*PostsPostIDReactionsDeleteUnauthorized) postsPostIDReactionsDeleteRes() {}
+// SetType sets the value of Type.
+func (s *SearchResult) SetType(val SearchType) {
+	s.Type = val
+}
 
-type PostsPostIDReactionsGetOKApplicationJSON []Reaction
+// SetID sets the value of ID.
+func (s *SearchResult) SetID(val uuid.UUID) {
+	s.ID = val
+}
 
-func (*PostsPostIDReactionsGetOKApplicationJSON) postsPostIDReactionsGetRes() {}
+// SetSnippet sets the value of Snippet.
+func (s *SearchResult) SetSnippet(val string) {
+	s.Snippet = val
+}
 
-// PostsPostIDReactionsPostCreated is response for PostsPostIDReactionsPost operation.
-type PostsPostIDReactionsPostCreated struct{}
+// SetPost sets the value of Post.
+func (s *SearchResult) SetPost(val OptPost) {
+	s.Post = val
+}
 
-func (*PostsPostIDReactionsPostCreated) postsPostIDReactionsPostRes() {}
+// SetGoal sets the value of Goal.
+func (s *SearchResult) SetGoal(val OptGoal) {
+	s.Goal = val
+}
 
-type PostsPostIDReactionsPostNotFound Error
+// 検索対象。posts（投稿）またはgoals（目標）.
+// Ref: #/components/schemas/SearchType
+type SearchType string
 
-func (*PostsPostIDReactionsPostNotFound) postsPostIDReactionsPostRes() {}
+const (
+	SearchTypePosts SearchType = "posts"
+	SearchTypeGoals SearchType = "goals"
+)
 
-type PostsPostIDReactionsPostUnauthorized Error
+// AllValues returns all SearchType values.
+func (SearchType) AllValues() []SearchType {
+	return []SearchType{
+		SearchTypePosts,
+		SearchTypeGoals,
+	}
+}
 
-func (*PostsPostIDReactionsPostUnauthorized) postsPostIDReactionsPostRes() {}
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchType) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchTypePosts:
+		return []byte(s), nil
+	case SearchTypeGoals:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-type PostsPostUnauthorized Error
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchType) UnmarshalText(data []byte) error {
+	switch SearchType(data) {
+	case SearchTypePosts:
+		*s = SearchTypePosts
+		return nil
+	case SearchTypeGoals:
+		*s = SearchTypeGoals
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-func (*PostsPostUnauthorized) postsPostRes() {}
+type TimelineDiscoverGetBadRequest Error
 
-// Ref: #/components/schemas/Reaction
-type Reaction struct {
-	UserID    uuid.UUID `json:"user_id"`
-	CreatedAt time.Time `json:"created_at"`
+func (*TimelineDiscoverGetBadRequest) timelineDiscoverGetRes() {}
+
+// TimelineDiscoverGetOKHeaders wraps []Post with response headers.
+type TimelineDiscoverGetOKHeaders struct {
+	Link     OptString
+	Response []Post
 }
 
-// GetUserID returns the value of UserID.
-func (s *Reaction) GetUserID() uuid.UUID {
-	return s.UserID
+// GetLink returns the value of Link.
+func (s *TimelineDiscoverGetOKHeaders) GetLink() OptString {
+	return s.Link
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Reaction) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetResponse returns the value of Response.
+func (s *TimelineDiscoverGetOKHeaders) GetResponse() []Post {
+	return s.Response
 }
 
-// SetUserID sets the value of UserID.
-func (s *Reaction) SetUserID(val uuid.UUID) {
-	s.UserID = val
+// SetLink sets the value of Link.
+func (s *TimelineDiscoverGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Reaction) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetResponse sets the value of Response.
+func (s *TimelineDiscoverGetOKHeaders) SetResponse(val []Post) {
+	s.Response = val
 }
 
+func (*TimelineDiscoverGetOKHeaders) timelineDiscoverGetRes() {}
+
+type TimelineDiscoverGetUnauthorized Error
+
+func (*TimelineDiscoverGetUnauthorized) timelineDiscoverGetRes() {}
+
 type TimelineGetBadRequest Error
 
 func (*TimelineGetBadRequest) timelineGetRes() {}
 
-type TimelineGetOKApplicationJSON []Post
+// TimelineGetOKHeaders wraps []Post with response headers.
+type TimelineGetOKHeaders struct {
+	Link     OptString
+	Response []Post
+}
+
+// GetLink returns the value of Link.
+func (s *TimelineGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *TimelineGetOKHeaders) GetResponse() []Post {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *TimelineGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *TimelineGetOKHeaders) SetResponse(val []Post) {
+	s.Response = val
+}
 
-func (*TimelineGetOKApplicationJSON) timelineGetRes() {}
+func (*TimelineGetOKHeaders) timelineGetRes() {}
 
 type TimelineGetUnauthorized Error
 
@@ -994,12 +6206,15 @@ func (*TimelineGetUnauthorized) timelineGetRes() {}
 
 // Ref: #/components/schemas/User
 type User struct {
-	ID       uuid.UUID   `json:"id"`
-	Name     string      `json:"name"`
-	Birthday OptDate     `json:"birthday"`
-	Genres   []uuid.UUID `json:"genres"`
-	Hometown OptString   `json:"hometown"`
-	Bio      OptString   `json:"bio"`
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	// メンション用のハンドル（"@"を含まない）.
+	Handle           OptString           `json:"handle"`
+	Birthday         OptDate             `json:"birthday"`
+	Genres           []uuid.UUID         `json:"genres"`
+	Hometown         OptString           `json:"hometown"`
+	Bio              OptString           `json:"bio"`
+	ModerationNotice OptModerationNotice `json:"moderation_notice"`
 }
 
 // GetID returns the value of ID.
@@ -1012,6 +6227,11 @@ func (s *User) GetName() string {
 	return s.Name
 }
 
+// GetHandle returns the value of Handle.
+func (s *User) GetHandle() OptString {
+	return s.Handle
+}
+
 // GetBirthday returns the value of Birthday.
 func (s *User) GetBirthday() OptDate {
 	return s.Birthday
@@ -1032,6 +6252,11 @@ func (s *User) GetBio() OptString {
 	return s.Bio
 }
 
+// GetModerationNotice returns the value of ModerationNotice.
+func (s *User) GetModerationNotice() OptModerationNotice {
+	return s.ModerationNotice
+}
+
 // SetID sets the value of ID.
 func (s *User) SetID(val uuid.UUID) {
 	s.ID = val
@@ -1042,6 +6267,11 @@ func (s *User) SetName(val string) {
 	s.Name = val
 }
 
+// SetHandle sets the value of Handle.
+func (s *User) SetHandle(val OptString) {
+	s.Handle = val
+}
+
 // SetBirthday sets the value of Birthday.
 func (s *User) SetBirthday(val OptDate) {
 	s.Birthday = val
@@ -1062,6 +6292,11 @@ func (s *User) SetBio(val OptString) {
 	s.Bio = val
 }
 
+// SetModerationNotice sets the value of ModerationNotice.
+func (s *User) SetModerationNotice(val OptModerationNotice) {
+	s.ModerationNotice = val
+}
+
 func (*User) authMeGetRes()      {}
 func (*User) usersPostRes()      {}
 func (*User) usersUserIDGetRes() {}
@@ -1069,7 +6304,9 @@ func (*User) usersUserIDPutRes() {}
 
 // Ref: #/components/schemas/UserRequest
 type UserRequest struct {
-	Name     string      `json:"name"`
+	Name string `json:"name"`
+	// メンション用のハンドル。英字は小文字で保存されます。省略すると未設定になります.
+	Handle   OptString   `json:"handle"`
 	Birthday OptDate     `json:"birthday"`
 	Genres   []uuid.UUID `json:"genres"`
 	Hometown OptString   `json:"hometown"`
@@ -1081,6 +6318,11 @@ func (s *UserRequest) GetName() string {
 	return s.Name
 }
 
+// GetHandle returns the value of Handle.
+func (s *UserRequest) GetHandle() OptString {
+	return s.Handle
+}
+
 // GetBirthday returns the value of Birthday.
 func (s *UserRequest) GetBirthday() OptDate {
 	return s.Birthday
@@ -1106,6 +6348,11 @@ func (s *UserRequest) SetName(val string) {
 	s.Name = val
 }
 
+// SetHandle sets the value of Handle.
+func (s *UserRequest) SetHandle(val OptString) {
+	s.Handle = val
+}
+
 // SetBirthday sets the value of Birthday.
 func (s *UserRequest) SetBirthday(val OptDate) {
 	s.Birthday = val
@@ -1143,13 +6390,41 @@ type UsersUserIDDeleteUnauthorized Error
 
 func (*UsersUserIDDeleteUnauthorized) usersUserIDDeleteRes() {}
 
+type UsersUserIDFriendsGetBadRequest Error
+
+func (*UsersUserIDFriendsGetBadRequest) usersUserIDFriendsGetRes() {}
+
 type UsersUserIDFriendsGetNotFound Error
 
 func (*UsersUserIDFriendsGetNotFound) usersUserIDFriendsGetRes() {}
 
-type UsersUserIDFriendsGetOKApplicationJSON []uuid.UUID
+// UsersUserIDFriendsGetOKHeaders wraps []uuid.UUID with response headers.
+type UsersUserIDFriendsGetOKHeaders struct {
+	Link     OptString
+	Response []uuid.UUID
+}
+
+// GetLink returns the value of Link.
+func (s *UsersUserIDFriendsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *UsersUserIDFriendsGetOKHeaders) GetResponse() []uuid.UUID {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *UsersUserIDFriendsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *UsersUserIDFriendsGetOKHeaders) SetResponse(val []uuid.UUID) {
+	s.Response = val
+}
 
-func (*UsersUserIDFriendsGetOKApplicationJSON) usersUserIDFriendsGetRes() {}
+func (*UsersUserIDFriendsGetOKHeaders) usersUserIDFriendsGetRes() {}
 
 type UsersUserIDFriendsGetUnauthorized Error
 
@@ -1171,9 +6446,33 @@ type UsersUserIDGoalsGetNotFound Error
 
 func (*UsersUserIDGoalsGetNotFound) usersUserIDGoalsGetRes() {}
 
-type UsersUserIDGoalsGetOKApplicationJSON []Goal
+// UsersUserIDGoalsGetOKHeaders wraps []Goal with response headers.
+type UsersUserIDGoalsGetOKHeaders struct {
+	Link     OptString
+	Response []Goal
+}
+
+// GetLink returns the value of Link.
+func (s *UsersUserIDGoalsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *UsersUserIDGoalsGetOKHeaders) GetResponse() []Goal {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *UsersUserIDGoalsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *UsersUserIDGoalsGetOKHeaders) SetResponse(val []Goal) {
+	s.Response = val
+}
 
-func (*UsersUserIDGoalsGetOKApplicationJSON) usersUserIDGoalsGetRes() {}
+func (*UsersUserIDGoalsGetOKHeaders) usersUserIDGoalsGetRes() {}
 
 // UsersUserIDIconDeleteNoContent is response for UsersUserIDIconDelete operation.
 type UsersUserIDIconDeleteNoContent struct{}
@@ -1239,14 +6538,46 @@ type UsersUserIDPostsGetNotFound Error
 
 func (*UsersUserIDPostsGetNotFound) usersUserIDPostsGetRes() {}
 
-type UsersUserIDPostsGetOKApplicationJSON []Post
+// UsersUserIDPostsGetOKHeaders wraps []Post with response headers.
+type UsersUserIDPostsGetOKHeaders struct {
+	Link     OptString
+	Response []Post
+}
+
+// GetLink returns the value of Link.
+func (s *UsersUserIDPostsGetOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *UsersUserIDPostsGetOKHeaders) GetResponse() []Post {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *UsersUserIDPostsGetOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *UsersUserIDPostsGetOKHeaders) SetResponse(val []Post) {
+	s.Response = val
+}
 
-func (*UsersUserIDPostsGetOKApplicationJSON) usersUserIDPostsGetRes() {}
+func (*UsersUserIDPostsGetOKHeaders) usersUserIDPostsGetRes() {}
 
 type UsersUserIDPutBadRequest Error
 
 func (*UsersUserIDPutBadRequest) usersUserIDPutRes() {}
 
+type UsersUserIDPutConflict Error
+
+func (*UsersUserIDPutConflict) usersUserIDPutRes() {}
+
+type UsersUserIDPutForbidden Error
+
+func (*UsersUserIDPutForbidden) usersUserIDPutRes() {}
+
 type UsersUserIDPutNotFound Error
 
 func (*UsersUserIDPutNotFound) usersUserIDPutRes() {}
@@ -1254,3 +6585,80 @@ func (*UsersUserIDPutNotFound) usersUserIDPutRes() {}
 type UsersUserIDPutUnauthorized Error
 
 func (*UsersUserIDPutUnauthorized) usersUserIDPutRes() {}
+
+// Ref: #/components/schemas/Weekday
+type Weekday string
+
+const (
+	WeekdayMon Weekday = "mon"
+	WeekdayTue Weekday = "tue"
+	WeekdayWed Weekday = "wed"
+	WeekdayThu Weekday = "thu"
+	WeekdayFri Weekday = "fri"
+	WeekdaySat Weekday = "sat"
+	WeekdaySun Weekday = "sun"
+)
+
+// AllValues returns all Weekday values.
+func (Weekday) AllValues() []Weekday {
+	return []Weekday{
+		WeekdayMon,
+		WeekdayTue,
+		WeekdayWed,
+		WeekdayThu,
+		WeekdayFri,
+		WeekdaySat,
+		WeekdaySun,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Weekday) MarshalText() ([]byte, error) {
+	switch s {
+	case WeekdayMon:
+		return []byte(s), nil
+	case WeekdayTue:
+		return []byte(s), nil
+	case WeekdayWed:
+		return []byte(s), nil
+	case WeekdayThu:
+		return []byte(s), nil
+	case WeekdayFri:
+		return []byte(s), nil
+	case WeekdaySat:
+		return []byte(s), nil
+	case WeekdaySun:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Weekday) UnmarshalText(data []byte) error {
+	switch Weekday(data) {
+	case WeekdayMon:
+		*s = WeekdayMon
+		return nil
+	case WeekdayTue:
+		*s = WeekdayTue
+		return nil
+	case WeekdayWed:
+		*s = WeekdayWed
+		return nil
+	case WeekdayThu:
+		*s = WeekdayThu
+		return nil
+	case WeekdayFri:
+		*s = WeekdayFri
+		return nil
+	case WeekdaySat:
+		*s = WeekdaySat
+		return nil
+	case WeekdaySun:
+		*s = WeekdaySun
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
@@ -33,28 +33,73 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 }
 
 var operationRolesBearerAuth = map[string][]string{
-	AuthMeGetOperation:                  []string{},
-	FriendsGetOperation:                 []string{},
-	FriendsPostOperation:                []string{},
-	FriendsUserIDDeleteOperation:        []string{},
-	GoalsGetOperation:                   []string{},
-	GoalsGoalIDDeleteOperation:          []string{},
-	GoalsGoalIDPutOperation:             []string{},
-	GoalsPostOperation:                  []string{},
-	ImagesPostOperation:                 []string{},
-	PostsGetOperation:                   []string{},
-	PostsPostOperation:                  []string{},
-	PostsPostIDDeleteOperation:          []string{},
-	PostsPostIDPutOperation:             []string{},
-	PostsPostIDReactionsDeleteOperation: []string{},
-	PostsPostIDReactionsPostOperation:   []string{},
-	TimelineGetOperation:                []string{},
-	UsersUserIDDeleteOperation:          []string{},
-	UsersUserIDFriendsGetOperation:      []string{},
-	UsersUserIDGetOperation:             []string{},
-	UsersUserIDIconDeleteOperation:      []string{},
-	UsersUserIDIconPostOperation:        []string{},
-	UsersUserIDPutOperation:             []string{},
+	AdminModerationActionsGetOperation:               []string{},
+	AdminModerationActionsPostOperation:              []string{},
+	AdminReportsGetOperation:                         []string{},
+	AdminReportsReportIDClaimPostOperation:           []string{},
+	AdminReportsReportIDResolvePostOperation:         []string{},
+	AdminTemplatesPostOperation:                      []string{},
+	AdminTemplatesTemplateIDDeleteOperation:          []string{},
+	AdminTemplatesTemplateIDPutOperation:             []string{},
+	AuthMeGetOperation:                               []string{},
+	BlocksGetOperation:                               []string{},
+	BlocksPostOperation:                              []string{},
+	BlocksUserIDDeleteOperation:                      []string{},
+	BookmarksCollectionsCollectionIDDeleteOperation:  []string{},
+	BookmarksCollectionsCollectionIDPutOperation:     []string{},
+	BookmarksCollectionsGetOperation:                 []string{},
+	BookmarksCollectionsPostOperation:                []string{},
+	BookmarksGetOperation:                            []string{},
+	CommentsCommentIDDeleteOperation:                 []string{},
+	CommentsCommentIDPutOperation:                    []string{},
+	FriendsGetOperation:                              []string{},
+	FriendsPostOperation:                             []string{},
+	FriendsUserIDDeleteOperation:                     []string{},
+	GoalsFromTemplateTemplateIDPostOperation:         []string{},
+	GoalsGetOperation:                                []string{},
+	GoalsGoalIDDeleteOperation:                       []string{},
+	GoalsGoalIDParticipantsAcceptPostOperation:       []string{},
+	GoalsGoalIDParticipantsPostOperation:             []string{},
+	GoalsGoalIDParticipantsUserIDDeleteOperation:     []string{},
+	GoalsGoalIDPostsGetOperation:                     []string{},
+	GoalsGoalIDPutOperation:                          []string{},
+	GoalsInvitationsGetOperation:                     []string{},
+	GoalsOrderPutOperation:                           []string{},
+	GoalsPostOperation:                               []string{},
+	HashtagsTagPostsGetOperation:                     []string{},
+	ImagesPostOperation:                              []string{},
+	NotificationsGetOperation:                        []string{},
+	NotificationsPreferencesGetOperation:             []string{},
+	NotificationsPreferencesPutOperation:             []string{},
+	NotificationsReadPostOperation:                   []string{},
+	NotificationsUnreadCountGetOperation:             []string{},
+	PostsGetOperation:                                []string{},
+	PostsPostOperation:                               []string{},
+	PostsPostIDBookmarkDeleteOperation:               []string{},
+	PostsPostIDBookmarkPostOperation:                 []string{},
+	PostsPostIDCommentsGetOperation:                  []string{},
+	PostsPostIDCommentsPostOperation:                 []string{},
+	PostsPostIDDeleteOperation:                       []string{},
+	PostsPostIDGetOperation:                          []string{},
+	PostsPostIDPutOperation:                          []string{},
+	PostsPostIDReactionsDeleteOperation:              []string{},
+	PostsPostIDReactionsGetOperation:                 []string{},
+	PostsPostIDReactionsPostOperation:                []string{},
+	PostsPostIDRepostDeleteOperation:                 []string{},
+	PostsPostIDRepostPostOperation:                   []string{},
+	PostsPostIDRevisionsGetOperation:                 []string{},
+	PostsPostIDRevisionsRevisionRestorePostOperation: []string{},
+	ReportsPostOperation:                             []string{},
+	SearchGetOperation:                               []string{},
+	TimelineDiscoverGetOperation:                     []string{},
+	TimelineGetOperation:                             []string{},
+	UsersUserIDDeleteOperation:                       []string{},
+	UsersUserIDFriendsGetOperation:                   []string{},
+	UsersUserIDGetOperation:                          []string{},
+	UsersUserIDIconDeleteOperation:                   []string{},
+	UsersUserIDIconPostOperation:                     []string{},
+	UsersUserIDPostsGetOperation:                     []string{},
+	UsersUserIDPutOperation:                          []string{},
 }
 
 func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
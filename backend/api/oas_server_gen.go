@@ -8,6 +8,62 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AdminModerationActionsGet implements GET /admin/moderation/actions operation.
+	//
+	// 報告の担当・対応を含む、モデレーターが行ったすべての操作を新しい順に返します。
+	// 続きを取得するには、前回の最後の操作のIDをafterに指定します。.
+	//
+	// GET /admin/moderation/actions
+	AdminModerationActionsGet(ctx context.Context, params AdminModerationActionsGetParams) (AdminModerationActionsGetRes, error)
+	// AdminModerationActionsPost implements POST /admin/moderation/actions operation.
+	//
+	// 投稿・コメント・プロフィールの非表示（hide）と再表示（unhide）、ユーザーへの警告（warn）、
+	// アカウントの停止（suspend）と停止の解除（unsuspend）を行います。操作は監査ログに記録されます。
+	// 警告・停止・停止の解除の対象はユーザーのみです。自分自身は対象にできず、モデレーター・管理者を停止できるのは管理者のみです。
+	// 非表示の内容は作成者本人にのみ、通知（moderation_notice）付きで表示されます。
+	// 停止中のユーザーは認証が必要な操作を行えません（403）。.
+	//
+	// POST /admin/moderation/actions
+	AdminModerationActionsPost(ctx context.Context, req *ModerationActionRequest) (AdminModerationActionsPostRes, error)
+	// AdminReportsGet implements GET /admin/reports operation.
+	//
+	// 報告を古い順に返します。続きを取得するには、前回の最後の報告のIDをafterに指定します。
+	// 迷惑行為フィルタが保留にした投稿・コメントもsource=filterの報告として含まれます。.
+	//
+	// GET /admin/reports
+	AdminReportsGet(ctx context.Context, params AdminReportsGetParams) (AdminReportsGetRes, error)
+	// AdminReportsReportIDClaimPost implements POST /admin/reports/{report_id}/claim operation.
+	//
+	// 未対応の報告の担当になり、対応中にします。自分が担当している報告に対しては何もしません。
+	// 他のモデレーターが担当している報告や対応済みの報告は担当できません。.
+	//
+	// POST /admin/reports/{report_id}/claim
+	AdminReportsReportIDClaimPost(ctx context.Context, params AdminReportsReportIDClaimPostParams) (AdminReportsReportIDClaimPostRes, error)
+	// AdminReportsReportIDResolvePost implements POST /admin/reports/{report_id}/resolve operation.
+	//
+	// 自分が担当している報告を対応済みにします。対象への措置（非表示・警告・アカウント停止）は
+	// POST /admin/moderation/actionsで行い、report_idにこの報告を指定します。.
+	//
+	// POST /admin/reports/{report_id}/resolve
+	AdminReportsReportIDResolvePost(ctx context.Context, req *ReportResolveRequest, params AdminReportsReportIDResolvePostParams) (AdminReportsReportIDResolvePostRes, error)
+	// AdminTemplatesPost implements POST /admin/templates operation.
+	//
+	// 目標テンプレート作成（管理者）.
+	//
+	// POST /admin/templates
+	AdminTemplatesPost(ctx context.Context, req *GoalTemplateRequest) (AdminTemplatesPostRes, error)
+	// AdminTemplatesTemplateIDDelete implements DELETE /admin/templates/{template_id} operation.
+	//
+	// 目標テンプレート削除（管理者）.
+	//
+	// DELETE /admin/templates/{template_id}
+	AdminTemplatesTemplateIDDelete(ctx context.Context, params AdminTemplatesTemplateIDDeleteParams) (AdminTemplatesTemplateIDDeleteRes, error)
+	// AdminTemplatesTemplateIDPut implements PUT /admin/templates/{template_id} operation.
+	//
+	// 目標テンプレート更新（管理者）.
+	//
+	// PUT /admin/templates/{template_id}
+	AdminTemplatesTemplateIDPut(ctx context.Context, req *GoalTemplateRequest, params AdminTemplatesTemplateIDPutParams) (AdminTemplatesTemplateIDPutRes, error)
 	// AuthCallbackGet implements GET /auth/callback operation.
 	//
 	// OIDCコールバック（コードをトークンに交換）.
@@ -32,15 +88,76 @@ type Handler interface {
 	//
 	// GET /auth/me
 	AuthMeGet(ctx context.Context) (AuthMeGetRes, error)
+	// BlocksGet implements GET /blocks operation.
+	//
+	// 自分がブロックしているユーザー一覧取得.
+	//
+	// GET /blocks
+	BlocksGet(ctx context.Context) (BlocksGetRes, error)
+	// BlocksPost implements POST /blocks operation.
+	//
+	// ブロックすると互いのフォローが解除され、互いの投稿へのコメントやコメントの表示ができなくなります。.
+	//
+	// POST /blocks
+	BlocksPost(ctx context.Context, req *BlocksPostReq) (BlocksPostRes, error)
+	// BlocksUserIDDelete implements DELETE /blocks/{user_id} operation.
+	//
+	// ブロック解除.
+	//
+	// DELETE /blocks/{user_id}
+	BlocksUserIDDelete(ctx context.Context, params BlocksUserIDDeleteParams) (BlocksUserIDDeleteRes, error)
+	// BookmarksCollectionsCollectionIDDelete implements DELETE /bookmarks/collections/{collection_id} operation.
+	//
+	// コレクション内のブックマークは削除されず、未分類になります。.
+	//
+	// DELETE /bookmarks/collections/{collection_id}
+	BookmarksCollectionsCollectionIDDelete(ctx context.Context, params BookmarksCollectionsCollectionIDDeleteParams) (BookmarksCollectionsCollectionIDDeleteRes, error)
+	// BookmarksCollectionsCollectionIDPut implements PUT /bookmarks/collections/{collection_id} operation.
+	//
+	// ブックマークコレクション名の変更.
+	//
+	// PUT /bookmarks/collections/{collection_id}
+	BookmarksCollectionsCollectionIDPut(ctx context.Context, req *BookmarkCollectionRequest, params BookmarksCollectionsCollectionIDPutParams) (BookmarksCollectionsCollectionIDPutRes, error)
+	// BookmarksCollectionsGet implements GET /bookmarks/collections operation.
+	//
+	// 自分のブックマークコレクション一覧取得.
+	//
+	// GET /bookmarks/collections
+	BookmarksCollectionsGet(ctx context.Context) (BookmarksCollectionsGetRes, error)
+	// BookmarksCollectionsPost implements POST /bookmarks/collections operation.
+	//
+	// ブックマークコレクション作成.
+	//
+	// POST /bookmarks/collections
+	BookmarksCollectionsPost(ctx context.Context, req *BookmarkCollectionRequest) (BookmarksCollectionsPostRes, error)
+	// BookmarksGet implements GET /bookmarks operation.
+	//
+	// ブックマークを新しい順に返します。続きを取得するには、前回の最後のブックマークのIDをafterに指定します。
+	// 削除された投稿や、下書きに戻された・ブロック関係になったなどの理由で閲覧できなくなった投稿は含まれません。.
+	//
+	// GET /bookmarks
+	BookmarksGet(ctx context.Context, params BookmarksGetParams) (BookmarksGetRes, error)
+	// CommentsCommentIDDelete implements DELETE /comments/{comment_id} operation.
+	//
+	// コメントしたユーザー本人、または投稿者が削除できます。返信のスレッドを保つため論理削除されます。.
+	//
+	// DELETE /comments/{comment_id}
+	CommentsCommentIDDelete(ctx context.Context, params CommentsCommentIDDeleteParams) (CommentsCommentIDDeleteRes, error)
+	// CommentsCommentIDPut implements PUT /comments/{comment_id} operation.
+	//
+	// コメントしたユーザー本人のみ編集できます。.
+	//
+	// PUT /comments/{comment_id}
+	CommentsCommentIDPut(ctx context.Context, req *CommentUpdateRequest, params CommentsCommentIDPutParams) (CommentsCommentIDPutRes, error)
 	// FriendsGet implements GET /friends operation.
 	//
-	// 自分のフレンド（フォロー）一覧取得.
+	// フォローしているユーザーのIDを、ユーザーの登録日時の新しい順に返します。.
 	//
 	// GET /friends
-	FriendsGet(ctx context.Context) (FriendsGetRes, error)
+	FriendsGet(ctx context.Context, params FriendsGetParams) (FriendsGetRes, error)
 	// FriendsPost implements POST /friends operation.
 	//
-	// フレンド追加（フォロー）.
+	// 既にフォローしている場合も201を返します。自分自身は400、存在しないユーザーやブロック関係にあるユーザーは404になります。.
 	//
 	// POST /friends
 	FriendsPost(ctx context.Context, req *FriendsPostReq) (FriendsPostRes, error)
@@ -50,18 +167,36 @@ type Handler interface {
 	//
 	// DELETE /friends/{user_id}
 	FriendsUserIDDelete(ctx context.Context, params FriendsUserIDDeleteParams) (FriendsUserIDDeleteRes, error)
+	// GenresGenreIDTemplatesGet implements GET /genres/{genre_id}/templates operation.
+	//
+	// ジャンル別の目標テンプレート一覧取得.
+	//
+	// GET /genres/{genre_id}/templates
+	GenresGenreIDTemplatesGet(ctx context.Context, params GenresGenreIDTemplatesGetParams) (GenresGenreIDTemplatesGetRes, error)
 	// GenresGet implements GET /genres operation.
 	//
 	// 利用可能なジャンル一覧取得.
 	//
 	// GET /genres
 	GenresGet(ctx context.Context) ([]Genre, error)
+	// GoalsFromTemplateTemplateIDPost implements POST /goals/from-template/{template_id} operation.
+	//
+	// テンプレートのタイトル・期間・マイルストーン・習慣スケジュールを元に目標を作成します。リクエストボディで指定した値はテンプレートの値より優先されます。.
+	//
+	// POST /goals/from-template/{template_id}
+	GoalsFromTemplateTemplateIDPost(ctx context.Context, req OptGoalFromTemplateRequest, params GoalsFromTemplateTemplateIDPostParams) (GoalsFromTemplateTemplateIDPostRes, error)
 	// GoalsGet implements GET /goals operation.
 	//
 	// 現在のユーザーの目標一覧取得.
 	//
 	// GET /goals
 	GoalsGet(ctx context.Context, params GoalsGetParams) (GoalsGetRes, error)
+	// GoalsGoalIDAnalyticsGet implements GET /goals/{goal_id}/analytics operation.
+	//
+	// 投稿のヒートマップ、投稿間隔、受け取ったリアクションの推移、期限との比較を返します。日付は目標オーナーのタイムゾーンで集計されます。.
+	//
+	// GET /goals/{goal_id}/analytics
+	GoalsGoalIDAnalyticsGet(ctx context.Context, params GoalsGoalIDAnalyticsGetParams) (GoalsGoalIDAnalyticsGetRes, error)
 	// GoalsGoalIDDelete implements DELETE /goals/{goal_id} operation.
 	//
 	// 目標削除.
@@ -74,21 +209,77 @@ type Handler interface {
 	//
 	// GET /goals/{goal_id}
 	GoalsGoalIDGet(ctx context.Context, params GoalsGoalIDGetParams) (GoalsGoalIDGetRes, error)
+	// GoalsGoalIDParticipantsAcceptPost implements POST /goals/{goal_id}/participants/accept operation.
+	//
+	// 目標への招待を承認.
+	//
+	// POST /goals/{goal_id}/participants/accept
+	GoalsGoalIDParticipantsAcceptPost(ctx context.Context, params GoalsGoalIDParticipantsAcceptPostParams) (GoalsGoalIDParticipantsAcceptPostRes, error)
+	// GoalsGoalIDParticipantsGet implements GET /goals/{goal_id}/participants operation.
+	//
+	// 参加者ごとの投稿数（貢献数）を含みます。.
+	//
+	// GET /goals/{goal_id}/participants
+	GoalsGoalIDParticipantsGet(ctx context.Context, params GoalsGoalIDParticipantsGetParams) (GoalsGoalIDParticipantsGetRes, error)
+	// GoalsGoalIDParticipantsPost implements POST /goals/{goal_id}/participants operation.
+	//
+	// 目標のオーナーのみ実行できます。招待されたユーザーが承認すると参加者になります。.
+	//
+	// POST /goals/{goal_id}/participants
+	GoalsGoalIDParticipantsPost(ctx context.Context, req *GoalsGoalIDParticipantsPostReq, params GoalsGoalIDParticipantsPostParams) (GoalsGoalIDParticipantsPostRes, error)
+	// GoalsGoalIDParticipantsUserIDDelete implements DELETE /goals/{goal_id}/participants/{user_id} operation.
+	//
+	// オーナーは他の参加者を削除できます。参加者本人は招待の辞退や目標からの脱退ができます。オーナーは脱退できません。.
+	//
+	// DELETE /goals/{goal_id}/participants/{user_id}
+	GoalsGoalIDParticipantsUserIDDelete(ctx context.Context, params GoalsGoalIDParticipantsUserIDDeleteParams) (GoalsGoalIDParticipantsUserIDDeleteRes, error)
+	// GoalsGoalIDPostsGet implements GET /goals/{goal_id}/posts operation.
+	//
+	// 目標の全参加者の投稿を新しい順で返します。閲覧者とブロック関係にあるユーザーの投稿は含めません。.
+	//
+	// GET /goals/{goal_id}/posts
+	GoalsGoalIDPostsGet(ctx context.Context, params GoalsGoalIDPostsGetParams) (GoalsGoalIDPostsGetRes, error)
 	// GoalsGoalIDPut implements PUT /goals/{goal_id} operation.
 	//
 	// 目標更新.
 	//
 	// PUT /goals/{goal_id}
 	GoalsGoalIDPut(ctx context.Context, req *GoalRequest, params GoalsGoalIDPutParams) (GoalsGoalIDPutRes, error)
+	// GoalsInvitationsGet implements GET /goals/invitations operation.
+	//
+	// 自分宛ての目標への招待一覧取得.
+	//
+	// GET /goals/invitations
+	GoalsInvitationsGet(ctx context.Context) (GoalsInvitationsGetRes, error)
+	// GoalsOrderPut implements PUT /goals/order operation.
+	//
+	// 自分が参加している目標のIDをすべて、表示したい順に指定します。並び順は一括で反映され、参加者ごとに保存されるため他の参加者の並び順は変わりません。.
+	//
+	// PUT /goals/order
+	GoalsOrderPut(ctx context.Context, req *GoalOrderRequest) (GoalsOrderPutRes, error)
 	// GoalsPost implements POST /goals operation.
 	//
 	// 新規目標作成.
 	//
 	// POST /goals
 	GoalsPost(ctx context.Context, req *GoalRequest) (GoalsPostRes, error)
+	// HashtagsTagPostsGet implements GET /hashtags/{tag}/posts operation.
+	//
+	// 投稿は新しい順で返されます。タグは"#"の有無や全角・半角、英字の大文字・小文字を区別しません。.
+	//
+	// GET /hashtags/{tag}/posts
+	HashtagsTagPostsGet(ctx context.Context, params HashtagsTagPostsGetParams) (HashtagsTagPostsGetRes, error)
+	// HashtagsTrendingGet implements GET /hashtags/trending operation.
+	//
+	// 直近の集計期間内に付けられた投稿の多い順にハッシュタグを返します。.
+	//
+	// GET /hashtags/trending
+	HashtagsTrendingGet(ctx context.Context, params HashtagsTrendingGetParams) (HashtagsTrendingGetRes, error)
 	// ImagesImageIDGet implements GET /images/{image_id} operation.
 	//
-	// 画像取得.
+	// 投稿に添付された画像は、その投稿を閲覧できる場合のみ取得できます。
+	// 投稿に紐付いていない画像は、アップロードしたユーザーと、その画像を含む編集履歴を閲覧できるユーザーのみ取得できます。
+	// 閲覧できない画像は存在しない場合と同じく404を返します。.
 	//
 	// GET /images/{image_id}
 	ImagesImageIDGet(ctx context.Context, params ImagesImageIDGetParams) (ImagesImageIDGetRes, error)
@@ -98,18 +289,79 @@ type Handler interface {
 	//
 	// POST /images
 	ImagesPost(ctx context.Context, req OptImagesPostReq) (ImagesPostRes, error)
+	// NotificationsGet implements GET /notifications operation.
+	//
+	// 通知を最後にまとめた日時の新しい順に返します。
+	// 未読の間は同じ種類・同じ投稿への通知（フォローの場合は全てのフォロー）を1件にまとめ、actor_countに操作をしたユーザーの人数を返します。
+	// まとめた通知は新しい操作があると先頭に移動するため、ページの間で重複・欠落することがあります。.
+	//
+	// GET /notifications
+	NotificationsGet(ctx context.Context, params NotificationsGetParams) (NotificationsGetRes, error)
+	// NotificationsPreferencesGet implements GET /notifications/preferences operation.
+	//
+	// 通知の設定取得.
+	//
+	// GET /notifications/preferences
+	NotificationsPreferencesGet(ctx context.Context) (NotificationsPreferencesGetRes, error)
+	// NotificationsPreferencesPut implements PUT /notifications/preferences operation.
+	//
+	// Falseにした種類の通知は、以降は通知一覧に追加されません。既にある通知はそのまま残ります。.
+	//
+	// PUT /notifications/preferences
+	NotificationsPreferencesPut(ctx context.Context, req *NotificationPreferences) (NotificationsPreferencesPutRes, error)
+	// NotificationsReadPost implements POST /notifications/read operation.
+	//
+	// Idsを省略した場合は全ての未読の通知を既読にします。自分以外の通知や既読の通知のIDは無視します。.
+	//
+	// POST /notifications/read
+	NotificationsReadPost(ctx context.Context, req OptNotificationReadRequest) (NotificationsReadPostRes, error)
+	// NotificationsUnreadCountGet implements GET /notifications/unread-count operation.
+	//
+	// まとめた通知は1件として数えます。.
+	//
+	// GET /notifications/unread-count
+	NotificationsUnreadCountGet(ctx context.Context) (NotificationsUnreadCountGetRes, error)
 	// PostsGet implements GET /posts operation.
 	//
-	// 現在のユーザーの投稿一覧取得.
+	// 自分の投稿を新しい順に返します。下書き・予約投稿を含みます。.
 	//
 	// GET /posts
 	PostsGet(ctx context.Context, params PostsGetParams) (PostsGetRes, error)
 	// PostsPost implements POST /posts operation.
 	//
-	// 進捗投稿作成.
+	// 本文は迷惑行為フィルタで判定されます。拒否された場合は400を返します。
+	// 確認のために保留になった場合は作成者本人以外には非表示の状態で作成され（moderation_notice付き）、
+	// モデレーションキュー（source=filterの報告）に追加されます。.
 	//
 	// POST /posts
 	PostsPost(ctx context.Context, req *PostRequest) (PostsPostRes, error)
+	// PostsPostIDBookmarkDelete implements DELETE /posts/{post_id}/bookmark operation.
+	//
+	// ブックマーク解除.
+	//
+	// DELETE /posts/{post_id}/bookmark
+	PostsPostIDBookmarkDelete(ctx context.Context, params PostsPostIDBookmarkDeleteParams) (PostsPostIDBookmarkDeleteRes, error)
+	// PostsPostIDBookmarkPost implements POST /posts/{post_id}/bookmark operation.
+	//
+	// 既にブックマークしている場合は保存先のコレクションを変更します。collection_idを省略すると未分類として保存します。.
+	//
+	// POST /posts/{post_id}/bookmark
+	PostsPostIDBookmarkPost(ctx context.Context, req OptBookmarkRequest, params PostsPostIDBookmarkPostParams) (PostsPostIDBookmarkPostRes, error)
+	// PostsPostIDCommentsGet implements GET /posts/{post_id}/comments operation.
+	//
+	// コメントを古い順に返します。parent_idを指定するとそのコメントへの返信を、省略すると投稿への直接のコメントを返します。
+	// 続きを取得するには、前回の最後のコメントのIDをafterに指定します。
+	// ブロック関係にあるユーザーのコメントは含まれません。削除済みのコメントは返信が残っている場合のみ、本文を空にして返します。.
+	//
+	// GET /posts/{post_id}/comments
+	PostsPostIDCommentsGet(ctx context.Context, params PostsPostIDCommentsGetParams) (PostsPostIDCommentsGetRes, error)
+	// PostsPostIDCommentsPost implements POST /posts/{post_id}/comments operation.
+	//
+	// Parent_idを指定すると、そのコメントへの返信になります。投稿者またはコメント先のユーザーとブロック関係にある場合はコメントできません。
+	// 本文は投稿と同じく迷惑行為フィルタで判定され、保留になったコメントはモデレーションキューに追加されます。.
+	//
+	// POST /posts/{post_id}/comments
+	PostsPostIDCommentsPost(ctx context.Context, req *CommentRequest, params PostsPostIDCommentsPostParams) (PostsPostIDCommentsPostRes, error)
 	// PostsPostIDDelete implements DELETE /posts/{post_id} operation.
 	//
 	// 投稿を削除します。紐づいている画像も同時に削除されます。.
@@ -130,25 +382,82 @@ type Handler interface {
 	PostsPostIDPut(ctx context.Context, req *PostRequest, params PostsPostIDPutParams) (PostsPostIDPutRes, error)
 	// PostsPostIDReactionsDelete implements DELETE /posts/{post_id}/reactions operation.
 	//
-	// 認証済みの現在のユーザー自身のリアクションを指定した投稿から削除します。`user_id` パラメータは不要で、認証されたユーザーのリアクションのみが削除されます。.
+	// 認証済みの現在のユーザー自身のリアクションを指定した投稿から削除します。`user_id` パラメータは不要で、認証されたユーザーのリアクションのみが削除されます。`kind`を省略すると、すべての種類のリアクションを削除します。.
 	//
 	// DELETE /posts/{post_id}/reactions
 	PostsPostIDReactionsDelete(ctx context.Context, params PostsPostIDReactionsDeleteParams) (PostsPostIDReactionsDeleteRes, error)
 	// PostsPostIDReactionsGet implements GET /posts/{post_id}/reactions operation.
 	//
-	// 投稿にリアクションしたユーザーの一覧取得.
+	// リアクションを新しい順に返します。閲覧者とブロック関係にあるユーザーのリアクションは含まれません。.
 	//
 	// GET /posts/{post_id}/reactions
 	PostsPostIDReactionsGet(ctx context.Context, params PostsPostIDReactionsGetParams) (PostsPostIDReactionsGetRes, error)
 	// PostsPostIDReactionsPost implements POST /posts/{post_id}/reactions operation.
 	//
-	// 投稿にリアクション（いいね）を追加します。リアクションしたユーザーを記録するために認証が必要です。.
+	// 投稿に指定した種類のリアクションを追加します。1ユーザーにつき種類ごとに1回までリアクションできます。種類を省略した場合は最初の種類になります。.
 	//
 	// POST /posts/{post_id}/reactions
-	PostsPostIDReactionsPost(ctx context.Context, params PostsPostIDReactionsPostParams) (PostsPostIDReactionsPostRes, error)
+	PostsPostIDReactionsPost(ctx context.Context, req OptReactionRequest, params PostsPostIDReactionsPostParams) (PostsPostIDReactionsPostRes, error)
+	// PostsPostIDRepostDelete implements DELETE /posts/{post_id}/repost operation.
+	//
+	// リポストを取り消し.
+	//
+	// DELETE /posts/{post_id}/repost
+	PostsPostIDRepostDelete(ctx context.Context, params PostsPostIDRepostDeleteParams) (PostsPostIDRepostDeleteRes, error)
+	// PostsPostIDRepostPost implements POST /posts/{post_id}/repost operation.
+	//
+	// 投稿をコメントなしで自分のフォロワーのタイムラインに共有します。コメントを付けて共有する場合は、quote_post_idを指定して投稿を作成します。
+	// 閲覧できない投稿や公開前の投稿はリポストできません。既にリポストしている場合は何もしません。.
+	//
+	// POST /posts/{post_id}/repost
+	PostsPostIDRepostPost(ctx context.Context, params PostsPostIDRepostPostParams) (PostsPostIDRepostPostRes, error)
+	// PostsPostIDRevisionsGet implements GET /posts/{post_id}/revisions operation.
+	//
+	// 編集前の版を新しい順に返します。現在の内容は含みません。投稿者本人のほか、サーバー設定で公開されている場合は誰でも閲覧できます。.
+	//
+	// GET /posts/{post_id}/revisions
+	PostsPostIDRevisionsGet(ctx context.Context, params PostsPostIDRevisionsGetParams) (PostsPostIDRevisionsGetRes, error)
+	// PostsPostIDRevisionsRevisionRestorePost implements POST /posts/{post_id}/revisions/{revision}/restore operation.
+	//
+	// 指定した版の本文・進捗量・画像で投稿を更新します。復元前の内容は新しい版として履歴に残ります。投稿者本人のみ実行できます。.
+	//
+	// POST /posts/{post_id}/revisions/{revision}/restore
+	PostsPostIDRevisionsRevisionRestorePost(ctx context.Context, params PostsPostIDRevisionsRevisionRestorePostParams) (PostsPostIDRevisionsRevisionRestorePostRes, error)
+	// ReactionsKindsGet implements GET /reactions/kinds operation.
+	//
+	// サーバー設定で有効なリアクションの種類を表示順で返します。.
+	//
+	// GET /reactions/kinds
+	ReactionsKindsGet(ctx context.Context) ([]ReactionKind, error)
+	// ReportsPost implements POST /reports operation.
+	//
+	// 不適切な投稿・コメント・プロフィールを報告します。報告はモデレーターの対応待ちの一覧に追加されます。
+	// 閲覧できない対象や自分自身の投稿・コメント・プロフィールは報告できません。同じ対象は1回だけ報告できます。.
+	//
+	// POST /reports
+	ReportsPost(ctx context.Context, req *ReportRequest) (ReportsPostRes, error)
+	// SearchGet implements GET /search operation.
+	//
+	// 投稿の本文または目標のタイトルを検索し、作成日時の新しい順に返します。続きを取得するには、前回の最後の結果のIDをafterに指定します。
+	// 検索語は空白で区切ったすべての語を含むものが一致します。漢字・かなは2文字ずつの組で、英数字は単語の前方一致で検索します。
+	// 全角・半角、英字の大文字・小文字は区別しません。
+	// 投稿は公開済みのものと自分の下書き・予約投稿が対象です。ブロック関係にあるユーザーの投稿・目標は含まれません。.
+	//
+	// GET /search
+	SearchGet(ctx context.Context, params SearchGetParams) (SearchGetRes, error)
+	// TimelineDiscoverGet implements GET /timeline/discover operation.
+	//
+	// フォローしていないユーザーの最近の公開済みの投稿から、興味のあるジャンルが共通のユーザーの投稿、フォローしているユーザーがフォローしているユーザーの投稿、最近投稿の多い目標への投稿を集め、おすすめ順に返します。
+	// おすすめ順は新しさ、1時間あたりのリアクション数、共通のジャンルの割合、おすすめの理由から求めた点数の高い順で、1ページに同じユーザーの投稿は一定件数までしか含めません。
+	// 次のページはLinkヘッダーのcursorで取得します。cursorは最初のページを取得した時点の並び順を引き継ぎます。.
+	//
+	// GET /timeline/discover
+	TimelineDiscoverGet(ctx context.Context, params TimelineDiscoverGetParams) (TimelineDiscoverGetRes, error)
 	// TimelineGet implements GET /timeline operation.
 	//
-	// 投稿は新しい順（降順、最新が最初）で返されます。.
+	// 自分とフォローしているユーザーの投稿と、それらのユーザーのリポストを新しい順（降順、最新が最初）で返します。
+	// 同じ投稿は最も新しい投稿・リポストの位置に1回だけ含まれ、リポストによって含まれた場合はreposted_byとreposted_atが設定されます。
+	// タイムラインは投稿・リポスト・フォローの時点で非同期に書き込まれるため、反映まで少し遅れることがあります。.
 	//
 	// GET /timeline
 	TimelineGet(ctx context.Context, params TimelineGetParams) (TimelineGetRes, error)
@@ -166,7 +475,8 @@ type Handler interface {
 	UsersUserIDDelete(ctx context.Context, params UsersUserIDDeleteParams) (UsersUserIDDeleteRes, error)
 	// UsersUserIDFriendsGet implements GET /users/{user_id}/friends operation.
 	//
-	// ユーザーのフレンド（フォロー）一覧取得.
+	// ユーザーがフォローしているユーザーのIDを、ユーザーの登録日時の新しい順に返します。
+	// 閲覧者とブロック関係にあるユーザーは含まれず、閲覧者とブロック関係にあるユーザーの一覧は404になります。.
 	//
 	// GET /users/{user_id}/friends
 	UsersUserIDFriendsGet(ctx context.Context, params UsersUserIDFriendsGetParams) (UsersUserIDFriendsGetRes, error)
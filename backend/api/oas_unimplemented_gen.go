@@ -13,6 +13,86 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AdminModerationActionsGet implements GET /admin/moderation/actions operation.
+//
+// 報告の担当・対応を含む、モデレーターが行ったすべての操作を新しい順に返します。
+// 続きを取得するには、前回の最後の操作のIDをafterに指定します。.
+//
+// GET /admin/moderation/actions
+func (UnimplementedHandler) AdminModerationActionsGet(ctx context.Context, params AdminModerationActionsGetParams) (r AdminModerationActionsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminModerationActionsPost implements POST /admin/moderation/actions operation.
+//
+// 投稿・コメント・プロフィールの非表示（hide）と再表示（unhide）、ユーザーへの警告（warn）、
+// アカウントの停止（suspend）と停止の解除（unsuspend）を行います。操作は監査ログに記録されます。
+// 警告・停止・停止の解除の対象はユーザーのみです。自分自身は対象にできず、モデレーター・管理者を停止できるのは管理者のみです。
+// 非表示の内容は作成者本人にのみ、通知（moderation_notice）付きで表示されます。
+// 停止中のユーザーは認証が必要な操作を行えません（403）。.
+//
+// POST /admin/moderation/actions
+func (UnimplementedHandler) AdminModerationActionsPost(ctx context.Context, req *ModerationActionRequest) (r AdminModerationActionsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminReportsGet implements GET /admin/reports operation.
+//
+// 報告を古い順に返します。続きを取得するには、前回の最後の報告のIDをafterに指定します。
+// 迷惑行為フィルタが保留にした投稿・コメントもsource=filterの報告として含まれます。.
+//
+// GET /admin/reports
+func (UnimplementedHandler) AdminReportsGet(ctx context.Context, params AdminReportsGetParams) (r AdminReportsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminReportsReportIDClaimPost implements POST /admin/reports/{report_id}/claim operation.
+//
+// 未対応の報告の担当になり、対応中にします。自分が担当している報告に対しては何もしません。
+// 他のモデレーターが担当している報告や対応済みの報告は担当できません。.
+//
+// POST /admin/reports/{report_id}/claim
+func (UnimplementedHandler) AdminReportsReportIDClaimPost(ctx context.Context, params AdminReportsReportIDClaimPostParams) (r AdminReportsReportIDClaimPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminReportsReportIDResolvePost implements POST /admin/reports/{report_id}/resolve operation.
+//
+// 自分が担当している報告を対応済みにします。対象への措置（非表示・警告・アカウント停止）は
+// POST /admin/moderation/actionsで行い、report_idにこの報告を指定します。.
+//
+// POST /admin/reports/{report_id}/resolve
+func (UnimplementedHandler) AdminReportsReportIDResolvePost(ctx context.Context, req *ReportResolveRequest, params AdminReportsReportIDResolvePostParams) (r AdminReportsReportIDResolvePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminTemplatesPost implements POST /admin/templates operation.
+//
+// 目標テンプレート作成（管理者）.
+//
+// POST /admin/templates
+func (UnimplementedHandler) AdminTemplatesPost(ctx context.Context, req *GoalTemplateRequest) (r AdminTemplatesPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminTemplatesTemplateIDDelete implements DELETE /admin/templates/{template_id} operation.
+//
+// 目標テンプレート削除（管理者）.
+//
+// DELETE /admin/templates/{template_id}
+func (UnimplementedHandler) AdminTemplatesTemplateIDDelete(ctx context.Context, params AdminTemplatesTemplateIDDeleteParams) (r AdminTemplatesTemplateIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminTemplatesTemplateIDPut implements PUT /admin/templates/{template_id} operation.
+//
+// 目標テンプレート更新（管理者）.
+//
+// PUT /admin/templates/{template_id}
+func (UnimplementedHandler) AdminTemplatesTemplateIDPut(ctx context.Context, req *GoalTemplateRequest, params AdminTemplatesTemplateIDPutParams) (r AdminTemplatesTemplateIDPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AuthCallbackGet implements GET /auth/callback operation.
 //
 // OIDCコールバック（コードをトークンに交換）.
@@ -49,18 +129,109 @@ func (UnimplementedHandler) AuthMeGet(ctx context.Context) (r AuthMeGetRes, _ er
 	return r, ht.ErrNotImplemented
 }
 
+// BlocksGet implements GET /blocks operation.
+//
+// 自分がブロックしているユーザー一覧取得.
+//
+// GET /blocks
+func (UnimplementedHandler) BlocksGet(ctx context.Context) (r BlocksGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BlocksPost implements POST /blocks operation.
+//
+// ブロックすると互いのフォローが解除され、互いの投稿へのコメントやコメントの表示ができなくなります。.
+//
+// POST /blocks
+func (UnimplementedHandler) BlocksPost(ctx context.Context, req *BlocksPostReq) (r BlocksPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BlocksUserIDDelete implements DELETE /blocks/{user_id} operation.
+//
+// ブロック解除.
+//
+// DELETE /blocks/{user_id}
+func (UnimplementedHandler) BlocksUserIDDelete(ctx context.Context, params BlocksUserIDDeleteParams) (r BlocksUserIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookmarksCollectionsCollectionIDDelete implements DELETE /bookmarks/collections/{collection_id} operation.
+//
+// コレクション内のブックマークは削除されず、未分類になります。.
+//
+// DELETE /bookmarks/collections/{collection_id}
+func (UnimplementedHandler) BookmarksCollectionsCollectionIDDelete(ctx context.Context, params BookmarksCollectionsCollectionIDDeleteParams) (r BookmarksCollectionsCollectionIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookmarksCollectionsCollectionIDPut implements PUT /bookmarks/collections/{collection_id} operation.
+//
+// ブックマークコレクション名の変更.
+//
+// PUT /bookmarks/collections/{collection_id}
+func (UnimplementedHandler) BookmarksCollectionsCollectionIDPut(ctx context.Context, req *BookmarkCollectionRequest, params BookmarksCollectionsCollectionIDPutParams) (r BookmarksCollectionsCollectionIDPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookmarksCollectionsGet implements GET /bookmarks/collections operation.
+//
+// 自分のブックマークコレクション一覧取得.
+//
+// GET /bookmarks/collections
+func (UnimplementedHandler) BookmarksCollectionsGet(ctx context.Context) (r BookmarksCollectionsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookmarksCollectionsPost implements POST /bookmarks/collections operation.
+//
+// ブックマークコレクション作成.
+//
+// POST /bookmarks/collections
+func (UnimplementedHandler) BookmarksCollectionsPost(ctx context.Context, req *BookmarkCollectionRequest) (r BookmarksCollectionsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookmarksGet implements GET /bookmarks operation.
+//
+// ブックマークを新しい順に返します。続きを取得するには、前回の最後のブックマークのIDをafterに指定します。
+// 削除された投稿や、下書きに戻された・ブロック関係になったなどの理由で閲覧できなくなった投稿は含まれません。.
+//
+// GET /bookmarks
+func (UnimplementedHandler) BookmarksGet(ctx context.Context, params BookmarksGetParams) (r BookmarksGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CommentsCommentIDDelete implements DELETE /comments/{comment_id} operation.
+//
+// コメントしたユーザー本人、または投稿者が削除できます。返信のスレッドを保つため論理削除されます。.
+//
+// DELETE /comments/{comment_id}
+func (UnimplementedHandler) CommentsCommentIDDelete(ctx context.Context, params CommentsCommentIDDeleteParams) (r CommentsCommentIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CommentsCommentIDPut implements PUT /comments/{comment_id} operation.
+//
+// コメントしたユーザー本人のみ編集できます。.
+//
+// PUT /comments/{comment_id}
+func (UnimplementedHandler) CommentsCommentIDPut(ctx context.Context, req *CommentUpdateRequest, params CommentsCommentIDPutParams) (r CommentsCommentIDPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // FriendsGet implements GET /friends operation.
 //
-// 自分のフレンド（フォロー）一覧取得.
+// フォローしているユーザーのIDを、ユーザーの登録日時の新しい順に返します。.
 //
 // GET /friends
-func (UnimplementedHandler) FriendsGet(ctx context.Context) (r FriendsGetRes, _ error) {
+func (UnimplementedHandler) FriendsGet(ctx context.Context, params FriendsGetParams) (r FriendsGetRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // FriendsPost implements POST /friends operation.
 //
-// フレンド追加（フォロー）.
+// 既にフォローしている場合も201を返します。自分自身は400、存在しないユーザーやブロック関係にあるユーザーは404になります。.
 //
 // POST /friends
 func (UnimplementedHandler) FriendsPost(ctx context.Context, req *FriendsPostReq) (r FriendsPostRes, _ error) {
@@ -76,6 +247,15 @@ func (UnimplementedHandler) FriendsUserIDDelete(ctx context.Context, params Frie
 	return r, ht.ErrNotImplemented
 }
 
+// GenresGenreIDTemplatesGet implements GET /genres/{genre_id}/templates operation.
+//
+// ジャンル別の目標テンプレート一覧取得.
+//
+// GET /genres/{genre_id}/templates
+func (UnimplementedHandler) GenresGenreIDTemplatesGet(ctx context.Context, params GenresGenreIDTemplatesGetParams) (r GenresGenreIDTemplatesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GenresGet implements GET /genres operation.
 //
 // 利用可能なジャンル一覧取得.
@@ -85,6 +265,15 @@ func (UnimplementedHandler) GenresGet(ctx context.Context) (r []Genre, _ error)
 	return r, ht.ErrNotImplemented
 }
 
+// GoalsFromTemplateTemplateIDPost implements POST /goals/from-template/{template_id} operation.
+//
+// テンプレートのタイトル・期間・マイルストーン・習慣スケジュールを元に目標を作成します。リクエストボディで指定した値はテンプレートの値より優先されます。.
+//
+// POST /goals/from-template/{template_id}
+func (UnimplementedHandler) GoalsFromTemplateTemplateIDPost(ctx context.Context, req OptGoalFromTemplateRequest, params GoalsFromTemplateTemplateIDPostParams) (r GoalsFromTemplateTemplateIDPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GoalsGet implements GET /goals operation.
 //
 // 現在のユーザーの目標一覧取得.
@@ -94,6 +283,15 @@ func (UnimplementedHandler) GoalsGet(ctx context.Context, params GoalsGetParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GoalsGoalIDAnalyticsGet implements GET /goals/{goal_id}/analytics operation.
+//
+// 投稿のヒートマップ、投稿間隔、受け取ったリアクションの推移、期限との比較を返します。日付は目標オーナーのタイムゾーンで集計されます。.
+//
+// GET /goals/{goal_id}/analytics
+func (UnimplementedHandler) GoalsGoalIDAnalyticsGet(ctx context.Context, params GoalsGoalIDAnalyticsGetParams) (r GoalsGoalIDAnalyticsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GoalsGoalIDDelete implements DELETE /goals/{goal_id} operation.
 //
 // 目標削除.
@@ -112,6 +310,51 @@ func (UnimplementedHandler) GoalsGoalIDGet(ctx context.Context, params GoalsGoal
 	return r, ht.ErrNotImplemented
 }
 
+// GoalsGoalIDParticipantsAcceptPost implements POST /goals/{goal_id}/participants/accept operation.
+//
+// 目標への招待を承認.
+//
+// POST /goals/{goal_id}/participants/accept
+func (UnimplementedHandler) GoalsGoalIDParticipantsAcceptPost(ctx context.Context, params GoalsGoalIDParticipantsAcceptPostParams) (r GoalsGoalIDParticipantsAcceptPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GoalsGoalIDParticipantsGet implements GET /goals/{goal_id}/participants operation.
+//
+// 参加者ごとの投稿数（貢献数）を含みます。.
+//
+// GET /goals/{goal_id}/participants
+func (UnimplementedHandler) GoalsGoalIDParticipantsGet(ctx context.Context, params GoalsGoalIDParticipantsGetParams) (r GoalsGoalIDParticipantsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GoalsGoalIDParticipantsPost implements POST /goals/{goal_id}/participants operation.
+//
+// 目標のオーナーのみ実行できます。招待されたユーザーが承認すると参加者になります。.
+//
+// POST /goals/{goal_id}/participants
+func (UnimplementedHandler) GoalsGoalIDParticipantsPost(ctx context.Context, req *GoalsGoalIDParticipantsPostReq, params GoalsGoalIDParticipantsPostParams) (r GoalsGoalIDParticipantsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GoalsGoalIDParticipantsUserIDDelete implements DELETE /goals/{goal_id}/participants/{user_id} operation.
+//
+// オーナーは他の参加者を削除できます。参加者本人は招待の辞退や目標からの脱退ができます。オーナーは脱退できません。.
+//
+// DELETE /goals/{goal_id}/participants/{user_id}
+func (UnimplementedHandler) GoalsGoalIDParticipantsUserIDDelete(ctx context.Context, params GoalsGoalIDParticipantsUserIDDeleteParams) (r GoalsGoalIDParticipantsUserIDDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GoalsGoalIDPostsGet implements GET /goals/{goal_id}/posts operation.
+//
+// 目標の全参加者の投稿を新しい順で返します。閲覧者とブロック関係にあるユーザーの投稿は含めません。.
+//
+// GET /goals/{goal_id}/posts
+func (UnimplementedHandler) GoalsGoalIDPostsGet(ctx context.Context, params GoalsGoalIDPostsGetParams) (r GoalsGoalIDPostsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GoalsGoalIDPut implements PUT /goals/{goal_id} operation.
 //
 // 目標更新.
@@ -121,6 +364,24 @@ func (UnimplementedHandler) GoalsGoalIDPut(ctx context.Context, req *GoalRequest
 	return r, ht.ErrNotImplemented
 }
 
+// GoalsInvitationsGet implements GET /goals/invitations operation.
+//
+// 自分宛ての目標への招待一覧取得.
+//
+// GET /goals/invitations
+func (UnimplementedHandler) GoalsInvitationsGet(ctx context.Context) (r GoalsInvitationsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GoalsOrderPut implements PUT /goals/order operation.
+//
+// 自分が参加している目標のIDをすべて、表示したい順に指定します。並び順は一括で反映され、参加者ごとに保存されるため他の参加者の並び順は変わりません。.
+//
+// PUT /goals/order
+func (UnimplementedHandler) GoalsOrderPut(ctx context.Context, req *GoalOrderRequest) (r GoalsOrderPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GoalsPost implements POST /goals operation.
 //
 // 新規目標作成.
@@ -130,9 +391,29 @@ func (UnimplementedHandler) GoalsPost(ctx context.Context, req *GoalRequest) (r
 	return r, ht.ErrNotImplemented
 }
 
+// HashtagsTagPostsGet implements GET /hashtags/{tag}/posts operation.
+//
+// 投稿は新しい順で返されます。タグは"#"の有無や全角・半角、英字の大文字・小文字を区別しません。.
+//
+// GET /hashtags/{tag}/posts
+func (UnimplementedHandler) HashtagsTagPostsGet(ctx context.Context, params HashtagsTagPostsGetParams) (r HashtagsTagPostsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// HashtagsTrendingGet implements GET /hashtags/trending operation.
+//
+// 直近の集計期間内に付けられた投稿の多い順にハッシュタグを返します。.
+//
+// GET /hashtags/trending
+func (UnimplementedHandler) HashtagsTrendingGet(ctx context.Context, params HashtagsTrendingGetParams) (r HashtagsTrendingGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ImagesImageIDGet implements GET /images/{image_id} operation.
 //
-// 画像取得.
+// 投稿に添付された画像は、その投稿を閲覧できる場合のみ取得できます。
+// 投稿に紐付いていない画像は、アップロードしたユーザーと、その画像を含む編集履歴を閲覧できるユーザーのみ取得できます。
+// 閲覧できない画像は存在しない場合と同じく404を返します。.
 //
 // GET /images/{image_id}
 func (UnimplementedHandler) ImagesImageIDGet(ctx context.Context, params ImagesImageIDGetParams) (r ImagesImageIDGetRes, _ error) {
@@ -148,9 +429,56 @@ func (UnimplementedHandler) ImagesPost(ctx context.Context, req OptImagesPostReq
 	return r, ht.ErrNotImplemented
 }
 
+// NotificationsGet implements GET /notifications operation.
+//
+// 通知を最後にまとめた日時の新しい順に返します。
+// 未読の間は同じ種類・同じ投稿への通知（フォローの場合は全てのフォロー）を1件にまとめ、actor_countに操作をしたユーザーの人数を返します。
+// まとめた通知は新しい操作があると先頭に移動するため、ページの間で重複・欠落することがあります。.
+//
+// GET /notifications
+func (UnimplementedHandler) NotificationsGet(ctx context.Context, params NotificationsGetParams) (r NotificationsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationsPreferencesGet implements GET /notifications/preferences operation.
+//
+// 通知の設定取得.
+//
+// GET /notifications/preferences
+func (UnimplementedHandler) NotificationsPreferencesGet(ctx context.Context) (r NotificationsPreferencesGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationsPreferencesPut implements PUT /notifications/preferences operation.
+//
+// Falseにした種類の通知は、以降は通知一覧に追加されません。既にある通知はそのまま残ります。.
+//
+// PUT /notifications/preferences
+func (UnimplementedHandler) NotificationsPreferencesPut(ctx context.Context, req *NotificationPreferences) (r NotificationsPreferencesPutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationsReadPost implements POST /notifications/read operation.
+//
+// Idsを省略した場合は全ての未読の通知を既読にします。自分以外の通知や既読の通知のIDは無視します。.
+//
+// POST /notifications/read
+func (UnimplementedHandler) NotificationsReadPost(ctx context.Context, req OptNotificationReadRequest) (r NotificationsReadPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// NotificationsUnreadCountGet implements GET /notifications/unread-count operation.
+//
+// まとめた通知は1件として数えます。.
+//
+// GET /notifications/unread-count
+func (UnimplementedHandler) NotificationsUnreadCountGet(ctx context.Context) (r NotificationsUnreadCountGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PostsGet implements GET /posts operation.
 //
-// 現在のユーザーの投稿一覧取得.
+// 自分の投稿を新しい順に返します。下書き・予約投稿を含みます。.
 //
 // GET /posts
 func (UnimplementedHandler) PostsGet(ctx context.Context, params PostsGetParams) (r PostsGetRes, _ error) {
@@ -159,13 +487,54 @@ func (UnimplementedHandler) PostsGet(ctx context.Context, params PostsGetParams)
 
 // PostsPost implements POST /posts operation.
 //
-// 進捗投稿作成.
+// 本文は迷惑行為フィルタで判定されます。拒否された場合は400を返します。
+// 確認のために保留になった場合は作成者本人以外には非表示の状態で作成され（moderation_notice付き）、
+// モデレーションキュー（source=filterの報告）に追加されます。.
 //
 // POST /posts
 func (UnimplementedHandler) PostsPost(ctx context.Context, req *PostRequest) (r PostsPostRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// PostsPostIDBookmarkDelete implements DELETE /posts/{post_id}/bookmark operation.
+//
+// ブックマーク解除.
+//
+// DELETE /posts/{post_id}/bookmark
+func (UnimplementedHandler) PostsPostIDBookmarkDelete(ctx context.Context, params PostsPostIDBookmarkDeleteParams) (r PostsPostIDBookmarkDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostsPostIDBookmarkPost implements POST /posts/{post_id}/bookmark operation.
+//
+// 既にブックマークしている場合は保存先のコレクションを変更します。collection_idを省略すると未分類として保存します。.
+//
+// POST /posts/{post_id}/bookmark
+func (UnimplementedHandler) PostsPostIDBookmarkPost(ctx context.Context, req OptBookmarkRequest, params PostsPostIDBookmarkPostParams) (r PostsPostIDBookmarkPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostsPostIDCommentsGet implements GET /posts/{post_id}/comments operation.
+//
+// コメントを古い順に返します。parent_idを指定するとそのコメントへの返信を、省略すると投稿への直接のコメントを返します。
+// 続きを取得するには、前回の最後のコメントのIDをafterに指定します。
+// ブロック関係にあるユーザーのコメントは含まれません。削除済みのコメントは返信が残っている場合のみ、本文を空にして返します。.
+//
+// GET /posts/{post_id}/comments
+func (UnimplementedHandler) PostsPostIDCommentsGet(ctx context.Context, params PostsPostIDCommentsGetParams) (r PostsPostIDCommentsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostsPostIDCommentsPost implements POST /posts/{post_id}/comments operation.
+//
+// Parent_idを指定すると、そのコメントへの返信になります。投稿者またはコメント先のユーザーとブロック関係にある場合はコメントできません。
+// 本文は投稿と同じく迷惑行為フィルタで判定され、保留になったコメントはモデレーションキューに追加されます。.
+//
+// POST /posts/{post_id}/comments
+func (UnimplementedHandler) PostsPostIDCommentsPost(ctx context.Context, req *CommentRequest, params PostsPostIDCommentsPostParams) (r PostsPostIDCommentsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PostsPostIDDelete implements DELETE /posts/{post_id} operation.
 //
 // 投稿を削除します。紐づいている画像も同時に削除されます。.
@@ -195,7 +564,7 @@ func (UnimplementedHandler) PostsPostIDPut(ctx context.Context, req *PostRequest
 
 // PostsPostIDReactionsDelete implements DELETE /posts/{post_id}/reactions operation.
 //
-// 認証済みの現在のユーザー自身のリアクションを指定した投稿から削除します。`user_id` パラメータは不要で、認証されたユーザーのリアクションのみが削除されます。.
+// 認証済みの現在のユーザー自身のリアクションを指定した投稿から削除します。`user_id` パラメータは不要で、認証されたユーザーのリアクションのみが削除されます。`kind`を省略すると、すべての種類のリアクションを削除します。.
 //
 // DELETE /posts/{post_id}/reactions
 func (UnimplementedHandler) PostsPostIDReactionsDelete(ctx context.Context, params PostsPostIDReactionsDeleteParams) (r PostsPostIDReactionsDeleteRes, _ error) {
@@ -204,7 +573,7 @@ func (UnimplementedHandler) PostsPostIDReactionsDelete(ctx context.Context, para
 
 // PostsPostIDReactionsGet implements GET /posts/{post_id}/reactions operation.
 //
-// 投稿にリアクションしたユーザーの一覧取得.
+// リアクションを新しい順に返します。閲覧者とブロック関係にあるユーザーのリアクションは含まれません。.
 //
 // GET /posts/{post_id}/reactions
 func (UnimplementedHandler) PostsPostIDReactionsGet(ctx context.Context, params PostsPostIDReactionsGetParams) (r PostsPostIDReactionsGetRes, _ error) {
@@ -213,16 +582,97 @@ func (UnimplementedHandler) PostsPostIDReactionsGet(ctx context.Context, params
 
 // PostsPostIDReactionsPost implements POST /posts/{post_id}/reactions operation.
 //
-// 投稿にリアクション（いいね）を追加します。リアクションしたユーザーを記録するために認証が必要です。.
+// 投稿に指定した種類のリアクションを追加します。1ユーザーにつき種類ごとに1回までリアクションできます。種類を省略した場合は最初の種類になります。.
 //
 // POST /posts/{post_id}/reactions
-func (UnimplementedHandler) PostsPostIDReactionsPost(ctx context.Context, params PostsPostIDReactionsPostParams) (r PostsPostIDReactionsPostRes, _ error) {
+func (UnimplementedHandler) PostsPostIDReactionsPost(ctx context.Context, req OptReactionRequest, params PostsPostIDReactionsPostParams) (r PostsPostIDReactionsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostsPostIDRepostDelete implements DELETE /posts/{post_id}/repost operation.
+//
+// リポストを取り消し.
+//
+// DELETE /posts/{post_id}/repost
+func (UnimplementedHandler) PostsPostIDRepostDelete(ctx context.Context, params PostsPostIDRepostDeleteParams) (r PostsPostIDRepostDeleteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostsPostIDRepostPost implements POST /posts/{post_id}/repost operation.
+//
+// 投稿をコメントなしで自分のフォロワーのタイムラインに共有します。コメントを付けて共有する場合は、quote_post_idを指定して投稿を作成します。
+// 閲覧できない投稿や公開前の投稿はリポストできません。既にリポストしている場合は何もしません。.
+//
+// POST /posts/{post_id}/repost
+func (UnimplementedHandler) PostsPostIDRepostPost(ctx context.Context, params PostsPostIDRepostPostParams) (r PostsPostIDRepostPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostsPostIDRevisionsGet implements GET /posts/{post_id}/revisions operation.
+//
+// 編集前の版を新しい順に返します。現在の内容は含みません。投稿者本人のほか、サーバー設定で公開されている場合は誰でも閲覧できます。.
+//
+// GET /posts/{post_id}/revisions
+func (UnimplementedHandler) PostsPostIDRevisionsGet(ctx context.Context, params PostsPostIDRevisionsGetParams) (r PostsPostIDRevisionsGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PostsPostIDRevisionsRevisionRestorePost implements POST /posts/{post_id}/revisions/{revision}/restore operation.
+//
+// 指定した版の本文・進捗量・画像で投稿を更新します。復元前の内容は新しい版として履歴に残ります。投稿者本人のみ実行できます。.
+//
+// POST /posts/{post_id}/revisions/{revision}/restore
+func (UnimplementedHandler) PostsPostIDRevisionsRevisionRestorePost(ctx context.Context, params PostsPostIDRevisionsRevisionRestorePostParams) (r PostsPostIDRevisionsRevisionRestorePostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReactionsKindsGet implements GET /reactions/kinds operation.
+//
+// サーバー設定で有効なリアクションの種類を表示順で返します。.
+//
+// GET /reactions/kinds
+func (UnimplementedHandler) ReactionsKindsGet(ctx context.Context) (r []ReactionKind, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReportsPost implements POST /reports operation.
+//
+// 不適切な投稿・コメント・プロフィールを報告します。報告はモデレーターの対応待ちの一覧に追加されます。
+// 閲覧できない対象や自分自身の投稿・コメント・プロフィールは報告できません。同じ対象は1回だけ報告できます。.
+//
+// POST /reports
+func (UnimplementedHandler) ReportsPost(ctx context.Context, req *ReportRequest) (r ReportsPostRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SearchGet implements GET /search operation.
+//
+// 投稿の本文または目標のタイトルを検索し、作成日時の新しい順に返します。続きを取得するには、前回の最後の結果のIDをafterに指定します。
+// 検索語は空白で区切ったすべての語を含むものが一致します。漢字・かなは2文字ずつの組で、英数字は単語の前方一致で検索します。
+// 全角・半角、英字の大文字・小文字は区別しません。
+// 投稿は公開済みのものと自分の下書き・予約投稿が対象です。ブロック関係にあるユーザーの投稿・目標は含まれません。.
+//
+// GET /search
+func (UnimplementedHandler) SearchGet(ctx context.Context, params SearchGetParams) (r SearchGetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// TimelineDiscoverGet implements GET /timeline/discover operation.
+//
+// フォローしていないユーザーの最近の公開済みの投稿から、興味のあるジャンルが共通のユーザーの投稿、フォローしているユーザーがフォローしているユーザーの投稿、最近投稿の多い目標への投稿を集め、おすすめ順に返します。
+// おすすめ順は新しさ、1時間あたりのリアクション数、共通のジャンルの割合、おすすめの理由から求めた点数の高い順で、1ページに同じユーザーの投稿は一定件数までしか含めません。
+// 次のページはLinkヘッダーのcursorで取得します。cursorは最初のページを取得した時点の並び順を引き継ぎます。.
+//
+// GET /timeline/discover
+func (UnimplementedHandler) TimelineDiscoverGet(ctx context.Context, params TimelineDiscoverGetParams) (r TimelineDiscoverGetRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // TimelineGet implements GET /timeline operation.
 //
-// 投稿は新しい順（降順、最新が最初）で返されます。.
+// 自分とフォローしているユーザーの投稿と、それらのユーザーのリポストを新しい順（降順、最新が最初）で返します。
+// 同じ投稿は最も新しい投稿・リポストの位置に1回だけ含まれ、リポストによって含まれた場合はreposted_byとreposted_atが設定されます。
+// タイムラインは投稿・リポスト・フォローの時点で非同期に書き込まれるため、反映まで少し遅れることがあります。.
 //
 // GET /timeline
 func (UnimplementedHandler) TimelineGet(ctx context.Context, params TimelineGetParams) (r TimelineGetRes, _ error) {
@@ -249,7 +699,8 @@ func (UnimplementedHandler) UsersUserIDDelete(ctx context.Context, params UsersU
 
 // UsersUserIDFriendsGet implements GET /users/{user_id}/friends operation.
 //
-// ユーザーのフレンド（フォロー）一覧取得.
+// ユーザーがフォローしているユーザーのIDを、ユーザーの登録日時の新しい順に返します。
+// 閲覧者とブロック関係にあるユーザーは含まれず、閲覧者とブロック関係にあるユーザーの一覧は404になります。.
 //
 // GET /users/{user_id}/friends
 func (UnimplementedHandler) UsersUserIDFriendsGet(ctx context.Context, params UsersUserIDFriendsGetParams) (r UsersUserIDFriendsGetRes, _ error) {
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/bookmark"
+	"backend/ent/bookmarkcollection"
+	"backend/ent/post"
+	"backend/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Bookmark is the model entity for the Bookmark schema.
+type Bookmark struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BookmarkQuery when eager-loading is set.
+	Edges                         BookmarkEdges `json:"edges"`
+	bookmark_collection_bookmarks *uuid.UUID
+	post_bookmarks                *uuid.UUID
+	user_bookmarks                *uuid.UUID
+	selectValues                  sql.SelectValues
+}
+
+// BookmarkEdges holds the relations/edges for other nodes in the graph.
+type BookmarkEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// Collection holds the value of the collection edge.
+	Collection *BookmarkCollection `json:"collection,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookmarkEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookmarkEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// CollectionOrErr returns the Collection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookmarkEdges) CollectionOrErr() (*BookmarkCollection, error) {
+	if e.Collection != nil {
+		return e.Collection, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: bookmarkcollection.Label}
+	}
+	return nil, &NotLoadedError{edge: "collection"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Bookmark) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bookmark.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case bookmark.FieldID:
+			values[i] = new(uuid.UUID)
+		case bookmark.ForeignKeys[0]: // bookmark_collection_bookmarks
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bookmark.ForeignKeys[1]: // post_bookmarks
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case bookmark.ForeignKeys[2]: // user_bookmarks
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Bookmark fields.
+func (_m *Bookmark) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bookmark.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bookmark.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case bookmark.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field bookmark_collection_bookmarks", values[i])
+			} else if value.Valid {
+				_m.bookmark_collection_bookmarks = new(uuid.UUID)
+				*_m.bookmark_collection_bookmarks = *value.S.(*uuid.UUID)
+			}
+		case bookmark.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field post_bookmarks", values[i])
+			} else if value.Valid {
+				_m.post_bookmarks = new(uuid.UUID)
+				*_m.post_bookmarks = *value.S.(*uuid.UUID)
+			}
+		case bookmark.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_bookmarks", values[i])
+			} else if value.Valid {
+				_m.user_bookmarks = new(uuid.UUID)
+				*_m.user_bookmarks = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Bookmark.
+// This includes values selected through modifiers, order, etc.
+func (_m *Bookmark) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Bookmark entity.
+func (_m *Bookmark) QueryUser() *UserQuery {
+	return NewBookmarkClient(_m.config).QueryUser(_m)
+}
+
+// QueryPost queries the "post" edge of the Bookmark entity.
+func (_m *Bookmark) QueryPost() *PostQuery {
+	return NewBookmarkClient(_m.config).QueryPost(_m)
+}
+
+// QueryCollection queries the "collection" edge of the Bookmark entity.
+func (_m *Bookmark) QueryCollection() *BookmarkCollectionQuery {
+	return NewBookmarkClient(_m.config).QueryCollection(_m)
+}
+
+// Update returns a builder for updating this Bookmark.
+// Note that you need to call Bookmark.Unwrap() before calling this method if this Bookmark
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Bookmark) Update() *BookmarkUpdateOne {
+	return NewBookmarkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Bookmark entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Bookmark) Unwrap() *Bookmark {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Bookmark is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Bookmark) String() string {
+	var builder strings.Builder
+	builder.WriteString("Bookmark(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Bookmarks is a parsable slice of Bookmark.
+type Bookmarks []*Bookmark
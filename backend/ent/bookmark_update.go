@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/bookmark"
+	"backend/ent/bookmarkcollection"
+	"backend/ent/post"
+	"backend/ent/predicate"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BookmarkUpdate is the builder for updating Bookmark entities.
+type BookmarkUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BookmarkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BookmarkUpdate builder.
+func (_u *BookmarkUpdate) Where(ps ...predicate.Bookmark) *BookmarkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *BookmarkUpdate) SetUserID(id uuid.UUID) *BookmarkUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *BookmarkUpdate) SetUser(v *User) *BookmarkUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (_u *BookmarkUpdate) SetPostID(id uuid.UUID) *BookmarkUpdate {
+	_u.mutation.SetPostID(id)
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *BookmarkUpdate) SetPost(v *Post) *BookmarkUpdate {
+	return _u.SetPostID(v.ID)
+}
+
+// SetCollectionID sets the "collection" edge to the BookmarkCollection entity by ID.
+func (_u *BookmarkUpdate) SetCollectionID(id uuid.UUID) *BookmarkUpdate {
+	_u.mutation.SetCollectionID(id)
+	return _u
+}
+
+// SetNillableCollectionID sets the "collection" edge to the BookmarkCollection entity by ID if the given value is not nil.
+func (_u *BookmarkUpdate) SetNillableCollectionID(id *uuid.UUID) *BookmarkUpdate {
+	if id != nil {
+		_u = _u.SetCollectionID(*id)
+	}
+	return _u
+}
+
+// SetCollection sets the "collection" edge to the BookmarkCollection entity.
+func (_u *BookmarkUpdate) SetCollection(v *BookmarkCollection) *BookmarkUpdate {
+	return _u.SetCollectionID(v.ID)
+}
+
+// Mutation returns the BookmarkMutation object of the builder.
+func (_u *BookmarkUpdate) Mutation() *BookmarkMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *BookmarkUpdate) ClearUser() *BookmarkUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *BookmarkUpdate) ClearPost() *BookmarkUpdate {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// ClearCollection clears the "collection" edge to the BookmarkCollection entity.
+func (_u *BookmarkUpdate) ClearCollection() *BookmarkUpdate {
+	_u.mutation.ClearCollection()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BookmarkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BookmarkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BookmarkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BookmarkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BookmarkUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Bookmark.user"`)
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Bookmark.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BookmarkUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BookmarkUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BookmarkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bookmark.Table, bookmark.Columns, sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.UserTable,
+			Columns: []string{bookmark.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.UserTable,
+			Columns: []string{bookmark.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.PostTable,
+			Columns: []string{bookmark.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.PostTable,
+			Columns: []string{bookmark.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CollectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.CollectionTable,
+			Columns: []string{bookmark.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmarkcollection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.CollectionTable,
+			Columns: []string{bookmark.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmarkcollection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bookmark.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BookmarkUpdateOne is the builder for updating a single Bookmark entity.
+type BookmarkUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BookmarkMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *BookmarkUpdateOne) SetUserID(id uuid.UUID) *BookmarkUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *BookmarkUpdateOne) SetUser(v *User) *BookmarkUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (_u *BookmarkUpdateOne) SetPostID(id uuid.UUID) *BookmarkUpdateOne {
+	_u.mutation.SetPostID(id)
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *BookmarkUpdateOne) SetPost(v *Post) *BookmarkUpdateOne {
+	return _u.SetPostID(v.ID)
+}
+
+// SetCollectionID sets the "collection" edge to the BookmarkCollection entity by ID.
+func (_u *BookmarkUpdateOne) SetCollectionID(id uuid.UUID) *BookmarkUpdateOne {
+	_u.mutation.SetCollectionID(id)
+	return _u
+}
+
+// SetNillableCollectionID sets the "collection" edge to the BookmarkCollection entity by ID if the given value is not nil.
+func (_u *BookmarkUpdateOne) SetNillableCollectionID(id *uuid.UUID) *BookmarkUpdateOne {
+	if id != nil {
+		_u = _u.SetCollectionID(*id)
+	}
+	return _u
+}
+
+// SetCollection sets the "collection" edge to the BookmarkCollection entity.
+func (_u *BookmarkUpdateOne) SetCollection(v *BookmarkCollection) *BookmarkUpdateOne {
+	return _u.SetCollectionID(v.ID)
+}
+
+// Mutation returns the BookmarkMutation object of the builder.
+func (_u *BookmarkUpdateOne) Mutation() *BookmarkMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *BookmarkUpdateOne) ClearUser() *BookmarkUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *BookmarkUpdateOne) ClearPost() *BookmarkUpdateOne {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// ClearCollection clears the "collection" edge to the BookmarkCollection entity.
+func (_u *BookmarkUpdateOne) ClearCollection() *BookmarkUpdateOne {
+	_u.mutation.ClearCollection()
+	return _u
+}
+
+// Where appends a list predicates to the BookmarkUpdate builder.
+func (_u *BookmarkUpdateOne) Where(ps ...predicate.Bookmark) *BookmarkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BookmarkUpdateOne) Select(field string, fields ...string) *BookmarkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Bookmark entity.
+func (_u *BookmarkUpdateOne) Save(ctx context.Context) (*Bookmark, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BookmarkUpdateOne) SaveX(ctx context.Context) *Bookmark {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BookmarkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BookmarkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BookmarkUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Bookmark.user"`)
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Bookmark.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BookmarkUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BookmarkUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BookmarkUpdateOne) sqlSave(ctx context.Context) (_node *Bookmark, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bookmark.Table, bookmark.Columns, sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Bookmark.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bookmark.FieldID)
+		for _, f := range fields {
+			if !bookmark.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bookmark.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.UserTable,
+			Columns: []string{bookmark.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.UserTable,
+			Columns: []string{bookmark.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.PostTable,
+			Columns: []string{bookmark.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.PostTable,
+			Columns: []string{bookmark.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CollectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.CollectionTable,
+			Columns: []string{bookmark.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmarkcollection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookmark.CollectionTable,
+			Columns: []string{bookmark.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmarkcollection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Bookmark{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bookmark.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/bookmarkcollection"
+	"backend/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// BookmarkCollection is the model entity for the BookmarkCollection schema.
+type BookmarkCollection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BookmarkCollectionQuery when eager-loading is set.
+	Edges                     BookmarkCollectionEdges `json:"edges"`
+	user_bookmark_collections *uuid.UUID
+	selectValues              sql.SelectValues
+}
+
+// BookmarkCollectionEdges holds the relations/edges for other nodes in the graph.
+type BookmarkCollectionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Bookmarks holds the value of the bookmarks edge.
+	Bookmarks []*Bookmark `json:"bookmarks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookmarkCollectionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// BookmarksOrErr returns the Bookmarks value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookmarkCollectionEdges) BookmarksOrErr() ([]*Bookmark, error) {
+	if e.loadedTypes[1] {
+		return e.Bookmarks, nil
+	}
+	return nil, &NotLoadedError{edge: "bookmarks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BookmarkCollection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bookmarkcollection.FieldName:
+			values[i] = new(sql.NullString)
+		case bookmarkcollection.FieldCreatedAt, bookmarkcollection.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case bookmarkcollection.FieldID:
+			values[i] = new(uuid.UUID)
+		case bookmarkcollection.ForeignKeys[0]: // user_bookmark_collections
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BookmarkCollection fields.
+func (_m *BookmarkCollection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bookmarkcollection.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bookmarkcollection.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case bookmarkcollection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case bookmarkcollection.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case bookmarkcollection.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_bookmark_collections", values[i])
+			} else if value.Valid {
+				_m.user_bookmark_collections = new(uuid.UUID)
+				*_m.user_bookmark_collections = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BookmarkCollection.
+// This includes values selected through modifiers, order, etc.
+func (_m *BookmarkCollection) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the BookmarkCollection entity.
+func (_m *BookmarkCollection) QueryUser() *UserQuery {
+	return NewBookmarkCollectionClient(_m.config).QueryUser(_m)
+}
+
+// QueryBookmarks queries the "bookmarks" edge of the BookmarkCollection entity.
+func (_m *BookmarkCollection) QueryBookmarks() *BookmarkQuery {
+	return NewBookmarkCollectionClient(_m.config).QueryBookmarks(_m)
+}
+
+// Update returns a builder for updating this BookmarkCollection.
+// Note that you need to call BookmarkCollection.Unwrap() before calling this method if this BookmarkCollection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BookmarkCollection) Update() *BookmarkCollectionUpdateOne {
+	return NewBookmarkCollectionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BookmarkCollection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BookmarkCollection) Unwrap() *BookmarkCollection {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BookmarkCollection is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BookmarkCollection) String() string {
+	var builder strings.Builder
+	builder.WriteString("BookmarkCollection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BookmarkCollections is a parsable slice of BookmarkCollection.
+type BookmarkCollections []*BookmarkCollection
@@ -3,12 +3,29 @@
 package ent
 
 import (
+	"backend/ent/bookmark"
+	"backend/ent/bookmarkcollection"
+	"backend/ent/comment"
 	"backend/ent/genre"
 	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/goaltemplate"
+	"backend/ent/hashtag"
 	"backend/ent/image"
+	"backend/ent/linkpreview"
+	"backend/ent/milestone"
+	"backend/ent/moderationaction"
+	"backend/ent/notification"
+	"backend/ent/notificationdelivery"
+	"backend/ent/outboxevent"
 	"backend/ent/post"
+	"backend/ent/postrevision"
 	"backend/ent/reaction"
 	"backend/ent/refreshtoken"
+	"backend/ent/reminderlog"
+	"backend/ent/report"
+	"backend/ent/repost"
+	"backend/ent/timelineentry"
 	"backend/ent/user"
 	"context"
 	"errors"
@@ -79,13 +96,30 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			genre.Table:        genre.ValidColumn,
-			goal.Table:         goal.ValidColumn,
-			image.Table:        image.ValidColumn,
-			post.Table:         post.ValidColumn,
-			reaction.Table:     reaction.ValidColumn,
-			refreshtoken.Table: refreshtoken.ValidColumn,
-			user.Table:         user.ValidColumn,
+			bookmark.Table:             bookmark.ValidColumn,
+			bookmarkcollection.Table:   bookmarkcollection.ValidColumn,
+			comment.Table:              comment.ValidColumn,
+			genre.Table:                genre.ValidColumn,
+			goal.Table:                 goal.ValidColumn,
+			goalparticipant.Table:      goalparticipant.ValidColumn,
+			goaltemplate.Table:         goaltemplate.ValidColumn,
+			hashtag.Table:              hashtag.ValidColumn,
+			image.Table:                image.ValidColumn,
+			linkpreview.Table:          linkpreview.ValidColumn,
+			milestone.Table:            milestone.ValidColumn,
+			moderationaction.Table:     moderationaction.ValidColumn,
+			notification.Table:         notification.ValidColumn,
+			notificationdelivery.Table: notificationdelivery.ValidColumn,
+			outboxevent.Table:          outboxevent.ValidColumn,
+			post.Table:                 post.ValidColumn,
+			postrevision.Table:         postrevision.ValidColumn,
+			reaction.Table:             reaction.ValidColumn,
+			refreshtoken.Table:         refreshtoken.ValidColumn,
+			reminderlog.Table:          reminderlog.ValidColumn,
+			report.Table:               report.ValidColumn,
+			repost.Table:               repost.ValidColumn,
+			timelineentry.Table:        timelineentry.ValidColumn,
+			user.Table:                 user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
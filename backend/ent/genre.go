@@ -32,9 +32,11 @@ type Genre struct {
 type GenreEdges struct {
 	// Users holds the value of the users edge.
 	Users []*User `json:"users,omitempty"`
+	// Templates holds the value of the templates edge.
+	Templates []*GoalTemplate `json:"templates,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -46,6 +48,15 @@ func (e GenreEdges) UsersOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "users"}
 }
 
+// TemplatesOrErr returns the Templates value or an error if the edge
+// was not loaded in eager-loading.
+func (e GenreEdges) TemplatesOrErr() ([]*GoalTemplate, error) {
+	if e.loadedTypes[1] {
+		return e.Templates, nil
+	}
+	return nil, &NotLoadedError{edge: "templates"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Genre) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -108,6 +119,11 @@ func (_m *Genre) QueryUsers() *UserQuery {
 	return NewGenreClient(_m.config).QueryUsers(_m)
 }
 
+// QueryTemplates queries the "templates" edge of the Genre entity.
+func (_m *Genre) QueryTemplates() *GoalTemplateQuery {
+	return NewGenreClient(_m.config).QueryTemplates(_m)
+}
+
 // Update returns a builder for updating this Genre.
 // Note that you need to call Genre.Unwrap() before calling this method if this Genre
 // was returned from a transaction, and the transaction was committed or rolled back.
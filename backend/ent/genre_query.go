@@ -4,6 +4,7 @@ package ent
 
 import (
 	"backend/ent/genre"
+	"backend/ent/goaltemplate"
 	"backend/ent/predicate"
 	"backend/ent/user"
 	"context"
@@ -12,6 +13,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -21,11 +23,13 @@ import (
 // GenreQuery is the builder for querying Genre entities.
 type GenreQuery struct {
 	config
-	ctx        *QueryContext
-	order      []genre.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Genre
-	withUsers  *UserQuery
+	ctx           *QueryContext
+	order         []genre.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Genre
+	withUsers     *UserQuery
+	withTemplates *GoalTemplateQuery
+	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +88,28 @@ func (_q *GenreQuery) QueryUsers() *UserQuery {
 	return query
 }
 
+// QueryTemplates chains the current query on the "templates" edge.
+func (_q *GenreQuery) QueryTemplates() *GoalTemplateQuery {
+	query := (&GoalTemplateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(genre.Table, genre.FieldID, selector),
+			sqlgraph.To(goaltemplate.Table, goaltemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, genre.TemplatesTable, genre.TemplatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Genre entity from the query.
 // Returns a *NotFoundError when no Genre was found.
 func (_q *GenreQuery) First(ctx context.Context) (*Genre, error) {
@@ -271,15 +297,17 @@ func (_q *GenreQuery) Clone() *GenreQuery {
 		return nil
 	}
 	return &GenreQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]genre.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Genre{}, _q.predicates...),
-		withUsers:  _q.withUsers.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]genre.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.Genre{}, _q.predicates...),
+		withUsers:     _q.withUsers.Clone(),
+		withTemplates: _q.withTemplates.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -294,6 +322,17 @@ func (_q *GenreQuery) WithUsers(opts ...func(*UserQuery)) *GenreQuery {
 	return _q
 }
 
+// WithTemplates tells the query-builder to eager-load the nodes that are connected to
+// the "templates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GenreQuery) WithTemplates(opts ...func(*GoalTemplateQuery)) *GenreQuery {
+	query := (&GoalTemplateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTemplates = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +411,9 @@ func (_q *GenreQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Genre,
 	var (
 		nodes       = []*Genre{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withUsers != nil,
+			_q.withTemplates != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -385,6 +425,9 @@ func (_q *GenreQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Genre,
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -401,6 +444,13 @@ func (_q *GenreQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Genre,
 			return nil, err
 		}
 	}
+	if query := _q.withTemplates; query != nil {
+		if err := _q.loadTemplates(ctx, query, nodes,
+			func(n *Genre) { n.Edges.Templates = []*GoalTemplate{} },
+			func(n *Genre, e *GoalTemplate) { n.Edges.Templates = append(n.Edges.Templates, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -465,9 +515,43 @@ func (_q *GenreQuery) loadUsers(ctx context.Context, query *UserQuery, nodes []*
 	}
 	return nil
 }
+func (_q *GenreQuery) loadTemplates(ctx context.Context, query *GoalTemplateQuery, nodes []*Genre, init func(*Genre), assign func(*Genre, *GoalTemplate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Genre)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GoalTemplate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(genre.TemplatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.genre_templates
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "genre_templates" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "genre_templates" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *GenreQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -530,6 +614,9 @@ func (_q *GenreQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -547,6 +634,38 @@ func (_q *GenreQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *GenreQuery) ForUpdate(opts ...sql.LockOption) *GenreQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *GenreQuery) ForShare(opts ...sql.LockOption) *GenreQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *GenreQuery) Modify(modifiers ...func(s *sql.Selector)) *GenreSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // GenreGroupBy is the group-by builder for Genre entities.
 type GenreGroupBy struct {
 	selector
@@ -636,3 +755,9 @@ func (_s *GenreSelect) sqlScan(ctx context.Context, root *GenreQuery, v any) err
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *GenreSelect) Modify(modifiers ...func(s *sql.Selector)) *GenreSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
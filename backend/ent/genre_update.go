@@ -4,6 +4,7 @@ package ent
 
 import (
 	"backend/ent/genre"
+	"backend/ent/goaltemplate"
 	"backend/ent/predicate"
 	"backend/ent/user"
 	"context"
@@ -19,8 +20,9 @@ import (
 // GenreUpdate is the builder for updating Genre entities.
 type GenreUpdate struct {
 	config
-	hooks    []Hook
-	mutation *GenreMutation
+	hooks     []Hook
+	mutation  *GenreMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the GenreUpdate builder.
@@ -58,6 +60,21 @@ func (_u *GenreUpdate) AddUsers(v ...*User) *GenreUpdate {
 	return _u.AddUserIDs(ids...)
 }
 
+// AddTemplateIDs adds the "templates" edge to the GoalTemplate entity by IDs.
+func (_u *GenreUpdate) AddTemplateIDs(ids ...uuid.UUID) *GenreUpdate {
+	_u.mutation.AddTemplateIDs(ids...)
+	return _u
+}
+
+// AddTemplates adds the "templates" edges to the GoalTemplate entity.
+func (_u *GenreUpdate) AddTemplates(v ...*GoalTemplate) *GenreUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTemplateIDs(ids...)
+}
+
 // Mutation returns the GenreMutation object of the builder.
 func (_u *GenreUpdate) Mutation() *GenreMutation {
 	return _u.mutation
@@ -84,6 +101,27 @@ func (_u *GenreUpdate) RemoveUsers(v ...*User) *GenreUpdate {
 	return _u.RemoveUserIDs(ids...)
 }
 
+// ClearTemplates clears all "templates" edges to the GoalTemplate entity.
+func (_u *GenreUpdate) ClearTemplates() *GenreUpdate {
+	_u.mutation.ClearTemplates()
+	return _u
+}
+
+// RemoveTemplateIDs removes the "templates" edge to GoalTemplate entities by IDs.
+func (_u *GenreUpdate) RemoveTemplateIDs(ids ...uuid.UUID) *GenreUpdate {
+	_u.mutation.RemoveTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveTemplates removes "templates" edges to GoalTemplate entities.
+func (_u *GenreUpdate) RemoveTemplates(v ...*GoalTemplate) *GenreUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTemplateIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *GenreUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -121,6 +159,12 @@ func (_u *GenreUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GenreUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GenreUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *GenreUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -181,6 +225,52 @@ func (_u *GenreUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   genre.TemplatesTable,
+			Columns: []string{genre.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goaltemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTemplatesIDs(); len(nodes) > 0 && !_u.mutation.TemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   genre.TemplatesTable,
+			Columns: []string{genre.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goaltemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   genre.TemplatesTable,
+			Columns: []string{genre.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goaltemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{genre.Label}
@@ -196,9 +286,10 @@ func (_u *GenreUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // GenreUpdateOne is the builder for updating a single Genre entity.
 type GenreUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GenreMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *GenreMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -230,6 +321,21 @@ func (_u *GenreUpdateOne) AddUsers(v ...*User) *GenreUpdateOne {
 	return _u.AddUserIDs(ids...)
 }
 
+// AddTemplateIDs adds the "templates" edge to the GoalTemplate entity by IDs.
+func (_u *GenreUpdateOne) AddTemplateIDs(ids ...uuid.UUID) *GenreUpdateOne {
+	_u.mutation.AddTemplateIDs(ids...)
+	return _u
+}
+
+// AddTemplates adds the "templates" edges to the GoalTemplate entity.
+func (_u *GenreUpdateOne) AddTemplates(v ...*GoalTemplate) *GenreUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTemplateIDs(ids...)
+}
+
 // Mutation returns the GenreMutation object of the builder.
 func (_u *GenreUpdateOne) Mutation() *GenreMutation {
 	return _u.mutation
@@ -256,6 +362,27 @@ func (_u *GenreUpdateOne) RemoveUsers(v ...*User) *GenreUpdateOne {
 	return _u.RemoveUserIDs(ids...)
 }
 
+// ClearTemplates clears all "templates" edges to the GoalTemplate entity.
+func (_u *GenreUpdateOne) ClearTemplates() *GenreUpdateOne {
+	_u.mutation.ClearTemplates()
+	return _u
+}
+
+// RemoveTemplateIDs removes the "templates" edge to GoalTemplate entities by IDs.
+func (_u *GenreUpdateOne) RemoveTemplateIDs(ids ...uuid.UUID) *GenreUpdateOne {
+	_u.mutation.RemoveTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveTemplates removes "templates" edges to GoalTemplate entities.
+func (_u *GenreUpdateOne) RemoveTemplates(v ...*GoalTemplate) *GenreUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTemplateIDs(ids...)
+}
+
 // Where appends a list predicates to the GenreUpdate builder.
 func (_u *GenreUpdateOne) Where(ps ...predicate.Genre) *GenreUpdateOne {
 	_u.mutation.Where(ps...)
@@ -306,6 +433,12 @@ func (_u *GenreUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GenreUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GenreUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *GenreUpdateOne) sqlSave(ctx context.Context) (_node *Genre, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -383,6 +516,52 @@ func (_u *GenreUpdateOne) sqlSave(ctx context.Context) (_node *Genre, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   genre.TemplatesTable,
+			Columns: []string{genre.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goaltemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTemplatesIDs(); len(nodes) > 0 && !_u.mutation.TemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   genre.TemplatesTable,
+			Columns: []string{genre.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goaltemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   genre.TemplatesTable,
+			Columns: []string{genre.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goaltemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Genre{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
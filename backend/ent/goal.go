@@ -4,7 +4,9 @@ package ent
 
 import (
 	"backend/ent/goal"
+	"backend/ent/schema/types"
 	"backend/ent/user"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -23,10 +25,18 @@ type Goal struct {
 	Title string `json:"title,omitempty"`
 	// Deadline holds the value of the "deadline" field.
 	Deadline *time.Time `json:"deadline,omitempty"`
+	// HabitDays holds the value of the "habit_days" field.
+	HabitDays []string `json:"habit_days,omitempty"`
+	// Pinned holds the value of the "pinned" field.
+	Pinned bool `json:"pinned,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SearchVector holds the value of the "search_vector" field.
+	SearchVector types.TSVector `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GoalQuery when eager-loading is set.
 	Edges        GoalEdges `json:"edges"`
@@ -40,9 +50,17 @@ type GoalEdges struct {
 	User *User `json:"user,omitempty"`
 	// Posts holds the value of the posts edge.
 	Posts []*Post `json:"posts,omitempty"`
+	// Participants holds the value of the participants edge.
+	Participants []*GoalParticipant `json:"participants,omitempty"`
+	// Milestones holds the value of the milestones edge.
+	Milestones []*Milestone `json:"milestones,omitempty"`
+	// ReminderLogs holds the value of the reminder_logs edge.
+	ReminderLogs []*ReminderLog `json:"reminder_logs,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -65,15 +83,59 @@ func (e GoalEdges) PostsOrErr() ([]*Post, error) {
 	return nil, &NotLoadedError{edge: "posts"}
 }
 
+// ParticipantsOrErr returns the Participants value or an error if the edge
+// was not loaded in eager-loading.
+func (e GoalEdges) ParticipantsOrErr() ([]*GoalParticipant, error) {
+	if e.loadedTypes[2] {
+		return e.Participants, nil
+	}
+	return nil, &NotLoadedError{edge: "participants"}
+}
+
+// MilestonesOrErr returns the Milestones value or an error if the edge
+// was not loaded in eager-loading.
+func (e GoalEdges) MilestonesOrErr() ([]*Milestone, error) {
+	if e.loadedTypes[3] {
+		return e.Milestones, nil
+	}
+	return nil, &NotLoadedError{edge: "milestones"}
+}
+
+// ReminderLogsOrErr returns the ReminderLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e GoalEdges) ReminderLogsOrErr() ([]*ReminderLog, error) {
+	if e.loadedTypes[4] {
+		return e.ReminderLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "reminder_logs"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e GoalEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[5] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Goal) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case goal.FieldHabitDays:
+			values[i] = new([]byte)
+		case goal.FieldPinned:
+			values[i] = new(sql.NullBool)
+		case goal.FieldPosition:
+			values[i] = new(sql.NullInt64)
 		case goal.FieldTitle:
 			values[i] = new(sql.NullString)
 		case goal.FieldDeadline, goal.FieldCreatedAt, goal.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
+		case goal.FieldSearchVector:
+			values[i] = new(types.TSVector)
 		case goal.FieldID:
 			values[i] = new(uuid.UUID)
 		case goal.ForeignKeys[0]: // user_goals
@@ -112,6 +174,26 @@ func (_m *Goal) assignValues(columns []string, values []any) error {
 				_m.Deadline = new(time.Time)
 				*_m.Deadline = value.Time
 			}
+		case goal.FieldHabitDays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field habit_days", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HabitDays); err != nil {
+					return fmt.Errorf("unmarshal field habit_days: %w", err)
+				}
+			}
+		case goal.FieldPinned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned", values[i])
+			} else if value.Valid {
+				_m.Pinned = value.Bool
+			}
+		case goal.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
 		case goal.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -124,6 +206,12 @@ func (_m *Goal) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case goal.FieldSearchVector:
+			if value, ok := values[i].(*types.TSVector); !ok {
+				return fmt.Errorf("unexpected type %T for field search_vector", values[i])
+			} else if value != nil {
+				_m.SearchVector = *value
+			}
 		case goal.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_goals", values[i])
@@ -154,6 +242,26 @@ func (_m *Goal) QueryPosts() *PostQuery {
 	return NewGoalClient(_m.config).QueryPosts(_m)
 }
 
+// QueryParticipants queries the "participants" edge of the Goal entity.
+func (_m *Goal) QueryParticipants() *GoalParticipantQuery {
+	return NewGoalClient(_m.config).QueryParticipants(_m)
+}
+
+// QueryMilestones queries the "milestones" edge of the Goal entity.
+func (_m *Goal) QueryMilestones() *MilestoneQuery {
+	return NewGoalClient(_m.config).QueryMilestones(_m)
+}
+
+// QueryReminderLogs queries the "reminder_logs" edge of the Goal entity.
+func (_m *Goal) QueryReminderLogs() *ReminderLogQuery {
+	return NewGoalClient(_m.config).QueryReminderLogs(_m)
+}
+
+// QueryNotifications queries the "notifications" edge of the Goal entity.
+func (_m *Goal) QueryNotifications() *NotificationQuery {
+	return NewGoalClient(_m.config).QueryNotifications(_m)
+}
+
 // Update returns a builder for updating this Goal.
 // Note that you need to call Goal.Unwrap() before calling this method if this Goal
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -185,11 +293,22 @@ func (_m *Goal) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("habit_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HabitDays))
+	builder.WriteString(", ")
+	builder.WriteString("pinned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pinned))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("search_vector=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
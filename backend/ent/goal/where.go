@@ -4,6 +4,7 @@ package goal
 
 import (
 	"backend/ent/predicate"
+	"backend/ent/schema/types"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -66,6 +67,16 @@ func Deadline(v time.Time) predicate.Goal {
 	return predicate.Goal(sql.FieldEQ(FieldDeadline, v))
 }
 
+// Pinned applies equality check predicate on the "pinned" field. It's identical to PinnedEQ.
+func Pinned(v bool) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldPinned, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldPosition, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Goal {
 	return predicate.Goal(sql.FieldEQ(FieldCreatedAt, v))
@@ -76,6 +87,11 @@ func UpdatedAt(v time.Time) predicate.Goal {
 	return predicate.Goal(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// SearchVector applies equality check predicate on the "search_vector" field. It's identical to SearchVectorEQ.
+func SearchVector(v types.TSVector) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldSearchVector, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Goal {
 	return predicate.Goal(sql.FieldEQ(FieldTitle, v))
@@ -191,6 +207,66 @@ func DeadlineNotNil() predicate.Goal {
 	return predicate.Goal(sql.FieldNotNull(FieldDeadline))
 }
 
+// HabitDaysIsNil applies the IsNil predicate on the "habit_days" field.
+func HabitDaysIsNil() predicate.Goal {
+	return predicate.Goal(sql.FieldIsNull(FieldHabitDays))
+}
+
+// HabitDaysNotNil applies the NotNil predicate on the "habit_days" field.
+func HabitDaysNotNil() predicate.Goal {
+	return predicate.Goal(sql.FieldNotNull(FieldHabitDays))
+}
+
+// PinnedEQ applies the EQ predicate on the "pinned" field.
+func PinnedEQ(v bool) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldPinned, v))
+}
+
+// PinnedNEQ applies the NEQ predicate on the "pinned" field.
+func PinnedNEQ(v bool) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldPinned, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldPosition, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Goal {
 	return predicate.Goal(sql.FieldEQ(FieldCreatedAt, v))
@@ -271,6 +347,56 @@ func UpdatedAtLTE(v time.Time) predicate.Goal {
 	return predicate.Goal(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// SearchVectorEQ applies the EQ predicate on the "search_vector" field.
+func SearchVectorEQ(v types.TSVector) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldSearchVector, v))
+}
+
+// SearchVectorNEQ applies the NEQ predicate on the "search_vector" field.
+func SearchVectorNEQ(v types.TSVector) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldSearchVector, v))
+}
+
+// SearchVectorIn applies the In predicate on the "search_vector" field.
+func SearchVectorIn(vs ...types.TSVector) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldSearchVector, vs...))
+}
+
+// SearchVectorNotIn applies the NotIn predicate on the "search_vector" field.
+func SearchVectorNotIn(vs ...types.TSVector) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldSearchVector, vs...))
+}
+
+// SearchVectorGT applies the GT predicate on the "search_vector" field.
+func SearchVectorGT(v types.TSVector) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldSearchVector, v))
+}
+
+// SearchVectorGTE applies the GTE predicate on the "search_vector" field.
+func SearchVectorGTE(v types.TSVector) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldSearchVector, v))
+}
+
+// SearchVectorLT applies the LT predicate on the "search_vector" field.
+func SearchVectorLT(v types.TSVector) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldSearchVector, v))
+}
+
+// SearchVectorLTE applies the LTE predicate on the "search_vector" field.
+func SearchVectorLTE(v types.TSVector) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldSearchVector, v))
+}
+
+// SearchVectorIsNil applies the IsNil predicate on the "search_vector" field.
+func SearchVectorIsNil() predicate.Goal {
+	return predicate.Goal(sql.FieldIsNull(FieldSearchVector))
+}
+
+// SearchVectorNotNil applies the NotNil predicate on the "search_vector" field.
+func SearchVectorNotNil() predicate.Goal {
+	return predicate.Goal(sql.FieldNotNull(FieldSearchVector))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Goal {
 	return predicate.Goal(func(s *sql.Selector) {
@@ -317,6 +443,98 @@ func HasPostsWith(preds ...predicate.Post) predicate.Goal {
 	})
 }
 
+// HasParticipants applies the HasEdge predicate on the "participants" edge.
+func HasParticipants() predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ParticipantsTable, ParticipantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParticipantsWith applies the HasEdge predicate on the "participants" edge with a given conditions (other predicates).
+func HasParticipantsWith(preds ...predicate.GoalParticipant) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := newParticipantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMilestones applies the HasEdge predicate on the "milestones" edge.
+func HasMilestones() predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MilestonesTable, MilestonesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMilestonesWith applies the HasEdge predicate on the "milestones" edge with a given conditions (other predicates).
+func HasMilestonesWith(preds ...predicate.Milestone) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := newMilestonesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReminderLogs applies the HasEdge predicate on the "reminder_logs" edge.
+func HasReminderLogs() predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReminderLogsTable, ReminderLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReminderLogsWith applies the HasEdge predicate on the "reminder_logs" edge with a given conditions (other predicates).
+func HasReminderLogsWith(preds ...predicate.ReminderLog) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := newReminderLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifications applies the HasEdge predicate on the "notifications" edge.
+func HasNotifications() predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsWith applies the HasEdge predicate on the "notifications" edge with a given conditions (other predicates).
+func HasNotificationsWith(preds ...predicate.Notification) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := newNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Goal) predicate.Goal {
 	return predicate.Goal(sql.AndPredicates(predicates...))
@@ -4,13 +4,20 @@ package ent
 
 import (
 	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/milestone"
+	"backend/ent/notification"
 	"backend/ent/post"
+	"backend/ent/reminderlog"
+	"backend/ent/schema/types"
 	"backend/ent/user"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +28,7 @@ type GoalCreate struct {
 	config
 	mutation *GoalMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetTitle sets the "title" field.
@@ -43,6 +51,40 @@ func (_c *GoalCreate) SetNillableDeadline(v *time.Time) *GoalCreate {
 	return _c
 }
 
+// SetHabitDays sets the "habit_days" field.
+func (_c *GoalCreate) SetHabitDays(v []string) *GoalCreate {
+	_c.mutation.SetHabitDays(v)
+	return _c
+}
+
+// SetPinned sets the "pinned" field.
+func (_c *GoalCreate) SetPinned(v bool) *GoalCreate {
+	_c.mutation.SetPinned(v)
+	return _c
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_c *GoalCreate) SetNillablePinned(v *bool) *GoalCreate {
+	if v != nil {
+		_c.SetPinned(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *GoalCreate) SetPosition(v int) *GoalCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *GoalCreate) SetNillablePosition(v *int) *GoalCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *GoalCreate) SetCreatedAt(v time.Time) *GoalCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -71,6 +113,20 @@ func (_c *GoalCreate) SetNillableUpdatedAt(v *time.Time) *GoalCreate {
 	return _c
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (_c *GoalCreate) SetSearchVector(v types.TSVector) *GoalCreate {
+	_c.mutation.SetSearchVector(v)
+	return _c
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableSearchVector(v *types.TSVector) *GoalCreate {
+	if v != nil {
+		_c.SetSearchVector(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *GoalCreate) SetID(v uuid.UUID) *GoalCreate {
 	_c.mutation.SetID(v)
@@ -111,6 +167,66 @@ func (_c *GoalCreate) AddPosts(v ...*Post) *GoalCreate {
 	return _c.AddPostIDs(ids...)
 }
 
+// AddParticipantIDs adds the "participants" edge to the GoalParticipant entity by IDs.
+func (_c *GoalCreate) AddParticipantIDs(ids ...uuid.UUID) *GoalCreate {
+	_c.mutation.AddParticipantIDs(ids...)
+	return _c
+}
+
+// AddParticipants adds the "participants" edges to the GoalParticipant entity.
+func (_c *GoalCreate) AddParticipants(v ...*GoalParticipant) *GoalCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddParticipantIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (_c *GoalCreate) AddMilestoneIDs(ids ...uuid.UUID) *GoalCreate {
+	_c.mutation.AddMilestoneIDs(ids...)
+	return _c
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (_c *GoalCreate) AddMilestones(v ...*Milestone) *GoalCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMilestoneIDs(ids...)
+}
+
+// AddReminderLogIDs adds the "reminder_logs" edge to the ReminderLog entity by IDs.
+func (_c *GoalCreate) AddReminderLogIDs(ids ...uuid.UUID) *GoalCreate {
+	_c.mutation.AddReminderLogIDs(ids...)
+	return _c
+}
+
+// AddReminderLogs adds the "reminder_logs" edges to the ReminderLog entity.
+func (_c *GoalCreate) AddReminderLogs(v ...*ReminderLog) *GoalCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReminderLogIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_c *GoalCreate) AddNotificationIDs(ids ...uuid.UUID) *GoalCreate {
+	_c.mutation.AddNotificationIDs(ids...)
+	return _c
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_c *GoalCreate) AddNotifications(v ...*Notification) *GoalCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the GoalMutation object of the builder.
 func (_c *GoalCreate) Mutation() *GoalMutation {
 	return _c.mutation
@@ -118,7 +234,9 @@ func (_c *GoalCreate) Mutation() *GoalMutation {
 
 // Save creates the Goal in the database.
 func (_c *GoalCreate) Save(ctx context.Context) (*Goal, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -145,19 +263,37 @@ func (_c *GoalCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *GoalCreate) defaults() {
+func (_c *GoalCreate) defaults() error {
+	if _, ok := _c.mutation.Pinned(); !ok {
+		v := goal.DefaultPinned
+		_c.mutation.SetPinned(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := goal.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if goal.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized goal.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := goal.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if goal.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized goal.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := goal.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if goal.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized goal.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := goal.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -170,6 +306,12 @@ func (_c *GoalCreate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Goal.title": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Pinned(); !ok {
+		return &ValidationError{Name: "pinned", err: errors.New(`ent: missing required field "Goal.pinned"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "Goal.position"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Goal.created_at"`)}
 	}
@@ -210,6 +352,7 @@ func (_c *GoalCreate) createSpec() (*Goal, *sqlgraph.CreateSpec) {
 		_node = &Goal{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(goal.Table, sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -222,6 +365,18 @@ func (_c *GoalCreate) createSpec() (*Goal, *sqlgraph.CreateSpec) {
 		_spec.SetField(goal.FieldDeadline, field.TypeTime, value)
 		_node.Deadline = &value
 	}
+	if value, ok := _c.mutation.HabitDays(); ok {
+		_spec.SetField(goal.FieldHabitDays, field.TypeJSON, value)
+		_node.HabitDays = value
+	}
+	if value, ok := _c.mutation.Pinned(); ok {
+		_spec.SetField(goal.FieldPinned, field.TypeBool, value)
+		_node.Pinned = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(goal.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(goal.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -230,6 +385,10 @@ func (_c *GoalCreate) createSpec() (*Goal, *sqlgraph.CreateSpec) {
 		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.SearchVector(); ok {
+		_spec.SetField(goal.FieldSearchVector, field.TypeOther, value)
+		_node.SearchVector = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -263,14 +422,451 @@ func (_c *GoalCreate) createSpec() (*Goal, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ParticipantsTable,
+			Columns: []string{goal.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goalparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.MilestonesTable,
+			Columns: []string{goal.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReminderLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ReminderLogsTable,
+			Columns: []string{goal.ReminderLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.NotificationsTable,
+			Columns: []string{goal.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Goal.Create().
+//		SetTitle(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalCreate) OnConflict(opts ...sql.ConflictOption) *GoalUpsertOne {
+	_c.conflict = opts
+	return &GoalUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalCreate) OnConflictColumns(columns ...string) *GoalUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GoalUpsertOne is the builder for "upsert"-ing
+	//  one Goal node.
+	GoalUpsertOne struct {
+		create *GoalCreate
+	}
+
+	// GoalUpsert is the "OnConflict" setter.
+	GoalUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTitle sets the "title" field.
+func (u *GoalUpsert) SetTitle(v string) *GoalUpsert {
+	u.Set(goal.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateTitle() *GoalUpsert {
+	u.SetExcluded(goal.FieldTitle)
+	return u
+}
+
+// SetDeadline sets the "deadline" field.
+func (u *GoalUpsert) SetDeadline(v time.Time) *GoalUpsert {
+	u.Set(goal.FieldDeadline, v)
+	return u
+}
+
+// UpdateDeadline sets the "deadline" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateDeadline() *GoalUpsert {
+	u.SetExcluded(goal.FieldDeadline)
+	return u
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (u *GoalUpsert) ClearDeadline() *GoalUpsert {
+	u.SetNull(goal.FieldDeadline)
+	return u
+}
+
+// SetHabitDays sets the "habit_days" field.
+func (u *GoalUpsert) SetHabitDays(v []string) *GoalUpsert {
+	u.Set(goal.FieldHabitDays, v)
+	return u
+}
+
+// UpdateHabitDays sets the "habit_days" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateHabitDays() *GoalUpsert {
+	u.SetExcluded(goal.FieldHabitDays)
+	return u
+}
+
+// ClearHabitDays clears the value of the "habit_days" field.
+func (u *GoalUpsert) ClearHabitDays() *GoalUpsert {
+	u.SetNull(goal.FieldHabitDays)
+	return u
+}
+
+// SetPinned sets the "pinned" field.
+func (u *GoalUpsert) SetPinned(v bool) *GoalUpsert {
+	u.Set(goal.FieldPinned, v)
+	return u
+}
+
+// UpdatePinned sets the "pinned" field to the value that was provided on create.
+func (u *GoalUpsert) UpdatePinned() *GoalUpsert {
+	u.SetExcluded(goal.FieldPinned)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *GoalUpsert) SetPosition(v int) *GoalUpsert {
+	u.Set(goal.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *GoalUpsert) UpdatePosition() *GoalUpsert {
+	u.SetExcluded(goal.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *GoalUpsert) AddPosition(v int) *GoalUpsert {
+	u.Add(goal.FieldPosition, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsert) SetUpdatedAt(v time.Time) *GoalUpsert {
+	u.Set(goal.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateUpdatedAt() *GoalUpsert {
+	u.SetExcluded(goal.FieldUpdatedAt)
+	return u
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (u *GoalUpsert) SetSearchVector(v types.TSVector) *GoalUpsert {
+	u.Set(goal.FieldSearchVector, v)
+	return u
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateSearchVector() *GoalUpsert {
+	u.SetExcluded(goal.FieldSearchVector)
+	return u
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *GoalUpsert) ClearSearchVector() *GoalUpsert {
+	u.SetNull(goal.FieldSearchVector)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalUpsertOne) UpdateNewValues() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(goal.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(goal.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GoalUpsertOne) Ignore() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalUpsertOne) DoNothing() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalCreate.OnConflict
+// documentation for more info.
+func (u *GoalUpsertOne) Update(set func(*GoalUpsert)) *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *GoalUpsertOne) SetTitle(v string) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateTitle() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDeadline sets the "deadline" field.
+func (u *GoalUpsertOne) SetDeadline(v time.Time) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetDeadline(v)
+	})
+}
+
+// UpdateDeadline sets the "deadline" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateDeadline() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateDeadline()
+	})
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (u *GoalUpsertOne) ClearDeadline() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearDeadline()
+	})
+}
+
+// SetHabitDays sets the "habit_days" field.
+func (u *GoalUpsertOne) SetHabitDays(v []string) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetHabitDays(v)
+	})
+}
+
+// UpdateHabitDays sets the "habit_days" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateHabitDays() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateHabitDays()
+	})
+}
+
+// ClearHabitDays clears the value of the "habit_days" field.
+func (u *GoalUpsertOne) ClearHabitDays() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearHabitDays()
+	})
+}
+
+// SetPinned sets the "pinned" field.
+func (u *GoalUpsertOne) SetPinned(v bool) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetPinned(v)
+	})
+}
+
+// UpdatePinned sets the "pinned" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdatePinned() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdatePinned()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *GoalUpsertOne) SetPosition(v int) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *GoalUpsertOne) AddPosition(v int) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdatePosition() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsertOne) SetUpdatedAt(v time.Time) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateUpdatedAt() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (u *GoalUpsertOne) SetSearchVector(v types.TSVector) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetSearchVector(v)
+	})
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateSearchVector() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateSearchVector()
+	})
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *GoalUpsertOne) ClearSearchVector() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearSearchVector()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GoalUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GoalUpsertOne.ID is not supported by MySQL driver. Use GoalUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GoalUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // GoalCreateBulk is the builder for creating many Goal entities in bulk.
 type GoalCreateBulk struct {
 	config
 	err      error
 	builders []*GoalCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Goal entities in the database.
@@ -300,6 +896,7 @@ func (_c *GoalCreateBulk) Save(ctx context.Context) ([]*Goal, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -349,3 +946,246 @@ func (_c *GoalCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Goal.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalCreateBulk) OnConflict(opts ...sql.ConflictOption) *GoalUpsertBulk {
+	_c.conflict = opts
+	return &GoalUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalCreateBulk) OnConflictColumns(columns ...string) *GoalUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalUpsertBulk{
+		create: _c,
+	}
+}
+
+// GoalUpsertBulk is the builder for "upsert"-ing
+// a bulk of Goal nodes.
+type GoalUpsertBulk struct {
+	create *GoalCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalUpsertBulk) UpdateNewValues() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(goal.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(goal.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GoalUpsertBulk) Ignore() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalUpsertBulk) DoNothing() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalCreateBulk.OnConflict
+// documentation for more info.
+func (u *GoalUpsertBulk) Update(set func(*GoalUpsert)) *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *GoalUpsertBulk) SetTitle(v string) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateTitle() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDeadline sets the "deadline" field.
+func (u *GoalUpsertBulk) SetDeadline(v time.Time) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetDeadline(v)
+	})
+}
+
+// UpdateDeadline sets the "deadline" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateDeadline() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateDeadline()
+	})
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (u *GoalUpsertBulk) ClearDeadline() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearDeadline()
+	})
+}
+
+// SetHabitDays sets the "habit_days" field.
+func (u *GoalUpsertBulk) SetHabitDays(v []string) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetHabitDays(v)
+	})
+}
+
+// UpdateHabitDays sets the "habit_days" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateHabitDays() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateHabitDays()
+	})
+}
+
+// ClearHabitDays clears the value of the "habit_days" field.
+func (u *GoalUpsertBulk) ClearHabitDays() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearHabitDays()
+	})
+}
+
+// SetPinned sets the "pinned" field.
+func (u *GoalUpsertBulk) SetPinned(v bool) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetPinned(v)
+	})
+}
+
+// UpdatePinned sets the "pinned" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdatePinned() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdatePinned()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *GoalUpsertBulk) SetPosition(v int) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *GoalUpsertBulk) AddPosition(v int) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdatePosition() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsertBulk) SetUpdatedAt(v time.Time) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateUpdatedAt() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (u *GoalUpsertBulk) SetSearchVector(v types.TSVector) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetSearchVector(v)
+	})
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateSearchVector() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateSearchVector()
+	})
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *GoalUpsertBulk) ClearSearchVector() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearSearchVector()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GoalCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,8 +4,12 @@ package ent
 
 import (
 	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/milestone"
+	"backend/ent/notification"
 	"backend/ent/post"
 	"backend/ent/predicate"
+	"backend/ent/reminderlog"
 	"backend/ent/user"
 	"context"
 	"database/sql/driver"
@@ -13,6 +17,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -22,13 +27,18 @@ import (
 // GoalQuery is the builder for querying Goal entities.
 type GoalQuery struct {
 	config
-	ctx        *QueryContext
-	order      []goal.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Goal
-	withUser   *UserQuery
-	withPosts  *PostQuery
-	withFKs    bool
+	ctx               *QueryContext
+	order             []goal.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Goal
+	withUser          *UserQuery
+	withPosts         *PostQuery
+	withParticipants  *GoalParticipantQuery
+	withMilestones    *MilestoneQuery
+	withReminderLogs  *ReminderLogQuery
+	withNotifications *NotificationQuery
+	withFKs           bool
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -109,6 +119,94 @@ func (_q *GoalQuery) QueryPosts() *PostQuery {
 	return query
 }
 
+// QueryParticipants chains the current query on the "participants" edge.
+func (_q *GoalQuery) QueryParticipants() *GoalParticipantQuery {
+	query := (&GoalParticipantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(goal.Table, goal.FieldID, selector),
+			sqlgraph.To(goalparticipant.Table, goalparticipant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, goal.ParticipantsTable, goal.ParticipantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMilestones chains the current query on the "milestones" edge.
+func (_q *GoalQuery) QueryMilestones() *MilestoneQuery {
+	query := (&MilestoneClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(goal.Table, goal.FieldID, selector),
+			sqlgraph.To(milestone.Table, milestone.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, goal.MilestonesTable, goal.MilestonesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReminderLogs chains the current query on the "reminder_logs" edge.
+func (_q *GoalQuery) QueryReminderLogs() *ReminderLogQuery {
+	query := (&ReminderLogClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(goal.Table, goal.FieldID, selector),
+			sqlgraph.To(reminderlog.Table, reminderlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, goal.ReminderLogsTable, goal.ReminderLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotifications chains the current query on the "notifications" edge.
+func (_q *GoalQuery) QueryNotifications() *NotificationQuery {
+	query := (&NotificationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(goal.Table, goal.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, goal.NotificationsTable, goal.NotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Goal entity from the query.
 // Returns a *NotFoundError when no Goal was found.
 func (_q *GoalQuery) First(ctx context.Context) (*Goal, error) {
@@ -296,16 +394,21 @@ func (_q *GoalQuery) Clone() *GoalQuery {
 		return nil
 	}
 	return &GoalQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]goal.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Goal{}, _q.predicates...),
-		withUser:   _q.withUser.Clone(),
-		withPosts:  _q.withPosts.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]goal.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.Goal{}, _q.predicates...),
+		withUser:          _q.withUser.Clone(),
+		withPosts:         _q.withPosts.Clone(),
+		withParticipants:  _q.withParticipants.Clone(),
+		withMilestones:    _q.withMilestones.Clone(),
+		withReminderLogs:  _q.withReminderLogs.Clone(),
+		withNotifications: _q.withNotifications.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -331,6 +434,50 @@ func (_q *GoalQuery) WithPosts(opts ...func(*PostQuery)) *GoalQuery {
 	return _q
 }
 
+// WithParticipants tells the query-builder to eager-load the nodes that are connected to
+// the "participants" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GoalQuery) WithParticipants(opts ...func(*GoalParticipantQuery)) *GoalQuery {
+	query := (&GoalParticipantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withParticipants = query
+	return _q
+}
+
+// WithMilestones tells the query-builder to eager-load the nodes that are connected to
+// the "milestones" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GoalQuery) WithMilestones(opts ...func(*MilestoneQuery)) *GoalQuery {
+	query := (&MilestoneClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMilestones = query
+	return _q
+}
+
+// WithReminderLogs tells the query-builder to eager-load the nodes that are connected to
+// the "reminder_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GoalQuery) WithReminderLogs(opts ...func(*ReminderLogQuery)) *GoalQuery {
+	query := (&ReminderLogClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReminderLogs = query
+	return _q
+}
+
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GoalQuery) WithNotifications(opts ...func(*NotificationQuery)) *GoalQuery {
+	query := (&NotificationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotifications = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -410,9 +557,13 @@ func (_q *GoalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Goal, e
 		nodes       = []*Goal{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [6]bool{
 			_q.withUser != nil,
 			_q.withPosts != nil,
+			_q.withParticipants != nil,
+			_q.withMilestones != nil,
+			_q.withReminderLogs != nil,
+			_q.withNotifications != nil,
 		}
 	)
 	if _q.withUser != nil {
@@ -430,6 +581,9 @@ func (_q *GoalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Goal, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -452,6 +606,34 @@ func (_q *GoalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Goal, e
 			return nil, err
 		}
 	}
+	if query := _q.withParticipants; query != nil {
+		if err := _q.loadParticipants(ctx, query, nodes,
+			func(n *Goal) { n.Edges.Participants = []*GoalParticipant{} },
+			func(n *Goal, e *GoalParticipant) { n.Edges.Participants = append(n.Edges.Participants, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMilestones; query != nil {
+		if err := _q.loadMilestones(ctx, query, nodes,
+			func(n *Goal) { n.Edges.Milestones = []*Milestone{} },
+			func(n *Goal, e *Milestone) { n.Edges.Milestones = append(n.Edges.Milestones, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReminderLogs; query != nil {
+		if err := _q.loadReminderLogs(ctx, query, nodes,
+			func(n *Goal) { n.Edges.ReminderLogs = []*ReminderLog{} },
+			func(n *Goal, e *ReminderLog) { n.Edges.ReminderLogs = append(n.Edges.ReminderLogs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotifications; query != nil {
+		if err := _q.loadNotifications(ctx, query, nodes,
+			func(n *Goal) { n.Edges.Notifications = []*Notification{} },
+			func(n *Goal, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -518,9 +700,136 @@ func (_q *GoalQuery) loadPosts(ctx context.Context, query *PostQuery, nodes []*G
 	}
 	return nil
 }
+func (_q *GoalQuery) loadParticipants(ctx context.Context, query *GoalParticipantQuery, nodes []*Goal, init func(*Goal), assign func(*Goal, *GoalParticipant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Goal)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GoalParticipant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(goal.ParticipantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.goal_participants
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "goal_participants" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "goal_participants" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GoalQuery) loadMilestones(ctx context.Context, query *MilestoneQuery, nodes []*Goal, init func(*Goal), assign func(*Goal, *Milestone)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Goal)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Milestone(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(goal.MilestonesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.goal_milestones
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "goal_milestones" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "goal_milestones" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GoalQuery) loadReminderLogs(ctx context.Context, query *ReminderLogQuery, nodes []*Goal, init func(*Goal), assign func(*Goal, *ReminderLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Goal)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ReminderLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(goal.ReminderLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.goal_reminder_logs
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "goal_reminder_logs" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "goal_reminder_logs" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *GoalQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*Goal, init func(*Goal), assign func(*Goal, *Notification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Goal)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(goal.NotificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.goal_notifications
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "goal_notifications" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "goal_notifications" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *GoalQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -583,6 +892,9 @@ func (_q *GoalQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -600,6 +912,38 @@ func (_q *GoalQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *GoalQuery) ForUpdate(opts ...sql.LockOption) *GoalQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *GoalQuery) ForShare(opts ...sql.LockOption) *GoalQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *GoalQuery) Modify(modifiers ...func(s *sql.Selector)) *GoalSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // GoalGroupBy is the group-by builder for Goal entities.
 type GoalGroupBy struct {
 	selector
@@ -689,3 +1033,9 @@ func (_s *GoalSelect) sqlScan(ctx context.Context, root *GoalQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *GoalSelect) Modify(modifiers ...func(s *sql.Selector)) *GoalSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -4,8 +4,13 @@ package ent
 
 import (
 	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/milestone"
+	"backend/ent/notification"
 	"backend/ent/post"
 	"backend/ent/predicate"
+	"backend/ent/reminderlog"
+	"backend/ent/schema/types"
 	"backend/ent/user"
 	"context"
 	"errors"
@@ -14,6 +19,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -21,8 +27,9 @@ import (
 // GoalUpdate is the builder for updating Goal entities.
 type GoalUpdate struct {
 	config
-	hooks    []Hook
-	mutation *GoalMutation
+	hooks     []Hook
+	mutation  *GoalMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the GoalUpdate builder.
@@ -65,12 +72,85 @@ func (_u *GoalUpdate) ClearDeadline() *GoalUpdate {
 	return _u
 }
 
+// SetHabitDays sets the "habit_days" field.
+func (_u *GoalUpdate) SetHabitDays(v []string) *GoalUpdate {
+	_u.mutation.SetHabitDays(v)
+	return _u
+}
+
+// AppendHabitDays appends value to the "habit_days" field.
+func (_u *GoalUpdate) AppendHabitDays(v []string) *GoalUpdate {
+	_u.mutation.AppendHabitDays(v)
+	return _u
+}
+
+// ClearHabitDays clears the value of the "habit_days" field.
+func (_u *GoalUpdate) ClearHabitDays() *GoalUpdate {
+	_u.mutation.ClearHabitDays()
+	return _u
+}
+
+// SetPinned sets the "pinned" field.
+func (_u *GoalUpdate) SetPinned(v bool) *GoalUpdate {
+	_u.mutation.SetPinned(v)
+	return _u
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillablePinned(v *bool) *GoalUpdate {
+	if v != nil {
+		_u.SetPinned(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *GoalUpdate) SetPosition(v int) *GoalUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillablePosition(v *int) *GoalUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *GoalUpdate) AddPosition(v int) *GoalUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *GoalUpdate) SetUpdatedAt(v time.Time) *GoalUpdate {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (_u *GoalUpdate) SetSearchVector(v types.TSVector) *GoalUpdate {
+	_u.mutation.SetSearchVector(v)
+	return _u
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableSearchVector(v *types.TSVector) *GoalUpdate {
+	if v != nil {
+		_u.SetSearchVector(*v)
+	}
+	return _u
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (_u *GoalUpdate) ClearSearchVector() *GoalUpdate {
+	_u.mutation.ClearSearchVector()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *GoalUpdate) SetUserID(id uuid.UUID) *GoalUpdate {
 	_u.mutation.SetUserID(id)
@@ -97,6 +177,66 @@ func (_u *GoalUpdate) AddPosts(v ...*Post) *GoalUpdate {
 	return _u.AddPostIDs(ids...)
 }
 
+// AddParticipantIDs adds the "participants" edge to the GoalParticipant entity by IDs.
+func (_u *GoalUpdate) AddParticipantIDs(ids ...uuid.UUID) *GoalUpdate {
+	_u.mutation.AddParticipantIDs(ids...)
+	return _u
+}
+
+// AddParticipants adds the "participants" edges to the GoalParticipant entity.
+func (_u *GoalUpdate) AddParticipants(v ...*GoalParticipant) *GoalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipantIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (_u *GoalUpdate) AddMilestoneIDs(ids ...uuid.UUID) *GoalUpdate {
+	_u.mutation.AddMilestoneIDs(ids...)
+	return _u
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (_u *GoalUpdate) AddMilestones(v ...*Milestone) *GoalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMilestoneIDs(ids...)
+}
+
+// AddReminderLogIDs adds the "reminder_logs" edge to the ReminderLog entity by IDs.
+func (_u *GoalUpdate) AddReminderLogIDs(ids ...uuid.UUID) *GoalUpdate {
+	_u.mutation.AddReminderLogIDs(ids...)
+	return _u
+}
+
+// AddReminderLogs adds the "reminder_logs" edges to the ReminderLog entity.
+func (_u *GoalUpdate) AddReminderLogs(v ...*ReminderLog) *GoalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderLogIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *GoalUpdate) AddNotificationIDs(ids ...uuid.UUID) *GoalUpdate {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *GoalUpdate) AddNotifications(v ...*Notification) *GoalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the GoalMutation object of the builder.
 func (_u *GoalUpdate) Mutation() *GoalMutation {
 	return _u.mutation
@@ -129,9 +269,95 @@ func (_u *GoalUpdate) RemovePosts(v ...*Post) *GoalUpdate {
 	return _u.RemovePostIDs(ids...)
 }
 
+// ClearParticipants clears all "participants" edges to the GoalParticipant entity.
+func (_u *GoalUpdate) ClearParticipants() *GoalUpdate {
+	_u.mutation.ClearParticipants()
+	return _u
+}
+
+// RemoveParticipantIDs removes the "participants" edge to GoalParticipant entities by IDs.
+func (_u *GoalUpdate) RemoveParticipantIDs(ids ...uuid.UUID) *GoalUpdate {
+	_u.mutation.RemoveParticipantIDs(ids...)
+	return _u
+}
+
+// RemoveParticipants removes "participants" edges to GoalParticipant entities.
+func (_u *GoalUpdate) RemoveParticipants(v ...*GoalParticipant) *GoalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipantIDs(ids...)
+}
+
+// ClearMilestones clears all "milestones" edges to the Milestone entity.
+func (_u *GoalUpdate) ClearMilestones() *GoalUpdate {
+	_u.mutation.ClearMilestones()
+	return _u
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to Milestone entities by IDs.
+func (_u *GoalUpdate) RemoveMilestoneIDs(ids ...uuid.UUID) *GoalUpdate {
+	_u.mutation.RemoveMilestoneIDs(ids...)
+	return _u
+}
+
+// RemoveMilestones removes "milestones" edges to Milestone entities.
+func (_u *GoalUpdate) RemoveMilestones(v ...*Milestone) *GoalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMilestoneIDs(ids...)
+}
+
+// ClearReminderLogs clears all "reminder_logs" edges to the ReminderLog entity.
+func (_u *GoalUpdate) ClearReminderLogs() *GoalUpdate {
+	_u.mutation.ClearReminderLogs()
+	return _u
+}
+
+// RemoveReminderLogIDs removes the "reminder_logs" edge to ReminderLog entities by IDs.
+func (_u *GoalUpdate) RemoveReminderLogIDs(ids ...uuid.UUID) *GoalUpdate {
+	_u.mutation.RemoveReminderLogIDs(ids...)
+	return _u
+}
+
+// RemoveReminderLogs removes "reminder_logs" edges to ReminderLog entities.
+func (_u *GoalUpdate) RemoveReminderLogs(v ...*ReminderLog) *GoalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReminderLogIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *GoalUpdate) ClearNotifications() *GoalUpdate {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *GoalUpdate) RemoveNotificationIDs(ids ...uuid.UUID) *GoalUpdate {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *GoalUpdate) RemoveNotifications(v ...*Notification) *GoalUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *GoalUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -158,11 +384,15 @@ func (_u *GoalUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *GoalUpdate) defaults() {
+func (_u *GoalUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if goal.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized goal.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := goal.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -178,6 +408,12 @@ func (_u *GoalUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GoalUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GoalUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *GoalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -199,9 +435,35 @@ func (_u *GoalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DeadlineCleared() {
 		_spec.ClearField(goal.FieldDeadline, field.TypeTime)
 	}
+	if value, ok := _u.mutation.HabitDays(); ok {
+		_spec.SetField(goal.FieldHabitDays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHabitDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goal.FieldHabitDays, value)
+		})
+	}
+	if _u.mutation.HabitDaysCleared() {
+		_spec.ClearField(goal.FieldHabitDays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Pinned(); ok {
+		_spec.SetField(goal.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(goal.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(goal.FieldPosition, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.SearchVector(); ok {
+		_spec.SetField(goal.FieldSearchVector, field.TypeOther, value)
+	}
+	if _u.mutation.SearchVectorCleared() {
+		_spec.ClearField(goal.FieldSearchVector, field.TypeOther)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -276,6 +538,187 @@ func (_u *GoalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ParticipantsTable,
+			Columns: []string{goal.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goalparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !_u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ParticipantsTable,
+			Columns: []string{goal.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goalparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ParticipantsTable,
+			Columns: []string{goal.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goalparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.MilestonesTable,
+			Columns: []string{goal.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMilestonesIDs(); len(nodes) > 0 && !_u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.MilestonesTable,
+			Columns: []string{goal.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.MilestonesTable,
+			Columns: []string{goal.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReminderLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ReminderLogsTable,
+			Columns: []string{goal.ReminderLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReminderLogsIDs(); len(nodes) > 0 && !_u.mutation.ReminderLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ReminderLogsTable,
+			Columns: []string{goal.ReminderLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReminderLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ReminderLogsTable,
+			Columns: []string{goal.ReminderLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.NotificationsTable,
+			Columns: []string{goal.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.NotificationsTable,
+			Columns: []string{goal.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.NotificationsTable,
+			Columns: []string{goal.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{goal.Label}
@@ -291,9 +734,10 @@ func (_u *GoalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // GoalUpdateOne is the builder for updating a single Goal entity.
 type GoalUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *GoalMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *GoalMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetTitle sets the "title" field.
@@ -302,31 +746,84 @@ func (_u *GoalUpdateOne) SetTitle(v string) *GoalUpdateOne {
 	return _u
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (_u *GoalUpdateOne) SetNillableTitle(v *string) *GoalUpdateOne {
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableTitle(v *string) *GoalUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDeadline sets the "deadline" field.
+func (_u *GoalUpdateOne) SetDeadline(v time.Time) *GoalUpdateOne {
+	_u.mutation.SetDeadline(v)
+	return _u
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableDeadline(v *time.Time) *GoalUpdateOne {
+	if v != nil {
+		_u.SetDeadline(*v)
+	}
+	return _u
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (_u *GoalUpdateOne) ClearDeadline() *GoalUpdateOne {
+	_u.mutation.ClearDeadline()
+	return _u
+}
+
+// SetHabitDays sets the "habit_days" field.
+func (_u *GoalUpdateOne) SetHabitDays(v []string) *GoalUpdateOne {
+	_u.mutation.SetHabitDays(v)
+	return _u
+}
+
+// AppendHabitDays appends value to the "habit_days" field.
+func (_u *GoalUpdateOne) AppendHabitDays(v []string) *GoalUpdateOne {
+	_u.mutation.AppendHabitDays(v)
+	return _u
+}
+
+// ClearHabitDays clears the value of the "habit_days" field.
+func (_u *GoalUpdateOne) ClearHabitDays() *GoalUpdateOne {
+	_u.mutation.ClearHabitDays()
+	return _u
+}
+
+// SetPinned sets the "pinned" field.
+func (_u *GoalUpdateOne) SetPinned(v bool) *GoalUpdateOne {
+	_u.mutation.SetPinned(v)
+	return _u
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillablePinned(v *bool) *GoalUpdateOne {
 	if v != nil {
-		_u.SetTitle(*v)
+		_u.SetPinned(*v)
 	}
 	return _u
 }
 
-// SetDeadline sets the "deadline" field.
-func (_u *GoalUpdateOne) SetDeadline(v time.Time) *GoalUpdateOne {
-	_u.mutation.SetDeadline(v)
+// SetPosition sets the "position" field.
+func (_u *GoalUpdateOne) SetPosition(v int) *GoalUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
 	return _u
 }
 
-// SetNillableDeadline sets the "deadline" field if the given value is not nil.
-func (_u *GoalUpdateOne) SetNillableDeadline(v *time.Time) *GoalUpdateOne {
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillablePosition(v *int) *GoalUpdateOne {
 	if v != nil {
-		_u.SetDeadline(*v)
+		_u.SetPosition(*v)
 	}
 	return _u
 }
 
-// ClearDeadline clears the value of the "deadline" field.
-func (_u *GoalUpdateOne) ClearDeadline() *GoalUpdateOne {
-	_u.mutation.ClearDeadline()
+// AddPosition adds value to the "position" field.
+func (_u *GoalUpdateOne) AddPosition(v int) *GoalUpdateOne {
+	_u.mutation.AddPosition(v)
 	return _u
 }
 
@@ -336,6 +833,26 @@ func (_u *GoalUpdateOne) SetUpdatedAt(v time.Time) *GoalUpdateOne {
 	return _u
 }
 
+// SetSearchVector sets the "search_vector" field.
+func (_u *GoalUpdateOne) SetSearchVector(v types.TSVector) *GoalUpdateOne {
+	_u.mutation.SetSearchVector(v)
+	return _u
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableSearchVector(v *types.TSVector) *GoalUpdateOne {
+	if v != nil {
+		_u.SetSearchVector(*v)
+	}
+	return _u
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (_u *GoalUpdateOne) ClearSearchVector() *GoalUpdateOne {
+	_u.mutation.ClearSearchVector()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *GoalUpdateOne) SetUserID(id uuid.UUID) *GoalUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -362,6 +879,66 @@ func (_u *GoalUpdateOne) AddPosts(v ...*Post) *GoalUpdateOne {
 	return _u.AddPostIDs(ids...)
 }
 
+// AddParticipantIDs adds the "participants" edge to the GoalParticipant entity by IDs.
+func (_u *GoalUpdateOne) AddParticipantIDs(ids ...uuid.UUID) *GoalUpdateOne {
+	_u.mutation.AddParticipantIDs(ids...)
+	return _u
+}
+
+// AddParticipants adds the "participants" edges to the GoalParticipant entity.
+func (_u *GoalUpdateOne) AddParticipants(v ...*GoalParticipant) *GoalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipantIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (_u *GoalUpdateOne) AddMilestoneIDs(ids ...uuid.UUID) *GoalUpdateOne {
+	_u.mutation.AddMilestoneIDs(ids...)
+	return _u
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (_u *GoalUpdateOne) AddMilestones(v ...*Milestone) *GoalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMilestoneIDs(ids...)
+}
+
+// AddReminderLogIDs adds the "reminder_logs" edge to the ReminderLog entity by IDs.
+func (_u *GoalUpdateOne) AddReminderLogIDs(ids ...uuid.UUID) *GoalUpdateOne {
+	_u.mutation.AddReminderLogIDs(ids...)
+	return _u
+}
+
+// AddReminderLogs adds the "reminder_logs" edges to the ReminderLog entity.
+func (_u *GoalUpdateOne) AddReminderLogs(v ...*ReminderLog) *GoalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderLogIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *GoalUpdateOne) AddNotificationIDs(ids ...uuid.UUID) *GoalUpdateOne {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *GoalUpdateOne) AddNotifications(v ...*Notification) *GoalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the GoalMutation object of the builder.
 func (_u *GoalUpdateOne) Mutation() *GoalMutation {
 	return _u.mutation
@@ -394,6 +971,90 @@ func (_u *GoalUpdateOne) RemovePosts(v ...*Post) *GoalUpdateOne {
 	return _u.RemovePostIDs(ids...)
 }
 
+// ClearParticipants clears all "participants" edges to the GoalParticipant entity.
+func (_u *GoalUpdateOne) ClearParticipants() *GoalUpdateOne {
+	_u.mutation.ClearParticipants()
+	return _u
+}
+
+// RemoveParticipantIDs removes the "participants" edge to GoalParticipant entities by IDs.
+func (_u *GoalUpdateOne) RemoveParticipantIDs(ids ...uuid.UUID) *GoalUpdateOne {
+	_u.mutation.RemoveParticipantIDs(ids...)
+	return _u
+}
+
+// RemoveParticipants removes "participants" edges to GoalParticipant entities.
+func (_u *GoalUpdateOne) RemoveParticipants(v ...*GoalParticipant) *GoalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipantIDs(ids...)
+}
+
+// ClearMilestones clears all "milestones" edges to the Milestone entity.
+func (_u *GoalUpdateOne) ClearMilestones() *GoalUpdateOne {
+	_u.mutation.ClearMilestones()
+	return _u
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to Milestone entities by IDs.
+func (_u *GoalUpdateOne) RemoveMilestoneIDs(ids ...uuid.UUID) *GoalUpdateOne {
+	_u.mutation.RemoveMilestoneIDs(ids...)
+	return _u
+}
+
+// RemoveMilestones removes "milestones" edges to Milestone entities.
+func (_u *GoalUpdateOne) RemoveMilestones(v ...*Milestone) *GoalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMilestoneIDs(ids...)
+}
+
+// ClearReminderLogs clears all "reminder_logs" edges to the ReminderLog entity.
+func (_u *GoalUpdateOne) ClearReminderLogs() *GoalUpdateOne {
+	_u.mutation.ClearReminderLogs()
+	return _u
+}
+
+// RemoveReminderLogIDs removes the "reminder_logs" edge to ReminderLog entities by IDs.
+func (_u *GoalUpdateOne) RemoveReminderLogIDs(ids ...uuid.UUID) *GoalUpdateOne {
+	_u.mutation.RemoveReminderLogIDs(ids...)
+	return _u
+}
+
+// RemoveReminderLogs removes "reminder_logs" edges to ReminderLog entities.
+func (_u *GoalUpdateOne) RemoveReminderLogs(v ...*ReminderLog) *GoalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReminderLogIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *GoalUpdateOne) ClearNotifications() *GoalUpdateOne {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *GoalUpdateOne) RemoveNotificationIDs(ids ...uuid.UUID) *GoalUpdateOne {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *GoalUpdateOne) RemoveNotifications(v ...*Notification) *GoalUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
 // Where appends a list predicates to the GoalUpdate builder.
 func (_u *GoalUpdateOne) Where(ps ...predicate.Goal) *GoalUpdateOne {
 	_u.mutation.Where(ps...)
@@ -409,7 +1070,9 @@ func (_u *GoalUpdateOne) Select(field string, fields ...string) *GoalUpdateOne {
 
 // Save executes the query and returns the updated Goal entity.
 func (_u *GoalUpdateOne) Save(ctx context.Context) (*Goal, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -436,11 +1099,15 @@ func (_u *GoalUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *GoalUpdateOne) defaults() {
+func (_u *GoalUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if goal.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized goal.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := goal.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -456,6 +1123,12 @@ func (_u *GoalUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GoalUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GoalUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *GoalUpdateOne) sqlSave(ctx context.Context) (_node *Goal, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -494,9 +1167,35 @@ func (_u *GoalUpdateOne) sqlSave(ctx context.Context) (_node *Goal, err error) {
 	if _u.mutation.DeadlineCleared() {
 		_spec.ClearField(goal.FieldDeadline, field.TypeTime)
 	}
+	if value, ok := _u.mutation.HabitDays(); ok {
+		_spec.SetField(goal.FieldHabitDays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHabitDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goal.FieldHabitDays, value)
+		})
+	}
+	if _u.mutation.HabitDaysCleared() {
+		_spec.ClearField(goal.FieldHabitDays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Pinned(); ok {
+		_spec.SetField(goal.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(goal.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(goal.FieldPosition, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.SearchVector(); ok {
+		_spec.SetField(goal.FieldSearchVector, field.TypeOther, value)
+	}
+	if _u.mutation.SearchVectorCleared() {
+		_spec.ClearField(goal.FieldSearchVector, field.TypeOther)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -571,6 +1270,187 @@ func (_u *GoalUpdateOne) sqlSave(ctx context.Context) (_node *Goal, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ParticipantsTable,
+			Columns: []string{goal.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goalparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !_u.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ParticipantsTable,
+			Columns: []string{goal.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goalparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ParticipantsTable,
+			Columns: []string{goal.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goalparticipant.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.MilestonesTable,
+			Columns: []string{goal.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMilestonesIDs(); len(nodes) > 0 && !_u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.MilestonesTable,
+			Columns: []string{goal.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.MilestonesTable,
+			Columns: []string{goal.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReminderLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ReminderLogsTable,
+			Columns: []string{goal.ReminderLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReminderLogsIDs(); len(nodes) > 0 && !_u.mutation.ReminderLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ReminderLogsTable,
+			Columns: []string{goal.ReminderLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReminderLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.ReminderLogsTable,
+			Columns: []string{goal.ReminderLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.NotificationsTable,
+			Columns: []string{goal.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.NotificationsTable,
+			Columns: []string{goal.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   goal.NotificationsTable,
+			Columns: []string{goal.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Goal{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
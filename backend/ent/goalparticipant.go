@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// GoalParticipant is the model entity for the GoalParticipant schema.
+type GoalParticipant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role goalparticipant.Role `json:"role,omitempty"`
+	// Status holds the value of the "status" field.
+	Status goalparticipant.Status `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// JoinedAt holds the value of the "joined_at" field.
+	JoinedAt *time.Time `json:"joined_at,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GoalParticipantQuery when eager-loading is set.
+	Edges                    GoalParticipantEdges `json:"edges"`
+	goal_participants        *uuid.UUID
+	user_goal_participations *uuid.UUID
+	selectValues             sql.SelectValues
+}
+
+// GoalParticipantEdges holds the relations/edges for other nodes in the graph.
+type GoalParticipantEdges struct {
+	// Goal holds the value of the goal edge.
+	Goal *Goal `json:"goal,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GoalOrErr returns the Goal value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoalParticipantEdges) GoalOrErr() (*Goal, error) {
+	if e.Goal != nil {
+		return e.Goal, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: goal.Label}
+	}
+	return nil, &NotLoadedError{edge: "goal"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoalParticipantEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GoalParticipant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goalparticipant.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case goalparticipant.FieldRole, goalparticipant.FieldStatus:
+			values[i] = new(sql.NullString)
+		case goalparticipant.FieldCreatedAt, goalparticipant.FieldJoinedAt:
+			values[i] = new(sql.NullTime)
+		case goalparticipant.FieldID:
+			values[i] = new(uuid.UUID)
+		case goalparticipant.ForeignKeys[0]: // goal_participants
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case goalparticipant.ForeignKeys[1]: // user_goal_participations
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GoalParticipant fields.
+func (_m *GoalParticipant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goalparticipant.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case goalparticipant.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = goalparticipant.Role(value.String)
+			}
+		case goalparticipant.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = goalparticipant.Status(value.String)
+			}
+		case goalparticipant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case goalparticipant.FieldJoinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
+			} else if value.Valid {
+				_m.JoinedAt = new(time.Time)
+				*_m.JoinedAt = value.Time
+			}
+		case goalparticipant.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case goalparticipant.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field goal_participants", values[i])
+			} else if value.Valid {
+				_m.goal_participants = new(uuid.UUID)
+				*_m.goal_participants = *value.S.(*uuid.UUID)
+			}
+		case goalparticipant.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_goal_participations", values[i])
+			} else if value.Valid {
+				_m.user_goal_participations = new(uuid.UUID)
+				*_m.user_goal_participations = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GoalParticipant.
+// This includes values selected through modifiers, order, etc.
+func (_m *GoalParticipant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryGoal queries the "goal" edge of the GoalParticipant entity.
+func (_m *GoalParticipant) QueryGoal() *GoalQuery {
+	return NewGoalParticipantClient(_m.config).QueryGoal(_m)
+}
+
+// QueryUser queries the "user" edge of the GoalParticipant entity.
+func (_m *GoalParticipant) QueryUser() *UserQuery {
+	return NewGoalParticipantClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this GoalParticipant.
+// Note that you need to call GoalParticipant.Unwrap() before calling this method if this GoalParticipant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GoalParticipant) Update() *GoalParticipantUpdateOne {
+	return NewGoalParticipantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GoalParticipant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GoalParticipant) Unwrap() *GoalParticipant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GoalParticipant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GoalParticipant) String() string {
+	var builder strings.Builder
+	builder.WriteString("GoalParticipant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.JoinedAt; v != nil {
+		builder.WriteString("joined_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GoalParticipants is a parsable slice of GoalParticipant.
+type GoalParticipants []*GoalParticipant
@@ -0,0 +1,854 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GoalParticipantCreate is the builder for creating a GoalParticipant entity.
+type GoalParticipantCreate struct {
+	config
+	mutation *GoalParticipantMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRole sets the "role" field.
+func (_c *GoalParticipantCreate) SetRole(v goalparticipant.Role) *GoalParticipantCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *GoalParticipantCreate) SetNillableRole(v *goalparticipant.Role) *GoalParticipantCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *GoalParticipantCreate) SetStatus(v goalparticipant.Status) *GoalParticipantCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *GoalParticipantCreate) SetNillableStatus(v *goalparticipant.Status) *GoalParticipantCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GoalParticipantCreate) SetCreatedAt(v time.Time) *GoalParticipantCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GoalParticipantCreate) SetNillableCreatedAt(v *time.Time) *GoalParticipantCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_c *GoalParticipantCreate) SetJoinedAt(v time.Time) *GoalParticipantCreate {
+	_c.mutation.SetJoinedAt(v)
+	return _c
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_c *GoalParticipantCreate) SetNillableJoinedAt(v *time.Time) *GoalParticipantCreate {
+	if v != nil {
+		_c.SetJoinedAt(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *GoalParticipantCreate) SetPosition(v int) *GoalParticipantCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *GoalParticipantCreate) SetNillablePosition(v *int) *GoalParticipantCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GoalParticipantCreate) SetID(v uuid.UUID) *GoalParticipantCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GoalParticipantCreate) SetNillableID(v *uuid.UUID) *GoalParticipantCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by ID.
+func (_c *GoalParticipantCreate) SetGoalID(id uuid.UUID) *GoalParticipantCreate {
+	_c.mutation.SetGoalID(id)
+	return _c
+}
+
+// SetGoal sets the "goal" edge to the Goal entity.
+func (_c *GoalParticipantCreate) SetGoal(v *Goal) *GoalParticipantCreate {
+	return _c.SetGoalID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *GoalParticipantCreate) SetUserID(id uuid.UUID) *GoalParticipantCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *GoalParticipantCreate) SetUser(v *User) *GoalParticipantCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the GoalParticipantMutation object of the builder.
+func (_c *GoalParticipantCreate) Mutation() *GoalParticipantMutation {
+	return _c.mutation
+}
+
+// Save creates the GoalParticipant in the database.
+func (_c *GoalParticipantCreate) Save(ctx context.Context) (*GoalParticipant, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoalParticipantCreate) SaveX(ctx context.Context) *GoalParticipant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoalParticipantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoalParticipantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GoalParticipantCreate) defaults() {
+	if _, ok := _c.mutation.Role(); !ok {
+		v := goalparticipant.DefaultRole
+		_c.mutation.SetRole(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := goalparticipant.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := goalparticipant.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := goalparticipant.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := goalparticipant.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoalParticipantCreate) check() error {
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "GoalParticipant.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := goalparticipant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "GoalParticipant.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "GoalParticipant.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := goalparticipant.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GoalParticipant.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GoalParticipant.created_at"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "GoalParticipant.position"`)}
+	}
+	if len(_c.mutation.GoalIDs()) == 0 {
+		return &ValidationError{Name: "goal", err: errors.New(`ent: missing required edge "GoalParticipant.goal"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "GoalParticipant.user"`)}
+	}
+	return nil
+}
+
+func (_c *GoalParticipantCreate) sqlSave(ctx context.Context) (*GoalParticipant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoalParticipantCreate) createSpec() (*GoalParticipant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GoalParticipant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goalparticipant.Table, sqlgraph.NewFieldSpec(goalparticipant.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(goalparticipant.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(goalparticipant.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(goalparticipant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.JoinedAt(); ok {
+		_spec.SetField(goalparticipant.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = &value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(goalparticipant.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := _c.mutation.GoalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goalparticipant.GoalTable,
+			Columns: []string{goalparticipant.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.goal_participants = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goalparticipant.UserTable,
+			Columns: []string{goalparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_goal_participations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoalParticipant.Create().
+//		SetRole(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalParticipantUpsert) {
+//			SetRole(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalParticipantCreate) OnConflict(opts ...sql.ConflictOption) *GoalParticipantUpsertOne {
+	_c.conflict = opts
+	return &GoalParticipantUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoalParticipant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalParticipantCreate) OnConflictColumns(columns ...string) *GoalParticipantUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalParticipantUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GoalParticipantUpsertOne is the builder for "upsert"-ing
+	//  one GoalParticipant node.
+	GoalParticipantUpsertOne struct {
+		create *GoalParticipantCreate
+	}
+
+	// GoalParticipantUpsert is the "OnConflict" setter.
+	GoalParticipantUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRole sets the "role" field.
+func (u *GoalParticipantUpsert) SetRole(v goalparticipant.Role) *GoalParticipantUpsert {
+	u.Set(goalparticipant.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *GoalParticipantUpsert) UpdateRole() *GoalParticipantUpsert {
+	u.SetExcluded(goalparticipant.FieldRole)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *GoalParticipantUpsert) SetStatus(v goalparticipant.Status) *GoalParticipantUpsert {
+	u.Set(goalparticipant.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoalParticipantUpsert) UpdateStatus() *GoalParticipantUpsert {
+	u.SetExcluded(goalparticipant.FieldStatus)
+	return u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *GoalParticipantUpsert) SetJoinedAt(v time.Time) *GoalParticipantUpsert {
+	u.Set(goalparticipant.FieldJoinedAt, v)
+	return u
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *GoalParticipantUpsert) UpdateJoinedAt() *GoalParticipantUpsert {
+	u.SetExcluded(goalparticipant.FieldJoinedAt)
+	return u
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *GoalParticipantUpsert) ClearJoinedAt() *GoalParticipantUpsert {
+	u.SetNull(goalparticipant.FieldJoinedAt)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *GoalParticipantUpsert) SetPosition(v int) *GoalParticipantUpsert {
+	u.Set(goalparticipant.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *GoalParticipantUpsert) UpdatePosition() *GoalParticipantUpsert {
+	u.SetExcluded(goalparticipant.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *GoalParticipantUpsert) AddPosition(v int) *GoalParticipantUpsert {
+	u.Add(goalparticipant.FieldPosition, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GoalParticipant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goalparticipant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalParticipantUpsertOne) UpdateNewValues() *GoalParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(goalparticipant.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(goalparticipant.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoalParticipant.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GoalParticipantUpsertOne) Ignore() *GoalParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalParticipantUpsertOne) DoNothing() *GoalParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalParticipantCreate.OnConflict
+// documentation for more info.
+func (u *GoalParticipantUpsertOne) Update(set func(*GoalParticipantUpsert)) *GoalParticipantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalParticipantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *GoalParticipantUpsertOne) SetRole(v goalparticipant.Role) *GoalParticipantUpsertOne {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *GoalParticipantUpsertOne) UpdateRole() *GoalParticipantUpsertOne {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GoalParticipantUpsertOne) SetStatus(v goalparticipant.Status) *GoalParticipantUpsertOne {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoalParticipantUpsertOne) UpdateStatus() *GoalParticipantUpsertOne {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *GoalParticipantUpsertOne) SetJoinedAt(v time.Time) *GoalParticipantUpsertOne {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.SetJoinedAt(v)
+	})
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *GoalParticipantUpsertOne) UpdateJoinedAt() *GoalParticipantUpsertOne {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.UpdateJoinedAt()
+	})
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *GoalParticipantUpsertOne) ClearJoinedAt() *GoalParticipantUpsertOne {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.ClearJoinedAt()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *GoalParticipantUpsertOne) SetPosition(v int) *GoalParticipantUpsertOne {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *GoalParticipantUpsertOne) AddPosition(v int) *GoalParticipantUpsertOne {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *GoalParticipantUpsertOne) UpdatePosition() *GoalParticipantUpsertOne {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalParticipantUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalParticipantCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalParticipantUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GoalParticipantUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GoalParticipantUpsertOne.ID is not supported by MySQL driver. Use GoalParticipantUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GoalParticipantUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GoalParticipantCreateBulk is the builder for creating many GoalParticipant entities in bulk.
+type GoalParticipantCreateBulk struct {
+	config
+	err      error
+	builders []*GoalParticipantCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GoalParticipant entities in the database.
+func (_c *GoalParticipantCreateBulk) Save(ctx context.Context) ([]*GoalParticipant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GoalParticipant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoalParticipantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoalParticipantCreateBulk) SaveX(ctx context.Context) []*GoalParticipant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoalParticipantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoalParticipantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoalParticipant.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalParticipantUpsert) {
+//			SetRole(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalParticipantCreateBulk) OnConflict(opts ...sql.ConflictOption) *GoalParticipantUpsertBulk {
+	_c.conflict = opts
+	return &GoalParticipantUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoalParticipant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalParticipantCreateBulk) OnConflictColumns(columns ...string) *GoalParticipantUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalParticipantUpsertBulk{
+		create: _c,
+	}
+}
+
+// GoalParticipantUpsertBulk is the builder for "upsert"-ing
+// a bulk of GoalParticipant nodes.
+type GoalParticipantUpsertBulk struct {
+	create *GoalParticipantCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GoalParticipant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goalparticipant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalParticipantUpsertBulk) UpdateNewValues() *GoalParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(goalparticipant.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(goalparticipant.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoalParticipant.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GoalParticipantUpsertBulk) Ignore() *GoalParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalParticipantUpsertBulk) DoNothing() *GoalParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalParticipantCreateBulk.OnConflict
+// documentation for more info.
+func (u *GoalParticipantUpsertBulk) Update(set func(*GoalParticipantUpsert)) *GoalParticipantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalParticipantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *GoalParticipantUpsertBulk) SetRole(v goalparticipant.Role) *GoalParticipantUpsertBulk {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *GoalParticipantUpsertBulk) UpdateRole() *GoalParticipantUpsertBulk {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GoalParticipantUpsertBulk) SetStatus(v goalparticipant.Status) *GoalParticipantUpsertBulk {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoalParticipantUpsertBulk) UpdateStatus() *GoalParticipantUpsertBulk {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *GoalParticipantUpsertBulk) SetJoinedAt(v time.Time) *GoalParticipantUpsertBulk {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.SetJoinedAt(v)
+	})
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *GoalParticipantUpsertBulk) UpdateJoinedAt() *GoalParticipantUpsertBulk {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.UpdateJoinedAt()
+	})
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *GoalParticipantUpsertBulk) ClearJoinedAt() *GoalParticipantUpsertBulk {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.ClearJoinedAt()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *GoalParticipantUpsertBulk) SetPosition(v int) *GoalParticipantUpsertBulk {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *GoalParticipantUpsertBulk) AddPosition(v int) *GoalParticipantUpsertBulk {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *GoalParticipantUpsertBulk) UpdatePosition() *GoalParticipantUpsertBulk {
+	return u.Update(func(s *GoalParticipantUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalParticipantUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GoalParticipantCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalParticipantCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalParticipantUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
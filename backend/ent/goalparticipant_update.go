@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/predicate"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GoalParticipantUpdate is the builder for updating GoalParticipant entities.
+type GoalParticipantUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *GoalParticipantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the GoalParticipantUpdate builder.
+func (_u *GoalParticipantUpdate) Where(ps ...predicate.GoalParticipant) *GoalParticipantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *GoalParticipantUpdate) SetRole(v goalparticipant.Role) *GoalParticipantUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *GoalParticipantUpdate) SetNillableRole(v *goalparticipant.Role) *GoalParticipantUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GoalParticipantUpdate) SetStatus(v goalparticipant.Status) *GoalParticipantUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoalParticipantUpdate) SetNillableStatus(v *goalparticipant.Status) *GoalParticipantUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_u *GoalParticipantUpdate) SetJoinedAt(v time.Time) *GoalParticipantUpdate {
+	_u.mutation.SetJoinedAt(v)
+	return _u
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_u *GoalParticipantUpdate) SetNillableJoinedAt(v *time.Time) *GoalParticipantUpdate {
+	if v != nil {
+		_u.SetJoinedAt(*v)
+	}
+	return _u
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (_u *GoalParticipantUpdate) ClearJoinedAt() *GoalParticipantUpdate {
+	_u.mutation.ClearJoinedAt()
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *GoalParticipantUpdate) SetPosition(v int) *GoalParticipantUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *GoalParticipantUpdate) SetNillablePosition(v *int) *GoalParticipantUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *GoalParticipantUpdate) AddPosition(v int) *GoalParticipantUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by ID.
+func (_u *GoalParticipantUpdate) SetGoalID(id uuid.UUID) *GoalParticipantUpdate {
+	_u.mutation.SetGoalID(id)
+	return _u
+}
+
+// SetGoal sets the "goal" edge to the Goal entity.
+func (_u *GoalParticipantUpdate) SetGoal(v *Goal) *GoalParticipantUpdate {
+	return _u.SetGoalID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *GoalParticipantUpdate) SetUserID(id uuid.UUID) *GoalParticipantUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *GoalParticipantUpdate) SetUser(v *User) *GoalParticipantUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the GoalParticipantMutation object of the builder.
+func (_u *GoalParticipantUpdate) Mutation() *GoalParticipantMutation {
+	return _u.mutation
+}
+
+// ClearGoal clears the "goal" edge to the Goal entity.
+func (_u *GoalParticipantUpdate) ClearGoal() *GoalParticipantUpdate {
+	_u.mutation.ClearGoal()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *GoalParticipantUpdate) ClearUser() *GoalParticipantUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoalParticipantUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoalParticipantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoalParticipantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoalParticipantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoalParticipantUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := goalparticipant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "GoalParticipant.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goalparticipant.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GoalParticipant.status": %w`, err)}
+		}
+	}
+	if _u.mutation.GoalCleared() && len(_u.mutation.GoalIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoalParticipant.goal"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoalParticipant.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GoalParticipantUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GoalParticipantUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *GoalParticipantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goalparticipant.Table, goalparticipant.Columns, sqlgraph.NewFieldSpec(goalparticipant.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(goalparticipant.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goalparticipant.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.JoinedAt(); ok {
+		_spec.SetField(goalparticipant.FieldJoinedAt, field.TypeTime, value)
+	}
+	if _u.mutation.JoinedAtCleared() {
+		_spec.ClearField(goalparticipant.FieldJoinedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(goalparticipant.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(goalparticipant.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.GoalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goalparticipant.GoalTable,
+			Columns: []string{goalparticipant.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goalparticipant.GoalTable,
+			Columns: []string{goalparticipant.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goalparticipant.UserTable,
+			Columns: []string{goalparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goalparticipant.UserTable,
+			Columns: []string{goalparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goalparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoalParticipantUpdateOne is the builder for updating a single GoalParticipant entity.
+type GoalParticipantUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *GoalParticipantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRole sets the "role" field.
+func (_u *GoalParticipantUpdateOne) SetRole(v goalparticipant.Role) *GoalParticipantUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *GoalParticipantUpdateOne) SetNillableRole(v *goalparticipant.Role) *GoalParticipantUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GoalParticipantUpdateOne) SetStatus(v goalparticipant.Status) *GoalParticipantUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoalParticipantUpdateOne) SetNillableStatus(v *goalparticipant.Status) *GoalParticipantUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_u *GoalParticipantUpdateOne) SetJoinedAt(v time.Time) *GoalParticipantUpdateOne {
+	_u.mutation.SetJoinedAt(v)
+	return _u
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_u *GoalParticipantUpdateOne) SetNillableJoinedAt(v *time.Time) *GoalParticipantUpdateOne {
+	if v != nil {
+		_u.SetJoinedAt(*v)
+	}
+	return _u
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (_u *GoalParticipantUpdateOne) ClearJoinedAt() *GoalParticipantUpdateOne {
+	_u.mutation.ClearJoinedAt()
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *GoalParticipantUpdateOne) SetPosition(v int) *GoalParticipantUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *GoalParticipantUpdateOne) SetNillablePosition(v *int) *GoalParticipantUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *GoalParticipantUpdateOne) AddPosition(v int) *GoalParticipantUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by ID.
+func (_u *GoalParticipantUpdateOne) SetGoalID(id uuid.UUID) *GoalParticipantUpdateOne {
+	_u.mutation.SetGoalID(id)
+	return _u
+}
+
+// SetGoal sets the "goal" edge to the Goal entity.
+func (_u *GoalParticipantUpdateOne) SetGoal(v *Goal) *GoalParticipantUpdateOne {
+	return _u.SetGoalID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *GoalParticipantUpdateOne) SetUserID(id uuid.UUID) *GoalParticipantUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *GoalParticipantUpdateOne) SetUser(v *User) *GoalParticipantUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the GoalParticipantMutation object of the builder.
+func (_u *GoalParticipantUpdateOne) Mutation() *GoalParticipantMutation {
+	return _u.mutation
+}
+
+// ClearGoal clears the "goal" edge to the Goal entity.
+func (_u *GoalParticipantUpdateOne) ClearGoal() *GoalParticipantUpdateOne {
+	_u.mutation.ClearGoal()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *GoalParticipantUpdateOne) ClearUser() *GoalParticipantUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the GoalParticipantUpdate builder.
+func (_u *GoalParticipantUpdateOne) Where(ps ...predicate.GoalParticipant) *GoalParticipantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoalParticipantUpdateOne) Select(field string, fields ...string) *GoalParticipantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GoalParticipant entity.
+func (_u *GoalParticipantUpdateOne) Save(ctx context.Context) (*GoalParticipant, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoalParticipantUpdateOne) SaveX(ctx context.Context) *GoalParticipant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoalParticipantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoalParticipantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoalParticipantUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := goalparticipant.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "GoalParticipant.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goalparticipant.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GoalParticipant.status": %w`, err)}
+		}
+	}
+	if _u.mutation.GoalCleared() && len(_u.mutation.GoalIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoalParticipant.goal"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoalParticipant.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GoalParticipantUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GoalParticipantUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *GoalParticipantUpdateOne) sqlSave(ctx context.Context) (_node *GoalParticipant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goalparticipant.Table, goalparticipant.Columns, sqlgraph.NewFieldSpec(goalparticipant.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GoalParticipant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goalparticipant.FieldID)
+		for _, f := range fields {
+			if !goalparticipant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != goalparticipant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(goalparticipant.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goalparticipant.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.JoinedAt(); ok {
+		_spec.SetField(goalparticipant.FieldJoinedAt, field.TypeTime, value)
+	}
+	if _u.mutation.JoinedAtCleared() {
+		_spec.ClearField(goalparticipant.FieldJoinedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(goalparticipant.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(goalparticipant.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.GoalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goalparticipant.GoalTable,
+			Columns: []string{goalparticipant.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goalparticipant.GoalTable,
+			Columns: []string{goalparticipant.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goalparticipant.UserTable,
+			Columns: []string{goalparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goalparticipant.UserTable,
+			Columns: []string{goalparticipant.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &GoalParticipant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goalparticipant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/genre"
+	"backend/ent/goaltemplate"
+	"backend/ent/schema/types"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// GoalTemplate is the model entity for the GoalTemplate schema.
+type GoalTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// SuggestedDurationDays holds the value of the "suggested_duration_days" field.
+	SuggestedDurationDays *int `json:"suggested_duration_days,omitempty"`
+	// Milestones holds the value of the "milestones" field.
+	Milestones []types.MilestoneTemplate `json:"milestones,omitempty"`
+	// HabitDays holds the value of the "habit_days" field.
+	HabitDays []string `json:"habit_days,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GoalTemplateQuery when eager-loading is set.
+	Edges           GoalTemplateEdges `json:"edges"`
+	genre_templates *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// GoalTemplateEdges holds the relations/edges for other nodes in the graph.
+type GoalTemplateEdges struct {
+	// Genre holds the value of the genre edge.
+	Genre *Genre `json:"genre,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GenreOrErr returns the Genre value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoalTemplateEdges) GenreOrErr() (*Genre, error) {
+	if e.Genre != nil {
+		return e.Genre, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: genre.Label}
+	}
+	return nil, &NotLoadedError{edge: "genre"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GoalTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goaltemplate.FieldMilestones, goaltemplate.FieldHabitDays:
+			values[i] = new([]byte)
+		case goaltemplate.FieldSuggestedDurationDays:
+			values[i] = new(sql.NullInt64)
+		case goaltemplate.FieldTitle, goaltemplate.FieldDescription:
+			values[i] = new(sql.NullString)
+		case goaltemplate.FieldCreatedAt, goaltemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case goaltemplate.FieldID:
+			values[i] = new(uuid.UUID)
+		case goaltemplate.ForeignKeys[0]: // genre_templates
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GoalTemplate fields.
+func (_m *GoalTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goaltemplate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case goaltemplate.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case goaltemplate.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case goaltemplate.FieldSuggestedDurationDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field suggested_duration_days", values[i])
+			} else if value.Valid {
+				_m.SuggestedDurationDays = new(int)
+				*_m.SuggestedDurationDays = int(value.Int64)
+			}
+		case goaltemplate.FieldMilestones:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field milestones", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Milestones); err != nil {
+					return fmt.Errorf("unmarshal field milestones: %w", err)
+				}
+			}
+		case goaltemplate.FieldHabitDays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field habit_days", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HabitDays); err != nil {
+					return fmt.Errorf("unmarshal field habit_days: %w", err)
+				}
+			}
+		case goaltemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case goaltemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case goaltemplate.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field genre_templates", values[i])
+			} else if value.Valid {
+				_m.genre_templates = new(uuid.UUID)
+				*_m.genre_templates = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GoalTemplate.
+// This includes values selected through modifiers, order, etc.
+func (_m *GoalTemplate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryGenre queries the "genre" edge of the GoalTemplate entity.
+func (_m *GoalTemplate) QueryGenre() *GenreQuery {
+	return NewGoalTemplateClient(_m.config).QueryGenre(_m)
+}
+
+// Update returns a builder for updating this GoalTemplate.
+// Note that you need to call GoalTemplate.Unwrap() before calling this method if this GoalTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GoalTemplate) Update() *GoalTemplateUpdateOne {
+	return NewGoalTemplateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GoalTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GoalTemplate) Unwrap() *GoalTemplate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GoalTemplate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GoalTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("GoalTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SuggestedDurationDays; v != nil {
+		builder.WriteString("suggested_duration_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("milestones=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Milestones))
+	builder.WriteString(", ")
+	builder.WriteString("habit_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HabitDays))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GoalTemplates is a parsable slice of GoalTemplate.
+type GoalTemplates []*GoalTemplate
@@ -0,0 +1,138 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goaltemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the goaltemplate type in the database.
+	Label = "goal_template"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldSuggestedDurationDays holds the string denoting the suggested_duration_days field in the database.
+	FieldSuggestedDurationDays = "suggested_duration_days"
+	// FieldMilestones holds the string denoting the milestones field in the database.
+	FieldMilestones = "milestones"
+	// FieldHabitDays holds the string denoting the habit_days field in the database.
+	FieldHabitDays = "habit_days"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeGenre holds the string denoting the genre edge name in mutations.
+	EdgeGenre = "genre"
+	// Table holds the table name of the goaltemplate in the database.
+	Table = "goal_templates"
+	// GenreTable is the table that holds the genre relation/edge.
+	GenreTable = "goal_templates"
+	// GenreInverseTable is the table name for the Genre entity.
+	// It exists in this package in order to avoid circular dependency with the "genre" package.
+	GenreInverseTable = "genres"
+	// GenreColumn is the table column denoting the genre relation/edge.
+	GenreColumn = "genre_templates"
+)
+
+// Columns holds all SQL columns for goaltemplate fields.
+var Columns = []string{
+	FieldID,
+	FieldTitle,
+	FieldDescription,
+	FieldSuggestedDurationDays,
+	FieldMilestones,
+	FieldHabitDays,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "goal_templates"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"genre_templates",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// SuggestedDurationDaysValidator is a validator for the "suggested_duration_days" field. It is called by the builders before save.
+	SuggestedDurationDaysValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the GoalTemplate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// BySuggestedDurationDays orders the results by the suggested_duration_days field.
+func BySuggestedDurationDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuggestedDurationDays, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByGenreField orders the results by genre field.
+func ByGenreField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGenreStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newGenreStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GenreInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GenreTable, GenreColumn),
+	)
+}
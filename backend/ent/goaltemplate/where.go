@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goaltemplate
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// SuggestedDurationDays applies equality check predicate on the "suggested_duration_days" field. It's identical to SuggestedDurationDaysEQ.
+func SuggestedDurationDays(v int) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldSuggestedDurationDays, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// SuggestedDurationDaysEQ applies the EQ predicate on the "suggested_duration_days" field.
+func SuggestedDurationDaysEQ(v int) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldSuggestedDurationDays, v))
+}
+
+// SuggestedDurationDaysNEQ applies the NEQ predicate on the "suggested_duration_days" field.
+func SuggestedDurationDaysNEQ(v int) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNEQ(FieldSuggestedDurationDays, v))
+}
+
+// SuggestedDurationDaysIn applies the In predicate on the "suggested_duration_days" field.
+func SuggestedDurationDaysIn(vs ...int) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldIn(FieldSuggestedDurationDays, vs...))
+}
+
+// SuggestedDurationDaysNotIn applies the NotIn predicate on the "suggested_duration_days" field.
+func SuggestedDurationDaysNotIn(vs ...int) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNotIn(FieldSuggestedDurationDays, vs...))
+}
+
+// SuggestedDurationDaysGT applies the GT predicate on the "suggested_duration_days" field.
+func SuggestedDurationDaysGT(v int) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGT(FieldSuggestedDurationDays, v))
+}
+
+// SuggestedDurationDaysGTE applies the GTE predicate on the "suggested_duration_days" field.
+func SuggestedDurationDaysGTE(v int) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGTE(FieldSuggestedDurationDays, v))
+}
+
+// SuggestedDurationDaysLT applies the LT predicate on the "suggested_duration_days" field.
+func SuggestedDurationDaysLT(v int) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLT(FieldSuggestedDurationDays, v))
+}
+
+// SuggestedDurationDaysLTE applies the LTE predicate on the "suggested_duration_days" field.
+func SuggestedDurationDaysLTE(v int) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLTE(FieldSuggestedDurationDays, v))
+}
+
+// SuggestedDurationDaysIsNil applies the IsNil predicate on the "suggested_duration_days" field.
+func SuggestedDurationDaysIsNil() predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldIsNull(FieldSuggestedDurationDays))
+}
+
+// SuggestedDurationDaysNotNil applies the NotNil predicate on the "suggested_duration_days" field.
+func SuggestedDurationDaysNotNil() predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNotNull(FieldSuggestedDurationDays))
+}
+
+// MilestonesIsNil applies the IsNil predicate on the "milestones" field.
+func MilestonesIsNil() predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldIsNull(FieldMilestones))
+}
+
+// MilestonesNotNil applies the NotNil predicate on the "milestones" field.
+func MilestonesNotNil() predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNotNull(FieldMilestones))
+}
+
+// HabitDaysIsNil applies the IsNil predicate on the "habit_days" field.
+func HabitDaysIsNil() predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldIsNull(FieldHabitDays))
+}
+
+// HabitDaysNotNil applies the NotNil predicate on the "habit_days" field.
+func HabitDaysNotNil() predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNotNull(FieldHabitDays))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasGenre applies the HasEdge predicate on the "genre" edge.
+func HasGenre() predicate.GoalTemplate {
+	return predicate.GoalTemplate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GenreTable, GenreColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGenreWith applies the HasEdge predicate on the "genre" edge with a given conditions (other predicates).
+func HasGenreWith(preds ...predicate.Genre) predicate.GoalTemplate {
+	return predicate.GoalTemplate(func(s *sql.Selector) {
+		step := newGenreStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GoalTemplate) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GoalTemplate) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GoalTemplate) predicate.GoalTemplate {
+	return predicate.GoalTemplate(sql.NotPredicates(p))
+}
@@ -0,0 +1,964 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/genre"
+	"backend/ent/goaltemplate"
+	"backend/ent/schema/types"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GoalTemplateCreate is the builder for creating a GoalTemplate entity.
+type GoalTemplateCreate struct {
+	config
+	mutation *GoalTemplateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTitle sets the "title" field.
+func (_c *GoalTemplateCreate) SetTitle(v string) *GoalTemplateCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *GoalTemplateCreate) SetDescription(v string) *GoalTemplateCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *GoalTemplateCreate) SetNillableDescription(v *string) *GoalTemplateCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSuggestedDurationDays sets the "suggested_duration_days" field.
+func (_c *GoalTemplateCreate) SetSuggestedDurationDays(v int) *GoalTemplateCreate {
+	_c.mutation.SetSuggestedDurationDays(v)
+	return _c
+}
+
+// SetNillableSuggestedDurationDays sets the "suggested_duration_days" field if the given value is not nil.
+func (_c *GoalTemplateCreate) SetNillableSuggestedDurationDays(v *int) *GoalTemplateCreate {
+	if v != nil {
+		_c.SetSuggestedDurationDays(*v)
+	}
+	return _c
+}
+
+// SetMilestones sets the "milestones" field.
+func (_c *GoalTemplateCreate) SetMilestones(v []types.MilestoneTemplate) *GoalTemplateCreate {
+	_c.mutation.SetMilestones(v)
+	return _c
+}
+
+// SetHabitDays sets the "habit_days" field.
+func (_c *GoalTemplateCreate) SetHabitDays(v []string) *GoalTemplateCreate {
+	_c.mutation.SetHabitDays(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GoalTemplateCreate) SetCreatedAt(v time.Time) *GoalTemplateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GoalTemplateCreate) SetNillableCreatedAt(v *time.Time) *GoalTemplateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GoalTemplateCreate) SetUpdatedAt(v time.Time) *GoalTemplateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GoalTemplateCreate) SetNillableUpdatedAt(v *time.Time) *GoalTemplateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GoalTemplateCreate) SetID(v uuid.UUID) *GoalTemplateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GoalTemplateCreate) SetNillableID(v *uuid.UUID) *GoalTemplateCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGenreID sets the "genre" edge to the Genre entity by ID.
+func (_c *GoalTemplateCreate) SetGenreID(id uuid.UUID) *GoalTemplateCreate {
+	_c.mutation.SetGenreID(id)
+	return _c
+}
+
+// SetGenre sets the "genre" edge to the Genre entity.
+func (_c *GoalTemplateCreate) SetGenre(v *Genre) *GoalTemplateCreate {
+	return _c.SetGenreID(v.ID)
+}
+
+// Mutation returns the GoalTemplateMutation object of the builder.
+func (_c *GoalTemplateCreate) Mutation() *GoalTemplateMutation {
+	return _c.mutation
+}
+
+// Save creates the GoalTemplate in the database.
+func (_c *GoalTemplateCreate) Save(ctx context.Context) (*GoalTemplate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoalTemplateCreate) SaveX(ctx context.Context) *GoalTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoalTemplateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoalTemplateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GoalTemplateCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := goaltemplate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := goaltemplate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := goaltemplate.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoalTemplateCreate) check() error {
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "GoalTemplate.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := goaltemplate.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "GoalTemplate.title": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SuggestedDurationDays(); ok {
+		if err := goaltemplate.SuggestedDurationDaysValidator(v); err != nil {
+			return &ValidationError{Name: "suggested_duration_days", err: fmt.Errorf(`ent: validator failed for field "GoalTemplate.suggested_duration_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GoalTemplate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GoalTemplate.updated_at"`)}
+	}
+	if len(_c.mutation.GenreIDs()) == 0 {
+		return &ValidationError{Name: "genre", err: errors.New(`ent: missing required edge "GoalTemplate.genre"`)}
+	}
+	return nil
+}
+
+func (_c *GoalTemplateCreate) sqlSave(ctx context.Context) (*GoalTemplate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoalTemplateCreate) createSpec() (*GoalTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GoalTemplate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goaltemplate.Table, sqlgraph.NewFieldSpec(goaltemplate.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(goaltemplate.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(goaltemplate.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.SuggestedDurationDays(); ok {
+		_spec.SetField(goaltemplate.FieldSuggestedDurationDays, field.TypeInt, value)
+		_node.SuggestedDurationDays = &value
+	}
+	if value, ok := _c.mutation.Milestones(); ok {
+		_spec.SetField(goaltemplate.FieldMilestones, field.TypeJSON, value)
+		_node.Milestones = value
+	}
+	if value, ok := _c.mutation.HabitDays(); ok {
+		_spec.SetField(goaltemplate.FieldHabitDays, field.TypeJSON, value)
+		_node.HabitDays = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(goaltemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(goaltemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.GenreIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goaltemplate.GenreTable,
+			Columns: []string{goaltemplate.GenreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(genre.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.genre_templates = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoalTemplate.Create().
+//		SetTitle(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalTemplateUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalTemplateCreate) OnConflict(opts ...sql.ConflictOption) *GoalTemplateUpsertOne {
+	_c.conflict = opts
+	return &GoalTemplateUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoalTemplate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalTemplateCreate) OnConflictColumns(columns ...string) *GoalTemplateUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalTemplateUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GoalTemplateUpsertOne is the builder for "upsert"-ing
+	//  one GoalTemplate node.
+	GoalTemplateUpsertOne struct {
+		create *GoalTemplateCreate
+	}
+
+	// GoalTemplateUpsert is the "OnConflict" setter.
+	GoalTemplateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTitle sets the "title" field.
+func (u *GoalTemplateUpsert) SetTitle(v string) *GoalTemplateUpsert {
+	u.Set(goaltemplate.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *GoalTemplateUpsert) UpdateTitle() *GoalTemplateUpsert {
+	u.SetExcluded(goaltemplate.FieldTitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *GoalTemplateUpsert) SetDescription(v string) *GoalTemplateUpsert {
+	u.Set(goaltemplate.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GoalTemplateUpsert) UpdateDescription() *GoalTemplateUpsert {
+	u.SetExcluded(goaltemplate.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GoalTemplateUpsert) ClearDescription() *GoalTemplateUpsert {
+	u.SetNull(goaltemplate.FieldDescription)
+	return u
+}
+
+// SetSuggestedDurationDays sets the "suggested_duration_days" field.
+func (u *GoalTemplateUpsert) SetSuggestedDurationDays(v int) *GoalTemplateUpsert {
+	u.Set(goaltemplate.FieldSuggestedDurationDays, v)
+	return u
+}
+
+// UpdateSuggestedDurationDays sets the "suggested_duration_days" field to the value that was provided on create.
+func (u *GoalTemplateUpsert) UpdateSuggestedDurationDays() *GoalTemplateUpsert {
+	u.SetExcluded(goaltemplate.FieldSuggestedDurationDays)
+	return u
+}
+
+// AddSuggestedDurationDays adds v to the "suggested_duration_days" field.
+func (u *GoalTemplateUpsert) AddSuggestedDurationDays(v int) *GoalTemplateUpsert {
+	u.Add(goaltemplate.FieldSuggestedDurationDays, v)
+	return u
+}
+
+// ClearSuggestedDurationDays clears the value of the "suggested_duration_days" field.
+func (u *GoalTemplateUpsert) ClearSuggestedDurationDays() *GoalTemplateUpsert {
+	u.SetNull(goaltemplate.FieldSuggestedDurationDays)
+	return u
+}
+
+// SetMilestones sets the "milestones" field.
+func (u *GoalTemplateUpsert) SetMilestones(v []types.MilestoneTemplate) *GoalTemplateUpsert {
+	u.Set(goaltemplate.FieldMilestones, v)
+	return u
+}
+
+// UpdateMilestones sets the "milestones" field to the value that was provided on create.
+func (u *GoalTemplateUpsert) UpdateMilestones() *GoalTemplateUpsert {
+	u.SetExcluded(goaltemplate.FieldMilestones)
+	return u
+}
+
+// ClearMilestones clears the value of the "milestones" field.
+func (u *GoalTemplateUpsert) ClearMilestones() *GoalTemplateUpsert {
+	u.SetNull(goaltemplate.FieldMilestones)
+	return u
+}
+
+// SetHabitDays sets the "habit_days" field.
+func (u *GoalTemplateUpsert) SetHabitDays(v []string) *GoalTemplateUpsert {
+	u.Set(goaltemplate.FieldHabitDays, v)
+	return u
+}
+
+// UpdateHabitDays sets the "habit_days" field to the value that was provided on create.
+func (u *GoalTemplateUpsert) UpdateHabitDays() *GoalTemplateUpsert {
+	u.SetExcluded(goaltemplate.FieldHabitDays)
+	return u
+}
+
+// ClearHabitDays clears the value of the "habit_days" field.
+func (u *GoalTemplateUpsert) ClearHabitDays() *GoalTemplateUpsert {
+	u.SetNull(goaltemplate.FieldHabitDays)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalTemplateUpsert) SetUpdatedAt(v time.Time) *GoalTemplateUpsert {
+	u.Set(goaltemplate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalTemplateUpsert) UpdateUpdatedAt() *GoalTemplateUpsert {
+	u.SetExcluded(goaltemplate.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GoalTemplate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goaltemplate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalTemplateUpsertOne) UpdateNewValues() *GoalTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(goaltemplate.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(goaltemplate.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoalTemplate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GoalTemplateUpsertOne) Ignore() *GoalTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalTemplateUpsertOne) DoNothing() *GoalTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalTemplateCreate.OnConflict
+// documentation for more info.
+func (u *GoalTemplateUpsertOne) Update(set func(*GoalTemplateUpsert)) *GoalTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalTemplateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *GoalTemplateUpsertOne) SetTitle(v string) *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *GoalTemplateUpsertOne) UpdateTitle() *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GoalTemplateUpsertOne) SetDescription(v string) *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GoalTemplateUpsertOne) UpdateDescription() *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GoalTemplateUpsertOne) ClearDescription() *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSuggestedDurationDays sets the "suggested_duration_days" field.
+func (u *GoalTemplateUpsertOne) SetSuggestedDurationDays(v int) *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetSuggestedDurationDays(v)
+	})
+}
+
+// AddSuggestedDurationDays adds v to the "suggested_duration_days" field.
+func (u *GoalTemplateUpsertOne) AddSuggestedDurationDays(v int) *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.AddSuggestedDurationDays(v)
+	})
+}
+
+// UpdateSuggestedDurationDays sets the "suggested_duration_days" field to the value that was provided on create.
+func (u *GoalTemplateUpsertOne) UpdateSuggestedDurationDays() *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateSuggestedDurationDays()
+	})
+}
+
+// ClearSuggestedDurationDays clears the value of the "suggested_duration_days" field.
+func (u *GoalTemplateUpsertOne) ClearSuggestedDurationDays() *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.ClearSuggestedDurationDays()
+	})
+}
+
+// SetMilestones sets the "milestones" field.
+func (u *GoalTemplateUpsertOne) SetMilestones(v []types.MilestoneTemplate) *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetMilestones(v)
+	})
+}
+
+// UpdateMilestones sets the "milestones" field to the value that was provided on create.
+func (u *GoalTemplateUpsertOne) UpdateMilestones() *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateMilestones()
+	})
+}
+
+// ClearMilestones clears the value of the "milestones" field.
+func (u *GoalTemplateUpsertOne) ClearMilestones() *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.ClearMilestones()
+	})
+}
+
+// SetHabitDays sets the "habit_days" field.
+func (u *GoalTemplateUpsertOne) SetHabitDays(v []string) *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetHabitDays(v)
+	})
+}
+
+// UpdateHabitDays sets the "habit_days" field to the value that was provided on create.
+func (u *GoalTemplateUpsertOne) UpdateHabitDays() *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateHabitDays()
+	})
+}
+
+// ClearHabitDays clears the value of the "habit_days" field.
+func (u *GoalTemplateUpsertOne) ClearHabitDays() *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.ClearHabitDays()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalTemplateUpsertOne) SetUpdatedAt(v time.Time) *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalTemplateUpsertOne) UpdateUpdatedAt() *GoalTemplateUpsertOne {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalTemplateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalTemplateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalTemplateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GoalTemplateUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GoalTemplateUpsertOne.ID is not supported by MySQL driver. Use GoalTemplateUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GoalTemplateUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GoalTemplateCreateBulk is the builder for creating many GoalTemplate entities in bulk.
+type GoalTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*GoalTemplateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GoalTemplate entities in the database.
+func (_c *GoalTemplateCreateBulk) Save(ctx context.Context) ([]*GoalTemplate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GoalTemplate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoalTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoalTemplateCreateBulk) SaveX(ctx context.Context) []*GoalTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoalTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoalTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GoalTemplate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalTemplateUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalTemplateCreateBulk) OnConflict(opts ...sql.ConflictOption) *GoalTemplateUpsertBulk {
+	_c.conflict = opts
+	return &GoalTemplateUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GoalTemplate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalTemplateCreateBulk) OnConflictColumns(columns ...string) *GoalTemplateUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalTemplateUpsertBulk{
+		create: _c,
+	}
+}
+
+// GoalTemplateUpsertBulk is the builder for "upsert"-ing
+// a bulk of GoalTemplate nodes.
+type GoalTemplateUpsertBulk struct {
+	create *GoalTemplateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GoalTemplate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goaltemplate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalTemplateUpsertBulk) UpdateNewValues() *GoalTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(goaltemplate.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(goaltemplate.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GoalTemplate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GoalTemplateUpsertBulk) Ignore() *GoalTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalTemplateUpsertBulk) DoNothing() *GoalTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalTemplateCreateBulk.OnConflict
+// documentation for more info.
+func (u *GoalTemplateUpsertBulk) Update(set func(*GoalTemplateUpsert)) *GoalTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalTemplateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *GoalTemplateUpsertBulk) SetTitle(v string) *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *GoalTemplateUpsertBulk) UpdateTitle() *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GoalTemplateUpsertBulk) SetDescription(v string) *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GoalTemplateUpsertBulk) UpdateDescription() *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GoalTemplateUpsertBulk) ClearDescription() *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSuggestedDurationDays sets the "suggested_duration_days" field.
+func (u *GoalTemplateUpsertBulk) SetSuggestedDurationDays(v int) *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetSuggestedDurationDays(v)
+	})
+}
+
+// AddSuggestedDurationDays adds v to the "suggested_duration_days" field.
+func (u *GoalTemplateUpsertBulk) AddSuggestedDurationDays(v int) *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.AddSuggestedDurationDays(v)
+	})
+}
+
+// UpdateSuggestedDurationDays sets the "suggested_duration_days" field to the value that was provided on create.
+func (u *GoalTemplateUpsertBulk) UpdateSuggestedDurationDays() *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateSuggestedDurationDays()
+	})
+}
+
+// ClearSuggestedDurationDays clears the value of the "suggested_duration_days" field.
+func (u *GoalTemplateUpsertBulk) ClearSuggestedDurationDays() *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.ClearSuggestedDurationDays()
+	})
+}
+
+// SetMilestones sets the "milestones" field.
+func (u *GoalTemplateUpsertBulk) SetMilestones(v []types.MilestoneTemplate) *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetMilestones(v)
+	})
+}
+
+// UpdateMilestones sets the "milestones" field to the value that was provided on create.
+func (u *GoalTemplateUpsertBulk) UpdateMilestones() *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateMilestones()
+	})
+}
+
+// ClearMilestones clears the value of the "milestones" field.
+func (u *GoalTemplateUpsertBulk) ClearMilestones() *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.ClearMilestones()
+	})
+}
+
+// SetHabitDays sets the "habit_days" field.
+func (u *GoalTemplateUpsertBulk) SetHabitDays(v []string) *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetHabitDays(v)
+	})
+}
+
+// UpdateHabitDays sets the "habit_days" field to the value that was provided on create.
+func (u *GoalTemplateUpsertBulk) UpdateHabitDays() *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateHabitDays()
+	})
+}
+
+// ClearHabitDays clears the value of the "habit_days" field.
+func (u *GoalTemplateUpsertBulk) ClearHabitDays() *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.ClearHabitDays()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalTemplateUpsertBulk) SetUpdatedAt(v time.Time) *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalTemplateUpsertBulk) UpdateUpdatedAt() *GoalTemplateUpsertBulk {
+	return u.Update(func(s *GoalTemplateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalTemplateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GoalTemplateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalTemplateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalTemplateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
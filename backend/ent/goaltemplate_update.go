@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/genre"
+	"backend/ent/goaltemplate"
+	"backend/ent/predicate"
+	"backend/ent/schema/types"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GoalTemplateUpdate is the builder for updating GoalTemplate entities.
+type GoalTemplateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *GoalTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the GoalTemplateUpdate builder.
+func (_u *GoalTemplateUpdate) Where(ps ...predicate.GoalTemplate) *GoalTemplateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *GoalTemplateUpdate) SetTitle(v string) *GoalTemplateUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *GoalTemplateUpdate) SetNillableTitle(v *string) *GoalTemplateUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GoalTemplateUpdate) SetDescription(v string) *GoalTemplateUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GoalTemplateUpdate) SetNillableDescription(v *string) *GoalTemplateUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GoalTemplateUpdate) ClearDescription() *GoalTemplateUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSuggestedDurationDays sets the "suggested_duration_days" field.
+func (_u *GoalTemplateUpdate) SetSuggestedDurationDays(v int) *GoalTemplateUpdate {
+	_u.mutation.ResetSuggestedDurationDays()
+	_u.mutation.SetSuggestedDurationDays(v)
+	return _u
+}
+
+// SetNillableSuggestedDurationDays sets the "suggested_duration_days" field if the given value is not nil.
+func (_u *GoalTemplateUpdate) SetNillableSuggestedDurationDays(v *int) *GoalTemplateUpdate {
+	if v != nil {
+		_u.SetSuggestedDurationDays(*v)
+	}
+	return _u
+}
+
+// AddSuggestedDurationDays adds value to the "suggested_duration_days" field.
+func (_u *GoalTemplateUpdate) AddSuggestedDurationDays(v int) *GoalTemplateUpdate {
+	_u.mutation.AddSuggestedDurationDays(v)
+	return _u
+}
+
+// ClearSuggestedDurationDays clears the value of the "suggested_duration_days" field.
+func (_u *GoalTemplateUpdate) ClearSuggestedDurationDays() *GoalTemplateUpdate {
+	_u.mutation.ClearSuggestedDurationDays()
+	return _u
+}
+
+// SetMilestones sets the "milestones" field.
+func (_u *GoalTemplateUpdate) SetMilestones(v []types.MilestoneTemplate) *GoalTemplateUpdate {
+	_u.mutation.SetMilestones(v)
+	return _u
+}
+
+// AppendMilestones appends value to the "milestones" field.
+func (_u *GoalTemplateUpdate) AppendMilestones(v []types.MilestoneTemplate) *GoalTemplateUpdate {
+	_u.mutation.AppendMilestones(v)
+	return _u
+}
+
+// ClearMilestones clears the value of the "milestones" field.
+func (_u *GoalTemplateUpdate) ClearMilestones() *GoalTemplateUpdate {
+	_u.mutation.ClearMilestones()
+	return _u
+}
+
+// SetHabitDays sets the "habit_days" field.
+func (_u *GoalTemplateUpdate) SetHabitDays(v []string) *GoalTemplateUpdate {
+	_u.mutation.SetHabitDays(v)
+	return _u
+}
+
+// AppendHabitDays appends value to the "habit_days" field.
+func (_u *GoalTemplateUpdate) AppendHabitDays(v []string) *GoalTemplateUpdate {
+	_u.mutation.AppendHabitDays(v)
+	return _u
+}
+
+// ClearHabitDays clears the value of the "habit_days" field.
+func (_u *GoalTemplateUpdate) ClearHabitDays() *GoalTemplateUpdate {
+	_u.mutation.ClearHabitDays()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GoalTemplateUpdate) SetUpdatedAt(v time.Time) *GoalTemplateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGenreID sets the "genre" edge to the Genre entity by ID.
+func (_u *GoalTemplateUpdate) SetGenreID(id uuid.UUID) *GoalTemplateUpdate {
+	_u.mutation.SetGenreID(id)
+	return _u
+}
+
+// SetGenre sets the "genre" edge to the Genre entity.
+func (_u *GoalTemplateUpdate) SetGenre(v *Genre) *GoalTemplateUpdate {
+	return _u.SetGenreID(v.ID)
+}
+
+// Mutation returns the GoalTemplateMutation object of the builder.
+func (_u *GoalTemplateUpdate) Mutation() *GoalTemplateMutation {
+	return _u.mutation
+}
+
+// ClearGenre clears the "genre" edge to the Genre entity.
+func (_u *GoalTemplateUpdate) ClearGenre() *GoalTemplateUpdate {
+	_u.mutation.ClearGenre()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoalTemplateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoalTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoalTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoalTemplateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GoalTemplateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := goaltemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoalTemplateUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := goaltemplate.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "GoalTemplate.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SuggestedDurationDays(); ok {
+		if err := goaltemplate.SuggestedDurationDaysValidator(v); err != nil {
+			return &ValidationError{Name: "suggested_duration_days", err: fmt.Errorf(`ent: validator failed for field "GoalTemplate.suggested_duration_days": %w`, err)}
+		}
+	}
+	if _u.mutation.GenreCleared() && len(_u.mutation.GenreIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoalTemplate.genre"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GoalTemplateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GoalTemplateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *GoalTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goaltemplate.Table, goaltemplate.Columns, sqlgraph.NewFieldSpec(goaltemplate.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(goaltemplate.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(goaltemplate.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(goaltemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuggestedDurationDays(); ok {
+		_spec.SetField(goaltemplate.FieldSuggestedDurationDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuggestedDurationDays(); ok {
+		_spec.AddField(goaltemplate.FieldSuggestedDurationDays, field.TypeInt, value)
+	}
+	if _u.mutation.SuggestedDurationDaysCleared() {
+		_spec.ClearField(goaltemplate.FieldSuggestedDurationDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Milestones(); ok {
+		_spec.SetField(goaltemplate.FieldMilestones, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMilestones(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goaltemplate.FieldMilestones, value)
+		})
+	}
+	if _u.mutation.MilestonesCleared() {
+		_spec.ClearField(goaltemplate.FieldMilestones, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HabitDays(); ok {
+		_spec.SetField(goaltemplate.FieldHabitDays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHabitDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goaltemplate.FieldHabitDays, value)
+		})
+	}
+	if _u.mutation.HabitDaysCleared() {
+		_spec.ClearField(goaltemplate.FieldHabitDays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(goaltemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.GenreCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goaltemplate.GenreTable,
+			Columns: []string{goaltemplate.GenreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(genre.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GenreIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goaltemplate.GenreTable,
+			Columns: []string{goaltemplate.GenreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(genre.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goaltemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoalTemplateUpdateOne is the builder for updating a single GoalTemplate entity.
+type GoalTemplateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *GoalTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTitle sets the "title" field.
+func (_u *GoalTemplateUpdateOne) SetTitle(v string) *GoalTemplateUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *GoalTemplateUpdateOne) SetNillableTitle(v *string) *GoalTemplateUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GoalTemplateUpdateOne) SetDescription(v string) *GoalTemplateUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GoalTemplateUpdateOne) SetNillableDescription(v *string) *GoalTemplateUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GoalTemplateUpdateOne) ClearDescription() *GoalTemplateUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSuggestedDurationDays sets the "suggested_duration_days" field.
+func (_u *GoalTemplateUpdateOne) SetSuggestedDurationDays(v int) *GoalTemplateUpdateOne {
+	_u.mutation.ResetSuggestedDurationDays()
+	_u.mutation.SetSuggestedDurationDays(v)
+	return _u
+}
+
+// SetNillableSuggestedDurationDays sets the "suggested_duration_days" field if the given value is not nil.
+func (_u *GoalTemplateUpdateOne) SetNillableSuggestedDurationDays(v *int) *GoalTemplateUpdateOne {
+	if v != nil {
+		_u.SetSuggestedDurationDays(*v)
+	}
+	return _u
+}
+
+// AddSuggestedDurationDays adds value to the "suggested_duration_days" field.
+func (_u *GoalTemplateUpdateOne) AddSuggestedDurationDays(v int) *GoalTemplateUpdateOne {
+	_u.mutation.AddSuggestedDurationDays(v)
+	return _u
+}
+
+// ClearSuggestedDurationDays clears the value of the "suggested_duration_days" field.
+func (_u *GoalTemplateUpdateOne) ClearSuggestedDurationDays() *GoalTemplateUpdateOne {
+	_u.mutation.ClearSuggestedDurationDays()
+	return _u
+}
+
+// SetMilestones sets the "milestones" field.
+func (_u *GoalTemplateUpdateOne) SetMilestones(v []types.MilestoneTemplate) *GoalTemplateUpdateOne {
+	_u.mutation.SetMilestones(v)
+	return _u
+}
+
+// AppendMilestones appends value to the "milestones" field.
+func (_u *GoalTemplateUpdateOne) AppendMilestones(v []types.MilestoneTemplate) *GoalTemplateUpdateOne {
+	_u.mutation.AppendMilestones(v)
+	return _u
+}
+
+// ClearMilestones clears the value of the "milestones" field.
+func (_u *GoalTemplateUpdateOne) ClearMilestones() *GoalTemplateUpdateOne {
+	_u.mutation.ClearMilestones()
+	return _u
+}
+
+// SetHabitDays sets the "habit_days" field.
+func (_u *GoalTemplateUpdateOne) SetHabitDays(v []string) *GoalTemplateUpdateOne {
+	_u.mutation.SetHabitDays(v)
+	return _u
+}
+
+// AppendHabitDays appends value to the "habit_days" field.
+func (_u *GoalTemplateUpdateOne) AppendHabitDays(v []string) *GoalTemplateUpdateOne {
+	_u.mutation.AppendHabitDays(v)
+	return _u
+}
+
+// ClearHabitDays clears the value of the "habit_days" field.
+func (_u *GoalTemplateUpdateOne) ClearHabitDays() *GoalTemplateUpdateOne {
+	_u.mutation.ClearHabitDays()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GoalTemplateUpdateOne) SetUpdatedAt(v time.Time) *GoalTemplateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGenreID sets the "genre" edge to the Genre entity by ID.
+func (_u *GoalTemplateUpdateOne) SetGenreID(id uuid.UUID) *GoalTemplateUpdateOne {
+	_u.mutation.SetGenreID(id)
+	return _u
+}
+
+// SetGenre sets the "genre" edge to the Genre entity.
+func (_u *GoalTemplateUpdateOne) SetGenre(v *Genre) *GoalTemplateUpdateOne {
+	return _u.SetGenreID(v.ID)
+}
+
+// Mutation returns the GoalTemplateMutation object of the builder.
+func (_u *GoalTemplateUpdateOne) Mutation() *GoalTemplateMutation {
+	return _u.mutation
+}
+
+// ClearGenre clears the "genre" edge to the Genre entity.
+func (_u *GoalTemplateUpdateOne) ClearGenre() *GoalTemplateUpdateOne {
+	_u.mutation.ClearGenre()
+	return _u
+}
+
+// Where appends a list predicates to the GoalTemplateUpdate builder.
+func (_u *GoalTemplateUpdateOne) Where(ps ...predicate.GoalTemplate) *GoalTemplateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoalTemplateUpdateOne) Select(field string, fields ...string) *GoalTemplateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GoalTemplate entity.
+func (_u *GoalTemplateUpdateOne) Save(ctx context.Context) (*GoalTemplate, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoalTemplateUpdateOne) SaveX(ctx context.Context) *GoalTemplate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoalTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoalTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GoalTemplateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := goaltemplate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoalTemplateUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := goaltemplate.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "GoalTemplate.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SuggestedDurationDays(); ok {
+		if err := goaltemplate.SuggestedDurationDaysValidator(v); err != nil {
+			return &ValidationError{Name: "suggested_duration_days", err: fmt.Errorf(`ent: validator failed for field "GoalTemplate.suggested_duration_days": %w`, err)}
+		}
+	}
+	if _u.mutation.GenreCleared() && len(_u.mutation.GenreIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GoalTemplate.genre"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GoalTemplateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GoalTemplateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *GoalTemplateUpdateOne) sqlSave(ctx context.Context) (_node *GoalTemplate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goaltemplate.Table, goaltemplate.Columns, sqlgraph.NewFieldSpec(goaltemplate.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GoalTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goaltemplate.FieldID)
+		for _, f := range fields {
+			if !goaltemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != goaltemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(goaltemplate.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(goaltemplate.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(goaltemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuggestedDurationDays(); ok {
+		_spec.SetField(goaltemplate.FieldSuggestedDurationDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuggestedDurationDays(); ok {
+		_spec.AddField(goaltemplate.FieldSuggestedDurationDays, field.TypeInt, value)
+	}
+	if _u.mutation.SuggestedDurationDaysCleared() {
+		_spec.ClearField(goaltemplate.FieldSuggestedDurationDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Milestones(); ok {
+		_spec.SetField(goaltemplate.FieldMilestones, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMilestones(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goaltemplate.FieldMilestones, value)
+		})
+	}
+	if _u.mutation.MilestonesCleared() {
+		_spec.ClearField(goaltemplate.FieldMilestones, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HabitDays(); ok {
+		_spec.SetField(goaltemplate.FieldHabitDays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHabitDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goaltemplate.FieldHabitDays, value)
+		})
+	}
+	if _u.mutation.HabitDaysCleared() {
+		_spec.ClearField(goaltemplate.FieldHabitDays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(goaltemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.GenreCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goaltemplate.GenreTable,
+			Columns: []string{goaltemplate.GenreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(genre.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GenreIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goaltemplate.GenreTable,
+			Columns: []string{goaltemplate.GenreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(genre.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &GoalTemplate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goaltemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +23,7 @@ type ImageCreate struct {
 	config
 	mutation *ImageMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetObjectName sets the "object_name" field.
@@ -193,6 +196,7 @@ func (_c *ImageCreate) createSpec() (*Image, *sqlgraph.CreateSpec) {
 		_node = &Image{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(image.Table, sqlgraph.NewFieldSpec(image.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -246,11 +250,202 @@ func (_c *ImageCreate) createSpec() (*Image, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Image.Create().
+//		SetObjectName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImageUpsert) {
+//			SetObjectName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImageCreate) OnConflict(opts ...sql.ConflictOption) *ImageUpsertOne {
+	_c.conflict = opts
+	return &ImageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Image.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImageCreate) OnConflictColumns(columns ...string) *ImageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ImageUpsertOne is the builder for "upsert"-ing
+	//  one Image node.
+	ImageUpsertOne struct {
+		create *ImageCreate
+	}
+
+	// ImageUpsert is the "OnConflict" setter.
+	ImageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetObjectName sets the "object_name" field.
+func (u *ImageUpsert) SetObjectName(v string) *ImageUpsert {
+	u.Set(image.FieldObjectName, v)
+	return u
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *ImageUpsert) UpdateObjectName() *ImageUpsert {
+	u.SetExcluded(image.FieldObjectName)
+	return u
+}
+
+// SetContentType sets the "content_type" field.
+func (u *ImageUpsert) SetContentType(v string) *ImageUpsert {
+	u.Set(image.FieldContentType, v)
+	return u
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *ImageUpsert) UpdateContentType() *ImageUpsert {
+	u.SetExcluded(image.FieldContentType)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Image.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(image.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImageUpsertOne) UpdateNewValues() *ImageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(image.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(image.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Image.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ImageUpsertOne) Ignore() *ImageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImageUpsertOne) DoNothing() *ImageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImageCreate.OnConflict
+// documentation for more info.
+func (u *ImageUpsertOne) Update(set func(*ImageUpsert)) *ImageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetObjectName sets the "object_name" field.
+func (u *ImageUpsertOne) SetObjectName(v string) *ImageUpsertOne {
+	return u.Update(func(s *ImageUpsert) {
+		s.SetObjectName(v)
+	})
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *ImageUpsertOne) UpdateObjectName() *ImageUpsertOne {
+	return u.Update(func(s *ImageUpsert) {
+		s.UpdateObjectName()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *ImageUpsertOne) SetContentType(v string) *ImageUpsertOne {
+	return u.Update(func(s *ImageUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *ImageUpsertOne) UpdateContentType() *ImageUpsertOne {
+	return u.Update(func(s *ImageUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// Exec executes the query.
+func (u *ImageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ImageUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ImageUpsertOne.ID is not supported by MySQL driver. Use ImageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ImageUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ImageCreateBulk is the builder for creating many Image entities in bulk.
 type ImageCreateBulk struct {
 	config
 	err      error
 	builders []*ImageCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Image entities in the database.
@@ -280,6 +475,7 @@ func (_c *ImageCreateBulk) Save(ctx context.Context) ([]*Image, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -329,3 +525,148 @@ func (_c *ImageCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Image.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImageUpsert) {
+//			SetObjectName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImageCreateBulk) OnConflict(opts ...sql.ConflictOption) *ImageUpsertBulk {
+	_c.conflict = opts
+	return &ImageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Image.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImageCreateBulk) OnConflictColumns(columns ...string) *ImageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImageUpsertBulk{
+		create: _c,
+	}
+}
+
+// ImageUpsertBulk is the builder for "upsert"-ing
+// a bulk of Image nodes.
+type ImageUpsertBulk struct {
+	create *ImageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Image.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(image.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ImageUpsertBulk) UpdateNewValues() *ImageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(image.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(image.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Image.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ImageUpsertBulk) Ignore() *ImageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImageUpsertBulk) DoNothing() *ImageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImageCreateBulk.OnConflict
+// documentation for more info.
+func (u *ImageUpsertBulk) Update(set func(*ImageUpsert)) *ImageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetObjectName sets the "object_name" field.
+func (u *ImageUpsertBulk) SetObjectName(v string) *ImageUpsertBulk {
+	return u.Update(func(s *ImageUpsert) {
+		s.SetObjectName(v)
+	})
+}
+
+// UpdateObjectName sets the "object_name" field to the value that was provided on create.
+func (u *ImageUpsertBulk) UpdateObjectName() *ImageUpsertBulk {
+	return u.Update(func(s *ImageUpsert) {
+		s.UpdateObjectName()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *ImageUpsertBulk) SetContentType(v string) *ImageUpsertBulk {
+	return u.Update(func(s *ImageUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *ImageUpsertBulk) UpdateContentType() *ImageUpsertBulk {
+	return u.Update(func(s *ImageUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// Exec executes the query.
+func (u *ImageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ImageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
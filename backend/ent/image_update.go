@@ -20,8 +20,9 @@ import (
 // ImageUpdate is the builder for updating Image entities.
 type ImageUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ImageMutation
+	hooks     []Hook
+	mutation  *ImageMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ImageUpdate builder.
@@ -150,6 +151,12 @@ func (_u *ImageUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImageUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ImageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -226,6 +233,7 @@ func (_u *ImageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{image.Label}
@@ -241,9 +249,10 @@ func (_u *ImageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // ImageUpdateOne is the builder for updating a single Image entity.
 type ImageUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ImageMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ImageMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetObjectName sets the "object_name" field.
@@ -379,6 +388,12 @@ func (_u *ImageUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImageUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImageUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ImageUpdateOne) sqlSave(ctx context.Context) (_node *Image, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -472,6 +487,7 @@ func (_u *ImageUpdateOne) sqlSave(ctx context.Context) (_node *Image, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Image{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
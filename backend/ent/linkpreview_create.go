@@ -0,0 +1,903 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/linkpreview"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LinkPreviewCreate is the builder for creating a LinkPreview entity.
+type LinkPreviewCreate struct {
+	config
+	mutation *LinkPreviewMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetURL sets the "url" field.
+func (_c *LinkPreviewCreate) SetURL(v string) *LinkPreviewCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LinkPreviewCreate) SetStatus(v linkpreview.Status) *LinkPreviewCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *LinkPreviewCreate) SetTitle(v string) *LinkPreviewCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *LinkPreviewCreate) SetNillableTitle(v *string) *LinkPreviewCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *LinkPreviewCreate) SetDescription(v string) *LinkPreviewCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *LinkPreviewCreate) SetNillableDescription(v *string) *LinkPreviewCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetImageURL sets the "image_url" field.
+func (_c *LinkPreviewCreate) SetImageURL(v string) *LinkPreviewCreate {
+	_c.mutation.SetImageURL(v)
+	return _c
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (_c *LinkPreviewCreate) SetNillableImageURL(v *string) *LinkPreviewCreate {
+	if v != nil {
+		_c.SetImageURL(*v)
+	}
+	return _c
+}
+
+// SetSiteName sets the "site_name" field.
+func (_c *LinkPreviewCreate) SetSiteName(v string) *LinkPreviewCreate {
+	_c.mutation.SetSiteName(v)
+	return _c
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_c *LinkPreviewCreate) SetNillableSiteName(v *string) *LinkPreviewCreate {
+	if v != nil {
+		_c.SetSiteName(*v)
+	}
+	return _c
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (_c *LinkPreviewCreate) SetFetchedAt(v time.Time) *LinkPreviewCreate {
+	_c.mutation.SetFetchedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LinkPreviewCreate) SetID(v uuid.UUID) *LinkPreviewCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LinkPreviewCreate) SetNillableID(v *uuid.UUID) *LinkPreviewCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LinkPreviewMutation object of the builder.
+func (_c *LinkPreviewCreate) Mutation() *LinkPreviewMutation {
+	return _c.mutation
+}
+
+// Save creates the LinkPreview in the database.
+func (_c *LinkPreviewCreate) Save(ctx context.Context) (*LinkPreview, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LinkPreviewCreate) SaveX(ctx context.Context) *LinkPreview {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkPreviewCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkPreviewCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LinkPreviewCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := linkpreview.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LinkPreviewCreate) check() error {
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "LinkPreview.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := linkpreview.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LinkPreview.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := linkpreview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FetchedAt(); !ok {
+		return &ValidationError{Name: "fetched_at", err: errors.New(`ent: missing required field "LinkPreview.fetched_at"`)}
+	}
+	return nil
+}
+
+func (_c *LinkPreviewCreate) sqlSave(ctx context.Context) (*LinkPreview, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LinkPreviewCreate) createSpec() (*LinkPreview, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LinkPreview{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(linkpreview.Table, sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(linkpreview.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(linkpreview.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(linkpreview.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(linkpreview.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.ImageURL(); ok {
+		_spec.SetField(linkpreview.FieldImageURL, field.TypeString, value)
+		_node.ImageURL = value
+	}
+	if value, ok := _c.mutation.SiteName(); ok {
+		_spec.SetField(linkpreview.FieldSiteName, field.TypeString, value)
+		_node.SiteName = value
+	}
+	if value, ok := _c.mutation.FetchedAt(); ok {
+		_spec.SetField(linkpreview.FieldFetchedAt, field.TypeTime, value)
+		_node.FetchedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LinkPreview.Create().
+//		SetURL(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LinkPreviewUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LinkPreviewCreate) OnConflict(opts ...sql.ConflictOption) *LinkPreviewUpsertOne {
+	_c.conflict = opts
+	return &LinkPreviewUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LinkPreview.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LinkPreviewCreate) OnConflictColumns(columns ...string) *LinkPreviewUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LinkPreviewUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LinkPreviewUpsertOne is the builder for "upsert"-ing
+	//  one LinkPreview node.
+	LinkPreviewUpsertOne struct {
+		create *LinkPreviewCreate
+	}
+
+	// LinkPreviewUpsert is the "OnConflict" setter.
+	LinkPreviewUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *LinkPreviewUpsert) SetStatus(v linkpreview.Status) *LinkPreviewUpsert {
+	u.Set(linkpreview.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LinkPreviewUpsert) UpdateStatus() *LinkPreviewUpsert {
+	u.SetExcluded(linkpreview.FieldStatus)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *LinkPreviewUpsert) SetTitle(v string) *LinkPreviewUpsert {
+	u.Set(linkpreview.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *LinkPreviewUpsert) UpdateTitle() *LinkPreviewUpsert {
+	u.SetExcluded(linkpreview.FieldTitle)
+	return u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *LinkPreviewUpsert) ClearTitle() *LinkPreviewUpsert {
+	u.SetNull(linkpreview.FieldTitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *LinkPreviewUpsert) SetDescription(v string) *LinkPreviewUpsert {
+	u.Set(linkpreview.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LinkPreviewUpsert) UpdateDescription() *LinkPreviewUpsert {
+	u.SetExcluded(linkpreview.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LinkPreviewUpsert) ClearDescription() *LinkPreviewUpsert {
+	u.SetNull(linkpreview.FieldDescription)
+	return u
+}
+
+// SetImageURL sets the "image_url" field.
+func (u *LinkPreviewUpsert) SetImageURL(v string) *LinkPreviewUpsert {
+	u.Set(linkpreview.FieldImageURL, v)
+	return u
+}
+
+// UpdateImageURL sets the "image_url" field to the value that was provided on create.
+func (u *LinkPreviewUpsert) UpdateImageURL() *LinkPreviewUpsert {
+	u.SetExcluded(linkpreview.FieldImageURL)
+	return u
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (u *LinkPreviewUpsert) ClearImageURL() *LinkPreviewUpsert {
+	u.SetNull(linkpreview.FieldImageURL)
+	return u
+}
+
+// SetSiteName sets the "site_name" field.
+func (u *LinkPreviewUpsert) SetSiteName(v string) *LinkPreviewUpsert {
+	u.Set(linkpreview.FieldSiteName, v)
+	return u
+}
+
+// UpdateSiteName sets the "site_name" field to the value that was provided on create.
+func (u *LinkPreviewUpsert) UpdateSiteName() *LinkPreviewUpsert {
+	u.SetExcluded(linkpreview.FieldSiteName)
+	return u
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (u *LinkPreviewUpsert) ClearSiteName() *LinkPreviewUpsert {
+	u.SetNull(linkpreview.FieldSiteName)
+	return u
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (u *LinkPreviewUpsert) SetFetchedAt(v time.Time) *LinkPreviewUpsert {
+	u.Set(linkpreview.FieldFetchedAt, v)
+	return u
+}
+
+// UpdateFetchedAt sets the "fetched_at" field to the value that was provided on create.
+func (u *LinkPreviewUpsert) UpdateFetchedAt() *LinkPreviewUpsert {
+	u.SetExcluded(linkpreview.FieldFetchedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LinkPreview.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(linkpreview.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LinkPreviewUpsertOne) UpdateNewValues() *LinkPreviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(linkpreview.FieldID)
+		}
+		if _, exists := u.create.mutation.URL(); exists {
+			s.SetIgnore(linkpreview.FieldURL)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LinkPreview.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LinkPreviewUpsertOne) Ignore() *LinkPreviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LinkPreviewUpsertOne) DoNothing() *LinkPreviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LinkPreviewCreate.OnConflict
+// documentation for more info.
+func (u *LinkPreviewUpsertOne) Update(set func(*LinkPreviewUpsert)) *LinkPreviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LinkPreviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *LinkPreviewUpsertOne) SetStatus(v linkpreview.Status) *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LinkPreviewUpsertOne) UpdateStatus() *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *LinkPreviewUpsertOne) SetTitle(v string) *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *LinkPreviewUpsertOne) UpdateTitle() *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *LinkPreviewUpsertOne) ClearTitle() *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LinkPreviewUpsertOne) SetDescription(v string) *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LinkPreviewUpsertOne) UpdateDescription() *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LinkPreviewUpsertOne) ClearDescription() *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetImageURL sets the "image_url" field.
+func (u *LinkPreviewUpsertOne) SetImageURL(v string) *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetImageURL(v)
+	})
+}
+
+// UpdateImageURL sets the "image_url" field to the value that was provided on create.
+func (u *LinkPreviewUpsertOne) UpdateImageURL() *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateImageURL()
+	})
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (u *LinkPreviewUpsertOne) ClearImageURL() *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.ClearImageURL()
+	})
+}
+
+// SetSiteName sets the "site_name" field.
+func (u *LinkPreviewUpsertOne) SetSiteName(v string) *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetSiteName(v)
+	})
+}
+
+// UpdateSiteName sets the "site_name" field to the value that was provided on create.
+func (u *LinkPreviewUpsertOne) UpdateSiteName() *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateSiteName()
+	})
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (u *LinkPreviewUpsertOne) ClearSiteName() *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.ClearSiteName()
+	})
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (u *LinkPreviewUpsertOne) SetFetchedAt(v time.Time) *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetFetchedAt(v)
+	})
+}
+
+// UpdateFetchedAt sets the "fetched_at" field to the value that was provided on create.
+func (u *LinkPreviewUpsertOne) UpdateFetchedAt() *LinkPreviewUpsertOne {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateFetchedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LinkPreviewUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LinkPreviewCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LinkPreviewUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LinkPreviewUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LinkPreviewUpsertOne.ID is not supported by MySQL driver. Use LinkPreviewUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LinkPreviewUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LinkPreviewCreateBulk is the builder for creating many LinkPreview entities in bulk.
+type LinkPreviewCreateBulk struct {
+	config
+	err      error
+	builders []*LinkPreviewCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LinkPreview entities in the database.
+func (_c *LinkPreviewCreateBulk) Save(ctx context.Context) ([]*LinkPreview, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LinkPreview, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LinkPreviewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LinkPreviewCreateBulk) SaveX(ctx context.Context) []*LinkPreview {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LinkPreviewCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LinkPreviewCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LinkPreview.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LinkPreviewUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LinkPreviewCreateBulk) OnConflict(opts ...sql.ConflictOption) *LinkPreviewUpsertBulk {
+	_c.conflict = opts
+	return &LinkPreviewUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LinkPreview.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LinkPreviewCreateBulk) OnConflictColumns(columns ...string) *LinkPreviewUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LinkPreviewUpsertBulk{
+		create: _c,
+	}
+}
+
+// LinkPreviewUpsertBulk is the builder for "upsert"-ing
+// a bulk of LinkPreview nodes.
+type LinkPreviewUpsertBulk struct {
+	create *LinkPreviewCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LinkPreview.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(linkpreview.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LinkPreviewUpsertBulk) UpdateNewValues() *LinkPreviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(linkpreview.FieldID)
+			}
+			if _, exists := b.mutation.URL(); exists {
+				s.SetIgnore(linkpreview.FieldURL)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LinkPreview.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LinkPreviewUpsertBulk) Ignore() *LinkPreviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LinkPreviewUpsertBulk) DoNothing() *LinkPreviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LinkPreviewCreateBulk.OnConflict
+// documentation for more info.
+func (u *LinkPreviewUpsertBulk) Update(set func(*LinkPreviewUpsert)) *LinkPreviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LinkPreviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *LinkPreviewUpsertBulk) SetStatus(v linkpreview.Status) *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LinkPreviewUpsertBulk) UpdateStatus() *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *LinkPreviewUpsertBulk) SetTitle(v string) *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *LinkPreviewUpsertBulk) UpdateTitle() *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *LinkPreviewUpsertBulk) ClearTitle() *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LinkPreviewUpsertBulk) SetDescription(v string) *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LinkPreviewUpsertBulk) UpdateDescription() *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LinkPreviewUpsertBulk) ClearDescription() *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetImageURL sets the "image_url" field.
+func (u *LinkPreviewUpsertBulk) SetImageURL(v string) *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetImageURL(v)
+	})
+}
+
+// UpdateImageURL sets the "image_url" field to the value that was provided on create.
+func (u *LinkPreviewUpsertBulk) UpdateImageURL() *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateImageURL()
+	})
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (u *LinkPreviewUpsertBulk) ClearImageURL() *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.ClearImageURL()
+	})
+}
+
+// SetSiteName sets the "site_name" field.
+func (u *LinkPreviewUpsertBulk) SetSiteName(v string) *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetSiteName(v)
+	})
+}
+
+// UpdateSiteName sets the "site_name" field to the value that was provided on create.
+func (u *LinkPreviewUpsertBulk) UpdateSiteName() *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateSiteName()
+	})
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (u *LinkPreviewUpsertBulk) ClearSiteName() *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.ClearSiteName()
+	})
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (u *LinkPreviewUpsertBulk) SetFetchedAt(v time.Time) *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.SetFetchedAt(v)
+	})
+}
+
+// UpdateFetchedAt sets the "fetched_at" field to the value that was provided on create.
+func (u *LinkPreviewUpsertBulk) UpdateFetchedAt() *LinkPreviewUpsertBulk {
+	return u.Update(func(s *LinkPreviewUpsert) {
+		s.UpdateFetchedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LinkPreviewUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LinkPreviewCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LinkPreviewCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LinkPreviewUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/linkpreview"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LinkPreviewUpdate is the builder for updating LinkPreview entities.
+type LinkPreviewUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LinkPreviewMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LinkPreviewUpdate builder.
+func (_u *LinkPreviewUpdate) Where(ps ...predicate.LinkPreview) *LinkPreviewUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LinkPreviewUpdate) SetStatus(v linkpreview.Status) *LinkPreviewUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LinkPreviewUpdate) SetNillableStatus(v *linkpreview.Status) *LinkPreviewUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *LinkPreviewUpdate) SetTitle(v string) *LinkPreviewUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *LinkPreviewUpdate) SetNillableTitle(v *string) *LinkPreviewUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *LinkPreviewUpdate) ClearTitle() *LinkPreviewUpdate {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *LinkPreviewUpdate) SetDescription(v string) *LinkPreviewUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *LinkPreviewUpdate) SetNillableDescription(v *string) *LinkPreviewUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *LinkPreviewUpdate) ClearDescription() *LinkPreviewUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetImageURL sets the "image_url" field.
+func (_u *LinkPreviewUpdate) SetImageURL(v string) *LinkPreviewUpdate {
+	_u.mutation.SetImageURL(v)
+	return _u
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (_u *LinkPreviewUpdate) SetNillableImageURL(v *string) *LinkPreviewUpdate {
+	if v != nil {
+		_u.SetImageURL(*v)
+	}
+	return _u
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (_u *LinkPreviewUpdate) ClearImageURL() *LinkPreviewUpdate {
+	_u.mutation.ClearImageURL()
+	return _u
+}
+
+// SetSiteName sets the "site_name" field.
+func (_u *LinkPreviewUpdate) SetSiteName(v string) *LinkPreviewUpdate {
+	_u.mutation.SetSiteName(v)
+	return _u
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_u *LinkPreviewUpdate) SetNillableSiteName(v *string) *LinkPreviewUpdate {
+	if v != nil {
+		_u.SetSiteName(*v)
+	}
+	return _u
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (_u *LinkPreviewUpdate) ClearSiteName() *LinkPreviewUpdate {
+	_u.mutation.ClearSiteName()
+	return _u
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (_u *LinkPreviewUpdate) SetFetchedAt(v time.Time) *LinkPreviewUpdate {
+	_u.mutation.SetFetchedAt(v)
+	return _u
+}
+
+// SetNillableFetchedAt sets the "fetched_at" field if the given value is not nil.
+func (_u *LinkPreviewUpdate) SetNillableFetchedAt(v *time.Time) *LinkPreviewUpdate {
+	if v != nil {
+		_u.SetFetchedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LinkPreviewMutation object of the builder.
+func (_u *LinkPreviewUpdate) Mutation() *LinkPreviewMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LinkPreviewUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkPreviewUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LinkPreviewUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkPreviewUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkPreviewUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := linkpreview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LinkPreviewUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LinkPreviewUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LinkPreviewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkpreview.Table, linkpreview.Columns, sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(linkpreview.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(linkpreview.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(linkpreview.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(linkpreview.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(linkpreview.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageURL(); ok {
+		_spec.SetField(linkpreview.FieldImageURL, field.TypeString, value)
+	}
+	if _u.mutation.ImageURLCleared() {
+		_spec.ClearField(linkpreview.FieldImageURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteName(); ok {
+		_spec.SetField(linkpreview.FieldSiteName, field.TypeString, value)
+	}
+	if _u.mutation.SiteNameCleared() {
+		_spec.ClearField(linkpreview.FieldSiteName, field.TypeString)
+	}
+	if value, ok := _u.mutation.FetchedAt(); ok {
+		_spec.SetField(linkpreview.FieldFetchedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkpreview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LinkPreviewUpdateOne is the builder for updating a single LinkPreview entity.
+type LinkPreviewUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LinkPreviewMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (_u *LinkPreviewUpdateOne) SetStatus(v linkpreview.Status) *LinkPreviewUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LinkPreviewUpdateOne) SetNillableStatus(v *linkpreview.Status) *LinkPreviewUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *LinkPreviewUpdateOne) SetTitle(v string) *LinkPreviewUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *LinkPreviewUpdateOne) SetNillableTitle(v *string) *LinkPreviewUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *LinkPreviewUpdateOne) ClearTitle() *LinkPreviewUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *LinkPreviewUpdateOne) SetDescription(v string) *LinkPreviewUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *LinkPreviewUpdateOne) SetNillableDescription(v *string) *LinkPreviewUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *LinkPreviewUpdateOne) ClearDescription() *LinkPreviewUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetImageURL sets the "image_url" field.
+func (_u *LinkPreviewUpdateOne) SetImageURL(v string) *LinkPreviewUpdateOne {
+	_u.mutation.SetImageURL(v)
+	return _u
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (_u *LinkPreviewUpdateOne) SetNillableImageURL(v *string) *LinkPreviewUpdateOne {
+	if v != nil {
+		_u.SetImageURL(*v)
+	}
+	return _u
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (_u *LinkPreviewUpdateOne) ClearImageURL() *LinkPreviewUpdateOne {
+	_u.mutation.ClearImageURL()
+	return _u
+}
+
+// SetSiteName sets the "site_name" field.
+func (_u *LinkPreviewUpdateOne) SetSiteName(v string) *LinkPreviewUpdateOne {
+	_u.mutation.SetSiteName(v)
+	return _u
+}
+
+// SetNillableSiteName sets the "site_name" field if the given value is not nil.
+func (_u *LinkPreviewUpdateOne) SetNillableSiteName(v *string) *LinkPreviewUpdateOne {
+	if v != nil {
+		_u.SetSiteName(*v)
+	}
+	return _u
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (_u *LinkPreviewUpdateOne) ClearSiteName() *LinkPreviewUpdateOne {
+	_u.mutation.ClearSiteName()
+	return _u
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (_u *LinkPreviewUpdateOne) SetFetchedAt(v time.Time) *LinkPreviewUpdateOne {
+	_u.mutation.SetFetchedAt(v)
+	return _u
+}
+
+// SetNillableFetchedAt sets the "fetched_at" field if the given value is not nil.
+func (_u *LinkPreviewUpdateOne) SetNillableFetchedAt(v *time.Time) *LinkPreviewUpdateOne {
+	if v != nil {
+		_u.SetFetchedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LinkPreviewMutation object of the builder.
+func (_u *LinkPreviewUpdateOne) Mutation() *LinkPreviewMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LinkPreviewUpdate builder.
+func (_u *LinkPreviewUpdateOne) Where(ps ...predicate.LinkPreview) *LinkPreviewUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LinkPreviewUpdateOne) Select(field string, fields ...string) *LinkPreviewUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LinkPreview entity.
+func (_u *LinkPreviewUpdateOne) Save(ctx context.Context) (*LinkPreview, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LinkPreviewUpdateOne) SaveX(ctx context.Context) *LinkPreview {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LinkPreviewUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LinkPreviewUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LinkPreviewUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := linkpreview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LinkPreview.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LinkPreviewUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LinkPreviewUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LinkPreviewUpdateOne) sqlSave(ctx context.Context) (_node *LinkPreview, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(linkpreview.Table, linkpreview.Columns, sqlgraph.NewFieldSpec(linkpreview.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LinkPreview.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, linkpreview.FieldID)
+		for _, f := range fields {
+			if !linkpreview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != linkpreview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(linkpreview.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(linkpreview.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(linkpreview.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(linkpreview.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(linkpreview.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImageURL(); ok {
+		_spec.SetField(linkpreview.FieldImageURL, field.TypeString, value)
+	}
+	if _u.mutation.ImageURLCleared() {
+		_spec.ClearField(linkpreview.FieldImageURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.SiteName(); ok {
+		_spec.SetField(linkpreview.FieldSiteName, field.TypeString, value)
+	}
+	if _u.mutation.SiteNameCleared() {
+		_spec.ClearField(linkpreview.FieldSiteName, field.TypeString)
+	}
+	if value, ok := _u.mutation.FetchedAt(); ok {
+		_spec.SetField(linkpreview.FieldFetchedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LinkPreview{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{linkpreview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
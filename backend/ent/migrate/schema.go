@@ -3,11 +3,148 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// BookmarksColumns holds the columns for the "bookmarks" table.
+	BookmarksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "bookmark_collection_bookmarks", Type: field.TypeUUID, Nullable: true},
+		{Name: "post_bookmarks", Type: field.TypeUUID},
+		{Name: "user_bookmarks", Type: field.TypeUUID},
+	}
+	// BookmarksTable holds the schema information for the "bookmarks" table.
+	BookmarksTable = &schema.Table{
+		Name:       "bookmarks",
+		Columns:    BookmarksColumns,
+		PrimaryKey: []*schema.Column{BookmarksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bookmarks_bookmark_collections_bookmarks",
+				Columns:    []*schema.Column{BookmarksColumns[2]},
+				RefColumns: []*schema.Column{BookmarkCollectionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "bookmarks_posts_bookmarks",
+				Columns:    []*schema.Column{BookmarksColumns[3]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "bookmarks_users_bookmarks",
+				Columns:    []*schema.Column{BookmarksColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bookmark_user_bookmarks_post_bookmarks",
+				Unique:  true,
+				Columns: []*schema.Column{BookmarksColumns[4], BookmarksColumns[3]},
+			},
+			{
+				Name:    "bookmark_created_at_user_bookmarks",
+				Unique:  false,
+				Columns: []*schema.Column{BookmarksColumns[1], BookmarksColumns[4]},
+			},
+		},
+	}
+	// BookmarkCollectionsColumns holds the columns for the "bookmark_collections" table.
+	BookmarkCollectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_bookmark_collections", Type: field.TypeUUID},
+	}
+	// BookmarkCollectionsTable holds the schema information for the "bookmark_collections" table.
+	BookmarkCollectionsTable = &schema.Table{
+		Name:       "bookmark_collections",
+		Columns:    BookmarkCollectionsColumns,
+		PrimaryKey: []*schema.Column{BookmarkCollectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bookmark_collections_users_bookmark_collections",
+				Columns:    []*schema.Column{BookmarkCollectionsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bookmarkcollection_name_user_bookmark_collections",
+				Unique:  true,
+				Columns: []*schema.Column{BookmarkCollectionsColumns[1], BookmarkCollectionsColumns[4]},
+			},
+		},
+	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "content", Type: field.TypeString, Size: 1000},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "hidden_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true},
+		{Name: "comment_replies", Type: field.TypeUUID, Nullable: true},
+		{Name: "post_comments", Type: field.TypeUUID},
+		{Name: "user_comments", Type: field.TypeUUID},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_comments_replies",
+				Columns:    []*schema.Column{CommentsColumns[7]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "comments_posts_comments",
+				Columns:    []*schema.Column{CommentsColumns[8]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comments_users_comments",
+				Columns:    []*schema.Column{CommentsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_created_at_id_post_comments",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[2], CommentsColumns[0], CommentsColumns[8]},
+			},
+			{
+				Name:    "comment_comment_replies",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[7]},
+			},
+			{
+				Name:    "comment_user_comments",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[9]},
+			},
+			{
+				Name:    "comment_content_hash_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[6], CommentsColumns[2]},
+			},
+		},
+	}
 	// GenresColumns holds the columns for the "genres" table.
 	GenresColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -25,8 +162,12 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "deadline", Type: field.TypeTime, Nullable: true},
+		{Name: "habit_days", Type: field.TypeJSON, Nullable: true},
+		{Name: "pinned", Type: field.TypeBool, Default: false},
+		{Name: "position", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "search_vector", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "tsvector", "sqlite3": "text"}},
 		{Name: "user_goals", Type: field.TypeUUID},
 	}
 	// GoalsTable holds the schema information for the "goals" table.
@@ -37,7 +178,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "goals_users_goals",
-				Columns:    []*schema.Column{GoalsColumns[5]},
+				Columns:    []*schema.Column{GoalsColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -46,10 +187,111 @@ var (
 			{
 				Name:    "goal_user_goals",
 				Unique:  false,
-				Columns: []*schema.Column{GoalsColumns[5]},
+				Columns: []*schema.Column{GoalsColumns[9]},
+			},
+			{
+				Name:    "goal_pinned_position_user_goals",
+				Unique:  false,
+				Columns: []*schema.Column{GoalsColumns[4], GoalsColumns[5], GoalsColumns[9]},
+			},
+			{
+				Name:    "goal_search_vector",
+				Unique:  false,
+				Columns: []*schema.Column{GoalsColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "GIN",
+				},
 			},
 		},
 	}
+	// GoalParticipantsColumns holds the columns for the "goal_participants" table.
+	GoalParticipantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "member"}, Default: "member"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"invited", "active"}, Default: "invited"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "joined_at", Type: field.TypeTime, Nullable: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "goal_participants", Type: field.TypeUUID},
+		{Name: "user_goal_participations", Type: field.TypeUUID},
+	}
+	// GoalParticipantsTable holds the schema information for the "goal_participants" table.
+	GoalParticipantsTable = &schema.Table{
+		Name:       "goal_participants",
+		Columns:    GoalParticipantsColumns,
+		PrimaryKey: []*schema.Column{GoalParticipantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "goal_participants_goals_participants",
+				Columns:    []*schema.Column{GoalParticipantsColumns[6]},
+				RefColumns: []*schema.Column{GoalsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "goal_participants_users_goal_participations",
+				Columns:    []*schema.Column{GoalParticipantsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goalparticipant_goal_participants_user_goal_participations",
+				Unique:  true,
+				Columns: []*schema.Column{GoalParticipantsColumns[6], GoalParticipantsColumns[7]},
+			},
+			{
+				Name:    "goalparticipant_user_goal_participations",
+				Unique:  false,
+				Columns: []*schema.Column{GoalParticipantsColumns[7]},
+			},
+		},
+	}
+	// GoalTemplatesColumns holds the columns for the "goal_templates" table.
+	GoalTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "suggested_duration_days", Type: field.TypeInt, Nullable: true},
+		{Name: "milestones", Type: field.TypeJSON, Nullable: true},
+		{Name: "habit_days", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "genre_templates", Type: field.TypeUUID},
+	}
+	// GoalTemplatesTable holds the schema information for the "goal_templates" table.
+	GoalTemplatesTable = &schema.Table{
+		Name:       "goal_templates",
+		Columns:    GoalTemplatesColumns,
+		PrimaryKey: []*schema.Column{GoalTemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "goal_templates_genres_templates",
+				Columns:    []*schema.Column{GoalTemplatesColumns[8]},
+				RefColumns: []*schema.Column{GenresColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goaltemplate_title_genre_templates",
+				Unique:  true,
+				Columns: []*schema.Column{GoalTemplatesColumns[1], GoalTemplatesColumns[8]},
+			},
+		},
+	}
+	// HashtagsColumns holds the columns for the "hashtags" table.
+	HashtagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// HashtagsTable holds the schema information for the "hashtags" table.
+	HashtagsTable = &schema.Table{
+		Name:       "hashtags",
+		Columns:    HashtagsColumns,
+		PrimaryKey: []*schema.Column{HashtagsColumns[0]},
+	}
 	// ImagesColumns holds the columns for the "images" table.
 	ImagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -91,13 +333,239 @@ var (
 			},
 		},
 	}
+	// LinkPreviewsColumns holds the columns for the "link_previews" table.
+	LinkPreviewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "url", Type: field.TypeString, Unique: true, Size: 2048},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"ok", "failed"}},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "image_url", Type: field.TypeString, Nullable: true},
+		{Name: "site_name", Type: field.TypeString, Nullable: true},
+		{Name: "fetched_at", Type: field.TypeTime},
+	}
+	// LinkPreviewsTable holds the schema information for the "link_previews" table.
+	LinkPreviewsTable = &schema.Table{
+		Name:       "link_previews",
+		Columns:    LinkPreviewsColumns,
+		PrimaryKey: []*schema.Column{LinkPreviewsColumns[0]},
+	}
+	// MilestonesColumns holds the columns for the "milestones" table.
+	MilestonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "due_date", Type: field.TypeTime, Nullable: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "goal_milestones", Type: field.TypeUUID},
+	}
+	// MilestonesTable holds the schema information for the "milestones" table.
+	MilestonesTable = &schema.Table{
+		Name:       "milestones",
+		Columns:    MilestonesColumns,
+		PrimaryKey: []*schema.Column{MilestonesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "milestones_goals_milestones",
+				Columns:    []*schema.Column{MilestonesColumns[5]},
+				RefColumns: []*schema.Column{GoalsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "milestone_goal_milestones",
+				Unique:  false,
+				Columns: []*schema.Column{MilestonesColumns[5]},
+			},
+		},
+	}
+	// ModerationActionsColumns holds the columns for the "moderation_actions" table.
+	ModerationActionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"claim", "resolve", "hide", "unhide", "warn", "suspend", "unsuspend"}},
+		{Name: "target_type", Type: field.TypeEnum, Enums: []string{"post", "comment", "user", "report"}},
+		{Name: "target_id", Type: field.TypeUUID},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "suspended_until", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "report_actions", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_moderation_actions", Type: field.TypeUUID, Nullable: true},
+	}
+	// ModerationActionsTable holds the schema information for the "moderation_actions" table.
+	ModerationActionsTable = &schema.Table{
+		Name:       "moderation_actions",
+		Columns:    ModerationActionsColumns,
+		PrimaryKey: []*schema.Column{ModerationActionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "moderation_actions_reports_actions",
+				Columns:    []*schema.Column{ModerationActionsColumns[7]},
+				RefColumns: []*schema.Column{ReportsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "moderation_actions_users_moderation_actions",
+				Columns:    []*schema.Column{ModerationActionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderationaction_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationActionsColumns[6]},
+			},
+			{
+				Name:    "moderationaction_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationActionsColumns[2], ModerationActionsColumns[3]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"mention", "reaction", "follow", "comment", "moderation_warning", "goal_reminder"}},
+		{Name: "actor_count", Type: field.TypeInt, Default: 1},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "goal_notifications", Type: field.TypeUUID, Nullable: true},
+		{Name: "post_notifications", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_notifications", Type: field.TypeUUID},
+		{Name: "user_latest_notifications", Type: field.TypeUUID, Nullable: true},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_goals_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[6]},
+				RefColumns: []*schema.Column{GoalsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notifications_posts_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[7]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notifications_users_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notifications_users_latest_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_updated_at_user_notifications",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[5], NotificationsColumns[8]},
+			},
+			{
+				Name:    "notification_read_at_user_notifications",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[3], NotificationsColumns[8]},
+			},
+			{
+				Name:    "notification_type_user_notifications_post_notifications",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[1], NotificationsColumns[8], NotificationsColumns[7]},
+			},
+		},
+	}
+	// NotificationDeliveriesColumns holds the columns for the "notification_deliveries" table.
+	NotificationDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "delivered_at", Type: field.TypeTime},
+		{Name: "user_notification_deliveries", Type: field.TypeUUID},
+	}
+	// NotificationDeliveriesTable holds the schema information for the "notification_deliveries" table.
+	NotificationDeliveriesTable = &schema.Table{
+		Name:       "notification_deliveries",
+		Columns:    NotificationDeliveriesColumns,
+		PrimaryKey: []*schema.Column{NotificationDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_deliveries_users_notification_deliveries",
+				Columns:    []*schema.Column{NotificationDeliveriesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationdelivery_event_id_user_notification_deliveries",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[1], NotificationDeliveriesColumns[3]},
+			},
+			{
+				Name:    "notificationdelivery_delivered_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[2]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "aggregate_id", Type: field.TypeUUID},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "done", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "dispatched_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[4], OutboxEventsColumns[6]},
+			},
+			{
+				Name:    "outboxevent_status_dispatched_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[4], OutboxEventsColumns[8]},
+			},
+		},
+	}
 	// PostsColumns holds the columns for the "posts" table.
 	PostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "content", Type: field.TypeString, Size: 1000},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"plain", "markdown"}, Default: "plain"},
+		{Name: "amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "edit_count", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "scheduled", "published"}, Default: "published"},
+		{Name: "publish_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "hidden_at", Type: field.TypeTime, Nullable: true},
+		{Name: "search_vector", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "tsvector", "sqlite3": "text"}},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true},
 		{Name: "goal_posts", Type: field.TypeUUID},
+		{Name: "post_quotes", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_posts", Type: field.TypeUUID},
 	}
 	// PostsTable holds the schema information for the "posts" table.
@@ -108,13 +576,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "posts_goals_posts",
-				Columns:    []*schema.Column{PostsColumns[4]},
+				Columns:    []*schema.Column{PostsColumns[12]},
 				RefColumns: []*schema.Column{GoalsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "posts_posts_quotes",
+				Columns:    []*schema.Column{PostsColumns[13]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "posts_users_posts",
-				Columns:    []*schema.Column{PostsColumns[5]},
+				Columns:    []*schema.Column{PostsColumns[14]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -123,23 +597,75 @@ var (
 			{
 				Name:    "post_user_posts",
 				Unique:  false,
-				Columns: []*schema.Column{PostsColumns[5]},
+				Columns: []*schema.Column{PostsColumns[14]},
 			},
 			{
 				Name:    "post_goal_posts",
 				Unique:  false,
-				Columns: []*schema.Column{PostsColumns[4]},
+				Columns: []*schema.Column{PostsColumns[12]},
 			},
 			{
 				Name:    "post_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{PostsColumns[2]},
+				Columns: []*schema.Column{PostsColumns[7]},
+			},
+			{
+				Name:    "post_status_publish_at",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[5], PostsColumns[6]},
+			},
+			{
+				Name:    "post_search_vector",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "GIN",
+				},
+			},
+			{
+				Name:    "post_content_hash_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[11], PostsColumns[7]},
+			},
+		},
+	}
+	// PostRevisionsColumns holds the columns for the "post_revisions" table.
+	PostRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "content", Type: field.TypeString},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"plain", "markdown"}, Default: "plain"},
+		{Name: "amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "image_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "replaced_at", Type: field.TypeTime},
+		{Name: "post_revisions", Type: field.TypeUUID},
+	}
+	// PostRevisionsTable holds the schema information for the "post_revisions" table.
+	PostRevisionsTable = &schema.Table{
+		Name:       "post_revisions",
+		Columns:    PostRevisionsColumns,
+		PrimaryKey: []*schema.Column{PostRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_revisions_posts_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[8]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postrevision_revision_post_revisions",
+				Unique:  true,
+				Columns: []*schema.Column{PostRevisionsColumns[1], PostRevisionsColumns[8]},
 			},
 		},
 	}
 	// ReactionsColumns holds the columns for the "reactions" table.
 	ReactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "kind", Type: field.TypeString, Default: "clap"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "post_reactions", Type: field.TypeUUID},
 		{Name: "user_reactions", Type: field.TypeUUID},
@@ -152,22 +678,27 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "reactions_posts_reactions",
-				Columns:    []*schema.Column{ReactionsColumns[2]},
+				Columns:    []*schema.Column{ReactionsColumns[3]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "reactions_users_reactions",
-				Columns:    []*schema.Column{ReactionsColumns[3]},
+				Columns:    []*schema.Column{ReactionsColumns[4]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "reaction_user_reactions_post_reactions",
+				Name:    "reaction_kind_user_reactions_post_reactions",
 				Unique:  true,
-				Columns: []*schema.Column{ReactionsColumns[3], ReactionsColumns[2]},
+				Columns: []*schema.Column{ReactionsColumns[1], ReactionsColumns[4], ReactionsColumns[3]},
+			},
+			{
+				Name:    "reaction_kind_post_reactions",
+				Unique:  false,
+				Columns: []*schema.Column{ReactionsColumns[1], ReactionsColumns[3]},
 			},
 		},
 	}
@@ -211,15 +742,205 @@ var (
 			},
 		},
 	}
+	// ReminderLogsColumns holds the columns for the "reminder_logs" table.
+	ReminderLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "threshold", Type: field.TypeEnum, Enums: []string{"week", "day", "today", "overdue"}},
+		{Name: "sent_at", Type: field.TypeTime},
+		{Name: "goal_reminder_logs", Type: field.TypeUUID},
+	}
+	// ReminderLogsTable holds the schema information for the "reminder_logs" table.
+	ReminderLogsTable = &schema.Table{
+		Name:       "reminder_logs",
+		Columns:    ReminderLogsColumns,
+		PrimaryKey: []*schema.Column{ReminderLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reminder_logs_goals_reminder_logs",
+				Columns:    []*schema.Column{ReminderLogsColumns[3]},
+				RefColumns: []*schema.Column{GoalsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reminderlog_threshold_goal_reminder_logs",
+				Unique:  true,
+				Columns: []*schema.Column{ReminderLogsColumns[1], ReminderLogsColumns[3]},
+			},
+		},
+	}
+	// ReportsColumns holds the columns for the "reports" table.
+	ReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "target_type", Type: field.TypeEnum, Enums: []string{"post", "comment", "user"}},
+		{Name: "target_id", Type: field.TypeUUID},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"user", "filter"}, Default: "user"},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"spam", "harassment", "hate", "violence", "sexual", "misinformation", "other"}},
+		{Name: "text", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "claimed", "resolved"}, Default: "open"},
+		{Name: "resolution", Type: field.TypeEnum, Nullable: true, Enums: []string{"action_taken", "dismissed"}},
+		{Name: "resolution_note", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "claimed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_reports", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_claimed_reports", Type: field.TypeUUID, Nullable: true},
+	}
+	// ReportsTable holds the schema information for the "reports" table.
+	ReportsTable = &schema.Table{
+		Name:       "reports",
+		Columns:    ReportsColumns,
+		PrimaryKey: []*schema.Column{ReportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reports_users_reports",
+				Columns:    []*schema.Column{ReportsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "reports_users_claimed_reports",
+				Columns:    []*schema.Column{ReportsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "report_target_type_target_id_user_reports",
+				Unique:  true,
+				Columns: []*schema.Column{ReportsColumns[1], ReportsColumns[2], ReportsColumns[12]},
+			},
+			{
+				Name:    "report_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[6], ReportsColumns[9]},
+			},
+		},
+	}
+	// RepostsColumns holds the columns for the "reposts" table.
+	RepostsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_reposts", Type: field.TypeUUID},
+		{Name: "user_reposts", Type: field.TypeUUID},
+	}
+	// RepostsTable holds the schema information for the "reposts" table.
+	RepostsTable = &schema.Table{
+		Name:       "reposts",
+		Columns:    RepostsColumns,
+		PrimaryKey: []*schema.Column{RepostsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reposts_posts_reposts",
+				Columns:    []*schema.Column{RepostsColumns[2]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "reposts_users_reposts",
+				Columns:    []*schema.Column{RepostsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "repost_user_reposts_post_reposts",
+				Unique:  true,
+				Columns: []*schema.Column{RepostsColumns[3], RepostsColumns[2]},
+			},
+			{
+				Name:    "repost_post_reposts",
+				Unique:  false,
+				Columns: []*schema.Column{RepostsColumns[2]},
+			},
+			{
+				Name:    "repost_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RepostsColumns[1]},
+			},
+		},
+	}
+	// TimelineEntriesColumns holds the columns for the "timeline_entries" table.
+	TimelineEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_timeline_entries", Type: field.TypeUUID},
+		{Name: "repost_timeline_entries", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_timeline_entries", Type: field.TypeUUID},
+		{Name: "user_timeline_activities", Type: field.TypeUUID},
+	}
+	// TimelineEntriesTable holds the schema information for the "timeline_entries" table.
+	TimelineEntriesTable = &schema.Table{
+		Name:       "timeline_entries",
+		Columns:    TimelineEntriesColumns,
+		PrimaryKey: []*schema.Column{TimelineEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "timeline_entries_posts_timeline_entries",
+				Columns:    []*schema.Column{TimelineEntriesColumns[3]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "timeline_entries_reposts_timeline_entries",
+				Columns:    []*schema.Column{TimelineEntriesColumns[4]},
+				RefColumns: []*schema.Column{RepostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "timeline_entries_users_timeline_entries",
+				Columns:    []*schema.Column{TimelineEntriesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "timeline_entries_users_timeline_activities",
+				Columns:    []*schema.Column{TimelineEntriesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "timelineentry_user_timeline_entries_post_timeline_entries_user_timeline_activities",
+				Unique:  true,
+				Columns: []*schema.Column{TimelineEntriesColumns[5], TimelineEntriesColumns[3], TimelineEntriesColumns[6]},
+			},
+			{
+				Name:    "timelineentry_at_user_timeline_entries",
+				Unique:  false,
+				Columns: []*schema.Column{TimelineEntriesColumns[1], TimelineEntriesColumns[5]},
+			},
+			{
+				Name:    "timelineentry_user_timeline_entries_user_timeline_activities",
+				Unique:  false,
+				Columns: []*schema.Column{TimelineEntriesColumns[5], TimelineEntriesColumns[6]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "handle", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "birthday", Type: field.TypeTime, Nullable: true},
 		{Name: "hometown", Type: field.TypeString, Nullable: true},
 		{Name: "bio", Type: field.TypeString, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "moderator", "admin"}, Default: "user"},
 		{Name: "profile_picture_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "time_zone", Type: field.TypeString, Default: "Asia/Tokyo"},
+		{Name: "quiet_hours_start", Type: field.TypeInt, Nullable: true},
+		{Name: "quiet_hours_end", Type: field.TypeInt, Nullable: true},
+		{Name: "hidden_at", Type: field.TypeTime, Nullable: true},
+		{Name: "suspended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "suspended_until", Type: field.TypeTime, Nullable: true},
+		{Name: "timeline_horizon", Type: field.TypeTime, Nullable: true},
+		{Name: "notification_opt_outs", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -229,6 +950,56 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// PostMentionsColumns holds the columns for the "post_mentions" table.
+	PostMentionsColumns = []*schema.Column{
+		{Name: "post_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// PostMentionsTable holds the schema information for the "post_mentions" table.
+	PostMentionsTable = &schema.Table{
+		Name:       "post_mentions",
+		Columns:    PostMentionsColumns,
+		PrimaryKey: []*schema.Column{PostMentionsColumns[0], PostMentionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_mentions_post_id",
+				Columns:    []*schema.Column{PostMentionsColumns[0]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "post_mentions_user_id",
+				Columns:    []*schema.Column{PostMentionsColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PostHashtagsColumns holds the columns for the "post_hashtags" table.
+	PostHashtagsColumns = []*schema.Column{
+		{Name: "post_id", Type: field.TypeUUID},
+		{Name: "hashtag_id", Type: field.TypeUUID},
+	}
+	// PostHashtagsTable holds the schema information for the "post_hashtags" table.
+	PostHashtagsTable = &schema.Table{
+		Name:       "post_hashtags",
+		Columns:    PostHashtagsColumns,
+		PrimaryKey: []*schema.Column{PostHashtagsColumns[0], PostHashtagsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_hashtags_post_id",
+				Columns:    []*schema.Column{PostHashtagsColumns[0]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "post_hashtags_hashtag_id",
+				Columns:    []*schema.Column{PostHashtagsColumns[1]},
+				RefColumns: []*schema.Column{HashtagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// UserGenresColumns holds the columns for the "user_genres" table.
 	UserGenresColumns = []*schema.Column{
 		{Name: "user_id", Type: field.TypeUUID},
@@ -254,6 +1025,31 @@ var (
 			},
 		},
 	}
+	// UserActedNotificationsColumns holds the columns for the "user_acted_notifications" table.
+	UserActedNotificationsColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "notification_id", Type: field.TypeUUID},
+	}
+	// UserActedNotificationsTable holds the schema information for the "user_acted_notifications" table.
+	UserActedNotificationsTable = &schema.Table{
+		Name:       "user_acted_notifications",
+		Columns:    UserActedNotificationsColumns,
+		PrimaryKey: []*schema.Column{UserActedNotificationsColumns[0], UserActedNotificationsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_acted_notifications_user_id",
+				Columns:    []*schema.Column{UserActedNotificationsColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_acted_notifications_notification_id",
+				Columns:    []*schema.Column{UserActedNotificationsColumns[1]},
+				RefColumns: []*schema.Column{NotificationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// UserFollowingColumns holds the columns for the "user_following" table.
 	UserFollowingColumns = []*schema.Column{
 		{Name: "user_id", Type: field.TypeUUID},
@@ -279,31 +1075,114 @@ var (
 			},
 		},
 	}
+	// UserBlockingColumns holds the columns for the "user_blocking" table.
+	UserBlockingColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "blocked_by_id", Type: field.TypeUUID},
+	}
+	// UserBlockingTable holds the schema information for the "user_blocking" table.
+	UserBlockingTable = &schema.Table{
+		Name:       "user_blocking",
+		Columns:    UserBlockingColumns,
+		PrimaryKey: []*schema.Column{UserBlockingColumns[0], UserBlockingColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_blocking_user_id",
+				Columns:    []*schema.Column{UserBlockingColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_blocking_blocked_by_id",
+				Columns:    []*schema.Column{UserBlockingColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BookmarksTable,
+		BookmarkCollectionsTable,
+		CommentsTable,
 		GenresTable,
 		GoalsTable,
+		GoalParticipantsTable,
+		GoalTemplatesTable,
+		HashtagsTable,
 		ImagesTable,
+		LinkPreviewsTable,
+		MilestonesTable,
+		ModerationActionsTable,
+		NotificationsTable,
+		NotificationDeliveriesTable,
+		OutboxEventsTable,
 		PostsTable,
+		PostRevisionsTable,
 		ReactionsTable,
 		RefreshTokensTable,
+		ReminderLogsTable,
+		ReportsTable,
+		RepostsTable,
+		TimelineEntriesTable,
 		UsersTable,
+		PostMentionsTable,
+		PostHashtagsTable,
 		UserGenresTable,
+		UserActedNotificationsTable,
 		UserFollowingTable,
+		UserBlockingTable,
 	}
 )
 
 func init() {
+	BookmarksTable.ForeignKeys[0].RefTable = BookmarkCollectionsTable
+	BookmarksTable.ForeignKeys[1].RefTable = PostsTable
+	BookmarksTable.ForeignKeys[2].RefTable = UsersTable
+	BookmarkCollectionsTable.ForeignKeys[0].RefTable = UsersTable
+	CommentsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentsTable.ForeignKeys[1].RefTable = PostsTable
+	CommentsTable.ForeignKeys[2].RefTable = UsersTable
 	GoalsTable.ForeignKeys[0].RefTable = UsersTable
+	GoalParticipantsTable.ForeignKeys[0].RefTable = GoalsTable
+	GoalParticipantsTable.ForeignKeys[1].RefTable = UsersTable
+	GoalTemplatesTable.ForeignKeys[0].RefTable = GenresTable
 	ImagesTable.ForeignKeys[0].RefTable = PostsTable
 	ImagesTable.ForeignKeys[1].RefTable = UsersTable
+	MilestonesTable.ForeignKeys[0].RefTable = GoalsTable
+	ModerationActionsTable.ForeignKeys[0].RefTable = ReportsTable
+	ModerationActionsTable.ForeignKeys[1].RefTable = UsersTable
+	NotificationsTable.ForeignKeys[0].RefTable = GoalsTable
+	NotificationsTable.ForeignKeys[1].RefTable = PostsTable
+	NotificationsTable.ForeignKeys[2].RefTable = UsersTable
+	NotificationsTable.ForeignKeys[3].RefTable = UsersTable
+	NotificationDeliveriesTable.ForeignKeys[0].RefTable = UsersTable
 	PostsTable.ForeignKeys[0].RefTable = GoalsTable
-	PostsTable.ForeignKeys[1].RefTable = UsersTable
+	PostsTable.ForeignKeys[1].RefTable = PostsTable
+	PostsTable.ForeignKeys[2].RefTable = UsersTable
+	PostRevisionsTable.ForeignKeys[0].RefTable = PostsTable
 	ReactionsTable.ForeignKeys[0].RefTable = PostsTable
 	ReactionsTable.ForeignKeys[1].RefTable = UsersTable
 	RefreshTokensTable.ForeignKeys[0].RefTable = UsersTable
+	ReminderLogsTable.ForeignKeys[0].RefTable = GoalsTable
+	ReportsTable.ForeignKeys[0].RefTable = UsersTable
+	ReportsTable.ForeignKeys[1].RefTable = UsersTable
+	RepostsTable.ForeignKeys[0].RefTable = PostsTable
+	RepostsTable.ForeignKeys[1].RefTable = UsersTable
+	TimelineEntriesTable.ForeignKeys[0].RefTable = PostsTable
+	TimelineEntriesTable.ForeignKeys[1].RefTable = RepostsTable
+	TimelineEntriesTable.ForeignKeys[2].RefTable = UsersTable
+	TimelineEntriesTable.ForeignKeys[3].RefTable = UsersTable
+	PostMentionsTable.ForeignKeys[0].RefTable = PostsTable
+	PostMentionsTable.ForeignKeys[1].RefTable = UsersTable
+	PostHashtagsTable.ForeignKeys[0].RefTable = PostsTable
+	PostHashtagsTable.ForeignKeys[1].RefTable = HashtagsTable
 	UserGenresTable.ForeignKeys[0].RefTable = UsersTable
 	UserGenresTable.ForeignKeys[1].RefTable = GenresTable
+	UserActedNotificationsTable.ForeignKeys[0].RefTable = UsersTable
+	UserActedNotificationsTable.ForeignKeys[1].RefTable = NotificationsTable
 	UserFollowingTable.ForeignKeys[0].RefTable = UsersTable
 	UserFollowingTable.ForeignKeys[1].RefTable = UsersTable
+	UserBlockingTable.ForeignKeys[0].RefTable = UsersTable
+	UserBlockingTable.ForeignKeys[1].RefTable = UsersTable
 }
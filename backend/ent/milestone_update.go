@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/goal"
+	"backend/ent/milestone"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MilestoneUpdate is the builder for updating Milestone entities.
+type MilestoneUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MilestoneMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MilestoneUpdate builder.
+func (_u *MilestoneUpdate) Where(ps ...predicate.Milestone) *MilestoneUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *MilestoneUpdate) SetTitle(v string) *MilestoneUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *MilestoneUpdate) SetNillableTitle(v *string) *MilestoneUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *MilestoneUpdate) SetDueDate(v time.Time) *MilestoneUpdate {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *MilestoneUpdate) SetNillableDueDate(v *time.Time) *MilestoneUpdate {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (_u *MilestoneUpdate) ClearDueDate() *MilestoneUpdate {
+	_u.mutation.ClearDueDate()
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *MilestoneUpdate) SetPosition(v int) *MilestoneUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *MilestoneUpdate) SetNillablePosition(v *int) *MilestoneUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *MilestoneUpdate) AddPosition(v int) *MilestoneUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by ID.
+func (_u *MilestoneUpdate) SetGoalID(id uuid.UUID) *MilestoneUpdate {
+	_u.mutation.SetGoalID(id)
+	return _u
+}
+
+// SetGoal sets the "goal" edge to the Goal entity.
+func (_u *MilestoneUpdate) SetGoal(v *Goal) *MilestoneUpdate {
+	return _u.SetGoalID(v.ID)
+}
+
+// Mutation returns the MilestoneMutation object of the builder.
+func (_u *MilestoneUpdate) Mutation() *MilestoneMutation {
+	return _u.mutation
+}
+
+// ClearGoal clears the "goal" edge to the Goal entity.
+func (_u *MilestoneUpdate) ClearGoal() *MilestoneUpdate {
+	_u.mutation.ClearGoal()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MilestoneUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MilestoneUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MilestoneUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MilestoneUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MilestoneUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := milestone.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Milestone.title": %w`, err)}
+		}
+	}
+	if _u.mutation.GoalCleared() && len(_u.mutation.GoalIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Milestone.goal"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MilestoneUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MilestoneUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MilestoneUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(milestone.Table, milestone.Columns, sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(milestone.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(milestone.FieldDueDate, field.TypeTime, value)
+	}
+	if _u.mutation.DueDateCleared() {
+		_spec.ClearField(milestone.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(milestone.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(milestone.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.GoalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.GoalTable,
+			Columns: []string{milestone.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.GoalTable,
+			Columns: []string{milestone.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{milestone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MilestoneUpdateOne is the builder for updating a single Milestone entity.
+type MilestoneUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MilestoneMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTitle sets the "title" field.
+func (_u *MilestoneUpdateOne) SetTitle(v string) *MilestoneUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *MilestoneUpdateOne) SetNillableTitle(v *string) *MilestoneUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDueDate sets the "due_date" field.
+func (_u *MilestoneUpdateOne) SetDueDate(v time.Time) *MilestoneUpdateOne {
+	_u.mutation.SetDueDate(v)
+	return _u
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (_u *MilestoneUpdateOne) SetNillableDueDate(v *time.Time) *MilestoneUpdateOne {
+	if v != nil {
+		_u.SetDueDate(*v)
+	}
+	return _u
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (_u *MilestoneUpdateOne) ClearDueDate() *MilestoneUpdateOne {
+	_u.mutation.ClearDueDate()
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *MilestoneUpdateOne) SetPosition(v int) *MilestoneUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *MilestoneUpdateOne) SetNillablePosition(v *int) *MilestoneUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *MilestoneUpdateOne) AddPosition(v int) *MilestoneUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by ID.
+func (_u *MilestoneUpdateOne) SetGoalID(id uuid.UUID) *MilestoneUpdateOne {
+	_u.mutation.SetGoalID(id)
+	return _u
+}
+
+// SetGoal sets the "goal" edge to the Goal entity.
+func (_u *MilestoneUpdateOne) SetGoal(v *Goal) *MilestoneUpdateOne {
+	return _u.SetGoalID(v.ID)
+}
+
+// Mutation returns the MilestoneMutation object of the builder.
+func (_u *MilestoneUpdateOne) Mutation() *MilestoneMutation {
+	return _u.mutation
+}
+
+// ClearGoal clears the "goal" edge to the Goal entity.
+func (_u *MilestoneUpdateOne) ClearGoal() *MilestoneUpdateOne {
+	_u.mutation.ClearGoal()
+	return _u
+}
+
+// Where appends a list predicates to the MilestoneUpdate builder.
+func (_u *MilestoneUpdateOne) Where(ps ...predicate.Milestone) *MilestoneUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MilestoneUpdateOne) Select(field string, fields ...string) *MilestoneUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Milestone entity.
+func (_u *MilestoneUpdateOne) Save(ctx context.Context) (*Milestone, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MilestoneUpdateOne) SaveX(ctx context.Context) *Milestone {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MilestoneUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MilestoneUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MilestoneUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := milestone.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Milestone.title": %w`, err)}
+		}
+	}
+	if _u.mutation.GoalCleared() && len(_u.mutation.GoalIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Milestone.goal"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MilestoneUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MilestoneUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MilestoneUpdateOne) sqlSave(ctx context.Context) (_node *Milestone, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(milestone.Table, milestone.Columns, sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Milestone.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, milestone.FieldID)
+		for _, f := range fields {
+			if !milestone.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != milestone.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(milestone.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DueDate(); ok {
+		_spec.SetField(milestone.FieldDueDate, field.TypeTime, value)
+	}
+	if _u.mutation.DueDateCleared() {
+		_spec.ClearField(milestone.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(milestone.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(milestone.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.GoalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.GoalTable,
+			Columns: []string{milestone.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.GoalTable,
+			Columns: []string{milestone.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Milestone{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{milestone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
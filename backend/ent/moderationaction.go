@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/moderationaction"
+	"backend/ent/report"
+	"backend/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ModerationAction is the model entity for the ModerationAction schema.
+type ModerationAction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action moderationaction.Action `json:"action,omitempty"`
+	// TargetType holds the value of the "target_type" field.
+	TargetType moderationaction.TargetType `json:"target_type,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID uuid.UUID `json:"target_id,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// SuspendedUntil holds the value of the "suspended_until" field.
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ModerationActionQuery when eager-loading is set.
+	Edges                   ModerationActionEdges `json:"edges"`
+	report_actions          *uuid.UUID
+	user_moderation_actions *uuid.UUID
+	selectValues            sql.SelectValues
+}
+
+// ModerationActionEdges holds the relations/edges for other nodes in the graph.
+type ModerationActionEdges struct {
+	// Moderator holds the value of the moderator edge.
+	Moderator *User `json:"moderator,omitempty"`
+	// Report holds the value of the report edge.
+	Report *Report `json:"report,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ModeratorOrErr returns the Moderator value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ModerationActionEdges) ModeratorOrErr() (*User, error) {
+	if e.Moderator != nil {
+		return e.Moderator, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "moderator"}
+}
+
+// ReportOrErr returns the Report value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ModerationActionEdges) ReportOrErr() (*Report, error) {
+	if e.Report != nil {
+		return e.Report, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: report.Label}
+	}
+	return nil, &NotLoadedError{edge: "report"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModerationAction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case moderationaction.FieldAction, moderationaction.FieldTargetType, moderationaction.FieldNote:
+			values[i] = new(sql.NullString)
+		case moderationaction.FieldSuspendedUntil, moderationaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case moderationaction.FieldID, moderationaction.FieldTargetID:
+			values[i] = new(uuid.UUID)
+		case moderationaction.ForeignKeys[0]: // report_actions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case moderationaction.ForeignKeys[1]: // user_moderation_actions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModerationAction fields.
+func (_m *ModerationAction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case moderationaction.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case moderationaction.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = moderationaction.Action(value.String)
+			}
+		case moderationaction.FieldTargetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_type", values[i])
+			} else if value.Valid {
+				_m.TargetType = moderationaction.TargetType(value.String)
+			}
+		case moderationaction.FieldTargetID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value != nil {
+				_m.TargetID = *value
+			}
+		case moderationaction.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case moderationaction.FieldSuspendedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_until", values[i])
+			} else if value.Valid {
+				_m.SuspendedUntil = new(time.Time)
+				*_m.SuspendedUntil = value.Time
+			}
+		case moderationaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case moderationaction.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field report_actions", values[i])
+			} else if value.Valid {
+				_m.report_actions = new(uuid.UUID)
+				*_m.report_actions = *value.S.(*uuid.UUID)
+			}
+		case moderationaction.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_moderation_actions", values[i])
+			} else if value.Valid {
+				_m.user_moderation_actions = new(uuid.UUID)
+				*_m.user_moderation_actions = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModerationAction.
+// This includes values selected through modifiers, order, etc.
+func (_m *ModerationAction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryModerator queries the "moderator" edge of the ModerationAction entity.
+func (_m *ModerationAction) QueryModerator() *UserQuery {
+	return NewModerationActionClient(_m.config).QueryModerator(_m)
+}
+
+// QueryReport queries the "report" edge of the ModerationAction entity.
+func (_m *ModerationAction) QueryReport() *ReportQuery {
+	return NewModerationActionClient(_m.config).QueryReport(_m)
+}
+
+// Update returns a builder for updating this ModerationAction.
+// Note that you need to call ModerationAction.Unwrap() before calling this method if this ModerationAction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ModerationAction) Update() *ModerationActionUpdateOne {
+	return NewModerationActionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ModerationAction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ModerationAction) Unwrap() *ModerationAction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ModerationAction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ModerationAction) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModerationAction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("target_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetType))
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	if v := _m.SuspendedUntil; v != nil {
+		builder.WriteString("suspended_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModerationActions is a parsable slice of ModerationAction.
+type ModerationActions []*ModerationAction
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderationaction
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the moderationaction type in the database.
+	Label = "moderation_action"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldTargetType holds the string denoting the target_type field in the database.
+	FieldTargetType = "target_type"
+	// FieldTargetID holds the string denoting the target_id field in the database.
+	FieldTargetID = "target_id"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldSuspendedUntil holds the string denoting the suspended_until field in the database.
+	FieldSuspendedUntil = "suspended_until"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeModerator holds the string denoting the moderator edge name in mutations.
+	EdgeModerator = "moderator"
+	// EdgeReport holds the string denoting the report edge name in mutations.
+	EdgeReport = "report"
+	// Table holds the table name of the moderationaction in the database.
+	Table = "moderation_actions"
+	// ModeratorTable is the table that holds the moderator relation/edge.
+	ModeratorTable = "moderation_actions"
+	// ModeratorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ModeratorInverseTable = "users"
+	// ModeratorColumn is the table column denoting the moderator relation/edge.
+	ModeratorColumn = "user_moderation_actions"
+	// ReportTable is the table that holds the report relation/edge.
+	ReportTable = "moderation_actions"
+	// ReportInverseTable is the table name for the Report entity.
+	// It exists in this package in order to avoid circular dependency with the "report" package.
+	ReportInverseTable = "reports"
+	// ReportColumn is the table column denoting the report relation/edge.
+	ReportColumn = "report_actions"
+)
+
+// Columns holds all SQL columns for moderationaction fields.
+var Columns = []string{
+	FieldID,
+	FieldAction,
+	FieldTargetType,
+	FieldTargetID,
+	FieldNote,
+	FieldSuspendedUntil,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "moderation_actions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"report_actions",
+	"user_moderation_actions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	NoteValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionClaim     Action = "claim"
+	ActionResolve   Action = "resolve"
+	ActionHide      Action = "hide"
+	ActionUnhide    Action = "unhide"
+	ActionWarn      Action = "warn"
+	ActionSuspend   Action = "suspend"
+	ActionUnsuspend Action = "unsuspend"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionClaim, ActionResolve, ActionHide, ActionUnhide, ActionWarn, ActionSuspend, ActionUnsuspend:
+		return nil
+	default:
+		return fmt.Errorf("moderationaction: invalid enum value for action field: %q", a)
+	}
+}
+
+// TargetType defines the type for the "target_type" enum field.
+type TargetType string
+
+// TargetType values.
+const (
+	TargetTypePost    TargetType = "post"
+	TargetTypeComment TargetType = "comment"
+	TargetTypeUser    TargetType = "user"
+	TargetTypeReport  TargetType = "report"
+)
+
+func (tt TargetType) String() string {
+	return string(tt)
+}
+
+// TargetTypeValidator is a validator for the "target_type" field enum values. It is called by the builders before save.
+func TargetTypeValidator(tt TargetType) error {
+	switch tt {
+	case TargetTypePost, TargetTypeComment, TargetTypeUser, TargetTypeReport:
+		return nil
+	default:
+		return fmt.Errorf("moderationaction: invalid enum value for target_type field: %q", tt)
+	}
+}
+
+// OrderOption defines the ordering options for the ModerationAction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByTargetType orders the results by the target_type field.
+func ByTargetType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetType, opts...).ToFunc()
+}
+
+// ByTargetID orders the results by the target_id field.
+func ByTargetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetID, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// BySuspendedUntil orders the results by the suspended_until field.
+func BySuspendedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedUntil, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByModeratorField orders the results by moderator field.
+func ByModeratorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModeratorStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReportField orders the results by report field.
+func ByReportField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newModeratorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModeratorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ModeratorTable, ModeratorColumn),
+	)
+}
+func newReportStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReportTable, ReportColumn),
+	)
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/moderationaction"
+	"backend/ent/report"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ModerationActionCreate is the builder for creating a ModerationAction entity.
+type ModerationActionCreate struct {
+	config
+	mutation *ModerationActionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAction sets the "action" field.
+func (_c *ModerationActionCreate) SetAction(v moderationaction.Action) *ModerationActionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetTargetType sets the "target_type" field.
+func (_c *ModerationActionCreate) SetTargetType(v moderationaction.TargetType) *ModerationActionCreate {
+	_c.mutation.SetTargetType(v)
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *ModerationActionCreate) SetTargetID(v uuid.UUID) *ModerationActionCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *ModerationActionCreate) SetNote(v string) *ModerationActionCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *ModerationActionCreate) SetNillableNote(v *string) *ModerationActionCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (_c *ModerationActionCreate) SetSuspendedUntil(v time.Time) *ModerationActionCreate {
+	_c.mutation.SetSuspendedUntil(v)
+	return _c
+}
+
+// SetNillableSuspendedUntil sets the "suspended_until" field if the given value is not nil.
+func (_c *ModerationActionCreate) SetNillableSuspendedUntil(v *time.Time) *ModerationActionCreate {
+	if v != nil {
+		_c.SetSuspendedUntil(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ModerationActionCreate) SetCreatedAt(v time.Time) *ModerationActionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ModerationActionCreate) SetNillableCreatedAt(v *time.Time) *ModerationActionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ModerationActionCreate) SetID(v uuid.UUID) *ModerationActionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ModerationActionCreate) SetNillableID(v *uuid.UUID) *ModerationActionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetModeratorID sets the "moderator" edge to the User entity by ID.
+func (_c *ModerationActionCreate) SetModeratorID(id uuid.UUID) *ModerationActionCreate {
+	_c.mutation.SetModeratorID(id)
+	return _c
+}
+
+// SetNillableModeratorID sets the "moderator" edge to the User entity by ID if the given value is not nil.
+func (_c *ModerationActionCreate) SetNillableModeratorID(id *uuid.UUID) *ModerationActionCreate {
+	if id != nil {
+		_c = _c.SetModeratorID(*id)
+	}
+	return _c
+}
+
+// SetModerator sets the "moderator" edge to the User entity.
+func (_c *ModerationActionCreate) SetModerator(v *User) *ModerationActionCreate {
+	return _c.SetModeratorID(v.ID)
+}
+
+// SetReportID sets the "report" edge to the Report entity by ID.
+func (_c *ModerationActionCreate) SetReportID(id uuid.UUID) *ModerationActionCreate {
+	_c.mutation.SetReportID(id)
+	return _c
+}
+
+// SetNillableReportID sets the "report" edge to the Report entity by ID if the given value is not nil.
+func (_c *ModerationActionCreate) SetNillableReportID(id *uuid.UUID) *ModerationActionCreate {
+	if id != nil {
+		_c = _c.SetReportID(*id)
+	}
+	return _c
+}
+
+// SetReport sets the "report" edge to the Report entity.
+func (_c *ModerationActionCreate) SetReport(v *Report) *ModerationActionCreate {
+	return _c.SetReportID(v.ID)
+}
+
+// Mutation returns the ModerationActionMutation object of the builder.
+func (_c *ModerationActionCreate) Mutation() *ModerationActionMutation {
+	return _c.mutation
+}
+
+// Save creates the ModerationAction in the database.
+func (_c *ModerationActionCreate) Save(ctx context.Context) (*ModerationAction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ModerationActionCreate) SaveX(ctx context.Context) *ModerationAction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationActionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationActionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ModerationActionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := moderationaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := moderationaction.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ModerationActionCreate) check() error {
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ModerationAction.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := moderationaction.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ModerationAction.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "ModerationAction.target_type"`)}
+	}
+	if v, ok := _c.mutation.TargetType(); ok {
+		if err := moderationaction.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "ModerationAction.target_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "ModerationAction.target_id"`)}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := moderationaction.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "ModerationAction.note": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ModerationAction.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ModerationActionCreate) sqlSave(ctx context.Context) (*ModerationAction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ModerationActionCreate) createSpec() (*ModerationAction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationAction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(moderationaction.Table, sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(moderationaction.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.TargetType(); ok {
+		_spec.SetField(moderationaction.FieldTargetType, field.TypeEnum, value)
+		_node.TargetType = value
+	}
+	if value, ok := _c.mutation.TargetID(); ok {
+		_spec.SetField(moderationaction.FieldTargetID, field.TypeUUID, value)
+		_node.TargetID = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(moderationaction.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.SuspendedUntil(); ok {
+		_spec.SetField(moderationaction.FieldSuspendedUntil, field.TypeTime, value)
+		_node.SuspendedUntil = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ModeratorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationaction.ModeratorTable,
+			Columns: []string{moderationaction.ModeratorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_moderation_actions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationaction.ReportTable,
+			Columns: []string{moderationaction.ReportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.report_actions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationAction.Create().
+//		SetAction(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationActionUpsert) {
+//			SetAction(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ModerationActionCreate) OnConflict(opts ...sql.ConflictOption) *ModerationActionUpsertOne {
+	_c.conflict = opts
+	return &ModerationActionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ModerationActionCreate) OnConflictColumns(columns ...string) *ModerationActionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ModerationActionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ModerationActionUpsertOne is the builder for "upsert"-ing
+	//  one ModerationAction node.
+	ModerationActionUpsertOne struct {
+		create *ModerationActionCreate
+	}
+
+	// ModerationActionUpsert is the "OnConflict" setter.
+	ModerationActionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(moderationaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModerationActionUpsertOne) UpdateNewValues() *ModerationActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(moderationaction.FieldID)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(moderationaction.FieldAction)
+		}
+		if _, exists := u.create.mutation.TargetType(); exists {
+			s.SetIgnore(moderationaction.FieldTargetType)
+		}
+		if _, exists := u.create.mutation.TargetID(); exists {
+			s.SetIgnore(moderationaction.FieldTargetID)
+		}
+		if _, exists := u.create.mutation.Note(); exists {
+			s.SetIgnore(moderationaction.FieldNote)
+		}
+		if _, exists := u.create.mutation.SuspendedUntil(); exists {
+			s.SetIgnore(moderationaction.FieldSuspendedUntil)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(moderationaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModerationActionUpsertOne) Ignore() *ModerationActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationActionUpsertOne) DoNothing() *ModerationActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationActionCreate.OnConflict
+// documentation for more info.
+func (u *ModerationActionUpsertOne) Update(set func(*ModerationActionUpsert)) *ModerationActionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationActionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ModerationActionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationActionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationActionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModerationActionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ModerationActionUpsertOne.ID is not supported by MySQL driver. Use ModerationActionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModerationActionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ModerationActionCreateBulk is the builder for creating many ModerationAction entities in bulk.
+type ModerationActionCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationActionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ModerationAction entities in the database.
+func (_c *ModerationActionCreateBulk) Save(ctx context.Context) ([]*ModerationAction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ModerationAction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationActionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ModerationActionCreateBulk) SaveX(ctx context.Context) []*ModerationAction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationActionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationActionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationAction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationActionUpsert) {
+//			SetAction(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ModerationActionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModerationActionUpsertBulk {
+	_c.conflict = opts
+	return &ModerationActionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ModerationActionCreateBulk) OnConflictColumns(columns ...string) *ModerationActionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ModerationActionUpsertBulk{
+		create: _c,
+	}
+}
+
+// ModerationActionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ModerationAction nodes.
+type ModerationActionUpsertBulk struct {
+	create *ModerationActionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(moderationaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModerationActionUpsertBulk) UpdateNewValues() *ModerationActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(moderationaction.FieldID)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(moderationaction.FieldAction)
+			}
+			if _, exists := b.mutation.TargetType(); exists {
+				s.SetIgnore(moderationaction.FieldTargetType)
+			}
+			if _, exists := b.mutation.TargetID(); exists {
+				s.SetIgnore(moderationaction.FieldTargetID)
+			}
+			if _, exists := b.mutation.Note(); exists {
+				s.SetIgnore(moderationaction.FieldNote)
+			}
+			if _, exists := b.mutation.SuspendedUntil(); exists {
+				s.SetIgnore(moderationaction.FieldSuspendedUntil)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(moderationaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationAction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModerationActionUpsertBulk) Ignore() *ModerationActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationActionUpsertBulk) DoNothing() *ModerationActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationActionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModerationActionUpsertBulk) Update(set func(*ModerationActionUpsert)) *ModerationActionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationActionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ModerationActionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ModerationActionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationActionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationActionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
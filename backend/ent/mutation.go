@@ -3,15 +3,34 @@
 package ent
 
 import (
+	"backend/ent/bookmark"
+	"backend/ent/bookmarkcollection"
+	"backend/ent/comment"
 	"backend/ent/genre"
 	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/goaltemplate"
+	"backend/ent/hashtag"
 	"backend/ent/image"
+	"backend/ent/linkpreview"
+	"backend/ent/milestone"
+	"backend/ent/moderationaction"
+	"backend/ent/notification"
+	"backend/ent/notificationdelivery"
+	"backend/ent/outboxevent"
 	"backend/ent/post"
+	"backend/ent/postrevision"
 	"backend/ent/predicate"
 	"backend/ent/reaction"
 	"backend/ent/refreshtoken"
+	"backend/ent/reminderlog"
+	"backend/ent/report"
+	"backend/ent/repost"
+	"backend/ent/schema/types"
+	"backend/ent/timelineentry"
 	"backend/ent/user"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
@@ -31,43 +50,62 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeGenre        = "Genre"
-	TypeGoal         = "Goal"
-	TypeImage        = "Image"
-	TypePost         = "Post"
-	TypeReaction     = "Reaction"
-	TypeRefreshToken = "RefreshToken"
-	TypeUser         = "User"
+	TypeBookmark             = "Bookmark"
+	TypeBookmarkCollection   = "BookmarkCollection"
+	TypeComment              = "Comment"
+	TypeGenre                = "Genre"
+	TypeGoal                 = "Goal"
+	TypeGoalParticipant      = "GoalParticipant"
+	TypeGoalTemplate         = "GoalTemplate"
+	TypeHashtag              = "Hashtag"
+	TypeImage                = "Image"
+	TypeLinkPreview          = "LinkPreview"
+	TypeMilestone            = "Milestone"
+	TypeModerationAction     = "ModerationAction"
+	TypeNotification         = "Notification"
+	TypeNotificationDelivery = "NotificationDelivery"
+	TypeOutboxEvent          = "OutboxEvent"
+	TypePost                 = "Post"
+	TypePostRevision         = "PostRevision"
+	TypeReaction             = "Reaction"
+	TypeRefreshToken         = "RefreshToken"
+	TypeReminderLog          = "ReminderLog"
+	TypeReport               = "Report"
+	TypeRepost               = "Repost"
+	TypeTimelineEntry        = "TimelineEntry"
+	TypeUser                 = "User"
 )
 
-// GenreMutation represents an operation that mutates the Genre nodes in the graph.
-type GenreMutation struct {
+// BookmarkMutation represents an operation that mutates the Bookmark nodes in the graph.
+type BookmarkMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	name          *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	users         map[uuid.UUID]struct{}
-	removedusers  map[uuid.UUID]struct{}
-	clearedusers  bool
-	done          bool
-	oldValue      func(context.Context) (*Genre, error)
-	predicates    []predicate.Genre
-}
-
-var _ ent.Mutation = (*GenreMutation)(nil)
-
-// genreOption allows management of the mutation configuration using functional options.
-type genreOption func(*GenreMutation)
-
-// newGenreMutation creates new mutation for the Genre entity.
-func newGenreMutation(c config, op Op, opts ...genreOption) *GenreMutation {
-	m := &GenreMutation{
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	user              *uuid.UUID
+	cleareduser       bool
+	post              *uuid.UUID
+	clearedpost       bool
+	collection        *uuid.UUID
+	clearedcollection bool
+	done              bool
+	oldValue          func(context.Context) (*Bookmark, error)
+	predicates        []predicate.Bookmark
+}
+
+var _ ent.Mutation = (*BookmarkMutation)(nil)
+
+// bookmarkOption allows management of the mutation configuration using functional options.
+type bookmarkOption func(*BookmarkMutation)
+
+// newBookmarkMutation creates new mutation for the Bookmark entity.
+func newBookmarkMutation(c config, op Op, opts ...bookmarkOption) *BookmarkMutation {
+	m := &BookmarkMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGenre,
+		typ:           TypeBookmark,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -76,20 +114,20 @@ func newGenreMutation(c config, op Op, opts ...genreOption) *GenreMutation {
 	return m
 }
 
-// withGenreID sets the ID field of the mutation.
-func withGenreID(id uuid.UUID) genreOption {
-	return func(m *GenreMutation) {
+// withBookmarkID sets the ID field of the mutation.
+func withBookmarkID(id uuid.UUID) bookmarkOption {
+	return func(m *BookmarkMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Genre
+			value *Bookmark
 		)
-		m.oldValue = func(ctx context.Context) (*Genre, error) {
+		m.oldValue = func(ctx context.Context) (*Bookmark, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Genre.Get(ctx, id)
+					value, err = m.Client().Bookmark.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -98,10 +136,10 @@ func withGenreID(id uuid.UUID) genreOption {
 	}
 }
 
-// withGenre sets the old Genre of the mutation.
-func withGenre(node *Genre) genreOption {
-	return func(m *GenreMutation) {
-		m.oldValue = func(context.Context) (*Genre, error) {
+// withBookmark sets the old Bookmark of the mutation.
+func withBookmark(node *Bookmark) bookmarkOption {
+	return func(m *BookmarkMutation) {
+		m.oldValue = func(context.Context) (*Bookmark, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -110,7 +148,7 @@ func withGenre(node *Genre) genreOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GenreMutation) Client() *Client {
+func (m BookmarkMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -118,7 +156,7 @@ func (m GenreMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GenreMutation) Tx() (*Tx, error) {
+func (m BookmarkMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -128,14 +166,14 @@ func (m GenreMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Genre entities.
-func (m *GenreMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Bookmark entities.
+func (m *BookmarkMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GenreMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BookmarkMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -146,7 +184,7 @@ func (m *GenreMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GenreMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BookmarkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -155,55 +193,19 @@ func (m *GenreMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Genre.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Bookmark.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *GenreMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *GenreMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Genre entity.
-// If the Genre object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenreMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *GenreMutation) ResetName() {
-	m.name = nil
-}
-
 // SetCreatedAt sets the "created_at" field.
-func (m *GenreMutation) SetCreatedAt(t time.Time) {
+func (m *BookmarkMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GenreMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *BookmarkMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -211,10 +213,10 @@ func (m *GenreMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Genre entity.
-// If the Genre object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Bookmark entity.
+// If the Bookmark object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GenreMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BookmarkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -229,73 +231,136 @@ func (m *GenreMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GenreMutation) ResetCreatedAt() {
+func (m *BookmarkMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *GenreMutation) AddUserIDs(ids ...uuid.UUID) {
-	if m.users == nil {
-		m.users = make(map[uuid.UUID]struct{})
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *BookmarkMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *BookmarkMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *BookmarkMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *BookmarkMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *BookmarkMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *GenreMutation) ClearUsers() {
-	m.clearedusers = true
+// ResetUser resets all changes to the "user" edge.
+func (m *BookmarkMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *GenreMutation) UsersCleared() bool {
-	return m.clearedusers
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *BookmarkMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *GenreMutation) RemoveUserIDs(ids ...uuid.UUID) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[uuid.UUID]struct{})
+// ClearPost clears the "post" edge to the Post entity.
+func (m *BookmarkMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *BookmarkMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *BookmarkMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
 	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *BookmarkMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *GenreMutation) RemovedUsersIDs() (ids []uuid.UUID) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+// ResetPost resets all changes to the "post" edge.
+func (m *BookmarkMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// SetCollectionID sets the "collection" edge to the BookmarkCollection entity by id.
+func (m *BookmarkMutation) SetCollectionID(id uuid.UUID) {
+	m.collection = &id
+}
+
+// ClearCollection clears the "collection" edge to the BookmarkCollection entity.
+func (m *BookmarkMutation) ClearCollection() {
+	m.clearedcollection = true
+}
+
+// CollectionCleared reports if the "collection" edge to the BookmarkCollection entity was cleared.
+func (m *BookmarkMutation) CollectionCleared() bool {
+	return m.clearedcollection
+}
+
+// CollectionID returns the "collection" edge ID in the mutation.
+func (m *BookmarkMutation) CollectionID() (id uuid.UUID, exists bool) {
+	if m.collection != nil {
+		return *m.collection, true
 	}
 	return
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *GenreMutation) UsersIDs() (ids []uuid.UUID) {
-	for id := range m.users {
-		ids = append(ids, id)
+// CollectionIDs returns the "collection" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CollectionID instead. It exists only for internal usage by the builders.
+func (m *BookmarkMutation) CollectionIDs() (ids []uuid.UUID) {
+	if id := m.collection; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *GenreMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// ResetCollection resets all changes to the "collection" edge.
+func (m *BookmarkMutation) ResetCollection() {
+	m.collection = nil
+	m.clearedcollection = false
 }
 
-// Where appends a list predicates to the GenreMutation builder.
-func (m *GenreMutation) Where(ps ...predicate.Genre) {
+// Where appends a list predicates to the BookmarkMutation builder.
+func (m *BookmarkMutation) Where(ps ...predicate.Bookmark) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GenreMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BookmarkMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GenreMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Genre, len(ps))
+func (m *BookmarkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Bookmark, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -303,30 +368,27 @@ func (m *GenreMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GenreMutation) Op() Op {
+func (m *BookmarkMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GenreMutation) SetOp(op Op) {
+func (m *BookmarkMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Genre).
-func (m *GenreMutation) Type() string {
+// Type returns the node type of this mutation (Bookmark).
+func (m *BookmarkMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GenreMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.name != nil {
-		fields = append(fields, genre.FieldName)
-	}
+func (m *BookmarkMutation) Fields() []string {
+	fields := make([]string, 0, 1)
 	if m.created_at != nil {
-		fields = append(fields, genre.FieldCreatedAt)
+		fields = append(fields, bookmark.FieldCreatedAt)
 	}
 	return fields
 }
@@ -334,11 +396,9 @@ func (m *GenreMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GenreMutation) Field(name string) (ent.Value, bool) {
+func (m *BookmarkMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case genre.FieldName:
-		return m.Name()
-	case genre.FieldCreatedAt:
+	case bookmark.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -347,29 +407,20 @@ func (m *GenreMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GenreMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BookmarkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case genre.FieldName:
-		return m.OldName(ctx)
-	case genre.FieldCreatedAt:
+	case bookmark.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Genre field %s", name)
+	return nil, fmt.Errorf("unknown Bookmark field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GenreMutation) SetField(name string, value ent.Value) error {
+func (m *BookmarkMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case genre.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case genre.FieldCreatedAt:
+	case bookmark.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -377,180 +428,202 @@ func (m *GenreMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Genre field %s", name)
+	return fmt.Errorf("unknown Bookmark field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GenreMutation) AddedFields() []string {
+func (m *BookmarkMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GenreMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BookmarkMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GenreMutation) AddField(name string, value ent.Value) error {
+func (m *BookmarkMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Genre numeric field %s", name)
+	return fmt.Errorf("unknown Bookmark numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GenreMutation) ClearedFields() []string {
+func (m *BookmarkMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GenreMutation) FieldCleared(name string) bool {
+func (m *BookmarkMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GenreMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Genre nullable field %s", name)
+func (m *BookmarkMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Bookmark nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GenreMutation) ResetField(name string) error {
+func (m *BookmarkMutation) ResetField(name string) error {
 	switch name {
-	case genre.FieldName:
-		m.ResetName()
-		return nil
-	case genre.FieldCreatedAt:
+	case bookmark.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Genre field %s", name)
+	return fmt.Errorf("unknown Bookmark field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GenreMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.users != nil {
-		edges = append(edges, genre.EdgeUsers)
+func (m *BookmarkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, bookmark.EdgeUser)
+	}
+	if m.post != nil {
+		edges = append(edges, bookmark.EdgePost)
+	}
+	if m.collection != nil {
+		edges = append(edges, bookmark.EdgeCollection)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GenreMutation) AddedIDs(name string) []ent.Value {
+func (m *BookmarkMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case genre.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
+	case bookmark.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case bookmark.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case bookmark.EdgeCollection:
+		if id := m.collection; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GenreMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedusers != nil {
-		edges = append(edges, genre.EdgeUsers)
-	}
+func (m *BookmarkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GenreMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case genre.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BookmarkMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GenreMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedusers {
-		edges = append(edges, genre.EdgeUsers)
+func (m *BookmarkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, bookmark.EdgeUser)
+	}
+	if m.clearedpost {
+		edges = append(edges, bookmark.EdgePost)
+	}
+	if m.clearedcollection {
+		edges = append(edges, bookmark.EdgeCollection)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GenreMutation) EdgeCleared(name string) bool {
+func (m *BookmarkMutation) EdgeCleared(name string) bool {
 	switch name {
-	case genre.EdgeUsers:
-		return m.clearedusers
+	case bookmark.EdgeUser:
+		return m.cleareduser
+	case bookmark.EdgePost:
+		return m.clearedpost
+	case bookmark.EdgeCollection:
+		return m.clearedcollection
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GenreMutation) ClearEdge(name string) error {
+func (m *BookmarkMutation) ClearEdge(name string) error {
 	switch name {
+	case bookmark.EdgeUser:
+		m.ClearUser()
+		return nil
+	case bookmark.EdgePost:
+		m.ClearPost()
+		return nil
+	case bookmark.EdgeCollection:
+		m.ClearCollection()
+		return nil
 	}
-	return fmt.Errorf("unknown Genre unique edge %s", name)
+	return fmt.Errorf("unknown Bookmark unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GenreMutation) ResetEdge(name string) error {
+func (m *BookmarkMutation) ResetEdge(name string) error {
 	switch name {
-	case genre.EdgeUsers:
-		m.ResetUsers()
+	case bookmark.EdgeUser:
+		m.ResetUser()
+		return nil
+	case bookmark.EdgePost:
+		m.ResetPost()
+		return nil
+	case bookmark.EdgeCollection:
+		m.ResetCollection()
 		return nil
 	}
-	return fmt.Errorf("unknown Genre edge %s", name)
+	return fmt.Errorf("unknown Bookmark edge %s", name)
 }
 
-// GoalMutation represents an operation that mutates the Goal nodes in the graph.
-type GoalMutation struct {
+// BookmarkCollectionMutation represents an operation that mutates the BookmarkCollection nodes in the graph.
+type BookmarkCollectionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	title         *string
-	deadline      *time.Time
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	posts         map[uuid.UUID]struct{}
-	removedposts  map[uuid.UUID]struct{}
-	clearedposts  bool
-	done          bool
-	oldValue      func(context.Context) (*Goal, error)
-	predicates    []predicate.Goal
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *uuid.UUID
+	cleareduser      bool
+	bookmarks        map[uuid.UUID]struct{}
+	removedbookmarks map[uuid.UUID]struct{}
+	clearedbookmarks bool
+	done             bool
+	oldValue         func(context.Context) (*BookmarkCollection, error)
+	predicates       []predicate.BookmarkCollection
 }
 
-var _ ent.Mutation = (*GoalMutation)(nil)
+var _ ent.Mutation = (*BookmarkCollectionMutation)(nil)
 
-// goalOption allows management of the mutation configuration using functional options.
-type goalOption func(*GoalMutation)
+// bookmarkcollectionOption allows management of the mutation configuration using functional options.
+type bookmarkcollectionOption func(*BookmarkCollectionMutation)
 
-// newGoalMutation creates new mutation for the Goal entity.
-func newGoalMutation(c config, op Op, opts ...goalOption) *GoalMutation {
-	m := &GoalMutation{
+// newBookmarkCollectionMutation creates new mutation for the BookmarkCollection entity.
+func newBookmarkCollectionMutation(c config, op Op, opts ...bookmarkcollectionOption) *BookmarkCollectionMutation {
+	m := &BookmarkCollectionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGoal,
+		typ:           TypeBookmarkCollection,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -559,20 +632,20 @@ func newGoalMutation(c config, op Op, opts ...goalOption) *GoalMutation {
 	return m
 }
 
-// withGoalID sets the ID field of the mutation.
-func withGoalID(id uuid.UUID) goalOption {
-	return func(m *GoalMutation) {
+// withBookmarkCollectionID sets the ID field of the mutation.
+func withBookmarkCollectionID(id uuid.UUID) bookmarkcollectionOption {
+	return func(m *BookmarkCollectionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Goal
+			value *BookmarkCollection
 		)
-		m.oldValue = func(ctx context.Context) (*Goal, error) {
+		m.oldValue = func(ctx context.Context) (*BookmarkCollection, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Goal.Get(ctx, id)
+					value, err = m.Client().BookmarkCollection.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -581,10 +654,10 @@ func withGoalID(id uuid.UUID) goalOption {
 	}
 }
 
-// withGoal sets the old Goal of the mutation.
-func withGoal(node *Goal) goalOption {
-	return func(m *GoalMutation) {
-		m.oldValue = func(context.Context) (*Goal, error) {
+// withBookmarkCollection sets the old BookmarkCollection of the mutation.
+func withBookmarkCollection(node *BookmarkCollection) bookmarkcollectionOption {
+	return func(m *BookmarkCollectionMutation) {
+		m.oldValue = func(context.Context) (*BookmarkCollection, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -593,7 +666,7 @@ func withGoal(node *Goal) goalOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GoalMutation) Client() *Client {
+func (m BookmarkCollectionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -601,7 +674,7 @@ func (m GoalMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GoalMutation) Tx() (*Tx, error) {
+func (m BookmarkCollectionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -611,14 +684,14 @@ func (m GoalMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Goal entities.
-func (m *GoalMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of BookmarkCollection entities.
+func (m *BookmarkCollectionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GoalMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BookmarkCollectionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -629,7 +702,7 @@ func (m *GoalMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GoalMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BookmarkCollectionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -638,104 +711,55 @@ func (m *GoalMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Goal.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BookmarkCollection.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *GoalMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *GoalMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the Goal entity.
-// If the Goal object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GoalMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *GoalMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetDeadline sets the "deadline" field.
-func (m *GoalMutation) SetDeadline(t time.Time) {
-	m.deadline = &t
+// SetName sets the "name" field.
+func (m *BookmarkCollectionMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Deadline returns the value of the "deadline" field in the mutation.
-func (m *GoalMutation) Deadline() (r time.Time, exists bool) {
-	v := m.deadline
+// Name returns the value of the "name" field in the mutation.
+func (m *BookmarkCollectionMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeadline returns the old "deadline" field's value of the Goal entity.
-// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the BookmarkCollection entity.
+// If the BookmarkCollection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GoalMutation) OldDeadline(ctx context.Context) (v *time.Time, err error) {
+func (m *BookmarkCollectionMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeadline is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeadline requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeadline: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Deadline, nil
-}
-
-// ClearDeadline clears the value of the "deadline" field.
-func (m *GoalMutation) ClearDeadline() {
-	m.deadline = nil
-	m.clearedFields[goal.FieldDeadline] = struct{}{}
-}
-
-// DeadlineCleared returns if the "deadline" field was cleared in this mutation.
-func (m *GoalMutation) DeadlineCleared() bool {
-	_, ok := m.clearedFields[goal.FieldDeadline]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetDeadline resets all changes to the "deadline" field.
-func (m *GoalMutation) ResetDeadline() {
-	m.deadline = nil
-	delete(m.clearedFields, goal.FieldDeadline)
+// ResetName resets all changes to the "name" field.
+func (m *BookmarkCollectionMutation) ResetName() {
+	m.name = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *GoalMutation) SetCreatedAt(t time.Time) {
+func (m *BookmarkCollectionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GoalMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *BookmarkCollectionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -743,10 +767,10 @@ func (m *GoalMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Goal entity.
-// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BookmarkCollection entity.
+// If the BookmarkCollection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GoalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BookmarkCollectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -761,17 +785,17 @@ func (m *GoalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GoalMutation) ResetCreatedAt() {
+func (m *BookmarkCollectionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *GoalMutation) SetUpdatedAt(t time.Time) {
+func (m *BookmarkCollectionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *GoalMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *BookmarkCollectionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -779,10 +803,10 @@ func (m *GoalMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Goal entity.
-// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the BookmarkCollection entity.
+// If the BookmarkCollection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GoalMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BookmarkCollectionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -797,27 +821,27 @@ func (m *GoalMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *GoalMutation) ResetUpdatedAt() {
+func (m *BookmarkCollectionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *GoalMutation) SetUserID(id uuid.UUID) {
+func (m *BookmarkCollectionMutation) SetUserID(id uuid.UUID) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *GoalMutation) ClearUser() {
+func (m *BookmarkCollectionMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *GoalMutation) UserCleared() bool {
+func (m *BookmarkCollectionMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *GoalMutation) UserID() (id uuid.UUID, exists bool) {
+func (m *BookmarkCollectionMutation) UserID() (id uuid.UUID, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -827,7 +851,7 @@ func (m *GoalMutation) UserID() (id uuid.UUID, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *GoalMutation) UserIDs() (ids []uuid.UUID) {
+func (m *BookmarkCollectionMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -835,74 +859,74 @@ func (m *GoalMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *GoalMutation) ResetUser() {
+func (m *BookmarkCollectionMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// AddPostIDs adds the "posts" edge to the Post entity by ids.
-func (m *GoalMutation) AddPostIDs(ids ...uuid.UUID) {
-	if m.posts == nil {
-		m.posts = make(map[uuid.UUID]struct{})
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by ids.
+func (m *BookmarkCollectionMutation) AddBookmarkIDs(ids ...uuid.UUID) {
+	if m.bookmarks == nil {
+		m.bookmarks = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.posts[ids[i]] = struct{}{}
+		m.bookmarks[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPosts clears the "posts" edge to the Post entity.
-func (m *GoalMutation) ClearPosts() {
-	m.clearedposts = true
+// ClearBookmarks clears the "bookmarks" edge to the Bookmark entity.
+func (m *BookmarkCollectionMutation) ClearBookmarks() {
+	m.clearedbookmarks = true
 }
 
-// PostsCleared reports if the "posts" edge to the Post entity was cleared.
-func (m *GoalMutation) PostsCleared() bool {
-	return m.clearedposts
+// BookmarksCleared reports if the "bookmarks" edge to the Bookmark entity was cleared.
+func (m *BookmarkCollectionMutation) BookmarksCleared() bool {
+	return m.clearedbookmarks
 }
 
-// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
-func (m *GoalMutation) RemovePostIDs(ids ...uuid.UUID) {
-	if m.removedposts == nil {
-		m.removedposts = make(map[uuid.UUID]struct{})
+// RemoveBookmarkIDs removes the "bookmarks" edge to the Bookmark entity by IDs.
+func (m *BookmarkCollectionMutation) RemoveBookmarkIDs(ids ...uuid.UUID) {
+	if m.removedbookmarks == nil {
+		m.removedbookmarks = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.posts, ids[i])
-		m.removedposts[ids[i]] = struct{}{}
+		delete(m.bookmarks, ids[i])
+		m.removedbookmarks[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
-func (m *GoalMutation) RemovedPostsIDs() (ids []uuid.UUID) {
-	for id := range m.removedposts {
+// RemovedBookmarks returns the removed IDs of the "bookmarks" edge to the Bookmark entity.
+func (m *BookmarkCollectionMutation) RemovedBookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.removedbookmarks {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PostsIDs returns the "posts" edge IDs in the mutation.
-func (m *GoalMutation) PostsIDs() (ids []uuid.UUID) {
-	for id := range m.posts {
+// BookmarksIDs returns the "bookmarks" edge IDs in the mutation.
+func (m *BookmarkCollectionMutation) BookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.bookmarks {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPosts resets all changes to the "posts" edge.
-func (m *GoalMutation) ResetPosts() {
-	m.posts = nil
-	m.clearedposts = false
-	m.removedposts = nil
+// ResetBookmarks resets all changes to the "bookmarks" edge.
+func (m *BookmarkCollectionMutation) ResetBookmarks() {
+	m.bookmarks = nil
+	m.clearedbookmarks = false
+	m.removedbookmarks = nil
 }
 
-// Where appends a list predicates to the GoalMutation builder.
-func (m *GoalMutation) Where(ps ...predicate.Goal) {
+// Where appends a list predicates to the BookmarkCollectionMutation builder.
+func (m *BookmarkCollectionMutation) Where(ps ...predicate.BookmarkCollection) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GoalMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BookmarkCollectionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GoalMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Goal, len(ps))
+func (m *BookmarkCollectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BookmarkCollection, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -910,36 +934,33 @@ func (m *GoalMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GoalMutation) Op() Op {
+func (m *BookmarkCollectionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GoalMutation) SetOp(op Op) {
+func (m *BookmarkCollectionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Goal).
-func (m *GoalMutation) Type() string {
+// Type returns the node type of this mutation (BookmarkCollection).
+func (m *BookmarkCollectionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GoalMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.title != nil {
-		fields = append(fields, goal.FieldTitle)
-	}
-	if m.deadline != nil {
-		fields = append(fields, goal.FieldDeadline)
+func (m *BookmarkCollectionMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, bookmarkcollection.FieldName)
 	}
 	if m.created_at != nil {
-		fields = append(fields, goal.FieldCreatedAt)
+		fields = append(fields, bookmarkcollection.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, goal.FieldUpdatedAt)
+		fields = append(fields, bookmarkcollection.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -947,15 +968,13 @@ func (m *GoalMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GoalMutation) Field(name string) (ent.Value, bool) {
+func (m *BookmarkCollectionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case goal.FieldTitle:
-		return m.Title()
-	case goal.FieldDeadline:
-		return m.Deadline()
-	case goal.FieldCreatedAt:
+	case bookmarkcollection.FieldName:
+		return m.Name()
+	case bookmarkcollection.FieldCreatedAt:
 		return m.CreatedAt()
-	case goal.FieldUpdatedAt:
+	case bookmarkcollection.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -964,47 +983,38 @@ func (m *GoalMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GoalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BookmarkCollectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case goal.FieldTitle:
-		return m.OldTitle(ctx)
-	case goal.FieldDeadline:
-		return m.OldDeadline(ctx)
-	case goal.FieldCreatedAt:
+	case bookmarkcollection.FieldName:
+		return m.OldName(ctx)
+	case bookmarkcollection.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case goal.FieldUpdatedAt:
+	case bookmarkcollection.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Goal field %s", name)
+	return nil, fmt.Errorf("unknown BookmarkCollection field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GoalMutation) SetField(name string, value ent.Value) error {
+func (m *BookmarkCollectionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case goal.FieldTitle:
+	case bookmarkcollection.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
-		return nil
-	case goal.FieldDeadline:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeadline(v)
+		m.SetName(v)
 		return nil
-	case goal.FieldCreatedAt:
+	case bookmarkcollection.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case goal.FieldUpdatedAt:
+	case bookmarkcollection.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1012,102 +1022,90 @@ func (m *GoalMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Goal field %s", name)
+	return fmt.Errorf("unknown BookmarkCollection field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GoalMutation) AddedFields() []string {
+func (m *BookmarkCollectionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GoalMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BookmarkCollectionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GoalMutation) AddField(name string, value ent.Value) error {
+func (m *BookmarkCollectionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Goal numeric field %s", name)
+	return fmt.Errorf("unknown BookmarkCollection numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GoalMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(goal.FieldDeadline) {
-		fields = append(fields, goal.FieldDeadline)
-	}
-	return fields
+func (m *BookmarkCollectionMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GoalMutation) FieldCleared(name string) bool {
+func (m *BookmarkCollectionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GoalMutation) ClearField(name string) error {
-	switch name {
-	case goal.FieldDeadline:
-		m.ClearDeadline()
-		return nil
-	}
-	return fmt.Errorf("unknown Goal nullable field %s", name)
+func (m *BookmarkCollectionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BookmarkCollection nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GoalMutation) ResetField(name string) error {
+func (m *BookmarkCollectionMutation) ResetField(name string) error {
 	switch name {
-	case goal.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case goal.FieldDeadline:
-		m.ResetDeadline()
+	case bookmarkcollection.FieldName:
+		m.ResetName()
 		return nil
-	case goal.FieldCreatedAt:
+	case bookmarkcollection.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case goal.FieldUpdatedAt:
+	case bookmarkcollection.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Goal field %s", name)
+	return fmt.Errorf("unknown BookmarkCollection field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GoalMutation) AddedEdges() []string {
+func (m *BookmarkCollectionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, goal.EdgeUser)
+		edges = append(edges, bookmarkcollection.EdgeUser)
 	}
-	if m.posts != nil {
-		edges = append(edges, goal.EdgePosts)
+	if m.bookmarks != nil {
+		edges = append(edges, bookmarkcollection.EdgeBookmarks)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GoalMutation) AddedIDs(name string) []ent.Value {
+func (m *BookmarkCollectionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case goal.EdgeUser:
+	case bookmarkcollection.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case goal.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.posts))
-		for id := range m.posts {
+	case bookmarkcollection.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.bookmarks))
+		for id := range m.bookmarks {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1116,21 +1114,21 @@ func (m *GoalMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GoalMutation) RemovedEdges() []string {
+func (m *BookmarkCollectionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedposts != nil {
-		edges = append(edges, goal.EdgePosts)
+	if m.removedbookmarks != nil {
+		edges = append(edges, bookmarkcollection.EdgeBookmarks)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GoalMutation) RemovedIDs(name string) []ent.Value {
+func (m *BookmarkCollectionMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case goal.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.removedposts))
-		for id := range m.removedposts {
+	case bookmarkcollection.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.removedbookmarks))
+		for id := range m.removedbookmarks {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1139,84 +1137,92 @@ func (m *GoalMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GoalMutation) ClearedEdges() []string {
+func (m *BookmarkCollectionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, goal.EdgeUser)
+		edges = append(edges, bookmarkcollection.EdgeUser)
 	}
-	if m.clearedposts {
-		edges = append(edges, goal.EdgePosts)
+	if m.clearedbookmarks {
+		edges = append(edges, bookmarkcollection.EdgeBookmarks)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GoalMutation) EdgeCleared(name string) bool {
+func (m *BookmarkCollectionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case goal.EdgeUser:
+	case bookmarkcollection.EdgeUser:
 		return m.cleareduser
-	case goal.EdgePosts:
-		return m.clearedposts
+	case bookmarkcollection.EdgeBookmarks:
+		return m.clearedbookmarks
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GoalMutation) ClearEdge(name string) error {
+func (m *BookmarkCollectionMutation) ClearEdge(name string) error {
 	switch name {
-	case goal.EdgeUser:
+	case bookmarkcollection.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Goal unique edge %s", name)
+	return fmt.Errorf("unknown BookmarkCollection unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GoalMutation) ResetEdge(name string) error {
+func (m *BookmarkCollectionMutation) ResetEdge(name string) error {
 	switch name {
-	case goal.EdgeUser:
+	case bookmarkcollection.EdgeUser:
 		m.ResetUser()
 		return nil
-	case goal.EdgePosts:
-		m.ResetPosts()
+	case bookmarkcollection.EdgeBookmarks:
+		m.ResetBookmarks()
 		return nil
 	}
-	return fmt.Errorf("unknown Goal edge %s", name)
+	return fmt.Errorf("unknown BookmarkCollection edge %s", name)
 }
 
-// ImageMutation represents an operation that mutates the Image nodes in the graph.
-type ImageMutation struct {
+// CommentMutation represents an operation that mutates the Comment nodes in the graph.
+type CommentMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	object_name        *string
-	content_type       *string
-	created_at         *time.Time
-	clearedFields      map[string]struct{}
-	post               *uuid.UUID
-	clearedpost        bool
-	uploaded_by        *uuid.UUID
-	cleareduploaded_by bool
-	done               bool
-	oldValue           func(context.Context) (*Image, error)
-	predicates         []predicate.Image
-}
-
-var _ ent.Mutation = (*ImageMutation)(nil)
-
-// imageOption allows management of the mutation configuration using functional options.
-type imageOption func(*ImageMutation)
-
-// newImageMutation creates new mutation for the Image entity.
-func newImageMutation(c config, op Op, opts ...imageOption) *ImageMutation {
-	m := &ImageMutation{
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	content        *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	hidden_at      *time.Time
+	deleted_at     *time.Time
+	content_hash   *string
+	clearedFields  map[string]struct{}
+	post           *uuid.UUID
+	clearedpost    bool
+	user           *uuid.UUID
+	cleareduser    bool
+	parent         *uuid.UUID
+	clearedparent  bool
+	replies        map[uuid.UUID]struct{}
+	removedreplies map[uuid.UUID]struct{}
+	clearedreplies bool
+	done           bool
+	oldValue       func(context.Context) (*Comment, error)
+	predicates     []predicate.Comment
+}
+
+var _ ent.Mutation = (*CommentMutation)(nil)
+
+// commentOption allows management of the mutation configuration using functional options.
+type commentOption func(*CommentMutation)
+
+// newCommentMutation creates new mutation for the Comment entity.
+func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
+	m := &CommentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeImage,
+		typ:           TypeComment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1225,20 +1231,20 @@ func newImageMutation(c config, op Op, opts ...imageOption) *ImageMutation {
 	return m
 }
 
-// withImageID sets the ID field of the mutation.
-func withImageID(id uuid.UUID) imageOption {
-	return func(m *ImageMutation) {
+// withCommentID sets the ID field of the mutation.
+func withCommentID(id uuid.UUID) commentOption {
+	return func(m *CommentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Image
+			value *Comment
 		)
-		m.oldValue = func(ctx context.Context) (*Image, error) {
+		m.oldValue = func(ctx context.Context) (*Comment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Image.Get(ctx, id)
+					value, err = m.Client().Comment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1247,10 +1253,10 @@ func withImageID(id uuid.UUID) imageOption {
 	}
 }
 
-// withImage sets the old Image of the mutation.
-func withImage(node *Image) imageOption {
-	return func(m *ImageMutation) {
-		m.oldValue = func(context.Context) (*Image, error) {
+// withComment sets the old Comment of the mutation.
+func withComment(node *Comment) commentOption {
+	return func(m *CommentMutation) {
+		m.oldValue = func(context.Context) (*Comment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1259,7 +1265,7 @@ func withImage(node *Image) imageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ImageMutation) Client() *Client {
+func (m CommentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1267,7 +1273,7 @@ func (m ImageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ImageMutation) Tx() (*Tx, error) {
+func (m CommentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1277,14 +1283,14 @@ func (m ImageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Image entities.
-func (m *ImageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Comment entities.
+func (m *CommentMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ImageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CommentMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1295,7 +1301,7 @@ func (m *ImageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ImageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CommentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1304,137 +1310,284 @@ func (m *ImageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Image.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetObjectName sets the "object_name" field.
-func (m *ImageMutation) SetObjectName(s string) {
-	m.object_name = &s
+// SetContent sets the "content" field.
+func (m *CommentMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// ObjectName returns the value of the "object_name" field in the mutation.
-func (m *ImageMutation) ObjectName() (r string, exists bool) {
-	v := m.object_name
+// Content returns the value of the "content" field in the mutation.
+func (m *CommentMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldObjectName returns the old "object_name" field's value of the Image entity.
-// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMutation) OldObjectName(ctx context.Context) (v string, err error) {
+func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldObjectName is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldObjectName requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldObjectName: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.ObjectName, nil
+	return oldValue.Content, nil
 }
 
-// ResetObjectName resets all changes to the "object_name" field.
-func (m *ImageMutation) ResetObjectName() {
-	m.object_name = nil
+// ResetContent resets all changes to the "content" field.
+func (m *CommentMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetContentType sets the "content_type" field.
-func (m *ImageMutation) SetContentType(s string) {
-	m.content_type = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ContentType returns the value of the "content_type" field in the mutation.
-func (m *ImageMutation) ContentType() (r string, exists bool) {
-	v := m.content_type
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentType returns the old "content_type" field's value of the Image entity.
-// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMutation) OldContentType(ctx context.Context) (v string, err error) {
+func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentType requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ContentType, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetContentType resets all changes to the "content_type" field.
-func (m *ImageMutation) ResetContentType() {
-	m.content_type = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommentMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ImageMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CommentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ImageMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CommentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Image entity.
-// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ImageMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CommentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (m *CommentMutation) SetHiddenAt(t time.Time) {
+	m.hidden_at = &t
+}
+
+// HiddenAt returns the value of the "hidden_at" field in the mutation.
+func (m *CommentMutation) HiddenAt() (r time.Time, exists bool) {
+	v := m.hidden_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHiddenAt returns the old "hidden_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldHiddenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHiddenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHiddenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHiddenAt: %w", err)
+	}
+	return oldValue.HiddenAt, nil
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (m *CommentMutation) ClearHiddenAt() {
+	m.hidden_at = nil
+	m.clearedFields[comment.FieldHiddenAt] = struct{}{}
+}
+
+// HiddenAtCleared returns if the "hidden_at" field was cleared in this mutation.
+func (m *CommentMutation) HiddenAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldHiddenAt]
+	return ok
+}
+
+// ResetHiddenAt resets all changes to the "hidden_at" field.
+func (m *CommentMutation) ResetHiddenAt() {
+	m.hidden_at = nil
+	delete(m.clearedFields, comment.FieldHiddenAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CommentMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CommentMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CommentMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[comment.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CommentMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CommentMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, comment.FieldDeletedAt)
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *CommentMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *CommentMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *CommentMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[comment.FieldContentHash] = struct{}{}
+}
+
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *CommentMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[comment.FieldContentHash]
+	return ok
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *CommentMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, comment.FieldContentHash)
 }
 
 // SetPostID sets the "post" edge to the Post entity by id.
-func (m *ImageMutation) SetPostID(id uuid.UUID) {
+func (m *CommentMutation) SetPostID(id uuid.UUID) {
 	m.post = &id
 }
 
 // ClearPost clears the "post" edge to the Post entity.
-func (m *ImageMutation) ClearPost() {
+func (m *CommentMutation) ClearPost() {
 	m.clearedpost = true
 }
 
 // PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *ImageMutation) PostCleared() bool {
+func (m *CommentMutation) PostCleared() bool {
 	return m.clearedpost
 }
 
 // PostID returns the "post" edge ID in the mutation.
-func (m *ImageMutation) PostID() (id uuid.UUID, exists bool) {
+func (m *CommentMutation) PostID() (id uuid.UUID, exists bool) {
 	if m.post != nil {
 		return *m.post, true
 	}
@@ -1444,7 +1597,7 @@ func (m *ImageMutation) PostID() (id uuid.UUID, exists bool) {
 // PostIDs returns the "post" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // PostID instead. It exists only for internal usage by the builders.
-func (m *ImageMutation) PostIDs() (ids []uuid.UUID) {
+func (m *CommentMutation) PostIDs() (ids []uuid.UUID) {
 	if id := m.post; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1452,59 +1605,152 @@ func (m *ImageMutation) PostIDs() (ids []uuid.UUID) {
 }
 
 // ResetPost resets all changes to the "post" edge.
-func (m *ImageMutation) ResetPost() {
+func (m *CommentMutation) ResetPost() {
 	m.post = nil
 	m.clearedpost = false
 }
 
-// SetUploadedByID sets the "uploaded_by" edge to the User entity by id.
-func (m *ImageMutation) SetUploadedByID(id uuid.UUID) {
-	m.uploaded_by = &id
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *CommentMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
 }
 
-// ClearUploadedBy clears the "uploaded_by" edge to the User entity.
-func (m *ImageMutation) ClearUploadedBy() {
-	m.cleareduploaded_by = true
+// ClearUser clears the "user" edge to the User entity.
+func (m *CommentMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// UploadedByCleared reports if the "uploaded_by" edge to the User entity was cleared.
-func (m *ImageMutation) UploadedByCleared() bool {
-	return m.cleareduploaded_by
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *CommentMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// UploadedByID returns the "uploaded_by" edge ID in the mutation.
-func (m *ImageMutation) UploadedByID() (id uuid.UUID, exists bool) {
-	if m.uploaded_by != nil {
-		return *m.uploaded_by, true
+// UserID returns the "user" edge ID in the mutation.
+func (m *CommentMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// UploadedByIDs returns the "uploaded_by" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UploadedByID instead. It exists only for internal usage by the builders.
-func (m *ImageMutation) UploadedByIDs() (ids []uuid.UUID) {
-	if id := m.uploaded_by; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUploadedBy resets all changes to the "uploaded_by" edge.
-func (m *ImageMutation) ResetUploadedBy() {
-	m.uploaded_by = nil
-	m.cleareduploaded_by = false
+// ResetUser resets all changes to the "user" edge.
+func (m *CommentMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the ImageMutation builder.
-func (m *ImageMutation) Where(ps ...predicate.Image) {
+// SetParentID sets the "parent" edge to the Comment entity by id.
+func (m *CommentMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (m *CommentMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Comment entity was cleared.
+func (m *CommentMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *CommentMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *CommentMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by ids.
+func (m *CommentMutation) AddReplyIDs(ids ...uuid.UUID) {
+	if m.replies == nil {
+		m.replies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplies clears the "replies" edge to the Comment entity.
+func (m *CommentMutation) ClearReplies() {
+	m.clearedreplies = true
+}
+
+// RepliesCleared reports if the "replies" edge to the Comment entity was cleared.
+func (m *CommentMutation) RepliesCleared() bool {
+	return m.clearedreplies
+}
+
+// RemoveReplyIDs removes the "replies" edge to the Comment entity by IDs.
+func (m *CommentMutation) RemoveReplyIDs(ids ...uuid.UUID) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplies returns the removed IDs of the "replies" edge to the Comment entity.
+func (m *CommentMutation) RemovedRepliesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *CommentMutation) RepliesIDs() (ids []uuid.UUID) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplies resets all changes to the "replies" edge.
+func (m *CommentMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
+}
+
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ImageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ImageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Image, len(ps))
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1512,33 +1758,42 @@ func (m *ImageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ImageMutation) Op() Op {
+func (m *CommentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ImageMutation) SetOp(op Op) {
+func (m *CommentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Image).
-func (m *ImageMutation) Type() string {
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ImageMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.object_name != nil {
-		fields = append(fields, image.FieldObjectName)
-	}
-	if m.content_type != nil {
-		fields = append(fields, image.FieldContentType)
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.content != nil {
+		fields = append(fields, comment.FieldContent)
 	}
 	if m.created_at != nil {
-		fields = append(fields, image.FieldCreatedAt)
+		fields = append(fields, comment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, comment.FieldUpdatedAt)
+	}
+	if m.hidden_at != nil {
+		fields = append(fields, comment.FieldHiddenAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, comment.FieldDeletedAt)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, comment.FieldContentHash)
 	}
 	return fields
 }
@@ -1546,14 +1801,20 @@ func (m *ImageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ImageMutation) Field(name string) (ent.Value, bool) {
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case image.FieldObjectName:
-		return m.ObjectName()
-	case image.FieldContentType:
-		return m.ContentType()
-	case image.FieldCreatedAt:
+	case comment.FieldContent:
+		return m.Content()
+	case comment.FieldCreatedAt:
 		return m.CreatedAt()
+	case comment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case comment.FieldHiddenAt:
+		return m.HiddenAt()
+	case comment.FieldDeletedAt:
+		return m.DeletedAt()
+	case comment.FieldContentHash:
+		return m.ContentHash()
 	}
 	return nil, false
 }
@@ -1561,234 +1822,332 @@ func (m *ImageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case image.FieldObjectName:
-		return m.OldObjectName(ctx)
-	case image.FieldContentType:
-		return m.OldContentType(ctx)
-	case image.FieldCreatedAt:
+	case comment.FieldContent:
+		return m.OldContent(ctx)
+	case comment.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case comment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case comment.FieldHiddenAt:
+		return m.OldHiddenAt(ctx)
+	case comment.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case comment.FieldContentHash:
+		return m.OldContentHash(ctx)
 	}
-	return nil, fmt.Errorf("unknown Image field %s", name)
+	return nil, fmt.Errorf("unknown Comment field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImageMutation) SetField(name string, value ent.Value) error {
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case image.FieldObjectName:
+	case comment.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetObjectName(v)
+		m.SetContent(v)
 		return nil
-	case image.FieldContentType:
-		v, ok := value.(string)
+	case comment.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContentType(v)
+		m.SetCreatedAt(v)
 		return nil
-	case image.FieldCreatedAt:
+	case comment.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
+		return nil
+	case comment.FieldHiddenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHiddenAt(v)
+		return nil
+	case comment.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case comment.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Image field %s", name)
+	return fmt.Errorf("unknown Comment field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ImageMutation) AddedFields() []string {
+func (m *CommentMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ImageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImageMutation) AddField(name string, value ent.Value) error {
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Image numeric field %s", name)
+	return fmt.Errorf("unknown Comment numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ImageMutation) ClearedFields() []string {
-	return nil
+func (m *CommentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(comment.FieldHiddenAt) {
+		fields = append(fields, comment.FieldHiddenAt)
+	}
+	if m.FieldCleared(comment.FieldDeletedAt) {
+		fields = append(fields, comment.FieldDeletedAt)
+	}
+	if m.FieldCleared(comment.FieldContentHash) {
+		fields = append(fields, comment.FieldContentHash)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ImageMutation) FieldCleared(name string) bool {
+func (m *CommentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ImageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Image nullable field %s", name)
+func (m *CommentMutation) ClearField(name string) error {
+	switch name {
+	case comment.FieldHiddenAt:
+		m.ClearHiddenAt()
+		return nil
+	case comment.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case comment.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ImageMutation) ResetField(name string) error {
+func (m *CommentMutation) ResetField(name string) error {
 	switch name {
-	case image.FieldObjectName:
-		m.ResetObjectName()
-		return nil
-	case image.FieldContentType:
-		m.ResetContentType()
+	case comment.FieldContent:
+		m.ResetContent()
 		return nil
-	case image.FieldCreatedAt:
+	case comment.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case comment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case comment.FieldHiddenAt:
+		m.ResetHiddenAt()
+		return nil
+	case comment.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case comment.FieldContentHash:
+		m.ResetContentHash()
+		return nil
 	}
-	return fmt.Errorf("unknown Image field %s", name)
+	return fmt.Errorf("unknown Comment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ImageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.post != nil {
-		edges = append(edges, image.EdgePost)
+		edges = append(edges, comment.EdgePost)
 	}
-	if m.uploaded_by != nil {
-		edges = append(edges, image.EdgeUploadedBy)
+	if m.user != nil {
+		edges = append(edges, comment.EdgeUser)
+	}
+	if m.parent != nil {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.replies != nil {
+		edges = append(edges, comment.EdgeReplies)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ImageMutation) AddedIDs(name string) []ent.Value {
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case image.EdgePost:
+	case comment.EdgePost:
 		if id := m.post; id != nil {
 			return []ent.Value{*id}
 		}
-	case image.EdgeUploadedBy:
-		if id := m.uploaded_by; id != nil {
+	case comment.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeParent:
+		if id := m.parent; id != nil {
 			return []ent.Value{*id}
 		}
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ImageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedreplies != nil {
+		edges = append(edges, comment.EdgeReplies)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ImageMutation) RemovedIDs(name string) []ent.Value {
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ImageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.clearedpost {
-		edges = append(edges, image.EdgePost)
+		edges = append(edges, comment.EdgePost)
 	}
-	if m.cleareduploaded_by {
-		edges = append(edges, image.EdgeUploadedBy)
+	if m.cleareduser {
+		edges = append(edges, comment.EdgeUser)
+	}
+	if m.clearedparent {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.clearedreplies {
+		edges = append(edges, comment.EdgeReplies)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ImageMutation) EdgeCleared(name string) bool {
+func (m *CommentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case image.EdgePost:
+	case comment.EdgePost:
 		return m.clearedpost
-	case image.EdgeUploadedBy:
-		return m.cleareduploaded_by
+	case comment.EdgeUser:
+		return m.cleareduser
+	case comment.EdgeParent:
+		return m.clearedparent
+	case comment.EdgeReplies:
+		return m.clearedreplies
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ImageMutation) ClearEdge(name string) error {
+func (m *CommentMutation) ClearEdge(name string) error {
 	switch name {
-	case image.EdgePost:
+	case comment.EdgePost:
 		m.ClearPost()
 		return nil
-	case image.EdgeUploadedBy:
-		m.ClearUploadedBy()
+	case comment.EdgeUser:
+		m.ClearUser()
+		return nil
+	case comment.EdgeParent:
+		m.ClearParent()
 		return nil
 	}
-	return fmt.Errorf("unknown Image unique edge %s", name)
+	return fmt.Errorf("unknown Comment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ImageMutation) ResetEdge(name string) error {
+func (m *CommentMutation) ResetEdge(name string) error {
 	switch name {
-	case image.EdgePost:
+	case comment.EdgePost:
 		m.ResetPost()
 		return nil
-	case image.EdgeUploadedBy:
-		m.ResetUploadedBy()
+	case comment.EdgeUser:
+		m.ResetUser()
+		return nil
+	case comment.EdgeParent:
+		m.ResetParent()
+		return nil
+	case comment.EdgeReplies:
+		m.ResetReplies()
 		return nil
 	}
-	return fmt.Errorf("unknown Image edge %s", name)
+	return fmt.Errorf("unknown Comment edge %s", name)
 }
 
-// PostMutation represents an operation that mutates the Post nodes in the graph.
-type PostMutation struct {
+// GenreMutation represents an operation that mutates the Genre nodes in the graph.
+type GenreMutation struct {
 	config
 	op               Op
 	typ              string
 	id               *uuid.UUID
-	content          *string
+	name             *string
 	created_at       *time.Time
-	updated_at       *time.Time
 	clearedFields    map[string]struct{}
-	user             *uuid.UUID
-	cleareduser      bool
-	goal             *uuid.UUID
-	clearedgoal      bool
-	images           map[uuid.UUID]struct{}
-	removedimages    map[uuid.UUID]struct{}
-	clearedimages    bool
-	reactions        map[uuid.UUID]struct{}
-	removedreactions map[uuid.UUID]struct{}
-	clearedreactions bool
+	users            map[uuid.UUID]struct{}
+	removedusers     map[uuid.UUID]struct{}
+	clearedusers     bool
+	templates        map[uuid.UUID]struct{}
+	removedtemplates map[uuid.UUID]struct{}
+	clearedtemplates bool
 	done             bool
-	oldValue         func(context.Context) (*Post, error)
-	predicates       []predicate.Post
+	oldValue         func(context.Context) (*Genre, error)
+	predicates       []predicate.Genre
 }
 
-var _ ent.Mutation = (*PostMutation)(nil)
+var _ ent.Mutation = (*GenreMutation)(nil)
 
-// postOption allows management of the mutation configuration using functional options.
-type postOption func(*PostMutation)
+// genreOption allows management of the mutation configuration using functional options.
+type genreOption func(*GenreMutation)
 
-// newPostMutation creates new mutation for the Post entity.
-func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
-	m := &PostMutation{
+// newGenreMutation creates new mutation for the Genre entity.
+func newGenreMutation(c config, op Op, opts ...genreOption) *GenreMutation {
+	m := &GenreMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePost,
+		typ:           TypeGenre,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1797,20 +2156,20 @@ func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
 	return m
 }
 
-// withPostID sets the ID field of the mutation.
-func withPostID(id uuid.UUID) postOption {
-	return func(m *PostMutation) {
+// withGenreID sets the ID field of the mutation.
+func withGenreID(id uuid.UUID) genreOption {
+	return func(m *GenreMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Post
+			value *Genre
 		)
-		m.oldValue = func(ctx context.Context) (*Post, error) {
+		m.oldValue = func(ctx context.Context) (*Genre, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Post.Get(ctx, id)
+					value, err = m.Client().Genre.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1819,10 +2178,10 @@ func withPostID(id uuid.UUID) postOption {
 	}
 }
 
-// withPost sets the old Post of the mutation.
-func withPost(node *Post) postOption {
-	return func(m *PostMutation) {
-		m.oldValue = func(context.Context) (*Post, error) {
+// withGenre sets the old Genre of the mutation.
+func withGenre(node *Genre) genreOption {
+	return func(m *GenreMutation) {
+		m.oldValue = func(context.Context) (*Genre, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1831,7 +2190,7 @@ func withPost(node *Post) postOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostMutation) Client() *Client {
+func (m GenreMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1839,7 +2198,7 @@ func (m PostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostMutation) Tx() (*Tx, error) {
+func (m GenreMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1849,14 +2208,14 @@ func (m PostMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Post entities.
-func (m *PostMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Genre entities.
+func (m *GenreMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PostMutation) ID() (id uuid.UUID, exists bool) {
+func (m *GenreMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1867,7 +2226,7 @@ func (m *PostMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *GenreMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1876,55 +2235,55 @@ func (m *PostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Genre.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetContent sets the "content" field.
-func (m *PostMutation) SetContent(s string) {
-	m.content = &s
+// SetName sets the "name" field.
+func (m *GenreMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *PostMutation) Content() (r string, exists bool) {
-	v := m.content
+// Name returns the value of the "name" field in the mutation.
+func (m *GenreMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Genre entity.
+// If the Genre object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *GenreMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.Name, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *PostMutation) ResetContent() {
-	m.content = nil
+// ResetName resets all changes to the "name" field.
+func (m *GenreMutation) ResetName() {
+	m.name = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PostMutation) SetCreatedAt(t time.Time) {
+func (m *GenreMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *GenreMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1932,10 +2291,10 @@ func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Genre entity.
+// If the Genre object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GenreMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1950,275 +2309,158 @@ func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostMutation) ResetCreatedAt() {
+func (m *GenreMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PostMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *GenreMutation) AddUserIDs(ids ...uuid.UUID) {
+	if m.users == nil {
+		m.users = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PostMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *PostMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *PostMutation) ClearUser() {
-	m.cleareduser = true
+// ClearUsers clears the "users" edge to the User entity.
+func (m *GenreMutation) ClearUsers() {
+	m.clearedusers = true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PostMutation) UserCleared() bool {
-	return m.cleareduser
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *GenreMutation) UsersCleared() bool {
+	return m.clearedusers
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *PostMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *GenreMutation) RemoveUserIDs(ids ...uuid.UUID) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uuid.UUID]struct{})
 	}
-	return
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PostMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PostMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// SetGoalID sets the "goal" edge to the Goal entity by id.
-func (m *PostMutation) SetGoalID(id uuid.UUID) {
-	m.goal = &id
-}
-
-// ClearGoal clears the "goal" edge to the Goal entity.
-func (m *PostMutation) ClearGoal() {
-	m.clearedgoal = true
-}
-
-// GoalCleared reports if the "goal" edge to the Goal entity was cleared.
-func (m *PostMutation) GoalCleared() bool {
-	return m.clearedgoal
-}
-
-// GoalID returns the "goal" edge ID in the mutation.
-func (m *PostMutation) GoalID() (id uuid.UUID, exists bool) {
-	if m.goal != nil {
-		return *m.goal, true
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *GenreMutation) RemovedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// GoalIDs returns the "goal" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GoalID instead. It exists only for internal usage by the builders.
-func (m *PostMutation) GoalIDs() (ids []uuid.UUID) {
-	if id := m.goal; id != nil {
-		ids = append(ids, *id)
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *GenreMutation) UsersIDs() (ids []uuid.UUID) {
+	for id := range m.users {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGoal resets all changes to the "goal" edge.
-func (m *PostMutation) ResetGoal() {
-	m.goal = nil
-	m.clearedgoal = false
+// ResetUsers resets all changes to the "users" edge.
+func (m *GenreMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
 }
 
-// AddImageIDs adds the "images" edge to the Image entity by ids.
-func (m *PostMutation) AddImageIDs(ids ...uuid.UUID) {
-	if m.images == nil {
-		m.images = make(map[uuid.UUID]struct{})
+// AddTemplateIDs adds the "templates" edge to the GoalTemplate entity by ids.
+func (m *GenreMutation) AddTemplateIDs(ids ...uuid.UUID) {
+	if m.templates == nil {
+		m.templates = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.images[ids[i]] = struct{}{}
+		m.templates[ids[i]] = struct{}{}
 	}
 }
 
-// ClearImages clears the "images" edge to the Image entity.
-func (m *PostMutation) ClearImages() {
-	m.clearedimages = true
+// ClearTemplates clears the "templates" edge to the GoalTemplate entity.
+func (m *GenreMutation) ClearTemplates() {
+	m.clearedtemplates = true
 }
 
-// ImagesCleared reports if the "images" edge to the Image entity was cleared.
-func (m *PostMutation) ImagesCleared() bool {
-	return m.clearedimages
+// TemplatesCleared reports if the "templates" edge to the GoalTemplate entity was cleared.
+func (m *GenreMutation) TemplatesCleared() bool {
+	return m.clearedtemplates
 }
 
-// RemoveImageIDs removes the "images" edge to the Image entity by IDs.
-func (m *PostMutation) RemoveImageIDs(ids ...uuid.UUID) {
-	if m.removedimages == nil {
-		m.removedimages = make(map[uuid.UUID]struct{})
+// RemoveTemplateIDs removes the "templates" edge to the GoalTemplate entity by IDs.
+func (m *GenreMutation) RemoveTemplateIDs(ids ...uuid.UUID) {
+	if m.removedtemplates == nil {
+		m.removedtemplates = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.images, ids[i])
-		m.removedimages[ids[i]] = struct{}{}
+		delete(m.templates, ids[i])
+		m.removedtemplates[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedImages returns the removed IDs of the "images" edge to the Image entity.
-func (m *PostMutation) RemovedImagesIDs() (ids []uuid.UUID) {
-	for id := range m.removedimages {
+// RemovedTemplates returns the removed IDs of the "templates" edge to the GoalTemplate entity.
+func (m *GenreMutation) RemovedTemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtemplates {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ImagesIDs returns the "images" edge IDs in the mutation.
-func (m *PostMutation) ImagesIDs() (ids []uuid.UUID) {
-	for id := range m.images {
+// TemplatesIDs returns the "templates" edge IDs in the mutation.
+func (m *GenreMutation) TemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.templates {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetImages resets all changes to the "images" edge.
-func (m *PostMutation) ResetImages() {
-	m.images = nil
-	m.clearedimages = false
-	m.removedimages = nil
+// ResetTemplates resets all changes to the "templates" edge.
+func (m *GenreMutation) ResetTemplates() {
+	m.templates = nil
+	m.clearedtemplates = false
+	m.removedtemplates = nil
 }
 
-// AddReactionIDs adds the "reactions" edge to the Reaction entity by ids.
-func (m *PostMutation) AddReactionIDs(ids ...uuid.UUID) {
-	if m.reactions == nil {
-		m.reactions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.reactions[ids[i]] = struct{}{}
+// Where appends a list predicates to the GenreMutation builder.
+func (m *GenreMutation) Where(ps ...predicate.Genre) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GenreMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GenreMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Genre, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-}
-
-// ClearReactions clears the "reactions" edge to the Reaction entity.
-func (m *PostMutation) ClearReactions() {
-	m.clearedreactions = true
-}
-
-// ReactionsCleared reports if the "reactions" edge to the Reaction entity was cleared.
-func (m *PostMutation) ReactionsCleared() bool {
-	return m.clearedreactions
-}
-
-// RemoveReactionIDs removes the "reactions" edge to the Reaction entity by IDs.
-func (m *PostMutation) RemoveReactionIDs(ids ...uuid.UUID) {
-	if m.removedreactions == nil {
-		m.removedreactions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.reactions, ids[i])
-		m.removedreactions[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedReactions returns the removed IDs of the "reactions" edge to the Reaction entity.
-func (m *PostMutation) RemovedReactionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedreactions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ReactionsIDs returns the "reactions" edge IDs in the mutation.
-func (m *PostMutation) ReactionsIDs() (ids []uuid.UUID) {
-	for id := range m.reactions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetReactions resets all changes to the "reactions" edge.
-func (m *PostMutation) ResetReactions() {
-	m.reactions = nil
-	m.clearedreactions = false
-	m.removedreactions = nil
-}
-
-// Where appends a list predicates to the PostMutation builder.
-func (m *PostMutation) Where(ps ...predicate.Post) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Post, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+	m.Where(p...)
 }
 
 // Op returns the operation name.
-func (m *PostMutation) Op() Op {
+func (m *GenreMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostMutation) SetOp(op Op) {
+func (m *GenreMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Post).
-func (m *PostMutation) Type() string {
+// Type returns the node type of this mutation (Genre).
+func (m *GenreMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.content != nil {
-		fields = append(fields, post.FieldContent)
+func (m *GenreMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, genre.FieldName)
 	}
 	if m.created_at != nil {
-		fields = append(fields, post.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, post.FieldUpdatedAt)
+		fields = append(fields, genre.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2226,14 +2468,12 @@ func (m *PostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostMutation) Field(name string) (ent.Value, bool) {
+func (m *GenreMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case post.FieldContent:
-		return m.Content()
-	case post.FieldCreatedAt:
+	case genre.FieldName:
+		return m.Name()
+	case genre.FieldCreatedAt:
 		return m.CreatedAt()
-	case post.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2241,145 +2481,119 @@ func (m *PostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GenreMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case post.FieldContent:
-		return m.OldContent(ctx)
-	case post.FieldCreatedAt:
+	case genre.FieldName:
+		return m.OldName(ctx)
+	case genre.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case post.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Post field %s", name)
+	return nil, fmt.Errorf("unknown Genre field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) SetField(name string, value ent.Value) error {
+func (m *GenreMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldContent:
+	case genre.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetName(v)
 		return nil
-	case post.FieldCreatedAt:
+	case genre.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case post.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown Genre field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostMutation) AddedFields() []string {
+func (m *GenreMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GenreMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) AddField(name string, value ent.Value) error {
+func (m *GenreMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Post numeric field %s", name)
+	return fmt.Errorf("unknown Genre numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostMutation) ClearedFields() []string {
+func (m *GenreMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostMutation) FieldCleared(name string) bool {
+func (m *GenreMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Post nullable field %s", name)
+func (m *GenreMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Genre nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostMutation) ResetField(name string) error {
+func (m *GenreMutation) ResetField(name string) error {
 	switch name {
-	case post.FieldContent:
-		m.ResetContent()
+	case genre.FieldName:
+		m.ResetName()
 		return nil
-	case post.FieldCreatedAt:
+	case genre.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case post.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown Genre field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.user != nil {
-		edges = append(edges, post.EdgeUser)
-	}
-	if m.goal != nil {
-		edges = append(edges, post.EdgeGoal)
-	}
-	if m.images != nil {
-		edges = append(edges, post.EdgeImages)
+func (m *GenreMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.users != nil {
+		edges = append(edges, genre.EdgeUsers)
 	}
-	if m.reactions != nil {
-		edges = append(edges, post.EdgeReactions)
+	if m.templates != nil {
+		edges = append(edges, genre.EdgeTemplates)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostMutation) AddedIDs(name string) []ent.Value {
+func (m *GenreMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case post.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case post.EdgeGoal:
-		if id := m.goal; id != nil {
-			return []ent.Value{*id}
-		}
-	case post.EdgeImages:
-		ids := make([]ent.Value, 0, len(m.images))
-		for id := range m.images {
+	case genre.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
 			ids = append(ids, id)
 		}
 		return ids
-	case post.EdgeReactions:
-		ids := make([]ent.Value, 0, len(m.reactions))
-		for id := range m.reactions {
+	case genre.EdgeTemplates:
+		ids := make([]ent.Value, 0, len(m.templates))
+		for id := range m.templates {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2388,30 +2602,30 @@ func (m *PostMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedimages != nil {
-		edges = append(edges, post.EdgeImages)
+func (m *GenreMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedusers != nil {
+		edges = append(edges, genre.EdgeUsers)
 	}
-	if m.removedreactions != nil {
-		edges = append(edges, post.EdgeReactions)
+	if m.removedtemplates != nil {
+		edges = append(edges, genre.EdgeTemplates)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+func (m *GenreMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case post.EdgeImages:
-		ids := make([]ent.Value, 0, len(m.removedimages))
-		for id := range m.removedimages {
+	case genre.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
 			ids = append(ids, id)
 		}
 		return ids
-	case post.EdgeReactions:
-		ids := make([]ent.Value, 0, len(m.removedreactions))
-		for id := range m.removedreactions {
+	case genre.EdgeTemplates:
+		ids := make([]ent.Value, 0, len(m.removedtemplates))
+		for id := range m.removedtemplates {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2420,101 +2634,101 @@ func (m *PostMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.cleareduser {
-		edges = append(edges, post.EdgeUser)
-	}
-	if m.clearedgoal {
-		edges = append(edges, post.EdgeGoal)
-	}
-	if m.clearedimages {
-		edges = append(edges, post.EdgeImages)
+func (m *GenreMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedusers {
+		edges = append(edges, genre.EdgeUsers)
 	}
-	if m.clearedreactions {
-		edges = append(edges, post.EdgeReactions)
+	if m.clearedtemplates {
+		edges = append(edges, genre.EdgeTemplates)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostMutation) EdgeCleared(name string) bool {
+func (m *GenreMutation) EdgeCleared(name string) bool {
 	switch name {
-	case post.EdgeUser:
-		return m.cleareduser
-	case post.EdgeGoal:
-		return m.clearedgoal
-	case post.EdgeImages:
-		return m.clearedimages
-	case post.EdgeReactions:
-		return m.clearedreactions
+	case genre.EdgeUsers:
+		return m.clearedusers
+	case genre.EdgeTemplates:
+		return m.clearedtemplates
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostMutation) ClearEdge(name string) error {
+func (m *GenreMutation) ClearEdge(name string) error {
 	switch name {
-	case post.EdgeUser:
-		m.ClearUser()
-		return nil
-	case post.EdgeGoal:
-		m.ClearGoal()
-		return nil
 	}
-	return fmt.Errorf("unknown Post unique edge %s", name)
+	return fmt.Errorf("unknown Genre unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostMutation) ResetEdge(name string) error {
+func (m *GenreMutation) ResetEdge(name string) error {
 	switch name {
-	case post.EdgeUser:
-		m.ResetUser()
-		return nil
-	case post.EdgeGoal:
-		m.ResetGoal()
-		return nil
-	case post.EdgeImages:
-		m.ResetImages()
+	case genre.EdgeUsers:
+		m.ResetUsers()
 		return nil
-	case post.EdgeReactions:
-		m.ResetReactions()
+	case genre.EdgeTemplates:
+		m.ResetTemplates()
 		return nil
 	}
-	return fmt.Errorf("unknown Post edge %s", name)
+	return fmt.Errorf("unknown Genre edge %s", name)
 }
 
-// ReactionMutation represents an operation that mutates the Reaction nodes in the graph.
-type ReactionMutation struct {
+// GoalMutation represents an operation that mutates the Goal nodes in the graph.
+type GoalMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	post          *uuid.UUID
-	clearedpost   bool
-	done          bool
-	oldValue      func(context.Context) (*Reaction, error)
-	predicates    []predicate.Reaction
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	title                *string
+	deadline             *time.Time
+	habit_days           *[]string
+	appendhabit_days     []string
+	pinned               *bool
+	position             *int
+	addposition          *int
+	created_at           *time.Time
+	updated_at           *time.Time
+	search_vector        *types.TSVector
+	clearedFields        map[string]struct{}
+	user                 *uuid.UUID
+	cleareduser          bool
+	posts                map[uuid.UUID]struct{}
+	removedposts         map[uuid.UUID]struct{}
+	clearedposts         bool
+	participants         map[uuid.UUID]struct{}
+	removedparticipants  map[uuid.UUID]struct{}
+	clearedparticipants  bool
+	milestones           map[uuid.UUID]struct{}
+	removedmilestones    map[uuid.UUID]struct{}
+	clearedmilestones    bool
+	reminder_logs        map[uuid.UUID]struct{}
+	removedreminder_logs map[uuid.UUID]struct{}
+	clearedreminder_logs bool
+	notifications        map[uuid.UUID]struct{}
+	removednotifications map[uuid.UUID]struct{}
+	clearednotifications bool
+	done                 bool
+	oldValue             func(context.Context) (*Goal, error)
+	predicates           []predicate.Goal
 }
 
-var _ ent.Mutation = (*ReactionMutation)(nil)
+var _ ent.Mutation = (*GoalMutation)(nil)
 
-// reactionOption allows management of the mutation configuration using functional options.
-type reactionOption func(*ReactionMutation)
+// goalOption allows management of the mutation configuration using functional options.
+type goalOption func(*GoalMutation)
 
-// newReactionMutation creates new mutation for the Reaction entity.
-func newReactionMutation(c config, op Op, opts ...reactionOption) *ReactionMutation {
-	m := &ReactionMutation{
+// newGoalMutation creates new mutation for the Goal entity.
+func newGoalMutation(c config, op Op, opts ...goalOption) *GoalMutation {
+	m := &GoalMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeReaction,
+		typ:           TypeGoal,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2523,20 +2737,20 @@ func newReactionMutation(c config, op Op, opts ...reactionOption) *ReactionMutat
 	return m
 }
 
-// withReactionID sets the ID field of the mutation.
-func withReactionID(id uuid.UUID) reactionOption {
-	return func(m *ReactionMutation) {
+// withGoalID sets the ID field of the mutation.
+func withGoalID(id uuid.UUID) goalOption {
+	return func(m *GoalMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Reaction
+			value *Goal
 		)
-		m.oldValue = func(ctx context.Context) (*Reaction, error) {
+		m.oldValue = func(ctx context.Context) (*Goal, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Reaction.Get(ctx, id)
+					value, err = m.Client().Goal.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2545,10 +2759,10 @@ func withReactionID(id uuid.UUID) reactionOption {
 	}
 }
 
-// withReaction sets the old Reaction of the mutation.
-func withReaction(node *Reaction) reactionOption {
-	return func(m *ReactionMutation) {
-		m.oldValue = func(context.Context) (*Reaction, error) {
+// withGoal sets the old Goal of the mutation.
+func withGoal(node *Goal) goalOption {
+	return func(m *GoalMutation) {
+		m.oldValue = func(context.Context) (*Goal, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2557,7 +2771,7 @@ func withReaction(node *Reaction) reactionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ReactionMutation) Client() *Client {
+func (m GoalMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2565,7 +2779,7 @@ func (m ReactionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ReactionMutation) Tx() (*Tx, error) {
+func (m GoalMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2575,14 +2789,14 @@ func (m ReactionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Reaction entities.
-func (m *ReactionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Goal entities.
+func (m *GoalMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ReactionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *GoalMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2593,7 +2807,7 @@ func (m *ReactionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ReactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *GoalMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2602,586 +2816,16074 @@ func (m *ReactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Reaction.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Goal.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ReactionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetTitle sets the "title" field.
+func (m *GoalMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ReactionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Title returns the value of the "title" field in the mutation.
+func (m *GoalMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Reaction entity.
-// If the Reaction object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GoalMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ReactionMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *ReactionMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+	return oldValue.Title, nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *ReactionMutation) ClearUser() {
-	m.cleareduser = true
+// ResetTitle resets all changes to the "title" field.
+func (m *GoalMutation) ResetTitle() {
+	m.title = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ReactionMutation) UserCleared() bool {
-	return m.cleareduser
+// SetDeadline sets the "deadline" field.
+func (m *GoalMutation) SetDeadline(t time.Time) {
+	m.deadline = &t
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *ReactionMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// Deadline returns the value of the "deadline" field in the mutation.
+func (m *GoalMutation) Deadline() (r time.Time, exists bool) {
+	v := m.deadline
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *ReactionMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldDeadline returns the old "deadline" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldDeadline(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeadline is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeadline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadline: %w", err)
+	}
+	return oldValue.Deadline, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *ReactionMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ClearDeadline clears the value of the "deadline" field.
+func (m *GoalMutation) ClearDeadline() {
+	m.deadline = nil
+	m.clearedFields[goal.FieldDeadline] = struct{}{}
 }
 
-// SetPostID sets the "post" edge to the Post entity by id.
-func (m *ReactionMutation) SetPostID(id uuid.UUID) {
-	m.post = &id
+// DeadlineCleared returns if the "deadline" field was cleared in this mutation.
+func (m *GoalMutation) DeadlineCleared() bool {
+	_, ok := m.clearedFields[goal.FieldDeadline]
+	return ok
 }
 
-// ClearPost clears the "post" edge to the Post entity.
-func (m *ReactionMutation) ClearPost() {
-	m.clearedpost = true
+// ResetDeadline resets all changes to the "deadline" field.
+func (m *GoalMutation) ResetDeadline() {
+	m.deadline = nil
+	delete(m.clearedFields, goal.FieldDeadline)
 }
 
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *ReactionMutation) PostCleared() bool {
-	return m.clearedpost
+// SetHabitDays sets the "habit_days" field.
+func (m *GoalMutation) SetHabitDays(s []string) {
+	m.habit_days = &s
+	m.appendhabit_days = nil
 }
 
-// PostID returns the "post" edge ID in the mutation.
-func (m *ReactionMutation) PostID() (id uuid.UUID, exists bool) {
-	if m.post != nil {
-		return *m.post, true
+// HabitDays returns the value of the "habit_days" field in the mutation.
+func (m *GoalMutation) HabitDays() (r []string, exists bool) {
+	v := m.habit_days
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// PostIDs returns the "post" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PostID instead. It exists only for internal usage by the builders.
-func (m *ReactionMutation) PostIDs() (ids []uuid.UUID) {
-	if id := m.post; id != nil {
-		ids = append(ids, *id)
+// OldHabitDays returns the old "habit_days" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldHabitDays(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHabitDays is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHabitDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHabitDays: %w", err)
+	}
+	return oldValue.HabitDays, nil
 }
 
-// ResetPost resets all changes to the "post" edge.
-func (m *ReactionMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
+// AppendHabitDays adds s to the "habit_days" field.
+func (m *GoalMutation) AppendHabitDays(s []string) {
+	m.appendhabit_days = append(m.appendhabit_days, s...)
 }
 
-// Where appends a list predicates to the ReactionMutation builder.
-func (m *ReactionMutation) Where(ps ...predicate.Reaction) {
-	m.predicates = append(m.predicates, ps...)
+// AppendedHabitDays returns the list of values that were appended to the "habit_days" field in this mutation.
+func (m *GoalMutation) AppendedHabitDays() ([]string, bool) {
+	if len(m.appendhabit_days) == 0 {
+		return nil, false
+	}
+	return m.appendhabit_days, true
 }
 
-// WhereP appends storage-level predicates to the ReactionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ReactionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Reaction, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearHabitDays clears the value of the "habit_days" field.
+func (m *GoalMutation) ClearHabitDays() {
+	m.habit_days = nil
+	m.appendhabit_days = nil
+	m.clearedFields[goal.FieldHabitDays] = struct{}{}
 }
 
-// Op returns the operation name.
-func (m *ReactionMutation) Op() Op {
-	return m.op
+// HabitDaysCleared returns if the "habit_days" field was cleared in this mutation.
+func (m *GoalMutation) HabitDaysCleared() bool {
+	_, ok := m.clearedFields[goal.FieldHabitDays]
+	return ok
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ReactionMutation) SetOp(op Op) {
-	m.op = op
+// ResetHabitDays resets all changes to the "habit_days" field.
+func (m *GoalMutation) ResetHabitDays() {
+	m.habit_days = nil
+	m.appendhabit_days = nil
+	delete(m.clearedFields, goal.FieldHabitDays)
 }
 
-// Type returns the node type of this mutation (Reaction).
-func (m *ReactionMutation) Type() string {
-	return m.typ
+// SetPinned sets the "pinned" field.
+func (m *GoalMutation) SetPinned(b bool) {
+	m.pinned = &b
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ReactionMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.created_at != nil {
-		fields = append(fields, reaction.FieldCreatedAt)
+// Pinned returns the value of the "pinned" field in the mutation.
+func (m *GoalMutation) Pinned() (r bool, exists bool) {
+	v := m.pinned
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ReactionMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case reaction.FieldCreatedAt:
-		return m.CreatedAt()
+// OldPinned returns the old "pinned" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldPinned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinned is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinned: %w", err)
+	}
+	return oldValue.Pinned, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ReactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case reaction.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Reaction field %s", name)
+// ResetPinned resets all changes to the "pinned" field.
+func (m *GoalMutation) ResetPinned() {
+	m.pinned = nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
+// SetPosition sets the "position" field.
+func (m *GoalMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *GoalMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *GoalMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *GoalMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *GoalMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GoalMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GoalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GoalMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GoalMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GoalMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GoalMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (m *GoalMutation) SetSearchVector(tv types.TSVector) {
+	m.search_vector = &tv
+}
+
+// SearchVector returns the value of the "search_vector" field in the mutation.
+func (m *GoalMutation) SearchVector() (r types.TSVector, exists bool) {
+	v := m.search_vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchVector returns the old "search_vector" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldSearchVector(ctx context.Context) (v types.TSVector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchVector: %w", err)
+	}
+	return oldValue.SearchVector, nil
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (m *GoalMutation) ClearSearchVector() {
+	m.search_vector = nil
+	m.clearedFields[goal.FieldSearchVector] = struct{}{}
+}
+
+// SearchVectorCleared returns if the "search_vector" field was cleared in this mutation.
+func (m *GoalMutation) SearchVectorCleared() bool {
+	_, ok := m.clearedFields[goal.FieldSearchVector]
+	return ok
+}
+
+// ResetSearchVector resets all changes to the "search_vector" field.
+func (m *GoalMutation) ResetSearchVector() {
+	m.search_vector = nil
+	delete(m.clearedFields, goal.FieldSearchVector)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *GoalMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *GoalMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *GoalMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *GoalMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *GoalMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *GoalMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *GoalMutation) AddPostIDs(ids ...uuid.UUID) {
+	if m.posts == nil {
+		m.posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *GoalMutation) ClearPosts() {
+	m.clearedposts = true
+}
+
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *GoalMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *GoalMutation) RemovePostIDs(ids ...uuid.UUID) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *GoalMutation) RemovedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *GoalMutation) PostsIDs() (ids []uuid.UUID) {
+	for id := range m.posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPosts resets all changes to the "posts" edge.
+func (m *GoalMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
+}
+
+// AddParticipantIDs adds the "participants" edge to the GoalParticipant entity by ids.
+func (m *GoalMutation) AddParticipantIDs(ids ...uuid.UUID) {
+	if m.participants == nil {
+		m.participants = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.participants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParticipants clears the "participants" edge to the GoalParticipant entity.
+func (m *GoalMutation) ClearParticipants() {
+	m.clearedparticipants = true
+}
+
+// ParticipantsCleared reports if the "participants" edge to the GoalParticipant entity was cleared.
+func (m *GoalMutation) ParticipantsCleared() bool {
+	return m.clearedparticipants
+}
+
+// RemoveParticipantIDs removes the "participants" edge to the GoalParticipant entity by IDs.
+func (m *GoalMutation) RemoveParticipantIDs(ids ...uuid.UUID) {
+	if m.removedparticipants == nil {
+		m.removedparticipants = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.participants, ids[i])
+		m.removedparticipants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParticipants returns the removed IDs of the "participants" edge to the GoalParticipant entity.
+func (m *GoalMutation) RemovedParticipantsIDs() (ids []uuid.UUID) {
+	for id := range m.removedparticipants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParticipantsIDs returns the "participants" edge IDs in the mutation.
+func (m *GoalMutation) ParticipantsIDs() (ids []uuid.UUID) {
+	for id := range m.participants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParticipants resets all changes to the "participants" edge.
+func (m *GoalMutation) ResetParticipants() {
+	m.participants = nil
+	m.clearedparticipants = false
+	m.removedparticipants = nil
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by ids.
+func (m *GoalMutation) AddMilestoneIDs(ids ...uuid.UUID) {
+	if m.milestones == nil {
+		m.milestones = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.milestones[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMilestones clears the "milestones" edge to the Milestone entity.
+func (m *GoalMutation) ClearMilestones() {
+	m.clearedmilestones = true
+}
+
+// MilestonesCleared reports if the "milestones" edge to the Milestone entity was cleared.
+func (m *GoalMutation) MilestonesCleared() bool {
+	return m.clearedmilestones
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to the Milestone entity by IDs.
+func (m *GoalMutation) RemoveMilestoneIDs(ids ...uuid.UUID) {
+	if m.removedmilestones == nil {
+		m.removedmilestones = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.milestones, ids[i])
+		m.removedmilestones[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMilestones returns the removed IDs of the "milestones" edge to the Milestone entity.
+func (m *GoalMutation) RemovedMilestonesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmilestones {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MilestonesIDs returns the "milestones" edge IDs in the mutation.
+func (m *GoalMutation) MilestonesIDs() (ids []uuid.UUID) {
+	for id := range m.milestones {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMilestones resets all changes to the "milestones" edge.
+func (m *GoalMutation) ResetMilestones() {
+	m.milestones = nil
+	m.clearedmilestones = false
+	m.removedmilestones = nil
+}
+
+// AddReminderLogIDs adds the "reminder_logs" edge to the ReminderLog entity by ids.
+func (m *GoalMutation) AddReminderLogIDs(ids ...uuid.UUID) {
+	if m.reminder_logs == nil {
+		m.reminder_logs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reminder_logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReminderLogs clears the "reminder_logs" edge to the ReminderLog entity.
+func (m *GoalMutation) ClearReminderLogs() {
+	m.clearedreminder_logs = true
+}
+
+// ReminderLogsCleared reports if the "reminder_logs" edge to the ReminderLog entity was cleared.
+func (m *GoalMutation) ReminderLogsCleared() bool {
+	return m.clearedreminder_logs
+}
+
+// RemoveReminderLogIDs removes the "reminder_logs" edge to the ReminderLog entity by IDs.
+func (m *GoalMutation) RemoveReminderLogIDs(ids ...uuid.UUID) {
+	if m.removedreminder_logs == nil {
+		m.removedreminder_logs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reminder_logs, ids[i])
+		m.removedreminder_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReminderLogs returns the removed IDs of the "reminder_logs" edge to the ReminderLog entity.
+func (m *GoalMutation) RemovedReminderLogsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreminder_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReminderLogsIDs returns the "reminder_logs" edge IDs in the mutation.
+func (m *GoalMutation) ReminderLogsIDs() (ids []uuid.UUID) {
+	for id := range m.reminder_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReminderLogs resets all changes to the "reminder_logs" edge.
+func (m *GoalMutation) ResetReminderLogs() {
+	m.reminder_logs = nil
+	m.clearedreminder_logs = false
+	m.removedreminder_logs = nil
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *GoalMutation) AddNotificationIDs(ids ...uuid.UUID) {
+	if m.notifications == nil {
+		m.notifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *GoalMutation) ClearNotifications() {
+	m.clearednotifications = true
+}
+
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *GoalMutation) NotificationsCleared() bool {
+	return m.clearednotifications
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *GoalMutation) RemoveNotificationIDs(ids ...uuid.UUID) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *GoalMutation) RemovedNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *GoalMutation) NotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *GoalMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
+}
+
+// Where appends a list predicates to the GoalMutation builder.
+func (m *GoalMutation) Where(ps ...predicate.Goal) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GoalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GoalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Goal, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GoalMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GoalMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Goal).
+func (m *GoalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GoalMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.title != nil {
+		fields = append(fields, goal.FieldTitle)
+	}
+	if m.deadline != nil {
+		fields = append(fields, goal.FieldDeadline)
+	}
+	if m.habit_days != nil {
+		fields = append(fields, goal.FieldHabitDays)
+	}
+	if m.pinned != nil {
+		fields = append(fields, goal.FieldPinned)
+	}
+	if m.position != nil {
+		fields = append(fields, goal.FieldPosition)
+	}
+	if m.created_at != nil {
+		fields = append(fields, goal.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, goal.FieldUpdatedAt)
+	}
+	if m.search_vector != nil {
+		fields = append(fields, goal.FieldSearchVector)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GoalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case goal.FieldTitle:
+		return m.Title()
+	case goal.FieldDeadline:
+		return m.Deadline()
+	case goal.FieldHabitDays:
+		return m.HabitDays()
+	case goal.FieldPinned:
+		return m.Pinned()
+	case goal.FieldPosition:
+		return m.Position()
+	case goal.FieldCreatedAt:
+		return m.CreatedAt()
+	case goal.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case goal.FieldSearchVector:
+		return m.SearchVector()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GoalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case goal.FieldTitle:
+		return m.OldTitle(ctx)
+	case goal.FieldDeadline:
+		return m.OldDeadline(ctx)
+	case goal.FieldHabitDays:
+		return m.OldHabitDays(ctx)
+	case goal.FieldPinned:
+		return m.OldPinned(ctx)
+	case goal.FieldPosition:
+		return m.OldPosition(ctx)
+	case goal.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case goal.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case goal.FieldSearchVector:
+		return m.OldSearchVector(ctx)
+	}
+	return nil, fmt.Errorf("unknown Goal field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case goal.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case goal.FieldDeadline:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadline(v)
+		return nil
+	case goal.FieldHabitDays:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHabitDays(v)
+		return nil
+	case goal.FieldPinned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinned(v)
+		return nil
+	case goal.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case goal.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case goal.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case goal.FieldSearchVector:
+		v, ok := value.(types.TSVector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchVector(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Goal field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GoalMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, goal.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GoalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case goal.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case goal.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Goal numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GoalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(goal.FieldDeadline) {
+		fields = append(fields, goal.FieldDeadline)
+	}
+	if m.FieldCleared(goal.FieldHabitDays) {
+		fields = append(fields, goal.FieldHabitDays)
+	}
+	if m.FieldCleared(goal.FieldSearchVector) {
+		fields = append(fields, goal.FieldSearchVector)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GoalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GoalMutation) ClearField(name string) error {
+	switch name {
+	case goal.FieldDeadline:
+		m.ClearDeadline()
+		return nil
+	case goal.FieldHabitDays:
+		m.ClearHabitDays()
+		return nil
+	case goal.FieldSearchVector:
+		m.ClearSearchVector()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GoalMutation) ResetField(name string) error {
+	switch name {
+	case goal.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case goal.FieldDeadline:
+		m.ResetDeadline()
+		return nil
+	case goal.FieldHabitDays:
+		m.ResetHabitDays()
+		return nil
+	case goal.FieldPinned:
+		m.ResetPinned()
+		return nil
+	case goal.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case goal.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case goal.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case goal.FieldSearchVector:
+		m.ResetSearchVector()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GoalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.user != nil {
+		edges = append(edges, goal.EdgeUser)
+	}
+	if m.posts != nil {
+		edges = append(edges, goal.EdgePosts)
+	}
+	if m.participants != nil {
+		edges = append(edges, goal.EdgeParticipants)
+	}
+	if m.milestones != nil {
+		edges = append(edges, goal.EdgeMilestones)
+	}
+	if m.reminder_logs != nil {
+		edges = append(edges, goal.EdgeReminderLogs)
+	}
+	if m.notifications != nil {
+		edges = append(edges, goal.EdgeNotifications)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GoalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case goal.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case goal.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case goal.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.participants))
+		for id := range m.participants {
+			ids = append(ids, id)
+		}
+		return ids
+	case goal.EdgeMilestones:
+		ids := make([]ent.Value, 0, len(m.milestones))
+		for id := range m.milestones {
+			ids = append(ids, id)
+		}
+		return ids
+	case goal.EdgeReminderLogs:
+		ids := make([]ent.Value, 0, len(m.reminder_logs))
+		for id := range m.reminder_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case goal.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GoalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedposts != nil {
+		edges = append(edges, goal.EdgePosts)
+	}
+	if m.removedparticipants != nil {
+		edges = append(edges, goal.EdgeParticipants)
+	}
+	if m.removedmilestones != nil {
+		edges = append(edges, goal.EdgeMilestones)
+	}
+	if m.removedreminder_logs != nil {
+		edges = append(edges, goal.EdgeReminderLogs)
+	}
+	if m.removednotifications != nil {
+		edges = append(edges, goal.EdgeNotifications)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GoalMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case goal.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
+			ids = append(ids, id)
+		}
+		return ids
+	case goal.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.removedparticipants))
+		for id := range m.removedparticipants {
+			ids = append(ids, id)
+		}
+		return ids
+	case goal.EdgeMilestones:
+		ids := make([]ent.Value, 0, len(m.removedmilestones))
+		for id := range m.removedmilestones {
+			ids = append(ids, id)
+		}
+		return ids
+	case goal.EdgeReminderLogs:
+		ids := make([]ent.Value, 0, len(m.removedreminder_logs))
+		for id := range m.removedreminder_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case goal.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GoalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.cleareduser {
+		edges = append(edges, goal.EdgeUser)
+	}
+	if m.clearedposts {
+		edges = append(edges, goal.EdgePosts)
+	}
+	if m.clearedparticipants {
+		edges = append(edges, goal.EdgeParticipants)
+	}
+	if m.clearedmilestones {
+		edges = append(edges, goal.EdgeMilestones)
+	}
+	if m.clearedreminder_logs {
+		edges = append(edges, goal.EdgeReminderLogs)
+	}
+	if m.clearednotifications {
+		edges = append(edges, goal.EdgeNotifications)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GoalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case goal.EdgeUser:
+		return m.cleareduser
+	case goal.EdgePosts:
+		return m.clearedposts
+	case goal.EdgeParticipants:
+		return m.clearedparticipants
+	case goal.EdgeMilestones:
+		return m.clearedmilestones
+	case goal.EdgeReminderLogs:
+		return m.clearedreminder_logs
+	case goal.EdgeNotifications:
+		return m.clearednotifications
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GoalMutation) ClearEdge(name string) error {
+	switch name {
+	case goal.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GoalMutation) ResetEdge(name string) error {
+	switch name {
+	case goal.EdgeUser:
+		m.ResetUser()
+		return nil
+	case goal.EdgePosts:
+		m.ResetPosts()
+		return nil
+	case goal.EdgeParticipants:
+		m.ResetParticipants()
+		return nil
+	case goal.EdgeMilestones:
+		m.ResetMilestones()
+		return nil
+	case goal.EdgeReminderLogs:
+		m.ResetReminderLogs()
+		return nil
+	case goal.EdgeNotifications:
+		m.ResetNotifications()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal edge %s", name)
+}
+
+// GoalParticipantMutation represents an operation that mutates the GoalParticipant nodes in the graph.
+type GoalParticipantMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	role          *goalparticipant.Role
+	status        *goalparticipant.Status
+	created_at    *time.Time
+	joined_at     *time.Time
+	position      *int
+	addposition   *int
+	clearedFields map[string]struct{}
+	goal          *uuid.UUID
+	clearedgoal   bool
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*GoalParticipant, error)
+	predicates    []predicate.GoalParticipant
+}
+
+var _ ent.Mutation = (*GoalParticipantMutation)(nil)
+
+// goalparticipantOption allows management of the mutation configuration using functional options.
+type goalparticipantOption func(*GoalParticipantMutation)
+
+// newGoalParticipantMutation creates new mutation for the GoalParticipant entity.
+func newGoalParticipantMutation(c config, op Op, opts ...goalparticipantOption) *GoalParticipantMutation {
+	m := &GoalParticipantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGoalParticipant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGoalParticipantID sets the ID field of the mutation.
+func withGoalParticipantID(id uuid.UUID) goalparticipantOption {
+	return func(m *GoalParticipantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GoalParticipant
+		)
+		m.oldValue = func(ctx context.Context) (*GoalParticipant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GoalParticipant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGoalParticipant sets the old GoalParticipant of the mutation.
+func withGoalParticipant(node *GoalParticipant) goalparticipantOption {
+	return func(m *GoalParticipantMutation) {
+		m.oldValue = func(context.Context) (*GoalParticipant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GoalParticipantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GoalParticipantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GoalParticipant entities.
+func (m *GoalParticipantMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GoalParticipantMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GoalParticipantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GoalParticipant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRole sets the "role" field.
+func (m *GoalParticipantMutation) SetRole(_go goalparticipant.Role) {
+	m.role = &_go
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *GoalParticipantMutation) Role() (r goalparticipant.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the GoalParticipant entity.
+// If the GoalParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalParticipantMutation) OldRole(ctx context.Context) (v goalparticipant.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *GoalParticipantMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *GoalParticipantMutation) SetStatus(_go goalparticipant.Status) {
+	m.status = &_go
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GoalParticipantMutation) Status() (r goalparticipant.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the GoalParticipant entity.
+// If the GoalParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalParticipantMutation) OldStatus(ctx context.Context) (v goalparticipant.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GoalParticipantMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GoalParticipantMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GoalParticipantMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GoalParticipant entity.
+// If the GoalParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalParticipantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GoalParticipantMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *GoalParticipantMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *GoalParticipantMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the GoalParticipant entity.
+// If the GoalParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalParticipantMutation) OldJoinedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (m *GoalParticipantMutation) ClearJoinedAt() {
+	m.joined_at = nil
+	m.clearedFields[goalparticipant.FieldJoinedAt] = struct{}{}
+}
+
+// JoinedAtCleared returns if the "joined_at" field was cleared in this mutation.
+func (m *GoalParticipantMutation) JoinedAtCleared() bool {
+	_, ok := m.clearedFields[goalparticipant.FieldJoinedAt]
+	return ok
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *GoalParticipantMutation) ResetJoinedAt() {
+	m.joined_at = nil
+	delete(m.clearedFields, goalparticipant.FieldJoinedAt)
+}
+
+// SetPosition sets the "position" field.
+func (m *GoalParticipantMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *GoalParticipantMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the GoalParticipant entity.
+// If the GoalParticipant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalParticipantMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *GoalParticipantMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *GoalParticipantMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *GoalParticipantMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by id.
+func (m *GoalParticipantMutation) SetGoalID(id uuid.UUID) {
+	m.goal = &id
+}
+
+// ClearGoal clears the "goal" edge to the Goal entity.
+func (m *GoalParticipantMutation) ClearGoal() {
+	m.clearedgoal = true
+}
+
+// GoalCleared reports if the "goal" edge to the Goal entity was cleared.
+func (m *GoalParticipantMutation) GoalCleared() bool {
+	return m.clearedgoal
+}
+
+// GoalID returns the "goal" edge ID in the mutation.
+func (m *GoalParticipantMutation) GoalID() (id uuid.UUID, exists bool) {
+	if m.goal != nil {
+		return *m.goal, true
+	}
+	return
+}
+
+// GoalIDs returns the "goal" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GoalID instead. It exists only for internal usage by the builders.
+func (m *GoalParticipantMutation) GoalIDs() (ids []uuid.UUID) {
+	if id := m.goal; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGoal resets all changes to the "goal" edge.
+func (m *GoalParticipantMutation) ResetGoal() {
+	m.goal = nil
+	m.clearedgoal = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *GoalParticipantMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *GoalParticipantMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *GoalParticipantMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *GoalParticipantMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *GoalParticipantMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *GoalParticipantMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the GoalParticipantMutation builder.
+func (m *GoalParticipantMutation) Where(ps ...predicate.GoalParticipant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GoalParticipantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GoalParticipantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GoalParticipant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GoalParticipantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GoalParticipantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GoalParticipant).
+func (m *GoalParticipantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GoalParticipantMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.role != nil {
+		fields = append(fields, goalparticipant.FieldRole)
+	}
+	if m.status != nil {
+		fields = append(fields, goalparticipant.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, goalparticipant.FieldCreatedAt)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, goalparticipant.FieldJoinedAt)
+	}
+	if m.position != nil {
+		fields = append(fields, goalparticipant.FieldPosition)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GoalParticipantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case goalparticipant.FieldRole:
+		return m.Role()
+	case goalparticipant.FieldStatus:
+		return m.Status()
+	case goalparticipant.FieldCreatedAt:
+		return m.CreatedAt()
+	case goalparticipant.FieldJoinedAt:
+		return m.JoinedAt()
+	case goalparticipant.FieldPosition:
+		return m.Position()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GoalParticipantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case goalparticipant.FieldRole:
+		return m.OldRole(ctx)
+	case goalparticipant.FieldStatus:
+		return m.OldStatus(ctx)
+	case goalparticipant.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case goalparticipant.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case goalparticipant.FieldPosition:
+		return m.OldPosition(ctx)
+	}
+	return nil, fmt.Errorf("unknown GoalParticipant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalParticipantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case goalparticipant.FieldRole:
+		v, ok := value.(goalparticipant.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case goalparticipant.FieldStatus:
+		v, ok := value.(goalparticipant.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case goalparticipant.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case goalparticipant.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	case goalparticipant.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GoalParticipant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GoalParticipantMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, goalparticipant.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GoalParticipantMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case goalparticipant.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalParticipantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case goalparticipant.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GoalParticipant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GoalParticipantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(goalparticipant.FieldJoinedAt) {
+		fields = append(fields, goalparticipant.FieldJoinedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GoalParticipantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GoalParticipantMutation) ClearField(name string) error {
+	switch name {
+	case goalparticipant.FieldJoinedAt:
+		m.ClearJoinedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GoalParticipant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GoalParticipantMutation) ResetField(name string) error {
+	switch name {
+	case goalparticipant.FieldRole:
+		m.ResetRole()
+		return nil
+	case goalparticipant.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case goalparticipant.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case goalparticipant.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	case goalparticipant.FieldPosition:
+		m.ResetPosition()
+		return nil
+	}
+	return fmt.Errorf("unknown GoalParticipant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GoalParticipantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.goal != nil {
+		edges = append(edges, goalparticipant.EdgeGoal)
+	}
+	if m.user != nil {
+		edges = append(edges, goalparticipant.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GoalParticipantMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case goalparticipant.EdgeGoal:
+		if id := m.goal; id != nil {
+			return []ent.Value{*id}
+		}
+	case goalparticipant.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GoalParticipantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GoalParticipantMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GoalParticipantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgoal {
+		edges = append(edges, goalparticipant.EdgeGoal)
+	}
+	if m.cleareduser {
+		edges = append(edges, goalparticipant.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GoalParticipantMutation) EdgeCleared(name string) bool {
+	switch name {
+	case goalparticipant.EdgeGoal:
+		return m.clearedgoal
+	case goalparticipant.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GoalParticipantMutation) ClearEdge(name string) error {
+	switch name {
+	case goalparticipant.EdgeGoal:
+		m.ClearGoal()
+		return nil
+	case goalparticipant.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown GoalParticipant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GoalParticipantMutation) ResetEdge(name string) error {
+	switch name {
+	case goalparticipant.EdgeGoal:
+		m.ResetGoal()
+		return nil
+	case goalparticipant.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown GoalParticipant edge %s", name)
+}
+
+// GoalTemplateMutation represents an operation that mutates the GoalTemplate nodes in the graph.
+type GoalTemplateMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	title                      *string
+	description                *string
+	suggested_duration_days    *int
+	addsuggested_duration_days *int
+	milestones                 *[]types.MilestoneTemplate
+	appendmilestones           []types.MilestoneTemplate
+	habit_days                 *[]string
+	appendhabit_days           []string
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	clearedFields              map[string]struct{}
+	genre                      *uuid.UUID
+	clearedgenre               bool
+	done                       bool
+	oldValue                   func(context.Context) (*GoalTemplate, error)
+	predicates                 []predicate.GoalTemplate
+}
+
+var _ ent.Mutation = (*GoalTemplateMutation)(nil)
+
+// goaltemplateOption allows management of the mutation configuration using functional options.
+type goaltemplateOption func(*GoalTemplateMutation)
+
+// newGoalTemplateMutation creates new mutation for the GoalTemplate entity.
+func newGoalTemplateMutation(c config, op Op, opts ...goaltemplateOption) *GoalTemplateMutation {
+	m := &GoalTemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGoalTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGoalTemplateID sets the ID field of the mutation.
+func withGoalTemplateID(id uuid.UUID) goaltemplateOption {
+	return func(m *GoalTemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GoalTemplate
+		)
+		m.oldValue = func(ctx context.Context) (*GoalTemplate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GoalTemplate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGoalTemplate sets the old GoalTemplate of the mutation.
+func withGoalTemplate(node *GoalTemplate) goaltemplateOption {
+	return func(m *GoalTemplateMutation) {
+		m.oldValue = func(context.Context) (*GoalTemplate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GoalTemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GoalTemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GoalTemplate entities.
+func (m *GoalTemplateMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GoalTemplateMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GoalTemplateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GoalTemplate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *GoalTemplateMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *GoalTemplateMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the GoalTemplate entity.
+// If the GoalTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalTemplateMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *GoalTemplateMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *GoalTemplateMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *GoalTemplateMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the GoalTemplate entity.
+// If the GoalTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalTemplateMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *GoalTemplateMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[goaltemplate.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *GoalTemplateMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[goaltemplate.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *GoalTemplateMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, goaltemplate.FieldDescription)
+}
+
+// SetSuggestedDurationDays sets the "suggested_duration_days" field.
+func (m *GoalTemplateMutation) SetSuggestedDurationDays(i int) {
+	m.suggested_duration_days = &i
+	m.addsuggested_duration_days = nil
+}
+
+// SuggestedDurationDays returns the value of the "suggested_duration_days" field in the mutation.
+func (m *GoalTemplateMutation) SuggestedDurationDays() (r int, exists bool) {
+	v := m.suggested_duration_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuggestedDurationDays returns the old "suggested_duration_days" field's value of the GoalTemplate entity.
+// If the GoalTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalTemplateMutation) OldSuggestedDurationDays(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuggestedDurationDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuggestedDurationDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuggestedDurationDays: %w", err)
+	}
+	return oldValue.SuggestedDurationDays, nil
+}
+
+// AddSuggestedDurationDays adds i to the "suggested_duration_days" field.
+func (m *GoalTemplateMutation) AddSuggestedDurationDays(i int) {
+	if m.addsuggested_duration_days != nil {
+		*m.addsuggested_duration_days += i
+	} else {
+		m.addsuggested_duration_days = &i
+	}
+}
+
+// AddedSuggestedDurationDays returns the value that was added to the "suggested_duration_days" field in this mutation.
+func (m *GoalTemplateMutation) AddedSuggestedDurationDays() (r int, exists bool) {
+	v := m.addsuggested_duration_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSuggestedDurationDays clears the value of the "suggested_duration_days" field.
+func (m *GoalTemplateMutation) ClearSuggestedDurationDays() {
+	m.suggested_duration_days = nil
+	m.addsuggested_duration_days = nil
+	m.clearedFields[goaltemplate.FieldSuggestedDurationDays] = struct{}{}
+}
+
+// SuggestedDurationDaysCleared returns if the "suggested_duration_days" field was cleared in this mutation.
+func (m *GoalTemplateMutation) SuggestedDurationDaysCleared() bool {
+	_, ok := m.clearedFields[goaltemplate.FieldSuggestedDurationDays]
+	return ok
+}
+
+// ResetSuggestedDurationDays resets all changes to the "suggested_duration_days" field.
+func (m *GoalTemplateMutation) ResetSuggestedDurationDays() {
+	m.suggested_duration_days = nil
+	m.addsuggested_duration_days = nil
+	delete(m.clearedFields, goaltemplate.FieldSuggestedDurationDays)
+}
+
+// SetMilestones sets the "milestones" field.
+func (m *GoalTemplateMutation) SetMilestones(tt []types.MilestoneTemplate) {
+	m.milestones = &tt
+	m.appendmilestones = nil
+}
+
+// Milestones returns the value of the "milestones" field in the mutation.
+func (m *GoalTemplateMutation) Milestones() (r []types.MilestoneTemplate, exists bool) {
+	v := m.milestones
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMilestones returns the old "milestones" field's value of the GoalTemplate entity.
+// If the GoalTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalTemplateMutation) OldMilestones(ctx context.Context) (v []types.MilestoneTemplate, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMilestones is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMilestones requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMilestones: %w", err)
+	}
+	return oldValue.Milestones, nil
+}
+
+// AppendMilestones adds tt to the "milestones" field.
+func (m *GoalTemplateMutation) AppendMilestones(tt []types.MilestoneTemplate) {
+	m.appendmilestones = append(m.appendmilestones, tt...)
+}
+
+// AppendedMilestones returns the list of values that were appended to the "milestones" field in this mutation.
+func (m *GoalTemplateMutation) AppendedMilestones() ([]types.MilestoneTemplate, bool) {
+	if len(m.appendmilestones) == 0 {
+		return nil, false
+	}
+	return m.appendmilestones, true
+}
+
+// ClearMilestones clears the value of the "milestones" field.
+func (m *GoalTemplateMutation) ClearMilestones() {
+	m.milestones = nil
+	m.appendmilestones = nil
+	m.clearedFields[goaltemplate.FieldMilestones] = struct{}{}
+}
+
+// MilestonesCleared returns if the "milestones" field was cleared in this mutation.
+func (m *GoalTemplateMutation) MilestonesCleared() bool {
+	_, ok := m.clearedFields[goaltemplate.FieldMilestones]
+	return ok
+}
+
+// ResetMilestones resets all changes to the "milestones" field.
+func (m *GoalTemplateMutation) ResetMilestones() {
+	m.milestones = nil
+	m.appendmilestones = nil
+	delete(m.clearedFields, goaltemplate.FieldMilestones)
+}
+
+// SetHabitDays sets the "habit_days" field.
+func (m *GoalTemplateMutation) SetHabitDays(s []string) {
+	m.habit_days = &s
+	m.appendhabit_days = nil
+}
+
+// HabitDays returns the value of the "habit_days" field in the mutation.
+func (m *GoalTemplateMutation) HabitDays() (r []string, exists bool) {
+	v := m.habit_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHabitDays returns the old "habit_days" field's value of the GoalTemplate entity.
+// If the GoalTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalTemplateMutation) OldHabitDays(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHabitDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHabitDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHabitDays: %w", err)
+	}
+	return oldValue.HabitDays, nil
+}
+
+// AppendHabitDays adds s to the "habit_days" field.
+func (m *GoalTemplateMutation) AppendHabitDays(s []string) {
+	m.appendhabit_days = append(m.appendhabit_days, s...)
+}
+
+// AppendedHabitDays returns the list of values that were appended to the "habit_days" field in this mutation.
+func (m *GoalTemplateMutation) AppendedHabitDays() ([]string, bool) {
+	if len(m.appendhabit_days) == 0 {
+		return nil, false
+	}
+	return m.appendhabit_days, true
+}
+
+// ClearHabitDays clears the value of the "habit_days" field.
+func (m *GoalTemplateMutation) ClearHabitDays() {
+	m.habit_days = nil
+	m.appendhabit_days = nil
+	m.clearedFields[goaltemplate.FieldHabitDays] = struct{}{}
+}
+
+// HabitDaysCleared returns if the "habit_days" field was cleared in this mutation.
+func (m *GoalTemplateMutation) HabitDaysCleared() bool {
+	_, ok := m.clearedFields[goaltemplate.FieldHabitDays]
+	return ok
+}
+
+// ResetHabitDays resets all changes to the "habit_days" field.
+func (m *GoalTemplateMutation) ResetHabitDays() {
+	m.habit_days = nil
+	m.appendhabit_days = nil
+	delete(m.clearedFields, goaltemplate.FieldHabitDays)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GoalTemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GoalTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GoalTemplate entity.
+// If the GoalTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GoalTemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GoalTemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GoalTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the GoalTemplate entity.
+// If the GoalTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GoalTemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetGenreID sets the "genre" edge to the Genre entity by id.
+func (m *GoalTemplateMutation) SetGenreID(id uuid.UUID) {
+	m.genre = &id
+}
+
+// ClearGenre clears the "genre" edge to the Genre entity.
+func (m *GoalTemplateMutation) ClearGenre() {
+	m.clearedgenre = true
+}
+
+// GenreCleared reports if the "genre" edge to the Genre entity was cleared.
+func (m *GoalTemplateMutation) GenreCleared() bool {
+	return m.clearedgenre
+}
+
+// GenreID returns the "genre" edge ID in the mutation.
+func (m *GoalTemplateMutation) GenreID() (id uuid.UUID, exists bool) {
+	if m.genre != nil {
+		return *m.genre, true
+	}
+	return
+}
+
+// GenreIDs returns the "genre" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GenreID instead. It exists only for internal usage by the builders.
+func (m *GoalTemplateMutation) GenreIDs() (ids []uuid.UUID) {
+	if id := m.genre; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGenre resets all changes to the "genre" edge.
+func (m *GoalTemplateMutation) ResetGenre() {
+	m.genre = nil
+	m.clearedgenre = false
+}
+
+// Where appends a list predicates to the GoalTemplateMutation builder.
+func (m *GoalTemplateMutation) Where(ps ...predicate.GoalTemplate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GoalTemplateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GoalTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GoalTemplate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GoalTemplateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GoalTemplateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GoalTemplate).
+func (m *GoalTemplateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GoalTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.title != nil {
+		fields = append(fields, goaltemplate.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, goaltemplate.FieldDescription)
+	}
+	if m.suggested_duration_days != nil {
+		fields = append(fields, goaltemplate.FieldSuggestedDurationDays)
+	}
+	if m.milestones != nil {
+		fields = append(fields, goaltemplate.FieldMilestones)
+	}
+	if m.habit_days != nil {
+		fields = append(fields, goaltemplate.FieldHabitDays)
+	}
+	if m.created_at != nil {
+		fields = append(fields, goaltemplate.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, goaltemplate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GoalTemplateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case goaltemplate.FieldTitle:
+		return m.Title()
+	case goaltemplate.FieldDescription:
+		return m.Description()
+	case goaltemplate.FieldSuggestedDurationDays:
+		return m.SuggestedDurationDays()
+	case goaltemplate.FieldMilestones:
+		return m.Milestones()
+	case goaltemplate.FieldHabitDays:
+		return m.HabitDays()
+	case goaltemplate.FieldCreatedAt:
+		return m.CreatedAt()
+	case goaltemplate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GoalTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case goaltemplate.FieldTitle:
+		return m.OldTitle(ctx)
+	case goaltemplate.FieldDescription:
+		return m.OldDescription(ctx)
+	case goaltemplate.FieldSuggestedDurationDays:
+		return m.OldSuggestedDurationDays(ctx)
+	case goaltemplate.FieldMilestones:
+		return m.OldMilestones(ctx)
+	case goaltemplate.FieldHabitDays:
+		return m.OldHabitDays(ctx)
+	case goaltemplate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case goaltemplate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GoalTemplate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalTemplateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case goaltemplate.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case goaltemplate.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case goaltemplate.FieldSuggestedDurationDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuggestedDurationDays(v)
+		return nil
+	case goaltemplate.FieldMilestones:
+		v, ok := value.([]types.MilestoneTemplate)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMilestones(v)
+		return nil
+	case goaltemplate.FieldHabitDays:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHabitDays(v)
+		return nil
+	case goaltemplate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case goaltemplate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GoalTemplate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GoalTemplateMutation) AddedFields() []string {
+	var fields []string
+	if m.addsuggested_duration_days != nil {
+		fields = append(fields, goaltemplate.FieldSuggestedDurationDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GoalTemplateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case goaltemplate.FieldSuggestedDurationDays:
+		return m.AddedSuggestedDurationDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalTemplateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case goaltemplate.FieldSuggestedDurationDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSuggestedDurationDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GoalTemplate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GoalTemplateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(goaltemplate.FieldDescription) {
+		fields = append(fields, goaltemplate.FieldDescription)
+	}
+	if m.FieldCleared(goaltemplate.FieldSuggestedDurationDays) {
+		fields = append(fields, goaltemplate.FieldSuggestedDurationDays)
+	}
+	if m.FieldCleared(goaltemplate.FieldMilestones) {
+		fields = append(fields, goaltemplate.FieldMilestones)
+	}
+	if m.FieldCleared(goaltemplate.FieldHabitDays) {
+		fields = append(fields, goaltemplate.FieldHabitDays)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GoalTemplateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GoalTemplateMutation) ClearField(name string) error {
+	switch name {
+	case goaltemplate.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case goaltemplate.FieldSuggestedDurationDays:
+		m.ClearSuggestedDurationDays()
+		return nil
+	case goaltemplate.FieldMilestones:
+		m.ClearMilestones()
+		return nil
+	case goaltemplate.FieldHabitDays:
+		m.ClearHabitDays()
+		return nil
+	}
+	return fmt.Errorf("unknown GoalTemplate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GoalTemplateMutation) ResetField(name string) error {
+	switch name {
+	case goaltemplate.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case goaltemplate.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case goaltemplate.FieldSuggestedDurationDays:
+		m.ResetSuggestedDurationDays()
+		return nil
+	case goaltemplate.FieldMilestones:
+		m.ResetMilestones()
+		return nil
+	case goaltemplate.FieldHabitDays:
+		m.ResetHabitDays()
+		return nil
+	case goaltemplate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case goaltemplate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GoalTemplate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GoalTemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.genre != nil {
+		edges = append(edges, goaltemplate.EdgeGenre)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GoalTemplateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case goaltemplate.EdgeGenre:
+		if id := m.genre; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GoalTemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GoalTemplateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GoalTemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgenre {
+		edges = append(edges, goaltemplate.EdgeGenre)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GoalTemplateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case goaltemplate.EdgeGenre:
+		return m.clearedgenre
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GoalTemplateMutation) ClearEdge(name string) error {
+	switch name {
+	case goaltemplate.EdgeGenre:
+		m.ClearGenre()
+		return nil
+	}
+	return fmt.Errorf("unknown GoalTemplate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GoalTemplateMutation) ResetEdge(name string) error {
+	switch name {
+	case goaltemplate.EdgeGenre:
+		m.ResetGenre()
+		return nil
+	}
+	return fmt.Errorf("unknown GoalTemplate edge %s", name)
+}
+
+// HashtagMutation represents an operation that mutates the Hashtag nodes in the graph.
+type HashtagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	posts         map[uuid.UUID]struct{}
+	removedposts  map[uuid.UUID]struct{}
+	clearedposts  bool
+	done          bool
+	oldValue      func(context.Context) (*Hashtag, error)
+	predicates    []predicate.Hashtag
+}
+
+var _ ent.Mutation = (*HashtagMutation)(nil)
+
+// hashtagOption allows management of the mutation configuration using functional options.
+type hashtagOption func(*HashtagMutation)
+
+// newHashtagMutation creates new mutation for the Hashtag entity.
+func newHashtagMutation(c config, op Op, opts ...hashtagOption) *HashtagMutation {
+	m := &HashtagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHashtag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHashtagID sets the ID field of the mutation.
+func withHashtagID(id uuid.UUID) hashtagOption {
+	return func(m *HashtagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Hashtag
+		)
+		m.oldValue = func(ctx context.Context) (*Hashtag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Hashtag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHashtag sets the old Hashtag of the mutation.
+func withHashtag(node *Hashtag) hashtagOption {
+	return func(m *HashtagMutation) {
+		m.oldValue = func(context.Context) (*Hashtag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HashtagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HashtagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Hashtag entities.
+func (m *HashtagMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HashtagMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HashtagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Hashtag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *HashtagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *HashtagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Hashtag entity.
+// If the Hashtag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HashtagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *HashtagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HashtagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HashtagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Hashtag entity.
+// If the Hashtag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HashtagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HashtagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *HashtagMutation) AddPostIDs(ids ...uuid.UUID) {
+	if m.posts == nil {
+		m.posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *HashtagMutation) ClearPosts() {
+	m.clearedposts = true
+}
+
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *HashtagMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *HashtagMutation) RemovePostIDs(ids ...uuid.UUID) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *HashtagMutation) RemovedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *HashtagMutation) PostsIDs() (ids []uuid.UUID) {
+	for id := range m.posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPosts resets all changes to the "posts" edge.
+func (m *HashtagMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
+}
+
+// Where appends a list predicates to the HashtagMutation builder.
+func (m *HashtagMutation) Where(ps ...predicate.Hashtag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HashtagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HashtagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Hashtag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HashtagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HashtagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Hashtag).
+func (m *HashtagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HashtagMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, hashtag.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, hashtag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HashtagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hashtag.FieldName:
+		return m.Name()
+	case hashtag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HashtagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hashtag.FieldName:
+		return m.OldName(ctx)
+	case hashtag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Hashtag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HashtagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hashtag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case hashtag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Hashtag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HashtagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HashtagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HashtagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Hashtag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HashtagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HashtagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HashtagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Hashtag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HashtagMutation) ResetField(name string) error {
+	switch name {
+	case hashtag.FieldName:
+		m.ResetName()
+		return nil
+	case hashtag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Hashtag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HashtagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.posts != nil {
+		edges = append(edges, hashtag.EdgePosts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HashtagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case hashtag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HashtagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedposts != nil {
+		edges = append(edges, hashtag.EdgePosts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HashtagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case hashtag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HashtagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedposts {
+		edges = append(edges, hashtag.EdgePosts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HashtagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case hashtag.EdgePosts:
+		return m.clearedposts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HashtagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Hashtag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HashtagMutation) ResetEdge(name string) error {
+	switch name {
+	case hashtag.EdgePosts:
+		m.ResetPosts()
+		return nil
+	}
+	return fmt.Errorf("unknown Hashtag edge %s", name)
+}
+
+// ImageMutation represents an operation that mutates the Image nodes in the graph.
+type ImageMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	object_name        *string
+	content_type       *string
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	post               *uuid.UUID
+	clearedpost        bool
+	uploaded_by        *uuid.UUID
+	cleareduploaded_by bool
+	done               bool
+	oldValue           func(context.Context) (*Image, error)
+	predicates         []predicate.Image
+}
+
+var _ ent.Mutation = (*ImageMutation)(nil)
+
+// imageOption allows management of the mutation configuration using functional options.
+type imageOption func(*ImageMutation)
+
+// newImageMutation creates new mutation for the Image entity.
+func newImageMutation(c config, op Op, opts ...imageOption) *ImageMutation {
+	m := &ImageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeImage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withImageID sets the ID field of the mutation.
+func withImageID(id uuid.UUID) imageOption {
+	return func(m *ImageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Image
+		)
+		m.oldValue = func(ctx context.Context) (*Image, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Image.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withImage sets the old Image of the mutation.
+func withImage(node *Image) imageOption {
+	return func(m *ImageMutation) {
+		m.oldValue = func(context.Context) (*Image, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ImageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ImageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Image entities.
+func (m *ImageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ImageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ImageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Image.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetObjectName sets the "object_name" field.
+func (m *ImageMutation) SetObjectName(s string) {
+	m.object_name = &s
+}
+
+// ObjectName returns the value of the "object_name" field in the mutation.
+func (m *ImageMutation) ObjectName() (r string, exists bool) {
+	v := m.object_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectName returns the old "object_name" field's value of the Image entity.
+// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMutation) OldObjectName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectName: %w", err)
+	}
+	return oldValue.ObjectName, nil
+}
+
+// ResetObjectName resets all changes to the "object_name" field.
+func (m *ImageMutation) ResetObjectName() {
+	m.object_name = nil
+}
+
+// SetContentType sets the "content_type" field.
+func (m *ImageMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *ImageMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the Image entity.
+// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *ImageMutation) ResetContentType() {
+	m.content_type = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ImageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ImageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Image entity.
+// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ImageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *ImageMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *ImageMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *ImageMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *ImageMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *ImageMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *ImageMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// SetUploadedByID sets the "uploaded_by" edge to the User entity by id.
+func (m *ImageMutation) SetUploadedByID(id uuid.UUID) {
+	m.uploaded_by = &id
+}
+
+// ClearUploadedBy clears the "uploaded_by" edge to the User entity.
+func (m *ImageMutation) ClearUploadedBy() {
+	m.cleareduploaded_by = true
+}
+
+// UploadedByCleared reports if the "uploaded_by" edge to the User entity was cleared.
+func (m *ImageMutation) UploadedByCleared() bool {
+	return m.cleareduploaded_by
+}
+
+// UploadedByID returns the "uploaded_by" edge ID in the mutation.
+func (m *ImageMutation) UploadedByID() (id uuid.UUID, exists bool) {
+	if m.uploaded_by != nil {
+		return *m.uploaded_by, true
+	}
+	return
+}
+
+// UploadedByIDs returns the "uploaded_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UploadedByID instead. It exists only for internal usage by the builders.
+func (m *ImageMutation) UploadedByIDs() (ids []uuid.UUID) {
+	if id := m.uploaded_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUploadedBy resets all changes to the "uploaded_by" edge.
+func (m *ImageMutation) ResetUploadedBy() {
+	m.uploaded_by = nil
+	m.cleareduploaded_by = false
+}
+
+// Where appends a list predicates to the ImageMutation builder.
+func (m *ImageMutation) Where(ps ...predicate.Image) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Image, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Image).
+func (m *ImageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImageMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.object_name != nil {
+		fields = append(fields, image.FieldObjectName)
+	}
+	if m.content_type != nil {
+		fields = append(fields, image.FieldContentType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, image.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case image.FieldObjectName:
+		return m.ObjectName()
+	case image.FieldContentType:
+		return m.ContentType()
+	case image.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case image.FieldObjectName:
+		return m.OldObjectName(ctx)
+	case image.FieldContentType:
+		return m.OldContentType(ctx)
+	case image.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Image field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case image.FieldObjectName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectName(v)
+		return nil
+	case image.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case image.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Image field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Image numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Image nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImageMutation) ResetField(name string) error {
+	switch name {
+	case image.FieldObjectName:
+		m.ResetObjectName()
+		return nil
+	case image.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case image.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Image field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.post != nil {
+		edges = append(edges, image.EdgePost)
+	}
+	if m.uploaded_by != nil {
+		edges = append(edges, image.EdgeUploadedBy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case image.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case image.EdgeUploadedBy:
+		if id := m.uploaded_by; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpost {
+		edges = append(edges, image.EdgePost)
+	}
+	if m.cleareduploaded_by {
+		edges = append(edges, image.EdgeUploadedBy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case image.EdgePost:
+		return m.clearedpost
+	case image.EdgeUploadedBy:
+		return m.cleareduploaded_by
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImageMutation) ClearEdge(name string) error {
+	switch name {
+	case image.EdgePost:
+		m.ClearPost()
+		return nil
+	case image.EdgeUploadedBy:
+		m.ClearUploadedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Image unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImageMutation) ResetEdge(name string) error {
+	switch name {
+	case image.EdgePost:
+		m.ResetPost()
+		return nil
+	case image.EdgeUploadedBy:
+		m.ResetUploadedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Image edge %s", name)
+}
+
+// LinkPreviewMutation represents an operation that mutates the LinkPreview nodes in the graph.
+type LinkPreviewMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	url           *string
+	status        *linkpreview.Status
+	title         *string
+	description   *string
+	image_url     *string
+	site_name     *string
+	fetched_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LinkPreview, error)
+	predicates    []predicate.LinkPreview
+}
+
+var _ ent.Mutation = (*LinkPreviewMutation)(nil)
+
+// linkpreviewOption allows management of the mutation configuration using functional options.
+type linkpreviewOption func(*LinkPreviewMutation)
+
+// newLinkPreviewMutation creates new mutation for the LinkPreview entity.
+func newLinkPreviewMutation(c config, op Op, opts ...linkpreviewOption) *LinkPreviewMutation {
+	m := &LinkPreviewMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLinkPreview,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLinkPreviewID sets the ID field of the mutation.
+func withLinkPreviewID(id uuid.UUID) linkpreviewOption {
+	return func(m *LinkPreviewMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LinkPreview
+		)
+		m.oldValue = func(ctx context.Context) (*LinkPreview, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LinkPreview.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLinkPreview sets the old LinkPreview of the mutation.
+func withLinkPreview(node *LinkPreview) linkpreviewOption {
+	return func(m *LinkPreviewMutation) {
+		m.oldValue = func(context.Context) (*LinkPreview, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LinkPreviewMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LinkPreviewMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LinkPreview entities.
+func (m *LinkPreviewMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LinkPreviewMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LinkPreviewMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LinkPreview.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetURL sets the "url" field.
+func (m *LinkPreviewMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *LinkPreviewMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the LinkPreview entity.
+// If the LinkPreview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkPreviewMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *LinkPreviewMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *LinkPreviewMutation) SetStatus(l linkpreview.Status) {
+	m.status = &l
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *LinkPreviewMutation) Status() (r linkpreview.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the LinkPreview entity.
+// If the LinkPreview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkPreviewMutation) OldStatus(ctx context.Context) (v linkpreview.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *LinkPreviewMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *LinkPreviewMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *LinkPreviewMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the LinkPreview entity.
+// If the LinkPreview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkPreviewMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *LinkPreviewMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[linkpreview.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *LinkPreviewMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[linkpreview.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *LinkPreviewMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, linkpreview.FieldTitle)
+}
+
+// SetDescription sets the "description" field.
+func (m *LinkPreviewMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *LinkPreviewMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the LinkPreview entity.
+// If the LinkPreview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkPreviewMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *LinkPreviewMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[linkpreview.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *LinkPreviewMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[linkpreview.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *LinkPreviewMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, linkpreview.FieldDescription)
+}
+
+// SetImageURL sets the "image_url" field.
+func (m *LinkPreviewMutation) SetImageURL(s string) {
+	m.image_url = &s
+}
+
+// ImageURL returns the value of the "image_url" field in the mutation.
+func (m *LinkPreviewMutation) ImageURL() (r string, exists bool) {
+	v := m.image_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageURL returns the old "image_url" field's value of the LinkPreview entity.
+// If the LinkPreview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkPreviewMutation) OldImageURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+	}
+	return oldValue.ImageURL, nil
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (m *LinkPreviewMutation) ClearImageURL() {
+	m.image_url = nil
+	m.clearedFields[linkpreview.FieldImageURL] = struct{}{}
+}
+
+// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
+func (m *LinkPreviewMutation) ImageURLCleared() bool {
+	_, ok := m.clearedFields[linkpreview.FieldImageURL]
+	return ok
+}
+
+// ResetImageURL resets all changes to the "image_url" field.
+func (m *LinkPreviewMutation) ResetImageURL() {
+	m.image_url = nil
+	delete(m.clearedFields, linkpreview.FieldImageURL)
+}
+
+// SetSiteName sets the "site_name" field.
+func (m *LinkPreviewMutation) SetSiteName(s string) {
+	m.site_name = &s
+}
+
+// SiteName returns the value of the "site_name" field in the mutation.
+func (m *LinkPreviewMutation) SiteName() (r string, exists bool) {
+	v := m.site_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSiteName returns the old "site_name" field's value of the LinkPreview entity.
+// If the LinkPreview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkPreviewMutation) OldSiteName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSiteName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSiteName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSiteName: %w", err)
+	}
+	return oldValue.SiteName, nil
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (m *LinkPreviewMutation) ClearSiteName() {
+	m.site_name = nil
+	m.clearedFields[linkpreview.FieldSiteName] = struct{}{}
+}
+
+// SiteNameCleared returns if the "site_name" field was cleared in this mutation.
+func (m *LinkPreviewMutation) SiteNameCleared() bool {
+	_, ok := m.clearedFields[linkpreview.FieldSiteName]
+	return ok
+}
+
+// ResetSiteName resets all changes to the "site_name" field.
+func (m *LinkPreviewMutation) ResetSiteName() {
+	m.site_name = nil
+	delete(m.clearedFields, linkpreview.FieldSiteName)
+}
+
+// SetFetchedAt sets the "fetched_at" field.
+func (m *LinkPreviewMutation) SetFetchedAt(t time.Time) {
+	m.fetched_at = &t
+}
+
+// FetchedAt returns the value of the "fetched_at" field in the mutation.
+func (m *LinkPreviewMutation) FetchedAt() (r time.Time, exists bool) {
+	v := m.fetched_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFetchedAt returns the old "fetched_at" field's value of the LinkPreview entity.
+// If the LinkPreview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LinkPreviewMutation) OldFetchedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFetchedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFetchedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFetchedAt: %w", err)
+	}
+	return oldValue.FetchedAt, nil
+}
+
+// ResetFetchedAt resets all changes to the "fetched_at" field.
+func (m *LinkPreviewMutation) ResetFetchedAt() {
+	m.fetched_at = nil
+}
+
+// Where appends a list predicates to the LinkPreviewMutation builder.
+func (m *LinkPreviewMutation) Where(ps ...predicate.LinkPreview) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LinkPreviewMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LinkPreviewMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LinkPreview, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LinkPreviewMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LinkPreviewMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LinkPreview).
+func (m *LinkPreviewMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LinkPreviewMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.url != nil {
+		fields = append(fields, linkpreview.FieldURL)
+	}
+	if m.status != nil {
+		fields = append(fields, linkpreview.FieldStatus)
+	}
+	if m.title != nil {
+		fields = append(fields, linkpreview.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, linkpreview.FieldDescription)
+	}
+	if m.image_url != nil {
+		fields = append(fields, linkpreview.FieldImageURL)
+	}
+	if m.site_name != nil {
+		fields = append(fields, linkpreview.FieldSiteName)
+	}
+	if m.fetched_at != nil {
+		fields = append(fields, linkpreview.FieldFetchedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LinkPreviewMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case linkpreview.FieldURL:
+		return m.URL()
+	case linkpreview.FieldStatus:
+		return m.Status()
+	case linkpreview.FieldTitle:
+		return m.Title()
+	case linkpreview.FieldDescription:
+		return m.Description()
+	case linkpreview.FieldImageURL:
+		return m.ImageURL()
+	case linkpreview.FieldSiteName:
+		return m.SiteName()
+	case linkpreview.FieldFetchedAt:
+		return m.FetchedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LinkPreviewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case linkpreview.FieldURL:
+		return m.OldURL(ctx)
+	case linkpreview.FieldStatus:
+		return m.OldStatus(ctx)
+	case linkpreview.FieldTitle:
+		return m.OldTitle(ctx)
+	case linkpreview.FieldDescription:
+		return m.OldDescription(ctx)
+	case linkpreview.FieldImageURL:
+		return m.OldImageURL(ctx)
+	case linkpreview.FieldSiteName:
+		return m.OldSiteName(ctx)
+	case linkpreview.FieldFetchedAt:
+		return m.OldFetchedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LinkPreview field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkPreviewMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case linkpreview.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case linkpreview.FieldStatus:
+		v, ok := value.(linkpreview.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case linkpreview.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case linkpreview.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case linkpreview.FieldImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageURL(v)
+		return nil
+	case linkpreview.FieldSiteName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSiteName(v)
+		return nil
+	case linkpreview.FieldFetchedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFetchedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LinkPreview field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LinkPreviewMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LinkPreviewMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LinkPreviewMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LinkPreview numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LinkPreviewMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(linkpreview.FieldTitle) {
+		fields = append(fields, linkpreview.FieldTitle)
+	}
+	if m.FieldCleared(linkpreview.FieldDescription) {
+		fields = append(fields, linkpreview.FieldDescription)
+	}
+	if m.FieldCleared(linkpreview.FieldImageURL) {
+		fields = append(fields, linkpreview.FieldImageURL)
+	}
+	if m.FieldCleared(linkpreview.FieldSiteName) {
+		fields = append(fields, linkpreview.FieldSiteName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LinkPreviewMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LinkPreviewMutation) ClearField(name string) error {
+	switch name {
+	case linkpreview.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case linkpreview.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case linkpreview.FieldImageURL:
+		m.ClearImageURL()
+		return nil
+	case linkpreview.FieldSiteName:
+		m.ClearSiteName()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkPreview nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LinkPreviewMutation) ResetField(name string) error {
+	switch name {
+	case linkpreview.FieldURL:
+		m.ResetURL()
+		return nil
+	case linkpreview.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case linkpreview.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case linkpreview.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case linkpreview.FieldImageURL:
+		m.ResetImageURL()
+		return nil
+	case linkpreview.FieldSiteName:
+		m.ResetSiteName()
+		return nil
+	case linkpreview.FieldFetchedAt:
+		m.ResetFetchedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LinkPreview field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LinkPreviewMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LinkPreviewMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LinkPreviewMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LinkPreviewMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LinkPreviewMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LinkPreviewMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LinkPreviewMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LinkPreview unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LinkPreviewMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LinkPreview edge %s", name)
+}
+
+// MilestoneMutation represents an operation that mutates the Milestone nodes in the graph.
+type MilestoneMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	title         *string
+	due_date      *time.Time
+	position      *int
+	addposition   *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	goal          *uuid.UUID
+	clearedgoal   bool
+	done          bool
+	oldValue      func(context.Context) (*Milestone, error)
+	predicates    []predicate.Milestone
+}
+
+var _ ent.Mutation = (*MilestoneMutation)(nil)
+
+// milestoneOption allows management of the mutation configuration using functional options.
+type milestoneOption func(*MilestoneMutation)
+
+// newMilestoneMutation creates new mutation for the Milestone entity.
+func newMilestoneMutation(c config, op Op, opts ...milestoneOption) *MilestoneMutation {
+	m := &MilestoneMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMilestone,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMilestoneID sets the ID field of the mutation.
+func withMilestoneID(id uuid.UUID) milestoneOption {
+	return func(m *MilestoneMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Milestone
+		)
+		m.oldValue = func(ctx context.Context) (*Milestone, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Milestone.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMilestone sets the old Milestone of the mutation.
+func withMilestone(node *Milestone) milestoneOption {
+	return func(m *MilestoneMutation) {
+		m.oldValue = func(context.Context) (*Milestone, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MilestoneMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MilestoneMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Milestone entities.
+func (m *MilestoneMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MilestoneMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MilestoneMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Milestone.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *MilestoneMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *MilestoneMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Milestone entity.
+// If the Milestone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MilestoneMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *MilestoneMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDueDate sets the "due_date" field.
+func (m *MilestoneMutation) SetDueDate(t time.Time) {
+	m.due_date = &t
+}
+
+// DueDate returns the value of the "due_date" field in the mutation.
+func (m *MilestoneMutation) DueDate() (r time.Time, exists bool) {
+	v := m.due_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueDate returns the old "due_date" field's value of the Milestone entity.
+// If the Milestone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MilestoneMutation) OldDueDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueDate: %w", err)
+	}
+	return oldValue.DueDate, nil
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (m *MilestoneMutation) ClearDueDate() {
+	m.due_date = nil
+	m.clearedFields[milestone.FieldDueDate] = struct{}{}
+}
+
+// DueDateCleared returns if the "due_date" field was cleared in this mutation.
+func (m *MilestoneMutation) DueDateCleared() bool {
+	_, ok := m.clearedFields[milestone.FieldDueDate]
+	return ok
+}
+
+// ResetDueDate resets all changes to the "due_date" field.
+func (m *MilestoneMutation) ResetDueDate() {
+	m.due_date = nil
+	delete(m.clearedFields, milestone.FieldDueDate)
+}
+
+// SetPosition sets the "position" field.
+func (m *MilestoneMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *MilestoneMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the Milestone entity.
+// If the Milestone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MilestoneMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *MilestoneMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *MilestoneMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *MilestoneMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MilestoneMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MilestoneMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Milestone entity.
+// If the Milestone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MilestoneMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MilestoneMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by id.
+func (m *MilestoneMutation) SetGoalID(id uuid.UUID) {
+	m.goal = &id
+}
+
+// ClearGoal clears the "goal" edge to the Goal entity.
+func (m *MilestoneMutation) ClearGoal() {
+	m.clearedgoal = true
+}
+
+// GoalCleared reports if the "goal" edge to the Goal entity was cleared.
+func (m *MilestoneMutation) GoalCleared() bool {
+	return m.clearedgoal
+}
+
+// GoalID returns the "goal" edge ID in the mutation.
+func (m *MilestoneMutation) GoalID() (id uuid.UUID, exists bool) {
+	if m.goal != nil {
+		return *m.goal, true
+	}
+	return
+}
+
+// GoalIDs returns the "goal" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GoalID instead. It exists only for internal usage by the builders.
+func (m *MilestoneMutation) GoalIDs() (ids []uuid.UUID) {
+	if id := m.goal; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGoal resets all changes to the "goal" edge.
+func (m *MilestoneMutation) ResetGoal() {
+	m.goal = nil
+	m.clearedgoal = false
+}
+
+// Where appends a list predicates to the MilestoneMutation builder.
+func (m *MilestoneMutation) Where(ps ...predicate.Milestone) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MilestoneMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MilestoneMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Milestone, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MilestoneMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MilestoneMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Milestone).
+func (m *MilestoneMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MilestoneMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.title != nil {
+		fields = append(fields, milestone.FieldTitle)
+	}
+	if m.due_date != nil {
+		fields = append(fields, milestone.FieldDueDate)
+	}
+	if m.position != nil {
+		fields = append(fields, milestone.FieldPosition)
+	}
+	if m.created_at != nil {
+		fields = append(fields, milestone.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MilestoneMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case milestone.FieldTitle:
+		return m.Title()
+	case milestone.FieldDueDate:
+		return m.DueDate()
+	case milestone.FieldPosition:
+		return m.Position()
+	case milestone.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MilestoneMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case milestone.FieldTitle:
+		return m.OldTitle(ctx)
+	case milestone.FieldDueDate:
+		return m.OldDueDate(ctx)
+	case milestone.FieldPosition:
+		return m.OldPosition(ctx)
+	case milestone.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Milestone field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MilestoneMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case milestone.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case milestone.FieldDueDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueDate(v)
+		return nil
+	case milestone.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case milestone.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Milestone field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MilestoneMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, milestone.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MilestoneMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case milestone.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MilestoneMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case milestone.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Milestone numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MilestoneMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(milestone.FieldDueDate) {
+		fields = append(fields, milestone.FieldDueDate)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MilestoneMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MilestoneMutation) ClearField(name string) error {
+	switch name {
+	case milestone.FieldDueDate:
+		m.ClearDueDate()
+		return nil
+	}
+	return fmt.Errorf("unknown Milestone nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MilestoneMutation) ResetField(name string) error {
+	switch name {
+	case milestone.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case milestone.FieldDueDate:
+		m.ResetDueDate()
+		return nil
+	case milestone.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case milestone.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Milestone field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MilestoneMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.goal != nil {
+		edges = append(edges, milestone.EdgeGoal)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MilestoneMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case milestone.EdgeGoal:
+		if id := m.goal; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MilestoneMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MilestoneMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MilestoneMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgoal {
+		edges = append(edges, milestone.EdgeGoal)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MilestoneMutation) EdgeCleared(name string) bool {
+	switch name {
+	case milestone.EdgeGoal:
+		return m.clearedgoal
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MilestoneMutation) ClearEdge(name string) error {
+	switch name {
+	case milestone.EdgeGoal:
+		m.ClearGoal()
+		return nil
+	}
+	return fmt.Errorf("unknown Milestone unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MilestoneMutation) ResetEdge(name string) error {
+	switch name {
+	case milestone.EdgeGoal:
+		m.ResetGoal()
+		return nil
+	}
+	return fmt.Errorf("unknown Milestone edge %s", name)
+}
+
+// ModerationActionMutation represents an operation that mutates the ModerationAction nodes in the graph.
+type ModerationActionMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	action           *moderationaction.Action
+	target_type      *moderationaction.TargetType
+	target_id        *uuid.UUID
+	note             *string
+	suspended_until  *time.Time
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	moderator        *uuid.UUID
+	clearedmoderator bool
+	report           *uuid.UUID
+	clearedreport    bool
+	done             bool
+	oldValue         func(context.Context) (*ModerationAction, error)
+	predicates       []predicate.ModerationAction
+}
+
+var _ ent.Mutation = (*ModerationActionMutation)(nil)
+
+// moderationactionOption allows management of the mutation configuration using functional options.
+type moderationactionOption func(*ModerationActionMutation)
+
+// newModerationActionMutation creates new mutation for the ModerationAction entity.
+func newModerationActionMutation(c config, op Op, opts ...moderationactionOption) *ModerationActionMutation {
+	m := &ModerationActionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModerationAction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModerationActionID sets the ID field of the mutation.
+func withModerationActionID(id uuid.UUID) moderationactionOption {
+	return func(m *ModerationActionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModerationAction
+		)
+		m.oldValue = func(ctx context.Context) (*ModerationAction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModerationAction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModerationAction sets the old ModerationAction of the mutation.
+func withModerationAction(node *ModerationAction) moderationactionOption {
+	return func(m *ModerationActionMutation) {
+		m.oldValue = func(context.Context) (*ModerationAction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModerationActionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModerationActionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModerationAction entities.
+func (m *ModerationActionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModerationActionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModerationActionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModerationAction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAction sets the "action" field.
+func (m *ModerationActionMutation) SetAction(value moderationaction.Action) {
+	m.action = &value
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ModerationActionMutation) Action() (r moderationaction.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ModerationAction entity.
+// If the ModerationAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationActionMutation) OldAction(ctx context.Context) (v moderationaction.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ModerationActionMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetTargetType sets the "target_type" field.
+func (m *ModerationActionMutation) SetTargetType(mt moderationaction.TargetType) {
+	m.target_type = &mt
+}
+
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *ModerationActionMutation) TargetType() (r moderationaction.TargetType, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetType returns the old "target_type" field's value of the ModerationAction entity.
+// If the ModerationAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationActionMutation) OldTargetType(ctx context.Context) (v moderationaction.TargetType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
+	}
+	return oldValue.TargetType, nil
+}
+
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *ModerationActionMutation) ResetTargetType() {
+	m.target_type = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *ModerationActionMutation) SetTargetID(u uuid.UUID) {
+	m.target_id = &u
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *ModerationActionMutation) TargetID() (r uuid.UUID, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the ModerationAction entity.
+// If the ModerationAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationActionMutation) OldTargetID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *ModerationActionMutation) ResetTargetID() {
+	m.target_id = nil
+}
+
+// SetNote sets the "note" field.
+func (m *ModerationActionMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *ModerationActionMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the ModerationAction entity.
+// If the ModerationAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationActionMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *ModerationActionMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[moderationaction.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *ModerationActionMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[moderationaction.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *ModerationActionMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, moderationaction.FieldNote)
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (m *ModerationActionMutation) SetSuspendedUntil(t time.Time) {
+	m.suspended_until = &t
+}
+
+// SuspendedUntil returns the value of the "suspended_until" field in the mutation.
+func (m *ModerationActionMutation) SuspendedUntil() (r time.Time, exists bool) {
+	v := m.suspended_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuspendedUntil returns the old "suspended_until" field's value of the ModerationAction entity.
+// If the ModerationAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationActionMutation) OldSuspendedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuspendedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuspendedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuspendedUntil: %w", err)
+	}
+	return oldValue.SuspendedUntil, nil
+}
+
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (m *ModerationActionMutation) ClearSuspendedUntil() {
+	m.suspended_until = nil
+	m.clearedFields[moderationaction.FieldSuspendedUntil] = struct{}{}
+}
+
+// SuspendedUntilCleared returns if the "suspended_until" field was cleared in this mutation.
+func (m *ModerationActionMutation) SuspendedUntilCleared() bool {
+	_, ok := m.clearedFields[moderationaction.FieldSuspendedUntil]
+	return ok
+}
+
+// ResetSuspendedUntil resets all changes to the "suspended_until" field.
+func (m *ModerationActionMutation) ResetSuspendedUntil() {
+	m.suspended_until = nil
+	delete(m.clearedFields, moderationaction.FieldSuspendedUntil)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModerationActionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModerationActionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ModerationAction entity.
+// If the ModerationAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationActionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModerationActionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetModeratorID sets the "moderator" edge to the User entity by id.
+func (m *ModerationActionMutation) SetModeratorID(id uuid.UUID) {
+	m.moderator = &id
+}
+
+// ClearModerator clears the "moderator" edge to the User entity.
+func (m *ModerationActionMutation) ClearModerator() {
+	m.clearedmoderator = true
+}
+
+// ModeratorCleared reports if the "moderator" edge to the User entity was cleared.
+func (m *ModerationActionMutation) ModeratorCleared() bool {
+	return m.clearedmoderator
+}
+
+// ModeratorID returns the "moderator" edge ID in the mutation.
+func (m *ModerationActionMutation) ModeratorID() (id uuid.UUID, exists bool) {
+	if m.moderator != nil {
+		return *m.moderator, true
+	}
+	return
+}
+
+// ModeratorIDs returns the "moderator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModeratorID instead. It exists only for internal usage by the builders.
+func (m *ModerationActionMutation) ModeratorIDs() (ids []uuid.UUID) {
+	if id := m.moderator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModerator resets all changes to the "moderator" edge.
+func (m *ModerationActionMutation) ResetModerator() {
+	m.moderator = nil
+	m.clearedmoderator = false
+}
+
+// SetReportID sets the "report" edge to the Report entity by id.
+func (m *ModerationActionMutation) SetReportID(id uuid.UUID) {
+	m.report = &id
+}
+
+// ClearReport clears the "report" edge to the Report entity.
+func (m *ModerationActionMutation) ClearReport() {
+	m.clearedreport = true
+}
+
+// ReportCleared reports if the "report" edge to the Report entity was cleared.
+func (m *ModerationActionMutation) ReportCleared() bool {
+	return m.clearedreport
+}
+
+// ReportID returns the "report" edge ID in the mutation.
+func (m *ModerationActionMutation) ReportID() (id uuid.UUID, exists bool) {
+	if m.report != nil {
+		return *m.report, true
+	}
+	return
+}
+
+// ReportIDs returns the "report" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReportID instead. It exists only for internal usage by the builders.
+func (m *ModerationActionMutation) ReportIDs() (ids []uuid.UUID) {
+	if id := m.report; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReport resets all changes to the "report" edge.
+func (m *ModerationActionMutation) ResetReport() {
+	m.report = nil
+	m.clearedreport = false
+}
+
+// Where appends a list predicates to the ModerationActionMutation builder.
+func (m *ModerationActionMutation) Where(ps ...predicate.ModerationAction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModerationActionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModerationActionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModerationAction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModerationActionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModerationActionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModerationAction).
+func (m *ModerationActionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModerationActionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.action != nil {
+		fields = append(fields, moderationaction.FieldAction)
+	}
+	if m.target_type != nil {
+		fields = append(fields, moderationaction.FieldTargetType)
+	}
+	if m.target_id != nil {
+		fields = append(fields, moderationaction.FieldTargetID)
+	}
+	if m.note != nil {
+		fields = append(fields, moderationaction.FieldNote)
+	}
+	if m.suspended_until != nil {
+		fields = append(fields, moderationaction.FieldSuspendedUntil)
+	}
+	if m.created_at != nil {
+		fields = append(fields, moderationaction.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModerationActionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case moderationaction.FieldAction:
+		return m.Action()
+	case moderationaction.FieldTargetType:
+		return m.TargetType()
+	case moderationaction.FieldTargetID:
+		return m.TargetID()
+	case moderationaction.FieldNote:
+		return m.Note()
+	case moderationaction.FieldSuspendedUntil:
+		return m.SuspendedUntil()
+	case moderationaction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModerationActionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case moderationaction.FieldAction:
+		return m.OldAction(ctx)
+	case moderationaction.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case moderationaction.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case moderationaction.FieldNote:
+		return m.OldNote(ctx)
+	case moderationaction.FieldSuspendedUntil:
+		return m.OldSuspendedUntil(ctx)
+	case moderationaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModerationAction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModerationActionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case moderationaction.FieldAction:
+		v, ok := value.(moderationaction.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case moderationaction.FieldTargetType:
+		v, ok := value.(moderationaction.TargetType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetType(v)
+		return nil
+	case moderationaction.FieldTargetID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case moderationaction.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case moderationaction.FieldSuspendedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspendedUntil(v)
+		return nil
+	case moderationaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationAction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModerationActionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModerationActionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModerationActionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModerationAction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModerationActionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(moderationaction.FieldNote) {
+		fields = append(fields, moderationaction.FieldNote)
+	}
+	if m.FieldCleared(moderationaction.FieldSuspendedUntil) {
+		fields = append(fields, moderationaction.FieldSuspendedUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModerationActionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModerationActionMutation) ClearField(name string) error {
+	switch name {
+	case moderationaction.FieldNote:
+		m.ClearNote()
+		return nil
+	case moderationaction.FieldSuspendedUntil:
+		m.ClearSuspendedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationAction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModerationActionMutation) ResetField(name string) error {
+	switch name {
+	case moderationaction.FieldAction:
+		m.ResetAction()
+		return nil
+	case moderationaction.FieldTargetType:
+		m.ResetTargetType()
+		return nil
+	case moderationaction.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case moderationaction.FieldNote:
+		m.ResetNote()
+		return nil
+	case moderationaction.FieldSuspendedUntil:
+		m.ResetSuspendedUntil()
+		return nil
+	case moderationaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationAction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModerationActionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.moderator != nil {
+		edges = append(edges, moderationaction.EdgeModerator)
+	}
+	if m.report != nil {
+		edges = append(edges, moderationaction.EdgeReport)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModerationActionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case moderationaction.EdgeModerator:
+		if id := m.moderator; id != nil {
+			return []ent.Value{*id}
+		}
+	case moderationaction.EdgeReport:
+		if id := m.report; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModerationActionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModerationActionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModerationActionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmoderator {
+		edges = append(edges, moderationaction.EdgeModerator)
+	}
+	if m.clearedreport {
+		edges = append(edges, moderationaction.EdgeReport)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModerationActionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case moderationaction.EdgeModerator:
+		return m.clearedmoderator
+	case moderationaction.EdgeReport:
+		return m.clearedreport
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModerationActionMutation) ClearEdge(name string) error {
+	switch name {
+	case moderationaction.EdgeModerator:
+		m.ClearModerator()
+		return nil
+	case moderationaction.EdgeReport:
+		m.ClearReport()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationAction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModerationActionMutation) ResetEdge(name string) error {
+	switch name {
+	case moderationaction.EdgeModerator:
+		m.ResetModerator()
+		return nil
+	case moderationaction.EdgeReport:
+		m.ResetReport()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationAction edge %s", name)
+}
+
+// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
+type NotificationMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	_type            *notification.Type
+	actor_count      *int
+	addactor_count   *int
+	read_at          *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	recipient        *uuid.UUID
+	clearedrecipient bool
+	actor            *uuid.UUID
+	clearedactor     bool
+	actors           map[uuid.UUID]struct{}
+	removedactors    map[uuid.UUID]struct{}
+	clearedactors    bool
+	post             *uuid.UUID
+	clearedpost      bool
+	goal             *uuid.UUID
+	clearedgoal      bool
+	done             bool
+	oldValue         func(context.Context) (*Notification, error)
+	predicates       []predicate.Notification
+}
+
+var _ ent.Mutation = (*NotificationMutation)(nil)
+
+// notificationOption allows management of the mutation configuration using functional options.
+type notificationOption func(*NotificationMutation)
+
+// newNotificationMutation creates new mutation for the Notification entity.
+func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
+	m := &NotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationID sets the ID field of the mutation.
+func withNotificationID(id uuid.UUID) notificationOption {
+	return func(m *NotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notification
+		)
+		m.oldValue = func(ctx context.Context) (*Notification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotification sets the old Notification of the mutation.
+func withNotification(node *Notification) notificationOption {
+	return func(m *NotificationMutation) {
+		m.oldValue = func(context.Context) (*Notification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Notification entities.
+func (m *NotificationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *NotificationMutation) SetType(n notification.Type) {
+	m._type = &n
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NotificationMutation) GetType() (r notification.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldType(ctx context.Context) (v notification.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NotificationMutation) ResetType() {
+	m._type = nil
+}
+
+// SetActorCount sets the "actor_count" field.
+func (m *NotificationMutation) SetActorCount(i int) {
+	m.actor_count = &i
+	m.addactor_count = nil
+}
+
+// ActorCount returns the value of the "actor_count" field in the mutation.
+func (m *NotificationMutation) ActorCount() (r int, exists bool) {
+	v := m.actor_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorCount returns the old "actor_count" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldActorCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorCount: %w", err)
+	}
+	return oldValue.ActorCount, nil
+}
+
+// AddActorCount adds i to the "actor_count" field.
+func (m *NotificationMutation) AddActorCount(i int) {
+	if m.addactor_count != nil {
+		*m.addactor_count += i
+	} else {
+		m.addactor_count = &i
+	}
+}
+
+// AddedActorCount returns the value that was added to the "actor_count" field in this mutation.
+func (m *NotificationMutation) AddedActorCount() (r int, exists bool) {
+	v := m.addactor_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetActorCount resets all changes to the "actor_count" field.
+func (m *NotificationMutation) ResetActorCount() {
+	m.actor_count = nil
+	m.addactor_count = nil
+}
+
+// SetReadAt sets the "read_at" field.
+func (m *NotificationMutation) SetReadAt(t time.Time) {
+	m.read_at = &t
+}
+
+// ReadAt returns the value of the "read_at" field in the mutation.
+func (m *NotificationMutation) ReadAt() (r time.Time, exists bool) {
+	v := m.read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadAt returns the old "read_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldReadAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+	}
+	return oldValue.ReadAt, nil
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (m *NotificationMutation) ClearReadAt() {
+	m.read_at = nil
+	m.clearedFields[notification.FieldReadAt] = struct{}{}
+}
+
+// ReadAtCleared returns if the "read_at" field was cleared in this mutation.
+func (m *NotificationMutation) ReadAtCleared() bool {
+	_, ok := m.clearedFields[notification.FieldReadAt]
+	return ok
+}
+
+// ResetReadAt resets all changes to the "read_at" field.
+func (m *NotificationMutation) ResetReadAt() {
+	m.read_at = nil
+	delete(m.clearedFields, notification.FieldReadAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRecipientID sets the "recipient" edge to the User entity by id.
+func (m *NotificationMutation) SetRecipientID(id uuid.UUID) {
+	m.recipient = &id
+}
+
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (m *NotificationMutation) ClearRecipient() {
+	m.clearedrecipient = true
+}
+
+// RecipientCleared reports if the "recipient" edge to the User entity was cleared.
+func (m *NotificationMutation) RecipientCleared() bool {
+	return m.clearedrecipient
+}
+
+// RecipientID returns the "recipient" edge ID in the mutation.
+func (m *NotificationMutation) RecipientID() (id uuid.UUID, exists bool) {
+	if m.recipient != nil {
+		return *m.recipient, true
+	}
+	return
+}
+
+// RecipientIDs returns the "recipient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RecipientID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) RecipientIDs() (ids []uuid.UUID) {
+	if id := m.recipient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRecipient resets all changes to the "recipient" edge.
+func (m *NotificationMutation) ResetRecipient() {
+	m.recipient = nil
+	m.clearedrecipient = false
+}
+
+// SetActorID sets the "actor" edge to the User entity by id.
+func (m *NotificationMutation) SetActorID(id uuid.UUID) {
+	m.actor = &id
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (m *NotificationMutation) ClearActor() {
+	m.clearedactor = true
+}
+
+// ActorCleared reports if the "actor" edge to the User entity was cleared.
+func (m *NotificationMutation) ActorCleared() bool {
+	return m.clearedactor
+}
+
+// ActorID returns the "actor" edge ID in the mutation.
+func (m *NotificationMutation) ActorID() (id uuid.UUID, exists bool) {
+	if m.actor != nil {
+		return *m.actor, true
+	}
+	return
+}
+
+// ActorIDs returns the "actor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ActorID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) ActorIDs() (ids []uuid.UUID) {
+	if id := m.actor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetActor resets all changes to the "actor" edge.
+func (m *NotificationMutation) ResetActor() {
+	m.actor = nil
+	m.clearedactor = false
+}
+
+// AddActorIDs adds the "actors" edge to the User entity by ids.
+func (m *NotificationMutation) AddActorIDs(ids ...uuid.UUID) {
+	if m.actors == nil {
+		m.actors = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.actors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActors clears the "actors" edge to the User entity.
+func (m *NotificationMutation) ClearActors() {
+	m.clearedactors = true
+}
+
+// ActorsCleared reports if the "actors" edge to the User entity was cleared.
+func (m *NotificationMutation) ActorsCleared() bool {
+	return m.clearedactors
+}
+
+// RemoveActorIDs removes the "actors" edge to the User entity by IDs.
+func (m *NotificationMutation) RemoveActorIDs(ids ...uuid.UUID) {
+	if m.removedactors == nil {
+		m.removedactors = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.actors, ids[i])
+		m.removedactors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActors returns the removed IDs of the "actors" edge to the User entity.
+func (m *NotificationMutation) RemovedActorsIDs() (ids []uuid.UUID) {
+	for id := range m.removedactors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActorsIDs returns the "actors" edge IDs in the mutation.
+func (m *NotificationMutation) ActorsIDs() (ids []uuid.UUID) {
+	for id := range m.actors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActors resets all changes to the "actors" edge.
+func (m *NotificationMutation) ResetActors() {
+	m.actors = nil
+	m.clearedactors = false
+	m.removedactors = nil
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *NotificationMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *NotificationMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *NotificationMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *NotificationMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *NotificationMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by id.
+func (m *NotificationMutation) SetGoalID(id uuid.UUID) {
+	m.goal = &id
+}
+
+// ClearGoal clears the "goal" edge to the Goal entity.
+func (m *NotificationMutation) ClearGoal() {
+	m.clearedgoal = true
+}
+
+// GoalCleared reports if the "goal" edge to the Goal entity was cleared.
+func (m *NotificationMutation) GoalCleared() bool {
+	return m.clearedgoal
+}
+
+// GoalID returns the "goal" edge ID in the mutation.
+func (m *NotificationMutation) GoalID() (id uuid.UUID, exists bool) {
+	if m.goal != nil {
+		return *m.goal, true
+	}
+	return
+}
+
+// GoalIDs returns the "goal" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GoalID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) GoalIDs() (ids []uuid.UUID) {
+	if id := m.goal; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGoal resets all changes to the "goal" edge.
+func (m *NotificationMutation) ResetGoal() {
+	m.goal = nil
+	m.clearedgoal = false
+}
+
+// Where appends a list predicates to the NotificationMutation builder.
+func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Notification).
+func (m *NotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m._type != nil {
+		fields = append(fields, notification.FieldType)
+	}
+	if m.actor_count != nil {
+		fields = append(fields, notification.FieldActorCount)
+	}
+	if m.read_at != nil {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notification.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notification.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldType:
+		return m.GetType()
+	case notification.FieldActorCount:
+		return m.ActorCount()
+	case notification.FieldReadAt:
+		return m.ReadAt()
+	case notification.FieldCreatedAt:
+		return m.CreatedAt()
+	case notification.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notification.FieldType:
+		return m.OldType(ctx)
+	case notification.FieldActorCount:
+		return m.OldActorCount(ctx)
+	case notification.FieldReadAt:
+		return m.OldReadAt(ctx)
+	case notification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notification.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldType:
+		v, ok := value.(notification.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case notification.FieldActorCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorCount(v)
+		return nil
+	case notification.FieldReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadAt(v)
+		return nil
+	case notification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notification.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationMutation) AddedFields() []string {
+	var fields []string
+	if m.addactor_count != nil {
+		fields = append(fields, notification.FieldActorCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldActorCount:
+		return m.AddedActorCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldActorCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActorCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notification.FieldReadAt) {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationMutation) ClearField(name string) error {
+	switch name {
+	case notification.FieldReadAt:
+		m.ClearReadAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationMutation) ResetField(name string) error {
+	switch name {
+	case notification.FieldType:
+		m.ResetType()
+		return nil
+	case notification.FieldActorCount:
+		m.ResetActorCount()
+		return nil
+	case notification.FieldReadAt:
+		m.ResetReadAt()
+		return nil
+	case notification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notification.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.recipient != nil {
+		edges = append(edges, notification.EdgeRecipient)
+	}
+	if m.actor != nil {
+		edges = append(edges, notification.EdgeActor)
+	}
+	if m.actors != nil {
+		edges = append(edges, notification.EdgeActors)
+	}
+	if m.post != nil {
+		edges = append(edges, notification.EdgePost)
+	}
+	if m.goal != nil {
+		edges = append(edges, notification.EdgeGoal)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notification.EdgeRecipient:
+		if id := m.recipient; id != nil {
+			return []ent.Value{*id}
+		}
+	case notification.EdgeActor:
+		if id := m.actor; id != nil {
+			return []ent.Value{*id}
+		}
+	case notification.EdgeActors:
+		ids := make([]ent.Value, 0, len(m.actors))
+		for id := range m.actors {
+			ids = append(ids, id)
+		}
+		return ids
+	case notification.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case notification.EdgeGoal:
+		if id := m.goal; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedactors != nil {
+		edges = append(edges, notification.EdgeActors)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case notification.EdgeActors:
+		ids := make([]ent.Value, 0, len(m.removedactors))
+		for id := range m.removedactors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedrecipient {
+		edges = append(edges, notification.EdgeRecipient)
+	}
+	if m.clearedactor {
+		edges = append(edges, notification.EdgeActor)
+	}
+	if m.clearedactors {
+		edges = append(edges, notification.EdgeActors)
+	}
+	if m.clearedpost {
+		edges = append(edges, notification.EdgePost)
+	}
+	if m.clearedgoal {
+		edges = append(edges, notification.EdgeGoal)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notification.EdgeRecipient:
+		return m.clearedrecipient
+	case notification.EdgeActor:
+		return m.clearedactor
+	case notification.EdgeActors:
+		return m.clearedactors
+	case notification.EdgePost:
+		return m.clearedpost
+	case notification.EdgeGoal:
+		return m.clearedgoal
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationMutation) ClearEdge(name string) error {
+	switch name {
+	case notification.EdgeRecipient:
+		m.ClearRecipient()
+		return nil
+	case notification.EdgeActor:
+		m.ClearActor()
+		return nil
+	case notification.EdgePost:
+		m.ClearPost()
+		return nil
+	case notification.EdgeGoal:
+		m.ClearGoal()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationMutation) ResetEdge(name string) error {
+	switch name {
+	case notification.EdgeRecipient:
+		m.ResetRecipient()
+		return nil
+	case notification.EdgeActor:
+		m.ResetActor()
+		return nil
+	case notification.EdgeActors:
+		m.ResetActors()
+		return nil
+	case notification.EdgePost:
+		m.ResetPost()
+		return nil
+	case notification.EdgeGoal:
+		m.ResetGoal()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification edge %s", name)
+}
+
+// NotificationDeliveryMutation represents an operation that mutates the NotificationDelivery nodes in the graph.
+type NotificationDeliveryMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	event_id         *uuid.UUID
+	delivered_at     *time.Time
+	clearedFields    map[string]struct{}
+	recipient        *uuid.UUID
+	clearedrecipient bool
+	done             bool
+	oldValue         func(context.Context) (*NotificationDelivery, error)
+	predicates       []predicate.NotificationDelivery
+}
+
+var _ ent.Mutation = (*NotificationDeliveryMutation)(nil)
+
+// notificationdeliveryOption allows management of the mutation configuration using functional options.
+type notificationdeliveryOption func(*NotificationDeliveryMutation)
+
+// newNotificationDeliveryMutation creates new mutation for the NotificationDelivery entity.
+func newNotificationDeliveryMutation(c config, op Op, opts ...notificationdeliveryOption) *NotificationDeliveryMutation {
+	m := &NotificationDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationDeliveryID sets the ID field of the mutation.
+func withNotificationDeliveryID(id uuid.UUID) notificationdeliveryOption {
+	return func(m *NotificationDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationDelivery sets the old NotificationDelivery of the mutation.
+func withNotificationDelivery(node *NotificationDelivery) notificationdeliveryOption {
+	return func(m *NotificationDeliveryMutation) {
+		m.oldValue = func(context.Context) (*NotificationDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NotificationDelivery entities.
+func (m *NotificationDeliveryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationDeliveryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEventID sets the "event_id" field.
+func (m *NotificationDeliveryMutation) SetEventID(u uuid.UUID) {
+	m.event_id = &u
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *NotificationDeliveryMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *NotificationDeliveryMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *NotificationDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *NotificationDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredAt returns the old "delivered_at" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldDeliveredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *NotificationDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+}
+
+// SetRecipientID sets the "recipient" edge to the User entity by id.
+func (m *NotificationDeliveryMutation) SetRecipientID(id uuid.UUID) {
+	m.recipient = &id
+}
+
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (m *NotificationDeliveryMutation) ClearRecipient() {
+	m.clearedrecipient = true
+}
+
+// RecipientCleared reports if the "recipient" edge to the User entity was cleared.
+func (m *NotificationDeliveryMutation) RecipientCleared() bool {
+	return m.clearedrecipient
+}
+
+// RecipientID returns the "recipient" edge ID in the mutation.
+func (m *NotificationDeliveryMutation) RecipientID() (id uuid.UUID, exists bool) {
+	if m.recipient != nil {
+		return *m.recipient, true
+	}
+	return
+}
+
+// RecipientIDs returns the "recipient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RecipientID instead. It exists only for internal usage by the builders.
+func (m *NotificationDeliveryMutation) RecipientIDs() (ids []uuid.UUID) {
+	if id := m.recipient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRecipient resets all changes to the "recipient" edge.
+func (m *NotificationDeliveryMutation) ResetRecipient() {
+	m.recipient = nil
+	m.clearedrecipient = false
+}
+
+// Where appends a list predicates to the NotificationDeliveryMutation builder.
+func (m *NotificationDeliveryMutation) Where(ps ...predicate.NotificationDelivery) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationDeliveryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationDelivery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationDeliveryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationDeliveryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NotificationDelivery).
+func (m *NotificationDeliveryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.event_id != nil {
+		fields = append(fields, notificationdelivery.FieldEventID)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, notificationdelivery.FieldDeliveredAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationDeliveryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notificationdelivery.FieldEventID:
+		return m.EventID()
+	case notificationdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notificationdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case notificationdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationDelivery field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationDeliveryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notificationdelivery.FieldEventID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case notificationdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationDelivery field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationDeliveryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationDeliveryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NotificationDelivery numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationDeliveryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationDeliveryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationDeliveryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NotificationDelivery nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationDeliveryMutation) ResetField(name string) error {
+	switch name {
+	case notificationdelivery.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case notificationdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationDelivery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.recipient != nil {
+		edges = append(edges, notificationdelivery.EdgeRecipient)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationDeliveryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notificationdelivery.EdgeRecipient:
+		if id := m.recipient; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationDeliveryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrecipient {
+		edges = append(edges, notificationdelivery.EdgeRecipient)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationDeliveryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notificationdelivery.EdgeRecipient:
+		return m.clearedrecipient
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationDeliveryMutation) ClearEdge(name string) error {
+	switch name {
+	case notificationdelivery.EdgeRecipient:
+		m.ClearRecipient()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationDelivery unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationDeliveryMutation) ResetEdge(name string) error {
+	switch name {
+	case notificationdelivery.EdgeRecipient:
+		m.ResetRecipient()
+		return nil
+	}
+	return fmt.Errorf("unknown NotificationDelivery edge %s", name)
+}
+
+// OutboxEventMutation represents an operation that mutates the OutboxEvent nodes in the graph.
+type OutboxEventMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	_type           *string
+	aggregate_id    *uuid.UUID
+	payload         *json.RawMessage
+	appendpayload   json.RawMessage
+	status          *outboxevent.Status
+	attempts        *int
+	addattempts     *int
+	next_attempt_at *time.Time
+	last_error      *string
+	dispatched_at   *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*OutboxEvent, error)
+	predicates      []predicate.OutboxEvent
+}
+
+var _ ent.Mutation = (*OutboxEventMutation)(nil)
+
+// outboxeventOption allows management of the mutation configuration using functional options.
+type outboxeventOption func(*OutboxEventMutation)
+
+// newOutboxEventMutation creates new mutation for the OutboxEvent entity.
+func newOutboxEventMutation(c config, op Op, opts ...outboxeventOption) *OutboxEventMutation {
+	m := &OutboxEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxEventID sets the ID field of the mutation.
+func withOutboxEventID(id uuid.UUID) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxEvent
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxEvent sets the old OutboxEvent of the mutation.
+func withOutboxEvent(node *OutboxEvent) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		m.oldValue = func(context.Context) (*OutboxEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OutboxEvent entities.
+func (m *OutboxEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *OutboxEventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *OutboxEventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *OutboxEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (m *OutboxEventMutation) SetAggregateID(u uuid.UUID) {
+	m.aggregate_id = &u
+}
+
+// AggregateID returns the value of the "aggregate_id" field in the mutation.
+func (m *OutboxEventMutation) AggregateID() (r uuid.UUID, exists bool) {
+	v := m.aggregate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregateID returns the old "aggregate_id" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAggregateID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregateID: %w", err)
+	}
+	return oldValue.AggregateID, nil
+}
+
+// ResetAggregateID resets all changes to the "aggregate_id" field.
+func (m *OutboxEventMutation) ResetAggregateID() {
+	m.aggregate_id = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxEventMutation) SetPayload(jm json.RawMessage) {
+	m.payload = &jm
+	m.appendpayload = nil
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxEventMutation) Payload() (r json.RawMessage, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPayload(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// AppendPayload adds jm to the "payload" field.
+func (m *OutboxEventMutation) AppendPayload(jm json.RawMessage) {
+	m.appendpayload = append(m.appendpayload, jm...)
+}
+
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *OutboxEventMutation) AppendedPayload() (json.RawMessage, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxEventMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OutboxEventMutation) SetStatus(o outboxevent.Status) {
+	m.status = &o
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OutboxEventMutation) Status() (r outboxevent.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldStatus(ctx context.Context) (v outboxevent.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OutboxEventMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxEventMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxEventMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxEventMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxEventMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxEventMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *OutboxEventMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *OutboxEventMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *OutboxEventMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *OutboxEventMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *OutboxEventMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *OutboxEventMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[outboxevent.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *OutboxEventMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *OutboxEventMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, outboxevent.FieldLastError)
+}
+
+// SetDispatchedAt sets the "dispatched_at" field.
+func (m *OutboxEventMutation) SetDispatchedAt(t time.Time) {
+	m.dispatched_at = &t
+}
+
+// DispatchedAt returns the value of the "dispatched_at" field in the mutation.
+func (m *OutboxEventMutation) DispatchedAt() (r time.Time, exists bool) {
+	v := m.dispatched_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDispatchedAt returns the old "dispatched_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldDispatchedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDispatchedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDispatchedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDispatchedAt: %w", err)
+	}
+	return oldValue.DispatchedAt, nil
+}
+
+// ClearDispatchedAt clears the value of the "dispatched_at" field.
+func (m *OutboxEventMutation) ClearDispatchedAt() {
+	m.dispatched_at = nil
+	m.clearedFields[outboxevent.FieldDispatchedAt] = struct{}{}
+}
+
+// DispatchedAtCleared returns if the "dispatched_at" field was cleared in this mutation.
+func (m *OutboxEventMutation) DispatchedAtCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldDispatchedAt]
+	return ok
+}
+
+// ResetDispatchedAt resets all changes to the "dispatched_at" field.
+func (m *OutboxEventMutation) ResetDispatchedAt() {
+	m.dispatched_at = nil
+	delete(m.clearedFields, outboxevent.FieldDispatchedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Where(ps ...predicate.OutboxEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OutboxEvent).
+func (m *OutboxEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxEventMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m._type != nil {
+		fields = append(fields, outboxevent.FieldType)
+	}
+	if m.aggregate_id != nil {
+		fields = append(fields, outboxevent.FieldAggregateID)
+	}
+	if m.payload != nil {
+		fields = append(fields, outboxevent.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, outboxevent.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, outboxevent.FieldNextAttemptAt)
+	}
+	if m.last_error != nil {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	if m.dispatched_at != nil {
+		fields = append(fields, outboxevent.FieldDispatchedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, outboxevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldType:
+		return m.GetType()
+	case outboxevent.FieldAggregateID:
+		return m.AggregateID()
+	case outboxevent.FieldPayload:
+		return m.Payload()
+	case outboxevent.FieldStatus:
+		return m.Status()
+	case outboxevent.FieldAttempts:
+		return m.Attempts()
+	case outboxevent.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case outboxevent.FieldLastError:
+		return m.LastError()
+	case outboxevent.FieldDispatchedAt:
+		return m.DispatchedAt()
+	case outboxevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outboxevent.FieldType:
+		return m.OldType(ctx)
+	case outboxevent.FieldAggregateID:
+		return m.OldAggregateID(ctx)
+	case outboxevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxevent.FieldStatus:
+		return m.OldStatus(ctx)
+	case outboxevent.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxevent.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case outboxevent.FieldLastError:
+		return m.OldLastError(ctx)
+	case outboxevent.FieldDispatchedAt:
+		return m.OldDispatchedAt(ctx)
+	case outboxevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case outboxevent.FieldAggregateID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggregateID(v)
+		return nil
+	case outboxevent.FieldPayload:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outboxevent.FieldStatus:
+		v, ok := value.(outboxevent.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outboxevent.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case outboxevent.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case outboxevent.FieldDispatchedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDispatchedAt(v)
+		return nil
+	case outboxevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outboxevent.FieldLastError) {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	if m.FieldCleared(outboxevent.FieldDispatchedAt) {
+		fields = append(fields, outboxevent.FieldDispatchedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ClearField(name string) error {
+	switch name {
+	case outboxevent.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case outboxevent.FieldDispatchedAt:
+		m.ClearDispatchedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ResetField(name string) error {
+	switch name {
+	case outboxevent.FieldType:
+		m.ResetType()
+		return nil
+	case outboxevent.FieldAggregateID:
+		m.ResetAggregateID()
+		return nil
+	case outboxevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outboxevent.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case outboxevent.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outboxevent.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case outboxevent.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case outboxevent.FieldDispatchedAt:
+		m.ResetDispatchedAt()
+		return nil
+	case outboxevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent edge %s", name)
+}
+
+// PostMutation represents an operation that mutates the Post nodes in the graph.
+type PostMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	content                 *string
+	format                  *post.Format
+	amount                  *float64
+	addamount               *float64
+	edit_count              *int
+	addedit_count           *int
+	status                  *post.Status
+	publish_at              *time.Time
+	created_at              *time.Time
+	updated_at              *time.Time
+	hidden_at               *time.Time
+	search_vector           *types.TSVector
+	content_hash            *string
+	clearedFields           map[string]struct{}
+	user                    *uuid.UUID
+	cleareduser             bool
+	goal                    *uuid.UUID
+	clearedgoal             bool
+	images                  map[uuid.UUID]struct{}
+	removedimages           map[uuid.UUID]struct{}
+	clearedimages           bool
+	reactions               map[uuid.UUID]struct{}
+	removedreactions        map[uuid.UUID]struct{}
+	clearedreactions        bool
+	comments                map[uuid.UUID]struct{}
+	removedcomments         map[uuid.UUID]struct{}
+	clearedcomments         bool
+	reposts                 map[uuid.UUID]struct{}
+	removedreposts          map[uuid.UUID]struct{}
+	clearedreposts          bool
+	quote_of                *uuid.UUID
+	clearedquote_of         bool
+	quotes                  map[uuid.UUID]struct{}
+	removedquotes           map[uuid.UUID]struct{}
+	clearedquotes           bool
+	bookmarks               map[uuid.UUID]struct{}
+	removedbookmarks        map[uuid.UUID]struct{}
+	clearedbookmarks        bool
+	mentions                map[uuid.UUID]struct{}
+	removedmentions         map[uuid.UUID]struct{}
+	clearedmentions         bool
+	hashtags                map[uuid.UUID]struct{}
+	removedhashtags         map[uuid.UUID]struct{}
+	clearedhashtags         bool
+	revisions               map[uuid.UUID]struct{}
+	removedrevisions        map[uuid.UUID]struct{}
+	clearedrevisions        bool
+	timeline_entries        map[uuid.UUID]struct{}
+	removedtimeline_entries map[uuid.UUID]struct{}
+	clearedtimeline_entries bool
+	notifications           map[uuid.UUID]struct{}
+	removednotifications    map[uuid.UUID]struct{}
+	clearednotifications    bool
+	done                    bool
+	oldValue                func(context.Context) (*Post, error)
+	predicates              []predicate.Post
+}
+
+var _ ent.Mutation = (*PostMutation)(nil)
+
+// postOption allows management of the mutation configuration using functional options.
+type postOption func(*PostMutation)
+
+// newPostMutation creates new mutation for the Post entity.
+func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
+	m := &PostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostID sets the ID field of the mutation.
+func withPostID(id uuid.UUID) postOption {
+	return func(m *PostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Post
+		)
+		m.oldValue = func(ctx context.Context) (*Post, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Post.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPost sets the old Post of the mutation.
+func withPost(node *Post) postOption {
+	return func(m *PostMutation) {
+		m.oldValue = func(context.Context) (*Post, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Post entities.
+func (m *PostMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetContent sets the "content" field.
+func (m *PostMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *PostMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *PostMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *PostMutation) SetFormat(po post.Format) {
+	m.format = &po
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *PostMutation) Format() (r post.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldFormat(ctx context.Context) (v post.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *PostMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *PostMutation) SetAmount(f float64) {
+	m.amount = &f
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *PostMutation) Amount() (r float64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldAmount(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds f to the "amount" field.
+func (m *PostMutation) AddAmount(f float64) {
+	if m.addamount != nil {
+		*m.addamount += f
+	} else {
+		m.addamount = &f
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *PostMutation) AddedAmount() (r float64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (m *PostMutation) ClearAmount() {
+	m.amount = nil
+	m.addamount = nil
+	m.clearedFields[post.FieldAmount] = struct{}{}
+}
+
+// AmountCleared returns if the "amount" field was cleared in this mutation.
+func (m *PostMutation) AmountCleared() bool {
+	_, ok := m.clearedFields[post.FieldAmount]
+	return ok
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *PostMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+	delete(m.clearedFields, post.FieldAmount)
+}
+
+// SetEditCount sets the "edit_count" field.
+func (m *PostMutation) SetEditCount(i int) {
+	m.edit_count = &i
+	m.addedit_count = nil
+}
+
+// EditCount returns the value of the "edit_count" field in the mutation.
+func (m *PostMutation) EditCount() (r int, exists bool) {
+	v := m.edit_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditCount returns the old "edit_count" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldEditCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditCount: %w", err)
+	}
+	return oldValue.EditCount, nil
+}
+
+// AddEditCount adds i to the "edit_count" field.
+func (m *PostMutation) AddEditCount(i int) {
+	if m.addedit_count != nil {
+		*m.addedit_count += i
+	} else {
+		m.addedit_count = &i
+	}
+}
+
+// AddedEditCount returns the value that was added to the "edit_count" field in this mutation.
+func (m *PostMutation) AddedEditCount() (r int, exists bool) {
+	v := m.addedit_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEditCount resets all changes to the "edit_count" field.
+func (m *PostMutation) ResetEditCount() {
+	m.edit_count = nil
+	m.addedit_count = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PostMutation) SetStatus(po post.Status) {
+	m.status = &po
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PostMutation) Status() (r post.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldStatus(ctx context.Context) (v post.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PostMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (m *PostMutation) SetPublishAt(t time.Time) {
+	m.publish_at = &t
+}
+
+// PublishAt returns the value of the "publish_at" field in the mutation.
+func (m *PostMutation) PublishAt() (r time.Time, exists bool) {
+	v := m.publish_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishAt returns the old "publish_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldPublishAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishAt: %w", err)
+	}
+	return oldValue.PublishAt, nil
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (m *PostMutation) ClearPublishAt() {
+	m.publish_at = nil
+	m.clearedFields[post.FieldPublishAt] = struct{}{}
+}
+
+// PublishAtCleared returns if the "publish_at" field was cleared in this mutation.
+func (m *PostMutation) PublishAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldPublishAt]
+	return ok
+}
+
+// ResetPublishAt resets all changes to the "publish_at" field.
+func (m *PostMutation) ResetPublishAt() {
+	m.publish_at = nil
+	delete(m.clearedFields, post.FieldPublishAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PostMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PostMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (m *PostMutation) SetHiddenAt(t time.Time) {
+	m.hidden_at = &t
+}
+
+// HiddenAt returns the value of the "hidden_at" field in the mutation.
+func (m *PostMutation) HiddenAt() (r time.Time, exists bool) {
+	v := m.hidden_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHiddenAt returns the old "hidden_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldHiddenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHiddenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHiddenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHiddenAt: %w", err)
+	}
+	return oldValue.HiddenAt, nil
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (m *PostMutation) ClearHiddenAt() {
+	m.hidden_at = nil
+	m.clearedFields[post.FieldHiddenAt] = struct{}{}
+}
+
+// HiddenAtCleared returns if the "hidden_at" field was cleared in this mutation.
+func (m *PostMutation) HiddenAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldHiddenAt]
+	return ok
+}
+
+// ResetHiddenAt resets all changes to the "hidden_at" field.
+func (m *PostMutation) ResetHiddenAt() {
+	m.hidden_at = nil
+	delete(m.clearedFields, post.FieldHiddenAt)
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (m *PostMutation) SetSearchVector(tv types.TSVector) {
+	m.search_vector = &tv
+}
+
+// SearchVector returns the value of the "search_vector" field in the mutation.
+func (m *PostMutation) SearchVector() (r types.TSVector, exists bool) {
+	v := m.search_vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchVector returns the old "search_vector" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldSearchVector(ctx context.Context) (v types.TSVector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchVector: %w", err)
+	}
+	return oldValue.SearchVector, nil
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (m *PostMutation) ClearSearchVector() {
+	m.search_vector = nil
+	m.clearedFields[post.FieldSearchVector] = struct{}{}
+}
+
+// SearchVectorCleared returns if the "search_vector" field was cleared in this mutation.
+func (m *PostMutation) SearchVectorCleared() bool {
+	_, ok := m.clearedFields[post.FieldSearchVector]
+	return ok
+}
+
+// ResetSearchVector resets all changes to the "search_vector" field.
+func (m *PostMutation) ResetSearchVector() {
+	m.search_vector = nil
+	delete(m.clearedFields, post.FieldSearchVector)
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *PostMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *PostMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *PostMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[post.FieldContentHash] = struct{}{}
+}
+
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *PostMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[post.FieldContentHash]
+	return ok
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *PostMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, post.FieldContentHash)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *PostMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PostMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PostMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *PostMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PostMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by id.
+func (m *PostMutation) SetGoalID(id uuid.UUID) {
+	m.goal = &id
+}
+
+// ClearGoal clears the "goal" edge to the Goal entity.
+func (m *PostMutation) ClearGoal() {
+	m.clearedgoal = true
+}
+
+// GoalCleared reports if the "goal" edge to the Goal entity was cleared.
+func (m *PostMutation) GoalCleared() bool {
+	return m.clearedgoal
+}
+
+// GoalID returns the "goal" edge ID in the mutation.
+func (m *PostMutation) GoalID() (id uuid.UUID, exists bool) {
+	if m.goal != nil {
+		return *m.goal, true
+	}
+	return
+}
+
+// GoalIDs returns the "goal" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GoalID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) GoalIDs() (ids []uuid.UUID) {
+	if id := m.goal; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGoal resets all changes to the "goal" edge.
+func (m *PostMutation) ResetGoal() {
+	m.goal = nil
+	m.clearedgoal = false
+}
+
+// AddImageIDs adds the "images" edge to the Image entity by ids.
+func (m *PostMutation) AddImageIDs(ids ...uuid.UUID) {
+	if m.images == nil {
+		m.images = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.images[ids[i]] = struct{}{}
+	}
+}
+
+// ClearImages clears the "images" edge to the Image entity.
+func (m *PostMutation) ClearImages() {
+	m.clearedimages = true
+}
+
+// ImagesCleared reports if the "images" edge to the Image entity was cleared.
+func (m *PostMutation) ImagesCleared() bool {
+	return m.clearedimages
+}
+
+// RemoveImageIDs removes the "images" edge to the Image entity by IDs.
+func (m *PostMutation) RemoveImageIDs(ids ...uuid.UUID) {
+	if m.removedimages == nil {
+		m.removedimages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.images, ids[i])
+		m.removedimages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedImages returns the removed IDs of the "images" edge to the Image entity.
+func (m *PostMutation) RemovedImagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedimages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ImagesIDs returns the "images" edge IDs in the mutation.
+func (m *PostMutation) ImagesIDs() (ids []uuid.UUID) {
+	for id := range m.images {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetImages resets all changes to the "images" edge.
+func (m *PostMutation) ResetImages() {
+	m.images = nil
+	m.clearedimages = false
+	m.removedimages = nil
+}
+
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by ids.
+func (m *PostMutation) AddReactionIDs(ids ...uuid.UUID) {
+	if m.reactions == nil {
+		m.reactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReactions clears the "reactions" edge to the Reaction entity.
+func (m *PostMutation) ClearReactions() {
+	m.clearedreactions = true
+}
+
+// ReactionsCleared reports if the "reactions" edge to the Reaction entity was cleared.
+func (m *PostMutation) ReactionsCleared() bool {
+	return m.clearedreactions
+}
+
+// RemoveReactionIDs removes the "reactions" edge to the Reaction entity by IDs.
+func (m *PostMutation) RemoveReactionIDs(ids ...uuid.UUID) {
+	if m.removedreactions == nil {
+		m.removedreactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reactions, ids[i])
+		m.removedreactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReactions returns the removed IDs of the "reactions" edge to the Reaction entity.
+func (m *PostMutation) RemovedReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReactionsIDs returns the "reactions" edge IDs in the mutation.
+func (m *PostMutation) ReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.reactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReactions resets all changes to the "reactions" edge.
+func (m *PostMutation) ResetReactions() {
+	m.reactions = nil
+	m.clearedreactions = false
+	m.removedreactions = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *PostMutation) AddCommentIDs(ids ...uuid.UUID) {
+	if m.comments == nil {
+		m.comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *PostMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *PostMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *PostMutation) RemoveCommentIDs(ids ...uuid.UUID) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *PostMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *PostMutation) CommentsIDs() (ids []uuid.UUID) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *PostMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddRepostIDs adds the "reposts" edge to the Repost entity by ids.
+func (m *PostMutation) AddRepostIDs(ids ...uuid.UUID) {
+	if m.reposts == nil {
+		m.reposts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reposts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReposts clears the "reposts" edge to the Repost entity.
+func (m *PostMutation) ClearReposts() {
+	m.clearedreposts = true
+}
+
+// RepostsCleared reports if the "reposts" edge to the Repost entity was cleared.
+func (m *PostMutation) RepostsCleared() bool {
+	return m.clearedreposts
+}
+
+// RemoveRepostIDs removes the "reposts" edge to the Repost entity by IDs.
+func (m *PostMutation) RemoveRepostIDs(ids ...uuid.UUID) {
+	if m.removedreposts == nil {
+		m.removedreposts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reposts, ids[i])
+		m.removedreposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReposts returns the removed IDs of the "reposts" edge to the Repost entity.
+func (m *PostMutation) RemovedRepostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepostsIDs returns the "reposts" edge IDs in the mutation.
+func (m *PostMutation) RepostsIDs() (ids []uuid.UUID) {
+	for id := range m.reposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReposts resets all changes to the "reposts" edge.
+func (m *PostMutation) ResetReposts() {
+	m.reposts = nil
+	m.clearedreposts = false
+	m.removedreposts = nil
+}
+
+// SetQuoteOfID sets the "quote_of" edge to the Post entity by id.
+func (m *PostMutation) SetQuoteOfID(id uuid.UUID) {
+	m.quote_of = &id
+}
+
+// ClearQuoteOf clears the "quote_of" edge to the Post entity.
+func (m *PostMutation) ClearQuoteOf() {
+	m.clearedquote_of = true
+}
+
+// QuoteOfCleared reports if the "quote_of" edge to the Post entity was cleared.
+func (m *PostMutation) QuoteOfCleared() bool {
+	return m.clearedquote_of
+}
+
+// QuoteOfID returns the "quote_of" edge ID in the mutation.
+func (m *PostMutation) QuoteOfID() (id uuid.UUID, exists bool) {
+	if m.quote_of != nil {
+		return *m.quote_of, true
+	}
+	return
+}
+
+// QuoteOfIDs returns the "quote_of" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuoteOfID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) QuoteOfIDs() (ids []uuid.UUID) {
+	if id := m.quote_of; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuoteOf resets all changes to the "quote_of" edge.
+func (m *PostMutation) ResetQuoteOf() {
+	m.quote_of = nil
+	m.clearedquote_of = false
+}
+
+// AddQuoteIDs adds the "quotes" edge to the Post entity by ids.
+func (m *PostMutation) AddQuoteIDs(ids ...uuid.UUID) {
+	if m.quotes == nil {
+		m.quotes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.quotes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuotes clears the "quotes" edge to the Post entity.
+func (m *PostMutation) ClearQuotes() {
+	m.clearedquotes = true
+}
+
+// QuotesCleared reports if the "quotes" edge to the Post entity was cleared.
+func (m *PostMutation) QuotesCleared() bool {
+	return m.clearedquotes
+}
+
+// RemoveQuoteIDs removes the "quotes" edge to the Post entity by IDs.
+func (m *PostMutation) RemoveQuoteIDs(ids ...uuid.UUID) {
+	if m.removedquotes == nil {
+		m.removedquotes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.quotes, ids[i])
+		m.removedquotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuotes returns the removed IDs of the "quotes" edge to the Post entity.
+func (m *PostMutation) RemovedQuotesIDs() (ids []uuid.UUID) {
+	for id := range m.removedquotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuotesIDs returns the "quotes" edge IDs in the mutation.
+func (m *PostMutation) QuotesIDs() (ids []uuid.UUID) {
+	for id := range m.quotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuotes resets all changes to the "quotes" edge.
+func (m *PostMutation) ResetQuotes() {
+	m.quotes = nil
+	m.clearedquotes = false
+	m.removedquotes = nil
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by ids.
+func (m *PostMutation) AddBookmarkIDs(ids ...uuid.UUID) {
+	if m.bookmarks == nil {
+		m.bookmarks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.bookmarks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBookmarks clears the "bookmarks" edge to the Bookmark entity.
+func (m *PostMutation) ClearBookmarks() {
+	m.clearedbookmarks = true
+}
+
+// BookmarksCleared reports if the "bookmarks" edge to the Bookmark entity was cleared.
+func (m *PostMutation) BookmarksCleared() bool {
+	return m.clearedbookmarks
+}
+
+// RemoveBookmarkIDs removes the "bookmarks" edge to the Bookmark entity by IDs.
+func (m *PostMutation) RemoveBookmarkIDs(ids ...uuid.UUID) {
+	if m.removedbookmarks == nil {
+		m.removedbookmarks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.bookmarks, ids[i])
+		m.removedbookmarks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBookmarks returns the removed IDs of the "bookmarks" edge to the Bookmark entity.
+func (m *PostMutation) RemovedBookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.removedbookmarks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BookmarksIDs returns the "bookmarks" edge IDs in the mutation.
+func (m *PostMutation) BookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.bookmarks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBookmarks resets all changes to the "bookmarks" edge.
+func (m *PostMutation) ResetBookmarks() {
+	m.bookmarks = nil
+	m.clearedbookmarks = false
+	m.removedbookmarks = nil
+}
+
+// AddMentionIDs adds the "mentions" edge to the User entity by ids.
+func (m *PostMutation) AddMentionIDs(ids ...uuid.UUID) {
+	if m.mentions == nil {
+		m.mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.mentions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMentions clears the "mentions" edge to the User entity.
+func (m *PostMutation) ClearMentions() {
+	m.clearedmentions = true
+}
+
+// MentionsCleared reports if the "mentions" edge to the User entity was cleared.
+func (m *PostMutation) MentionsCleared() bool {
+	return m.clearedmentions
+}
+
+// RemoveMentionIDs removes the "mentions" edge to the User entity by IDs.
+func (m *PostMutation) RemoveMentionIDs(ids ...uuid.UUID) {
+	if m.removedmentions == nil {
+		m.removedmentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.mentions, ids[i])
+		m.removedmentions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMentions returns the removed IDs of the "mentions" edge to the User entity.
+func (m *PostMutation) RemovedMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MentionsIDs returns the "mentions" edge IDs in the mutation.
+func (m *PostMutation) MentionsIDs() (ids []uuid.UUID) {
+	for id := range m.mentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMentions resets all changes to the "mentions" edge.
+func (m *PostMutation) ResetMentions() {
+	m.mentions = nil
+	m.clearedmentions = false
+	m.removedmentions = nil
+}
+
+// AddHashtagIDs adds the "hashtags" edge to the Hashtag entity by ids.
+func (m *PostMutation) AddHashtagIDs(ids ...uuid.UUID) {
+	if m.hashtags == nil {
+		m.hashtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.hashtags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHashtags clears the "hashtags" edge to the Hashtag entity.
+func (m *PostMutation) ClearHashtags() {
+	m.clearedhashtags = true
+}
+
+// HashtagsCleared reports if the "hashtags" edge to the Hashtag entity was cleared.
+func (m *PostMutation) HashtagsCleared() bool {
+	return m.clearedhashtags
+}
+
+// RemoveHashtagIDs removes the "hashtags" edge to the Hashtag entity by IDs.
+func (m *PostMutation) RemoveHashtagIDs(ids ...uuid.UUID) {
+	if m.removedhashtags == nil {
+		m.removedhashtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.hashtags, ids[i])
+		m.removedhashtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHashtags returns the removed IDs of the "hashtags" edge to the Hashtag entity.
+func (m *PostMutation) RemovedHashtagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedhashtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HashtagsIDs returns the "hashtags" edge IDs in the mutation.
+func (m *PostMutation) HashtagsIDs() (ids []uuid.UUID) {
+	for id := range m.hashtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHashtags resets all changes to the "hashtags" edge.
+func (m *PostMutation) ResetHashtags() {
+	m.hashtags = nil
+	m.clearedhashtags = false
+	m.removedhashtags = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by ids.
+func (m *PostMutation) AddRevisionIDs(ids ...uuid.UUID) {
+	if m.revisions == nil {
+		m.revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the PostRevision entity.
+func (m *PostMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the PostRevision entity was cleared.
+func (m *PostMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the PostRevision entity by IDs.
+func (m *PostMutation) RemoveRevisionIDs(ids ...uuid.UUID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the PostRevision entity.
+func (m *PostMutation) RemovedRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *PostMutation) RevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *PostMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddTimelineEntryIDs adds the "timeline_entries" edge to the TimelineEntry entity by ids.
+func (m *PostMutation) AddTimelineEntryIDs(ids ...uuid.UUID) {
+	if m.timeline_entries == nil {
+		m.timeline_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.timeline_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTimelineEntries clears the "timeline_entries" edge to the TimelineEntry entity.
+func (m *PostMutation) ClearTimelineEntries() {
+	m.clearedtimeline_entries = true
+}
+
+// TimelineEntriesCleared reports if the "timeline_entries" edge to the TimelineEntry entity was cleared.
+func (m *PostMutation) TimelineEntriesCleared() bool {
+	return m.clearedtimeline_entries
+}
+
+// RemoveTimelineEntryIDs removes the "timeline_entries" edge to the TimelineEntry entity by IDs.
+func (m *PostMutation) RemoveTimelineEntryIDs(ids ...uuid.UUID) {
+	if m.removedtimeline_entries == nil {
+		m.removedtimeline_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.timeline_entries, ids[i])
+		m.removedtimeline_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTimelineEntries returns the removed IDs of the "timeline_entries" edge to the TimelineEntry entity.
+func (m *PostMutation) RemovedTimelineEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtimeline_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TimelineEntriesIDs returns the "timeline_entries" edge IDs in the mutation.
+func (m *PostMutation) TimelineEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.timeline_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTimelineEntries resets all changes to the "timeline_entries" edge.
+func (m *PostMutation) ResetTimelineEntries() {
+	m.timeline_entries = nil
+	m.clearedtimeline_entries = false
+	m.removedtimeline_entries = nil
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *PostMutation) AddNotificationIDs(ids ...uuid.UUID) {
+	if m.notifications == nil {
+		m.notifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *PostMutation) ClearNotifications() {
+	m.clearednotifications = true
+}
+
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *PostMutation) NotificationsCleared() bool {
+	return m.clearednotifications
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *PostMutation) RemoveNotificationIDs(ids ...uuid.UUID) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *PostMutation) RemovedNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *PostMutation) NotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *PostMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
+}
+
+// Where appends a list predicates to the PostMutation builder.
+func (m *PostMutation) Where(ps ...predicate.Post) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Post, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Post).
+func (m *PostMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.content != nil {
+		fields = append(fields, post.FieldContent)
+	}
+	if m.format != nil {
+		fields = append(fields, post.FieldFormat)
+	}
+	if m.amount != nil {
+		fields = append(fields, post.FieldAmount)
+	}
+	if m.edit_count != nil {
+		fields = append(fields, post.FieldEditCount)
+	}
+	if m.status != nil {
+		fields = append(fields, post.FieldStatus)
+	}
+	if m.publish_at != nil {
+		fields = append(fields, post.FieldPublishAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, post.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, post.FieldUpdatedAt)
+	}
+	if m.hidden_at != nil {
+		fields = append(fields, post.FieldHiddenAt)
+	}
+	if m.search_vector != nil {
+		fields = append(fields, post.FieldSearchVector)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, post.FieldContentHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case post.FieldContent:
+		return m.Content()
+	case post.FieldFormat:
+		return m.Format()
+	case post.FieldAmount:
+		return m.Amount()
+	case post.FieldEditCount:
+		return m.EditCount()
+	case post.FieldStatus:
+		return m.Status()
+	case post.FieldPublishAt:
+		return m.PublishAt()
+	case post.FieldCreatedAt:
+		return m.CreatedAt()
+	case post.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case post.FieldHiddenAt:
+		return m.HiddenAt()
+	case post.FieldSearchVector:
+		return m.SearchVector()
+	case post.FieldContentHash:
+		return m.ContentHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case post.FieldContent:
+		return m.OldContent(ctx)
+	case post.FieldFormat:
+		return m.OldFormat(ctx)
+	case post.FieldAmount:
+		return m.OldAmount(ctx)
+	case post.FieldEditCount:
+		return m.OldEditCount(ctx)
+	case post.FieldStatus:
+		return m.OldStatus(ctx)
+	case post.FieldPublishAt:
+		return m.OldPublishAt(ctx)
+	case post.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case post.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case post.FieldHiddenAt:
+		return m.OldHiddenAt(ctx)
+	case post.FieldSearchVector:
+		return m.OldSearchVector(ctx)
+	case post.FieldContentHash:
+		return m.OldContentHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown Post field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case post.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case post.FieldFormat:
+		v, ok := value.(post.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case post.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case post.FieldEditCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditCount(v)
+		return nil
+	case post.FieldStatus:
+		v, ok := value.(post.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case post.FieldPublishAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishAt(v)
+		return nil
+	case post.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case post.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case post.FieldHiddenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHiddenAt(v)
+		return nil
+	case post.FieldSearchVector:
+		v, ok := value.(types.TSVector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchVector(v)
+		return nil
+	case post.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Post field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, post.FieldAmount)
+	}
+	if m.addedit_count != nil {
+		fields = append(fields, post.FieldEditCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case post.FieldAmount:
+		return m.AddedAmount()
+	case post.FieldEditCount:
+		return m.AddedEditCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case post.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case post.FieldEditCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEditCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Post numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(post.FieldAmount) {
+		fields = append(fields, post.FieldAmount)
+	}
+	if m.FieldCleared(post.FieldPublishAt) {
+		fields = append(fields, post.FieldPublishAt)
+	}
+	if m.FieldCleared(post.FieldHiddenAt) {
+		fields = append(fields, post.FieldHiddenAt)
+	}
+	if m.FieldCleared(post.FieldSearchVector) {
+		fields = append(fields, post.FieldSearchVector)
+	}
+	if m.FieldCleared(post.FieldContentHash) {
+		fields = append(fields, post.FieldContentHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostMutation) ClearField(name string) error {
+	switch name {
+	case post.FieldAmount:
+		m.ClearAmount()
+		return nil
+	case post.FieldPublishAt:
+		m.ClearPublishAt()
+		return nil
+	case post.FieldHiddenAt:
+		m.ClearHiddenAt()
+		return nil
+	case post.FieldSearchVector:
+		m.ClearSearchVector()
+		return nil
+	case post.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	}
+	return fmt.Errorf("unknown Post nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostMutation) ResetField(name string) error {
+	switch name {
+	case post.FieldContent:
+		m.ResetContent()
+		return nil
+	case post.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case post.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case post.FieldEditCount:
+		m.ResetEditCount()
+		return nil
+	case post.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case post.FieldPublishAt:
+		m.ResetPublishAt()
+		return nil
+	case post.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case post.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case post.FieldHiddenAt:
+		m.ResetHiddenAt()
+		return nil
+	case post.FieldSearchVector:
+		m.ResetSearchVector()
+		return nil
+	case post.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	}
+	return fmt.Errorf("unknown Post field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.user != nil {
+		edges = append(edges, post.EdgeUser)
+	}
+	if m.goal != nil {
+		edges = append(edges, post.EdgeGoal)
+	}
+	if m.images != nil {
+		edges = append(edges, post.EdgeImages)
+	}
+	if m.reactions != nil {
+		edges = append(edges, post.EdgeReactions)
+	}
+	if m.comments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.reposts != nil {
+		edges = append(edges, post.EdgeReposts)
+	}
+	if m.quote_of != nil {
+		edges = append(edges, post.EdgeQuoteOf)
+	}
+	if m.quotes != nil {
+		edges = append(edges, post.EdgeQuotes)
+	}
+	if m.bookmarks != nil {
+		edges = append(edges, post.EdgeBookmarks)
+	}
+	if m.mentions != nil {
+		edges = append(edges, post.EdgeMentions)
+	}
+	if m.hashtags != nil {
+		edges = append(edges, post.EdgeHashtags)
+	}
+	if m.revisions != nil {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	if m.timeline_entries != nil {
+		edges = append(edges, post.EdgeTimelineEntries)
+	}
+	if m.notifications != nil {
+		edges = append(edges, post.EdgeNotifications)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeGoal:
+		if id := m.goal; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeImages:
+		ids := make([]ent.Value, 0, len(m.images))
+		for id := range m.images {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.reactions))
+		for id := range m.reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeReposts:
+		ids := make([]ent.Value, 0, len(m.reposts))
+		for id := range m.reposts {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeQuoteOf:
+		if id := m.quote_of; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeQuotes:
+		ids := make([]ent.Value, 0, len(m.quotes))
+		for id := range m.quotes {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.bookmarks))
+		for id := range m.bookmarks {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.mentions))
+		for id := range m.mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeHashtags:
+		ids := make([]ent.Value, 0, len(m.hashtags))
+		for id := range m.hashtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeTimelineEntries:
+		ids := make([]ent.Value, 0, len(m.timeline_entries))
+		for id := range m.timeline_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.removedimages != nil {
+		edges = append(edges, post.EdgeImages)
+	}
+	if m.removedreactions != nil {
+		edges = append(edges, post.EdgeReactions)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.removedreposts != nil {
+		edges = append(edges, post.EdgeReposts)
+	}
+	if m.removedquotes != nil {
+		edges = append(edges, post.EdgeQuotes)
+	}
+	if m.removedbookmarks != nil {
+		edges = append(edges, post.EdgeBookmarks)
+	}
+	if m.removedmentions != nil {
+		edges = append(edges, post.EdgeMentions)
+	}
+	if m.removedhashtags != nil {
+		edges = append(edges, post.EdgeHashtags)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	if m.removedtimeline_entries != nil {
+		edges = append(edges, post.EdgeTimelineEntries)
+	}
+	if m.removednotifications != nil {
+		edges = append(edges, post.EdgeNotifications)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeImages:
+		ids := make([]ent.Value, 0, len(m.removedimages))
+		for id := range m.removedimages {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.removedreactions))
+		for id := range m.removedreactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeReposts:
+		ids := make([]ent.Value, 0, len(m.removedreposts))
+		for id := range m.removedreposts {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeQuotes:
+		ids := make([]ent.Value, 0, len(m.removedquotes))
+		for id := range m.removedquotes {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.removedbookmarks))
+		for id := range m.removedbookmarks {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.removedmentions))
+		for id := range m.removedmentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeHashtags:
+		ids := make([]ent.Value, 0, len(m.removedhashtags))
+		for id := range m.removedhashtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeTimelineEntries:
+		ids := make([]ent.Value, 0, len(m.removedtimeline_entries))
+		for id := range m.removedtimeline_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.cleareduser {
+		edges = append(edges, post.EdgeUser)
+	}
+	if m.clearedgoal {
+		edges = append(edges, post.EdgeGoal)
+	}
+	if m.clearedimages {
+		edges = append(edges, post.EdgeImages)
+	}
+	if m.clearedreactions {
+		edges = append(edges, post.EdgeReactions)
+	}
+	if m.clearedcomments {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.clearedreposts {
+		edges = append(edges, post.EdgeReposts)
+	}
+	if m.clearedquote_of {
+		edges = append(edges, post.EdgeQuoteOf)
+	}
+	if m.clearedquotes {
+		edges = append(edges, post.EdgeQuotes)
+	}
+	if m.clearedbookmarks {
+		edges = append(edges, post.EdgeBookmarks)
+	}
+	if m.clearedmentions {
+		edges = append(edges, post.EdgeMentions)
+	}
+	if m.clearedhashtags {
+		edges = append(edges, post.EdgeHashtags)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	if m.clearedtimeline_entries {
+		edges = append(edges, post.EdgeTimelineEntries)
+	}
+	if m.clearednotifications {
+		edges = append(edges, post.EdgeNotifications)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostMutation) EdgeCleared(name string) bool {
+	switch name {
+	case post.EdgeUser:
+		return m.cleareduser
+	case post.EdgeGoal:
+		return m.clearedgoal
+	case post.EdgeImages:
+		return m.clearedimages
+	case post.EdgeReactions:
+		return m.clearedreactions
+	case post.EdgeComments:
+		return m.clearedcomments
+	case post.EdgeReposts:
+		return m.clearedreposts
+	case post.EdgeQuoteOf:
+		return m.clearedquote_of
+	case post.EdgeQuotes:
+		return m.clearedquotes
+	case post.EdgeBookmarks:
+		return m.clearedbookmarks
+	case post.EdgeMentions:
+		return m.clearedmentions
+	case post.EdgeHashtags:
+		return m.clearedhashtags
+	case post.EdgeRevisions:
+		return m.clearedrevisions
+	case post.EdgeTimelineEntries:
+		return m.clearedtimeline_entries
+	case post.EdgeNotifications:
+		return m.clearednotifications
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostMutation) ClearEdge(name string) error {
+	switch name {
+	case post.EdgeUser:
+		m.ClearUser()
+		return nil
+	case post.EdgeGoal:
+		m.ClearGoal()
+		return nil
+	case post.EdgeQuoteOf:
+		m.ClearQuoteOf()
+		return nil
+	}
+	return fmt.Errorf("unknown Post unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostMutation) ResetEdge(name string) error {
+	switch name {
+	case post.EdgeUser:
+		m.ResetUser()
+		return nil
+	case post.EdgeGoal:
+		m.ResetGoal()
+		return nil
+	case post.EdgeImages:
+		m.ResetImages()
+		return nil
+	case post.EdgeReactions:
+		m.ResetReactions()
+		return nil
+	case post.EdgeComments:
+		m.ResetComments()
+		return nil
+	case post.EdgeReposts:
+		m.ResetReposts()
+		return nil
+	case post.EdgeQuoteOf:
+		m.ResetQuoteOf()
+		return nil
+	case post.EdgeQuotes:
+		m.ResetQuotes()
+		return nil
+	case post.EdgeBookmarks:
+		m.ResetBookmarks()
+		return nil
+	case post.EdgeMentions:
+		m.ResetMentions()
+		return nil
+	case post.EdgeHashtags:
+		m.ResetHashtags()
+		return nil
+	case post.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case post.EdgeTimelineEntries:
+		m.ResetTimelineEntries()
+		return nil
+	case post.EdgeNotifications:
+		m.ResetNotifications()
+		return nil
+	}
+	return fmt.Errorf("unknown Post edge %s", name)
+}
+
+// PostRevisionMutation represents an operation that mutates the PostRevision nodes in the graph.
+type PostRevisionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	revision        *int
+	addrevision     *int
+	content         *string
+	format          *postrevision.Format
+	amount          *float64
+	addamount       *float64
+	image_ids       *[]uuid.UUID
+	appendimage_ids []uuid.UUID
+	created_at      *time.Time
+	replaced_at     *time.Time
+	clearedFields   map[string]struct{}
+	post            *uuid.UUID
+	clearedpost     bool
+	done            bool
+	oldValue        func(context.Context) (*PostRevision, error)
+	predicates      []predicate.PostRevision
+}
+
+var _ ent.Mutation = (*PostRevisionMutation)(nil)
+
+// postrevisionOption allows management of the mutation configuration using functional options.
+type postrevisionOption func(*PostRevisionMutation)
+
+// newPostRevisionMutation creates new mutation for the PostRevision entity.
+func newPostRevisionMutation(c config, op Op, opts ...postrevisionOption) *PostRevisionMutation {
+	m := &PostRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostRevisionID sets the ID field of the mutation.
+func withPostRevisionID(id uuid.UUID) postrevisionOption {
+	return func(m *PostRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PostRevision
+		)
+		m.oldValue = func(ctx context.Context) (*PostRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PostRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPostRevision sets the old PostRevision of the mutation.
+func withPostRevision(node *PostRevision) postrevisionOption {
+	return func(m *PostRevisionMutation) {
+		m.oldValue = func(context.Context) (*PostRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PostRevision entities.
+func (m *PostRevisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostRevisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PostRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRevision sets the "revision" field.
+func (m *PostRevisionMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *PostRevisionMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *PostRevisionMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *PostRevisionMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *PostRevisionMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetContent sets the "content" field.
+func (m *PostRevisionMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *PostRevisionMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *PostRevisionMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *PostRevisionMutation) SetFormat(po postrevision.Format) {
+	m.format = &po
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *PostRevisionMutation) Format() (r postrevision.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldFormat(ctx context.Context) (v postrevision.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *PostRevisionMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *PostRevisionMutation) SetAmount(f float64) {
+	m.amount = &f
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *PostRevisionMutation) Amount() (r float64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldAmount(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds f to the "amount" field.
+func (m *PostRevisionMutation) AddAmount(f float64) {
+	if m.addamount != nil {
+		*m.addamount += f
+	} else {
+		m.addamount = &f
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *PostRevisionMutation) AddedAmount() (r float64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (m *PostRevisionMutation) ClearAmount() {
+	m.amount = nil
+	m.addamount = nil
+	m.clearedFields[postrevision.FieldAmount] = struct{}{}
+}
+
+// AmountCleared returns if the "amount" field was cleared in this mutation.
+func (m *PostRevisionMutation) AmountCleared() bool {
+	_, ok := m.clearedFields[postrevision.FieldAmount]
+	return ok
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *PostRevisionMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+	delete(m.clearedFields, postrevision.FieldAmount)
+}
+
+// SetImageIds sets the "image_ids" field.
+func (m *PostRevisionMutation) SetImageIds(u []uuid.UUID) {
+	m.image_ids = &u
+	m.appendimage_ids = nil
+}
+
+// ImageIds returns the value of the "image_ids" field in the mutation.
+func (m *PostRevisionMutation) ImageIds() (r []uuid.UUID, exists bool) {
+	v := m.image_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageIds returns the old "image_ids" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldImageIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageIds: %w", err)
+	}
+	return oldValue.ImageIds, nil
+}
+
+// AppendImageIds adds u to the "image_ids" field.
+func (m *PostRevisionMutation) AppendImageIds(u []uuid.UUID) {
+	m.appendimage_ids = append(m.appendimage_ids, u...)
+}
+
+// AppendedImageIds returns the list of values that were appended to the "image_ids" field in this mutation.
+func (m *PostRevisionMutation) AppendedImageIds() ([]uuid.UUID, bool) {
+	if len(m.appendimage_ids) == 0 {
+		return nil, false
+	}
+	return m.appendimage_ids, true
+}
+
+// ClearImageIds clears the value of the "image_ids" field.
+func (m *PostRevisionMutation) ClearImageIds() {
+	m.image_ids = nil
+	m.appendimage_ids = nil
+	m.clearedFields[postrevision.FieldImageIds] = struct{}{}
+}
+
+// ImageIdsCleared returns if the "image_ids" field was cleared in this mutation.
+func (m *PostRevisionMutation) ImageIdsCleared() bool {
+	_, ok := m.clearedFields[postrevision.FieldImageIds]
+	return ok
+}
+
+// ResetImageIds resets all changes to the "image_ids" field.
+func (m *PostRevisionMutation) ResetImageIds() {
+	m.image_ids = nil
+	m.appendimage_ids = nil
+	delete(m.clearedFields, postrevision.FieldImageIds)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReplacedAt sets the "replaced_at" field.
+func (m *PostRevisionMutation) SetReplacedAt(t time.Time) {
+	m.replaced_at = &t
+}
+
+// ReplacedAt returns the value of the "replaced_at" field in the mutation.
+func (m *PostRevisionMutation) ReplacedAt() (r time.Time, exists bool) {
+	v := m.replaced_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplacedAt returns the old "replaced_at" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldReplacedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplacedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplacedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplacedAt: %w", err)
+	}
+	return oldValue.ReplacedAt, nil
+}
+
+// ResetReplacedAt resets all changes to the "replaced_at" field.
+func (m *PostRevisionMutation) ResetReplacedAt() {
+	m.replaced_at = nil
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *PostRevisionMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostRevisionMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostRevisionMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *PostRevisionMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostRevisionMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *PostRevisionMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// Where appends a list predicates to the PostRevisionMutation builder.
+func (m *PostRevisionMutation) Where(ps ...predicate.PostRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostRevision).
+func (m *PostRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.revision != nil {
+		fields = append(fields, postrevision.FieldRevision)
+	}
+	if m.content != nil {
+		fields = append(fields, postrevision.FieldContent)
+	}
+	if m.format != nil {
+		fields = append(fields, postrevision.FieldFormat)
+	}
+	if m.amount != nil {
+		fields = append(fields, postrevision.FieldAmount)
+	}
+	if m.image_ids != nil {
+		fields = append(fields, postrevision.FieldImageIds)
+	}
+	if m.created_at != nil {
+		fields = append(fields, postrevision.FieldCreatedAt)
+	}
+	if m.replaced_at != nil {
+		fields = append(fields, postrevision.FieldReplacedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case postrevision.FieldRevision:
+		return m.Revision()
+	case postrevision.FieldContent:
+		return m.Content()
+	case postrevision.FieldFormat:
+		return m.Format()
+	case postrevision.FieldAmount:
+		return m.Amount()
+	case postrevision.FieldImageIds:
+		return m.ImageIds()
+	case postrevision.FieldCreatedAt:
+		return m.CreatedAt()
+	case postrevision.FieldReplacedAt:
+		return m.ReplacedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case postrevision.FieldRevision:
+		return m.OldRevision(ctx)
+	case postrevision.FieldContent:
+		return m.OldContent(ctx)
+	case postrevision.FieldFormat:
+		return m.OldFormat(ctx)
+	case postrevision.FieldAmount:
+		return m.OldAmount(ctx)
+	case postrevision.FieldImageIds:
+		return m.OldImageIds(ctx)
+	case postrevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case postrevision.FieldReplacedAt:
+		return m.OldReplacedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PostRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case postrevision.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case postrevision.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case postrevision.FieldFormat:
+		v, ok := value.(postrevision.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case postrevision.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case postrevision.FieldImageIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageIds(v)
+		return nil
+	case postrevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case postrevision.FieldReplacedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplacedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, postrevision.FieldRevision)
+	}
+	if m.addamount != nil {
+		fields = append(fields, postrevision.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case postrevision.FieldRevision:
+		return m.AddedRevision()
+	case postrevision.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case postrevision.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	case postrevision.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(postrevision.FieldAmount) {
+		fields = append(fields, postrevision.FieldAmount)
+	}
+	if m.FieldCleared(postrevision.FieldImageIds) {
+		fields = append(fields, postrevision.FieldImageIds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostRevisionMutation) ClearField(name string) error {
+	switch name {
+	case postrevision.FieldAmount:
+		m.ClearAmount()
+		return nil
+	case postrevision.FieldImageIds:
+		m.ClearImageIds()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostRevisionMutation) ResetField(name string) error {
+	switch name {
+	case postrevision.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case postrevision.FieldContent:
+		m.ResetContent()
+		return nil
+	case postrevision.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case postrevision.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case postrevision.FieldImageIds:
+		m.ResetImageIds()
+		return nil
+	case postrevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case postrevision.FieldReplacedAt:
+		m.ResetReplacedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.post != nil {
+		edges = append(edges, postrevision.EdgePost)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case postrevision.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpost {
+		edges = append(edges, postrevision.EdgePost)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case postrevision.EdgePost:
+		return m.clearedpost
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case postrevision.EdgePost:
+		m.ClearPost()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case postrevision.EdgePost:
+		m.ResetPost()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision edge %s", name)
+}
+
+// ReactionMutation represents an operation that mutates the Reaction nodes in the graph.
+type ReactionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	kind          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	post          *uuid.UUID
+	clearedpost   bool
+	done          bool
+	oldValue      func(context.Context) (*Reaction, error)
+	predicates    []predicate.Reaction
+}
+
+var _ ent.Mutation = (*ReactionMutation)(nil)
+
+// reactionOption allows management of the mutation configuration using functional options.
+type reactionOption func(*ReactionMutation)
+
+// newReactionMutation creates new mutation for the Reaction entity.
+func newReactionMutation(c config, op Op, opts ...reactionOption) *ReactionMutation {
+	m := &ReactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReactionID sets the ID field of the mutation.
+func withReactionID(id uuid.UUID) reactionOption {
+	return func(m *ReactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Reaction
+		)
+		m.oldValue = func(ctx context.Context) (*Reaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Reaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReaction sets the old Reaction of the mutation.
+func withReaction(node *Reaction) reactionOption {
+	return func(m *ReactionMutation) {
+		m.oldValue = func(context.Context) (*Reaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Reaction entities.
+func (m *ReactionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReactionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Reaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKind sets the "kind" field.
+func (m *ReactionMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ReactionMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Reaction entity.
+// If the Reaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReactionMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ReactionMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Reaction entity.
+// If the Reaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ReactionMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ReactionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ReactionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ReactionMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ReactionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ReactionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *ReactionMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *ReactionMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *ReactionMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *ReactionMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *ReactionMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *ReactionMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// Where appends a list predicates to the ReactionMutation builder.
+func (m *ReactionMutation) Where(ps ...predicate.Reaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Reaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Reaction).
+func (m *ReactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReactionMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.kind != nil {
+		fields = append(fields, reaction.FieldKind)
+	}
+	if m.created_at != nil {
+		fields = append(fields, reaction.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reaction.FieldKind:
+		return m.Kind()
+	case reaction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reaction.FieldKind:
+		return m.OldKind(ctx)
+	case reaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Reaction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reaction.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case reaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Reaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReactionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReactionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Reaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReactionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Reaction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReactionMutation) ResetField(name string) error {
+	switch name {
+	case reaction.FieldKind:
+		m.ResetKind()
+		return nil
+	case reaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Reaction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, reaction.EdgeUser)
+	}
+	if m.post != nil {
+		edges = append(edges, reaction.EdgePost)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case reaction.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case reaction.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReactionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, reaction.EdgeUser)
+	}
+	if m.clearedpost {
+		edges = append(edges, reaction.EdgePost)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case reaction.EdgeUser:
+		return m.cleareduser
+	case reaction.EdgePost:
+		return m.clearedpost
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReactionMutation) ClearEdge(name string) error {
+	switch name {
+	case reaction.EdgeUser:
+		m.ClearUser()
+		return nil
+	case reaction.EdgePost:
+		m.ClearPost()
+		return nil
+	}
+	return fmt.Errorf("unknown Reaction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReactionMutation) ResetEdge(name string) error {
+	switch name {
+	case reaction.EdgeUser:
+		m.ResetUser()
+		return nil
+	case reaction.EdgePost:
+		m.ResetPost()
+		return nil
+	}
+	return fmt.Errorf("unknown Reaction edge %s", name)
+}
+
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	token_hash    *string
+	expires_at    *time.Time
+	revoked       *bool
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RefreshToken, error)
+	predicates    []predicate.RefreshToken
+}
+
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
+
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRefreshToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id uuid.UUID) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RefreshToken
+		)
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RefreshToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefreshTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RefreshToken entities.
+func (m *RefreshTokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RefreshTokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *RefreshTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *RefreshTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *RefreshTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *RefreshTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RefreshTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RefreshTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRevoked sets the "revoked" field.
+func (m *RefreshTokenMutation) SetRevoked(b bool) {
+	m.revoked = &b
+}
+
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *RefreshTokenMutation) Revoked() (r bool, exists bool) {
+	v := m.revoked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevoked returns the old "revoked" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+	}
+	return oldValue.Revoked, nil
+}
+
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *RefreshTokenMutation) ResetRevoked() {
+	m.revoked = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RefreshTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *RefreshTokenMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RefreshTokenMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RefreshTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *RefreshTokenMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RefreshTokenMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RefreshTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RefreshToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RefreshTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RefreshTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.token_hash != nil {
+		fields = append(fields, refreshtoken.FieldTokenHash)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, refreshtoken.FieldExpiresAt)
+	}
+	if m.revoked != nil {
+		fields = append(fields, refreshtoken.FieldRevoked)
+	}
+	if m.created_at != nil {
+		fields = append(fields, refreshtoken.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldTokenHash:
+		return m.TokenHash()
+	case refreshtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case refreshtoken.FieldRevoked:
+		return m.Revoked()
+	case refreshtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case refreshtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case refreshtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case refreshtoken.FieldRevoked:
+		return m.OldRevoked(ctx)
+	case refreshtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case refreshtoken.FieldRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevoked(v)
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefreshTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefreshTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ResetField(name string) error {
+	switch name {
+	case refreshtoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case refreshtoken.FieldRevoked:
+		m.ResetRevoked()
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, refreshtoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case refreshtoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, refreshtoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case refreshtoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
+}
+
+// ReminderLogMutation represents an operation that mutates the ReminderLog nodes in the graph.
+type ReminderLogMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	threshold     *reminderlog.Threshold
+	sent_at       *time.Time
+	clearedFields map[string]struct{}
+	goal          *uuid.UUID
+	clearedgoal   bool
+	done          bool
+	oldValue      func(context.Context) (*ReminderLog, error)
+	predicates    []predicate.ReminderLog
+}
+
+var _ ent.Mutation = (*ReminderLogMutation)(nil)
+
+// reminderlogOption allows management of the mutation configuration using functional options.
+type reminderlogOption func(*ReminderLogMutation)
+
+// newReminderLogMutation creates new mutation for the ReminderLog entity.
+func newReminderLogMutation(c config, op Op, opts ...reminderlogOption) *ReminderLogMutation {
+	m := &ReminderLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReminderLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReminderLogID sets the ID field of the mutation.
+func withReminderLogID(id uuid.UUID) reminderlogOption {
+	return func(m *ReminderLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReminderLog
+		)
+		m.oldValue = func(ctx context.Context) (*ReminderLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReminderLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReminderLog sets the old ReminderLog of the mutation.
+func withReminderLog(node *ReminderLog) reminderlogOption {
+	return func(m *ReminderLogMutation) {
+		m.oldValue = func(context.Context) (*ReminderLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReminderLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReminderLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReminderLog entities.
+func (m *ReminderLogMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReminderLogMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReminderLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReminderLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetThreshold sets the "threshold" field.
+func (m *ReminderLogMutation) SetThreshold(r reminderlog.Threshold) {
+	m.threshold = &r
+}
+
+// Threshold returns the value of the "threshold" field in the mutation.
+func (m *ReminderLogMutation) Threshold() (r reminderlog.Threshold, exists bool) {
+	v := m.threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThreshold returns the old "threshold" field's value of the ReminderLog entity.
+// If the ReminderLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderLogMutation) OldThreshold(ctx context.Context) (v reminderlog.Threshold, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThreshold: %w", err)
+	}
+	return oldValue.Threshold, nil
+}
+
+// ResetThreshold resets all changes to the "threshold" field.
+func (m *ReminderLogMutation) ResetThreshold() {
+	m.threshold = nil
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *ReminderLogMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *ReminderLogMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the ReminderLog entity.
+// If the ReminderLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderLogMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *ReminderLogMutation) ResetSentAt() {
+	m.sent_at = nil
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by id.
+func (m *ReminderLogMutation) SetGoalID(id uuid.UUID) {
+	m.goal = &id
+}
+
+// ClearGoal clears the "goal" edge to the Goal entity.
+func (m *ReminderLogMutation) ClearGoal() {
+	m.clearedgoal = true
+}
+
+// GoalCleared reports if the "goal" edge to the Goal entity was cleared.
+func (m *ReminderLogMutation) GoalCleared() bool {
+	return m.clearedgoal
+}
+
+// GoalID returns the "goal" edge ID in the mutation.
+func (m *ReminderLogMutation) GoalID() (id uuid.UUID, exists bool) {
+	if m.goal != nil {
+		return *m.goal, true
+	}
+	return
+}
+
+// GoalIDs returns the "goal" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GoalID instead. It exists only for internal usage by the builders.
+func (m *ReminderLogMutation) GoalIDs() (ids []uuid.UUID) {
+	if id := m.goal; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGoal resets all changes to the "goal" edge.
+func (m *ReminderLogMutation) ResetGoal() {
+	m.goal = nil
+	m.clearedgoal = false
+}
+
+// Where appends a list predicates to the ReminderLogMutation builder.
+func (m *ReminderLogMutation) Where(ps ...predicate.ReminderLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReminderLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReminderLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReminderLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReminderLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReminderLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReminderLog).
+func (m *ReminderLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReminderLogMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.threshold != nil {
+		fields = append(fields, reminderlog.FieldThreshold)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, reminderlog.FieldSentAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReminderLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reminderlog.FieldThreshold:
+		return m.Threshold()
+	case reminderlog.FieldSentAt:
+		return m.SentAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReminderLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reminderlog.FieldThreshold:
+		return m.OldThreshold(ctx)
+	case reminderlog.FieldSentAt:
+		return m.OldSentAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReminderLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReminderLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reminderlog.FieldThreshold:
+		v, ok := value.(reminderlog.Threshold)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThreshold(v)
+		return nil
+	case reminderlog.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReminderLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReminderLogMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReminderLogMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReminderLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ReminderLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReminderLogMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReminderLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReminderLogMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReminderLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReminderLogMutation) ResetField(name string) error {
+	switch name {
+	case reminderlog.FieldThreshold:
+		m.ResetThreshold()
+		return nil
+	case reminderlog.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReminderLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReminderLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.goal != nil {
+		edges = append(edges, reminderlog.EdgeGoal)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReminderLogMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case reminderlog.EdgeGoal:
+		if id := m.goal; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReminderLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReminderLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReminderLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgoal {
+		edges = append(edges, reminderlog.EdgeGoal)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReminderLogMutation) EdgeCleared(name string) bool {
+	switch name {
+	case reminderlog.EdgeGoal:
+		return m.clearedgoal
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReminderLogMutation) ClearEdge(name string) error {
+	switch name {
+	case reminderlog.EdgeGoal:
+		m.ClearGoal()
+		return nil
+	}
+	return fmt.Errorf("unknown ReminderLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReminderLogMutation) ResetEdge(name string) error {
+	switch name {
+	case reminderlog.EdgeGoal:
+		m.ResetGoal()
+		return nil
+	}
+	return fmt.Errorf("unknown ReminderLog edge %s", name)
+}
+
+// ReportMutation represents an operation that mutates the Report nodes in the graph.
+type ReportMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	target_type      *report.TargetType
+	target_id        *uuid.UUID
+	source           *report.Source
+	reason           *report.Reason
+	text             *string
+	status           *report.Status
+	resolution       *report.Resolution
+	resolution_note  *string
+	created_at       *time.Time
+	claimed_at       *time.Time
+	resolved_at      *time.Time
+	clearedFields    map[string]struct{}
+	reporter         *uuid.UUID
+	clearedreporter  bool
+	moderator        *uuid.UUID
+	clearedmoderator bool
+	actions          map[uuid.UUID]struct{}
+	removedactions   map[uuid.UUID]struct{}
+	clearedactions   bool
+	done             bool
+	oldValue         func(context.Context) (*Report, error)
+	predicates       []predicate.Report
+}
+
+var _ ent.Mutation = (*ReportMutation)(nil)
+
+// reportOption allows management of the mutation configuration using functional options.
+type reportOption func(*ReportMutation)
+
+// newReportMutation creates new mutation for the Report entity.
+func newReportMutation(c config, op Op, opts ...reportOption) *ReportMutation {
+	m := &ReportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReportID sets the ID field of the mutation.
+func withReportID(id uuid.UUID) reportOption {
+	return func(m *ReportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Report
+		)
+		m.oldValue = func(ctx context.Context) (*Report, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Report.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReport sets the old Report of the mutation.
+func withReport(node *Report) reportOption {
+	return func(m *ReportMutation) {
+		m.oldValue = func(context.Context) (*Report, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Report entities.
+func (m *ReportMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReportMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReportMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Report.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTargetType sets the "target_type" field.
+func (m *ReportMutation) SetTargetType(rt report.TargetType) {
+	m.target_type = &rt
+}
+
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *ReportMutation) TargetType() (r report.TargetType, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetType returns the old "target_type" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldTargetType(ctx context.Context) (v report.TargetType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
+	}
+	return oldValue.TargetType, nil
+}
+
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *ReportMutation) ResetTargetType() {
+	m.target_type = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *ReportMutation) SetTargetID(u uuid.UUID) {
+	m.target_id = &u
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *ReportMutation) TargetID() (r uuid.UUID, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldTargetID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *ReportMutation) ResetTargetID() {
+	m.target_id = nil
+}
+
+// SetSource sets the "source" field.
+func (m *ReportMutation) SetSource(r report.Source) {
+	m.source = &r
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ReportMutation) Source() (r report.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldSource(ctx context.Context) (v report.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *ReportMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ReportMutation) SetReason(r report.Reason) {
+	m.reason = &r
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ReportMutation) Reason() (r report.Reason, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldReason(ctx context.Context) (v report.Reason, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ReportMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetText sets the "text" field.
+func (m *ReportMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *ReportMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ClearText clears the value of the "text" field.
+func (m *ReportMutation) ClearText() {
+	m.text = nil
+	m.clearedFields[report.FieldText] = struct{}{}
+}
+
+// TextCleared returns if the "text" field was cleared in this mutation.
+func (m *ReportMutation) TextCleared() bool {
+	_, ok := m.clearedFields[report.FieldText]
+	return ok
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *ReportMutation) ResetText() {
+	m.text = nil
+	delete(m.clearedFields, report.FieldText)
+}
+
+// SetStatus sets the "status" field.
+func (m *ReportMutation) SetStatus(r report.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReportMutation) Status() (r report.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldStatus(ctx context.Context) (v report.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReportMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetResolution sets the "resolution" field.
+func (m *ReportMutation) SetResolution(r report.Resolution) {
+	m.resolution = &r
+}
+
+// Resolution returns the value of the "resolution" field in the mutation.
+func (m *ReportMutation) Resolution() (r report.Resolution, exists bool) {
+	v := m.resolution
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolution returns the old "resolution" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldResolution(ctx context.Context) (v *report.Resolution, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolution is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolution requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolution: %w", err)
+	}
+	return oldValue.Resolution, nil
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (m *ReportMutation) ClearResolution() {
+	m.resolution = nil
+	m.clearedFields[report.FieldResolution] = struct{}{}
+}
+
+// ResolutionCleared returns if the "resolution" field was cleared in this mutation.
+func (m *ReportMutation) ResolutionCleared() bool {
+	_, ok := m.clearedFields[report.FieldResolution]
+	return ok
+}
+
+// ResetResolution resets all changes to the "resolution" field.
+func (m *ReportMutation) ResetResolution() {
+	m.resolution = nil
+	delete(m.clearedFields, report.FieldResolution)
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (m *ReportMutation) SetResolutionNote(s string) {
+	m.resolution_note = &s
+}
+
+// ResolutionNote returns the value of the "resolution_note" field in the mutation.
+func (m *ReportMutation) ResolutionNote() (r string, exists bool) {
+	v := m.resolution_note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolutionNote returns the old "resolution_note" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldResolutionNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolutionNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolutionNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolutionNote: %w", err)
+	}
+	return oldValue.ResolutionNote, nil
+}
+
+// ClearResolutionNote clears the value of the "resolution_note" field.
+func (m *ReportMutation) ClearResolutionNote() {
+	m.resolution_note = nil
+	m.clearedFields[report.FieldResolutionNote] = struct{}{}
+}
+
+// ResolutionNoteCleared returns if the "resolution_note" field was cleared in this mutation.
+func (m *ReportMutation) ResolutionNoteCleared() bool {
+	_, ok := m.clearedFields[report.FieldResolutionNote]
+	return ok
+}
+
+// ResetResolutionNote resets all changes to the "resolution_note" field.
+func (m *ReportMutation) ResetResolutionNote() {
+	m.resolution_note = nil
+	delete(m.clearedFields, report.FieldResolutionNote)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReportMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReportMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReportMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (m *ReportMutation) SetClaimedAt(t time.Time) {
+	m.claimed_at = &t
+}
+
+// ClaimedAt returns the value of the "claimed_at" field in the mutation.
+func (m *ReportMutation) ClaimedAt() (r time.Time, exists bool) {
+	v := m.claimed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimedAt returns the old "claimed_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldClaimedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedAt: %w", err)
+	}
+	return oldValue.ClaimedAt, nil
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (m *ReportMutation) ClearClaimedAt() {
+	m.claimed_at = nil
+	m.clearedFields[report.FieldClaimedAt] = struct{}{}
+}
+
+// ClaimedAtCleared returns if the "claimed_at" field was cleared in this mutation.
+func (m *ReportMutation) ClaimedAtCleared() bool {
+	_, ok := m.clearedFields[report.FieldClaimedAt]
+	return ok
+}
+
+// ResetClaimedAt resets all changes to the "claimed_at" field.
+func (m *ReportMutation) ResetClaimedAt() {
+	m.claimed_at = nil
+	delete(m.clearedFields, report.FieldClaimedAt)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *ReportMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *ReportMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *ReportMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[report.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *ReportMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[report.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *ReportMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, report.FieldResolvedAt)
+}
+
+// SetReporterID sets the "reporter" edge to the User entity by id.
+func (m *ReportMutation) SetReporterID(id uuid.UUID) {
+	m.reporter = &id
+}
+
+// ClearReporter clears the "reporter" edge to the User entity.
+func (m *ReportMutation) ClearReporter() {
+	m.clearedreporter = true
+}
+
+// ReporterCleared reports if the "reporter" edge to the User entity was cleared.
+func (m *ReportMutation) ReporterCleared() bool {
+	return m.clearedreporter
+}
+
+// ReporterID returns the "reporter" edge ID in the mutation.
+func (m *ReportMutation) ReporterID() (id uuid.UUID, exists bool) {
+	if m.reporter != nil {
+		return *m.reporter, true
+	}
+	return
+}
+
+// ReporterIDs returns the "reporter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReporterID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) ReporterIDs() (ids []uuid.UUID) {
+	if id := m.reporter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReporter resets all changes to the "reporter" edge.
+func (m *ReportMutation) ResetReporter() {
+	m.reporter = nil
+	m.clearedreporter = false
+}
+
+// SetModeratorID sets the "moderator" edge to the User entity by id.
+func (m *ReportMutation) SetModeratorID(id uuid.UUID) {
+	m.moderator = &id
+}
+
+// ClearModerator clears the "moderator" edge to the User entity.
+func (m *ReportMutation) ClearModerator() {
+	m.clearedmoderator = true
+}
+
+// ModeratorCleared reports if the "moderator" edge to the User entity was cleared.
+func (m *ReportMutation) ModeratorCleared() bool {
+	return m.clearedmoderator
+}
+
+// ModeratorID returns the "moderator" edge ID in the mutation.
+func (m *ReportMutation) ModeratorID() (id uuid.UUID, exists bool) {
+	if m.moderator != nil {
+		return *m.moderator, true
+	}
+	return
+}
+
+// ModeratorIDs returns the "moderator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModeratorID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) ModeratorIDs() (ids []uuid.UUID) {
+	if id := m.moderator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModerator resets all changes to the "moderator" edge.
+func (m *ReportMutation) ResetModerator() {
+	m.moderator = nil
+	m.clearedmoderator = false
+}
+
+// AddActionIDs adds the "actions" edge to the ModerationAction entity by ids.
+func (m *ReportMutation) AddActionIDs(ids ...uuid.UUID) {
+	if m.actions == nil {
+		m.actions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.actions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActions clears the "actions" edge to the ModerationAction entity.
+func (m *ReportMutation) ClearActions() {
+	m.clearedactions = true
+}
+
+// ActionsCleared reports if the "actions" edge to the ModerationAction entity was cleared.
+func (m *ReportMutation) ActionsCleared() bool {
+	return m.clearedactions
+}
+
+// RemoveActionIDs removes the "actions" edge to the ModerationAction entity by IDs.
+func (m *ReportMutation) RemoveActionIDs(ids ...uuid.UUID) {
+	if m.removedactions == nil {
+		m.removedactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.actions, ids[i])
+		m.removedactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActions returns the removed IDs of the "actions" edge to the ModerationAction entity.
+func (m *ReportMutation) RemovedActionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActionsIDs returns the "actions" edge IDs in the mutation.
+func (m *ReportMutation) ActionsIDs() (ids []uuid.UUID) {
+	for id := range m.actions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActions resets all changes to the "actions" edge.
+func (m *ReportMutation) ResetActions() {
+	m.actions = nil
+	m.clearedactions = false
+	m.removedactions = nil
+}
+
+// Where appends a list predicates to the ReportMutation builder.
+func (m *ReportMutation) Where(ps ...predicate.Report) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Report, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Report).
+func (m *ReportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReportMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.target_type != nil {
+		fields = append(fields, report.FieldTargetType)
+	}
+	if m.target_id != nil {
+		fields = append(fields, report.FieldTargetID)
+	}
+	if m.source != nil {
+		fields = append(fields, report.FieldSource)
+	}
+	if m.reason != nil {
+		fields = append(fields, report.FieldReason)
+	}
+	if m.text != nil {
+		fields = append(fields, report.FieldText)
+	}
+	if m.status != nil {
+		fields = append(fields, report.FieldStatus)
+	}
+	if m.resolution != nil {
+		fields = append(fields, report.FieldResolution)
+	}
+	if m.resolution_note != nil {
+		fields = append(fields, report.FieldResolutionNote)
+	}
+	if m.created_at != nil {
+		fields = append(fields, report.FieldCreatedAt)
+	}
+	if m.claimed_at != nil {
+		fields = append(fields, report.FieldClaimedAt)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, report.FieldResolvedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case report.FieldTargetType:
+		return m.TargetType()
+	case report.FieldTargetID:
+		return m.TargetID()
+	case report.FieldSource:
+		return m.Source()
+	case report.FieldReason:
+		return m.Reason()
+	case report.FieldText:
+		return m.Text()
+	case report.FieldStatus:
+		return m.Status()
+	case report.FieldResolution:
+		return m.Resolution()
+	case report.FieldResolutionNote:
+		return m.ResolutionNote()
+	case report.FieldCreatedAt:
+		return m.CreatedAt()
+	case report.FieldClaimedAt:
+		return m.ClaimedAt()
+	case report.FieldResolvedAt:
+		return m.ResolvedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case report.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case report.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case report.FieldSource:
+		return m.OldSource(ctx)
+	case report.FieldReason:
+		return m.OldReason(ctx)
+	case report.FieldText:
+		return m.OldText(ctx)
+	case report.FieldStatus:
+		return m.OldStatus(ctx)
+	case report.FieldResolution:
+		return m.OldResolution(ctx)
+	case report.FieldResolutionNote:
+		return m.OldResolutionNote(ctx)
+	case report.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case report.FieldClaimedAt:
+		return m.OldClaimedAt(ctx)
+	case report.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Report field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case report.FieldTargetType:
+		v, ok := value.(report.TargetType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetType(v)
+		return nil
+	case report.FieldTargetID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case report.FieldSource:
+		v, ok := value.(report.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case report.FieldReason:
+		v, ok := value.(report.Reason)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case report.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case report.FieldStatus:
+		v, ok := value.(report.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case report.FieldResolution:
+		v, ok := value.(report.Resolution)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolution(v)
+		return nil
+	case report.FieldResolutionNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolutionNote(v)
+		return nil
+	case report.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case report.FieldClaimedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedAt(v)
+		return nil
+	case report.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReportMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReportMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Report numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(report.FieldText) {
+		fields = append(fields, report.FieldText)
+	}
+	if m.FieldCleared(report.FieldResolution) {
+		fields = append(fields, report.FieldResolution)
+	}
+	if m.FieldCleared(report.FieldResolutionNote) {
+		fields = append(fields, report.FieldResolutionNote)
+	}
+	if m.FieldCleared(report.FieldClaimedAt) {
+		fields = append(fields, report.FieldClaimedAt)
+	}
+	if m.FieldCleared(report.FieldResolvedAt) {
+		fields = append(fields, report.FieldResolvedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReportMutation) ClearField(name string) error {
+	switch name {
+	case report.FieldText:
+		m.ClearText()
+		return nil
+	case report.FieldResolution:
+		m.ClearResolution()
+		return nil
+	case report.FieldResolutionNote:
+		m.ClearResolutionNote()
+		return nil
+	case report.FieldClaimedAt:
+		m.ClearClaimedAt()
+		return nil
+	case report.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Report nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReportMutation) ResetField(name string) error {
+	switch name {
+	case report.FieldTargetType:
+		m.ResetTargetType()
+		return nil
+	case report.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case report.FieldSource:
+		m.ResetSource()
+		return nil
+	case report.FieldReason:
+		m.ResetReason()
+		return nil
+	case report.FieldText:
+		m.ResetText()
+		return nil
+	case report.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case report.FieldResolution:
+		m.ResetResolution()
+		return nil
+	case report.FieldResolutionNote:
+		m.ResetResolutionNote()
+		return nil
+	case report.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case report.FieldClaimedAt:
+		m.ResetClaimedAt()
+		return nil
+	case report.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.reporter != nil {
+		edges = append(edges, report.EdgeReporter)
+	}
+	if m.moderator != nil {
+		edges = append(edges, report.EdgeModerator)
+	}
+	if m.actions != nil {
+		edges = append(edges, report.EdgeActions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReportMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case report.EdgeReporter:
+		if id := m.reporter; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeModerator:
+		if id := m.moderator; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeActions:
+		ids := make([]ent.Value, 0, len(m.actions))
+		for id := range m.actions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedactions != nil {
+		edges = append(edges, report.EdgeActions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReportMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case report.EdgeActions:
+		ids := make([]ent.Value, 0, len(m.removedactions))
+		for id := range m.removedactions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedreporter {
+		edges = append(edges, report.EdgeReporter)
+	}
+	if m.clearedmoderator {
+		edges = append(edges, report.EdgeModerator)
+	}
+	if m.clearedactions {
+		edges = append(edges, report.EdgeActions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReportMutation) EdgeCleared(name string) bool {
+	switch name {
+	case report.EdgeReporter:
+		return m.clearedreporter
+	case report.EdgeModerator:
+		return m.clearedmoderator
+	case report.EdgeActions:
+		return m.clearedactions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReportMutation) ClearEdge(name string) error {
+	switch name {
+	case report.EdgeReporter:
+		m.ClearReporter()
+		return nil
+	case report.EdgeModerator:
+		m.ClearModerator()
+		return nil
+	}
+	return fmt.Errorf("unknown Report unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReportMutation) ResetEdge(name string) error {
+	switch name {
+	case report.EdgeReporter:
+		m.ResetReporter()
+		return nil
+	case report.EdgeModerator:
+		m.ResetModerator()
+		return nil
+	case report.EdgeActions:
+		m.ResetActions()
+		return nil
+	}
+	return fmt.Errorf("unknown Report edge %s", name)
+}
+
+// RepostMutation represents an operation that mutates the Repost nodes in the graph.
+type RepostMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	user                    *uuid.UUID
+	cleareduser             bool
+	post                    *uuid.UUID
+	clearedpost             bool
+	timeline_entries        map[uuid.UUID]struct{}
+	removedtimeline_entries map[uuid.UUID]struct{}
+	clearedtimeline_entries bool
+	done                    bool
+	oldValue                func(context.Context) (*Repost, error)
+	predicates              []predicate.Repost
+}
+
+var _ ent.Mutation = (*RepostMutation)(nil)
+
+// repostOption allows management of the mutation configuration using functional options.
+type repostOption func(*RepostMutation)
+
+// newRepostMutation creates new mutation for the Repost entity.
+func newRepostMutation(c config, op Op, opts ...repostOption) *RepostMutation {
+	m := &RepostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRepost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRepostID sets the ID field of the mutation.
+func withRepostID(id uuid.UUID) repostOption {
+	return func(m *RepostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Repost
+		)
+		m.oldValue = func(ctx context.Context) (*Repost, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Repost.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRepost sets the old Repost of the mutation.
+func withRepost(node *Repost) repostOption {
+	return func(m *RepostMutation) {
+		m.oldValue = func(context.Context) (*Repost, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RepostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RepostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Repost entities.
+func (m *RepostMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RepostMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RepostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Repost.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RepostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RepostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Repost entity.
+// If the Repost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RepostMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *RepostMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RepostMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RepostMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *RepostMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RepostMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RepostMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *RepostMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *RepostMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *RepostMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *RepostMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *RepostMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *RepostMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// AddTimelineEntryIDs adds the "timeline_entries" edge to the TimelineEntry entity by ids.
+func (m *RepostMutation) AddTimelineEntryIDs(ids ...uuid.UUID) {
+	if m.timeline_entries == nil {
+		m.timeline_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.timeline_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTimelineEntries clears the "timeline_entries" edge to the TimelineEntry entity.
+func (m *RepostMutation) ClearTimelineEntries() {
+	m.clearedtimeline_entries = true
+}
+
+// TimelineEntriesCleared reports if the "timeline_entries" edge to the TimelineEntry entity was cleared.
+func (m *RepostMutation) TimelineEntriesCleared() bool {
+	return m.clearedtimeline_entries
+}
+
+// RemoveTimelineEntryIDs removes the "timeline_entries" edge to the TimelineEntry entity by IDs.
+func (m *RepostMutation) RemoveTimelineEntryIDs(ids ...uuid.UUID) {
+	if m.removedtimeline_entries == nil {
+		m.removedtimeline_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.timeline_entries, ids[i])
+		m.removedtimeline_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTimelineEntries returns the removed IDs of the "timeline_entries" edge to the TimelineEntry entity.
+func (m *RepostMutation) RemovedTimelineEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtimeline_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TimelineEntriesIDs returns the "timeline_entries" edge IDs in the mutation.
+func (m *RepostMutation) TimelineEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.timeline_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTimelineEntries resets all changes to the "timeline_entries" edge.
+func (m *RepostMutation) ResetTimelineEntries() {
+	m.timeline_entries = nil
+	m.clearedtimeline_entries = false
+	m.removedtimeline_entries = nil
+}
+
+// Where appends a list predicates to the RepostMutation builder.
+func (m *RepostMutation) Where(ps ...predicate.Repost) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RepostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RepostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Repost, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RepostMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RepostMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Repost).
+func (m *RepostMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RepostMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, repost.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RepostMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case repost.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RepostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case repost.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Repost field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RepostMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case repost.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Repost field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RepostMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RepostMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RepostMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Repost numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RepostMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RepostMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RepostMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Repost nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RepostMutation) ResetField(name string) error {
+	switch name {
+	case repost.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Repost field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RepostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, repost.EdgeUser)
+	}
+	if m.post != nil {
+		edges = append(edges, repost.EdgePost)
+	}
+	if m.timeline_entries != nil {
+		edges = append(edges, repost.EdgeTimelineEntries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RepostMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case repost.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case repost.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case repost.EdgeTimelineEntries:
+		ids := make([]ent.Value, 0, len(m.timeline_entries))
+		for id := range m.timeline_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RepostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedtimeline_entries != nil {
+		edges = append(edges, repost.EdgeTimelineEntries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RepostMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case repost.EdgeTimelineEntries:
+		ids := make([]ent.Value, 0, len(m.removedtimeline_entries))
+		for id := range m.removedtimeline_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RepostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, repost.EdgeUser)
+	}
+	if m.clearedpost {
+		edges = append(edges, repost.EdgePost)
+	}
+	if m.clearedtimeline_entries {
+		edges = append(edges, repost.EdgeTimelineEntries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RepostMutation) EdgeCleared(name string) bool {
+	switch name {
+	case repost.EdgeUser:
+		return m.cleareduser
+	case repost.EdgePost:
+		return m.clearedpost
+	case repost.EdgeTimelineEntries:
+		return m.clearedtimeline_entries
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RepostMutation) ClearEdge(name string) error {
+	switch name {
+	case repost.EdgeUser:
+		m.ClearUser()
+		return nil
+	case repost.EdgePost:
+		m.ClearPost()
+		return nil
+	}
+	return fmt.Errorf("unknown Repost unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RepostMutation) ResetEdge(name string) error {
+	switch name {
+	case repost.EdgeUser:
+		m.ResetUser()
+		return nil
+	case repost.EdgePost:
+		m.ResetPost()
+		return nil
+	case repost.EdgeTimelineEntries:
+		m.ResetTimelineEntries()
+		return nil
+	}
+	return fmt.Errorf("unknown Repost edge %s", name)
+}
+
+// TimelineEntryMutation represents an operation that mutates the TimelineEntry nodes in the graph.
+type TimelineEntryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	at            *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	actor         *uuid.UUID
+	clearedactor  bool
+	post          *uuid.UUID
+	clearedpost   bool
+	repost        *uuid.UUID
+	clearedrepost bool
+	done          bool
+	oldValue      func(context.Context) (*TimelineEntry, error)
+	predicates    []predicate.TimelineEntry
+}
+
+var _ ent.Mutation = (*TimelineEntryMutation)(nil)
+
+// timelineentryOption allows management of the mutation configuration using functional options.
+type timelineentryOption func(*TimelineEntryMutation)
+
+// newTimelineEntryMutation creates new mutation for the TimelineEntry entity.
+func newTimelineEntryMutation(c config, op Op, opts ...timelineentryOption) *TimelineEntryMutation {
+	m := &TimelineEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTimelineEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTimelineEntryID sets the ID field of the mutation.
+func withTimelineEntryID(id uuid.UUID) timelineentryOption {
+	return func(m *TimelineEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TimelineEntry
+		)
+		m.oldValue = func(ctx context.Context) (*TimelineEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TimelineEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTimelineEntry sets the old TimelineEntry of the mutation.
+func withTimelineEntry(node *TimelineEntry) timelineentryOption {
+	return func(m *TimelineEntryMutation) {
+		m.oldValue = func(context.Context) (*TimelineEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TimelineEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TimelineEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TimelineEntry entities.
+func (m *TimelineEntryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TimelineEntryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TimelineEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TimelineEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAt sets the "at" field.
+func (m *TimelineEntryMutation) SetAt(t time.Time) {
+	m.at = &t
+}
+
+// At returns the value of the "at" field in the mutation.
+func (m *TimelineEntryMutation) At() (r time.Time, exists bool) {
+	v := m.at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAt returns the old "at" field's value of the TimelineEntry entity.
+// If the TimelineEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimelineEntryMutation) OldAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAt: %w", err)
+	}
+	return oldValue.At, nil
+}
+
+// ResetAt resets all changes to the "at" field.
+func (m *TimelineEntryMutation) ResetAt() {
+	m.at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TimelineEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TimelineEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TimelineEntry entity.
+// If the TimelineEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimelineEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TimelineEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *TimelineEntryMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *TimelineEntryMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *TimelineEntryMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *TimelineEntryMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *TimelineEntryMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *TimelineEntryMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetActorID sets the "actor" edge to the User entity by id.
+func (m *TimelineEntryMutation) SetActorID(id uuid.UUID) {
+	m.actor = &id
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (m *TimelineEntryMutation) ClearActor() {
+	m.clearedactor = true
+}
+
+// ActorCleared reports if the "actor" edge to the User entity was cleared.
+func (m *TimelineEntryMutation) ActorCleared() bool {
+	return m.clearedactor
+}
+
+// ActorID returns the "actor" edge ID in the mutation.
+func (m *TimelineEntryMutation) ActorID() (id uuid.UUID, exists bool) {
+	if m.actor != nil {
+		return *m.actor, true
+	}
+	return
+}
+
+// ActorIDs returns the "actor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ActorID instead. It exists only for internal usage by the builders.
+func (m *TimelineEntryMutation) ActorIDs() (ids []uuid.UUID) {
+	if id := m.actor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetActor resets all changes to the "actor" edge.
+func (m *TimelineEntryMutation) ResetActor() {
+	m.actor = nil
+	m.clearedactor = false
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *TimelineEntryMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *TimelineEntryMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *TimelineEntryMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *TimelineEntryMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *TimelineEntryMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *TimelineEntryMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// SetRepostID sets the "repost" edge to the Repost entity by id.
+func (m *TimelineEntryMutation) SetRepostID(id uuid.UUID) {
+	m.repost = &id
+}
+
+// ClearRepost clears the "repost" edge to the Repost entity.
+func (m *TimelineEntryMutation) ClearRepost() {
+	m.clearedrepost = true
+}
+
+// RepostCleared reports if the "repost" edge to the Repost entity was cleared.
+func (m *TimelineEntryMutation) RepostCleared() bool {
+	return m.clearedrepost
+}
+
+// RepostID returns the "repost" edge ID in the mutation.
+func (m *TimelineEntryMutation) RepostID() (id uuid.UUID, exists bool) {
+	if m.repost != nil {
+		return *m.repost, true
+	}
+	return
+}
+
+// RepostIDs returns the "repost" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RepostID instead. It exists only for internal usage by the builders.
+func (m *TimelineEntryMutation) RepostIDs() (ids []uuid.UUID) {
+	if id := m.repost; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRepost resets all changes to the "repost" edge.
+func (m *TimelineEntryMutation) ResetRepost() {
+	m.repost = nil
+	m.clearedrepost = false
+}
+
+// Where appends a list predicates to the TimelineEntryMutation builder.
+func (m *TimelineEntryMutation) Where(ps ...predicate.TimelineEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TimelineEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TimelineEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TimelineEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TimelineEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TimelineEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TimelineEntry).
+func (m *TimelineEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TimelineEntryMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.at != nil {
+		fields = append(fields, timelineentry.FieldAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, timelineentry.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TimelineEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case timelineentry.FieldAt:
+		return m.At()
+	case timelineentry.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TimelineEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case timelineentry.FieldAt:
+		return m.OldAt(ctx)
+	case timelineentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TimelineEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReactionMutation) SetField(name string, value ent.Value) error {
+func (m *TimelineEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case timelineentry.FieldAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAt(v)
+		return nil
+	case timelineentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TimelineEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TimelineEntryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TimelineEntryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TimelineEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TimelineEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TimelineEntryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TimelineEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TimelineEntryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TimelineEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TimelineEntryMutation) ResetField(name string) error {
 	switch name {
-	case reaction.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	case timelineentry.FieldAt:
+		m.ResetAt()
+		return nil
+	case timelineentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TimelineEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TimelineEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user != nil {
+		edges = append(edges, timelineentry.EdgeUser)
+	}
+	if m.actor != nil {
+		edges = append(edges, timelineentry.EdgeActor)
+	}
+	if m.post != nil {
+		edges = append(edges, timelineentry.EdgePost)
+	}
+	if m.repost != nil {
+		edges = append(edges, timelineentry.EdgeRepost)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TimelineEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case timelineentry.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		m.SetCreatedAt(v)
+	case timelineentry.EdgeActor:
+		if id := m.actor; id != nil {
+			return []ent.Value{*id}
+		}
+	case timelineentry.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case timelineentry.EdgeRepost:
+		if id := m.repost; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TimelineEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TimelineEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TimelineEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser {
+		edges = append(edges, timelineentry.EdgeUser)
+	}
+	if m.clearedactor {
+		edges = append(edges, timelineentry.EdgeActor)
+	}
+	if m.clearedpost {
+		edges = append(edges, timelineentry.EdgePost)
+	}
+	if m.clearedrepost {
+		edges = append(edges, timelineentry.EdgeRepost)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TimelineEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case timelineentry.EdgeUser:
+		return m.cleareduser
+	case timelineentry.EdgeActor:
+		return m.clearedactor
+	case timelineentry.EdgePost:
+		return m.clearedpost
+	case timelineentry.EdgeRepost:
+		return m.clearedrepost
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TimelineEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case timelineentry.EdgeUser:
+		m.ClearUser()
+		return nil
+	case timelineentry.EdgeActor:
+		m.ClearActor()
+		return nil
+	case timelineentry.EdgePost:
+		m.ClearPost()
+		return nil
+	case timelineentry.EdgeRepost:
+		m.ClearRepost()
+		return nil
+	}
+	return fmt.Errorf("unknown TimelineEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TimelineEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case timelineentry.EdgeUser:
+		m.ResetUser()
+		return nil
+	case timelineentry.EdgeActor:
+		m.ResetActor()
+		return nil
+	case timelineentry.EdgePost:
+		m.ResetPost()
 		return nil
+	case timelineentry.EdgeRepost:
+		m.ResetRepost()
+		return nil
+	}
+	return fmt.Errorf("unknown TimelineEntry edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                             Op
+	typ                            string
+	id                             *uuid.UUID
+	name                           *string
+	email                          *string
+	handle                         *string
+	birthday                       *time.Time
+	hometown                       *string
+	bio                            *string
+	role                           *user.Role
+	profile_picture_id             *uuid.UUID
+	time_zone                      *string
+	quiet_hours_start              *int
+	addquiet_hours_start           *int
+	quiet_hours_end                *int
+	addquiet_hours_end             *int
+	hidden_at                      *time.Time
+	suspended_at                   *time.Time
+	suspended_until                *time.Time
+	timeline_horizon               *time.Time
+	notification_opt_outs          *[]string
+	appendnotification_opt_outs    []string
+	created_at                     *time.Time
+	updated_at                     *time.Time
+	clearedFields                  map[string]struct{}
+	genres                         map[uuid.UUID]struct{}
+	removedgenres                  map[uuid.UUID]struct{}
+	clearedgenres                  bool
+	goals                          map[uuid.UUID]struct{}
+	removedgoals                   map[uuid.UUID]struct{}
+	clearedgoals                   bool
+	goal_participations            map[uuid.UUID]struct{}
+	removedgoal_participations     map[uuid.UUID]struct{}
+	clearedgoal_participations     bool
+	posts                          map[uuid.UUID]struct{}
+	removedposts                   map[uuid.UUID]struct{}
+	clearedposts                   bool
+	reactions                      map[uuid.UUID]struct{}
+	removedreactions               map[uuid.UUID]struct{}
+	clearedreactions               bool
+	comments                       map[uuid.UUID]struct{}
+	removedcomments                map[uuid.UUID]struct{}
+	clearedcomments                bool
+	reposts                        map[uuid.UUID]struct{}
+	removedreposts                 map[uuid.UUID]struct{}
+	clearedreposts                 bool
+	bookmarks                      map[uuid.UUID]struct{}
+	removedbookmarks               map[uuid.UUID]struct{}
+	clearedbookmarks               bool
+	bookmark_collections           map[uuid.UUID]struct{}
+	removedbookmark_collections    map[uuid.UUID]struct{}
+	clearedbookmark_collections    bool
+	reports                        map[uuid.UUID]struct{}
+	removedreports                 map[uuid.UUID]struct{}
+	clearedreports                 bool
+	claimed_reports                map[uuid.UUID]struct{}
+	removedclaimed_reports         map[uuid.UUID]struct{}
+	clearedclaimed_reports         bool
+	moderation_actions             map[uuid.UUID]struct{}
+	removedmoderation_actions      map[uuid.UUID]struct{}
+	clearedmoderation_actions      bool
+	mentioned_in                   map[uuid.UUID]struct{}
+	removedmentioned_in            map[uuid.UUID]struct{}
+	clearedmentioned_in            bool
+	uploaded_images                map[uuid.UUID]struct{}
+	removeduploaded_images         map[uuid.UUID]struct{}
+	cleareduploaded_images         bool
+	refresh_tokens                 map[uuid.UUID]struct{}
+	removedrefresh_tokens          map[uuid.UUID]struct{}
+	clearedrefresh_tokens          bool
+	timeline_entries               map[uuid.UUID]struct{}
+	removedtimeline_entries        map[uuid.UUID]struct{}
+	clearedtimeline_entries        bool
+	timeline_activities            map[uuid.UUID]struct{}
+	removedtimeline_activities     map[uuid.UUID]struct{}
+	clearedtimeline_activities     bool
+	notifications                  map[uuid.UUID]struct{}
+	removednotifications           map[uuid.UUID]struct{}
+	clearednotifications           bool
+	latest_notifications           map[uuid.UUID]struct{}
+	removedlatest_notifications    map[uuid.UUID]struct{}
+	clearedlatest_notifications    bool
+	acted_notifications            map[uuid.UUID]struct{}
+	removedacted_notifications     map[uuid.UUID]struct{}
+	clearedacted_notifications     bool
+	notification_deliveries        map[uuid.UUID]struct{}
+	removednotification_deliveries map[uuid.UUID]struct{}
+	clearednotification_deliveries bool
+	followers                      map[uuid.UUID]struct{}
+	removedfollowers               map[uuid.UUID]struct{}
+	clearedfollowers               bool
+	following                      map[uuid.UUID]struct{}
+	removedfollowing               map[uuid.UUID]struct{}
+	clearedfollowing               bool
+	blocked_by                     map[uuid.UUID]struct{}
+	removedblocked_by              map[uuid.UUID]struct{}
+	clearedblocked_by              bool
+	blocking                       map[uuid.UUID]struct{}
+	removedblocking                map[uuid.UUID]struct{}
+	clearedblocking                bool
+	done                           bool
+	oldValue                       func(context.Context) (*User, error)
+	predicates                     []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Reaction field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetHandle sets the "handle" field.
+func (m *UserMutation) SetHandle(s string) {
+	m.handle = &s
+}
+
+// Handle returns the value of the "handle" field in the mutation.
+func (m *UserMutation) Handle() (r string, exists bool) {
+	v := m.handle
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHandle returns the old "handle" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldHandle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHandle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHandle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHandle: %w", err)
+	}
+	return oldValue.Handle, nil
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (m *UserMutation) ClearHandle() {
+	m.handle = nil
+	m.clearedFields[user.FieldHandle] = struct{}{}
+}
+
+// HandleCleared returns if the "handle" field was cleared in this mutation.
+func (m *UserMutation) HandleCleared() bool {
+	_, ok := m.clearedFields[user.FieldHandle]
+	return ok
+}
+
+// ResetHandle resets all changes to the "handle" field.
+func (m *UserMutation) ResetHandle() {
+	m.handle = nil
+	delete(m.clearedFields, user.FieldHandle)
+}
+
+// SetBirthday sets the "birthday" field.
+func (m *UserMutation) SetBirthday(t time.Time) {
+	m.birthday = &t
+}
+
+// Birthday returns the value of the "birthday" field in the mutation.
+func (m *UserMutation) Birthday() (r time.Time, exists bool) {
+	v := m.birthday
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBirthday returns the old "birthday" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBirthday(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBirthday is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBirthday requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBirthday: %w", err)
+	}
+	return oldValue.Birthday, nil
+}
+
+// ClearBirthday clears the value of the "birthday" field.
+func (m *UserMutation) ClearBirthday() {
+	m.birthday = nil
+	m.clearedFields[user.FieldBirthday] = struct{}{}
+}
+
+// BirthdayCleared returns if the "birthday" field was cleared in this mutation.
+func (m *UserMutation) BirthdayCleared() bool {
+	_, ok := m.clearedFields[user.FieldBirthday]
+	return ok
+}
+
+// ResetBirthday resets all changes to the "birthday" field.
+func (m *UserMutation) ResetBirthday() {
+	m.birthday = nil
+	delete(m.clearedFields, user.FieldBirthday)
+}
+
+// SetHometown sets the "hometown" field.
+func (m *UserMutation) SetHometown(s string) {
+	m.hometown = &s
+}
+
+// Hometown returns the value of the "hometown" field in the mutation.
+func (m *UserMutation) Hometown() (r string, exists bool) {
+	v := m.hometown
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHometown returns the old "hometown" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldHometown(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHometown is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHometown requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHometown: %w", err)
+	}
+	return oldValue.Hometown, nil
+}
+
+// ClearHometown clears the value of the "hometown" field.
+func (m *UserMutation) ClearHometown() {
+	m.hometown = nil
+	m.clearedFields[user.FieldHometown] = struct{}{}
+}
+
+// HometownCleared returns if the "hometown" field was cleared in this mutation.
+func (m *UserMutation) HometownCleared() bool {
+	_, ok := m.clearedFields[user.FieldHometown]
+	return ok
+}
+
+// ResetHometown resets all changes to the "hometown" field.
+func (m *UserMutation) ResetHometown() {
+	m.hometown = nil
+	delete(m.clearedFields, user.FieldHometown)
+}
+
+// SetBio sets the "bio" field.
+func (m *UserMutation) SetBio(s string) {
+	m.bio = &s
+}
+
+// Bio returns the value of the "bio" field in the mutation.
+func (m *UserMutation) Bio() (r string, exists bool) {
+	v := m.bio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBio returns the old "bio" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBio(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+	}
+	return oldValue.Bio, nil
+}
+
+// ClearBio clears the value of the "bio" field.
+func (m *UserMutation) ClearBio() {
+	m.bio = nil
+	m.clearedFields[user.FieldBio] = struct{}{}
+}
+
+// BioCleared returns if the "bio" field was cleared in this mutation.
+func (m *UserMutation) BioCleared() bool {
+	_, ok := m.clearedFields[user.FieldBio]
+	return ok
+}
+
+// ResetBio resets all changes to the "bio" field.
+func (m *UserMutation) ResetBio() {
+	m.bio = nil
+	delete(m.clearedFields, user.FieldBio)
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetProfilePictureID sets the "profile_picture_id" field.
+func (m *UserMutation) SetProfilePictureID(u uuid.UUID) {
+	m.profile_picture_id = &u
+}
+
+// ProfilePictureID returns the value of the "profile_picture_id" field in the mutation.
+func (m *UserMutation) ProfilePictureID() (r uuid.UUID, exists bool) {
+	v := m.profile_picture_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfilePictureID returns the old "profile_picture_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldProfilePictureID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfilePictureID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfilePictureID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfilePictureID: %w", err)
+	}
+	return oldValue.ProfilePictureID, nil
+}
+
+// ClearProfilePictureID clears the value of the "profile_picture_id" field.
+func (m *UserMutation) ClearProfilePictureID() {
+	m.profile_picture_id = nil
+	m.clearedFields[user.FieldProfilePictureID] = struct{}{}
+}
+
+// ProfilePictureIDCleared returns if the "profile_picture_id" field was cleared in this mutation.
+func (m *UserMutation) ProfilePictureIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldProfilePictureID]
+	return ok
+}
+
+// ResetProfilePictureID resets all changes to the "profile_picture_id" field.
+func (m *UserMutation) ResetProfilePictureID() {
+	m.profile_picture_id = nil
+	delete(m.clearedFields, user.FieldProfilePictureID)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ReactionMutation) AddedFields() []string {
-	return nil
+// SetTimeZone sets the "time_zone" field.
+func (m *UserMutation) SetTimeZone(s string) {
+	m.time_zone = &s
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ReactionMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// TimeZone returns the value of the "time_zone" field in the mutation.
+func (m *UserMutation) TimeZone() (r string, exists bool) {
+	v := m.time_zone
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ReactionMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldTimeZone returns the old "time_zone" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTimeZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeZone is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Reaction numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeZone: %w", err)
+	}
+	return oldValue.TimeZone, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ReactionMutation) ClearedFields() []string {
-	return nil
+// ResetTimeZone resets all changes to the "time_zone" field.
+func (m *UserMutation) ResetTimeZone() {
+	m.time_zone = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ReactionMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetQuietHoursStart sets the "quiet_hours_start" field.
+func (m *UserMutation) SetQuietHoursStart(i int) {
+	m.quiet_hours_start = &i
+	m.addquiet_hours_start = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ReactionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Reaction nullable field %s", name)
+// QuietHoursStart returns the value of the "quiet_hours_start" field in the mutation.
+func (m *UserMutation) QuietHoursStart() (r int, exists bool) {
+	v := m.quiet_hours_start
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ReactionMutation) ResetField(name string) error {
-	switch name {
-	case reaction.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// OldQuietHoursStart returns the old "quiet_hours_start" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldQuietHoursStart(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietHoursStart is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Reaction field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietHoursStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietHoursStart: %w", err)
+	}
+	return oldValue.QuietHoursStart, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ReactionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, reaction.EdgeUser)
-	}
-	if m.post != nil {
-		edges = append(edges, reaction.EdgePost)
+// AddQuietHoursStart adds i to the "quiet_hours_start" field.
+func (m *UserMutation) AddQuietHoursStart(i int) {
+	if m.addquiet_hours_start != nil {
+		*m.addquiet_hours_start += i
+	} else {
+		m.addquiet_hours_start = &i
 	}
-	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ReactionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case reaction.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case reaction.EdgePost:
-		if id := m.post; id != nil {
-			return []ent.Value{*id}
-		}
+// AddedQuietHoursStart returns the value that was added to the "quiet_hours_start" field in this mutation.
+func (m *UserMutation) AddedQuietHoursStart() (r int, exists bool) {
+	v := m.addquiet_hours_start
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ReactionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// ClearQuietHoursStart clears the value of the "quiet_hours_start" field.
+func (m *UserMutation) ClearQuietHoursStart() {
+	m.quiet_hours_start = nil
+	m.addquiet_hours_start = nil
+	m.clearedFields[user.FieldQuietHoursStart] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ReactionMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// QuietHoursStartCleared returns if the "quiet_hours_start" field was cleared in this mutation.
+func (m *UserMutation) QuietHoursStartCleared() bool {
+	_, ok := m.clearedFields[user.FieldQuietHoursStart]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ReactionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, reaction.EdgeUser)
-	}
-	if m.clearedpost {
-		edges = append(edges, reaction.EdgePost)
+// ResetQuietHoursStart resets all changes to the "quiet_hours_start" field.
+func (m *UserMutation) ResetQuietHoursStart() {
+	m.quiet_hours_start = nil
+	m.addquiet_hours_start = nil
+	delete(m.clearedFields, user.FieldQuietHoursStart)
+}
+
+// SetQuietHoursEnd sets the "quiet_hours_end" field.
+func (m *UserMutation) SetQuietHoursEnd(i int) {
+	m.quiet_hours_end = &i
+	m.addquiet_hours_end = nil
+}
+
+// QuietHoursEnd returns the value of the "quiet_hours_end" field in the mutation.
+func (m *UserMutation) QuietHoursEnd() (r int, exists bool) {
+	v := m.quiet_hours_end
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ReactionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case reaction.EdgeUser:
-		return m.cleareduser
-	case reaction.EdgePost:
-		return m.clearedpost
+// OldQuietHoursEnd returns the old "quiet_hours_end" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldQuietHoursEnd(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietHoursEnd is only allowed on UpdateOne operations")
 	}
-	return false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietHoursEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietHoursEnd: %w", err)
+	}
+	return oldValue.QuietHoursEnd, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ReactionMutation) ClearEdge(name string) error {
-	switch name {
-	case reaction.EdgeUser:
-		m.ClearUser()
-		return nil
-	case reaction.EdgePost:
-		m.ClearPost()
-		return nil
+// AddQuietHoursEnd adds i to the "quiet_hours_end" field.
+func (m *UserMutation) AddQuietHoursEnd(i int) {
+	if m.addquiet_hours_end != nil {
+		*m.addquiet_hours_end += i
+	} else {
+		m.addquiet_hours_end = &i
 	}
-	return fmt.Errorf("unknown Reaction unique edge %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ReactionMutation) ResetEdge(name string) error {
-	switch name {
-	case reaction.EdgeUser:
-		m.ResetUser()
-		return nil
-	case reaction.EdgePost:
-		m.ResetPost()
-		return nil
+// AddedQuietHoursEnd returns the value that was added to the "quiet_hours_end" field in this mutation.
+func (m *UserMutation) AddedQuietHoursEnd() (r int, exists bool) {
+	v := m.addquiet_hours_end
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Reaction edge %s", name)
+	return *v, true
 }
 
-// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
-type RefreshTokenMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	token_hash    *string
-	expires_at    *time.Time
-	revoked       *bool
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*RefreshToken, error)
-	predicates    []predicate.RefreshToken
+// ClearQuietHoursEnd clears the value of the "quiet_hours_end" field.
+func (m *UserMutation) ClearQuietHoursEnd() {
+	m.quiet_hours_end = nil
+	m.addquiet_hours_end = nil
+	m.clearedFields[user.FieldQuietHoursEnd] = struct{}{}
 }
 
-var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+// QuietHoursEndCleared returns if the "quiet_hours_end" field was cleared in this mutation.
+func (m *UserMutation) QuietHoursEndCleared() bool {
+	_, ok := m.clearedFields[user.FieldQuietHoursEnd]
+	return ok
+}
 
-// refreshtokenOption allows management of the mutation configuration using functional options.
-type refreshtokenOption func(*RefreshTokenMutation)
+// ResetQuietHoursEnd resets all changes to the "quiet_hours_end" field.
+func (m *UserMutation) ResetQuietHoursEnd() {
+	m.quiet_hours_end = nil
+	m.addquiet_hours_end = nil
+	delete(m.clearedFields, user.FieldQuietHoursEnd)
+}
 
-// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
-func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
-	m := &RefreshTokenMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeRefreshToken,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetHiddenAt sets the "hidden_at" field.
+func (m *UserMutation) SetHiddenAt(t time.Time) {
+	m.hidden_at = &t
 }
 
-// withRefreshTokenID sets the ID field of the mutation.
-func withRefreshTokenID(id uuid.UUID) refreshtokenOption {
-	return func(m *RefreshTokenMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *RefreshToken
-		)
-		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().RefreshToken.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// HiddenAt returns the value of the "hidden_at" field in the mutation.
+func (m *UserMutation) HiddenAt() (r time.Time, exists bool) {
+	v := m.hidden_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withRefreshToken sets the old RefreshToken of the mutation.
-func withRefreshToken(node *RefreshToken) refreshtokenOption {
-	return func(m *RefreshTokenMutation) {
-		m.oldValue = func(context.Context) (*RefreshToken, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldHiddenAt returns the old "hidden_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldHiddenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHiddenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHiddenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHiddenAt: %w", err)
 	}
+	return oldValue.HiddenAt, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RefreshTokenMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (m *UserMutation) ClearHiddenAt() {
+	m.hidden_at = nil
+	m.clearedFields[user.FieldHiddenAt] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m RefreshTokenMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// HiddenAtCleared returns if the "hidden_at" field was cleared in this mutation.
+func (m *UserMutation) HiddenAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldHiddenAt]
+	return ok
+}
+
+// ResetHiddenAt resets all changes to the "hidden_at" field.
+func (m *UserMutation) ResetHiddenAt() {
+	m.hidden_at = nil
+	delete(m.clearedFields, user.FieldHiddenAt)
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (m *UserMutation) SetSuspendedAt(t time.Time) {
+	m.suspended_at = &t
+}
+
+// SuspendedAt returns the value of the "suspended_at" field in the mutation.
+func (m *UserMutation) SuspendedAt() (r time.Time, exists bool) {
+	v := m.suspended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuspendedAt returns the old "suspended_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSuspendedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuspendedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuspendedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuspendedAt: %w", err)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return oldValue.SuspendedAt, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RefreshToken entities.
-func (m *RefreshTokenMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (m *UserMutation) ClearSuspendedAt() {
+	m.suspended_at = nil
+	m.clearedFields[user.FieldSuspendedAt] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *RefreshTokenMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// SuspendedAtCleared returns if the "suspended_at" field was cleared in this mutation.
+func (m *UserMutation) SuspendedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldSuspendedAt]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetSuspendedAt resets all changes to the "suspended_at" field.
+func (m *UserMutation) ResetSuspendedAt() {
+	m.suspended_at = nil
+	delete(m.clearedFields, user.FieldSuspendedAt)
 }
 
-// SetTokenHash sets the "token_hash" field.
-func (m *RefreshTokenMutation) SetTokenHash(s string) {
-	m.token_hash = &s
+// SetSuspendedUntil sets the "suspended_until" field.
+func (m *UserMutation) SetSuspendedUntil(t time.Time) {
+	m.suspended_until = &t
 }
 
-// TokenHash returns the value of the "token_hash" field in the mutation.
-func (m *RefreshTokenMutation) TokenHash() (r string, exists bool) {
-	v := m.token_hash
+// SuspendedUntil returns the value of the "suspended_until" field in the mutation.
+func (m *UserMutation) SuspendedUntil() (r time.Time, exists bool) {
+	v := m.suspended_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenHash returns the old "token_hash" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldSuspendedUntil returns the old "suspended_until" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldSuspendedUntil(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldSuspendedUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+		return v, errors.New("OldSuspendedUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldSuspendedUntil: %w", err)
 	}
-	return oldValue.TokenHash, nil
+	return oldValue.SuspendedUntil, nil
 }
 
-// ResetTokenHash resets all changes to the "token_hash" field.
-func (m *RefreshTokenMutation) ResetTokenHash() {
-	m.token_hash = nil
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (m *UserMutation) ClearSuspendedUntil() {
+	m.suspended_until = nil
+	m.clearedFields[user.FieldSuspendedUntil] = struct{}{}
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *RefreshTokenMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SuspendedUntilCleared returns if the "suspended_until" field was cleared in this mutation.
+func (m *UserMutation) SuspendedUntilCleared() bool {
+	_, ok := m.clearedFields[user.FieldSuspendedUntil]
+	return ok
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *RefreshTokenMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// ResetSuspendedUntil resets all changes to the "suspended_until" field.
+func (m *UserMutation) ResetSuspendedUntil() {
+	m.suspended_until = nil
+	delete(m.clearedFields, user.FieldSuspendedUntil)
+}
+
+// SetTimelineHorizon sets the "timeline_horizon" field.
+func (m *UserMutation) SetTimelineHorizon(t time.Time) {
+	m.timeline_horizon = &t
+}
+
+// TimelineHorizon returns the value of the "timeline_horizon" field in the mutation.
+func (m *UserMutation) TimelineHorizon() (r time.Time, exists bool) {
+	v := m.timeline_horizon
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldTimelineHorizon returns the old "timeline_horizon" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldTimelineHorizon(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimelineHorizon is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldTimelineHorizon requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimelineHorizon: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.TimelineHorizon, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *RefreshTokenMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ClearTimelineHorizon clears the value of the "timeline_horizon" field.
+func (m *UserMutation) ClearTimelineHorizon() {
+	m.timeline_horizon = nil
+	m.clearedFields[user.FieldTimelineHorizon] = struct{}{}
 }
 
-// SetRevoked sets the "revoked" field.
-func (m *RefreshTokenMutation) SetRevoked(b bool) {
-	m.revoked = &b
+// TimelineHorizonCleared returns if the "timeline_horizon" field was cleared in this mutation.
+func (m *UserMutation) TimelineHorizonCleared() bool {
+	_, ok := m.clearedFields[user.FieldTimelineHorizon]
+	return ok
 }
 
-// Revoked returns the value of the "revoked" field in the mutation.
-func (m *RefreshTokenMutation) Revoked() (r bool, exists bool) {
-	v := m.revoked
+// ResetTimelineHorizon resets all changes to the "timeline_horizon" field.
+func (m *UserMutation) ResetTimelineHorizon() {
+	m.timeline_horizon = nil
+	delete(m.clearedFields, user.FieldTimelineHorizon)
+}
+
+// SetNotificationOptOuts sets the "notification_opt_outs" field.
+func (m *UserMutation) SetNotificationOptOuts(s []string) {
+	m.notification_opt_outs = &s
+	m.appendnotification_opt_outs = nil
+}
+
+// NotificationOptOuts returns the value of the "notification_opt_outs" field in the mutation.
+func (m *UserMutation) NotificationOptOuts() (r []string, exists bool) {
+	v := m.notification_opt_outs
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRevoked returns the old "revoked" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldNotificationOptOuts returns the old "notification_opt_outs" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldNotificationOptOuts(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotificationOptOuts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRevoked requires an ID field in the mutation")
+		return v, errors.New("OldNotificationOptOuts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotificationOptOuts: %w", err)
 	}
-	return oldValue.Revoked, nil
+	return oldValue.NotificationOptOuts, nil
 }
 
-// ResetRevoked resets all changes to the "revoked" field.
-func (m *RefreshTokenMutation) ResetRevoked() {
-	m.revoked = nil
+// AppendNotificationOptOuts adds s to the "notification_opt_outs" field.
+func (m *UserMutation) AppendNotificationOptOuts(s []string) {
+	m.appendnotification_opt_outs = append(m.appendnotification_opt_outs, s...)
+}
+
+// AppendedNotificationOptOuts returns the list of values that were appended to the "notification_opt_outs" field in this mutation.
+func (m *UserMutation) AppendedNotificationOptOuts() ([]string, bool) {
+	if len(m.appendnotification_opt_outs) == 0 {
+		return nil, false
+	}
+	return m.appendnotification_opt_outs, true
+}
+
+// ClearNotificationOptOuts clears the value of the "notification_opt_outs" field.
+func (m *UserMutation) ClearNotificationOptOuts() {
+	m.notification_opt_outs = nil
+	m.appendnotification_opt_outs = nil
+	m.clearedFields[user.FieldNotificationOptOuts] = struct{}{}
+}
+
+// NotificationOptOutsCleared returns if the "notification_opt_outs" field was cleared in this mutation.
+func (m *UserMutation) NotificationOptOutsCleared() bool {
+	_, ok := m.clearedFields[user.FieldNotificationOptOuts]
+	return ok
+}
+
+// ResetNotificationOptOuts resets all changes to the "notification_opt_outs" field.
+func (m *UserMutation) ResetNotificationOptOuts() {
+	m.notification_opt_outs = nil
+	m.appendnotification_opt_outs = nil
+	delete(m.clearedFields, user.FieldNotificationOptOuts)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3189,10 +18891,10 @@ func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
-// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3207,1115 +18909,1178 @@ func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RefreshTokenMutation) ResetCreatedAt() {
+func (m *UserMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *RefreshTokenMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *RefreshTokenMutation) ClearUser() {
-	m.cleareduser = true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *RefreshTokenMutation) UserCleared() bool {
-	return m.cleareduser
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *RefreshTokenMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddGenreIDs adds the "genres" edge to the Genre entity by ids.
+func (m *UserMutation) AddGenreIDs(ids ...uuid.UUID) {
+	if m.genres == nil {
+		m.genres = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.genres[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGenres clears the "genres" edge to the Genre entity.
+func (m *UserMutation) ClearGenres() {
+	m.clearedgenres = true
+}
+
+// GenresCleared reports if the "genres" edge to the Genre entity was cleared.
+func (m *UserMutation) GenresCleared() bool {
+	return m.clearedgenres
+}
+
+// RemoveGenreIDs removes the "genres" edge to the Genre entity by IDs.
+func (m *UserMutation) RemoveGenreIDs(ids ...uuid.UUID) {
+	if m.removedgenres == nil {
+		m.removedgenres = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.genres, ids[i])
+		m.removedgenres[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGenres returns the removed IDs of the "genres" edge to the Genre entity.
+func (m *UserMutation) RemovedGenresIDs() (ids []uuid.UUID) {
+	for id := range m.removedgenres {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GenresIDs returns the "genres" edge IDs in the mutation.
+func (m *UserMutation) GenresIDs() (ids []uuid.UUID) {
+	for id := range m.genres {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGenres resets all changes to the "genres" edge.
+func (m *UserMutation) ResetGenres() {
+	m.genres = nil
+	m.clearedgenres = false
+	m.removedgenres = nil
+}
+
+// AddGoalIDs adds the "goals" edge to the Goal entity by ids.
+func (m *UserMutation) AddGoalIDs(ids ...uuid.UUID) {
+	if m.goals == nil {
+		m.goals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.goals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGoals clears the "goals" edge to the Goal entity.
+func (m *UserMutation) ClearGoals() {
+	m.clearedgoals = true
+}
+
+// GoalsCleared reports if the "goals" edge to the Goal entity was cleared.
+func (m *UserMutation) GoalsCleared() bool {
+	return m.clearedgoals
+}
+
+// RemoveGoalIDs removes the "goals" edge to the Goal entity by IDs.
+func (m *UserMutation) RemoveGoalIDs(ids ...uuid.UUID) {
+	if m.removedgoals == nil {
+		m.removedgoals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.goals, ids[i])
+		m.removedgoals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGoals returns the removed IDs of the "goals" edge to the Goal entity.
+func (m *UserMutation) RemovedGoalsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgoals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GoalsIDs returns the "goals" edge IDs in the mutation.
+func (m *UserMutation) GoalsIDs() (ids []uuid.UUID) {
+	for id := range m.goals {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *RefreshTokenMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// ResetGoals resets all changes to the "goals" edge.
+func (m *UserMutation) ResetGoals() {
+	m.goals = nil
+	m.clearedgoals = false
+	m.removedgoals = nil
+}
+
+// AddGoalParticipationIDs adds the "goal_participations" edge to the GoalParticipant entity by ids.
+func (m *UserMutation) AddGoalParticipationIDs(ids ...uuid.UUID) {
+	if m.goal_participations == nil {
+		m.goal_participations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.goal_participations[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *RefreshTokenMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ClearGoalParticipations clears the "goal_participations" edge to the GoalParticipant entity.
+func (m *UserMutation) ClearGoalParticipations() {
+	m.clearedgoal_participations = true
 }
 
-// Where appends a list predicates to the RefreshTokenMutation builder.
-func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
-	m.predicates = append(m.predicates, ps...)
+// GoalParticipationsCleared reports if the "goal_participations" edge to the GoalParticipant entity was cleared.
+func (m *UserMutation) GoalParticipationsCleared() bool {
+	return m.clearedgoal_participations
 }
 
-// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RefreshToken, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// RemoveGoalParticipationIDs removes the "goal_participations" edge to the GoalParticipant entity by IDs.
+func (m *UserMutation) RemoveGoalParticipationIDs(ids ...uuid.UUID) {
+	if m.removedgoal_participations == nil {
+		m.removedgoal_participations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.goal_participations, ids[i])
+		m.removedgoal_participations[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *RefreshTokenMutation) Op() Op {
-	return m.op
+// RemovedGoalParticipations returns the removed IDs of the "goal_participations" edge to the GoalParticipant entity.
+func (m *UserMutation) RemovedGoalParticipationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgoal_participations {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetOp allows setting the mutation operation.
-func (m *RefreshTokenMutation) SetOp(op Op) {
-	m.op = op
+// GoalParticipationsIDs returns the "goal_participations" edge IDs in the mutation.
+func (m *UserMutation) GoalParticipationsIDs() (ids []uuid.UUID) {
+	for id := range m.goal_participations {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Type returns the node type of this mutation (RefreshToken).
-func (m *RefreshTokenMutation) Type() string {
-	return m.typ
+// ResetGoalParticipations resets all changes to the "goal_participations" edge.
+func (m *UserMutation) ResetGoalParticipations() {
+	m.goal_participations = nil
+	m.clearedgoal_participations = false
+	m.removedgoal_participations = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RefreshTokenMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.token_hash != nil {
-		fields = append(fields, refreshtoken.FieldTokenHash)
-	}
-	if m.expires_at != nil {
-		fields = append(fields, refreshtoken.FieldExpiresAt)
-	}
-	if m.revoked != nil {
-		fields = append(fields, refreshtoken.FieldRevoked)
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *UserMutation) AddPostIDs(ids ...uuid.UUID) {
+	if m.posts == nil {
+		m.posts = make(map[uuid.UUID]struct{})
 	}
-	if m.created_at != nil {
-		fields = append(fields, refreshtoken.FieldCreatedAt)
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case refreshtoken.FieldTokenHash:
-		return m.TokenHash()
-	case refreshtoken.FieldExpiresAt:
-		return m.ExpiresAt()
-	case refreshtoken.FieldRevoked:
-		return m.Revoked()
-	case refreshtoken.FieldCreatedAt:
-		return m.CreatedAt()
-	}
-	return nil, false
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *UserMutation) ClearPosts() {
+	m.clearedposts = true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case refreshtoken.FieldTokenHash:
-		return m.OldTokenHash(ctx)
-	case refreshtoken.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case refreshtoken.FieldRevoked:
-		return m.OldRevoked(ctx)
-	case refreshtoken.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *UserMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *UserMutation) RemovePostIDs(ids ...uuid.UUID) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
 	}
-	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case refreshtoken.FieldTokenHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTokenHash(v)
-		return nil
-	case refreshtoken.FieldExpiresAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiresAt(v)
-		return nil
-	case refreshtoken.FieldRevoked:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRevoked(v)
-		return nil
-	case refreshtoken.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *UserMutation) RemovedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown RefreshToken field %s", name)
+	return
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *RefreshTokenMutation) AddedFields() []string {
-	return nil
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *UserMutation) PostsIDs() (ids []uuid.UUID) {
+	for id := range m.posts {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResetPosts resets all changes to the "posts" edge.
+func (m *UserMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// AddReactionIDs adds the "reactions" edge to the Reaction entity by ids.
+func (m *UserMutation) AddReactionIDs(ids ...uuid.UUID) {
+	if m.reactions == nil {
+		m.reactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reactions[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *RefreshTokenMutation) ClearedFields() []string {
-	return nil
+// ClearReactions clears the "reactions" edge to the Reaction entity.
+func (m *UserMutation) ClearReactions() {
+	m.clearedreactions = true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *RefreshTokenMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ReactionsCleared reports if the "reactions" edge to the Reaction entity was cleared.
+func (m *UserMutation) ReactionsCleared() bool {
+	return m.clearedreactions
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *RefreshTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+// RemoveReactionIDs removes the "reactions" edge to the Reaction entity by IDs.
+func (m *UserMutation) RemoveReactionIDs(ids ...uuid.UUID) {
+	if m.removedreactions == nil {
+		m.removedreactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reactions, ids[i])
+		m.removedreactions[ids[i]] = struct{}{}
+	}
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *RefreshTokenMutation) ResetField(name string) error {
-	switch name {
-	case refreshtoken.FieldTokenHash:
-		m.ResetTokenHash()
-		return nil
-	case refreshtoken.FieldExpiresAt:
-		m.ResetExpiresAt()
-		return nil
-	case refreshtoken.FieldRevoked:
-		m.ResetRevoked()
-		return nil
-	case refreshtoken.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// RemovedReactions returns the removed IDs of the "reactions" edge to the Reaction entity.
+func (m *UserMutation) RemovedReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreactions {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown RefreshToken field %s", name)
+	return
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RefreshTokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, refreshtoken.EdgeUser)
+// ReactionsIDs returns the "reactions" edge IDs in the mutation.
+func (m *UserMutation) ReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.reactions {
+		ids = append(ids, id)
 	}
-	return edges
+	return
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case refreshtoken.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
+// ResetReactions resets all changes to the "reactions" edge.
+func (m *UserMutation) ResetReactions() {
+	m.reactions = nil
+	m.clearedreactions = false
+	m.removedreactions = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *UserMutation) AddCommentIDs(ids ...uuid.UUID) {
+	if m.comments == nil {
+		m.comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RefreshTokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *UserMutation) ClearComments() {
+	m.clearedcomments = true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *UserMutation) CommentsCleared() bool {
+	return m.clearedcomments
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RefreshTokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, refreshtoken.EdgeUser)
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *UserMutation) RemoveCommentIDs(ids ...uuid.UUID) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
-	switch name {
-	case refreshtoken.EdgeUser:
-		return m.cleareduser
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *UserMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *RefreshTokenMutation) ClearEdge(name string) error {
-	switch name {
-	case refreshtoken.EdgeUser:
-		m.ClearUser()
-		return nil
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *UserMutation) CommentsIDs() (ids []uuid.UUID) {
+	for id := range m.comments {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *RefreshTokenMutation) ResetEdge(name string) error {
-	switch name {
-	case refreshtoken.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown RefreshToken edge %s", name)
+// ResetComments resets all changes to the "comments" edge.
+func (m *UserMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	name                   *string
-	email                  *string
-	birthday               *time.Time
-	hometown               *string
-	bio                    *string
-	profile_picture_id     *uuid.UUID
-	created_at             *time.Time
-	updated_at             *time.Time
-	clearedFields          map[string]struct{}
-	genres                 map[uuid.UUID]struct{}
-	removedgenres          map[uuid.UUID]struct{}
-	clearedgenres          bool
-	goals                  map[uuid.UUID]struct{}
-	removedgoals           map[uuid.UUID]struct{}
-	clearedgoals           bool
-	posts                  map[uuid.UUID]struct{}
-	removedposts           map[uuid.UUID]struct{}
-	clearedposts           bool
-	reactions              map[uuid.UUID]struct{}
-	removedreactions       map[uuid.UUID]struct{}
-	clearedreactions       bool
-	uploaded_images        map[uuid.UUID]struct{}
-	removeduploaded_images map[uuid.UUID]struct{}
-	cleareduploaded_images bool
-	refresh_tokens         map[uuid.UUID]struct{}
-	removedrefresh_tokens  map[uuid.UUID]struct{}
-	clearedrefresh_tokens  bool
-	followers              map[uuid.UUID]struct{}
-	removedfollowers       map[uuid.UUID]struct{}
-	clearedfollowers       bool
-	following              map[uuid.UUID]struct{}
-	removedfollowing       map[uuid.UUID]struct{}
-	clearedfollowing       bool
-	done                   bool
-	oldValue               func(context.Context) (*User, error)
-	predicates             []predicate.User
+// AddRepostIDs adds the "reposts" edge to the Repost entity by ids.
+func (m *UserMutation) AddRepostIDs(ids ...uuid.UUID) {
+	if m.reposts == nil {
+		m.reposts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reposts[ids[i]] = struct{}{}
+	}
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+// ClearReposts clears the "reposts" edge to the Repost entity.
+func (m *UserMutation) ClearReposts() {
+	m.clearedreposts = true
+}
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// RepostsCleared reports if the "reposts" edge to the Repost entity was cleared.
+func (m *UserMutation) RepostsCleared() bool {
+	return m.clearedreposts
+}
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
+// RemoveRepostIDs removes the "reposts" edge to the Repost entity by IDs.
+func (m *UserMutation) RemoveRepostIDs(ids ...uuid.UUID) {
+	if m.removedreposts == nil {
+		m.removedreposts = make(map[uuid.UUID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.reposts, ids[i])
+		m.removedreposts[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedReposts returns the removed IDs of the "reposts" edge to the Repost entity.
+func (m *UserMutation) RemovedRepostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreposts {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// RepostsIDs returns the "reposts" edge IDs in the mutation.
+func (m *UserMutation) RepostsIDs() (ids []uuid.UUID) {
+	for id := range m.reposts {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetReposts resets all changes to the "reposts" edge.
+func (m *UserMutation) ResetReposts() {
+	m.reposts = nil
+	m.clearedreposts = false
+	m.removedreposts = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by ids.
+func (m *UserMutation) AddBookmarkIDs(ids ...uuid.UUID) {
+	if m.bookmarks == nil {
+		m.bookmarks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.bookmarks[ids[i]] = struct{}{}
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearBookmarks clears the "bookmarks" edge to the Bookmark entity.
+func (m *UserMutation) ClearBookmarks() {
+	m.clearedbookmarks = true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// BookmarksCleared reports if the "bookmarks" edge to the Bookmark entity was cleared.
+func (m *UserMutation) BookmarksCleared() bool {
+	return m.clearedbookmarks
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// RemoveBookmarkIDs removes the "bookmarks" edge to the Bookmark entity by IDs.
+func (m *UserMutation) RemoveBookmarkIDs(ids ...uuid.UUID) {
+	if m.removedbookmarks == nil {
+		m.removedbookmarks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.bookmarks, ids[i])
+		m.removedbookmarks[ids[i]] = struct{}{}
 	}
 }
 
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
+// RemovedBookmarks returns the removed IDs of the "bookmarks" edge to the Bookmark entity.
+func (m *UserMutation) RemovedBookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.removedbookmarks {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// BookmarksIDs returns the "bookmarks" edge IDs in the mutation.
+func (m *UserMutation) BookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.bookmarks {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+// ResetBookmarks resets all changes to the "bookmarks" edge.
+func (m *UserMutation) ResetBookmarks() {
+	m.bookmarks = nil
+	m.clearedbookmarks = false
+	m.removedbookmarks = nil
+}
+
+// AddBookmarkCollectionIDs adds the "bookmark_collections" edge to the BookmarkCollection entity by ids.
+func (m *UserMutation) AddBookmarkCollectionIDs(ids ...uuid.UUID) {
+	if m.bookmark_collections == nil {
+		m.bookmark_collections = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	for i := range ids {
+		m.bookmark_collections[ids[i]] = struct{}{}
 	}
-	return oldValue.Name, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
+// ClearBookmarkCollections clears the "bookmark_collections" edge to the BookmarkCollection entity.
+func (m *UserMutation) ClearBookmarkCollections() {
+	m.clearedbookmark_collections = true
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// BookmarkCollectionsCleared reports if the "bookmark_collections" edge to the BookmarkCollection entity was cleared.
+func (m *UserMutation) BookmarkCollectionsCleared() bool {
+	return m.clearedbookmark_collections
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
+// RemoveBookmarkCollectionIDs removes the "bookmark_collections" edge to the BookmarkCollection entity by IDs.
+func (m *UserMutation) RemoveBookmarkCollectionIDs(ids ...uuid.UUID) {
+	if m.removedbookmark_collections == nil {
+		m.removedbookmark_collections = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.bookmark_collections, ids[i])
+		m.removedbookmark_collections[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+// RemovedBookmarkCollections returns the removed IDs of the "bookmark_collections" edge to the BookmarkCollection entity.
+func (m *UserMutation) RemovedBookmarkCollectionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbookmark_collections {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+	return
+}
+
+// BookmarkCollectionsIDs returns the "bookmark_collections" edge IDs in the mutation.
+func (m *UserMutation) BookmarkCollectionsIDs() (ids []uuid.UUID) {
+	for id := range m.bookmark_collections {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	return
+}
+
+// ResetBookmarkCollections resets all changes to the "bookmark_collections" edge.
+func (m *UserMutation) ResetBookmarkCollections() {
+	m.bookmark_collections = nil
+	m.clearedbookmark_collections = false
+	m.removedbookmark_collections = nil
+}
+
+// AddReportIDs adds the "reports" edge to the Report entity by ids.
+func (m *UserMutation) AddReportIDs(ids ...uuid.UUID) {
+	if m.reports == nil {
+		m.reports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reports[ids[i]] = struct{}{}
 	}
-	return oldValue.Email, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ClearReports clears the "reports" edge to the Report entity.
+func (m *UserMutation) ClearReports() {
+	m.clearedreports = true
 }
 
-// SetBirthday sets the "birthday" field.
-func (m *UserMutation) SetBirthday(t time.Time) {
-	m.birthday = &t
+// ReportsCleared reports if the "reports" edge to the Report entity was cleared.
+func (m *UserMutation) ReportsCleared() bool {
+	return m.clearedreports
 }
 
-// Birthday returns the value of the "birthday" field in the mutation.
-func (m *UserMutation) Birthday() (r time.Time, exists bool) {
-	v := m.birthday
-	if v == nil {
-		return
+// RemoveReportIDs removes the "reports" edge to the Report entity by IDs.
+func (m *UserMutation) RemoveReportIDs(ids ...uuid.UUID) {
+	if m.removedreports == nil {
+		m.removedreports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reports, ids[i])
+		m.removedreports[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldBirthday returns the old "birthday" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBirthday(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBirthday is only allowed on UpdateOne operations")
+// RemovedReports returns the removed IDs of the "reports" edge to the Report entity.
+func (m *UserMutation) RemovedReportsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreports {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBirthday requires an ID field in the mutation")
+	return
+}
+
+// ReportsIDs returns the "reports" edge IDs in the mutation.
+func (m *UserMutation) ReportsIDs() (ids []uuid.UUID) {
+	for id := range m.reports {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBirthday: %w", err)
+	return
+}
+
+// ResetReports resets all changes to the "reports" edge.
+func (m *UserMutation) ResetReports() {
+	m.reports = nil
+	m.clearedreports = false
+	m.removedreports = nil
+}
+
+// AddClaimedReportIDs adds the "claimed_reports" edge to the Report entity by ids.
+func (m *UserMutation) AddClaimedReportIDs(ids ...uuid.UUID) {
+	if m.claimed_reports == nil {
+		m.claimed_reports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.claimed_reports[ids[i]] = struct{}{}
 	}
-	return oldValue.Birthday, nil
 }
 
-// ClearBirthday clears the value of the "birthday" field.
-func (m *UserMutation) ClearBirthday() {
-	m.birthday = nil
-	m.clearedFields[user.FieldBirthday] = struct{}{}
+// ClearClaimedReports clears the "claimed_reports" edge to the Report entity.
+func (m *UserMutation) ClearClaimedReports() {
+	m.clearedclaimed_reports = true
 }
 
-// BirthdayCleared returns if the "birthday" field was cleared in this mutation.
-func (m *UserMutation) BirthdayCleared() bool {
-	_, ok := m.clearedFields[user.FieldBirthday]
-	return ok
+// ClaimedReportsCleared reports if the "claimed_reports" edge to the Report entity was cleared.
+func (m *UserMutation) ClaimedReportsCleared() bool {
+	return m.clearedclaimed_reports
 }
 
-// ResetBirthday resets all changes to the "birthday" field.
-func (m *UserMutation) ResetBirthday() {
-	m.birthday = nil
-	delete(m.clearedFields, user.FieldBirthday)
+// RemoveClaimedReportIDs removes the "claimed_reports" edge to the Report entity by IDs.
+func (m *UserMutation) RemoveClaimedReportIDs(ids ...uuid.UUID) {
+	if m.removedclaimed_reports == nil {
+		m.removedclaimed_reports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.claimed_reports, ids[i])
+		m.removedclaimed_reports[ids[i]] = struct{}{}
+	}
 }
 
-// SetHometown sets the "hometown" field.
-func (m *UserMutation) SetHometown(s string) {
-	m.hometown = &s
+// RemovedClaimedReports returns the removed IDs of the "claimed_reports" edge to the Report entity.
+func (m *UserMutation) RemovedClaimedReportsIDs() (ids []uuid.UUID) {
+	for id := range m.removedclaimed_reports {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Hometown returns the value of the "hometown" field in the mutation.
-func (m *UserMutation) Hometown() (r string, exists bool) {
-	v := m.hometown
-	if v == nil {
-		return
+// ClaimedReportsIDs returns the "claimed_reports" edge IDs in the mutation.
+func (m *UserMutation) ClaimedReportsIDs() (ids []uuid.UUID) {
+	for id := range m.claimed_reports {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldHometown returns the old "hometown" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldHometown(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHometown is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHometown requires an ID field in the mutation")
+// ResetClaimedReports resets all changes to the "claimed_reports" edge.
+func (m *UserMutation) ResetClaimedReports() {
+	m.claimed_reports = nil
+	m.clearedclaimed_reports = false
+	m.removedclaimed_reports = nil
+}
+
+// AddModerationActionIDs adds the "moderation_actions" edge to the ModerationAction entity by ids.
+func (m *UserMutation) AddModerationActionIDs(ids ...uuid.UUID) {
+	if m.moderation_actions == nil {
+		m.moderation_actions = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHometown: %w", err)
+	for i := range ids {
+		m.moderation_actions[ids[i]] = struct{}{}
 	}
-	return oldValue.Hometown, nil
 }
 
-// ClearHometown clears the value of the "hometown" field.
-func (m *UserMutation) ClearHometown() {
-	m.hometown = nil
-	m.clearedFields[user.FieldHometown] = struct{}{}
+// ClearModerationActions clears the "moderation_actions" edge to the ModerationAction entity.
+func (m *UserMutation) ClearModerationActions() {
+	m.clearedmoderation_actions = true
 }
 
-// HometownCleared returns if the "hometown" field was cleared in this mutation.
-func (m *UserMutation) HometownCleared() bool {
-	_, ok := m.clearedFields[user.FieldHometown]
-	return ok
+// ModerationActionsCleared reports if the "moderation_actions" edge to the ModerationAction entity was cleared.
+func (m *UserMutation) ModerationActionsCleared() bool {
+	return m.clearedmoderation_actions
 }
 
-// ResetHometown resets all changes to the "hometown" field.
-func (m *UserMutation) ResetHometown() {
-	m.hometown = nil
-	delete(m.clearedFields, user.FieldHometown)
+// RemoveModerationActionIDs removes the "moderation_actions" edge to the ModerationAction entity by IDs.
+func (m *UserMutation) RemoveModerationActionIDs(ids ...uuid.UUID) {
+	if m.removedmoderation_actions == nil {
+		m.removedmoderation_actions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.moderation_actions, ids[i])
+		m.removedmoderation_actions[ids[i]] = struct{}{}
+	}
 }
 
-// SetBio sets the "bio" field.
-func (m *UserMutation) SetBio(s string) {
-	m.bio = &s
+// RemovedModerationActions returns the removed IDs of the "moderation_actions" edge to the ModerationAction entity.
+func (m *UserMutation) RemovedModerationActionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmoderation_actions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Bio returns the value of the "bio" field in the mutation.
-func (m *UserMutation) Bio() (r string, exists bool) {
-	v := m.bio
-	if v == nil {
-		return
+// ModerationActionsIDs returns the "moderation_actions" edge IDs in the mutation.
+func (m *UserMutation) ModerationActionsIDs() (ids []uuid.UUID) {
+	for id := range m.moderation_actions {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldBio returns the old "bio" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBio(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBio is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBio requires an ID field in the mutation")
+// ResetModerationActions resets all changes to the "moderation_actions" edge.
+func (m *UserMutation) ResetModerationActions() {
+	m.moderation_actions = nil
+	m.clearedmoderation_actions = false
+	m.removedmoderation_actions = nil
+}
+
+// AddMentionedInIDs adds the "mentioned_in" edge to the Post entity by ids.
+func (m *UserMutation) AddMentionedInIDs(ids ...uuid.UUID) {
+	if m.mentioned_in == nil {
+		m.mentioned_in = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+	for i := range ids {
+		m.mentioned_in[ids[i]] = struct{}{}
 	}
-	return oldValue.Bio, nil
 }
 
-// ClearBio clears the value of the "bio" field.
-func (m *UserMutation) ClearBio() {
-	m.bio = nil
-	m.clearedFields[user.FieldBio] = struct{}{}
+// ClearMentionedIn clears the "mentioned_in" edge to the Post entity.
+func (m *UserMutation) ClearMentionedIn() {
+	m.clearedmentioned_in = true
 }
 
-// BioCleared returns if the "bio" field was cleared in this mutation.
-func (m *UserMutation) BioCleared() bool {
-	_, ok := m.clearedFields[user.FieldBio]
-	return ok
+// MentionedInCleared reports if the "mentioned_in" edge to the Post entity was cleared.
+func (m *UserMutation) MentionedInCleared() bool {
+	return m.clearedmentioned_in
 }
 
-// ResetBio resets all changes to the "bio" field.
-func (m *UserMutation) ResetBio() {
-	m.bio = nil
-	delete(m.clearedFields, user.FieldBio)
+// RemoveMentionedInIDs removes the "mentioned_in" edge to the Post entity by IDs.
+func (m *UserMutation) RemoveMentionedInIDs(ids ...uuid.UUID) {
+	if m.removedmentioned_in == nil {
+		m.removedmentioned_in = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.mentioned_in, ids[i])
+		m.removedmentioned_in[ids[i]] = struct{}{}
+	}
 }
 
-// SetProfilePictureID sets the "profile_picture_id" field.
-func (m *UserMutation) SetProfilePictureID(u uuid.UUID) {
-	m.profile_picture_id = &u
+// RemovedMentionedIn returns the removed IDs of the "mentioned_in" edge to the Post entity.
+func (m *UserMutation) RemovedMentionedInIDs() (ids []uuid.UUID) {
+	for id := range m.removedmentioned_in {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ProfilePictureID returns the value of the "profile_picture_id" field in the mutation.
-func (m *UserMutation) ProfilePictureID() (r uuid.UUID, exists bool) {
-	v := m.profile_picture_id
-	if v == nil {
-		return
+// MentionedInIDs returns the "mentioned_in" edge IDs in the mutation.
+func (m *UserMutation) MentionedInIDs() (ids []uuid.UUID) {
+	for id := range m.mentioned_in {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldProfilePictureID returns the old "profile_picture_id" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldProfilePictureID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfilePictureID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfilePictureID requires an ID field in the mutation")
+// ResetMentionedIn resets all changes to the "mentioned_in" edge.
+func (m *UserMutation) ResetMentionedIn() {
+	m.mentioned_in = nil
+	m.clearedmentioned_in = false
+	m.removedmentioned_in = nil
+}
+
+// AddUploadedImageIDs adds the "uploaded_images" edge to the Image entity by ids.
+func (m *UserMutation) AddUploadedImageIDs(ids ...uuid.UUID) {
+	if m.uploaded_images == nil {
+		m.uploaded_images = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfilePictureID: %w", err)
+	for i := range ids {
+		m.uploaded_images[ids[i]] = struct{}{}
 	}
-	return oldValue.ProfilePictureID, nil
 }
 
-// ClearProfilePictureID clears the value of the "profile_picture_id" field.
-func (m *UserMutation) ClearProfilePictureID() {
-	m.profile_picture_id = nil
-	m.clearedFields[user.FieldProfilePictureID] = struct{}{}
+// ClearUploadedImages clears the "uploaded_images" edge to the Image entity.
+func (m *UserMutation) ClearUploadedImages() {
+	m.cleareduploaded_images = true
 }
 
-// ProfilePictureIDCleared returns if the "profile_picture_id" field was cleared in this mutation.
-func (m *UserMutation) ProfilePictureIDCleared() bool {
-	_, ok := m.clearedFields[user.FieldProfilePictureID]
-	return ok
+// UploadedImagesCleared reports if the "uploaded_images" edge to the Image entity was cleared.
+func (m *UserMutation) UploadedImagesCleared() bool {
+	return m.cleareduploaded_images
 }
 
-// ResetProfilePictureID resets all changes to the "profile_picture_id" field.
-func (m *UserMutation) ResetProfilePictureID() {
-	m.profile_picture_id = nil
-	delete(m.clearedFields, user.FieldProfilePictureID)
+// RemoveUploadedImageIDs removes the "uploaded_images" edge to the Image entity by IDs.
+func (m *UserMutation) RemoveUploadedImageIDs(ids ...uuid.UUID) {
+	if m.removeduploaded_images == nil {
+		m.removeduploaded_images = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.uploaded_images, ids[i])
+		m.removeduploaded_images[ids[i]] = struct{}{}
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// RemovedUploadedImages returns the removed IDs of the "uploaded_images" edge to the Image entity.
+func (m *UserMutation) RemovedUploadedImagesIDs() (ids []uuid.UUID) {
+	for id := range m.removeduploaded_images {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// UploadedImagesIDs returns the "uploaded_images" edge IDs in the mutation.
+func (m *UserMutation) UploadedImagesIDs() (ids []uuid.UUID) {
+	for id := range m.uploaded_images {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// ResetUploadedImages resets all changes to the "uploaded_images" edge.
+func (m *UserMutation) ResetUploadedImages() {
+	m.uploaded_images = nil
+	m.cleareduploaded_images = false
+	m.removeduploaded_images = nil
+}
+
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by ids.
+func (m *UserMutation) AddRefreshTokenIDs(ids ...uuid.UUID) {
+	if m.refresh_tokens == nil {
+		m.refresh_tokens = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		m.refresh_tokens[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearRefreshTokens clears the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) ClearRefreshTokens() {
+	m.clearedrefresh_tokens = true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RefreshTokensCleared reports if the "refresh_tokens" edge to the RefreshToken entity was cleared.
+func (m *UserMutation) RefreshTokensCleared() bool {
+	return m.clearedrefresh_tokens
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (m *UserMutation) RemoveRefreshTokenIDs(ids ...uuid.UUID) {
+	if m.removedrefresh_tokens == nil {
+		m.removedrefresh_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.refresh_tokens, ids[i])
+		m.removedrefresh_tokens[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// RemovedRefreshTokens returns the removed IDs of the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) RemovedRefreshTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedrefresh_tokens {
+		ids = append(ids, id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// RefreshTokensIDs returns the "refresh_tokens" edge IDs in the mutation.
+func (m *UserMutation) RefreshTokensIDs() (ids []uuid.UUID) {
+	for id := range m.refresh_tokens {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddGenreIDs adds the "genres" edge to the Genre entity by ids.
-func (m *UserMutation) AddGenreIDs(ids ...uuid.UUID) {
-	if m.genres == nil {
-		m.genres = make(map[uuid.UUID]struct{})
+// ResetRefreshTokens resets all changes to the "refresh_tokens" edge.
+func (m *UserMutation) ResetRefreshTokens() {
+	m.refresh_tokens = nil
+	m.clearedrefresh_tokens = false
+	m.removedrefresh_tokens = nil
+}
+
+// AddTimelineEntryIDs adds the "timeline_entries" edge to the TimelineEntry entity by ids.
+func (m *UserMutation) AddTimelineEntryIDs(ids ...uuid.UUID) {
+	if m.timeline_entries == nil {
+		m.timeline_entries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.genres[ids[i]] = struct{}{}
+		m.timeline_entries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearGenres clears the "genres" edge to the Genre entity.
-func (m *UserMutation) ClearGenres() {
-	m.clearedgenres = true
+// ClearTimelineEntries clears the "timeline_entries" edge to the TimelineEntry entity.
+func (m *UserMutation) ClearTimelineEntries() {
+	m.clearedtimeline_entries = true
 }
 
-// GenresCleared reports if the "genres" edge to the Genre entity was cleared.
-func (m *UserMutation) GenresCleared() bool {
-	return m.clearedgenres
+// TimelineEntriesCleared reports if the "timeline_entries" edge to the TimelineEntry entity was cleared.
+func (m *UserMutation) TimelineEntriesCleared() bool {
+	return m.clearedtimeline_entries
 }
 
-// RemoveGenreIDs removes the "genres" edge to the Genre entity by IDs.
-func (m *UserMutation) RemoveGenreIDs(ids ...uuid.UUID) {
-	if m.removedgenres == nil {
-		m.removedgenres = make(map[uuid.UUID]struct{})
+// RemoveTimelineEntryIDs removes the "timeline_entries" edge to the TimelineEntry entity by IDs.
+func (m *UserMutation) RemoveTimelineEntryIDs(ids ...uuid.UUID) {
+	if m.removedtimeline_entries == nil {
+		m.removedtimeline_entries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.genres, ids[i])
-		m.removedgenres[ids[i]] = struct{}{}
+		delete(m.timeline_entries, ids[i])
+		m.removedtimeline_entries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedGenres returns the removed IDs of the "genres" edge to the Genre entity.
-func (m *UserMutation) RemovedGenresIDs() (ids []uuid.UUID) {
-	for id := range m.removedgenres {
+// RemovedTimelineEntries returns the removed IDs of the "timeline_entries" edge to the TimelineEntry entity.
+func (m *UserMutation) RemovedTimelineEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtimeline_entries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// GenresIDs returns the "genres" edge IDs in the mutation.
-func (m *UserMutation) GenresIDs() (ids []uuid.UUID) {
-	for id := range m.genres {
+// TimelineEntriesIDs returns the "timeline_entries" edge IDs in the mutation.
+func (m *UserMutation) TimelineEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.timeline_entries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGenres resets all changes to the "genres" edge.
-func (m *UserMutation) ResetGenres() {
-	m.genres = nil
-	m.clearedgenres = false
-	m.removedgenres = nil
+// ResetTimelineEntries resets all changes to the "timeline_entries" edge.
+func (m *UserMutation) ResetTimelineEntries() {
+	m.timeline_entries = nil
+	m.clearedtimeline_entries = false
+	m.removedtimeline_entries = nil
 }
 
-// AddGoalIDs adds the "goals" edge to the Goal entity by ids.
-func (m *UserMutation) AddGoalIDs(ids ...uuid.UUID) {
-	if m.goals == nil {
-		m.goals = make(map[uuid.UUID]struct{})
+// AddTimelineActivityIDs adds the "timeline_activities" edge to the TimelineEntry entity by ids.
+func (m *UserMutation) AddTimelineActivityIDs(ids ...uuid.UUID) {
+	if m.timeline_activities == nil {
+		m.timeline_activities = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.goals[ids[i]] = struct{}{}
+		m.timeline_activities[ids[i]] = struct{}{}
 	}
 }
 
-// ClearGoals clears the "goals" edge to the Goal entity.
-func (m *UserMutation) ClearGoals() {
-	m.clearedgoals = true
+// ClearTimelineActivities clears the "timeline_activities" edge to the TimelineEntry entity.
+func (m *UserMutation) ClearTimelineActivities() {
+	m.clearedtimeline_activities = true
 }
 
-// GoalsCleared reports if the "goals" edge to the Goal entity was cleared.
-func (m *UserMutation) GoalsCleared() bool {
-	return m.clearedgoals
+// TimelineActivitiesCleared reports if the "timeline_activities" edge to the TimelineEntry entity was cleared.
+func (m *UserMutation) TimelineActivitiesCleared() bool {
+	return m.clearedtimeline_activities
 }
 
-// RemoveGoalIDs removes the "goals" edge to the Goal entity by IDs.
-func (m *UserMutation) RemoveGoalIDs(ids ...uuid.UUID) {
-	if m.removedgoals == nil {
-		m.removedgoals = make(map[uuid.UUID]struct{})
+// RemoveTimelineActivityIDs removes the "timeline_activities" edge to the TimelineEntry entity by IDs.
+func (m *UserMutation) RemoveTimelineActivityIDs(ids ...uuid.UUID) {
+	if m.removedtimeline_activities == nil {
+		m.removedtimeline_activities = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.goals, ids[i])
-		m.removedgoals[ids[i]] = struct{}{}
+		delete(m.timeline_activities, ids[i])
+		m.removedtimeline_activities[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedGoals returns the removed IDs of the "goals" edge to the Goal entity.
-func (m *UserMutation) RemovedGoalsIDs() (ids []uuid.UUID) {
-	for id := range m.removedgoals {
+// RemovedTimelineActivities returns the removed IDs of the "timeline_activities" edge to the TimelineEntry entity.
+func (m *UserMutation) RemovedTimelineActivitiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtimeline_activities {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// GoalsIDs returns the "goals" edge IDs in the mutation.
-func (m *UserMutation) GoalsIDs() (ids []uuid.UUID) {
-	for id := range m.goals {
+// TimelineActivitiesIDs returns the "timeline_activities" edge IDs in the mutation.
+func (m *UserMutation) TimelineActivitiesIDs() (ids []uuid.UUID) {
+	for id := range m.timeline_activities {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGoals resets all changes to the "goals" edge.
-func (m *UserMutation) ResetGoals() {
-	m.goals = nil
-	m.clearedgoals = false
-	m.removedgoals = nil
+// ResetTimelineActivities resets all changes to the "timeline_activities" edge.
+func (m *UserMutation) ResetTimelineActivities() {
+	m.timeline_activities = nil
+	m.clearedtimeline_activities = false
+	m.removedtimeline_activities = nil
 }
 
-// AddPostIDs adds the "posts" edge to the Post entity by ids.
-func (m *UserMutation) AddPostIDs(ids ...uuid.UUID) {
-	if m.posts == nil {
-		m.posts = make(map[uuid.UUID]struct{})
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *UserMutation) AddNotificationIDs(ids ...uuid.UUID) {
+	if m.notifications == nil {
+		m.notifications = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.posts[ids[i]] = struct{}{}
+		m.notifications[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPosts clears the "posts" edge to the Post entity.
-func (m *UserMutation) ClearPosts() {
-	m.clearedposts = true
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *UserMutation) ClearNotifications() {
+	m.clearednotifications = true
 }
 
-// PostsCleared reports if the "posts" edge to the Post entity was cleared.
-func (m *UserMutation) PostsCleared() bool {
-	return m.clearedposts
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *UserMutation) NotificationsCleared() bool {
+	return m.clearednotifications
 }
 
-// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
-func (m *UserMutation) RemovePostIDs(ids ...uuid.UUID) {
-	if m.removedposts == nil {
-		m.removedposts = make(map[uuid.UUID]struct{})
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *UserMutation) RemoveNotificationIDs(ids ...uuid.UUID) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.posts, ids[i])
-		m.removedposts[ids[i]] = struct{}{}
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
-func (m *UserMutation) RemovedPostsIDs() (ids []uuid.UUID) {
-	for id := range m.removedposts {
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *UserMutation) RemovedNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifications {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PostsIDs returns the "posts" edge IDs in the mutation.
-func (m *UserMutation) PostsIDs() (ids []uuid.UUID) {
-	for id := range m.posts {
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *UserMutation) NotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.notifications {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPosts resets all changes to the "posts" edge.
-func (m *UserMutation) ResetPosts() {
-	m.posts = nil
-	m.clearedposts = false
-	m.removedposts = nil
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *UserMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
 }
 
-// AddReactionIDs adds the "reactions" edge to the Reaction entity by ids.
-func (m *UserMutation) AddReactionIDs(ids ...uuid.UUID) {
-	if m.reactions == nil {
-		m.reactions = make(map[uuid.UUID]struct{})
+// AddLatestNotificationIDs adds the "latest_notifications" edge to the Notification entity by ids.
+func (m *UserMutation) AddLatestNotificationIDs(ids ...uuid.UUID) {
+	if m.latest_notifications == nil {
+		m.latest_notifications = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.reactions[ids[i]] = struct{}{}
+		m.latest_notifications[ids[i]] = struct{}{}
 	}
 }
 
-// ClearReactions clears the "reactions" edge to the Reaction entity.
-func (m *UserMutation) ClearReactions() {
-	m.clearedreactions = true
+// ClearLatestNotifications clears the "latest_notifications" edge to the Notification entity.
+func (m *UserMutation) ClearLatestNotifications() {
+	m.clearedlatest_notifications = true
 }
 
-// ReactionsCleared reports if the "reactions" edge to the Reaction entity was cleared.
-func (m *UserMutation) ReactionsCleared() bool {
-	return m.clearedreactions
+// LatestNotificationsCleared reports if the "latest_notifications" edge to the Notification entity was cleared.
+func (m *UserMutation) LatestNotificationsCleared() bool {
+	return m.clearedlatest_notifications
 }
 
-// RemoveReactionIDs removes the "reactions" edge to the Reaction entity by IDs.
-func (m *UserMutation) RemoveReactionIDs(ids ...uuid.UUID) {
-	if m.removedreactions == nil {
-		m.removedreactions = make(map[uuid.UUID]struct{})
+// RemoveLatestNotificationIDs removes the "latest_notifications" edge to the Notification entity by IDs.
+func (m *UserMutation) RemoveLatestNotificationIDs(ids ...uuid.UUID) {
+	if m.removedlatest_notifications == nil {
+		m.removedlatest_notifications = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.reactions, ids[i])
-		m.removedreactions[ids[i]] = struct{}{}
+		delete(m.latest_notifications, ids[i])
+		m.removedlatest_notifications[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedReactions returns the removed IDs of the "reactions" edge to the Reaction entity.
-func (m *UserMutation) RemovedReactionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedreactions {
+// RemovedLatestNotifications returns the removed IDs of the "latest_notifications" edge to the Notification entity.
+func (m *UserMutation) RemovedLatestNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedlatest_notifications {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ReactionsIDs returns the "reactions" edge IDs in the mutation.
-func (m *UserMutation) ReactionsIDs() (ids []uuid.UUID) {
-	for id := range m.reactions {
+// LatestNotificationsIDs returns the "latest_notifications" edge IDs in the mutation.
+func (m *UserMutation) LatestNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.latest_notifications {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetReactions resets all changes to the "reactions" edge.
-func (m *UserMutation) ResetReactions() {
-	m.reactions = nil
-	m.clearedreactions = false
-	m.removedreactions = nil
+// ResetLatestNotifications resets all changes to the "latest_notifications" edge.
+func (m *UserMutation) ResetLatestNotifications() {
+	m.latest_notifications = nil
+	m.clearedlatest_notifications = false
+	m.removedlatest_notifications = nil
 }
 
-// AddUploadedImageIDs adds the "uploaded_images" edge to the Image entity by ids.
-func (m *UserMutation) AddUploadedImageIDs(ids ...uuid.UUID) {
-	if m.uploaded_images == nil {
-		m.uploaded_images = make(map[uuid.UUID]struct{})
+// AddActedNotificationIDs adds the "acted_notifications" edge to the Notification entity by ids.
+func (m *UserMutation) AddActedNotificationIDs(ids ...uuid.UUID) {
+	if m.acted_notifications == nil {
+		m.acted_notifications = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.uploaded_images[ids[i]] = struct{}{}
+		m.acted_notifications[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUploadedImages clears the "uploaded_images" edge to the Image entity.
-func (m *UserMutation) ClearUploadedImages() {
-	m.cleareduploaded_images = true
+// ClearActedNotifications clears the "acted_notifications" edge to the Notification entity.
+func (m *UserMutation) ClearActedNotifications() {
+	m.clearedacted_notifications = true
 }
 
-// UploadedImagesCleared reports if the "uploaded_images" edge to the Image entity was cleared.
-func (m *UserMutation) UploadedImagesCleared() bool {
-	return m.cleareduploaded_images
+// ActedNotificationsCleared reports if the "acted_notifications" edge to the Notification entity was cleared.
+func (m *UserMutation) ActedNotificationsCleared() bool {
+	return m.clearedacted_notifications
 }
 
-// RemoveUploadedImageIDs removes the "uploaded_images" edge to the Image entity by IDs.
-func (m *UserMutation) RemoveUploadedImageIDs(ids ...uuid.UUID) {
-	if m.removeduploaded_images == nil {
-		m.removeduploaded_images = make(map[uuid.UUID]struct{})
+// RemoveActedNotificationIDs removes the "acted_notifications" edge to the Notification entity by IDs.
+func (m *UserMutation) RemoveActedNotificationIDs(ids ...uuid.UUID) {
+	if m.removedacted_notifications == nil {
+		m.removedacted_notifications = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.uploaded_images, ids[i])
-		m.removeduploaded_images[ids[i]] = struct{}{}
+		delete(m.acted_notifications, ids[i])
+		m.removedacted_notifications[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUploadedImages returns the removed IDs of the "uploaded_images" edge to the Image entity.
-func (m *UserMutation) RemovedUploadedImagesIDs() (ids []uuid.UUID) {
-	for id := range m.removeduploaded_images {
+// RemovedActedNotifications returns the removed IDs of the "acted_notifications" edge to the Notification entity.
+func (m *UserMutation) RemovedActedNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedacted_notifications {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UploadedImagesIDs returns the "uploaded_images" edge IDs in the mutation.
-func (m *UserMutation) UploadedImagesIDs() (ids []uuid.UUID) {
-	for id := range m.uploaded_images {
+// ActedNotificationsIDs returns the "acted_notifications" edge IDs in the mutation.
+func (m *UserMutation) ActedNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.acted_notifications {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUploadedImages resets all changes to the "uploaded_images" edge.
-func (m *UserMutation) ResetUploadedImages() {
-	m.uploaded_images = nil
-	m.cleareduploaded_images = false
-	m.removeduploaded_images = nil
+// ResetActedNotifications resets all changes to the "acted_notifications" edge.
+func (m *UserMutation) ResetActedNotifications() {
+	m.acted_notifications = nil
+	m.clearedacted_notifications = false
+	m.removedacted_notifications = nil
 }
 
-// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by ids.
-func (m *UserMutation) AddRefreshTokenIDs(ids ...uuid.UUID) {
-	if m.refresh_tokens == nil {
-		m.refresh_tokens = make(map[uuid.UUID]struct{})
+// AddNotificationDeliveryIDs adds the "notification_deliveries" edge to the NotificationDelivery entity by ids.
+func (m *UserMutation) AddNotificationDeliveryIDs(ids ...uuid.UUID) {
+	if m.notification_deliveries == nil {
+		m.notification_deliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.refresh_tokens[ids[i]] = struct{}{}
+		m.notification_deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearRefreshTokens clears the "refresh_tokens" edge to the RefreshToken entity.
-func (m *UserMutation) ClearRefreshTokens() {
-	m.clearedrefresh_tokens = true
+// ClearNotificationDeliveries clears the "notification_deliveries" edge to the NotificationDelivery entity.
+func (m *UserMutation) ClearNotificationDeliveries() {
+	m.clearednotification_deliveries = true
 }
 
-// RefreshTokensCleared reports if the "refresh_tokens" edge to the RefreshToken entity was cleared.
-func (m *UserMutation) RefreshTokensCleared() bool {
-	return m.clearedrefresh_tokens
+// NotificationDeliveriesCleared reports if the "notification_deliveries" edge to the NotificationDelivery entity was cleared.
+func (m *UserMutation) NotificationDeliveriesCleared() bool {
+	return m.clearednotification_deliveries
 }
 
-// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to the RefreshToken entity by IDs.
-func (m *UserMutation) RemoveRefreshTokenIDs(ids ...uuid.UUID) {
-	if m.removedrefresh_tokens == nil {
-		m.removedrefresh_tokens = make(map[uuid.UUID]struct{})
+// RemoveNotificationDeliveryIDs removes the "notification_deliveries" edge to the NotificationDelivery entity by IDs.
+func (m *UserMutation) RemoveNotificationDeliveryIDs(ids ...uuid.UUID) {
+	if m.removednotification_deliveries == nil {
+		m.removednotification_deliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.refresh_tokens, ids[i])
-		m.removedrefresh_tokens[ids[i]] = struct{}{}
+		delete(m.notification_deliveries, ids[i])
+		m.removednotification_deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedRefreshTokens returns the removed IDs of the "refresh_tokens" edge to the RefreshToken entity.
-func (m *UserMutation) RemovedRefreshTokensIDs() (ids []uuid.UUID) {
-	for id := range m.removedrefresh_tokens {
+// RemovedNotificationDeliveries returns the removed IDs of the "notification_deliveries" edge to the NotificationDelivery entity.
+func (m *UserMutation) RemovedNotificationDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removednotification_deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// RefreshTokensIDs returns the "refresh_tokens" edge IDs in the mutation.
-func (m *UserMutation) RefreshTokensIDs() (ids []uuid.UUID) {
-	for id := range m.refresh_tokens {
+// NotificationDeliveriesIDs returns the "notification_deliveries" edge IDs in the mutation.
+func (m *UserMutation) NotificationDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.notification_deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetRefreshTokens resets all changes to the "refresh_tokens" edge.
-func (m *UserMutation) ResetRefreshTokens() {
-	m.refresh_tokens = nil
-	m.clearedrefresh_tokens = false
-	m.removedrefresh_tokens = nil
+// ResetNotificationDeliveries resets all changes to the "notification_deliveries" edge.
+func (m *UserMutation) ResetNotificationDeliveries() {
+	m.notification_deliveries = nil
+	m.clearednotification_deliveries = false
+	m.removednotification_deliveries = nil
 }
 
 // AddFollowerIDs adds the "followers" edge to the User entity by ids.
@@ -4426,6 +20191,114 @@ func (m *UserMutation) ResetFollowing() {
 	m.removedfollowing = nil
 }
 
+// AddBlockedByIDs adds the "blocked_by" edge to the User entity by ids.
+func (m *UserMutation) AddBlockedByIDs(ids ...uuid.UUID) {
+	if m.blocked_by == nil {
+		m.blocked_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.blocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlockedBy clears the "blocked_by" edge to the User entity.
+func (m *UserMutation) ClearBlockedBy() {
+	m.clearedblocked_by = true
+}
+
+// BlockedByCleared reports if the "blocked_by" edge to the User entity was cleared.
+func (m *UserMutation) BlockedByCleared() bool {
+	return m.clearedblocked_by
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to the User entity by IDs.
+func (m *UserMutation) RemoveBlockedByIDs(ids ...uuid.UUID) {
+	if m.removedblocked_by == nil {
+		m.removedblocked_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_by, ids[i])
+		m.removedblocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlockedBy returns the removed IDs of the "blocked_by" edge to the User entity.
+func (m *UserMutation) RemovedBlockedByIDs() (ids []uuid.UUID) {
+	for id := range m.removedblocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockedByIDs returns the "blocked_by" edge IDs in the mutation.
+func (m *UserMutation) BlockedByIDs() (ids []uuid.UUID) {
+	for id := range m.blocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlockedBy resets all changes to the "blocked_by" edge.
+func (m *UserMutation) ResetBlockedBy() {
+	m.blocked_by = nil
+	m.clearedblocked_by = false
+	m.removedblocked_by = nil
+}
+
+// AddBlockingIDs adds the "blocking" edge to the User entity by ids.
+func (m *UserMutation) AddBlockingIDs(ids ...uuid.UUID) {
+	if m.blocking == nil {
+		m.blocking = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.blocking[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlocking clears the "blocking" edge to the User entity.
+func (m *UserMutation) ClearBlocking() {
+	m.clearedblocking = true
+}
+
+// BlockingCleared reports if the "blocking" edge to the User entity was cleared.
+func (m *UserMutation) BlockingCleared() bool {
+	return m.clearedblocking
+}
+
+// RemoveBlockingIDs removes the "blocking" edge to the User entity by IDs.
+func (m *UserMutation) RemoveBlockingIDs(ids ...uuid.UUID) {
+	if m.removedblocking == nil {
+		m.removedblocking = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocking, ids[i])
+		m.removedblocking[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlocking returns the removed IDs of the "blocking" edge to the User entity.
+func (m *UserMutation) RemovedBlockingIDs() (ids []uuid.UUID) {
+	for id := range m.removedblocking {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockingIDs returns the "blocking" edge IDs in the mutation.
+func (m *UserMutation) BlockingIDs() (ids []uuid.UUID) {
+	for id := range m.blocking {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlocking resets all changes to the "blocking" edge.
+func (m *UserMutation) ResetBlocking() {
+	m.blocking = nil
+	m.clearedblocking = false
+	m.removedblocking = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -4460,13 +20333,16 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 18)
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
 	if m.email != nil {
 		fields = append(fields, user.FieldEmail)
 	}
+	if m.handle != nil {
+		fields = append(fields, user.FieldHandle)
+	}
 	if m.birthday != nil {
 		fields = append(fields, user.FieldBirthday)
 	}
@@ -4476,9 +20352,36 @@ func (m *UserMutation) Fields() []string {
 	if m.bio != nil {
 		fields = append(fields, user.FieldBio)
 	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
 	if m.profile_picture_id != nil {
 		fields = append(fields, user.FieldProfilePictureID)
 	}
+	if m.time_zone != nil {
+		fields = append(fields, user.FieldTimeZone)
+	}
+	if m.quiet_hours_start != nil {
+		fields = append(fields, user.FieldQuietHoursStart)
+	}
+	if m.quiet_hours_end != nil {
+		fields = append(fields, user.FieldQuietHoursEnd)
+	}
+	if m.hidden_at != nil {
+		fields = append(fields, user.FieldHiddenAt)
+	}
+	if m.suspended_at != nil {
+		fields = append(fields, user.FieldSuspendedAt)
+	}
+	if m.suspended_until != nil {
+		fields = append(fields, user.FieldSuspendedUntil)
+	}
+	if m.timeline_horizon != nil {
+		fields = append(fields, user.FieldTimelineHorizon)
+	}
+	if m.notification_opt_outs != nil {
+		fields = append(fields, user.FieldNotificationOptOuts)
+	}
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -4497,14 +20400,34 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Name()
 	case user.FieldEmail:
 		return m.Email()
+	case user.FieldHandle:
+		return m.Handle()
 	case user.FieldBirthday:
 		return m.Birthday()
 	case user.FieldHometown:
 		return m.Hometown()
 	case user.FieldBio:
 		return m.Bio()
+	case user.FieldRole:
+		return m.Role()
 	case user.FieldProfilePictureID:
 		return m.ProfilePictureID()
+	case user.FieldTimeZone:
+		return m.TimeZone()
+	case user.FieldQuietHoursStart:
+		return m.QuietHoursStart()
+	case user.FieldQuietHoursEnd:
+		return m.QuietHoursEnd()
+	case user.FieldHiddenAt:
+		return m.HiddenAt()
+	case user.FieldSuspendedAt:
+		return m.SuspendedAt()
+	case user.FieldSuspendedUntil:
+		return m.SuspendedUntil()
+	case user.FieldTimelineHorizon:
+		return m.TimelineHorizon()
+	case user.FieldNotificationOptOuts:
+		return m.NotificationOptOuts()
 	case user.FieldCreatedAt:
 		return m.CreatedAt()
 	case user.FieldUpdatedAt:
@@ -4522,14 +20445,34 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldName(ctx)
 	case user.FieldEmail:
 		return m.OldEmail(ctx)
+	case user.FieldHandle:
+		return m.OldHandle(ctx)
 	case user.FieldBirthday:
 		return m.OldBirthday(ctx)
 	case user.FieldHometown:
 		return m.OldHometown(ctx)
 	case user.FieldBio:
 		return m.OldBio(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
 	case user.FieldProfilePictureID:
 		return m.OldProfilePictureID(ctx)
+	case user.FieldTimeZone:
+		return m.OldTimeZone(ctx)
+	case user.FieldQuietHoursStart:
+		return m.OldQuietHoursStart(ctx)
+	case user.FieldQuietHoursEnd:
+		return m.OldQuietHoursEnd(ctx)
+	case user.FieldHiddenAt:
+		return m.OldHiddenAt(ctx)
+	case user.FieldSuspendedAt:
+		return m.OldSuspendedAt(ctx)
+	case user.FieldSuspendedUntil:
+		return m.OldSuspendedUntil(ctx)
+	case user.FieldTimelineHorizon:
+		return m.OldTimelineHorizon(ctx)
+	case user.FieldNotificationOptOuts:
+		return m.OldNotificationOptOuts(ctx)
 	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case user.FieldUpdatedAt:
@@ -4548,14 +20491,21 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetName(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
 		return nil
-	case user.FieldEmail:
+	case user.FieldHandle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetHandle(v)
 		return nil
 	case user.FieldBirthday:
 		v, ok := value.(time.Time)
@@ -4578,6 +20528,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetBio(v)
 		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
 	case user.FieldProfilePictureID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
@@ -4585,6 +20542,62 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetProfilePictureID(v)
 		return nil
+	case user.FieldTimeZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeZone(v)
+		return nil
+	case user.FieldQuietHoursStart:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietHoursStart(v)
+		return nil
+	case user.FieldQuietHoursEnd:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietHoursEnd(v)
+		return nil
+	case user.FieldHiddenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHiddenAt(v)
+		return nil
+	case user.FieldSuspendedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspendedAt(v)
+		return nil
+	case user.FieldSuspendedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspendedUntil(v)
+		return nil
+	case user.FieldTimelineHorizon:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimelineHorizon(v)
+		return nil
+	case user.FieldNotificationOptOuts:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotificationOptOuts(v)
+		return nil
 	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -4606,13 +20619,26 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addquiet_hours_start != nil {
+		fields = append(fields, user.FieldQuietHoursStart)
+	}
+	if m.addquiet_hours_end != nil {
+		fields = append(fields, user.FieldQuietHoursEnd)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldQuietHoursStart:
+		return m.AddedQuietHoursStart()
+	case user.FieldQuietHoursEnd:
+		return m.AddedQuietHoursEnd()
+	}
 	return nil, false
 }
 
@@ -4621,6 +20647,20 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldQuietHoursStart:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuietHoursStart(v)
+		return nil
+	case user.FieldQuietHoursEnd:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuietHoursEnd(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -4629,6 +20669,9 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *UserMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(user.FieldHandle) {
+		fields = append(fields, user.FieldHandle)
+	}
 	if m.FieldCleared(user.FieldBirthday) {
 		fields = append(fields, user.FieldBirthday)
 	}
@@ -4641,6 +20684,27 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldProfilePictureID) {
 		fields = append(fields, user.FieldProfilePictureID)
 	}
+	if m.FieldCleared(user.FieldQuietHoursStart) {
+		fields = append(fields, user.FieldQuietHoursStart)
+	}
+	if m.FieldCleared(user.FieldQuietHoursEnd) {
+		fields = append(fields, user.FieldQuietHoursEnd)
+	}
+	if m.FieldCleared(user.FieldHiddenAt) {
+		fields = append(fields, user.FieldHiddenAt)
+	}
+	if m.FieldCleared(user.FieldSuspendedAt) {
+		fields = append(fields, user.FieldSuspendedAt)
+	}
+	if m.FieldCleared(user.FieldSuspendedUntil) {
+		fields = append(fields, user.FieldSuspendedUntil)
+	}
+	if m.FieldCleared(user.FieldTimelineHorizon) {
+		fields = append(fields, user.FieldTimelineHorizon)
+	}
+	if m.FieldCleared(user.FieldNotificationOptOuts) {
+		fields = append(fields, user.FieldNotificationOptOuts)
+	}
 	return fields
 }
 
@@ -4655,6 +20719,9 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
 	switch name {
+	case user.FieldHandle:
+		m.ClearHandle()
+		return nil
 	case user.FieldBirthday:
 		m.ClearBirthday()
 		return nil
@@ -4667,6 +20734,27 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldProfilePictureID:
 		m.ClearProfilePictureID()
 		return nil
+	case user.FieldQuietHoursStart:
+		m.ClearQuietHoursStart()
+		return nil
+	case user.FieldQuietHoursEnd:
+		m.ClearQuietHoursEnd()
+		return nil
+	case user.FieldHiddenAt:
+		m.ClearHiddenAt()
+		return nil
+	case user.FieldSuspendedAt:
+		m.ClearSuspendedAt()
+		return nil
+	case user.FieldSuspendedUntil:
+		m.ClearSuspendedUntil()
+		return nil
+	case user.FieldTimelineHorizon:
+		m.ClearTimelineHorizon()
+		return nil
+	case user.FieldNotificationOptOuts:
+		m.ClearNotificationOptOuts()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -4681,6 +20769,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldEmail:
 		m.ResetEmail()
 		return nil
+	case user.FieldHandle:
+		m.ResetHandle()
+		return nil
 	case user.FieldBirthday:
 		m.ResetBirthday()
 		return nil
@@ -4690,9 +20781,36 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldBio:
 		m.ResetBio()
 		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
 	case user.FieldProfilePictureID:
 		m.ResetProfilePictureID()
 		return nil
+	case user.FieldTimeZone:
+		m.ResetTimeZone()
+		return nil
+	case user.FieldQuietHoursStart:
+		m.ResetQuietHoursStart()
+		return nil
+	case user.FieldQuietHoursEnd:
+		m.ResetQuietHoursEnd()
+		return nil
+	case user.FieldHiddenAt:
+		m.ResetHiddenAt()
+		return nil
+	case user.FieldSuspendedAt:
+		m.ResetSuspendedAt()
+		return nil
+	case user.FieldSuspendedUntil:
+		m.ResetSuspendedUntil()
+		return nil
+	case user.FieldTimelineHorizon:
+		m.ResetTimelineHorizon()
+		return nil
+	case user.FieldNotificationOptOuts:
+		m.ResetNotificationOptOuts()
+		return nil
 	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -4705,31 +20823,82 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 25)
 	if m.genres != nil {
 		edges = append(edges, user.EdgeGenres)
 	}
 	if m.goals != nil {
 		edges = append(edges, user.EdgeGoals)
 	}
+	if m.goal_participations != nil {
+		edges = append(edges, user.EdgeGoalParticipations)
+	}
 	if m.posts != nil {
 		edges = append(edges, user.EdgePosts)
 	}
 	if m.reactions != nil {
 		edges = append(edges, user.EdgeReactions)
 	}
+	if m.comments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.reposts != nil {
+		edges = append(edges, user.EdgeReposts)
+	}
+	if m.bookmarks != nil {
+		edges = append(edges, user.EdgeBookmarks)
+	}
+	if m.bookmark_collections != nil {
+		edges = append(edges, user.EdgeBookmarkCollections)
+	}
+	if m.reports != nil {
+		edges = append(edges, user.EdgeReports)
+	}
+	if m.claimed_reports != nil {
+		edges = append(edges, user.EdgeClaimedReports)
+	}
+	if m.moderation_actions != nil {
+		edges = append(edges, user.EdgeModerationActions)
+	}
+	if m.mentioned_in != nil {
+		edges = append(edges, user.EdgeMentionedIn)
+	}
 	if m.uploaded_images != nil {
 		edges = append(edges, user.EdgeUploadedImages)
 	}
 	if m.refresh_tokens != nil {
 		edges = append(edges, user.EdgeRefreshTokens)
 	}
+	if m.timeline_entries != nil {
+		edges = append(edges, user.EdgeTimelineEntries)
+	}
+	if m.timeline_activities != nil {
+		edges = append(edges, user.EdgeTimelineActivities)
+	}
+	if m.notifications != nil {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.latest_notifications != nil {
+		edges = append(edges, user.EdgeLatestNotifications)
+	}
+	if m.acted_notifications != nil {
+		edges = append(edges, user.EdgeActedNotifications)
+	}
+	if m.notification_deliveries != nil {
+		edges = append(edges, user.EdgeNotificationDeliveries)
+	}
 	if m.followers != nil {
 		edges = append(edges, user.EdgeFollowers)
 	}
 	if m.following != nil {
 		edges = append(edges, user.EdgeFollowing)
 	}
+	if m.blocked_by != nil {
+		edges = append(edges, user.EdgeBlockedBy)
+	}
+	if m.blocking != nil {
+		edges = append(edges, user.EdgeBlocking)
+	}
 	return edges
 }
 
@@ -4749,6 +20918,12 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeGoalParticipations:
+		ids := make([]ent.Value, 0, len(m.goal_participations))
+		for id := range m.goal_participations {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgePosts:
 		ids := make([]ent.Value, 0, len(m.posts))
 		for id := range m.posts {
@@ -4761,6 +20936,54 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReposts:
+		ids := make([]ent.Value, 0, len(m.reposts))
+		for id := range m.reposts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.bookmarks))
+		for id := range m.bookmarks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBookmarkCollections:
+		ids := make([]ent.Value, 0, len(m.bookmark_collections))
+		for id := range m.bookmark_collections {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.reports))
+		for id := range m.reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeClaimedReports:
+		ids := make([]ent.Value, 0, len(m.claimed_reports))
+		for id := range m.claimed_reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeModerationActions:
+		ids := make([]ent.Value, 0, len(m.moderation_actions))
+		for id := range m.moderation_actions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMentionedIn:
+		ids := make([]ent.Value, 0, len(m.mentioned_in))
+		for id := range m.mentioned_in {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgeUploadedImages:
 		ids := make([]ent.Value, 0, len(m.uploaded_images))
 		for id := range m.uploaded_images {
@@ -4773,6 +20996,42 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeTimelineEntries:
+		ids := make([]ent.Value, 0, len(m.timeline_entries))
+		for id := range m.timeline_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTimelineActivities:
+		ids := make([]ent.Value, 0, len(m.timeline_activities))
+		for id := range m.timeline_activities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLatestNotifications:
+		ids := make([]ent.Value, 0, len(m.latest_notifications))
+		for id := range m.latest_notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeActedNotifications:
+		ids := make([]ent.Value, 0, len(m.acted_notifications))
+		for id := range m.acted_notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotificationDeliveries:
+		ids := make([]ent.Value, 0, len(m.notification_deliveries))
+		for id := range m.notification_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgeFollowers:
 		ids := make([]ent.Value, 0, len(m.followers))
 		for id := range m.followers {
@@ -4785,37 +21044,100 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.blocked_by))
+		for id := range m.blocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlocking:
+		ids := make([]ent.Value, 0, len(m.blocking))
+		for id := range m.blocking {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 25)
 	if m.removedgenres != nil {
 		edges = append(edges, user.EdgeGenres)
 	}
 	if m.removedgoals != nil {
 		edges = append(edges, user.EdgeGoals)
 	}
+	if m.removedgoal_participations != nil {
+		edges = append(edges, user.EdgeGoalParticipations)
+	}
 	if m.removedposts != nil {
 		edges = append(edges, user.EdgePosts)
 	}
 	if m.removedreactions != nil {
 		edges = append(edges, user.EdgeReactions)
 	}
+	if m.removedcomments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.removedreposts != nil {
+		edges = append(edges, user.EdgeReposts)
+	}
+	if m.removedbookmarks != nil {
+		edges = append(edges, user.EdgeBookmarks)
+	}
+	if m.removedbookmark_collections != nil {
+		edges = append(edges, user.EdgeBookmarkCollections)
+	}
+	if m.removedreports != nil {
+		edges = append(edges, user.EdgeReports)
+	}
+	if m.removedclaimed_reports != nil {
+		edges = append(edges, user.EdgeClaimedReports)
+	}
+	if m.removedmoderation_actions != nil {
+		edges = append(edges, user.EdgeModerationActions)
+	}
+	if m.removedmentioned_in != nil {
+		edges = append(edges, user.EdgeMentionedIn)
+	}
 	if m.removeduploaded_images != nil {
 		edges = append(edges, user.EdgeUploadedImages)
 	}
 	if m.removedrefresh_tokens != nil {
 		edges = append(edges, user.EdgeRefreshTokens)
 	}
+	if m.removedtimeline_entries != nil {
+		edges = append(edges, user.EdgeTimelineEntries)
+	}
+	if m.removedtimeline_activities != nil {
+		edges = append(edges, user.EdgeTimelineActivities)
+	}
+	if m.removednotifications != nil {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.removedlatest_notifications != nil {
+		edges = append(edges, user.EdgeLatestNotifications)
+	}
+	if m.removedacted_notifications != nil {
+		edges = append(edges, user.EdgeActedNotifications)
+	}
+	if m.removednotification_deliveries != nil {
+		edges = append(edges, user.EdgeNotificationDeliveries)
+	}
 	if m.removedfollowers != nil {
 		edges = append(edges, user.EdgeFollowers)
 	}
 	if m.removedfollowing != nil {
 		edges = append(edges, user.EdgeFollowing)
 	}
+	if m.removedblocked_by != nil {
+		edges = append(edges, user.EdgeBlockedBy)
+	}
+	if m.removedblocking != nil {
+		edges = append(edges, user.EdgeBlocking)
+	}
 	return edges
 }
 
@@ -4835,6 +21157,12 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeGoalParticipations:
+		ids := make([]ent.Value, 0, len(m.removedgoal_participations))
+		for id := range m.removedgoal_participations {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgePosts:
 		ids := make([]ent.Value, 0, len(m.removedposts))
 		for id := range m.removedposts {
@@ -4847,6 +21175,54 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReposts:
+		ids := make([]ent.Value, 0, len(m.removedreposts))
+		for id := range m.removedreposts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.removedbookmarks))
+		for id := range m.removedbookmarks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBookmarkCollections:
+		ids := make([]ent.Value, 0, len(m.removedbookmark_collections))
+		for id := range m.removedbookmark_collections {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.removedreports))
+		for id := range m.removedreports {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeClaimedReports:
+		ids := make([]ent.Value, 0, len(m.removedclaimed_reports))
+		for id := range m.removedclaimed_reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeModerationActions:
+		ids := make([]ent.Value, 0, len(m.removedmoderation_actions))
+		for id := range m.removedmoderation_actions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMentionedIn:
+		ids := make([]ent.Value, 0, len(m.removedmentioned_in))
+		for id := range m.removedmentioned_in {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgeUploadedImages:
 		ids := make([]ent.Value, 0, len(m.removeduploaded_images))
 		for id := range m.removeduploaded_images {
@@ -4859,6 +21235,42 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeTimelineEntries:
+		ids := make([]ent.Value, 0, len(m.removedtimeline_entries))
+		for id := range m.removedtimeline_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTimelineActivities:
+		ids := make([]ent.Value, 0, len(m.removedtimeline_activities))
+		for id := range m.removedtimeline_activities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLatestNotifications:
+		ids := make([]ent.Value, 0, len(m.removedlatest_notifications))
+		for id := range m.removedlatest_notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeActedNotifications:
+		ids := make([]ent.Value, 0, len(m.removedacted_notifications))
+		for id := range m.removedacted_notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotificationDeliveries:
+		ids := make([]ent.Value, 0, len(m.removednotification_deliveries))
+		for id := range m.removednotification_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgeFollowers:
 		ids := make([]ent.Value, 0, len(m.removedfollowers))
 		for id := range m.removedfollowers {
@@ -4871,37 +21283,100 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.removedblocked_by))
+		for id := range m.removedblocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlocking:
+		ids := make([]ent.Value, 0, len(m.removedblocking))
+		for id := range m.removedblocking {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 25)
 	if m.clearedgenres {
 		edges = append(edges, user.EdgeGenres)
 	}
 	if m.clearedgoals {
 		edges = append(edges, user.EdgeGoals)
 	}
+	if m.clearedgoal_participations {
+		edges = append(edges, user.EdgeGoalParticipations)
+	}
 	if m.clearedposts {
 		edges = append(edges, user.EdgePosts)
 	}
 	if m.clearedreactions {
 		edges = append(edges, user.EdgeReactions)
 	}
+	if m.clearedcomments {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.clearedreposts {
+		edges = append(edges, user.EdgeReposts)
+	}
+	if m.clearedbookmarks {
+		edges = append(edges, user.EdgeBookmarks)
+	}
+	if m.clearedbookmark_collections {
+		edges = append(edges, user.EdgeBookmarkCollections)
+	}
+	if m.clearedreports {
+		edges = append(edges, user.EdgeReports)
+	}
+	if m.clearedclaimed_reports {
+		edges = append(edges, user.EdgeClaimedReports)
+	}
+	if m.clearedmoderation_actions {
+		edges = append(edges, user.EdgeModerationActions)
+	}
+	if m.clearedmentioned_in {
+		edges = append(edges, user.EdgeMentionedIn)
+	}
 	if m.cleareduploaded_images {
 		edges = append(edges, user.EdgeUploadedImages)
 	}
 	if m.clearedrefresh_tokens {
 		edges = append(edges, user.EdgeRefreshTokens)
 	}
+	if m.clearedtimeline_entries {
+		edges = append(edges, user.EdgeTimelineEntries)
+	}
+	if m.clearedtimeline_activities {
+		edges = append(edges, user.EdgeTimelineActivities)
+	}
+	if m.clearednotifications {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.clearedlatest_notifications {
+		edges = append(edges, user.EdgeLatestNotifications)
+	}
+	if m.clearedacted_notifications {
+		edges = append(edges, user.EdgeActedNotifications)
+	}
+	if m.clearednotification_deliveries {
+		edges = append(edges, user.EdgeNotificationDeliveries)
+	}
 	if m.clearedfollowers {
 		edges = append(edges, user.EdgeFollowers)
 	}
 	if m.clearedfollowing {
 		edges = append(edges, user.EdgeFollowing)
 	}
+	if m.clearedblocked_by {
+		edges = append(edges, user.EdgeBlockedBy)
+	}
+	if m.clearedblocking {
+		edges = append(edges, user.EdgeBlocking)
+	}
 	return edges
 }
 
@@ -4913,18 +21388,52 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedgenres
 	case user.EdgeGoals:
 		return m.clearedgoals
+	case user.EdgeGoalParticipations:
+		return m.clearedgoal_participations
 	case user.EdgePosts:
 		return m.clearedposts
 	case user.EdgeReactions:
 		return m.clearedreactions
+	case user.EdgeComments:
+		return m.clearedcomments
+	case user.EdgeReposts:
+		return m.clearedreposts
+	case user.EdgeBookmarks:
+		return m.clearedbookmarks
+	case user.EdgeBookmarkCollections:
+		return m.clearedbookmark_collections
+	case user.EdgeReports:
+		return m.clearedreports
+	case user.EdgeClaimedReports:
+		return m.clearedclaimed_reports
+	case user.EdgeModerationActions:
+		return m.clearedmoderation_actions
+	case user.EdgeMentionedIn:
+		return m.clearedmentioned_in
 	case user.EdgeUploadedImages:
 		return m.cleareduploaded_images
 	case user.EdgeRefreshTokens:
 		return m.clearedrefresh_tokens
+	case user.EdgeTimelineEntries:
+		return m.clearedtimeline_entries
+	case user.EdgeTimelineActivities:
+		return m.clearedtimeline_activities
+	case user.EdgeNotifications:
+		return m.clearednotifications
+	case user.EdgeLatestNotifications:
+		return m.clearedlatest_notifications
+	case user.EdgeActedNotifications:
+		return m.clearedacted_notifications
+	case user.EdgeNotificationDeliveries:
+		return m.clearednotification_deliveries
 	case user.EdgeFollowers:
 		return m.clearedfollowers
 	case user.EdgeFollowing:
 		return m.clearedfollowing
+	case user.EdgeBlockedBy:
+		return m.clearedblocked_by
+	case user.EdgeBlocking:
+		return m.clearedblocking
 	}
 	return false
 }
@@ -4947,24 +21456,75 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeGoals:
 		m.ResetGoals()
 		return nil
+	case user.EdgeGoalParticipations:
+		m.ResetGoalParticipations()
+		return nil
 	case user.EdgePosts:
 		m.ResetPosts()
 		return nil
 	case user.EdgeReactions:
 		m.ResetReactions()
 		return nil
+	case user.EdgeComments:
+		m.ResetComments()
+		return nil
+	case user.EdgeReposts:
+		m.ResetReposts()
+		return nil
+	case user.EdgeBookmarks:
+		m.ResetBookmarks()
+		return nil
+	case user.EdgeBookmarkCollections:
+		m.ResetBookmarkCollections()
+		return nil
+	case user.EdgeReports:
+		m.ResetReports()
+		return nil
+	case user.EdgeClaimedReports:
+		m.ResetClaimedReports()
+		return nil
+	case user.EdgeModerationActions:
+		m.ResetModerationActions()
+		return nil
+	case user.EdgeMentionedIn:
+		m.ResetMentionedIn()
+		return nil
 	case user.EdgeUploadedImages:
 		m.ResetUploadedImages()
 		return nil
 	case user.EdgeRefreshTokens:
 		m.ResetRefreshTokens()
 		return nil
+	case user.EdgeTimelineEntries:
+		m.ResetTimelineEntries()
+		return nil
+	case user.EdgeTimelineActivities:
+		m.ResetTimelineActivities()
+		return nil
+	case user.EdgeNotifications:
+		m.ResetNotifications()
+		return nil
+	case user.EdgeLatestNotifications:
+		m.ResetLatestNotifications()
+		return nil
+	case user.EdgeActedNotifications:
+		m.ResetActedNotifications()
+		return nil
+	case user.EdgeNotificationDeliveries:
+		m.ResetNotificationDeliveries()
+		return nil
 	case user.EdgeFollowers:
 		m.ResetFollowers()
 		return nil
 	case user.EdgeFollowing:
 		m.ResetFollowing()
 		return nil
+	case user.EdgeBlockedBy:
+		m.ResetBlockedBy()
+		return nil
+	case user.EdgeBlocking:
+		m.ResetBlocking()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
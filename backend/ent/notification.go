@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/goal"
+	"backend/ent/notification"
+	"backend/ent/post"
+	"backend/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Notification is the model entity for the Notification schema.
+type Notification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type notification.Type `json:"type,omitempty"`
+	// ActorCount holds the value of the "actor_count" field.
+	ActorCount int `json:"actor_count,omitempty"`
+	// ReadAt holds the value of the "read_at" field.
+	ReadAt *time.Time `json:"read_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationQuery when eager-loading is set.
+	Edges                     NotificationEdges `json:"edges"`
+	goal_notifications        *uuid.UUID
+	post_notifications        *uuid.UUID
+	user_notifications        *uuid.UUID
+	user_latest_notifications *uuid.UUID
+	selectValues              sql.SelectValues
+}
+
+// NotificationEdges holds the relations/edges for other nodes in the graph.
+type NotificationEdges struct {
+	// Recipient holds the value of the recipient edge.
+	Recipient *User `json:"recipient,omitempty"`
+	// Actor holds the value of the actor edge.
+	Actor *User `json:"actor,omitempty"`
+	// Actors holds the value of the actors edge.
+	Actors []*User `json:"actors,omitempty"`
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// Goal holds the value of the goal edge.
+	Goal *Goal `json:"goal,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// RecipientOrErr returns the Recipient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationEdges) RecipientOrErr() (*User, error) {
+	if e.Recipient != nil {
+		return e.Recipient, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "recipient"}
+}
+
+// ActorOrErr returns the Actor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationEdges) ActorOrErr() (*User, error) {
+	if e.Actor != nil {
+		return e.Actor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "actor"}
+}
+
+// ActorsOrErr returns the Actors value or an error if the edge
+// was not loaded in eager-loading.
+func (e NotificationEdges) ActorsOrErr() ([]*User, error) {
+	if e.loadedTypes[2] {
+		return e.Actors, nil
+	}
+	return nil, &NotLoadedError{edge: "actors"}
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// GoalOrErr returns the Goal value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationEdges) GoalOrErr() (*Goal, error) {
+	if e.Goal != nil {
+		return e.Goal, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: goal.Label}
+	}
+	return nil, &NotLoadedError{edge: "goal"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Notification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notification.FieldActorCount:
+			values[i] = new(sql.NullInt64)
+		case notification.FieldType:
+			values[i] = new(sql.NullString)
+		case notification.FieldReadAt, notification.FieldCreatedAt, notification.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case notification.FieldID:
+			values[i] = new(uuid.UUID)
+		case notification.ForeignKeys[0]: // goal_notifications
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case notification.ForeignKeys[1]: // post_notifications
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case notification.ForeignKeys[2]: // user_notifications
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case notification.ForeignKeys[3]: // user_latest_notifications
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Notification fields.
+func (_m *Notification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notification.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case notification.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = notification.Type(value.String)
+			}
+		case notification.FieldActorCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_count", values[i])
+			} else if value.Valid {
+				_m.ActorCount = int(value.Int64)
+			}
+		case notification.FieldReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field read_at", values[i])
+			} else if value.Valid {
+				_m.ReadAt = new(time.Time)
+				*_m.ReadAt = value.Time
+			}
+		case notification.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case notification.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case notification.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field goal_notifications", values[i])
+			} else if value.Valid {
+				_m.goal_notifications = new(uuid.UUID)
+				*_m.goal_notifications = *value.S.(*uuid.UUID)
+			}
+		case notification.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field post_notifications", values[i])
+			} else if value.Valid {
+				_m.post_notifications = new(uuid.UUID)
+				*_m.post_notifications = *value.S.(*uuid.UUID)
+			}
+		case notification.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_notifications", values[i])
+			} else if value.Valid {
+				_m.user_notifications = new(uuid.UUID)
+				*_m.user_notifications = *value.S.(*uuid.UUID)
+			}
+		case notification.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_latest_notifications", values[i])
+			} else if value.Valid {
+				_m.user_latest_notifications = new(uuid.UUID)
+				*_m.user_latest_notifications = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Notification.
+// This includes values selected through modifiers, order, etc.
+func (_m *Notification) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRecipient queries the "recipient" edge of the Notification entity.
+func (_m *Notification) QueryRecipient() *UserQuery {
+	return NewNotificationClient(_m.config).QueryRecipient(_m)
+}
+
+// QueryActor queries the "actor" edge of the Notification entity.
+func (_m *Notification) QueryActor() *UserQuery {
+	return NewNotificationClient(_m.config).QueryActor(_m)
+}
+
+// QueryActors queries the "actors" edge of the Notification entity.
+func (_m *Notification) QueryActors() *UserQuery {
+	return NewNotificationClient(_m.config).QueryActors(_m)
+}
+
+// QueryPost queries the "post" edge of the Notification entity.
+func (_m *Notification) QueryPost() *PostQuery {
+	return NewNotificationClient(_m.config).QueryPost(_m)
+}
+
+// QueryGoal queries the "goal" edge of the Notification entity.
+func (_m *Notification) QueryGoal() *GoalQuery {
+	return NewNotificationClient(_m.config).QueryGoal(_m)
+}
+
+// Update returns a builder for updating this Notification.
+// Note that you need to call Notification.Unwrap() before calling this method if this Notification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Notification) Update() *NotificationUpdateOne {
+	return NewNotificationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Notification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Notification) Unwrap() *Notification {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Notification is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Notification) String() string {
+	var builder strings.Builder
+	builder.WriteString("Notification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("actor_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ActorCount))
+	builder.WriteString(", ")
+	if v := _m.ReadAt; v != nil {
+		builder.WriteString("read_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Notifications is a parsable slice of Notification.
+type Notifications []*Notification
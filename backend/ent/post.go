@@ -5,6 +5,7 @@ package ent
 import (
 	"backend/ent/goal"
 	"backend/ent/post"
+	"backend/ent/schema/types"
 	"backend/ent/user"
 	"fmt"
 	"strings"
@@ -22,14 +23,31 @@ type Post struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
+	// Format holds the value of the "format" field.
+	Format post.Format `json:"format,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount *float64 `json:"amount,omitempty"`
+	// EditCount holds the value of the "edit_count" field.
+	EditCount int `json:"edit_count,omitempty"`
+	// Status holds the value of the "status" field.
+	Status post.Status `json:"status,omitempty"`
+	// PublishAt holds the value of the "publish_at" field.
+	PublishAt *time.Time `json:"publish_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// HiddenAt holds the value of the "hidden_at" field.
+	HiddenAt *time.Time `json:"hidden_at,omitempty"`
+	// SearchVector holds the value of the "search_vector" field.
+	SearchVector types.TSVector `json:"-"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash string `json:"content_hash,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PostQuery when eager-loading is set.
 	Edges        PostEdges `json:"edges"`
 	goal_posts   *uuid.UUID
+	post_quotes  *uuid.UUID
 	user_posts   *uuid.UUID
 	selectValues sql.SelectValues
 }
@@ -44,9 +62,29 @@ type PostEdges struct {
 	Images []*Image `json:"images,omitempty"`
 	// Reactions holds the value of the reactions edge.
 	Reactions []*Reaction `json:"reactions,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// Reposts holds the value of the reposts edge.
+	Reposts []*Repost `json:"reposts,omitempty"`
+	// QuoteOf holds the value of the quote_of edge.
+	QuoteOf *Post `json:"quote_of,omitempty"`
+	// Quotes holds the value of the quotes edge.
+	Quotes []*Post `json:"quotes,omitempty"`
+	// Bookmarks holds the value of the bookmarks edge.
+	Bookmarks []*Bookmark `json:"bookmarks,omitempty"`
+	// Mentions holds the value of the mentions edge.
+	Mentions []*User `json:"mentions,omitempty"`
+	// Hashtags holds the value of the hashtags edge.
+	Hashtags []*Hashtag `json:"hashtags,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*PostRevision `json:"revisions,omitempty"`
+	// TimelineEntries holds the value of the timeline_entries edge.
+	TimelineEntries []*TimelineEntry `json:"timeline_entries,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [14]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -89,20 +127,120 @@ func (e PostEdges) ReactionsOrErr() ([]*Reaction, error) {
 	return nil, &NotLoadedError{edge: "reactions"}
 }
 
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[4] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// RepostsOrErr returns the Reposts value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) RepostsOrErr() ([]*Repost, error) {
+	if e.loadedTypes[5] {
+		return e.Reposts, nil
+	}
+	return nil, &NotLoadedError{edge: "reposts"}
+}
+
+// QuoteOfOrErr returns the QuoteOf value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostEdges) QuoteOfOrErr() (*Post, error) {
+	if e.QuoteOf != nil {
+		return e.QuoteOf, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "quote_of"}
+}
+
+// QuotesOrErr returns the Quotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) QuotesOrErr() ([]*Post, error) {
+	if e.loadedTypes[7] {
+		return e.Quotes, nil
+	}
+	return nil, &NotLoadedError{edge: "quotes"}
+}
+
+// BookmarksOrErr returns the Bookmarks value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) BookmarksOrErr() ([]*Bookmark, error) {
+	if e.loadedTypes[8] {
+		return e.Bookmarks, nil
+	}
+	return nil, &NotLoadedError{edge: "bookmarks"}
+}
+
+// MentionsOrErr returns the Mentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) MentionsOrErr() ([]*User, error) {
+	if e.loadedTypes[9] {
+		return e.Mentions, nil
+	}
+	return nil, &NotLoadedError{edge: "mentions"}
+}
+
+// HashtagsOrErr returns the Hashtags value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) HashtagsOrErr() ([]*Hashtag, error) {
+	if e.loadedTypes[10] {
+		return e.Hashtags, nil
+	}
+	return nil, &NotLoadedError{edge: "hashtags"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) RevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[11] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// TimelineEntriesOrErr returns the TimelineEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) TimelineEntriesOrErr() ([]*TimelineEntry, error) {
+	if e.loadedTypes[12] {
+		return e.TimelineEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "timeline_entries"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[13] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Post) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case post.FieldContent:
+		case post.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case post.FieldEditCount:
+			values[i] = new(sql.NullInt64)
+		case post.FieldContent, post.FieldFormat, post.FieldStatus, post.FieldContentHash:
 			values[i] = new(sql.NullString)
-		case post.FieldCreatedAt, post.FieldUpdatedAt:
+		case post.FieldPublishAt, post.FieldCreatedAt, post.FieldUpdatedAt, post.FieldHiddenAt:
 			values[i] = new(sql.NullTime)
+		case post.FieldSearchVector:
+			values[i] = new(types.TSVector)
 		case post.FieldID:
 			values[i] = new(uuid.UUID)
 		case post.ForeignKeys[0]: // goal_posts
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case post.ForeignKeys[1]: // user_posts
+		case post.ForeignKeys[1]: // post_quotes
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case post.ForeignKeys[2]: // user_posts
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -131,6 +269,38 @@ func (_m *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Content = value.String
 			}
+		case post.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = post.Format(value.String)
+			}
+		case post.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = new(float64)
+				*_m.Amount = value.Float64
+			}
+		case post.FieldEditCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field edit_count", values[i])
+			} else if value.Valid {
+				_m.EditCount = int(value.Int64)
+			}
+		case post.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = post.Status(value.String)
+			}
+		case post.FieldPublishAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field publish_at", values[i])
+			} else if value.Valid {
+				_m.PublishAt = new(time.Time)
+				*_m.PublishAt = value.Time
+			}
 		case post.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -143,6 +313,25 @@ func (_m *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case post.FieldHiddenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field hidden_at", values[i])
+			} else if value.Valid {
+				_m.HiddenAt = new(time.Time)
+				*_m.HiddenAt = value.Time
+			}
+		case post.FieldSearchVector:
+			if value, ok := values[i].(*types.TSVector); !ok {
+				return fmt.Errorf("unexpected type %T for field search_vector", values[i])
+			} else if value != nil {
+				_m.SearchVector = *value
+			}
+		case post.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = value.String
+			}
 		case post.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field goal_posts", values[i])
@@ -151,6 +340,13 @@ func (_m *Post) assignValues(columns []string, values []any) error {
 				*_m.goal_posts = *value.S.(*uuid.UUID)
 			}
 		case post.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field post_quotes", values[i])
+			} else if value.Valid {
+				_m.post_quotes = new(uuid.UUID)
+				*_m.post_quotes = *value.S.(*uuid.UUID)
+			}
+		case post.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_posts", values[i])
 			} else if value.Valid {
@@ -190,6 +386,56 @@ func (_m *Post) QueryReactions() *ReactionQuery {
 	return NewPostClient(_m.config).QueryReactions(_m)
 }
 
+// QueryComments queries the "comments" edge of the Post entity.
+func (_m *Post) QueryComments() *CommentQuery {
+	return NewPostClient(_m.config).QueryComments(_m)
+}
+
+// QueryReposts queries the "reposts" edge of the Post entity.
+func (_m *Post) QueryReposts() *RepostQuery {
+	return NewPostClient(_m.config).QueryReposts(_m)
+}
+
+// QueryQuoteOf queries the "quote_of" edge of the Post entity.
+func (_m *Post) QueryQuoteOf() *PostQuery {
+	return NewPostClient(_m.config).QueryQuoteOf(_m)
+}
+
+// QueryQuotes queries the "quotes" edge of the Post entity.
+func (_m *Post) QueryQuotes() *PostQuery {
+	return NewPostClient(_m.config).QueryQuotes(_m)
+}
+
+// QueryBookmarks queries the "bookmarks" edge of the Post entity.
+func (_m *Post) QueryBookmarks() *BookmarkQuery {
+	return NewPostClient(_m.config).QueryBookmarks(_m)
+}
+
+// QueryMentions queries the "mentions" edge of the Post entity.
+func (_m *Post) QueryMentions() *UserQuery {
+	return NewPostClient(_m.config).QueryMentions(_m)
+}
+
+// QueryHashtags queries the "hashtags" edge of the Post entity.
+func (_m *Post) QueryHashtags() *HashtagQuery {
+	return NewPostClient(_m.config).QueryHashtags(_m)
+}
+
+// QueryRevisions queries the "revisions" edge of the Post entity.
+func (_m *Post) QueryRevisions() *PostRevisionQuery {
+	return NewPostClient(_m.config).QueryRevisions(_m)
+}
+
+// QueryTimelineEntries queries the "timeline_entries" edge of the Post entity.
+func (_m *Post) QueryTimelineEntries() *TimelineEntryQuery {
+	return NewPostClient(_m.config).QueryTimelineEntries(_m)
+}
+
+// QueryNotifications queries the "notifications" edge of the Post entity.
+func (_m *Post) QueryNotifications() *NotificationQuery {
+	return NewPostClient(_m.config).QueryNotifications(_m)
+}
+
 // Update returns a builder for updating this Post.
 // Note that you need to call Post.Unwrap() before calling this method if this Post
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -216,11 +462,40 @@ func (_m *Post) String() string {
 	builder.WriteString("content=")
 	builder.WriteString(_m.Content)
 	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Format))
+	builder.WriteString(", ")
+	if v := _m.Amount; v != nil {
+		builder.WriteString("amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("edit_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EditCount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.PublishAt; v != nil {
+		builder.WriteString("publish_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.HiddenAt; v != nil {
+		builder.WriteString("hidden_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("search_vector=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(_m.ContentHash)
 	builder.WriteByte(')')
 	return builder.String()
 }
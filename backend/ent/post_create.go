@@ -3,16 +3,26 @@
 package ent
 
 import (
+	"backend/ent/bookmark"
+	"backend/ent/comment"
 	"backend/ent/goal"
+	"backend/ent/hashtag"
 	"backend/ent/image"
+	"backend/ent/notification"
 	"backend/ent/post"
+	"backend/ent/postrevision"
 	"backend/ent/reaction"
+	"backend/ent/repost"
+	"backend/ent/schema/types"
+	"backend/ent/timelineentry"
 	"backend/ent/user"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -23,6 +33,7 @@ type PostCreate struct {
 	config
 	mutation *PostMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetContent sets the "content" field.
@@ -31,6 +42,76 @@ func (_c *PostCreate) SetContent(v string) *PostCreate {
 	return _c
 }
 
+// SetFormat sets the "format" field.
+func (_c *PostCreate) SetFormat(v post.Format) *PostCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *PostCreate) SetNillableFormat(v *post.Format) *PostCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PostCreate) SetAmount(v float64) *PostCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *PostCreate) SetNillableAmount(v *float64) *PostCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetEditCount sets the "edit_count" field.
+func (_c *PostCreate) SetEditCount(v int) *PostCreate {
+	_c.mutation.SetEditCount(v)
+	return _c
+}
+
+// SetNillableEditCount sets the "edit_count" field if the given value is not nil.
+func (_c *PostCreate) SetNillableEditCount(v *int) *PostCreate {
+	if v != nil {
+		_c.SetEditCount(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PostCreate) SetStatus(v post.Status) *PostCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PostCreate) SetNillableStatus(v *post.Status) *PostCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (_c *PostCreate) SetPublishAt(v time.Time) *PostCreate {
+	_c.mutation.SetPublishAt(v)
+	return _c
+}
+
+// SetNillablePublishAt sets the "publish_at" field if the given value is not nil.
+func (_c *PostCreate) SetNillablePublishAt(v *time.Time) *PostCreate {
+	if v != nil {
+		_c.SetPublishAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PostCreate) SetCreatedAt(v time.Time) *PostCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -59,6 +140,48 @@ func (_c *PostCreate) SetNillableUpdatedAt(v *time.Time) *PostCreate {
 	return _c
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (_c *PostCreate) SetHiddenAt(v time.Time) *PostCreate {
+	_c.mutation.SetHiddenAt(v)
+	return _c
+}
+
+// SetNillableHiddenAt sets the "hidden_at" field if the given value is not nil.
+func (_c *PostCreate) SetNillableHiddenAt(v *time.Time) *PostCreate {
+	if v != nil {
+		_c.SetHiddenAt(*v)
+	}
+	return _c
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (_c *PostCreate) SetSearchVector(v types.TSVector) *PostCreate {
+	_c.mutation.SetSearchVector(v)
+	return _c
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_c *PostCreate) SetNillableSearchVector(v *types.TSVector) *PostCreate {
+	if v != nil {
+		_c.SetSearchVector(*v)
+	}
+	return _c
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_c *PostCreate) SetContentHash(v string) *PostCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_c *PostCreate) SetNillableContentHash(v *string) *PostCreate {
+	if v != nil {
+		_c.SetContentHash(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PostCreate) SetID(v uuid.UUID) *PostCreate {
 	_c.mutation.SetID(v)
@@ -125,6 +248,160 @@ func (_c *PostCreate) AddReactions(v ...*Reaction) *PostCreate {
 	return _c.AddReactionIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_c *PostCreate) AddCommentIDs(ids ...uuid.UUID) *PostCreate {
+	_c.mutation.AddCommentIDs(ids...)
+	return _c
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_c *PostCreate) AddComments(v ...*Comment) *PostCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCommentIDs(ids...)
+}
+
+// AddRepostIDs adds the "reposts" edge to the Repost entity by IDs.
+func (_c *PostCreate) AddRepostIDs(ids ...uuid.UUID) *PostCreate {
+	_c.mutation.AddRepostIDs(ids...)
+	return _c
+}
+
+// AddReposts adds the "reposts" edges to the Repost entity.
+func (_c *PostCreate) AddReposts(v ...*Repost) *PostCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRepostIDs(ids...)
+}
+
+// SetQuoteOfID sets the "quote_of" edge to the Post entity by ID.
+func (_c *PostCreate) SetQuoteOfID(id uuid.UUID) *PostCreate {
+	_c.mutation.SetQuoteOfID(id)
+	return _c
+}
+
+// SetNillableQuoteOfID sets the "quote_of" edge to the Post entity by ID if the given value is not nil.
+func (_c *PostCreate) SetNillableQuoteOfID(id *uuid.UUID) *PostCreate {
+	if id != nil {
+		_c = _c.SetQuoteOfID(*id)
+	}
+	return _c
+}
+
+// SetQuoteOf sets the "quote_of" edge to the Post entity.
+func (_c *PostCreate) SetQuoteOf(v *Post) *PostCreate {
+	return _c.SetQuoteOfID(v.ID)
+}
+
+// AddQuoteIDs adds the "quotes" edge to the Post entity by IDs.
+func (_c *PostCreate) AddQuoteIDs(ids ...uuid.UUID) *PostCreate {
+	_c.mutation.AddQuoteIDs(ids...)
+	return _c
+}
+
+// AddQuotes adds the "quotes" edges to the Post entity.
+func (_c *PostCreate) AddQuotes(v ...*Post) *PostCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddQuoteIDs(ids...)
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
+func (_c *PostCreate) AddBookmarkIDs(ids ...uuid.UUID) *PostCreate {
+	_c.mutation.AddBookmarkIDs(ids...)
+	return _c
+}
+
+// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
+func (_c *PostCreate) AddBookmarks(v ...*Bookmark) *PostCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBookmarkIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the User entity by IDs.
+func (_c *PostCreate) AddMentionIDs(ids ...uuid.UUID) *PostCreate {
+	_c.mutation.AddMentionIDs(ids...)
+	return _c
+}
+
+// AddMentions adds the "mentions" edges to the User entity.
+func (_c *PostCreate) AddMentions(v ...*User) *PostCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMentionIDs(ids...)
+}
+
+// AddHashtagIDs adds the "hashtags" edge to the Hashtag entity by IDs.
+func (_c *PostCreate) AddHashtagIDs(ids ...uuid.UUID) *PostCreate {
+	_c.mutation.AddHashtagIDs(ids...)
+	return _c
+}
+
+// AddHashtags adds the "hashtags" edges to the Hashtag entity.
+func (_c *PostCreate) AddHashtags(v ...*Hashtag) *PostCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddHashtagIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (_c *PostCreate) AddRevisionIDs(ids ...uuid.UUID) *PostCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (_c *PostCreate) AddRevisions(v ...*PostRevision) *PostCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddTimelineEntryIDs adds the "timeline_entries" edge to the TimelineEntry entity by IDs.
+func (_c *PostCreate) AddTimelineEntryIDs(ids ...uuid.UUID) *PostCreate {
+	_c.mutation.AddTimelineEntryIDs(ids...)
+	return _c
+}
+
+// AddTimelineEntries adds the "timeline_entries" edges to the TimelineEntry entity.
+func (_c *PostCreate) AddTimelineEntries(v ...*TimelineEntry) *PostCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTimelineEntryIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_c *PostCreate) AddNotificationIDs(ids ...uuid.UUID) *PostCreate {
+	_c.mutation.AddNotificationIDs(ids...)
+	return _c
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_c *PostCreate) AddNotifications(v ...*Notification) *PostCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (_c *PostCreate) Mutation() *PostMutation {
 	return _c.mutation
@@ -132,7 +409,9 @@ func (_c *PostCreate) Mutation() *PostMutation {
 
 // Save creates the Post in the database.
 func (_c *PostCreate) Save(ctx context.Context) (*Post, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -159,19 +438,41 @@ func (_c *PostCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *PostCreate) defaults() {
+func (_c *PostCreate) defaults() error {
+	if _, ok := _c.mutation.Format(); !ok {
+		v := post.DefaultFormat
+		_c.mutation.SetFormat(v)
+	}
+	if _, ok := _c.mutation.EditCount(); !ok {
+		v := post.DefaultEditCount
+		_c.mutation.SetEditCount(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := post.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if post.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized post.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := post.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if post.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized post.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := post.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if post.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized post.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := post.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -184,6 +485,35 @@ func (_c *PostCreate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Post.content": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "Post.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := post.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Post.format": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Amount(); ok {
+		if err := post.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Post.amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EditCount(); !ok {
+		return &ValidationError{Name: "edit_count", err: errors.New(`ent: missing required field "Post.edit_count"`)}
+	}
+	if v, ok := _c.mutation.EditCount(); ok {
+		if err := post.EditCountValidator(v); err != nil {
+			return &ValidationError{Name: "edit_count", err: fmt.Errorf(`ent: validator failed for field "Post.edit_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Post.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := post.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Post.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Post.created_at"`)}
 	}
@@ -227,6 +557,7 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_node = &Post{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(post.Table, sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -235,6 +566,26 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(post.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(post.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = &value
+	}
+	if value, ok := _c.mutation.EditCount(); ok {
+		_spec.SetField(post.FieldEditCount, field.TypeInt, value)
+		_node.EditCount = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(post.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PublishAt(); ok {
+		_spec.SetField(post.FieldPublishAt, field.TypeTime, value)
+		_node.PublishAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -243,6 +594,18 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.HiddenAt(); ok {
+		_spec.SetField(post.FieldHiddenAt, field.TypeTime, value)
+		_node.HiddenAt = &value
+	}
+	if value, ok := _c.mutation.SearchVector(); ok {
+		_spec.SetField(post.FieldSearchVector, field.TypeOther, value)
+		_node.SearchVector = value
+	}
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(post.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -309,72 +672,747 @@ func (_c *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	return _node, _spec
-}
-
-// PostCreateBulk is the builder for creating many Post entities in bulk.
-type PostCreateBulk struct {
-	config
-	err      error
-	builders []*PostCreate
-}
-
-// Save creates the Post entities in the database.
-func (_c *PostCreateBulk) Save(ctx context.Context) ([]*Post, error) {
-	if _c.err != nil {
-		return nil, _c.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Post, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*PostMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
-	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
+	if nodes := _c.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.CommentsTable,
+			Columns: []string{post.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
 	}
-	return nodes, nil
-}
-
-// SaveX is like Save, but panics if an error occurs.
+	if nodes := _c.mutation.RepostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RepostsTable,
+			Columns: []string{post.RepostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repost.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.QuoteOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.QuoteOfTable,
+			Columns: []string{post.QuoteOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_quotes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.QuotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.QuotesTable,
+			Columns: []string{post.QuotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BookmarksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.BookmarksTable,
+			Columns: []string{post.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.MentionsTable,
+			Columns: post.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HashtagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.HashtagsTable,
+			Columns: post.HashtagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hashtag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TimelineEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.TimelineEntriesTable,
+			Columns: []string{post.TimelineEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.NotificationsTable,
+			Columns: []string{post.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Post.Create().
+//		SetContent(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostUpsert) {
+//			SetContent(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostCreate) OnConflict(opts ...sql.ConflictOption) *PostUpsertOne {
+	_c.conflict = opts
+	return &PostUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostCreate) OnConflictColumns(columns ...string) *PostUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PostUpsertOne is the builder for "upsert"-ing
+	//  one Post node.
+	PostUpsertOne struct {
+		create *PostCreate
+	}
+
+	// PostUpsert is the "OnConflict" setter.
+	PostUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetContent sets the "content" field.
+func (u *PostUpsert) SetContent(v string) *PostUpsert {
+	u.Set(post.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PostUpsert) UpdateContent() *PostUpsert {
+	u.SetExcluded(post.FieldContent)
+	return u
+}
+
+// SetFormat sets the "format" field.
+func (u *PostUpsert) SetFormat(v post.Format) *PostUpsert {
+	u.Set(post.FieldFormat, v)
+	return u
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *PostUpsert) UpdateFormat() *PostUpsert {
+	u.SetExcluded(post.FieldFormat)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *PostUpsert) SetAmount(v float64) *PostUpsert {
+	u.Set(post.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PostUpsert) UpdateAmount() *PostUpsert {
+	u.SetExcluded(post.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PostUpsert) AddAmount(v float64) *PostUpsert {
+	u.Add(post.FieldAmount, v)
+	return u
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *PostUpsert) ClearAmount() *PostUpsert {
+	u.SetNull(post.FieldAmount)
+	return u
+}
+
+// SetEditCount sets the "edit_count" field.
+func (u *PostUpsert) SetEditCount(v int) *PostUpsert {
+	u.Set(post.FieldEditCount, v)
+	return u
+}
+
+// UpdateEditCount sets the "edit_count" field to the value that was provided on create.
+func (u *PostUpsert) UpdateEditCount() *PostUpsert {
+	u.SetExcluded(post.FieldEditCount)
+	return u
+}
+
+// AddEditCount adds v to the "edit_count" field.
+func (u *PostUpsert) AddEditCount(v int) *PostUpsert {
+	u.Add(post.FieldEditCount, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PostUpsert) SetStatus(v post.Status) *PostUpsert {
+	u.Set(post.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PostUpsert) UpdateStatus() *PostUpsert {
+	u.SetExcluded(post.FieldStatus)
+	return u
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *PostUpsert) SetPublishAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldPublishAt, v)
+	return u
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdatePublishAt() *PostUpsert {
+	u.SetExcluded(post.FieldPublishAt)
+	return u
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (u *PostUpsert) ClearPublishAt() *PostUpsert {
+	u.SetNull(post.FieldPublishAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PostUpsert) SetCreatedAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateCreatedAt() *PostUpsert {
+	u.SetExcluded(post.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostUpsert) SetUpdatedAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateUpdatedAt() *PostUpsert {
+	u.SetExcluded(post.FieldUpdatedAt)
+	return u
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (u *PostUpsert) SetHiddenAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldHiddenAt, v)
+	return u
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateHiddenAt() *PostUpsert {
+	u.SetExcluded(post.FieldHiddenAt)
+	return u
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *PostUpsert) ClearHiddenAt() *PostUpsert {
+	u.SetNull(post.FieldHiddenAt)
+	return u
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (u *PostUpsert) SetSearchVector(v types.TSVector) *PostUpsert {
+	u.Set(post.FieldSearchVector, v)
+	return u
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *PostUpsert) UpdateSearchVector() *PostUpsert {
+	u.SetExcluded(post.FieldSearchVector)
+	return u
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *PostUpsert) ClearSearchVector() *PostUpsert {
+	u.SetNull(post.FieldSearchVector)
+	return u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *PostUpsert) SetContentHash(v string) *PostUpsert {
+	u.Set(post.FieldContentHash, v)
+	return u
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *PostUpsert) UpdateContentHash() *PostUpsert {
+	u.SetExcluded(post.FieldContentHash)
+	return u
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *PostUpsert) ClearContentHash() *PostUpsert {
+	u.SetNull(post.FieldContentHash)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(post.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostUpsertOne) UpdateNewValues() *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(post.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostUpsertOne) Ignore() *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostUpsertOne) DoNothing() *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostCreate.OnConflict
+// documentation for more info.
+func (u *PostUpsertOne) Update(set func(*PostUpsert)) *PostUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *PostUpsertOne) SetContent(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateContent() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *PostUpsertOne) SetFormat(v post.Format) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateFormat() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PostUpsertOne) SetAmount(v float64) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PostUpsertOne) AddAmount(v float64) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateAmount() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *PostUpsertOne) ClearAmount() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearAmount()
+	})
+}
+
+// SetEditCount sets the "edit_count" field.
+func (u *PostUpsertOne) SetEditCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetEditCount(v)
+	})
+}
+
+// AddEditCount adds v to the "edit_count" field.
+func (u *PostUpsertOne) AddEditCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddEditCount(v)
+	})
+}
+
+// UpdateEditCount sets the "edit_count" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateEditCount() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateEditCount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PostUpsertOne) SetStatus(v post.Status) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateStatus() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *PostUpsertOne) SetPublishAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetPublishAt(v)
+	})
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdatePublishAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdatePublishAt()
+	})
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (u *PostUpsertOne) ClearPublishAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearPublishAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PostUpsertOne) SetCreatedAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateCreatedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostUpsertOne) SetUpdatedAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateUpdatedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (u *PostUpsertOne) SetHiddenAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetHiddenAt(v)
+	})
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateHiddenAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateHiddenAt()
+	})
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *PostUpsertOne) ClearHiddenAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearHiddenAt()
+	})
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (u *PostUpsertOne) SetSearchVector(v types.TSVector) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetSearchVector(v)
+	})
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateSearchVector() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateSearchVector()
+	})
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *PostUpsertOne) ClearSearchVector() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearSearchVector()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *PostUpsertOne) SetContentHash(v string) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateContentHash() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *PostUpsertOne) ClearContentHash() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearContentHash()
+	})
+}
+
+// Exec executes the query.
+func (u *PostUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PostUpsertOne.ID is not supported by MySQL driver. Use PostUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PostCreateBulk is the builder for creating many Post entities in bulk.
+type PostCreateBulk struct {
+	config
+	err      error
+	builders []*PostCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Post entities in the database.
+func (_c *PostCreateBulk) Save(ctx context.Context) ([]*Post, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Post, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
 func (_c *PostCreateBulk) SaveX(ctx context.Context) []*Post {
 	v, err := _c.Save(ctx)
 	if err != nil {
@@ -395,3 +1433,320 @@ func (_c *PostCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Post.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostUpsert) {
+//			SetContent(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostUpsertBulk {
+	_c.conflict = opts
+	return &PostUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostCreateBulk) OnConflictColumns(columns ...string) *PostUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostUpsertBulk{
+		create: _c,
+	}
+}
+
+// PostUpsertBulk is the builder for "upsert"-ing
+// a bulk of Post nodes.
+type PostUpsertBulk struct {
+	create *PostCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(post.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostUpsertBulk) UpdateNewValues() *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(post.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Post.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostUpsertBulk) Ignore() *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostUpsertBulk) DoNothing() *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostUpsertBulk) Update(set func(*PostUpsert)) *PostUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *PostUpsertBulk) SetContent(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateContent() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *PostUpsertBulk) SetFormat(v post.Format) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateFormat() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *PostUpsertBulk) SetAmount(v float64) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *PostUpsertBulk) AddAmount(v float64) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateAmount() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *PostUpsertBulk) ClearAmount() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearAmount()
+	})
+}
+
+// SetEditCount sets the "edit_count" field.
+func (u *PostUpsertBulk) SetEditCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetEditCount(v)
+	})
+}
+
+// AddEditCount adds v to the "edit_count" field.
+func (u *PostUpsertBulk) AddEditCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddEditCount(v)
+	})
+}
+
+// UpdateEditCount sets the "edit_count" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateEditCount() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateEditCount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PostUpsertBulk) SetStatus(v post.Status) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateStatus() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *PostUpsertBulk) SetPublishAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetPublishAt(v)
+	})
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdatePublishAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdatePublishAt()
+	})
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (u *PostUpsertBulk) ClearPublishAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearPublishAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PostUpsertBulk) SetCreatedAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateCreatedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostUpsertBulk) SetUpdatedAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateUpdatedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (u *PostUpsertBulk) SetHiddenAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetHiddenAt(v)
+	})
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateHiddenAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateHiddenAt()
+	})
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *PostUpsertBulk) ClearHiddenAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearHiddenAt()
+	})
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (u *PostUpsertBulk) SetSearchVector(v types.TSVector) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetSearchVector(v)
+	})
+}
+
+// UpdateSearchVector sets the "search_vector" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateSearchVector() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateSearchVector()
+	})
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (u *PostUpsertBulk) ClearSearchVector() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearSearchVector()
+	})
+}
+
+// SetContentHash sets the "content_hash" field.
+func (u *PostUpsertBulk) SetContentHash(v string) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateContentHash() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *PostUpsertBulk) ClearContentHash() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearContentHash()
+	})
+}
+
+// Exec executes the query.
+func (u *PostUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,657 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/post"
+	"backend/ent/postrevision"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PostRevisionCreate is the builder for creating a PostRevision entity.
+type PostRevisionCreate struct {
+	config
+	mutation *PostRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRevision sets the "revision" field.
+func (_c *PostRevisionCreate) SetRevision(v int) *PostRevisionCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *PostRevisionCreate) SetContent(v string) *PostRevisionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *PostRevisionCreate) SetFormat(v postrevision.Format) *PostRevisionCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableFormat(v *postrevision.Format) *PostRevisionCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PostRevisionCreate) SetAmount(v float64) *PostRevisionCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableAmount(v *float64) *PostRevisionCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetImageIds sets the "image_ids" field.
+func (_c *PostRevisionCreate) SetImageIds(v []uuid.UUID) *PostRevisionCreate {
+	_c.mutation.SetImageIds(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PostRevisionCreate) SetCreatedAt(v time.Time) *PostRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetReplacedAt sets the "replaced_at" field.
+func (_c *PostRevisionCreate) SetReplacedAt(v time.Time) *PostRevisionCreate {
+	_c.mutation.SetReplacedAt(v)
+	return _c
+}
+
+// SetNillableReplacedAt sets the "replaced_at" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableReplacedAt(v *time.Time) *PostRevisionCreate {
+	if v != nil {
+		_c.SetReplacedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PostRevisionCreate) SetID(v uuid.UUID) *PostRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PostRevisionCreate) SetNillableID(v *uuid.UUID) *PostRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (_c *PostRevisionCreate) SetPostID(id uuid.UUID) *PostRevisionCreate {
+	_c.mutation.SetPostID(id)
+	return _c
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_c *PostRevisionCreate) SetPost(v *Post) *PostRevisionCreate {
+	return _c.SetPostID(v.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (_c *PostRevisionCreate) Mutation() *PostRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the PostRevision in the database.
+func (_c *PostRevisionCreate) Save(ctx context.Context) (*PostRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PostRevisionCreate) SaveX(ctx context.Context) *PostRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PostRevisionCreate) defaults() {
+	if _, ok := _c.mutation.Format(); !ok {
+		v := postrevision.DefaultFormat
+		_c.mutation.SetFormat(v)
+	}
+	if _, ok := _c.mutation.ReplacedAt(); !ok {
+		v := postrevision.DefaultReplacedAt()
+		_c.mutation.SetReplacedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := postrevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PostRevisionCreate) check() error {
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "PostRevision.revision"`)}
+	}
+	if v, ok := _c.mutation.Revision(); ok {
+		if err := postrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "PostRevision.revision": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "PostRevision.content"`)}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "PostRevision.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := postrevision.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "PostRevision.format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostRevision.created_at"`)}
+	}
+	if _, ok := _c.mutation.ReplacedAt(); !ok {
+		return &ValidationError{Name: "replaced_at", err: errors.New(`ent: missing required field "PostRevision.replaced_at"`)}
+	}
+	if len(_c.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "PostRevision.post"`)}
+	}
+	return nil
+}
+
+func (_c *PostRevisionCreate) sqlSave(ctx context.Context) (*PostRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PostRevisionCreate) createSpec() (*PostRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(postrevision.Table, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(postrevision.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(postrevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(postrevision.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(postrevision.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = &value
+	}
+	if value, ok := _c.mutation.ImageIds(); ok {
+		_spec.SetField(postrevision.FieldImageIds, field.TypeJSON, value)
+		_node.ImageIds = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ReplacedAt(); ok {
+		_spec.SetField(postrevision.FieldReplacedAt, field.TypeTime, value)
+		_node.ReplacedAt = value
+	}
+	if nodes := _c.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostRevision.Create().
+//		SetRevision(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostRevisionUpsert) {
+//			SetRevision(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostRevisionCreate) OnConflict(opts ...sql.ConflictOption) *PostRevisionUpsertOne {
+	_c.conflict = opts
+	return &PostRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostRevisionCreate) OnConflictColumns(columns ...string) *PostRevisionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PostRevisionUpsertOne is the builder for "upsert"-ing
+	//  one PostRevision node.
+	PostRevisionUpsertOne struct {
+		create *PostRevisionCreate
+	}
+
+	// PostRevisionUpsert is the "OnConflict" setter.
+	PostRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostRevisionUpsertOne) UpdateNewValues() *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(postrevision.FieldID)
+		}
+		if _, exists := u.create.mutation.Revision(); exists {
+			s.SetIgnore(postrevision.FieldRevision)
+		}
+		if _, exists := u.create.mutation.Content(); exists {
+			s.SetIgnore(postrevision.FieldContent)
+		}
+		if _, exists := u.create.mutation.Format(); exists {
+			s.SetIgnore(postrevision.FieldFormat)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(postrevision.FieldAmount)
+		}
+		if _, exists := u.create.mutation.ImageIds(); exists {
+			s.SetIgnore(postrevision.FieldImageIds)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(postrevision.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.ReplacedAt(); exists {
+			s.SetIgnore(postrevision.FieldReplacedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostRevisionUpsertOne) Ignore() *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostRevisionUpsertOne) DoNothing() *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostRevisionCreate.OnConflict
+// documentation for more info.
+func (u *PostRevisionUpsertOne) Update(set func(*PostRevisionUpsert)) *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PostRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostRevisionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PostRevisionUpsertOne.ID is not supported by MySQL driver. Use PostRevisionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostRevisionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PostRevisionCreateBulk is the builder for creating many PostRevision entities in bulk.
+type PostRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*PostRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PostRevision entities in the database.
+func (_c *PostRevisionCreateBulk) Save(ctx context.Context) ([]*PostRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PostRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PostRevisionCreateBulk) SaveX(ctx context.Context) []*PostRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostRevisionUpsert) {
+//			SetRevision(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostRevisionUpsertBulk {
+	_c.conflict = opts
+	return &PostRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostRevisionCreateBulk) OnConflictColumns(columns ...string) *PostRevisionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// PostRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PostRevision nodes.
+type PostRevisionUpsertBulk struct {
+	create *PostRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostRevisionUpsertBulk) UpdateNewValues() *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(postrevision.FieldID)
+			}
+			if _, exists := b.mutation.Revision(); exists {
+				s.SetIgnore(postrevision.FieldRevision)
+			}
+			if _, exists := b.mutation.Content(); exists {
+				s.SetIgnore(postrevision.FieldContent)
+			}
+			if _, exists := b.mutation.Format(); exists {
+				s.SetIgnore(postrevision.FieldFormat)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(postrevision.FieldAmount)
+			}
+			if _, exists := b.mutation.ImageIds(); exists {
+				s.SetIgnore(postrevision.FieldImageIds)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(postrevision.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.ReplacedAt(); exists {
+				s.SetIgnore(postrevision.FieldReplacedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostRevisionUpsertBulk) Ignore() *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostRevisionUpsertBulk) DoNothing() *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostRevisionUpsertBulk) Update(set func(*PostRevisionUpsert)) *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PostRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
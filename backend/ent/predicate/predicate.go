@@ -6,23 +6,74 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Bookmark is the predicate function for bookmark builders.
+type Bookmark func(*sql.Selector)
+
+// BookmarkCollection is the predicate function for bookmarkcollection builders.
+type BookmarkCollection func(*sql.Selector)
+
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
 // Genre is the predicate function for genre builders.
 type Genre func(*sql.Selector)
 
 // Goal is the predicate function for goal builders.
 type Goal func(*sql.Selector)
 
+// GoalParticipant is the predicate function for goalparticipant builders.
+type GoalParticipant func(*sql.Selector)
+
+// GoalTemplate is the predicate function for goaltemplate builders.
+type GoalTemplate func(*sql.Selector)
+
+// Hashtag is the predicate function for hashtag builders.
+type Hashtag func(*sql.Selector)
+
 // Image is the predicate function for image builders.
 type Image func(*sql.Selector)
 
+// LinkPreview is the predicate function for linkpreview builders.
+type LinkPreview func(*sql.Selector)
+
+// Milestone is the predicate function for milestone builders.
+type Milestone func(*sql.Selector)
+
+// ModerationAction is the predicate function for moderationaction builders.
+type ModerationAction func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// NotificationDelivery is the predicate function for notificationdelivery builders.
+type NotificationDelivery func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
+// PostRevision is the predicate function for postrevision builders.
+type PostRevision func(*sql.Selector)
+
 // Reaction is the predicate function for reaction builders.
 type Reaction func(*sql.Selector)
 
 // RefreshToken is the predicate function for refreshtoken builders.
 type RefreshToken func(*sql.Selector)
 
+// ReminderLog is the predicate function for reminderlog builders.
+type ReminderLog func(*sql.Selector)
+
+// Report is the predicate function for report builders.
+type Report func(*sql.Selector)
+
+// Repost is the predicate function for repost builders.
+type Repost func(*sql.Selector)
+
+// TimelineEntry is the predicate function for timelineentry builders.
+type TimelineEntry func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
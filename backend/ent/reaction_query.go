@@ -12,6 +12,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -28,6 +29,7 @@ type ReactionQuery struct {
 	withUser   *UserQuery
 	withPost   *PostQuery
 	withFKs    bool
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -303,8 +305,9 @@ func (_q *ReactionQuery) Clone() *ReactionQuery {
 		withUser:   _q.withUser.Clone(),
 		withPost:   _q.withPost.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -336,12 +339,12 @@ func (_q *ReactionQuery) WithPost(opts ...func(*PostQuery)) *ReactionQuery {
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Kind string `json:"kind,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Reaction.Query().
-//		GroupBy(reaction.FieldCreatedAt).
+//		GroupBy(reaction.FieldKind).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *ReactionQuery) GroupBy(field string, fields ...string) *ReactionGroupBy {
@@ -359,11 +362,11 @@ func (_q *ReactionQuery) GroupBy(field string, fields ...string) *ReactionGroupB
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Kind string `json:"kind,omitempty"`
 //	}
 //
 //	client.Reaction.Query().
-//		Select(reaction.FieldCreatedAt).
+//		Select(reaction.FieldKind).
 //		Scan(ctx, &v)
 func (_q *ReactionQuery) Select(fields ...string) *ReactionSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -429,6 +432,9 @@ func (_q *ReactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Rea
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -520,6 +526,9 @@ func (_q *ReactionQuery) loadPost(ctx context.Context, query *PostQuery, nodes [
 
 func (_q *ReactionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -582,6 +591,9 @@ func (_q *ReactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -599,6 +611,38 @@ func (_q *ReactionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *ReactionQuery) ForUpdate(opts ...sql.LockOption) *ReactionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *ReactionQuery) ForShare(opts ...sql.LockOption) *ReactionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *ReactionQuery) Modify(modifiers ...func(s *sql.Selector)) *ReactionSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // ReactionGroupBy is the group-by builder for Reaction entities.
 type ReactionGroupBy struct {
 	selector
@@ -688,3 +732,9 @@ func (_s *ReactionSelect) sqlScan(ctx context.Context, root *ReactionQuery, v an
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *ReactionSelect) Modify(modifiers ...func(s *sql.Selector)) *ReactionSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -20,8 +20,9 @@ import (
 // ReactionUpdate is the builder for updating Reaction entities.
 type ReactionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ReactionMutation
+	hooks     []Hook
+	mutation  *ReactionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ReactionUpdate builder.
@@ -107,6 +108,12 @@ func (_u *ReactionUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReactionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReactionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ReactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -177,6 +184,7 @@ func (_u *ReactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{reaction.Label}
@@ -192,9 +200,10 @@ func (_u *ReactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 // ReactionUpdateOne is the builder for updating a single Reaction entity.
 type ReactionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ReactionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ReactionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user" edge to the User entity by ID.
@@ -287,6 +296,12 @@ func (_u *ReactionUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReactionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReactionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *ReactionUpdateOne) sqlSave(ctx context.Context) (_node *Reaction, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -374,6 +389,7 @@ func (_u *ReactionUpdateOne) sqlSave(ctx context.Context) (_node *Reaction, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Reaction{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/goal"
+	"backend/ent/reminderlog"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ReminderLog is the model entity for the ReminderLog schema.
+type ReminderLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold reminderlog.Threshold `json:"threshold,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt time.Time `json:"sent_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReminderLogQuery when eager-loading is set.
+	Edges              ReminderLogEdges `json:"edges"`
+	goal_reminder_logs *uuid.UUID
+	selectValues       sql.SelectValues
+}
+
+// ReminderLogEdges holds the relations/edges for other nodes in the graph.
+type ReminderLogEdges struct {
+	// Goal holds the value of the goal edge.
+	Goal *Goal `json:"goal,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GoalOrErr returns the Goal value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReminderLogEdges) GoalOrErr() (*Goal, error) {
+	if e.Goal != nil {
+		return e.Goal, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: goal.Label}
+	}
+	return nil, &NotLoadedError{edge: "goal"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReminderLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reminderlog.FieldThreshold:
+			values[i] = new(sql.NullString)
+		case reminderlog.FieldSentAt:
+			values[i] = new(sql.NullTime)
+		case reminderlog.FieldID:
+			values[i] = new(uuid.UUID)
+		case reminderlog.ForeignKeys[0]: // goal_reminder_logs
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReminderLog fields.
+func (_m *ReminderLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reminderlog.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case reminderlog.FieldThreshold:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value.Valid {
+				_m.Threshold = reminderlog.Threshold(value.String)
+			}
+		case reminderlog.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = value.Time
+			}
+		case reminderlog.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field goal_reminder_logs", values[i])
+			} else if value.Valid {
+				_m.goal_reminder_logs = new(uuid.UUID)
+				*_m.goal_reminder_logs = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReminderLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReminderLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryGoal queries the "goal" edge of the ReminderLog entity.
+func (_m *ReminderLog) QueryGoal() *GoalQuery {
+	return NewReminderLogClient(_m.config).QueryGoal(_m)
+}
+
+// Update returns a builder for updating this ReminderLog.
+// Note that you need to call ReminderLog.Unwrap() before calling this method if this ReminderLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReminderLog) Update() *ReminderLogUpdateOne {
+	return NewReminderLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReminderLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReminderLog) Unwrap() *ReminderLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReminderLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReminderLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReminderLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Threshold))
+	builder.WriteString(", ")
+	builder.WriteString("sent_at=")
+	builder.WriteString(_m.SentAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReminderLogs is a parsable slice of ReminderLog.
+type ReminderLogs []*ReminderLog
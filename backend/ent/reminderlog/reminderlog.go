@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reminderlog
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the reminderlog type in the database.
+	Label = "reminder_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldThreshold holds the string denoting the threshold field in the database.
+	FieldThreshold = "threshold"
+	// FieldSentAt holds the string denoting the sent_at field in the database.
+	FieldSentAt = "sent_at"
+	// EdgeGoal holds the string denoting the goal edge name in mutations.
+	EdgeGoal = "goal"
+	// Table holds the table name of the reminderlog in the database.
+	Table = "reminder_logs"
+	// GoalTable is the table that holds the goal relation/edge.
+	GoalTable = "reminder_logs"
+	// GoalInverseTable is the table name for the Goal entity.
+	// It exists in this package in order to avoid circular dependency with the "goal" package.
+	GoalInverseTable = "goals"
+	// GoalColumn is the table column denoting the goal relation/edge.
+	GoalColumn = "goal_reminder_logs"
+)
+
+// Columns holds all SQL columns for reminderlog fields.
+var Columns = []string{
+	FieldID,
+	FieldThreshold,
+	FieldSentAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "reminder_logs"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"goal_reminder_logs",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultSentAt holds the default value on creation for the "sent_at" field.
+	DefaultSentAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Threshold defines the type for the "threshold" enum field.
+type Threshold string
+
+// Threshold values.
+const (
+	ThresholdWeek    Threshold = "week"
+	ThresholdDay     Threshold = "day"
+	ThresholdToday   Threshold = "today"
+	ThresholdOverdue Threshold = "overdue"
+)
+
+func (t Threshold) String() string {
+	return string(t)
+}
+
+// ThresholdValidator is a validator for the "threshold" field enum values. It is called by the builders before save.
+func ThresholdValidator(t Threshold) error {
+	switch t {
+	case ThresholdWeek, ThresholdDay, ThresholdToday, ThresholdOverdue:
+		return nil
+	default:
+		return fmt.Errorf("reminderlog: invalid enum value for threshold field: %q", t)
+	}
+}
+
+// OrderOption defines the ordering options for the ReminderLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByThreshold orders the results by the threshold field.
+func ByThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreshold, opts...).ToFunc()
+}
+
+// BySentAt orders the results by the sent_at field.
+func BySentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
+}
+
+// ByGoalField orders the results by goal field.
+func ByGoalField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGoalStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newGoalStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GoalInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GoalTable, GoalColumn),
+	)
+}
@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/goal"
+	"backend/ent/predicate"
+	"backend/ent/reminderlog"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReminderLogUpdate is the builder for updating ReminderLog entities.
+type ReminderLogUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReminderLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReminderLogUpdate builder.
+func (_u *ReminderLogUpdate) Where(ps ...predicate.ReminderLog) *ReminderLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by ID.
+func (_u *ReminderLogUpdate) SetGoalID(id uuid.UUID) *ReminderLogUpdate {
+	_u.mutation.SetGoalID(id)
+	return _u
+}
+
+// SetGoal sets the "goal" edge to the Goal entity.
+func (_u *ReminderLogUpdate) SetGoal(v *Goal) *ReminderLogUpdate {
+	return _u.SetGoalID(v.ID)
+}
+
+// Mutation returns the ReminderLogMutation object of the builder.
+func (_u *ReminderLogUpdate) Mutation() *ReminderLogMutation {
+	return _u.mutation
+}
+
+// ClearGoal clears the "goal" edge to the Goal entity.
+func (_u *ReminderLogUpdate) ClearGoal() *ReminderLogUpdate {
+	_u.mutation.ClearGoal()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReminderLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReminderLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReminderLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReminderLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReminderLogUpdate) check() error {
+	if _u.mutation.GoalCleared() && len(_u.mutation.GoalIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReminderLog.goal"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReminderLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReminderLogUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReminderLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminderlog.Table, reminderlog.Columns, sqlgraph.NewFieldSpec(reminderlog.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.GoalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderlog.GoalTable,
+			Columns: []string{reminderlog.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderlog.GoalTable,
+			Columns: []string{reminderlog.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminderlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReminderLogUpdateOne is the builder for updating a single ReminderLog entity.
+type ReminderLogUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReminderLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetGoalID sets the "goal" edge to the Goal entity by ID.
+func (_u *ReminderLogUpdateOne) SetGoalID(id uuid.UUID) *ReminderLogUpdateOne {
+	_u.mutation.SetGoalID(id)
+	return _u
+}
+
+// SetGoal sets the "goal" edge to the Goal entity.
+func (_u *ReminderLogUpdateOne) SetGoal(v *Goal) *ReminderLogUpdateOne {
+	return _u.SetGoalID(v.ID)
+}
+
+// Mutation returns the ReminderLogMutation object of the builder.
+func (_u *ReminderLogUpdateOne) Mutation() *ReminderLogMutation {
+	return _u.mutation
+}
+
+// ClearGoal clears the "goal" edge to the Goal entity.
+func (_u *ReminderLogUpdateOne) ClearGoal() *ReminderLogUpdateOne {
+	_u.mutation.ClearGoal()
+	return _u
+}
+
+// Where appends a list predicates to the ReminderLogUpdate builder.
+func (_u *ReminderLogUpdateOne) Where(ps ...predicate.ReminderLog) *ReminderLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReminderLogUpdateOne) Select(field string, fields ...string) *ReminderLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReminderLog entity.
+func (_u *ReminderLogUpdateOne) Save(ctx context.Context) (*ReminderLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReminderLogUpdateOne) SaveX(ctx context.Context) *ReminderLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReminderLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReminderLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReminderLogUpdateOne) check() error {
+	if _u.mutation.GoalCleared() && len(_u.mutation.GoalIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReminderLog.goal"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ReminderLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReminderLogUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ReminderLogUpdateOne) sqlSave(ctx context.Context) (_node *ReminderLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminderlog.Table, reminderlog.Columns, sqlgraph.NewFieldSpec(reminderlog.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReminderLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reminderlog.FieldID)
+		for _, f := range fields {
+			if !reminderlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reminderlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.GoalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderlog.GoalTable,
+			Columns: []string{reminderlog.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderlog.GoalTable,
+			Columns: []string{reminderlog.GoalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ReminderLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminderlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
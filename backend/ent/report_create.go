@@ -0,0 +1,1116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/moderationaction"
+	"backend/ent/report"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReportCreate is the builder for creating a Report entity.
+type ReportCreate struct {
+	config
+	mutation *ReportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTargetType sets the "target_type" field.
+func (_c *ReportCreate) SetTargetType(v report.TargetType) *ReportCreate {
+	_c.mutation.SetTargetType(v)
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *ReportCreate) SetTargetID(v uuid.UUID) *ReportCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ReportCreate) SetSource(v report.Source) *ReportCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *ReportCreate) SetNillableSource(v *report.Source) *ReportCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ReportCreate) SetReason(v report.Reason) *ReportCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *ReportCreate) SetText(v string) *ReportCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_c *ReportCreate) SetNillableText(v *string) *ReportCreate {
+	if v != nil {
+		_c.SetText(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReportCreate) SetStatus(v report.Status) *ReportCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReportCreate) SetNillableStatus(v *report.Status) *ReportCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetResolution sets the "resolution" field.
+func (_c *ReportCreate) SetResolution(v report.Resolution) *ReportCreate {
+	_c.mutation.SetResolution(v)
+	return _c
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_c *ReportCreate) SetNillableResolution(v *report.Resolution) *ReportCreate {
+	if v != nil {
+		_c.SetResolution(*v)
+	}
+	return _c
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (_c *ReportCreate) SetResolutionNote(v string) *ReportCreate {
+	_c.mutation.SetResolutionNote(v)
+	return _c
+}
+
+// SetNillableResolutionNote sets the "resolution_note" field if the given value is not nil.
+func (_c *ReportCreate) SetNillableResolutionNote(v *string) *ReportCreate {
+	if v != nil {
+		_c.SetResolutionNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReportCreate) SetCreatedAt(v time.Time) *ReportCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReportCreate) SetNillableCreatedAt(v *time.Time) *ReportCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_c *ReportCreate) SetClaimedAt(v time.Time) *ReportCreate {
+	_c.mutation.SetClaimedAt(v)
+	return _c
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_c *ReportCreate) SetNillableClaimedAt(v *time.Time) *ReportCreate {
+	if v != nil {
+		_c.SetClaimedAt(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *ReportCreate) SetResolvedAt(v time.Time) *ReportCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *ReportCreate) SetNillableResolvedAt(v *time.Time) *ReportCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReportCreate) SetID(v uuid.UUID) *ReportCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReportCreate) SetNillableID(v *uuid.UUID) *ReportCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetReporterID sets the "reporter" edge to the User entity by ID.
+func (_c *ReportCreate) SetReporterID(id uuid.UUID) *ReportCreate {
+	_c.mutation.SetReporterID(id)
+	return _c
+}
+
+// SetNillableReporterID sets the "reporter" edge to the User entity by ID if the given value is not nil.
+func (_c *ReportCreate) SetNillableReporterID(id *uuid.UUID) *ReportCreate {
+	if id != nil {
+		_c = _c.SetReporterID(*id)
+	}
+	return _c
+}
+
+// SetReporter sets the "reporter" edge to the User entity.
+func (_c *ReportCreate) SetReporter(v *User) *ReportCreate {
+	return _c.SetReporterID(v.ID)
+}
+
+// SetModeratorID sets the "moderator" edge to the User entity by ID.
+func (_c *ReportCreate) SetModeratorID(id uuid.UUID) *ReportCreate {
+	_c.mutation.SetModeratorID(id)
+	return _c
+}
+
+// SetNillableModeratorID sets the "moderator" edge to the User entity by ID if the given value is not nil.
+func (_c *ReportCreate) SetNillableModeratorID(id *uuid.UUID) *ReportCreate {
+	if id != nil {
+		_c = _c.SetModeratorID(*id)
+	}
+	return _c
+}
+
+// SetModerator sets the "moderator" edge to the User entity.
+func (_c *ReportCreate) SetModerator(v *User) *ReportCreate {
+	return _c.SetModeratorID(v.ID)
+}
+
+// AddActionIDs adds the "actions" edge to the ModerationAction entity by IDs.
+func (_c *ReportCreate) AddActionIDs(ids ...uuid.UUID) *ReportCreate {
+	_c.mutation.AddActionIDs(ids...)
+	return _c
+}
+
+// AddActions adds the "actions" edges to the ModerationAction entity.
+func (_c *ReportCreate) AddActions(v ...*ModerationAction) *ReportCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddActionIDs(ids...)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (_c *ReportCreate) Mutation() *ReportMutation {
+	return _c.mutation
+}
+
+// Save creates the Report in the database.
+func (_c *ReportCreate) Save(ctx context.Context) (*Report, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReportCreate) SaveX(ctx context.Context) *Report {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReportCreate) defaults() {
+	if _, ok := _c.mutation.Source(); !ok {
+		v := report.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := report.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := report.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := report.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReportCreate) check() error {
+	if _, ok := _c.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "Report.target_type"`)}
+	}
+	if v, ok := _c.mutation.TargetType(); ok {
+		if err := report.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "Report.target_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "Report.target_id"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Report.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := report.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Report.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Report.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := report.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Report.reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Text(); ok {
+		if err := report.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Report.text": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Report.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Resolution(); ok {
+		if err := report.ResolutionValidator(v); err != nil {
+			return &ValidationError{Name: "resolution", err: fmt.Errorf(`ent: validator failed for field "Report.resolution": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ResolutionNote(); ok {
+		if err := report.ResolutionNoteValidator(v); err != nil {
+			return &ValidationError{Name: "resolution_note", err: fmt.Errorf(`ent: validator failed for field "Report.resolution_note": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Report.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReportCreate) sqlSave(ctx context.Context) (*Report, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReportCreate) createSpec() (*Report, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Report{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(report.Table, sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TargetType(); ok {
+		_spec.SetField(report.FieldTargetType, field.TypeEnum, value)
+		_node.TargetType = value
+	}
+	if value, ok := _c.mutation.TargetID(); ok {
+		_spec.SetField(report.FieldTargetID, field.TypeUUID, value)
+		_node.TargetID = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(report.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(report.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(report.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Resolution(); ok {
+		_spec.SetField(report.FieldResolution, field.TypeEnum, value)
+		_node.Resolution = &value
+	}
+	if value, ok := _c.mutation.ResolutionNote(); ok {
+		_spec.SetField(report.FieldResolutionNote, field.TypeString, value)
+		_node.ResolutionNote = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(report.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ClaimedAt(); ok {
+		_spec.SetField(report.FieldClaimedAt, field.TypeTime, value)
+		_node.ClaimedAt = &value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(report.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if nodes := _c.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ReporterTable,
+			Columns: []string{report.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_reports = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ModeratorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ModeratorTable,
+			Columns: []string{report.ModeratorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_claimed_reports = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   report.ActionsTable,
+			Columns: []string{report.ActionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Report.Create().
+//		SetTargetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReportCreate) OnConflict(opts ...sql.ConflictOption) *ReportUpsertOne {
+	_c.conflict = opts
+	return &ReportUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReportCreate) OnConflictColumns(columns ...string) *ReportUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReportUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReportUpsertOne is the builder for "upsert"-ing
+	//  one Report node.
+	ReportUpsertOne struct {
+		create *ReportCreate
+	}
+
+	// ReportUpsert is the "OnConflict" setter.
+	ReportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsert) SetStatus(v report.Status) *ReportUpsert {
+	u.Set(report.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateStatus() *ReportUpsert {
+	u.SetExcluded(report.FieldStatus)
+	return u
+}
+
+// SetResolution sets the "resolution" field.
+func (u *ReportUpsert) SetResolution(v report.Resolution) *ReportUpsert {
+	u.Set(report.FieldResolution, v)
+	return u
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateResolution() *ReportUpsert {
+	u.SetExcluded(report.FieldResolution)
+	return u
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (u *ReportUpsert) ClearResolution() *ReportUpsert {
+	u.SetNull(report.FieldResolution)
+	return u
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (u *ReportUpsert) SetResolutionNote(v string) *ReportUpsert {
+	u.Set(report.FieldResolutionNote, v)
+	return u
+}
+
+// UpdateResolutionNote sets the "resolution_note" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateResolutionNote() *ReportUpsert {
+	u.SetExcluded(report.FieldResolutionNote)
+	return u
+}
+
+// ClearResolutionNote clears the value of the "resolution_note" field.
+func (u *ReportUpsert) ClearResolutionNote() *ReportUpsert {
+	u.SetNull(report.FieldResolutionNote)
+	return u
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (u *ReportUpsert) SetClaimedAt(v time.Time) *ReportUpsert {
+	u.Set(report.FieldClaimedAt, v)
+	return u
+}
+
+// UpdateClaimedAt sets the "claimed_at" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateClaimedAt() *ReportUpsert {
+	u.SetExcluded(report.FieldClaimedAt)
+	return u
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (u *ReportUpsert) ClearClaimedAt() *ReportUpsert {
+	u.SetNull(report.FieldClaimedAt)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *ReportUpsert) SetResolvedAt(v time.Time) *ReportUpsert {
+	u.Set(report.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateResolvedAt() *ReportUpsert {
+	u.SetExcluded(report.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *ReportUpsert) ClearResolvedAt() *ReportUpsert {
+	u.SetNull(report.FieldResolvedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(report.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportUpsertOne) UpdateNewValues() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(report.FieldID)
+		}
+		if _, exists := u.create.mutation.TargetType(); exists {
+			s.SetIgnore(report.FieldTargetType)
+		}
+		if _, exists := u.create.mutation.TargetID(); exists {
+			s.SetIgnore(report.FieldTargetID)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(report.FieldSource)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(report.FieldReason)
+		}
+		if _, exists := u.create.mutation.Text(); exists {
+			s.SetIgnore(report.FieldText)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(report.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReportUpsertOne) Ignore() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportUpsertOne) DoNothing() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportCreate.OnConflict
+// documentation for more info.
+func (u *ReportUpsertOne) Update(set func(*ReportUpsert)) *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsertOne) SetStatus(v report.Status) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateStatus() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResolution sets the "resolution" field.
+func (u *ReportUpsertOne) SetResolution(v report.Resolution) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolution(v)
+	})
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateResolution() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolution()
+	})
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (u *ReportUpsertOne) ClearResolution() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearResolution()
+	})
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (u *ReportUpsertOne) SetResolutionNote(v string) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolutionNote(v)
+	})
+}
+
+// UpdateResolutionNote sets the "resolution_note" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateResolutionNote() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolutionNote()
+	})
+}
+
+// ClearResolutionNote clears the value of the "resolution_note" field.
+func (u *ReportUpsertOne) ClearResolutionNote() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearResolutionNote()
+	})
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (u *ReportUpsertOne) SetClaimedAt(v time.Time) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetClaimedAt(v)
+	})
+}
+
+// UpdateClaimedAt sets the "claimed_at" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateClaimedAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateClaimedAt()
+	})
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (u *ReportUpsertOne) ClearClaimedAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearClaimedAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *ReportUpsertOne) SetResolvedAt(v time.Time) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateResolvedAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *ReportUpsertOne) ClearResolvedAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReportUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReportUpsertOne.ID is not supported by MySQL driver. Use ReportUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReportUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReportCreateBulk is the builder for creating many Report entities in bulk.
+type ReportCreateBulk struct {
+	config
+	err      error
+	builders []*ReportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Report entities in the database.
+func (_c *ReportCreateBulk) Save(ctx context.Context) ([]*Report, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Report, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReportCreateBulk) SaveX(ctx context.Context) []*Report {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Report.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReportCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReportUpsertBulk {
+	_c.conflict = opts
+	return &ReportUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReportCreateBulk) OnConflictColumns(columns ...string) *ReportUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReportUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReportUpsertBulk is the builder for "upsert"-ing
+// a bulk of Report nodes.
+type ReportUpsertBulk struct {
+	create *ReportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(report.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportUpsertBulk) UpdateNewValues() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(report.FieldID)
+			}
+			if _, exists := b.mutation.TargetType(); exists {
+				s.SetIgnore(report.FieldTargetType)
+			}
+			if _, exists := b.mutation.TargetID(); exists {
+				s.SetIgnore(report.FieldTargetID)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(report.FieldSource)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(report.FieldReason)
+			}
+			if _, exists := b.mutation.Text(); exists {
+				s.SetIgnore(report.FieldText)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(report.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReportUpsertBulk) Ignore() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportUpsertBulk) DoNothing() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReportUpsertBulk) Update(set func(*ReportUpsert)) *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsertBulk) SetStatus(v report.Status) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateStatus() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResolution sets the "resolution" field.
+func (u *ReportUpsertBulk) SetResolution(v report.Resolution) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolution(v)
+	})
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateResolution() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolution()
+	})
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (u *ReportUpsertBulk) ClearResolution() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearResolution()
+	})
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (u *ReportUpsertBulk) SetResolutionNote(v string) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolutionNote(v)
+	})
+}
+
+// UpdateResolutionNote sets the "resolution_note" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateResolutionNote() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolutionNote()
+	})
+}
+
+// ClearResolutionNote clears the value of the "resolution_note" field.
+func (u *ReportUpsertBulk) ClearResolutionNote() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearResolutionNote()
+	})
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (u *ReportUpsertBulk) SetClaimedAt(v time.Time) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetClaimedAt(v)
+	})
+}
+
+// UpdateClaimedAt sets the "claimed_at" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateClaimedAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateClaimedAt()
+	})
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (u *ReportUpsertBulk) ClearClaimedAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearClaimedAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *ReportUpsertBulk) SetResolvedAt(v time.Time) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateResolvedAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *ReportUpsertBulk) ClearResolvedAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/post"
+	"backend/ent/repost"
+	"backend/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Repost is the model entity for the Repost schema.
+type Repost struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RepostQuery when eager-loading is set.
+	Edges        RepostEdges `json:"edges"`
+	post_reposts *uuid.UUID
+	user_reposts *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// RepostEdges holds the relations/edges for other nodes in the graph.
+type RepostEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// TimelineEntries holds the value of the timeline_entries edge.
+	TimelineEntries []*TimelineEntry `json:"timeline_entries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RepostEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RepostEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// TimelineEntriesOrErr returns the TimelineEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e RepostEdges) TimelineEntriesOrErr() ([]*TimelineEntry, error) {
+	if e.loadedTypes[2] {
+		return e.TimelineEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "timeline_entries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Repost) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case repost.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case repost.FieldID:
+			values[i] = new(uuid.UUID)
+		case repost.ForeignKeys[0]: // post_reposts
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case repost.ForeignKeys[1]: // user_reposts
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Repost fields.
+func (_m *Repost) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case repost.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case repost.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case repost.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field post_reposts", values[i])
+			} else if value.Valid {
+				_m.post_reposts = new(uuid.UUID)
+				*_m.post_reposts = *value.S.(*uuid.UUID)
+			}
+		case repost.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_reposts", values[i])
+			} else if value.Valid {
+				_m.user_reposts = new(uuid.UUID)
+				*_m.user_reposts = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Repost.
+// This includes values selected through modifiers, order, etc.
+func (_m *Repost) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Repost entity.
+func (_m *Repost) QueryUser() *UserQuery {
+	return NewRepostClient(_m.config).QueryUser(_m)
+}
+
+// QueryPost queries the "post" edge of the Repost entity.
+func (_m *Repost) QueryPost() *PostQuery {
+	return NewRepostClient(_m.config).QueryPost(_m)
+}
+
+// QueryTimelineEntries queries the "timeline_entries" edge of the Repost entity.
+func (_m *Repost) QueryTimelineEntries() *TimelineEntryQuery {
+	return NewRepostClient(_m.config).QueryTimelineEntries(_m)
+}
+
+// Update returns a builder for updating this Repost.
+// Note that you need to call Repost.Unwrap() before calling this method if this Repost
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Repost) Update() *RepostUpdateOne {
+	return NewRepostClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Repost entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Repost) Unwrap() *Repost {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Repost is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Repost) String() string {
+	var builder strings.Builder
+	builder.WriteString("Repost(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reposts is a parsable slice of Repost.
+type Reposts []*Repost
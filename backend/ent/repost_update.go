@@ -0,0 +1,569 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/post"
+	"backend/ent/predicate"
+	"backend/ent/repost"
+	"backend/ent/timelineentry"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RepostUpdate is the builder for updating Repost entities.
+type RepostUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RepostMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RepostUpdate builder.
+func (_u *RepostUpdate) Where(ps ...predicate.Repost) *RepostUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *RepostUpdate) SetUserID(id uuid.UUID) *RepostUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *RepostUpdate) SetUser(v *User) *RepostUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (_u *RepostUpdate) SetPostID(id uuid.UUID) *RepostUpdate {
+	_u.mutation.SetPostID(id)
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *RepostUpdate) SetPost(v *Post) *RepostUpdate {
+	return _u.SetPostID(v.ID)
+}
+
+// AddTimelineEntryIDs adds the "timeline_entries" edge to the TimelineEntry entity by IDs.
+func (_u *RepostUpdate) AddTimelineEntryIDs(ids ...uuid.UUID) *RepostUpdate {
+	_u.mutation.AddTimelineEntryIDs(ids...)
+	return _u
+}
+
+// AddTimelineEntries adds the "timeline_entries" edges to the TimelineEntry entity.
+func (_u *RepostUpdate) AddTimelineEntries(v ...*TimelineEntry) *RepostUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTimelineEntryIDs(ids...)
+}
+
+// Mutation returns the RepostMutation object of the builder.
+func (_u *RepostUpdate) Mutation() *RepostMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *RepostUpdate) ClearUser() *RepostUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *RepostUpdate) ClearPost() *RepostUpdate {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// ClearTimelineEntries clears all "timeline_entries" edges to the TimelineEntry entity.
+func (_u *RepostUpdate) ClearTimelineEntries() *RepostUpdate {
+	_u.mutation.ClearTimelineEntries()
+	return _u
+}
+
+// RemoveTimelineEntryIDs removes the "timeline_entries" edge to TimelineEntry entities by IDs.
+func (_u *RepostUpdate) RemoveTimelineEntryIDs(ids ...uuid.UUID) *RepostUpdate {
+	_u.mutation.RemoveTimelineEntryIDs(ids...)
+	return _u
+}
+
+// RemoveTimelineEntries removes "timeline_entries" edges to TimelineEntry entities.
+func (_u *RepostUpdate) RemoveTimelineEntries(v ...*TimelineEntry) *RepostUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTimelineEntryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RepostUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RepostUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RepostUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RepostUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RepostUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Repost.user"`)
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Repost.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RepostUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RepostUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RepostUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(repost.Table, repost.Columns, sqlgraph.NewFieldSpec(repost.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repost.UserTable,
+			Columns: []string{repost.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repost.UserTable,
+			Columns: []string{repost.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repost.PostTable,
+			Columns: []string{repost.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repost.PostTable,
+			Columns: []string{repost.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TimelineEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repost.TimelineEntriesTable,
+			Columns: []string{repost.TimelineEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTimelineEntriesIDs(); len(nodes) > 0 && !_u.mutation.TimelineEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repost.TimelineEntriesTable,
+			Columns: []string{repost.TimelineEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TimelineEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repost.TimelineEntriesTable,
+			Columns: []string{repost.TimelineEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{repost.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RepostUpdateOne is the builder for updating a single Repost entity.
+type RepostUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RepostMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *RepostUpdateOne) SetUserID(id uuid.UUID) *RepostUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *RepostUpdateOne) SetUser(v *User) *RepostUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (_u *RepostUpdateOne) SetPostID(id uuid.UUID) *RepostUpdateOne {
+	_u.mutation.SetPostID(id)
+	return _u
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_u *RepostUpdateOne) SetPost(v *Post) *RepostUpdateOne {
+	return _u.SetPostID(v.ID)
+}
+
+// AddTimelineEntryIDs adds the "timeline_entries" edge to the TimelineEntry entity by IDs.
+func (_u *RepostUpdateOne) AddTimelineEntryIDs(ids ...uuid.UUID) *RepostUpdateOne {
+	_u.mutation.AddTimelineEntryIDs(ids...)
+	return _u
+}
+
+// AddTimelineEntries adds the "timeline_entries" edges to the TimelineEntry entity.
+func (_u *RepostUpdateOne) AddTimelineEntries(v ...*TimelineEntry) *RepostUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTimelineEntryIDs(ids...)
+}
+
+// Mutation returns the RepostMutation object of the builder.
+func (_u *RepostUpdateOne) Mutation() *RepostMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *RepostUpdateOne) ClearUser() *RepostUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (_u *RepostUpdateOne) ClearPost() *RepostUpdateOne {
+	_u.mutation.ClearPost()
+	return _u
+}
+
+// ClearTimelineEntries clears all "timeline_entries" edges to the TimelineEntry entity.
+func (_u *RepostUpdateOne) ClearTimelineEntries() *RepostUpdateOne {
+	_u.mutation.ClearTimelineEntries()
+	return _u
+}
+
+// RemoveTimelineEntryIDs removes the "timeline_entries" edge to TimelineEntry entities by IDs.
+func (_u *RepostUpdateOne) RemoveTimelineEntryIDs(ids ...uuid.UUID) *RepostUpdateOne {
+	_u.mutation.RemoveTimelineEntryIDs(ids...)
+	return _u
+}
+
+// RemoveTimelineEntries removes "timeline_entries" edges to TimelineEntry entities.
+func (_u *RepostUpdateOne) RemoveTimelineEntries(v ...*TimelineEntry) *RepostUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTimelineEntryIDs(ids...)
+}
+
+// Where appends a list predicates to the RepostUpdate builder.
+func (_u *RepostUpdateOne) Where(ps ...predicate.Repost) *RepostUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RepostUpdateOne) Select(field string, fields ...string) *RepostUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Repost entity.
+func (_u *RepostUpdateOne) Save(ctx context.Context) (*Repost, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RepostUpdateOne) SaveX(ctx context.Context) *Repost {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RepostUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RepostUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RepostUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Repost.user"`)
+	}
+	if _u.mutation.PostCleared() && len(_u.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Repost.post"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RepostUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RepostUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RepostUpdateOne) sqlSave(ctx context.Context) (_node *Repost, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(repost.Table, repost.Columns, sqlgraph.NewFieldSpec(repost.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Repost.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, repost.FieldID)
+		for _, f := range fields {
+			if !repost.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != repost.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repost.UserTable,
+			Columns: []string{repost.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repost.UserTable,
+			Columns: []string{repost.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repost.PostTable,
+			Columns: []string{repost.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   repost.PostTable,
+			Columns: []string{repost.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TimelineEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repost.TimelineEntriesTable,
+			Columns: []string{repost.TimelineEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTimelineEntriesIDs(); len(nodes) > 0 && !_u.mutation.TimelineEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repost.TimelineEntriesTable,
+			Columns: []string{repost.TimelineEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TimelineEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   repost.TimelineEntriesTable,
+			Columns: []string{repost.TimelineEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Repost{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{repost.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -2,160 +2,4 @@
 
 package ent
 
-import (
-	"backend/ent/genre"
-	"backend/ent/goal"
-	"backend/ent/image"
-	"backend/ent/post"
-	"backend/ent/reaction"
-	"backend/ent/refreshtoken"
-	"backend/ent/schema"
-	"backend/ent/user"
-	"time"
-
-	"github.com/google/uuid"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	genreFields := schema.Genre{}.Fields()
-	_ = genreFields
-	// genreDescName is the schema descriptor for name field.
-	genreDescName := genreFields[1].Descriptor()
-	// genre.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	genre.NameValidator = genreDescName.Validators[0].(func(string) error)
-	// genreDescCreatedAt is the schema descriptor for created_at field.
-	genreDescCreatedAt := genreFields[2].Descriptor()
-	// genre.DefaultCreatedAt holds the default value on creation for the created_at field.
-	genre.DefaultCreatedAt = genreDescCreatedAt.Default.(func() time.Time)
-	// genreDescID is the schema descriptor for id field.
-	genreDescID := genreFields[0].Descriptor()
-	// genre.DefaultID holds the default value on creation for the id field.
-	genre.DefaultID = genreDescID.Default.(func() uuid.UUID)
-	goalFields := schema.Goal{}.Fields()
-	_ = goalFields
-	// goalDescTitle is the schema descriptor for title field.
-	goalDescTitle := goalFields[1].Descriptor()
-	// goal.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	goal.TitleValidator = goalDescTitle.Validators[0].(func(string) error)
-	// goalDescCreatedAt is the schema descriptor for created_at field.
-	goalDescCreatedAt := goalFields[3].Descriptor()
-	// goal.DefaultCreatedAt holds the default value on creation for the created_at field.
-	goal.DefaultCreatedAt = goalDescCreatedAt.Default.(func() time.Time)
-	// goalDescUpdatedAt is the schema descriptor for updated_at field.
-	goalDescUpdatedAt := goalFields[4].Descriptor()
-	// goal.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	goal.DefaultUpdatedAt = goalDescUpdatedAt.Default.(func() time.Time)
-	// goal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	goal.UpdateDefaultUpdatedAt = goalDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// goalDescID is the schema descriptor for id field.
-	goalDescID := goalFields[0].Descriptor()
-	// goal.DefaultID holds the default value on creation for the id field.
-	goal.DefaultID = goalDescID.Default.(func() uuid.UUID)
-	imageFields := schema.Image{}.Fields()
-	_ = imageFields
-	// imageDescObjectName is the schema descriptor for object_name field.
-	imageDescObjectName := imageFields[1].Descriptor()
-	// image.ObjectNameValidator is a validator for the "object_name" field. It is called by the builders before save.
-	image.ObjectNameValidator = imageDescObjectName.Validators[0].(func(string) error)
-	// imageDescContentType is the schema descriptor for content_type field.
-	imageDescContentType := imageFields[2].Descriptor()
-	// image.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
-	image.ContentTypeValidator = imageDescContentType.Validators[0].(func(string) error)
-	// imageDescCreatedAt is the schema descriptor for created_at field.
-	imageDescCreatedAt := imageFields[3].Descriptor()
-	// image.DefaultCreatedAt holds the default value on creation for the created_at field.
-	image.DefaultCreatedAt = imageDescCreatedAt.Default.(func() time.Time)
-	// imageDescID is the schema descriptor for id field.
-	imageDescID := imageFields[0].Descriptor()
-	// image.DefaultID holds the default value on creation for the id field.
-	image.DefaultID = imageDescID.Default.(func() uuid.UUID)
-	postFields := schema.Post{}.Fields()
-	_ = postFields
-	// postDescContent is the schema descriptor for content field.
-	postDescContent := postFields[1].Descriptor()
-	// post.ContentValidator is a validator for the "content" field. It is called by the builders before save.
-	post.ContentValidator = func() func(string) error {
-		validators := postDescContent.Validators
-		fns := [...]func(string) error{
-			validators[0].(func(string) error),
-			validators[1].(func(string) error),
-		}
-		return func(content string) error {
-			for _, fn := range fns {
-				if err := fn(content); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
-	// postDescCreatedAt is the schema descriptor for created_at field.
-	postDescCreatedAt := postFields[2].Descriptor()
-	// post.DefaultCreatedAt holds the default value on creation for the created_at field.
-	post.DefaultCreatedAt = postDescCreatedAt.Default.(func() time.Time)
-	// postDescUpdatedAt is the schema descriptor for updated_at field.
-	postDescUpdatedAt := postFields[3].Descriptor()
-	// post.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	post.DefaultUpdatedAt = postDescUpdatedAt.Default.(func() time.Time)
-	// post.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	post.UpdateDefaultUpdatedAt = postDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// postDescID is the schema descriptor for id field.
-	postDescID := postFields[0].Descriptor()
-	// post.DefaultID holds the default value on creation for the id field.
-	post.DefaultID = postDescID.Default.(func() uuid.UUID)
-	reactionFields := schema.Reaction{}.Fields()
-	_ = reactionFields
-	// reactionDescCreatedAt is the schema descriptor for created_at field.
-	reactionDescCreatedAt := reactionFields[1].Descriptor()
-	// reaction.DefaultCreatedAt holds the default value on creation for the created_at field.
-	reaction.DefaultCreatedAt = reactionDescCreatedAt.Default.(func() time.Time)
-	// reactionDescID is the schema descriptor for id field.
-	reactionDescID := reactionFields[0].Descriptor()
-	// reaction.DefaultID holds the default value on creation for the id field.
-	reaction.DefaultID = reactionDescID.Default.(func() uuid.UUID)
-	refreshtokenFields := schema.RefreshToken{}.Fields()
-	_ = refreshtokenFields
-	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
-	refreshtokenDescTokenHash := refreshtokenFields[1].Descriptor()
-	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
-	refreshtoken.TokenHashValidator = refreshtokenDescTokenHash.Validators[0].(func(string) error)
-	// refreshtokenDescRevoked is the schema descriptor for revoked field.
-	refreshtokenDescRevoked := refreshtokenFields[3].Descriptor()
-	// refreshtoken.DefaultRevoked holds the default value on creation for the revoked field.
-	refreshtoken.DefaultRevoked = refreshtokenDescRevoked.Default.(bool)
-	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
-	refreshtokenDescCreatedAt := refreshtokenFields[4].Descriptor()
-	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
-	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
-	// refreshtokenDescID is the schema descriptor for id field.
-	refreshtokenDescID := refreshtokenFields[0].Descriptor()
-	// refreshtoken.DefaultID holds the default value on creation for the id field.
-	refreshtoken.DefaultID = refreshtokenDescID.Default.(func() uuid.UUID)
-	userFields := schema.User{}.Fields()
-	_ = userFields
-	// userDescName is the schema descriptor for name field.
-	userDescName := userFields[1].Descriptor()
-	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	user.NameValidator = userDescName.Validators[0].(func(string) error)
-	// userDescEmail is the schema descriptor for email field.
-	userDescEmail := userFields[2].Descriptor()
-	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
-	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
-	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[7].Descriptor()
-	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
-	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
-	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[8].Descriptor()
-	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
-	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// userDescID is the schema descriptor for id field.
-	userDescID := userFields[0].Descriptor()
-	// user.DefaultID holds the default value on creation for the id field.
-	user.DefaultID = userDescID.Default.(func() uuid.UUID)
-}
+// The schema-stitching logic is generated in backend/ent/runtime/runtime.go
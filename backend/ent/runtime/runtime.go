@@ -2,7 +2,549 @@
 
 package runtime
 
-// The schema-stitching logic is generated in backend/ent/runtime.go
+import (
+	"backend/ent/bookmark"
+	"backend/ent/bookmarkcollection"
+	"backend/ent/comment"
+	"backend/ent/genre"
+	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/goaltemplate"
+	"backend/ent/hashtag"
+	"backend/ent/image"
+	"backend/ent/linkpreview"
+	"backend/ent/milestone"
+	"backend/ent/moderationaction"
+	"backend/ent/notification"
+	"backend/ent/notificationdelivery"
+	"backend/ent/outboxevent"
+	"backend/ent/post"
+	"backend/ent/postrevision"
+	"backend/ent/reaction"
+	"backend/ent/refreshtoken"
+	"backend/ent/reminderlog"
+	"backend/ent/report"
+	"backend/ent/repost"
+	"backend/ent/schema"
+	"backend/ent/timelineentry"
+	"backend/ent/user"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	bookmarkFields := schema.Bookmark{}.Fields()
+	_ = bookmarkFields
+	// bookmarkDescCreatedAt is the schema descriptor for created_at field.
+	bookmarkDescCreatedAt := bookmarkFields[1].Descriptor()
+	// bookmark.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bookmark.DefaultCreatedAt = bookmarkDescCreatedAt.Default.(func() time.Time)
+	// bookmarkDescID is the schema descriptor for id field.
+	bookmarkDescID := bookmarkFields[0].Descriptor()
+	// bookmark.DefaultID holds the default value on creation for the id field.
+	bookmark.DefaultID = bookmarkDescID.Default.(func() uuid.UUID)
+	bookmarkcollectionFields := schema.BookmarkCollection{}.Fields()
+	_ = bookmarkcollectionFields
+	// bookmarkcollectionDescName is the schema descriptor for name field.
+	bookmarkcollectionDescName := bookmarkcollectionFields[1].Descriptor()
+	// bookmarkcollection.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bookmarkcollection.NameValidator = func() func(string) error {
+		validators := bookmarkcollectionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// bookmarkcollectionDescCreatedAt is the schema descriptor for created_at field.
+	bookmarkcollectionDescCreatedAt := bookmarkcollectionFields[2].Descriptor()
+	// bookmarkcollection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bookmarkcollection.DefaultCreatedAt = bookmarkcollectionDescCreatedAt.Default.(func() time.Time)
+	// bookmarkcollectionDescUpdatedAt is the schema descriptor for updated_at field.
+	bookmarkcollectionDescUpdatedAt := bookmarkcollectionFields[3].Descriptor()
+	// bookmarkcollection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	bookmarkcollection.DefaultUpdatedAt = bookmarkcollectionDescUpdatedAt.Default.(func() time.Time)
+	// bookmarkcollection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bookmarkcollection.UpdateDefaultUpdatedAt = bookmarkcollectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// bookmarkcollectionDescID is the schema descriptor for id field.
+	bookmarkcollectionDescID := bookmarkcollectionFields[0].Descriptor()
+	// bookmarkcollection.DefaultID holds the default value on creation for the id field.
+	bookmarkcollection.DefaultID = bookmarkcollectionDescID.Default.(func() uuid.UUID)
+	commentHooks := schema.Comment{}.Hooks()
+	comment.Hooks[0] = commentHooks[0]
+	comment.Hooks[1] = commentHooks[1]
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescContent is the schema descriptor for content field.
+	commentDescContent := commentFields[1].Descriptor()
+	// comment.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	comment.ContentValidator = func() func(string) error {
+		validators := commentDescContent.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(content string) error {
+			for _, fn := range fns {
+				if err := fn(content); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentFields[2].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	// commentDescUpdatedAt is the schema descriptor for updated_at field.
+	commentDescUpdatedAt := commentFields[3].Descriptor()
+	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	comment.UpdateDefaultUpdatedAt = commentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// commentDescID is the schema descriptor for id field.
+	commentDescID := commentFields[0].Descriptor()
+	// comment.DefaultID holds the default value on creation for the id field.
+	comment.DefaultID = commentDescID.Default.(func() uuid.UUID)
+	genreFields := schema.Genre{}.Fields()
+	_ = genreFields
+	// genreDescName is the schema descriptor for name field.
+	genreDescName := genreFields[1].Descriptor()
+	// genre.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	genre.NameValidator = genreDescName.Validators[0].(func(string) error)
+	// genreDescCreatedAt is the schema descriptor for created_at field.
+	genreDescCreatedAt := genreFields[2].Descriptor()
+	// genre.DefaultCreatedAt holds the default value on creation for the created_at field.
+	genre.DefaultCreatedAt = genreDescCreatedAt.Default.(func() time.Time)
+	// genreDescID is the schema descriptor for id field.
+	genreDescID := genreFields[0].Descriptor()
+	// genre.DefaultID holds the default value on creation for the id field.
+	genre.DefaultID = genreDescID.Default.(func() uuid.UUID)
+	goalHooks := schema.Goal{}.Hooks()
+	goal.Hooks[0] = goalHooks[0]
+	goal.Hooks[1] = goalHooks[1]
+	goal.Hooks[2] = goalHooks[2]
+	goalFields := schema.Goal{}.Fields()
+	_ = goalFields
+	// goalDescTitle is the schema descriptor for title field.
+	goalDescTitle := goalFields[1].Descriptor()
+	// goal.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	goal.TitleValidator = goalDescTitle.Validators[0].(func(string) error)
+	// goalDescPinned is the schema descriptor for pinned field.
+	goalDescPinned := goalFields[4].Descriptor()
+	// goal.DefaultPinned holds the default value on creation for the pinned field.
+	goal.DefaultPinned = goalDescPinned.Default.(bool)
+	// goalDescPosition is the schema descriptor for position field.
+	goalDescPosition := goalFields[5].Descriptor()
+	// goal.DefaultPosition holds the default value on creation for the position field.
+	goal.DefaultPosition = goalDescPosition.Default.(int)
+	// goalDescCreatedAt is the schema descriptor for created_at field.
+	goalDescCreatedAt := goalFields[6].Descriptor()
+	// goal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	goal.DefaultCreatedAt = goalDescCreatedAt.Default.(func() time.Time)
+	// goalDescUpdatedAt is the schema descriptor for updated_at field.
+	goalDescUpdatedAt := goalFields[7].Descriptor()
+	// goal.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	goal.DefaultUpdatedAt = goalDescUpdatedAt.Default.(func() time.Time)
+	// goal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	goal.UpdateDefaultUpdatedAt = goalDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// goalDescID is the schema descriptor for id field.
+	goalDescID := goalFields[0].Descriptor()
+	// goal.DefaultID holds the default value on creation for the id field.
+	goal.DefaultID = goalDescID.Default.(func() uuid.UUID)
+	goalparticipantFields := schema.GoalParticipant{}.Fields()
+	_ = goalparticipantFields
+	// goalparticipantDescCreatedAt is the schema descriptor for created_at field.
+	goalparticipantDescCreatedAt := goalparticipantFields[3].Descriptor()
+	// goalparticipant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	goalparticipant.DefaultCreatedAt = goalparticipantDescCreatedAt.Default.(func() time.Time)
+	// goalparticipantDescPosition is the schema descriptor for position field.
+	goalparticipantDescPosition := goalparticipantFields[5].Descriptor()
+	// goalparticipant.DefaultPosition holds the default value on creation for the position field.
+	goalparticipant.DefaultPosition = goalparticipantDescPosition.Default.(int)
+	// goalparticipantDescID is the schema descriptor for id field.
+	goalparticipantDescID := goalparticipantFields[0].Descriptor()
+	// goalparticipant.DefaultID holds the default value on creation for the id field.
+	goalparticipant.DefaultID = goalparticipantDescID.Default.(func() uuid.UUID)
+	goaltemplateFields := schema.GoalTemplate{}.Fields()
+	_ = goaltemplateFields
+	// goaltemplateDescTitle is the schema descriptor for title field.
+	goaltemplateDescTitle := goaltemplateFields[1].Descriptor()
+	// goaltemplate.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	goaltemplate.TitleValidator = goaltemplateDescTitle.Validators[0].(func(string) error)
+	// goaltemplateDescSuggestedDurationDays is the schema descriptor for suggested_duration_days field.
+	goaltemplateDescSuggestedDurationDays := goaltemplateFields[3].Descriptor()
+	// goaltemplate.SuggestedDurationDaysValidator is a validator for the "suggested_duration_days" field. It is called by the builders before save.
+	goaltemplate.SuggestedDurationDaysValidator = goaltemplateDescSuggestedDurationDays.Validators[0].(func(int) error)
+	// goaltemplateDescCreatedAt is the schema descriptor for created_at field.
+	goaltemplateDescCreatedAt := goaltemplateFields[6].Descriptor()
+	// goaltemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	goaltemplate.DefaultCreatedAt = goaltemplateDescCreatedAt.Default.(func() time.Time)
+	// goaltemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	goaltemplateDescUpdatedAt := goaltemplateFields[7].Descriptor()
+	// goaltemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	goaltemplate.DefaultUpdatedAt = goaltemplateDescUpdatedAt.Default.(func() time.Time)
+	// goaltemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	goaltemplate.UpdateDefaultUpdatedAt = goaltemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// goaltemplateDescID is the schema descriptor for id field.
+	goaltemplateDescID := goaltemplateFields[0].Descriptor()
+	// goaltemplate.DefaultID holds the default value on creation for the id field.
+	goaltemplate.DefaultID = goaltemplateDescID.Default.(func() uuid.UUID)
+	hashtagFields := schema.Hashtag{}.Fields()
+	_ = hashtagFields
+	// hashtagDescName is the schema descriptor for name field.
+	hashtagDescName := hashtagFields[1].Descriptor()
+	// hashtag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	hashtag.NameValidator = func() func(string) error {
+		validators := hashtagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// hashtagDescCreatedAt is the schema descriptor for created_at field.
+	hashtagDescCreatedAt := hashtagFields[2].Descriptor()
+	// hashtag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	hashtag.DefaultCreatedAt = hashtagDescCreatedAt.Default.(func() time.Time)
+	// hashtagDescID is the schema descriptor for id field.
+	hashtagDescID := hashtagFields[0].Descriptor()
+	// hashtag.DefaultID holds the default value on creation for the id field.
+	hashtag.DefaultID = hashtagDescID.Default.(func() uuid.UUID)
+	imageFields := schema.Image{}.Fields()
+	_ = imageFields
+	// imageDescObjectName is the schema descriptor for object_name field.
+	imageDescObjectName := imageFields[1].Descriptor()
+	// image.ObjectNameValidator is a validator for the "object_name" field. It is called by the builders before save.
+	image.ObjectNameValidator = imageDescObjectName.Validators[0].(func(string) error)
+	// imageDescContentType is the schema descriptor for content_type field.
+	imageDescContentType := imageFields[2].Descriptor()
+	// image.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	image.ContentTypeValidator = imageDescContentType.Validators[0].(func(string) error)
+	// imageDescCreatedAt is the schema descriptor for created_at field.
+	imageDescCreatedAt := imageFields[3].Descriptor()
+	// image.DefaultCreatedAt holds the default value on creation for the created_at field.
+	image.DefaultCreatedAt = imageDescCreatedAt.Default.(func() time.Time)
+	// imageDescID is the schema descriptor for id field.
+	imageDescID := imageFields[0].Descriptor()
+	// image.DefaultID holds the default value on creation for the id field.
+	image.DefaultID = imageDescID.Default.(func() uuid.UUID)
+	linkpreviewFields := schema.LinkPreview{}.Fields()
+	_ = linkpreviewFields
+	// linkpreviewDescURL is the schema descriptor for url field.
+	linkpreviewDescURL := linkpreviewFields[1].Descriptor()
+	// linkpreview.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	linkpreview.URLValidator = func() func(string) error {
+		validators := linkpreviewDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// linkpreviewDescID is the schema descriptor for id field.
+	linkpreviewDescID := linkpreviewFields[0].Descriptor()
+	// linkpreview.DefaultID holds the default value on creation for the id field.
+	linkpreview.DefaultID = linkpreviewDescID.Default.(func() uuid.UUID)
+	milestoneFields := schema.Milestone{}.Fields()
+	_ = milestoneFields
+	// milestoneDescTitle is the schema descriptor for title field.
+	milestoneDescTitle := milestoneFields[1].Descriptor()
+	// milestone.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	milestone.TitleValidator = milestoneDescTitle.Validators[0].(func(string) error)
+	// milestoneDescPosition is the schema descriptor for position field.
+	milestoneDescPosition := milestoneFields[3].Descriptor()
+	// milestone.DefaultPosition holds the default value on creation for the position field.
+	milestone.DefaultPosition = milestoneDescPosition.Default.(int)
+	// milestoneDescCreatedAt is the schema descriptor for created_at field.
+	milestoneDescCreatedAt := milestoneFields[4].Descriptor()
+	// milestone.DefaultCreatedAt holds the default value on creation for the created_at field.
+	milestone.DefaultCreatedAt = milestoneDescCreatedAt.Default.(func() time.Time)
+	// milestoneDescID is the schema descriptor for id field.
+	milestoneDescID := milestoneFields[0].Descriptor()
+	// milestone.DefaultID holds the default value on creation for the id field.
+	milestone.DefaultID = milestoneDescID.Default.(func() uuid.UUID)
+	moderationactionFields := schema.ModerationAction{}.Fields()
+	_ = moderationactionFields
+	// moderationactionDescNote is the schema descriptor for note field.
+	moderationactionDescNote := moderationactionFields[4].Descriptor()
+	// moderationaction.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	moderationaction.NoteValidator = moderationactionDescNote.Validators[0].(func(string) error)
+	// moderationactionDescCreatedAt is the schema descriptor for created_at field.
+	moderationactionDescCreatedAt := moderationactionFields[6].Descriptor()
+	// moderationaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderationaction.DefaultCreatedAt = moderationactionDescCreatedAt.Default.(func() time.Time)
+	// moderationactionDescID is the schema descriptor for id field.
+	moderationactionDescID := moderationactionFields[0].Descriptor()
+	// moderationaction.DefaultID holds the default value on creation for the id field.
+	moderationaction.DefaultID = moderationactionDescID.Default.(func() uuid.UUID)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescActorCount is the schema descriptor for actor_count field.
+	notificationDescActorCount := notificationFields[2].Descriptor()
+	// notification.DefaultActorCount holds the default value on creation for the actor_count field.
+	notification.DefaultActorCount = notificationDescActorCount.Default.(int)
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[4].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationDescUpdatedAt := notificationFields[5].Descriptor()
+	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() uuid.UUID)
+	notificationdeliveryFields := schema.NotificationDelivery{}.Fields()
+	_ = notificationdeliveryFields
+	// notificationdeliveryDescDeliveredAt is the schema descriptor for delivered_at field.
+	notificationdeliveryDescDeliveredAt := notificationdeliveryFields[2].Descriptor()
+	// notificationdelivery.DefaultDeliveredAt holds the default value on creation for the delivered_at field.
+	notificationdelivery.DefaultDeliveredAt = notificationdeliveryDescDeliveredAt.Default.(func() time.Time)
+	// notificationdeliveryDescID is the schema descriptor for id field.
+	notificationdeliveryDescID := notificationdeliveryFields[0].Descriptor()
+	// notificationdelivery.DefaultID holds the default value on creation for the id field.
+	notificationdelivery.DefaultID = notificationdeliveryDescID.Default.(func() uuid.UUID)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescType is the schema descriptor for type field.
+	outboxeventDescType := outboxeventFields[1].Descriptor()
+	// outboxevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	outboxevent.TypeValidator = outboxeventDescType.Validators[0].(func(string) error)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[5].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxeventDescNextAttemptAt := outboxeventFields[6].Descriptor()
+	// outboxevent.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxevent.DefaultNextAttemptAt = outboxeventDescNextAttemptAt.Default.(func() time.Time)
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[9].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescID is the schema descriptor for id field.
+	outboxeventDescID := outboxeventFields[0].Descriptor()
+	// outboxevent.DefaultID holds the default value on creation for the id field.
+	outboxevent.DefaultID = outboxeventDescID.Default.(func() uuid.UUID)
+	postHooks := schema.Post{}.Hooks()
+	post.Hooks[0] = postHooks[0]
+	post.Hooks[1] = postHooks[1]
+	post.Hooks[2] = postHooks[2]
+	post.Hooks[3] = postHooks[3]
+	post.Hooks[4] = postHooks[4]
+	postFields := schema.Post{}.Fields()
+	_ = postFields
+	// postDescContent is the schema descriptor for content field.
+	postDescContent := postFields[1].Descriptor()
+	// post.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	post.ContentValidator = func() func(string) error {
+		validators := postDescContent.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(content string) error {
+			for _, fn := range fns {
+				if err := fn(content); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// postDescAmount is the schema descriptor for amount field.
+	postDescAmount := postFields[3].Descriptor()
+	// post.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	post.AmountValidator = postDescAmount.Validators[0].(func(float64) error)
+	// postDescEditCount is the schema descriptor for edit_count field.
+	postDescEditCount := postFields[4].Descriptor()
+	// post.DefaultEditCount holds the default value on creation for the edit_count field.
+	post.DefaultEditCount = postDescEditCount.Default.(int)
+	// post.EditCountValidator is a validator for the "edit_count" field. It is called by the builders before save.
+	post.EditCountValidator = postDescEditCount.Validators[0].(func(int) error)
+	// postDescCreatedAt is the schema descriptor for created_at field.
+	postDescCreatedAt := postFields[7].Descriptor()
+	// post.DefaultCreatedAt holds the default value on creation for the created_at field.
+	post.DefaultCreatedAt = postDescCreatedAt.Default.(func() time.Time)
+	// postDescUpdatedAt is the schema descriptor for updated_at field.
+	postDescUpdatedAt := postFields[8].Descriptor()
+	// post.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	post.DefaultUpdatedAt = postDescUpdatedAt.Default.(func() time.Time)
+	// post.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	post.UpdateDefaultUpdatedAt = postDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// postDescID is the schema descriptor for id field.
+	postDescID := postFields[0].Descriptor()
+	// post.DefaultID holds the default value on creation for the id field.
+	post.DefaultID = postDescID.Default.(func() uuid.UUID)
+	postrevisionFields := schema.PostRevision{}.Fields()
+	_ = postrevisionFields
+	// postrevisionDescRevision is the schema descriptor for revision field.
+	postrevisionDescRevision := postrevisionFields[1].Descriptor()
+	// postrevision.RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	postrevision.RevisionValidator = postrevisionDescRevision.Validators[0].(func(int) error)
+	// postrevisionDescReplacedAt is the schema descriptor for replaced_at field.
+	postrevisionDescReplacedAt := postrevisionFields[7].Descriptor()
+	// postrevision.DefaultReplacedAt holds the default value on creation for the replaced_at field.
+	postrevision.DefaultReplacedAt = postrevisionDescReplacedAt.Default.(func() time.Time)
+	// postrevisionDescID is the schema descriptor for id field.
+	postrevisionDescID := postrevisionFields[0].Descriptor()
+	// postrevision.DefaultID holds the default value on creation for the id field.
+	postrevision.DefaultID = postrevisionDescID.Default.(func() uuid.UUID)
+	reactionHooks := schema.Reaction{}.Hooks()
+	reaction.Hooks[0] = reactionHooks[0]
+	reactionFields := schema.Reaction{}.Fields()
+	_ = reactionFields
+	// reactionDescKind is the schema descriptor for kind field.
+	reactionDescKind := reactionFields[1].Descriptor()
+	// reaction.DefaultKind holds the default value on creation for the kind field.
+	reaction.DefaultKind = reactionDescKind.Default.(string)
+	// reaction.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	reaction.KindValidator = reactionDescKind.Validators[0].(func(string) error)
+	// reactionDescCreatedAt is the schema descriptor for created_at field.
+	reactionDescCreatedAt := reactionFields[2].Descriptor()
+	// reaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reaction.DefaultCreatedAt = reactionDescCreatedAt.Default.(func() time.Time)
+	// reactionDescID is the schema descriptor for id field.
+	reactionDescID := reactionFields[0].Descriptor()
+	// reaction.DefaultID holds the default value on creation for the id field.
+	reaction.DefaultID = reactionDescID.Default.(func() uuid.UUID)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[1].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = refreshtokenDescTokenHash.Validators[0].(func(string) error)
+	// refreshtokenDescRevoked is the schema descriptor for revoked field.
+	refreshtokenDescRevoked := refreshtokenFields[3].Descriptor()
+	// refreshtoken.DefaultRevoked holds the default value on creation for the revoked field.
+	refreshtoken.DefaultRevoked = refreshtokenDescRevoked.Default.(bool)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[4].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	// refreshtokenDescID is the schema descriptor for id field.
+	refreshtokenDescID := refreshtokenFields[0].Descriptor()
+	// refreshtoken.DefaultID holds the default value on creation for the id field.
+	refreshtoken.DefaultID = refreshtokenDescID.Default.(func() uuid.UUID)
+	reminderlogFields := schema.ReminderLog{}.Fields()
+	_ = reminderlogFields
+	// reminderlogDescSentAt is the schema descriptor for sent_at field.
+	reminderlogDescSentAt := reminderlogFields[2].Descriptor()
+	// reminderlog.DefaultSentAt holds the default value on creation for the sent_at field.
+	reminderlog.DefaultSentAt = reminderlogDescSentAt.Default.(func() time.Time)
+	// reminderlogDescID is the schema descriptor for id field.
+	reminderlogDescID := reminderlogFields[0].Descriptor()
+	// reminderlog.DefaultID holds the default value on creation for the id field.
+	reminderlog.DefaultID = reminderlogDescID.Default.(func() uuid.UUID)
+	reportFields := schema.Report{}.Fields()
+	_ = reportFields
+	// reportDescText is the schema descriptor for text field.
+	reportDescText := reportFields[5].Descriptor()
+	// report.TextValidator is a validator for the "text" field. It is called by the builders before save.
+	report.TextValidator = reportDescText.Validators[0].(func(string) error)
+	// reportDescResolutionNote is the schema descriptor for resolution_note field.
+	reportDescResolutionNote := reportFields[8].Descriptor()
+	// report.ResolutionNoteValidator is a validator for the "resolution_note" field. It is called by the builders before save.
+	report.ResolutionNoteValidator = reportDescResolutionNote.Validators[0].(func(string) error)
+	// reportDescCreatedAt is the schema descriptor for created_at field.
+	reportDescCreatedAt := reportFields[9].Descriptor()
+	// report.DefaultCreatedAt holds the default value on creation for the created_at field.
+	report.DefaultCreatedAt = reportDescCreatedAt.Default.(func() time.Time)
+	// reportDescID is the schema descriptor for id field.
+	reportDescID := reportFields[0].Descriptor()
+	// report.DefaultID holds the default value on creation for the id field.
+	report.DefaultID = reportDescID.Default.(func() uuid.UUID)
+	repostFields := schema.Repost{}.Fields()
+	_ = repostFields
+	// repostDescCreatedAt is the schema descriptor for created_at field.
+	repostDescCreatedAt := repostFields[1].Descriptor()
+	// repost.DefaultCreatedAt holds the default value on creation for the created_at field.
+	repost.DefaultCreatedAt = repostDescCreatedAt.Default.(func() time.Time)
+	// repostDescID is the schema descriptor for id field.
+	repostDescID := repostFields[0].Descriptor()
+	// repost.DefaultID holds the default value on creation for the id field.
+	repost.DefaultID = repostDescID.Default.(func() uuid.UUID)
+	timelineentryFields := schema.TimelineEntry{}.Fields()
+	_ = timelineentryFields
+	// timelineentryDescCreatedAt is the schema descriptor for created_at field.
+	timelineentryDescCreatedAt := timelineentryFields[2].Descriptor()
+	// timelineentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	timelineentry.DefaultCreatedAt = timelineentryDescCreatedAt.Default.(func() time.Time)
+	// timelineentryDescID is the schema descriptor for id field.
+	timelineentryDescID := timelineentryFields[0].Descriptor()
+	// timelineentry.DefaultID holds the default value on creation for the id field.
+	timelineentry.DefaultID = timelineentryDescID.Default.(func() uuid.UUID)
+	userHooks := schema.User{}.Hooks()
+	user.Hooks[0] = userHooks[0]
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[1].Descriptor()
+	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	user.NameValidator = userDescName.Validators[0].(func(string) error)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[2].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescHandle is the schema descriptor for handle field.
+	userDescHandle := userFields[3].Descriptor()
+	// user.HandleValidator is a validator for the "handle" field. It is called by the builders before save.
+	user.HandleValidator = userDescHandle.Validators[0].(func(string) error)
+	// userDescTimeZone is the schema descriptor for time_zone field.
+	userDescTimeZone := userFields[9].Descriptor()
+	// user.DefaultTimeZone holds the default value on creation for the time_zone field.
+	user.DefaultTimeZone = userDescTimeZone.Default.(string)
+	// userDescQuietHoursStart is the schema descriptor for quiet_hours_start field.
+	userDescQuietHoursStart := userFields[10].Descriptor()
+	// user.QuietHoursStartValidator is a validator for the "quiet_hours_start" field. It is called by the builders before save.
+	user.QuietHoursStartValidator = userDescQuietHoursStart.Validators[0].(func(int) error)
+	// userDescQuietHoursEnd is the schema descriptor for quiet_hours_end field.
+	userDescQuietHoursEnd := userFields[11].Descriptor()
+	// user.QuietHoursEndValidator is a validator for the "quiet_hours_end" field. It is called by the builders before save.
+	user.QuietHoursEndValidator = userDescQuietHoursEnd.Validators[0].(func(int) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[17].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[18].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
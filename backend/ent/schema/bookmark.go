@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Bookmark holds the schema definition for the Bookmark entity.
+// ユーザーが後で読むために保存した投稿です。本人にのみ表示されます。
+type Bookmark struct {
+	ent.Schema
+}
+
+// Fields of the Bookmark.
+func (Bookmark) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Bookmark.
+func (Bookmark) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Bookmark -> User (多対1)
+		edge.From("user", User.Type).
+			Ref("bookmarks").
+			Unique().
+			Required(),
+		// Bookmark -> Post (多対1)
+		edge.From("post", Post.Type).
+			Ref("bookmarks").
+			Unique().
+			Required(),
+		// Bookmark -> BookmarkCollection (多対1、任意)
+		edge.From("collection", BookmarkCollection.Type).
+			Ref("bookmarks").
+			Unique(),
+	}
+}
+
+// Indexes of the Bookmark.
+func (Bookmark) Indexes() []ent.Index {
+	return []ent.Index{
+		// 1ユーザー・1投稿につき1ブックマークの複合ユニーク制約
+		index.Edges("user", "post").
+			Unique(),
+		// ユーザー別の一覧を新しい順に取得する用
+		index.Fields("created_at").
+			Edges("user"),
+	}
+}
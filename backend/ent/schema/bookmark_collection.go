@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// BookmarkCollection holds the schema definition for the BookmarkCollection entity.
+// ブックマークを分類するための名前付きのコレクションです。
+type BookmarkCollection struct {
+	ent.Schema
+}
+
+// Fields of the BookmarkCollection.
+func (BookmarkCollection) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		field.String("name").
+			NotEmpty().
+			MaxRuneLen(50),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the BookmarkCollection.
+func (BookmarkCollection) Edges() []ent.Edge {
+	return []ent.Edge{
+		// BookmarkCollection -> User (多対1)
+		edge.From("user", User.Type).
+			Ref("bookmark_collections").
+			Unique().
+			Required(),
+		// BookmarkCollection -> Bookmark (1対多。コレクションを削除してもブックマークは未分類として残す)
+		edge.To("bookmarks", Bookmark.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+// Indexes of the BookmarkCollection.
+func (BookmarkCollection) Indexes() []ent.Index {
+	return []ent.Index{
+		// 1ユーザー内でコレクション名が重複しないための複合ユニーク制約
+		index.Fields("name").
+			Edges("user").
+			Unique(),
+	}
+}
@@ -0,0 +1,101 @@
+package schema
+
+import (
+	"context"
+	"time"
+
+	gen "backend/ent"
+	"backend/ent/hook"
+	"backend/internal/spamfilter"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Comment holds the schema definition for the Comment entity.
+type Comment struct {
+	ent.Schema
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		field.String("content").
+			NotEmpty().
+			MaxLen(1000),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		// 運営により非表示にされた日時 (非表示の間は作成者本人にのみ表示される)
+		field.Time("hidden_at").
+			Optional().
+			Nillable(),
+		// 削除日時 (論理削除。返信のスレッドを保つため行は残す)
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+		// 迷惑行為フィルタで同じ本文を数えるための、表記の揺れを揃えた本文のハッシュ (本文の保存時にフックで更新される)
+		field.String("content_hash").
+			Optional(),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Comment -> Post (多対1)
+		edge.From("post", Post.Type).
+			Ref("comments").
+			Unique().
+			Required(),
+		// Comment -> User (多対1: コメントしたユーザー)
+		edge.From("user", User.Type).
+			Ref("comments").
+			Unique().
+			Required(),
+		// 返信関係 (親コメント 1対多 返信)
+		edge.To("replies", Comment.Type).
+			From("parent").
+			Unique(),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		// 投稿別コメントのキーセットページネーション用
+		index.Fields("created_at", "id").
+			Edges("post"),
+		// 返信一覧取得用
+		index.Edges("parent"),
+		// ユーザー別コメント取得用
+		index.Edges("user"),
+		// 迷惑行為フィルタの同じ本文の集計用
+		index.Fields("content_hash", "created_at"),
+	}
+}
+
+// Hooks of the Comment.
+func (Comment) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(hashCommentContent, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(recordCommentEvents, ent.OpCreate),
+	}
+}
+
+// hashCommentContent は本文が設定されたときに、迷惑行為フィルタで比較する本文のハッシュを更新します。
+func hashCommentContent(next ent.Mutator) ent.Mutator {
+	return hook.CommentFunc(func(ctx context.Context, m *gen.CommentMutation) (ent.Value, error) {
+		if content, ok := m.Content(); ok {
+			m.SetContentHash(spamfilter.ContentHash(content))
+		}
+		return next.Mutate(ctx, m)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -33,5 +34,8 @@ func (Genre) Edges() []ent.Edge {
 		// Genre -> User (多対多、逆参照)
 		edge.From("users", User.Type).
 			Ref("genres"),
+		// Genre -> GoalTemplate (1対多、ジャンル削除時に一緒に削除)
+		edge.To("templates", GoalTemplate.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
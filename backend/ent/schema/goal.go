@@ -1,9 +1,19 @@
 package schema
 
 import (
+	"context"
 	"time"
 
+	gen "backend/ent"
+	"backend/ent/goal"
+	"backend/ent/hook"
+	"backend/ent/reminderlog"
+	"backend/ent/schema/types"
+	"backend/internal/search"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -25,11 +35,25 @@ func (Goal) Fields() []ent.Field {
 		field.Time("deadline").
 			Optional().
 			Nillable(),
+		// 習慣として取り組む曜日 (例: "mon", "wed")
+		field.Strings("habit_days").
+			Optional(),
+		// プロフィールの先頭に表示するか
+		field.Bool("pinned").
+			Default(false),
+		// オーナーが指定した表示順 (参加者ごとの表示順はGoalParticipantに保存し、参加者の記録がない目標ではこちらを使う)
+		field.Int("position").
+			Default(0),
 		field.Time("created_at").
 			Default(time.Now).Immutable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		// 全文検索用にタイトルを字句に分割したもの (タイトルの保存時にフックで更新される)
+		field.Other("search_vector", types.TSVector("")).
+			SchemaType(map[string]string{dialect.Postgres: "tsvector", dialect.SQLite: "text"}).
+			Optional().
+			Sensitive(),
 	}
 }
 
@@ -43,6 +67,18 @@ func (Goal) Edges() []ent.Edge {
 			Required(),
 		// Goal -> Post (1対多)
 		edge.To("posts", Post.Type),
+		// Goal -> GoalParticipant (1対多、目標削除時に一緒に削除)
+		edge.To("participants", GoalParticipant.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Goal -> Milestone (1対多、目標削除時に一緒に削除)
+		edge.To("milestones", Milestone.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Goal -> ReminderLog (1対多、目標削除時に一緒に削除)
+		edge.To("reminder_logs", ReminderLog.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Goal -> Notification (1対多、目標削除時に一緒に削除)
+		edge.To("notifications", Notification.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -51,5 +87,63 @@ func (Goal) Indexes() []ent.Index {
 	return []ent.Index{
 		// ユーザー別目標一覧取得用
 		index.Edges("user"),
+		// 並び順での一覧取得用
+		index.Fields("pinned", "position").
+			Edges("user"),
+		// 全文検索用
+		index.Fields("search_vector").
+			Annotations(entsql.IndexType("GIN")),
+	}
+}
+
+// Hooks of the Goal.
+func (Goal) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(indexGoalSearchVector, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(resetGoalReminders, ent.OpUpdate|ent.OpUpdateOne),
+		recordGoalEvents,
 	}
 }
+
+// indexGoalSearchVector はタイトルが設定されたときに、全文検索用の字句を更新します。
+func indexGoalSearchVector(next ent.Mutator) ent.Mutator {
+	return hook.GoalFunc(func(ctx context.Context, m *gen.GoalMutation) (ent.Value, error) {
+		if title, ok := m.Title(); ok {
+			m.SetSearchVector(search.Vector(title))
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// resetGoalReminders は期限が変更・削除されたときに、送信済みのリマインダーの記録を削除します。
+// 期限を延ばした目標にも、新しい期限に対する各しきい値のリマインダーを送り直すためです。
+// 削除は目標の更新と同じトランザクションで行います。
+func resetGoalReminders(next ent.Mutator) ent.Mutator {
+	return hook.GoalFunc(func(ctx context.Context, m *gen.GoalMutation) (ent.Value, error) {
+		deadline, set := m.Deadline()
+		if !set && !m.DeadlineCleared() {
+			return next.Mutate(ctx, m)
+		}
+		if m.Op().Is(ent.OpUpdateOne) {
+			old, err := m.OldDeadline(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if set && old != nil && old.Equal(deadline) {
+				return next.Mutate(ctx, m)
+			}
+		}
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		_, err = m.Client().ReminderLog.Delete().
+			Where(reminderlog.HasGoalWith(goal.IDIn(ids...))).
+			Exec(ctx)
+		return v, err
+	})
+}
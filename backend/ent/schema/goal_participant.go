@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// GoalParticipant holds the schema definition for the GoalParticipant entity.
+type GoalParticipant struct {
+	ent.Schema
+}
+
+// Fields of the GoalParticipant.
+func (GoalParticipant) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		// 目標内での役割 (owner は目標の編集・削除・招待が可能)
+		field.Enum("role").
+			Values("owner", "member").
+			Default("member"),
+		// 参加状態 (invited は招待承認待ち)
+		field.Enum("status").
+			Values("invited", "active").
+			Default("invited"),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+		// 招待を承認した日時
+		field.Time("joined_at").
+			Optional().
+			Nillable(),
+		// 参加者の目標一覧での表示順 (参加者ごとに並び替えられる)
+		field.Int("position").
+			Default(0),
+	}
+}
+
+// Edges of the GoalParticipant.
+func (GoalParticipant) Edges() []ent.Edge {
+	return []ent.Edge{
+		// GoalParticipant -> Goal (多対1)
+		edge.From("goal", Goal.Type).
+			Ref("participants").
+			Unique().
+			Required(),
+		// GoalParticipant -> User (多対1)
+		edge.From("user", User.Type).
+			Ref("goal_participations").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the GoalParticipant.
+func (GoalParticipant) Indexes() []ent.Index {
+	return []ent.Index{
+		// 1目標につき1ユーザー1参加の複合ユニーク制約
+		index.Edges("goal", "user").
+			Unique(),
+		// ユーザー別参加目標取得用
+		index.Edges("user"),
+	}
+}
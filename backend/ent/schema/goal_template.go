@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"backend/ent/schema/types"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// GoalTemplate holds the schema definition for the GoalTemplate entity.
+type GoalTemplate struct {
+	ent.Schema
+}
+
+// Fields of the GoalTemplate.
+func (GoalTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		field.String("title").
+			NotEmpty(),
+		field.String("description").
+			Optional().
+			Nillable(),
+		// 推奨される目標期間（日数）
+		field.Int("suggested_duration_days").
+			Optional().
+			Nillable().
+			Positive(),
+		// 目標作成時に追加されるマイルストーン
+		field.JSON("milestones", []types.MilestoneTemplate{}).
+			Optional(),
+		// 習慣として取り組む曜日 (例: "mon", "wed")
+		field.Strings("habit_days").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the GoalTemplate.
+func (GoalTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		// GoalTemplate -> Genre (多対1)
+		edge.From("genre", Genre.Type).
+			Ref("templates").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the GoalTemplate.
+func (GoalTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		// ジャンル内でタイトルを一意にする（シードの冪等性のため）
+		index.Fields("title").
+			Edges("genre").
+			Unique(),
+	}
+}
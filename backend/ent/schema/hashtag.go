@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Hashtag holds the schema definition for the Hashtag entity.
+type Hashtag struct {
+	ent.Schema
+}
+
+// Fields of the Hashtag.
+func (Hashtag) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		// 正規化したタグ名 ("#" を含まない。英字は小文字、全角英数字は半角)
+		field.String("name").
+			NotEmpty().
+			MaxRuneLen(50).
+			Unique().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Hashtag.
+func (Hashtag) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Hashtag -> Post (多対多、逆参照)
+		edge.From("posts", Post.Type).
+			Ref("hashtags"),
+	}
+}
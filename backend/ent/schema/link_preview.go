@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LinkPreview holds the schema definition for the LinkPreview entity.
+// 投稿本文の最初のURLから取得したOpenGraph/Twitterカードの情報をURLごとにキャッシュします。
+type LinkPreview struct {
+	ent.Schema
+}
+
+// Fields of the LinkPreview.
+func (LinkPreview) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		// 本文に書かれたURL (キャッシュのキー)
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048).
+			Unique().
+			Immutable(),
+		// 取得結果 (失敗した場合も再取得を控えるために記録する)
+		field.Enum("status").
+			Values("ok", "failed"),
+		field.String("title").
+			Optional(),
+		field.String("description").
+			Optional(),
+		field.String("image_url").
+			Optional(),
+		field.String("site_name").
+			Optional(),
+		// 最後に取得した日時
+		field.Time("fetched_at"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Milestone holds the schema definition for the Milestone entity.
+type Milestone struct {
+	ent.Schema
+}
+
+// Fields of the Milestone.
+func (Milestone) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		field.String("title").
+			NotEmpty(),
+		field.Time("due_date").
+			Optional().
+			Nillable(),
+		// 目標内での表示順
+		field.Int("position").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Milestone.
+func (Milestone) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Milestone -> Goal (多対1)
+		edge.From("goal", Goal.Type).
+			Ref("milestones").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Milestone.
+func (Milestone) Indexes() []ent.Index {
+	return []ent.Index{
+		// 目標別マイルストーン取得用
+		index.Edges("goal"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ModerationAction holds the schema definition for the ModerationAction entity.
+// モデレーターが行った操作の監査ログです。記録後は変更しません。
+type ModerationAction struct {
+	ent.Schema
+}
+
+// Fields of the ModerationAction.
+func (ModerationAction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		// 操作の種類
+		field.Enum("action").
+			Values("claim", "resolve", "hide", "unhide", "warn", "suspend", "unsuspend").
+			Immutable(),
+		// 操作の対象の種類 (claim・resolve は report)
+		field.Enum("target_type").
+			Values("post", "comment", "user", "report").
+			Immutable(),
+		field.UUID("target_id", uuid.UUID{}).
+			Immutable(),
+		// 操作の理由などのメモ
+		field.String("note").
+			Optional().
+			MaxRuneLen(1000).
+			Immutable(),
+		// アカウント停止の終了日時 (suspend のみ。未設定の場合は無期限)
+		field.Time("suspended_until").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ModerationAction.
+func (ModerationAction) Edges() []ent.Edge {
+	return []ent.Edge{
+		// ModerationAction -> User (操作したモデレーター、多対1。ユーザー削除後もログは残す)
+		edge.From("moderator", User.Type).
+			Ref("moderation_actions").
+			Unique().
+			Immutable(),
+		// ModerationAction -> Report (操作のきっかけとなった報告、多対1、任意)
+		edge.From("report", Report.Type).
+			Ref("actions").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the ModerationAction.
+func (ModerationAction) Indexes() []ent.Index {
+	return []ent.Index{
+		// 監査ログを新しい順に取得する用
+		index.Fields("created_at"),
+		// 対象別の履歴取得用
+		index.Fields("target_type", "target_id"),
+	}
+}
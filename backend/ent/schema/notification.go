@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Notification holds the schema definition for the Notification entity.
+// ユーザーの通知一覧に表示する通知です。
+// 未読の間は同じ種類・同じ投稿への通知を1件にまとめ、まとめたユーザーの人数を数えます (例: 「Aさんと他5人がリアクションしました」)。
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		field.Enum("type").
+			Values("mention", "reaction", "follow", "comment", "moderation_warning", "goal_reminder").
+			Immutable(),
+		// まとめた通知のきっかけとなった操作をしたユーザーの人数
+		field.Int("actor_count").
+			Default(1),
+		// 既読にした日時 (未設定の場合は未読)
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+		// 最後に通知をまとめた日時 (一覧の並び順。既読にしても変わらない)
+		field.Time("updated_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Notification -> User (通知を受け取るユーザー、多対1)
+		edge.From("recipient", User.Type).
+			Ref("notifications").
+			Unique().
+			Required().
+			Immutable(),
+		// Notification -> User (最後に操作をしたユーザー、多対1、任意。ユーザーが削除された場合は未設定になる)
+		edge.From("actor", User.Type).
+			Ref("latest_notifications").
+			Unique(),
+		// Notification -> User (まとめた通知のきっかけとなった操作をしたユーザー、多対多。同じユーザーを重複して数えないために使う)
+		edge.From("actors", User.Type).
+			Ref("acted_notifications"),
+		// Notification -> Post (通知の対象の投稿、多対1、任意)
+		edge.From("post", Post.Type).
+			Ref("notifications").
+			Unique().
+			Immutable(),
+		// Notification -> Goal (通知の対象の目標、多対1、任意)
+		edge.From("goal", Goal.Type).
+			Ref("notifications").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		// 通知一覧の降順取得用
+		index.Fields("updated_at").
+			Edges("recipient"),
+		// 未読の件数の集計用
+		index.Fields("read_at").
+			Edges("recipient"),
+		// まとめる先の未読の通知の検索用
+		index.Fields("type").
+			Edges("recipient", "post"),
+	}
+}
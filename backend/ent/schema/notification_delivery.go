@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// NotificationDelivery holds the schema definition for the NotificationDelivery entity.
+// 通知一覧に反映したドメインイベント (OutboxEvent) の記録です。
+// イベントは少なくとも1回届くため、再配信されたイベントで通知を重複して作成しないよう受け取るユーザーごとに記録します。
+type NotificationDelivery struct {
+	ent.Schema
+}
+
+// Fields of the NotificationDelivery.
+func (NotificationDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		// 反映したOutboxEventのID
+		field.UUID("event_id", uuid.UUID{}).
+			Immutable(),
+		// 通知一覧に反映した日時
+		field.Time("delivered_at").
+			Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the NotificationDelivery.
+func (NotificationDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		// NotificationDelivery -> User (通知を受け取るユーザー、多対1)
+		edge.From("recipient", User.Type).
+			Ref("notification_deliveries").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the NotificationDelivery.
+func (NotificationDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// 1イベント・1ユーザーにつき1回だけ反映するための複合ユニーク制約
+		index.Fields("event_id").
+			Edges("recipient").
+			Unique(),
+		// 保持期間を過ぎた記録の削除用
+		index.Fields("delivered_at"),
+	}
+}
@@ -0,0 +1,457 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	gen "backend/ent"
+	"backend/ent/goal"
+	"backend/ent/hook"
+	"backend/ent/post"
+	"backend/ent/reaction"
+	"backend/ent/user"
+	"backend/internal/outbox"
+
+	"entgo.io/ent"
+	"github.com/google/uuid"
+)
+
+// outboxRecord は変更と同じトランザクションで記録する1件のドメインイベントです。
+type outboxRecord struct {
+	typ         outbox.Type
+	aggregateID uuid.UUID
+	payload     any
+}
+
+// writeOutbox はドメインイベントをOutboxEventとして保存します。
+// クライアントには変更と同じトランザクションのものを指定します。
+func writeOutbox(ctx context.Context, client *gen.Client, records []outboxRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	builders := make([]*gen.OutboxEventCreate, 0, len(records))
+	for _, r := range records {
+		payload, err := json.Marshal(r.payload)
+		if err != nil {
+			return err
+		}
+		builders = append(builders, client.OutboxEvent.Create().
+			SetType(string(r.typ)).
+			SetAggregateID(r.aggregateID).
+			SetPayload(payload))
+	}
+	return client.OutboxEvent.CreateBulk(builders...).Exec(ctx)
+}
+
+// requireTx は変更がトランザクション内で行われているかを確認します。
+// トランザクション外の変更ではイベントの記録と変更が別々にコミットされるため、エラーにします。
+func requireTx(m ent.Mutation, tx func() (*gen.Tx, error)) error {
+	if _, err := tx(); err != nil {
+		return fmt.Errorf("%s %s must run in a transaction to record outbox events", m.Type(), m.Op())
+	}
+	return nil
+}
+
+// changesOnly は変更される項目が、イベントにしない項目 (ignored) だけかを返します。
+func changesOnly(m ent.Mutation, ignored ...string) bool {
+	if len(m.AddedEdges()) > 0 || len(m.RemovedEdges()) > 0 || len(m.ClearedEdges()) > 0 {
+		return false
+	}
+	for _, fields := range [][]string{m.Fields(), m.AddedFields(), m.ClearedFields()} {
+		for _, f := range fields {
+			if !slices.Contains(ignored, f) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// recordPostEvents は投稿の作成・公開・更新・削除をドメインイベントとして記録します。
+// 全文検索の字句や本文のハッシュだけを更新する場合は記録しません。
+func recordPostEvents(next ent.Mutator) ent.Mutator {
+	return hook.PostFunc(func(ctx context.Context, m *gen.PostMutation) (ent.Value, error) {
+		if m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && changesOnly(m, post.FieldSearchVector, post.FieldContentHash, post.FieldUpdatedAt) {
+			return next.Mutate(ctx, m)
+		}
+		if err := requireTx(m, m.Tx); err != nil {
+			return nil, err
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			id, _ := m.ID()
+			userID, _ := m.UserID()
+			status, _ := m.Status()
+			payload := outbox.PostPayload{PostID: id, UserID: userID, Status: string(status)}
+			records := []outboxRecord{{typ: outbox.TypePostCreated, aggregateID: id, payload: payload}}
+			if status == post.StatusPublished {
+				records = append(records, outboxRecord{typ: outbox.TypePostPublished, aggregateID: id, payload: payload})
+				if r, ok := mentionRecord(id, userID, m.MentionsIDs(), nil); ok {
+					records = append(records, r)
+				}
+			}
+			return v, writeOutbox(ctx, m.Client(), records)
+		}
+
+		// 対象の投稿と、更新で公開される投稿は変更の前に求める
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var publishing []uuid.UUID
+		if status, ok := m.Status(); ok && status == post.StatusPublished && len(ids) > 0 {
+			publishing, err = m.Client().Post.Query().
+				Where(post.IDIn(ids...), post.StatusNEQ(post.StatusPublished)).
+				IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		deleting := m.Op().Is(ent.OpDelete | ent.OpDeleteOne)
+		var before []*gen.Post
+		if deleting {
+			if before, err = postsForOutbox(ctx, m.Client(), ids); err != nil {
+				return nil, err
+			}
+		}
+		// 本文の変更でメンションを付け替える場合は、変更前にメンションしていたユーザーを求める
+		mentionsChanged := !deleting && (m.MentionsCleared() || len(m.MentionsIDs()) > 0)
+		var mentionedBefore map[uuid.UUID][]uuid.UUID
+		if mentionsChanged {
+			if mentionedBefore, err = mentionsForOutbox(ctx, m.Client(), ids); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		var records []outboxRecord
+		if deleting {
+			for _, p := range before {
+				records = append(records, postRecord(outbox.TypePostDeleted, p))
+			}
+			return v, writeOutbox(ctx, m.Client(), records)
+		}
+		after, err := postsForOutbox(ctx, m.Client(), ids)
+		if err != nil {
+			return nil, err
+		}
+		var mentioned map[uuid.UUID][]uuid.UUID
+		if mentionsChanged || len(publishing) > 0 {
+			if mentioned, err = mentionsForOutbox(ctx, m.Client(), ids); err != nil {
+				return nil, err
+			}
+		}
+		for _, p := range after {
+			records = append(records, postRecord(outbox.TypePostUpdated, p))
+			if p.Status != post.StatusPublished {
+				continue
+			}
+			notified := mentionedBefore[p.ID]
+			if slices.Contains(publishing, p.ID) {
+				records = append(records, postRecord(outbox.TypePostPublished, p))
+				// 公開前にメンションしたユーザーにはまだ知らせていない
+				notified = nil
+			}
+			if r, ok := mentionRecord(p.ID, p.Edges.User.ID, mentioned[p.ID], notified); ok {
+				records = append(records, r)
+			}
+		}
+		return v, writeOutbox(ctx, m.Client(), records)
+	})
+}
+
+// mentionsForOutbox は投稿ごとに、メンションしているユーザーのIDを返します。
+func mentionsForOutbox(ctx context.Context, client *gen.Client, ids []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	posts, err := client.Post.Query().
+		Where(post.IDIn(ids...)).
+		Select(post.FieldID).
+		WithMentions(func(q *gen.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[uuid.UUID][]uuid.UUID, len(posts))
+	for _, p := range posts {
+		for _, u := range p.Edges.Mentions {
+			res[p.ID] = append(res[p.ID], u.ID)
+		}
+	}
+	return res, nil
+}
+
+// mentionRecord は投稿のメンションのうち、notifiedに含まれない新しいメンションのイベントを作成します。
+// 新しいメンションがない場合はfalseを返します。
+func mentionRecord(postID, userID uuid.UUID, mentioned, notified []uuid.UUID) (outboxRecord, bool) {
+	var added []uuid.UUID
+	for _, id := range mentioned {
+		if !slices.Contains(notified, id) && !slices.Contains(added, id) {
+			added = append(added, id)
+		}
+	}
+	if len(added) == 0 {
+		return outboxRecord{}, false
+	}
+	return outboxRecord{
+		typ:         outbox.TypePostMentioned,
+		aggregateID: postID,
+		payload:     outbox.MentionPayload{PostID: postID, UserID: userID, MentionedUserIDs: added},
+	}, true
+}
+
+// recordCommentEvents はコメントの作成をドメインイベントとして記録します。
+func recordCommentEvents(next ent.Mutator) ent.Mutator {
+	return hook.CommentFunc(func(ctx context.Context, m *gen.CommentMutation) (ent.Value, error) {
+		if err := requireTx(m, m.Tx); err != nil {
+			return nil, err
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		id, _ := m.ID()
+		postID, _ := m.PostID()
+		userID, _ := m.UserID()
+		parentID, _ := m.ParentID()
+		return v, writeOutbox(ctx, m.Client(), []outboxRecord{{
+			typ:         outbox.TypeCommentCreated,
+			aggregateID: id,
+			payload:     outbox.CommentPayload{CommentID: id, PostID: postID, UserID: userID, ParentID: parentID},
+		}})
+	})
+}
+
+// postsForOutbox はイベントの内容に使う投稿を、投稿したユーザーのIDと合わせて取得します。
+func postsForOutbox(ctx context.Context, client *gen.Client, ids []uuid.UUID) ([]*gen.Post, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return client.Post.Query().
+		Where(post.IDIn(ids...)).
+		WithUser(func(q *gen.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		All(ctx)
+}
+
+// postRecord は投稿のイベントを作成します。
+func postRecord(t outbox.Type, p *gen.Post) outboxRecord {
+	return outboxRecord{
+		typ:         t,
+		aggregateID: p.ID,
+		payload:     outbox.PostPayload{PostID: p.ID, UserID: p.Edges.User.ID, Status: string(p.Status)},
+	}
+}
+
+// recordReactionEvents はリアクションの追加・削除をドメインイベントとして記録します。
+func recordReactionEvents(next ent.Mutator) ent.Mutator {
+	return hook.ReactionFunc(func(ctx context.Context, m *gen.ReactionMutation) (ent.Value, error) {
+		if err := requireTx(m, m.Tx); err != nil {
+			return nil, err
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			id, _ := m.ID()
+			userID, _ := m.UserID()
+			postID, _ := m.PostID()
+			kind, _ := m.Kind()
+			return v, writeOutbox(ctx, m.Client(), []outboxRecord{{
+				typ:         outbox.TypeReactionCreated,
+				aggregateID: id,
+				payload:     outbox.ReactionPayload{ReactionID: id, PostID: postID, UserID: userID, Kind: kind},
+			}})
+		}
+
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var before []*gen.Reaction
+		if len(ids) > 0 {
+			before, err = m.Client().Reaction.Query().
+				Where(reaction.IDIn(ids...)).
+				WithUser(func(q *gen.UserQuery) {
+					q.Select(user.FieldID)
+				}).
+				WithPost(func(q *gen.PostQuery) {
+					q.Select(post.FieldID)
+				}).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		records := make([]outboxRecord, 0, len(before))
+		for _, r := range before {
+			records = append(records, outboxRecord{
+				typ:         outbox.TypeReactionDeleted,
+				aggregateID: r.ID,
+				payload:     outbox.ReactionPayload{ReactionID: r.ID, PostID: r.Edges.Post.ID, UserID: r.Edges.User.ID, Kind: r.Kind},
+			})
+		}
+		return v, writeOutbox(ctx, m.Client(), records)
+	})
+}
+
+// recordFollowEvents はフォロー・フォロー解除をドメインイベントとして記録します。
+// フォロー・フォロワーのエッジを変更しない場合は記録しません。
+func recordFollowEvents(next ent.Mutator) ent.Mutator {
+	return hook.UserFunc(func(ctx context.Context, m *gen.UserMutation) (ent.Value, error) {
+		following, followers := m.FollowingIDs(), m.FollowersIDs()
+		unfollowing, unfollowers := m.RemovedFollowingIDs(), m.RemovedFollowersIDs()
+		if len(following)+len(followers)+len(unfollowing)+len(unfollowers) == 0 && !m.FollowingCleared() && !m.FollowersCleared() {
+			return next.Mutate(ctx, m)
+		}
+		if err := requireTx(m, m.Tx); err != nil {
+			return nil, err
+		}
+		id, ok := m.ID()
+		if !ok {
+			return nil, fmt.Errorf("%s %s cannot record follow events for multiple users", m.Type(), m.Op())
+		}
+
+		// 解除するフォローのうち、実際にフォローしているものだけを記録する
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if unfollowing, err = existingFollows(ctx, m.Client().User.Query().Where(user.ID(id)).QueryFollowing(), unfollowing, m.FollowingCleared()); err != nil {
+				return nil, err
+			}
+			if unfollowers, err = existingFollows(ctx, m.Client().User.Query().Where(user.ID(id)).QueryFollowers(), unfollowers, m.FollowersCleared()); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		var records []outboxRecord
+		follow := func(t outbox.Type, followerID, followeeID uuid.UUID) {
+			records = append(records, outboxRecord{
+				typ:         t,
+				aggregateID: followerID,
+				payload:     outbox.FollowPayload{FollowerID: followerID, FolloweeID: followeeID},
+			})
+		}
+		for _, other := range following {
+			follow(outbox.TypeFollowCreated, id, other)
+		}
+		for _, other := range followers {
+			follow(outbox.TypeFollowCreated, other, id)
+		}
+		for _, other := range unfollowing {
+			follow(outbox.TypeFollowDeleted, id, other)
+		}
+		for _, other := range unfollowers {
+			follow(outbox.TypeFollowDeleted, other, id)
+		}
+		return v, writeOutbox(ctx, m.Client(), records)
+	})
+}
+
+// existingFollows はidsのうちqに含まれるユーザーのIDを返します。clearedの場合はqの全てのユーザーのIDを返します。
+func existingFollows(ctx context.Context, q *gen.UserQuery, ids []uuid.UUID, cleared bool) ([]uuid.UUID, error) {
+	if cleared {
+		return q.IDs(ctx)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return q.Where(user.IDIn(ids...)).IDs(ctx)
+}
+
+// recordGoalEvents は目標の作成・更新・削除をドメインイベントとして記録します。
+// 全文検索の字句だけを更新する場合は記録しません。
+func recordGoalEvents(next ent.Mutator) ent.Mutator {
+	return hook.GoalFunc(func(ctx context.Context, m *gen.GoalMutation) (ent.Value, error) {
+		if m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && changesOnly(m, goal.FieldSearchVector, goal.FieldUpdatedAt) {
+			return next.Mutate(ctx, m)
+		}
+		if err := requireTx(m, m.Tx); err != nil {
+			return nil, err
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			id, _ := m.ID()
+			userID, _ := m.UserID()
+			return v, writeOutbox(ctx, m.Client(), []outboxRecord{{
+				typ:         outbox.TypeGoalCreated,
+				aggregateID: id,
+				payload:     outbox.GoalPayload{GoalID: id, UserID: userID},
+			}})
+		}
+
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t := outbox.TypeGoalUpdated
+		var goals []*gen.Goal
+		if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+			t = outbox.TypeGoalDeleted
+			if goals, err = goalsForOutbox(ctx, m.Client(), ids); err != nil {
+				return nil, err
+			}
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if t == outbox.TypeGoalUpdated {
+			if goals, err = goalsForOutbox(ctx, m.Client(), ids); err != nil {
+				return nil, err
+			}
+		}
+
+		records := make([]outboxRecord, 0, len(goals))
+		for _, g := range goals {
+			records = append(records, outboxRecord{
+				typ:         t,
+				aggregateID: g.ID,
+				payload:     outbox.GoalPayload{GoalID: g.ID, UserID: g.Edges.User.ID},
+			})
+		}
+		return v, writeOutbox(ctx, m.Client(), records)
+	})
+}
+
+// goalsForOutbox はイベントの内容に使う目標を、作成したユーザーのIDと合わせて取得します。
+func goalsForOutbox(ctx context.Context, client *gen.Client, ids []uuid.UUID) ([]*gen.Goal, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return client.Goal.Query().
+		Where(goal.IDIn(ids...)).
+		WithUser(func(q *gen.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		All(ctx)
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity.
+// 投稿・リアクション・フォロー・目標の変更と同じトランザクションで、フックにより記録するドメインイベントです。
+// コミット後に配信ジョブが購読者に届け、失敗した場合は間隔を空けて再試行します。
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		// イベントの種類 (例: "post.created")
+		field.String("type").
+			NotEmpty().
+			Immutable(),
+		// イベントの対象のID (投稿・リアクション・目標、フォローの場合はフォローしたユーザー)
+		field.UUID("aggregate_id", uuid.UUID{}).
+			Immutable(),
+		field.JSON("payload", json.RawMessage{}).
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "done", "failed").
+			Default("pending"),
+		// 配信を試みた回数
+		field.Int("attempts").
+			Default(0),
+		// 次に配信を試みる日時
+		field.Time("next_attempt_at").
+			Default(time.Now),
+		// 最後に配信に失敗したときのエラー
+		field.String("last_error").
+			Optional().
+			Nillable(),
+		// 全ての購読者に届け終えた日時
+		field.Time("dispatched_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the OutboxEvent.
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// 配信待ちのイベントの取得用
+		index.Fields("status", "next_attempt_at"),
+		// 配信済みの古いイベントの削除用
+		index.Fields("status", "dispatched_at"),
+	}
+}
@@ -1,9 +1,25 @@
 package schema
 
 import (
+	"context"
+	"slices"
 	"time"
 
+	gen "backend/ent"
+	"backend/ent/hashtag"
+	"backend/ent/hook"
+	"backend/ent/image"
+	"backend/ent/post"
+	"backend/ent/postrevision"
+	"backend/ent/schema/types"
+	"backend/ent/user"
+	"backend/internal/richtext"
+	"backend/internal/search"
+	"backend/internal/spamfilter"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -23,11 +39,45 @@ func (Post) Fields() []ent.Field {
 		field.String("content").
 			NotEmpty().
 			MaxLen(1000),
+		// 本文の書式 (上限文字数は変換前の本文に対して適用する)
+		field.Enum("format").
+			Values("plain", "markdown").
+			Default("plain"),
+		// 進捗量 (例: 勉強時間の分数、走った距離など。単位は目標ごとに利用者が決める)
+		field.Float("amount").
+			Optional().
+			Nillable().
+			Min(0),
+		// 本文・書式・進捗量・画像が編集された回数
+		field.Int("edit_count").
+			Default(0).
+			NonNegative(),
+		// 公開状態 (下書き・予約投稿は作成者本人にのみ表示される)
+		field.Enum("status").
+			Values("draft", "scheduled", "published").
+			Default("published"),
+		// 予約投稿の公開予定日時
+		field.Time("publish_at").
+			Optional().
+			Nillable(),
+		// 投稿日時 (下書き・予約投稿は公開された時点の日時に更新される)
 		field.Time("created_at").
-			Default(time.Now).Immutable(),
+			Default(time.Now),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		// 運営により非表示にされた日時 (非表示の間は作成者本人にのみ表示される)
+		field.Time("hidden_at").
+			Optional().
+			Nillable(),
+		// 全文検索用に本文を字句に分割したもの (本文の保存時にフックで更新される)
+		field.Other("search_vector", types.TSVector("")).
+			SchemaType(map[string]string{dialect.Postgres: "tsvector", dialect.SQLite: "text"}).
+			Optional().
+			Sensitive(),
+		// 迷惑行為フィルタで同じ本文を数えるための、表記の揺れを揃えた本文のハッシュ (本文の保存時にフックで更新される)
+		field.String("content_hash").
+			Optional(),
 	}
 }
 
@@ -48,6 +98,32 @@ func (Post) Edges() []ent.Edge {
 		edge.To("images", Image.Type),
 		// Post -> Reaction (1対多)
 		edge.To("reactions", Reaction.Type),
+		// Post -> Comment (1対多)
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Post -> Repost (1対多)
+		edge.To("reposts", Repost.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 引用投稿 (引用元の投稿が削除された場合、引用投稿は引用元なしとして残る)
+		edge.To("quotes", Post.Type).
+			From("quote_of").
+			Unique(),
+		// Post -> Bookmark (1対多)
+		edge.To("bookmarks", Bookmark.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Post -> User (本文でメンションしたユーザー、多対多)
+		edge.To("mentions", User.Type),
+		// Post -> Hashtag (本文のハッシュタグ、多対多)
+		edge.To("hashtags", Hashtag.Type),
+		// Post -> PostRevision (1対多)
+		edge.To("revisions", PostRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Post -> TimelineEntry (1対多)
+		edge.To("timeline_entries", TimelineEntry.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Post -> Notification (1対多)
+		edge.To("notifications", Notification.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -60,5 +136,180 @@ func (Post) Indexes() []ent.Index {
 		index.Edges("goal"),
 		// タイムライン降順取得用
 		index.Fields("created_at"),
+		// 公開予定日時を過ぎた予約投稿の検索用
+		index.Fields("status", "publish_at"),
+		// 全文検索用
+		index.Fields("search_vector").
+			Annotations(entsql.IndexType("GIN")),
+		// 迷惑行為フィルタの同じ本文の集計用
+		index.Fields("content_hash", "created_at"),
+	}
+}
+
+// Hooks of the Post.
+func (Post) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(recordPostRevision, ent.OpUpdateOne),
+		hook.On(extractPostEntities, ent.OpCreate|ent.OpUpdateOne),
+		hook.On(indexPostSearchVector, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(hashPostContent, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+		recordPostEvents,
 	}
 }
+
+// recordPostRevision は本文・書式・進捗量・画像が変わる更新の直前に、
+// 更新前の内容をPostRevisionとして保存し、編集回数を増やします。
+// 公開済みの投稿のみが対象です。
+func recordPostRevision(next ent.Mutator) ent.Mutator {
+	return hook.PostFunc(func(ctx context.Context, m *gen.PostMutation) (ent.Value, error) {
+		id, ok := m.ID()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+
+		// 公開前の下書き・予約投稿の編集は履歴に残さない
+		oldStatus, err := m.OldStatus(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if oldStatus != post.StatusPublished {
+			return next.Mutate(ctx, m)
+		}
+
+		oldContent, err := m.OldContent(ctx)
+		if err != nil {
+			return nil, err
+		}
+		oldAmount, err := m.OldAmount(ctx)
+		if err != nil {
+			return nil, err
+		}
+		oldFormat, err := m.OldFormat(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		changed := len(m.ImagesIDs()) > 0 || len(m.RemovedImagesIDs()) > 0 || m.ImagesCleared()
+		if v, ok := m.Content(); ok && v != oldContent {
+			changed = true
+		}
+		if v, ok := m.Format(); ok && v != oldFormat {
+			changed = true
+		}
+		if v, ok := m.Amount(); ok && (oldAmount == nil || *oldAmount != v) {
+			changed = true
+		}
+		if m.AmountCleared() && oldAmount != nil {
+			changed = true
+		}
+		if !changed {
+			return next.Mutate(ctx, m)
+		}
+
+		oldEditCount, err := m.OldEditCount(ctx)
+		if err != nil {
+			return nil, err
+		}
+		oldUpdatedAt, err := m.OldUpdatedAt(ctx)
+		if err != nil {
+			return nil, err
+		}
+		imageIDs, err := m.Client().Image.Query().
+			Where(image.HasPostWith(post.ID(id))).
+			Order(gen.Asc(image.FieldCreatedAt), gen.Asc(image.FieldID)).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.Client().PostRevision.Create().
+			SetPostID(id).
+			SetRevision(oldEditCount + 1).
+			SetContent(oldContent).
+			SetFormat(postrevision.Format(oldFormat)).
+			SetNillableAmount(oldAmount).
+			SetImageIds(slices.Clip(imageIDs)).
+			SetCreatedAt(oldUpdatedAt).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		m.AddEditCount(1)
+		return next.Mutate(ctx, m)
+	})
+}
+
+// extractPostEntities は本文が保存されるときに、メンションとハッシュタグを取り出してエッジとして保存します。
+// メンションはハンドルが一致するユーザーのみ、ハッシュタグは未登録であれば作成します。
+func extractPostEntities(next ent.Mutator) ent.Mutator {
+	return hook.PostFunc(func(ctx context.Context, m *gen.PostMutation) (ent.Value, error) {
+		content, ok := m.Content()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+		entities := richtext.Parse(content)
+
+		var userIDs []uuid.UUID
+		if handles := richtext.Values(entities, richtext.EntityMention); len(handles) > 0 {
+			ids, err := m.Client().User.Query().
+				Where(user.HandleIn(handles...)).
+				IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			userIDs = ids
+		}
+
+		var hashtagIDs []uuid.UUID
+		if names := richtext.Values(entities, richtext.EntityHashtag); len(names) > 0 {
+			builders := make([]*gen.HashtagCreate, 0, len(names))
+			for _, name := range names {
+				builders = append(builders, m.Client().Hashtag.Create().SetName(name))
+			}
+			// 同時に同じタグが作成されても一意制約で失敗しないようにする
+			err := m.Client().Hashtag.CreateBulk(builders...).
+				OnConflictColumns(hashtag.FieldName).
+				Ignore().
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+			ids, err := m.Client().Hashtag.Query().
+				Where(hashtag.NameIn(names...)).
+				IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			hashtagIDs = ids
+		}
+
+		if m.Op().Is(ent.OpUpdateOne) {
+			m.ClearMentions()
+			m.ClearHashtags()
+		}
+		m.AddMentionIDs(userIDs...)
+		m.AddHashtagIDs(hashtagIDs...)
+		return next.Mutate(ctx, m)
+	})
+}
+
+// indexPostSearchVector は本文が設定されたときに、全文検索用の字句を更新します。
+func indexPostSearchVector(next ent.Mutator) ent.Mutator {
+	return hook.PostFunc(func(ctx context.Context, m *gen.PostMutation) (ent.Value, error) {
+		if content, ok := m.Content(); ok {
+			m.SetSearchVector(search.Vector(content))
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// hashPostContent は本文が設定されたときに、迷惑行為フィルタで比較する本文のハッシュを更新します。
+func hashPostContent(next ent.Mutator) ent.Mutator {
+	return hook.PostFunc(func(ctx context.Context, m *gen.PostMutation) (ent.Value, error) {
+		if content, ok := m.Content(); ok {
+			m.SetContentHash(spamfilter.ContentHash(content))
+		}
+		return next.Mutate(ctx, m)
+	})
+}
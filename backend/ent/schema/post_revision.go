@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PostRevision holds the schema definition for the PostRevision entity.
+// 投稿が編集される直前の内容のスナップショットです。
+type PostRevision struct {
+	ent.Schema
+}
+
+// Fields of the PostRevision.
+func (PostRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		// 版番号 (最初の投稿内容が1、編集のたびに1ずつ増える)
+		field.Int("revision").
+			Positive().
+			Immutable(),
+		field.String("content").
+			Immutable(),
+		field.Enum("format").
+			Values("plain", "markdown").
+			Default("plain").
+			Immutable(),
+		field.Float("amount").
+			Optional().
+			Nillable().
+			Immutable(),
+		// この版で添付されていた画像のID (表示順)
+		field.JSON("image_ids", []uuid.UUID{}).
+			Optional().
+			Immutable(),
+		// この版が書かれた日時
+		field.Time("created_at").
+			Immutable(),
+		// 次の版に置き換えられた日時
+		field.Time("replaced_at").
+			Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the PostRevision.
+func (PostRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		// PostRevision -> Post (多対1)
+		edge.From("post", Post.Type).
+			Ref("revisions").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PostRevision.
+func (PostRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		// 1投稿内で版番号が重複しないための複合ユニーク制約
+		index.Fields("revision").
+			Edges("post").
+			Unique(),
+	}
+}
@@ -3,6 +3,8 @@ package schema
 import (
 	"time"
 
+	"backend/ent/hook"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -20,6 +22,12 @@ func (Reaction) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).Immutable().Unique(),
+		// リアクションの種類 (例: "clap", "fire")。使用できる種類はサーバー設定で決まる
+		// 既存のいいねは "clap" として扱う
+		field.String("kind").
+			Default("clap").
+			NotEmpty().
+			Immutable(),
 		field.Time("created_at").
 			Default(time.Now).Immutable(),
 	}
@@ -44,8 +52,19 @@ func (Reaction) Edges() []ent.Edge {
 // Indexes of the Reaction.
 func (Reaction) Indexes() []ent.Index {
 	return []ent.Index{
-		// 1ユーザー1投稿につき1リアクションの複合ユニーク制約
-		index.Edges("user", "post").
+		// 1ユーザー・1投稿・1種類につき1リアクションの複合ユニーク制約
+		index.Fields("kind").
+			Edges("user", "post").
 			Unique(),
+		// 投稿別・種類別の集計用
+		index.Fields("kind").
+			Edges("post"),
+	}
+}
+
+// Hooks of the Reaction.
+func (Reaction) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(recordReactionEvents, ent.OpCreate|ent.OpDelete|ent.OpDeleteOne),
 	}
 }
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ReminderLog holds the schema definition for the ReminderLog entity.
+type ReminderLog struct {
+	ent.Schema
+}
+
+// Fields of the ReminderLog.
+func (ReminderLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		// 通知した期限のしきい値 (7日前 / 前日 / 当日 / 期限切れ)
+		field.Enum("threshold").
+			Values("week", "day", "today", "overdue").
+			Immutable(),
+		// 通知を送信した日時
+		field.Time("sent_at").
+			Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ReminderLog.
+func (ReminderLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		// ReminderLog -> Goal (多対1)
+		edge.From("goal", Goal.Type).
+			Ref("reminder_logs").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ReminderLog.
+func (ReminderLog) Indexes() []ent.Index {
+	return []ent.Index{
+		// 1目標・1しきい値につき1回だけ通知するための複合ユニーク制約
+		index.Fields("threshold").
+			Edges("goal").
+			Unique(),
+	}
+}
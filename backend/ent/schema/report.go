@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Report holds the schema definition for the Report entity.
+// ユーザーが不適切な投稿・コメント・プロフィールを運営に報告したもの、
+// または迷惑行為フィルタが確認のために保留にしたものです。
+// 対象は種類とIDで指定し、対象が削除されても報告は残ります。
+type Report struct {
+	ent.Schema
+}
+
+// Fields of the Report.
+func (Report) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		// 報告の対象の種類 (user はプロフィール)
+		field.Enum("target_type").
+			Values("post", "comment", "user").
+			Immutable(),
+		field.UUID("target_id", uuid.UUID{}).
+			Immutable(),
+		// 報告の経路 (user: ユーザーからの報告、filter: 迷惑行為フィルタによる保留)
+		field.Enum("source").
+			Values("user", "filter").
+			Default("user").
+			Immutable(),
+		// 報告の理由の分類
+		field.Enum("reason").
+			Values("spam", "harassment", "hate", "violence", "sexual", "misinformation", "other").
+			Immutable(),
+		// 報告者が書いた詳細 (フィルタによる保留の場合は判定した規則と理由)
+		field.String("text").
+			Optional().
+			MaxRuneLen(1000).
+			Immutable(),
+		// 対応状況 (open: 未対応、claimed: 担当者が対応中、resolved: 対応済み)
+		field.Enum("status").
+			Values("open", "claimed", "resolved").
+			Default("open"),
+		// 対応結果 (action_taken: 対象に措置を行った、dismissed: 措置は不要と判断した)
+		field.Enum("resolution").
+			Values("action_taken", "dismissed").
+			Optional().
+			Nillable(),
+		// 対応結果についての担当者のメモ
+		field.String("resolution_note").
+			Optional().
+			MaxRuneLen(1000),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+		field.Time("claimed_at").
+			Optional().
+			Nillable(),
+		field.Time("resolved_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Report.
+func (Report) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Report -> User (報告者、多対1。フィルタによる保留の場合は未設定)
+		edge.From("reporter", User.Type).
+			Ref("reports").
+			Unique().
+			Immutable(),
+		// Report -> User (担当のモデレーター、多対1、任意)
+		edge.From("moderator", User.Type).
+			Ref("claimed_reports").
+			Unique(),
+		// Report -> ModerationAction (1対多)
+		edge.To("actions", ModerationAction.Type),
+	}
+}
+
+// Indexes of the Report.
+func (Report) Indexes() []ent.Index {
+	return []ent.Index{
+		// 1ユーザーにつき1つの対象を1回だけ報告できる
+		index.Fields("target_type", "target_id").
+			Edges("reporter").
+			Unique(),
+		// 対応状況別の一覧を古い順に取得する用
+		index.Fields("status", "created_at"),
+	}
+}
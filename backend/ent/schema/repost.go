@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Repost holds the schema definition for the Repost entity.
+// 他のユーザーの投稿を、コメントを付けずに自分のフォロワーへ共有したものです。
+// コメントを付けて共有する場合は、引用元を参照する投稿 (引用投稿) として作成します。
+type Repost struct {
+	ent.Schema
+}
+
+// Fields of the Repost.
+func (Repost) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Repost.
+func (Repost) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Repost -> User (多対1)
+		edge.From("user", User.Type).
+			Ref("reposts").
+			Unique().
+			Required(),
+		// Repost -> Post (多対1)
+		edge.From("post", Post.Type).
+			Ref("reposts").
+			Unique().
+			Required(),
+		// Repost -> TimelineEntry (1対多)
+		edge.To("timeline_entries", TimelineEntry.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Repost.
+func (Repost) Indexes() []ent.Index {
+	return []ent.Index{
+		// 1ユーザー・1投稿につき1リポストの複合ユニーク制約
+		index.Edges("user", "post").
+			Unique(),
+		// 投稿別のリポスト数の集計用
+		index.Edges("post"),
+		// タイムライン降順取得用
+		index.Fields("created_at"),
+	}
+}
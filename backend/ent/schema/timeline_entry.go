@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TimelineEntry holds the schema definition for the TimelineEntry entity.
+// ホームタイムラインに現れる投稿・リポストを、タイムラインの持ち主ごとに書き込んでおいたものです。
+// 投稿・リポストの作成時に作成者と各フォロワーの分を非同期に作成し、持ち主ごとに新しいものから一定件数までを保持します。
+// 保持していない古い範囲のタイムラインは、フォローと投稿を結合して求めます。
+type TimelineEntry struct {
+	ent.Schema
+}
+
+// Fields of the TimelineEntry.
+func (TimelineEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).Immutable().Unique(),
+		// タイムラインに現れた日時 (投稿の作成日時、またはリポストの日時)
+		field.Time("at").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the TimelineEntry.
+func (TimelineEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		// TimelineEntry -> User (タイムラインの持ち主、多対1)
+		edge.From("user", User.Type).
+			Ref("timeline_entries").
+			Unique().
+			Required().
+			Immutable(),
+		// TimelineEntry -> User (投稿またはリポストしたユーザー、多対1。フォロー解除時の削除に使う)
+		edge.From("actor", User.Type).
+			Ref("timeline_activities").
+			Unique().
+			Required().
+			Immutable(),
+		// TimelineEntry -> Post (多対1)
+		edge.From("post", Post.Type).
+			Ref("timeline_entries").
+			Unique().
+			Required().
+			Immutable(),
+		// TimelineEntry -> Repost (リポストによる場合、多対1、任意)
+		edge.From("repost", Repost.Type).
+			Ref("timeline_entries").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the TimelineEntry.
+func (TimelineEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		// 1人のタイムラインに同じ投稿・リポストを重複して書き込まないための複合ユニーク制約
+		// (投稿は作成者、リポストはリポストしたユーザーをactorとし、1ユーザー・1投稿につき1リポストのため一意になる)
+		index.Edges("user", "post", "actor").
+			Unique(),
+		// タイムライン降順取得・件数の上限を超えた古いものの削除用
+		index.Fields("at").
+			Edges("user"),
+		// フォロー解除時の削除用
+		index.Edges("user", "actor"),
+	}
+}
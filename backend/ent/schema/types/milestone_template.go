@@ -0,0 +1,10 @@
+// Package types はentのJSONフィールドで使用する型を定義します。
+// 生成コードから参照されるため、schemaパッケージとは分けています。
+package types
+
+// MilestoneTemplate はテンプレートに含まれるマイルストーンの雛形です。
+type MilestoneTemplate struct {
+	Title string `json:"title" yaml:"title"`
+	// OffsetDays は目標開始日から何日後を期日とするかを表します。
+	OffsetDays int `json:"offset_days" yaml:"offset_days"`
+}
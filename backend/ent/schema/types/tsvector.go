@@ -0,0 +1,30 @@
+package types
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// TSVector はPostgreSQLのtsvector型の値をテキスト表現 (例: 'ab' 'bc') で保持します。
+// 字句への分割はアプリケーション側で行い、PostgreSQLの辞書による正規化は使いません。
+type TSVector string
+
+// Value はtsvectorのテキスト表現をデータベースに渡します。
+func (v TSVector) Value() (driver.Value, error) {
+	return string(v), nil
+}
+
+// Scan はデータベースから読み込んだtsvectorのテキスト表現を設定します。
+func (v *TSVector) Scan(src any) error {
+	switch s := src.(type) {
+	case nil:
+		*v = ""
+	case string:
+		*v = TSVector(s)
+	case []byte:
+		*v = TSVector(s)
+	default:
+		return fmt.Errorf("unexpected type %T for tsvector", src)
+	}
+	return nil
+}
@@ -1,9 +1,13 @@
 package schema
 
 import (
+	"regexp"
 	"time"
 
+	"backend/ent/hook"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -24,6 +28,12 @@ func (User) Fields() []ent.Field {
 		field.String("email").
 			NotEmpty().
 			Unique(),
+		// メンション用のハンドル (例: "taro"。英小文字・数字・アンダースコア)
+		field.String("handle").
+			Optional().
+			Nillable().
+			Unique().
+			Match(regexp.MustCompile(`^[a-z0-9_]{1,30}$`)),
 		field.Time("birthday").
 			Optional().
 			Nillable(),
@@ -33,9 +43,46 @@ func (User) Fields() []ent.Field {
 		field.String("bio").
 			Optional().
 			Nillable(),
+		// 権限 (moderator は報告の対応などのモデレーション、admin はそれに加えてテンプレートなどの管理操作が可能)
+		field.Enum("role").
+			Values("user", "moderator", "admin").
+			Default("user"),
 		field.UUID("profile_picture_id", uuid.UUID{}).
 			Optional().
 			Nillable(),
+		// IANAタイムゾーン名 (例: "Asia/Tokyo")
+		field.String("time_zone").
+			Default("Asia/Tokyo"),
+		// 通知を控える時間帯の開始時刻 (ローカル時刻の時、0-23)
+		field.Int("quiet_hours_start").
+			Optional().
+			Nillable().
+			Range(0, 23),
+		// 通知を控える時間帯の終了時刻 (ローカル時刻の時、0-23)
+		field.Int("quiet_hours_end").
+			Optional().
+			Nillable().
+			Range(0, 23),
+		// 運営によりプロフィールが非表示にされた日時 (本人以外には名前以外のプロフィールを表示しない)
+		field.Time("hidden_at").
+			Optional().
+			Nillable(),
+		// 運営によりアカウントが停止された日時 (停止中は認証が必要な操作を行えない)
+		field.Time("suspended_at").
+			Optional().
+			Nillable(),
+		// アカウント停止の終了日時 (未設定の場合は無期限)
+		field.Time("suspended_until").
+			Optional().
+			Nillable(),
+		// この日時より新しいホームタイムラインはTimelineEntryに揃っている
+		// (未設定の場合はまだ作成していないため、フォローと投稿を結合して求める)
+		field.Time("timeline_horizon").
+			Optional().
+			Nillable(),
+		// 受け取らない通知の種類 (例: ["reaction", "follow"])
+		field.Strings("notification_opt_outs").
+			Optional(),
 		field.Time("created_at").
 			Default(time.Now).Immutable(),
 
@@ -52,16 +99,65 @@ func (User) Edges() []ent.Edge {
 		edge.To("genres", Genre.Type),
 		// User -> Goal (1対多)
 		edge.To("goals", Goal.Type),
+		// User -> GoalParticipant (参加している目標、1対多)
+		edge.To("goal_participations", GoalParticipant.Type),
 		// User -> Post (1対多)
 		edge.To("posts", Post.Type),
 		// User -> Reaction (1対多)
 		edge.To("reactions", Reaction.Type),
+		// User -> Comment (1対多)
+		edge.To("comments", Comment.Type),
+		// User -> Repost (1対多)
+		edge.To("reposts", Repost.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// User -> Bookmark (1対多)
+		edge.To("bookmarks", Bookmark.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// User -> BookmarkCollection (1対多)
+		edge.To("bookmark_collections", BookmarkCollection.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// User -> Report (報告した内容、1対多)
+		edge.To("reports", Report.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// User -> Report (モデレーターとして担当している報告、1対多)
+		edge.To("claimed_reports", Report.Type),
+		// User -> ModerationAction (モデレーターとして行った操作、1対多)
+		edge.To("moderation_actions", ModerationAction.Type),
+		// User -> Post (メンションされた投稿、多対多、逆参照)
+		edge.From("mentioned_in", Post.Type).
+			Ref("mentions"),
 		// User -> Image (アップロードした画像、1対多)
 		edge.To("uploaded_images", Image.Type),
 		// User -> RefreshToken (1対多)
 		edge.To("refresh_tokens", RefreshToken.Type),
+		// User -> TimelineEntry (ホームタイムライン、1対多)
+		edge.To("timeline_entries", TimelineEntry.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// User -> TimelineEntry (自分の投稿・リポストにより他のユーザーのタイムラインに書き込まれたもの、1対多)
+		edge.To("timeline_activities", TimelineEntry.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// User -> Notification (受け取った通知、1対多)
+		edge.To("notifications", Notification.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// User -> Notification (最後に操作をした通知、1対多)
+		edge.To("latest_notifications", Notification.Type),
+		// User -> Notification (まとめた通知のきっかけとなった操作をした通知、多対多)
+		edge.To("acted_notifications", Notification.Type),
+		// User -> NotificationDelivery (通知一覧に反映したドメインイベント、1対多)
+		edge.To("notification_deliveries", NotificationDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 		// フォロー関係 (一方通行)
 		edge.To("following", User.Type).
 			From("followers"),
+		// ブロック関係 (一方通行。どちらかがブロックしていれば互いの投稿・コメントを表示しない)
+		edge.To("blocking", User.Type).
+			From("blocked_by"),
+	}
+}
+
+// Hooks of the User.
+func (User) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(recordFollowEvents, ent.OpCreate|ent.OpUpdateOne|ent.OpUpdate),
 	}
 }
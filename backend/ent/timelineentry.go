@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/post"
+	"backend/ent/repost"
+	"backend/ent/timelineentry"
+	"backend/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TimelineEntry is the model entity for the TimelineEntry schema.
+type TimelineEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// At holds the value of the "at" field.
+	At time.Time `json:"at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TimelineEntryQuery when eager-loading is set.
+	Edges                    TimelineEntryEdges `json:"edges"`
+	post_timeline_entries    *uuid.UUID
+	repost_timeline_entries  *uuid.UUID
+	user_timeline_entries    *uuid.UUID
+	user_timeline_activities *uuid.UUID
+	selectValues             sql.SelectValues
+}
+
+// TimelineEntryEdges holds the relations/edges for other nodes in the graph.
+type TimelineEntryEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Actor holds the value of the actor edge.
+	Actor *User `json:"actor,omitempty"`
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// Repost holds the value of the repost edge.
+	Repost *Repost `json:"repost,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TimelineEntryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ActorOrErr returns the Actor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TimelineEntryEdges) ActorOrErr() (*User, error) {
+	if e.Actor != nil {
+		return e.Actor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "actor"}
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TimelineEntryEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// RepostOrErr returns the Repost value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TimelineEntryEdges) RepostOrErr() (*Repost, error) {
+	if e.Repost != nil {
+		return e.Repost, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: repost.Label}
+	}
+	return nil, &NotLoadedError{edge: "repost"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TimelineEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case timelineentry.FieldAt, timelineentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case timelineentry.FieldID:
+			values[i] = new(uuid.UUID)
+		case timelineentry.ForeignKeys[0]: // post_timeline_entries
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case timelineentry.ForeignKeys[1]: // repost_timeline_entries
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case timelineentry.ForeignKeys[2]: // user_timeline_entries
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case timelineentry.ForeignKeys[3]: // user_timeline_activities
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TimelineEntry fields.
+func (_m *TimelineEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case timelineentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case timelineentry.FieldAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field at", values[i])
+			} else if value.Valid {
+				_m.At = value.Time
+			}
+		case timelineentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case timelineentry.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field post_timeline_entries", values[i])
+			} else if value.Valid {
+				_m.post_timeline_entries = new(uuid.UUID)
+				*_m.post_timeline_entries = *value.S.(*uuid.UUID)
+			}
+		case timelineentry.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field repost_timeline_entries", values[i])
+			} else if value.Valid {
+				_m.repost_timeline_entries = new(uuid.UUID)
+				*_m.repost_timeline_entries = *value.S.(*uuid.UUID)
+			}
+		case timelineentry.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_timeline_entries", values[i])
+			} else if value.Valid {
+				_m.user_timeline_entries = new(uuid.UUID)
+				*_m.user_timeline_entries = *value.S.(*uuid.UUID)
+			}
+		case timelineentry.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_timeline_activities", values[i])
+			} else if value.Valid {
+				_m.user_timeline_activities = new(uuid.UUID)
+				*_m.user_timeline_activities = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TimelineEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *TimelineEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the TimelineEntry entity.
+func (_m *TimelineEntry) QueryUser() *UserQuery {
+	return NewTimelineEntryClient(_m.config).QueryUser(_m)
+}
+
+// QueryActor queries the "actor" edge of the TimelineEntry entity.
+func (_m *TimelineEntry) QueryActor() *UserQuery {
+	return NewTimelineEntryClient(_m.config).QueryActor(_m)
+}
+
+// QueryPost queries the "post" edge of the TimelineEntry entity.
+func (_m *TimelineEntry) QueryPost() *PostQuery {
+	return NewTimelineEntryClient(_m.config).QueryPost(_m)
+}
+
+// QueryRepost queries the "repost" edge of the TimelineEntry entity.
+func (_m *TimelineEntry) QueryRepost() *RepostQuery {
+	return NewTimelineEntryClient(_m.config).QueryRepost(_m)
+}
+
+// Update returns a builder for updating this TimelineEntry.
+// Note that you need to call TimelineEntry.Unwrap() before calling this method if this TimelineEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TimelineEntry) Update() *TimelineEntryUpdateOne {
+	return NewTimelineEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TimelineEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TimelineEntry) Unwrap() *TimelineEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TimelineEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TimelineEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("TimelineEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("at=")
+	builder.WriteString(_m.At.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TimelineEntries is a parsable slice of TimelineEntry.
+type TimelineEntries []*TimelineEntry
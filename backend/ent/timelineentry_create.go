@@ -0,0 +1,638 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/post"
+	"backend/ent/repost"
+	"backend/ent/timelineentry"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TimelineEntryCreate is the builder for creating a TimelineEntry entity.
+type TimelineEntryCreate struct {
+	config
+	mutation *TimelineEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAt sets the "at" field.
+func (_c *TimelineEntryCreate) SetAt(v time.Time) *TimelineEntryCreate {
+	_c.mutation.SetAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TimelineEntryCreate) SetCreatedAt(v time.Time) *TimelineEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TimelineEntryCreate) SetNillableCreatedAt(v *time.Time) *TimelineEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TimelineEntryCreate) SetID(v uuid.UUID) *TimelineEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TimelineEntryCreate) SetNillableID(v *uuid.UUID) *TimelineEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *TimelineEntryCreate) SetUserID(id uuid.UUID) *TimelineEntryCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *TimelineEntryCreate) SetUser(v *User) *TimelineEntryCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetActorID sets the "actor" edge to the User entity by ID.
+func (_c *TimelineEntryCreate) SetActorID(id uuid.UUID) *TimelineEntryCreate {
+	_c.mutation.SetActorID(id)
+	return _c
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (_c *TimelineEntryCreate) SetActor(v *User) *TimelineEntryCreate {
+	return _c.SetActorID(v.ID)
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (_c *TimelineEntryCreate) SetPostID(id uuid.UUID) *TimelineEntryCreate {
+	_c.mutation.SetPostID(id)
+	return _c
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (_c *TimelineEntryCreate) SetPost(v *Post) *TimelineEntryCreate {
+	return _c.SetPostID(v.ID)
+}
+
+// SetRepostID sets the "repost" edge to the Repost entity by ID.
+func (_c *TimelineEntryCreate) SetRepostID(id uuid.UUID) *TimelineEntryCreate {
+	_c.mutation.SetRepostID(id)
+	return _c
+}
+
+// SetNillableRepostID sets the "repost" edge to the Repost entity by ID if the given value is not nil.
+func (_c *TimelineEntryCreate) SetNillableRepostID(id *uuid.UUID) *TimelineEntryCreate {
+	if id != nil {
+		_c = _c.SetRepostID(*id)
+	}
+	return _c
+}
+
+// SetRepost sets the "repost" edge to the Repost entity.
+func (_c *TimelineEntryCreate) SetRepost(v *Repost) *TimelineEntryCreate {
+	return _c.SetRepostID(v.ID)
+}
+
+// Mutation returns the TimelineEntryMutation object of the builder.
+func (_c *TimelineEntryCreate) Mutation() *TimelineEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the TimelineEntry in the database.
+func (_c *TimelineEntryCreate) Save(ctx context.Context) (*TimelineEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TimelineEntryCreate) SaveX(ctx context.Context) *TimelineEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TimelineEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TimelineEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TimelineEntryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := timelineentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := timelineentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TimelineEntryCreate) check() error {
+	if _, ok := _c.mutation.At(); !ok {
+		return &ValidationError{Name: "at", err: errors.New(`ent: missing required field "TimelineEntry.at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TimelineEntry.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TimelineEntry.user"`)}
+	}
+	if len(_c.mutation.ActorIDs()) == 0 {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required edge "TimelineEntry.actor"`)}
+	}
+	if len(_c.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "TimelineEntry.post"`)}
+	}
+	return nil
+}
+
+func (_c *TimelineEntryCreate) sqlSave(ctx context.Context) (*TimelineEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TimelineEntryCreate) createSpec() (*TimelineEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TimelineEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(timelineentry.Table, sqlgraph.NewFieldSpec(timelineentry.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.At(); ok {
+		_spec.SetField(timelineentry.FieldAt, field.TypeTime, value)
+		_node.At = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(timelineentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timelineentry.UserTable,
+			Columns: []string{timelineentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_timeline_entries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timelineentry.ActorTable,
+			Columns: []string{timelineentry.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_timeline_activities = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timelineentry.PostTable,
+			Columns: []string{timelineentry.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_timeline_entries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RepostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timelineentry.RepostTable,
+			Columns: []string{timelineentry.RepostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repost.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.repost_timeline_entries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TimelineEntry.Create().
+//		SetAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TimelineEntryUpsert) {
+//			SetAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TimelineEntryCreate) OnConflict(opts ...sql.ConflictOption) *TimelineEntryUpsertOne {
+	_c.conflict = opts
+	return &TimelineEntryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TimelineEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TimelineEntryCreate) OnConflictColumns(columns ...string) *TimelineEntryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TimelineEntryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TimelineEntryUpsertOne is the builder for "upsert"-ing
+	//  one TimelineEntry node.
+	TimelineEntryUpsertOne struct {
+		create *TimelineEntryCreate
+	}
+
+	// TimelineEntryUpsert is the "OnConflict" setter.
+	TimelineEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TimelineEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(timelineentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TimelineEntryUpsertOne) UpdateNewValues() *TimelineEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(timelineentry.FieldID)
+		}
+		if _, exists := u.create.mutation.At(); exists {
+			s.SetIgnore(timelineentry.FieldAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(timelineentry.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TimelineEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TimelineEntryUpsertOne) Ignore() *TimelineEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TimelineEntryUpsertOne) DoNothing() *TimelineEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TimelineEntryCreate.OnConflict
+// documentation for more info.
+func (u *TimelineEntryUpsertOne) Update(set func(*TimelineEntryUpsert)) *TimelineEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TimelineEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TimelineEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TimelineEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TimelineEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TimelineEntryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TimelineEntryUpsertOne.ID is not supported by MySQL driver. Use TimelineEntryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TimelineEntryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TimelineEntryCreateBulk is the builder for creating many TimelineEntry entities in bulk.
+type TimelineEntryCreateBulk struct {
+	config
+	err      error
+	builders []*TimelineEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TimelineEntry entities in the database.
+func (_c *TimelineEntryCreateBulk) Save(ctx context.Context) ([]*TimelineEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TimelineEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TimelineEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TimelineEntryCreateBulk) SaveX(ctx context.Context) []*TimelineEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TimelineEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TimelineEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TimelineEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TimelineEntryUpsert) {
+//			SetAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TimelineEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *TimelineEntryUpsertBulk {
+	_c.conflict = opts
+	return &TimelineEntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TimelineEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TimelineEntryCreateBulk) OnConflictColumns(columns ...string) *TimelineEntryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TimelineEntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// TimelineEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of TimelineEntry nodes.
+type TimelineEntryUpsertBulk struct {
+	create *TimelineEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TimelineEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(timelineentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TimelineEntryUpsertBulk) UpdateNewValues() *TimelineEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(timelineentry.FieldID)
+			}
+			if _, exists := b.mutation.At(); exists {
+				s.SetIgnore(timelineentry.FieldAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(timelineentry.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TimelineEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TimelineEntryUpsertBulk) Ignore() *TimelineEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TimelineEntryUpsertBulk) DoNothing() *TimelineEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TimelineEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *TimelineEntryUpsertBulk) Update(set func(*TimelineEntryUpsert)) *TimelineEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TimelineEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TimelineEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TimelineEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TimelineEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TimelineEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -12,18 +12,52 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Bookmark is the client for interacting with the Bookmark builders.
+	Bookmark *BookmarkClient
+	// BookmarkCollection is the client for interacting with the BookmarkCollection builders.
+	BookmarkCollection *BookmarkCollectionClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
 	// Genre is the client for interacting with the Genre builders.
 	Genre *GenreClient
 	// Goal is the client for interacting with the Goal builders.
 	Goal *GoalClient
+	// GoalParticipant is the client for interacting with the GoalParticipant builders.
+	GoalParticipant *GoalParticipantClient
+	// GoalTemplate is the client for interacting with the GoalTemplate builders.
+	GoalTemplate *GoalTemplateClient
+	// Hashtag is the client for interacting with the Hashtag builders.
+	Hashtag *HashtagClient
 	// Image is the client for interacting with the Image builders.
 	Image *ImageClient
+	// LinkPreview is the client for interacting with the LinkPreview builders.
+	LinkPreview *LinkPreviewClient
+	// Milestone is the client for interacting with the Milestone builders.
+	Milestone *MilestoneClient
+	// ModerationAction is the client for interacting with the ModerationAction builders.
+	ModerationAction *ModerationActionClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
+	NotificationDelivery *NotificationDeliveryClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
 	// Reaction is the client for interacting with the Reaction builders.
 	Reaction *ReactionClient
 	// RefreshToken is the client for interacting with the RefreshToken builders.
 	RefreshToken *RefreshTokenClient
+	// ReminderLog is the client for interacting with the ReminderLog builders.
+	ReminderLog *ReminderLogClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
+	// Repost is the client for interacting with the Repost builders.
+	Repost *RepostClient
+	// TimelineEntry is the client for interacting with the TimelineEntry builders.
+	TimelineEntry *TimelineEntryClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -157,12 +191,29 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Bookmark = NewBookmarkClient(tx.config)
+	tx.BookmarkCollection = NewBookmarkCollectionClient(tx.config)
+	tx.Comment = NewCommentClient(tx.config)
 	tx.Genre = NewGenreClient(tx.config)
 	tx.Goal = NewGoalClient(tx.config)
+	tx.GoalParticipant = NewGoalParticipantClient(tx.config)
+	tx.GoalTemplate = NewGoalTemplateClient(tx.config)
+	tx.Hashtag = NewHashtagClient(tx.config)
 	tx.Image = NewImageClient(tx.config)
+	tx.LinkPreview = NewLinkPreviewClient(tx.config)
+	tx.Milestone = NewMilestoneClient(tx.config)
+	tx.ModerationAction = NewModerationActionClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationDelivery = NewNotificationDeliveryClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
+	tx.PostRevision = NewPostRevisionClient(tx.config)
 	tx.Reaction = NewReactionClient(tx.config)
 	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.ReminderLog = NewReminderLogClient(tx.config)
+	tx.Report = NewReportClient(tx.config)
+	tx.Repost = NewRepostClient(tx.config)
+	tx.TimelineEntry = NewTimelineEntryClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -173,7 +224,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Genre.QueryXXX(), the query will be executed
+// applies a query, for example: Bookmark.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"backend/ent/user"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -22,14 +23,34 @@ type User struct {
 	Name string `json:"name,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
+	// Handle holds the value of the "handle" field.
+	Handle *string `json:"handle,omitempty"`
 	// Birthday holds the value of the "birthday" field.
 	Birthday *time.Time `json:"birthday,omitempty"`
 	// Hometown holds the value of the "hometown" field.
 	Hometown *string `json:"hometown,omitempty"`
 	// Bio holds the value of the "bio" field.
 	Bio *string `json:"bio,omitempty"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
 	// ProfilePictureID holds the value of the "profile_picture_id" field.
 	ProfilePictureID *uuid.UUID `json:"profile_picture_id,omitempty"`
+	// TimeZone holds the value of the "time_zone" field.
+	TimeZone string `json:"time_zone,omitempty"`
+	// QuietHoursStart holds the value of the "quiet_hours_start" field.
+	QuietHoursStart *int `json:"quiet_hours_start,omitempty"`
+	// QuietHoursEnd holds the value of the "quiet_hours_end" field.
+	QuietHoursEnd *int `json:"quiet_hours_end,omitempty"`
+	// HiddenAt holds the value of the "hidden_at" field.
+	HiddenAt *time.Time `json:"hidden_at,omitempty"`
+	// SuspendedAt holds the value of the "suspended_at" field.
+	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
+	// SuspendedUntil holds the value of the "suspended_until" field.
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"`
+	// TimelineHorizon holds the value of the "timeline_horizon" field.
+	TimelineHorizon *time.Time `json:"timeline_horizon,omitempty"`
+	// NotificationOptOuts holds the value of the "notification_opt_outs" field.
+	NotificationOptOuts []string `json:"notification_opt_outs,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -46,21 +67,55 @@ type UserEdges struct {
 	Genres []*Genre `json:"genres,omitempty"`
 	// Goals holds the value of the goals edge.
 	Goals []*Goal `json:"goals,omitempty"`
+	// GoalParticipations holds the value of the goal_participations edge.
+	GoalParticipations []*GoalParticipant `json:"goal_participations,omitempty"`
 	// Posts holds the value of the posts edge.
 	Posts []*Post `json:"posts,omitempty"`
 	// Reactions holds the value of the reactions edge.
 	Reactions []*Reaction `json:"reactions,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// Reposts holds the value of the reposts edge.
+	Reposts []*Repost `json:"reposts,omitempty"`
+	// Bookmarks holds the value of the bookmarks edge.
+	Bookmarks []*Bookmark `json:"bookmarks,omitempty"`
+	// BookmarkCollections holds the value of the bookmark_collections edge.
+	BookmarkCollections []*BookmarkCollection `json:"bookmark_collections,omitempty"`
+	// Reports holds the value of the reports edge.
+	Reports []*Report `json:"reports,omitempty"`
+	// ClaimedReports holds the value of the claimed_reports edge.
+	ClaimedReports []*Report `json:"claimed_reports,omitempty"`
+	// ModerationActions holds the value of the moderation_actions edge.
+	ModerationActions []*ModerationAction `json:"moderation_actions,omitempty"`
+	// MentionedIn holds the value of the mentioned_in edge.
+	MentionedIn []*Post `json:"mentioned_in,omitempty"`
 	// UploadedImages holds the value of the uploaded_images edge.
 	UploadedImages []*Image `json:"uploaded_images,omitempty"`
 	// RefreshTokens holds the value of the refresh_tokens edge.
 	RefreshTokens []*RefreshToken `json:"refresh_tokens,omitempty"`
+	// TimelineEntries holds the value of the timeline_entries edge.
+	TimelineEntries []*TimelineEntry `json:"timeline_entries,omitempty"`
+	// TimelineActivities holds the value of the timeline_activities edge.
+	TimelineActivities []*TimelineEntry `json:"timeline_activities,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// LatestNotifications holds the value of the latest_notifications edge.
+	LatestNotifications []*Notification `json:"latest_notifications,omitempty"`
+	// ActedNotifications holds the value of the acted_notifications edge.
+	ActedNotifications []*Notification `json:"acted_notifications,omitempty"`
+	// NotificationDeliveries holds the value of the notification_deliveries edge.
+	NotificationDeliveries []*NotificationDelivery `json:"notification_deliveries,omitempty"`
 	// Followers holds the value of the followers edge.
 	Followers []*User `json:"followers,omitempty"`
 	// Following holds the value of the following edge.
 	Following []*User `json:"following,omitempty"`
+	// BlockedBy holds the value of the blocked_by edge.
+	BlockedBy []*User `json:"blocked_by,omitempty"`
+	// Blocking holds the value of the blocking edge.
+	Blocking []*User `json:"blocking,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [25]bool
 }
 
 // GenresOrErr returns the Genres value or an error if the edge
@@ -81,10 +136,19 @@ func (e UserEdges) GoalsOrErr() ([]*Goal, error) {
 	return nil, &NotLoadedError{edge: "goals"}
 }
 
+// GoalParticipationsOrErr returns the GoalParticipations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GoalParticipationsOrErr() ([]*GoalParticipant, error) {
+	if e.loadedTypes[2] {
+		return e.GoalParticipations, nil
+	}
+	return nil, &NotLoadedError{edge: "goal_participations"}
+}
+
 // PostsOrErr returns the Posts value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) PostsOrErr() ([]*Post, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Posts, nil
 	}
 	return nil, &NotLoadedError{edge: "posts"}
@@ -93,16 +157,88 @@ func (e UserEdges) PostsOrErr() ([]*Post, error) {
 // ReactionsOrErr returns the Reactions value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ReactionsOrErr() ([]*Reaction, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		return e.Reactions, nil
 	}
 	return nil, &NotLoadedError{edge: "reactions"}
 }
 
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[5] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// RepostsOrErr returns the Reposts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RepostsOrErr() ([]*Repost, error) {
+	if e.loadedTypes[6] {
+		return e.Reposts, nil
+	}
+	return nil, &NotLoadedError{edge: "reposts"}
+}
+
+// BookmarksOrErr returns the Bookmarks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BookmarksOrErr() ([]*Bookmark, error) {
+	if e.loadedTypes[7] {
+		return e.Bookmarks, nil
+	}
+	return nil, &NotLoadedError{edge: "bookmarks"}
+}
+
+// BookmarkCollectionsOrErr returns the BookmarkCollections value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BookmarkCollectionsOrErr() ([]*BookmarkCollection, error) {
+	if e.loadedTypes[8] {
+		return e.BookmarkCollections, nil
+	}
+	return nil, &NotLoadedError{edge: "bookmark_collections"}
+}
+
+// ReportsOrErr returns the Reports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReportsOrErr() ([]*Report, error) {
+	if e.loadedTypes[9] {
+		return e.Reports, nil
+	}
+	return nil, &NotLoadedError{edge: "reports"}
+}
+
+// ClaimedReportsOrErr returns the ClaimedReports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ClaimedReportsOrErr() ([]*Report, error) {
+	if e.loadedTypes[10] {
+		return e.ClaimedReports, nil
+	}
+	return nil, &NotLoadedError{edge: "claimed_reports"}
+}
+
+// ModerationActionsOrErr returns the ModerationActions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ModerationActionsOrErr() ([]*ModerationAction, error) {
+	if e.loadedTypes[11] {
+		return e.ModerationActions, nil
+	}
+	return nil, &NotLoadedError{edge: "moderation_actions"}
+}
+
+// MentionedInOrErr returns the MentionedIn value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MentionedInOrErr() ([]*Post, error) {
+	if e.loadedTypes[12] {
+		return e.MentionedIn, nil
+	}
+	return nil, &NotLoadedError{edge: "mentioned_in"}
+}
+
 // UploadedImagesOrErr returns the UploadedImages value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) UploadedImagesOrErr() ([]*Image, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[13] {
 		return e.UploadedImages, nil
 	}
 	return nil, &NotLoadedError{edge: "uploaded_images"}
@@ -111,16 +247,70 @@ func (e UserEdges) UploadedImagesOrErr() ([]*Image, error) {
 // RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) RefreshTokensOrErr() ([]*RefreshToken, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[14] {
 		return e.RefreshTokens, nil
 	}
 	return nil, &NotLoadedError{edge: "refresh_tokens"}
 }
 
+// TimelineEntriesOrErr returns the TimelineEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TimelineEntriesOrErr() ([]*TimelineEntry, error) {
+	if e.loadedTypes[15] {
+		return e.TimelineEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "timeline_entries"}
+}
+
+// TimelineActivitiesOrErr returns the TimelineActivities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TimelineActivitiesOrErr() ([]*TimelineEntry, error) {
+	if e.loadedTypes[16] {
+		return e.TimelineActivities, nil
+	}
+	return nil, &NotLoadedError{edge: "timeline_activities"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[17] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// LatestNotificationsOrErr returns the LatestNotifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LatestNotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[18] {
+		return e.LatestNotifications, nil
+	}
+	return nil, &NotLoadedError{edge: "latest_notifications"}
+}
+
+// ActedNotificationsOrErr returns the ActedNotifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ActedNotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[19] {
+		return e.ActedNotifications, nil
+	}
+	return nil, &NotLoadedError{edge: "acted_notifications"}
+}
+
+// NotificationDeliveriesOrErr returns the NotificationDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationDeliveriesOrErr() ([]*NotificationDelivery, error) {
+	if e.loadedTypes[20] {
+		return e.NotificationDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_deliveries"}
+}
+
 // FollowersOrErr returns the Followers value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) FollowersOrErr() ([]*User, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[21] {
 		return e.Followers, nil
 	}
 	return nil, &NotLoadedError{edge: "followers"}
@@ -129,12 +319,30 @@ func (e UserEdges) FollowersOrErr() ([]*User, error) {
 // FollowingOrErr returns the Following value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) FollowingOrErr() ([]*User, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[22] {
 		return e.Following, nil
 	}
 	return nil, &NotLoadedError{edge: "following"}
 }
 
+// BlockedByOrErr returns the BlockedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockedByOrErr() ([]*User, error) {
+	if e.loadedTypes[23] {
+		return e.BlockedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_by"}
+}
+
+// BlockingOrErr returns the Blocking value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlockingOrErr() ([]*User, error) {
+	if e.loadedTypes[24] {
+		return e.Blocking, nil
+	}
+	return nil, &NotLoadedError{edge: "blocking"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -142,9 +350,13 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldProfilePictureID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case user.FieldName, user.FieldEmail, user.FieldHometown, user.FieldBio:
+		case user.FieldNotificationOptOuts:
+			values[i] = new([]byte)
+		case user.FieldQuietHoursStart, user.FieldQuietHoursEnd:
+			values[i] = new(sql.NullInt64)
+		case user.FieldName, user.FieldEmail, user.FieldHandle, user.FieldHometown, user.FieldBio, user.FieldRole, user.FieldTimeZone:
 			values[i] = new(sql.NullString)
-		case user.FieldBirthday, user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldBirthday, user.FieldHiddenAt, user.FieldSuspendedAt, user.FieldSuspendedUntil, user.FieldTimelineHorizon, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -181,6 +393,13 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Email = value.String
 			}
+		case user.FieldHandle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handle", values[i])
+			} else if value.Valid {
+				_m.Handle = new(string)
+				*_m.Handle = value.String
+			}
 		case user.FieldBirthday:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field birthday", values[i])
@@ -202,6 +421,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.Bio = new(string)
 				*_m.Bio = value.String
 			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = user.Role(value.String)
+			}
 		case user.FieldProfilePictureID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field profile_picture_id", values[i])
@@ -209,6 +434,62 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.ProfilePictureID = new(uuid.UUID)
 				*_m.ProfilePictureID = *value.S.(*uuid.UUID)
 			}
+		case user.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				_m.TimeZone = value.String
+			}
+		case user.FieldQuietHoursStart:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quiet_hours_start", values[i])
+			} else if value.Valid {
+				_m.QuietHoursStart = new(int)
+				*_m.QuietHoursStart = int(value.Int64)
+			}
+		case user.FieldQuietHoursEnd:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quiet_hours_end", values[i])
+			} else if value.Valid {
+				_m.QuietHoursEnd = new(int)
+				*_m.QuietHoursEnd = int(value.Int64)
+			}
+		case user.FieldHiddenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field hidden_at", values[i])
+			} else if value.Valid {
+				_m.HiddenAt = new(time.Time)
+				*_m.HiddenAt = value.Time
+			}
+		case user.FieldSuspendedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_at", values[i])
+			} else if value.Valid {
+				_m.SuspendedAt = new(time.Time)
+				*_m.SuspendedAt = value.Time
+			}
+		case user.FieldSuspendedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_until", values[i])
+			} else if value.Valid {
+				_m.SuspendedUntil = new(time.Time)
+				*_m.SuspendedUntil = value.Time
+			}
+		case user.FieldTimelineHorizon:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timeline_horizon", values[i])
+			} else if value.Valid {
+				_m.TimelineHorizon = new(time.Time)
+				*_m.TimelineHorizon = value.Time
+			}
+		case user.FieldNotificationOptOuts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field notification_opt_outs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.NotificationOptOuts); err != nil {
+					return fmt.Errorf("unmarshal field notification_opt_outs: %w", err)
+				}
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -244,6 +525,11 @@ func (_m *User) QueryGoals() *GoalQuery {
 	return NewUserClient(_m.config).QueryGoals(_m)
 }
 
+// QueryGoalParticipations queries the "goal_participations" edge of the User entity.
+func (_m *User) QueryGoalParticipations() *GoalParticipantQuery {
+	return NewUserClient(_m.config).QueryGoalParticipations(_m)
+}
+
 // QueryPosts queries the "posts" edge of the User entity.
 func (_m *User) QueryPosts() *PostQuery {
 	return NewUserClient(_m.config).QueryPosts(_m)
@@ -254,6 +540,46 @@ func (_m *User) QueryReactions() *ReactionQuery {
 	return NewUserClient(_m.config).QueryReactions(_m)
 }
 
+// QueryComments queries the "comments" edge of the User entity.
+func (_m *User) QueryComments() *CommentQuery {
+	return NewUserClient(_m.config).QueryComments(_m)
+}
+
+// QueryReposts queries the "reposts" edge of the User entity.
+func (_m *User) QueryReposts() *RepostQuery {
+	return NewUserClient(_m.config).QueryReposts(_m)
+}
+
+// QueryBookmarks queries the "bookmarks" edge of the User entity.
+func (_m *User) QueryBookmarks() *BookmarkQuery {
+	return NewUserClient(_m.config).QueryBookmarks(_m)
+}
+
+// QueryBookmarkCollections queries the "bookmark_collections" edge of the User entity.
+func (_m *User) QueryBookmarkCollections() *BookmarkCollectionQuery {
+	return NewUserClient(_m.config).QueryBookmarkCollections(_m)
+}
+
+// QueryReports queries the "reports" edge of the User entity.
+func (_m *User) QueryReports() *ReportQuery {
+	return NewUserClient(_m.config).QueryReports(_m)
+}
+
+// QueryClaimedReports queries the "claimed_reports" edge of the User entity.
+func (_m *User) QueryClaimedReports() *ReportQuery {
+	return NewUserClient(_m.config).QueryClaimedReports(_m)
+}
+
+// QueryModerationActions queries the "moderation_actions" edge of the User entity.
+func (_m *User) QueryModerationActions() *ModerationActionQuery {
+	return NewUserClient(_m.config).QueryModerationActions(_m)
+}
+
+// QueryMentionedIn queries the "mentioned_in" edge of the User entity.
+func (_m *User) QueryMentionedIn() *PostQuery {
+	return NewUserClient(_m.config).QueryMentionedIn(_m)
+}
+
 // QueryUploadedImages queries the "uploaded_images" edge of the User entity.
 func (_m *User) QueryUploadedImages() *ImageQuery {
 	return NewUserClient(_m.config).QueryUploadedImages(_m)
@@ -264,6 +590,36 @@ func (_m *User) QueryRefreshTokens() *RefreshTokenQuery {
 	return NewUserClient(_m.config).QueryRefreshTokens(_m)
 }
 
+// QueryTimelineEntries queries the "timeline_entries" edge of the User entity.
+func (_m *User) QueryTimelineEntries() *TimelineEntryQuery {
+	return NewUserClient(_m.config).QueryTimelineEntries(_m)
+}
+
+// QueryTimelineActivities queries the "timeline_activities" edge of the User entity.
+func (_m *User) QueryTimelineActivities() *TimelineEntryQuery {
+	return NewUserClient(_m.config).QueryTimelineActivities(_m)
+}
+
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (_m *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(_m.config).QueryNotifications(_m)
+}
+
+// QueryLatestNotifications queries the "latest_notifications" edge of the User entity.
+func (_m *User) QueryLatestNotifications() *NotificationQuery {
+	return NewUserClient(_m.config).QueryLatestNotifications(_m)
+}
+
+// QueryActedNotifications queries the "acted_notifications" edge of the User entity.
+func (_m *User) QueryActedNotifications() *NotificationQuery {
+	return NewUserClient(_m.config).QueryActedNotifications(_m)
+}
+
+// QueryNotificationDeliveries queries the "notification_deliveries" edge of the User entity.
+func (_m *User) QueryNotificationDeliveries() *NotificationDeliveryQuery {
+	return NewUserClient(_m.config).QueryNotificationDeliveries(_m)
+}
+
 // QueryFollowers queries the "followers" edge of the User entity.
 func (_m *User) QueryFollowers() *UserQuery {
 	return NewUserClient(_m.config).QueryFollowers(_m)
@@ -274,6 +630,16 @@ func (_m *User) QueryFollowing() *UserQuery {
 	return NewUserClient(_m.config).QueryFollowing(_m)
 }
 
+// QueryBlockedBy queries the "blocked_by" edge of the User entity.
+func (_m *User) QueryBlockedBy() *UserQuery {
+	return NewUserClient(_m.config).QueryBlockedBy(_m)
+}
+
+// QueryBlocking queries the "blocking" edge of the User entity.
+func (_m *User) QueryBlocking() *UserQuery {
+	return NewUserClient(_m.config).QueryBlocking(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -303,6 +669,11 @@ func (_m *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(_m.Email)
 	builder.WriteString(", ")
+	if v := _m.Handle; v != nil {
+		builder.WriteString("handle=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.Birthday; v != nil {
 		builder.WriteString("birthday=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -318,11 +689,50 @@ func (_m *User) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
 	if v := _m.ProfilePictureID; v != nil {
 		builder.WriteString("profile_picture_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(_m.TimeZone)
+	builder.WriteString(", ")
+	if v := _m.QuietHoursStart; v != nil {
+		builder.WriteString("quiet_hours_start=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.QuietHoursEnd; v != nil {
+		builder.WriteString("quiet_hours_end=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.HiddenAt; v != nil {
+		builder.WriteString("hidden_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SuspendedAt; v != nil {
+		builder.WriteString("suspended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SuspendedUntil; v != nil {
+		builder.WriteString("suspended_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TimelineHorizon; v != nil {
+		builder.WriteString("timeline_horizon=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("notification_opt_outs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotificationOptOuts))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -3,8 +3,10 @@
 package user
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -19,14 +21,34 @@ const (
 	FieldName = "name"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldHandle holds the string denoting the handle field in the database.
+	FieldHandle = "handle"
 	// FieldBirthday holds the string denoting the birthday field in the database.
 	FieldBirthday = "birthday"
 	// FieldHometown holds the string denoting the hometown field in the database.
 	FieldHometown = "hometown"
 	// FieldBio holds the string denoting the bio field in the database.
 	FieldBio = "bio"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
 	// FieldProfilePictureID holds the string denoting the profile_picture_id field in the database.
 	FieldProfilePictureID = "profile_picture_id"
+	// FieldTimeZone holds the string denoting the time_zone field in the database.
+	FieldTimeZone = "time_zone"
+	// FieldQuietHoursStart holds the string denoting the quiet_hours_start field in the database.
+	FieldQuietHoursStart = "quiet_hours_start"
+	// FieldQuietHoursEnd holds the string denoting the quiet_hours_end field in the database.
+	FieldQuietHoursEnd = "quiet_hours_end"
+	// FieldHiddenAt holds the string denoting the hidden_at field in the database.
+	FieldHiddenAt = "hidden_at"
+	// FieldSuspendedAt holds the string denoting the suspended_at field in the database.
+	FieldSuspendedAt = "suspended_at"
+	// FieldSuspendedUntil holds the string denoting the suspended_until field in the database.
+	FieldSuspendedUntil = "suspended_until"
+	// FieldTimelineHorizon holds the string denoting the timeline_horizon field in the database.
+	FieldTimelineHorizon = "timeline_horizon"
+	// FieldNotificationOptOuts holds the string denoting the notification_opt_outs field in the database.
+	FieldNotificationOptOuts = "notification_opt_outs"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -35,18 +57,52 @@ const (
 	EdgeGenres = "genres"
 	// EdgeGoals holds the string denoting the goals edge name in mutations.
 	EdgeGoals = "goals"
+	// EdgeGoalParticipations holds the string denoting the goal_participations edge name in mutations.
+	EdgeGoalParticipations = "goal_participations"
 	// EdgePosts holds the string denoting the posts edge name in mutations.
 	EdgePosts = "posts"
 	// EdgeReactions holds the string denoting the reactions edge name in mutations.
 	EdgeReactions = "reactions"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeReposts holds the string denoting the reposts edge name in mutations.
+	EdgeReposts = "reposts"
+	// EdgeBookmarks holds the string denoting the bookmarks edge name in mutations.
+	EdgeBookmarks = "bookmarks"
+	// EdgeBookmarkCollections holds the string denoting the bookmark_collections edge name in mutations.
+	EdgeBookmarkCollections = "bookmark_collections"
+	// EdgeReports holds the string denoting the reports edge name in mutations.
+	EdgeReports = "reports"
+	// EdgeClaimedReports holds the string denoting the claimed_reports edge name in mutations.
+	EdgeClaimedReports = "claimed_reports"
+	// EdgeModerationActions holds the string denoting the moderation_actions edge name in mutations.
+	EdgeModerationActions = "moderation_actions"
+	// EdgeMentionedIn holds the string denoting the mentioned_in edge name in mutations.
+	EdgeMentionedIn = "mentioned_in"
 	// EdgeUploadedImages holds the string denoting the uploaded_images edge name in mutations.
 	EdgeUploadedImages = "uploaded_images"
 	// EdgeRefreshTokens holds the string denoting the refresh_tokens edge name in mutations.
 	EdgeRefreshTokens = "refresh_tokens"
+	// EdgeTimelineEntries holds the string denoting the timeline_entries edge name in mutations.
+	EdgeTimelineEntries = "timeline_entries"
+	// EdgeTimelineActivities holds the string denoting the timeline_activities edge name in mutations.
+	EdgeTimelineActivities = "timeline_activities"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
+	// EdgeLatestNotifications holds the string denoting the latest_notifications edge name in mutations.
+	EdgeLatestNotifications = "latest_notifications"
+	// EdgeActedNotifications holds the string denoting the acted_notifications edge name in mutations.
+	EdgeActedNotifications = "acted_notifications"
+	// EdgeNotificationDeliveries holds the string denoting the notification_deliveries edge name in mutations.
+	EdgeNotificationDeliveries = "notification_deliveries"
 	// EdgeFollowers holds the string denoting the followers edge name in mutations.
 	EdgeFollowers = "followers"
 	// EdgeFollowing holds the string denoting the following edge name in mutations.
 	EdgeFollowing = "following"
+	// EdgeBlockedBy holds the string denoting the blocked_by edge name in mutations.
+	EdgeBlockedBy = "blocked_by"
+	// EdgeBlocking holds the string denoting the blocking edge name in mutations.
+	EdgeBlocking = "blocking"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// GenresTable is the table that holds the genres relation/edge. The primary key declared below.
@@ -61,6 +117,13 @@ const (
 	GoalsInverseTable = "goals"
 	// GoalsColumn is the table column denoting the goals relation/edge.
 	GoalsColumn = "user_goals"
+	// GoalParticipationsTable is the table that holds the goal_participations relation/edge.
+	GoalParticipationsTable = "goal_participants"
+	// GoalParticipationsInverseTable is the table name for the GoalParticipant entity.
+	// It exists in this package in order to avoid circular dependency with the "goalparticipant" package.
+	GoalParticipationsInverseTable = "goal_participants"
+	// GoalParticipationsColumn is the table column denoting the goal_participations relation/edge.
+	GoalParticipationsColumn = "user_goal_participations"
 	// PostsTable is the table that holds the posts relation/edge.
 	PostsTable = "posts"
 	// PostsInverseTable is the table name for the Post entity.
@@ -75,6 +138,60 @@ const (
 	ReactionsInverseTable = "reactions"
 	// ReactionsColumn is the table column denoting the reactions relation/edge.
 	ReactionsColumn = "user_reactions"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "comments"
+	// CommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentsInverseTable = "comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "user_comments"
+	// RepostsTable is the table that holds the reposts relation/edge.
+	RepostsTable = "reposts"
+	// RepostsInverseTable is the table name for the Repost entity.
+	// It exists in this package in order to avoid circular dependency with the "repost" package.
+	RepostsInverseTable = "reposts"
+	// RepostsColumn is the table column denoting the reposts relation/edge.
+	RepostsColumn = "user_reposts"
+	// BookmarksTable is the table that holds the bookmarks relation/edge.
+	BookmarksTable = "bookmarks"
+	// BookmarksInverseTable is the table name for the Bookmark entity.
+	// It exists in this package in order to avoid circular dependency with the "bookmark" package.
+	BookmarksInverseTable = "bookmarks"
+	// BookmarksColumn is the table column denoting the bookmarks relation/edge.
+	BookmarksColumn = "user_bookmarks"
+	// BookmarkCollectionsTable is the table that holds the bookmark_collections relation/edge.
+	BookmarkCollectionsTable = "bookmark_collections"
+	// BookmarkCollectionsInverseTable is the table name for the BookmarkCollection entity.
+	// It exists in this package in order to avoid circular dependency with the "bookmarkcollection" package.
+	BookmarkCollectionsInverseTable = "bookmark_collections"
+	// BookmarkCollectionsColumn is the table column denoting the bookmark_collections relation/edge.
+	BookmarkCollectionsColumn = "user_bookmark_collections"
+	// ReportsTable is the table that holds the reports relation/edge.
+	ReportsTable = "reports"
+	// ReportsInverseTable is the table name for the Report entity.
+	// It exists in this package in order to avoid circular dependency with the "report" package.
+	ReportsInverseTable = "reports"
+	// ReportsColumn is the table column denoting the reports relation/edge.
+	ReportsColumn = "user_reports"
+	// ClaimedReportsTable is the table that holds the claimed_reports relation/edge.
+	ClaimedReportsTable = "reports"
+	// ClaimedReportsInverseTable is the table name for the Report entity.
+	// It exists in this package in order to avoid circular dependency with the "report" package.
+	ClaimedReportsInverseTable = "reports"
+	// ClaimedReportsColumn is the table column denoting the claimed_reports relation/edge.
+	ClaimedReportsColumn = "user_claimed_reports"
+	// ModerationActionsTable is the table that holds the moderation_actions relation/edge.
+	ModerationActionsTable = "moderation_actions"
+	// ModerationActionsInverseTable is the table name for the ModerationAction entity.
+	// It exists in this package in order to avoid circular dependency with the "moderationaction" package.
+	ModerationActionsInverseTable = "moderation_actions"
+	// ModerationActionsColumn is the table column denoting the moderation_actions relation/edge.
+	ModerationActionsColumn = "user_moderation_actions"
+	// MentionedInTable is the table that holds the mentioned_in relation/edge. The primary key declared below.
+	MentionedInTable = "post_mentions"
+	// MentionedInInverseTable is the table name for the Post entity.
+	// It exists in this package in order to avoid circular dependency with the "post" package.
+	MentionedInInverseTable = "posts"
 	// UploadedImagesTable is the table that holds the uploaded_images relation/edge.
 	UploadedImagesTable = "images"
 	// UploadedImagesInverseTable is the table name for the Image entity.
@@ -89,10 +206,54 @@ const (
 	RefreshTokensInverseTable = "refresh_tokens"
 	// RefreshTokensColumn is the table column denoting the refresh_tokens relation/edge.
 	RefreshTokensColumn = "user_refresh_tokens"
+	// TimelineEntriesTable is the table that holds the timeline_entries relation/edge.
+	TimelineEntriesTable = "timeline_entries"
+	// TimelineEntriesInverseTable is the table name for the TimelineEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "timelineentry" package.
+	TimelineEntriesInverseTable = "timeline_entries"
+	// TimelineEntriesColumn is the table column denoting the timeline_entries relation/edge.
+	TimelineEntriesColumn = "user_timeline_entries"
+	// TimelineActivitiesTable is the table that holds the timeline_activities relation/edge.
+	TimelineActivitiesTable = "timeline_entries"
+	// TimelineActivitiesInverseTable is the table name for the TimelineEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "timelineentry" package.
+	TimelineActivitiesInverseTable = "timeline_entries"
+	// TimelineActivitiesColumn is the table column denoting the timeline_activities relation/edge.
+	TimelineActivitiesColumn = "user_timeline_activities"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "user_notifications"
+	// LatestNotificationsTable is the table that holds the latest_notifications relation/edge.
+	LatestNotificationsTable = "notifications"
+	// LatestNotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	LatestNotificationsInverseTable = "notifications"
+	// LatestNotificationsColumn is the table column denoting the latest_notifications relation/edge.
+	LatestNotificationsColumn = "user_latest_notifications"
+	// ActedNotificationsTable is the table that holds the acted_notifications relation/edge. The primary key declared below.
+	ActedNotificationsTable = "user_acted_notifications"
+	// ActedNotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	ActedNotificationsInverseTable = "notifications"
+	// NotificationDeliveriesTable is the table that holds the notification_deliveries relation/edge.
+	NotificationDeliveriesTable = "notification_deliveries"
+	// NotificationDeliveriesInverseTable is the table name for the NotificationDelivery entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationdelivery" package.
+	NotificationDeliveriesInverseTable = "notification_deliveries"
+	// NotificationDeliveriesColumn is the table column denoting the notification_deliveries relation/edge.
+	NotificationDeliveriesColumn = "user_notification_deliveries"
 	// FollowersTable is the table that holds the followers relation/edge. The primary key declared below.
 	FollowersTable = "user_following"
 	// FollowingTable is the table that holds the following relation/edge. The primary key declared below.
 	FollowingTable = "user_following"
+	// BlockedByTable is the table that holds the blocked_by relation/edge. The primary key declared below.
+	BlockedByTable = "user_blocking"
+	// BlockingTable is the table that holds the blocking relation/edge. The primary key declared below.
+	BlockingTable = "user_blocking"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -100,10 +261,20 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldEmail,
+	FieldHandle,
 	FieldBirthday,
 	FieldHometown,
 	FieldBio,
+	FieldRole,
 	FieldProfilePictureID,
+	FieldTimeZone,
+	FieldQuietHoursStart,
+	FieldQuietHoursEnd,
+	FieldHiddenAt,
+	FieldSuspendedAt,
+	FieldSuspendedUntil,
+	FieldTimelineHorizon,
+	FieldNotificationOptOuts,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -112,12 +283,24 @@ var (
 	// GenresPrimaryKey and GenresColumn2 are the table columns denoting the
 	// primary key for the genres relation (M2M).
 	GenresPrimaryKey = []string{"user_id", "genre_id"}
+	// MentionedInPrimaryKey and MentionedInColumn2 are the table columns denoting the
+	// primary key for the mentioned_in relation (M2M).
+	MentionedInPrimaryKey = []string{"post_id", "user_id"}
+	// ActedNotificationsPrimaryKey and ActedNotificationsColumn2 are the table columns denoting the
+	// primary key for the acted_notifications relation (M2M).
+	ActedNotificationsPrimaryKey = []string{"user_id", "notification_id"}
 	// FollowersPrimaryKey and FollowersColumn2 are the table columns denoting the
 	// primary key for the followers relation (M2M).
 	FollowersPrimaryKey = []string{"user_id", "follower_id"}
 	// FollowingPrimaryKey and FollowingColumn2 are the table columns denoting the
 	// primary key for the following relation (M2M).
 	FollowingPrimaryKey = []string{"user_id", "follower_id"}
+	// BlockedByPrimaryKey and BlockedByColumn2 are the table columns denoting the
+	// primary key for the blocked_by relation (M2M).
+	BlockedByPrimaryKey = []string{"user_id", "blocked_by_id"}
+	// BlockingPrimaryKey and BlockingColumn2 are the table columns denoting the
+	// primary key for the blocking relation (M2M).
+	BlockingPrimaryKey = []string{"user_id", "blocked_by_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -130,11 +313,25 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "backend/ent/runtime"
 var (
+	Hooks [1]ent.Hook
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	EmailValidator func(string) error
+	// HandleValidator is a validator for the "handle" field. It is called by the builders before save.
+	HandleValidator func(string) error
+	// DefaultTimeZone holds the default value on creation for the "time_zone" field.
+	DefaultTimeZone string
+	// QuietHoursStartValidator is a validator for the "quiet_hours_start" field. It is called by the builders before save.
+	QuietHoursStartValidator func(int) error
+	// QuietHoursEndValidator is a validator for the "quiet_hours_end" field. It is called by the builders before save.
+	QuietHoursEndValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -145,6 +342,33 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleUser is the default value of the Role enum.
+const DefaultRole = RoleUser
+
+// Role values.
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleUser, RoleModerator, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -163,6 +387,11 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByHandle orders the results by the handle field.
+func ByHandle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandle, opts...).ToFunc()
+}
+
 // ByBirthday orders the results by the birthday field.
 func ByBirthday(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBirthday, opts...).ToFunc()
@@ -178,11 +407,51 @@ func ByBio(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBio, opts...).ToFunc()
 }
 
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
 // ByProfilePictureID orders the results by the profile_picture_id field.
 func ByProfilePictureID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProfilePictureID, opts...).ToFunc()
 }
 
+// ByTimeZone orders the results by the time_zone field.
+func ByTimeZone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeZone, opts...).ToFunc()
+}
+
+// ByQuietHoursStart orders the results by the quiet_hours_start field.
+func ByQuietHoursStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietHoursStart, opts...).ToFunc()
+}
+
+// ByQuietHoursEnd orders the results by the quiet_hours_end field.
+func ByQuietHoursEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietHoursEnd, opts...).ToFunc()
+}
+
+// ByHiddenAt orders the results by the hidden_at field.
+func ByHiddenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHiddenAt, opts...).ToFunc()
+}
+
+// BySuspendedAt orders the results by the suspended_at field.
+func BySuspendedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedAt, opts...).ToFunc()
+}
+
+// BySuspendedUntil orders the results by the suspended_until field.
+func BySuspendedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedUntil, opts...).ToFunc()
+}
+
+// ByTimelineHorizon orders the results by the timeline_horizon field.
+func ByTimelineHorizon(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimelineHorizon, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -221,6 +490,20 @@ func ByGoals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByGoalParticipationsCount orders the results by goal_participations count.
+func ByGoalParticipationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGoalParticipationsStep(), opts...)
+	}
+}
+
+// ByGoalParticipations orders the results by goal_participations terms.
+func ByGoalParticipations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGoalParticipationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByPostsCount orders the results by posts count.
 func ByPostsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -249,6 +532,118 @@ func ByReactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRepostsCount orders the results by reposts count.
+func ByRepostsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRepostsStep(), opts...)
+	}
+}
+
+// ByReposts orders the results by reposts terms.
+func ByReposts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRepostsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBookmarksCount orders the results by bookmarks count.
+func ByBookmarksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBookmarksStep(), opts...)
+	}
+}
+
+// ByBookmarks orders the results by bookmarks terms.
+func ByBookmarks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBookmarksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBookmarkCollectionsCount orders the results by bookmark_collections count.
+func ByBookmarkCollectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBookmarkCollectionsStep(), opts...)
+	}
+}
+
+// ByBookmarkCollections orders the results by bookmark_collections terms.
+func ByBookmarkCollections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBookmarkCollectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReportsCount orders the results by reports count.
+func ByReportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReportsStep(), opts...)
+	}
+}
+
+// ByReports orders the results by reports terms.
+func ByReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByClaimedReportsCount orders the results by claimed_reports count.
+func ByClaimedReportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newClaimedReportsStep(), opts...)
+	}
+}
+
+// ByClaimedReports orders the results by claimed_reports terms.
+func ByClaimedReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClaimedReportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByModerationActionsCount orders the results by moderation_actions count.
+func ByModerationActionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newModerationActionsStep(), opts...)
+	}
+}
+
+// ByModerationActions orders the results by moderation_actions terms.
+func ByModerationActions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModerationActionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMentionedInCount orders the results by mentioned_in count.
+func ByMentionedInCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMentionedInStep(), opts...)
+	}
+}
+
+// ByMentionedIn orders the results by mentioned_in terms.
+func ByMentionedIn(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMentionedInStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByUploadedImagesCount orders the results by uploaded_images count.
 func ByUploadedImagesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -277,6 +672,90 @@ func ByRefreshTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByTimelineEntriesCount orders the results by timeline_entries count.
+func ByTimelineEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTimelineEntriesStep(), opts...)
+	}
+}
+
+// ByTimelineEntries orders the results by timeline_entries terms.
+func ByTimelineEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTimelineEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTimelineActivitiesCount orders the results by timeline_activities count.
+func ByTimelineActivitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTimelineActivitiesStep(), opts...)
+	}
+}
+
+// ByTimelineActivities orders the results by timeline_activities terms.
+func ByTimelineActivities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTimelineActivitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLatestNotificationsCount orders the results by latest_notifications count.
+func ByLatestNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLatestNotificationsStep(), opts...)
+	}
+}
+
+// ByLatestNotifications orders the results by latest_notifications terms.
+func ByLatestNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLatestNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByActedNotificationsCount orders the results by acted_notifications count.
+func ByActedNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActedNotificationsStep(), opts...)
+	}
+}
+
+// ByActedNotifications orders the results by acted_notifications terms.
+func ByActedNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActedNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationDeliveriesCount orders the results by notification_deliveries count.
+func ByNotificationDeliveriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationDeliveriesStep(), opts...)
+	}
+}
+
+// ByNotificationDeliveries orders the results by notification_deliveries terms.
+func ByNotificationDeliveries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationDeliveriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByFollowersCount orders the results by followers count.
 func ByFollowersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -304,6 +783,34 @@ func ByFollowing(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFollowingStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBlockedByCount orders the results by blocked_by count.
+func ByBlockedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedByStep(), opts...)
+	}
+}
+
+// ByBlockedBy orders the results by blocked_by terms.
+func ByBlockedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockingCount orders the results by blocking count.
+func ByBlockingCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockingStep(), opts...)
+	}
+}
+
+// ByBlocking orders the results by blocking terms.
+func ByBlocking(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockingStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newGenresStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -318,6 +825,13 @@ func newGoalsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, GoalsTable, GoalsColumn),
 	)
 }
+func newGoalParticipationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GoalParticipationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GoalParticipationsTable, GoalParticipationsColumn),
+	)
+}
 func newPostsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -332,6 +846,62 @@ func newReactionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
 	)
 }
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+	)
+}
+func newRepostsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RepostsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RepostsTable, RepostsColumn),
+	)
+}
+func newBookmarksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BookmarksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BookmarksTable, BookmarksColumn),
+	)
+}
+func newBookmarkCollectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BookmarkCollectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BookmarkCollectionsTable, BookmarkCollectionsColumn),
+	)
+}
+func newReportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReportsTable, ReportsColumn),
+	)
+}
+func newClaimedReportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ClaimedReportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ClaimedReportsTable, ClaimedReportsColumn),
+	)
+}
+func newModerationActionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModerationActionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ModerationActionsTable, ModerationActionsColumn),
+	)
+}
+func newMentionedInStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MentionedInInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, MentionedInTable, MentionedInPrimaryKey...),
+	)
+}
 func newUploadedImagesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -346,6 +916,48 @@ func newRefreshTokensStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RefreshTokensTable, RefreshTokensColumn),
 	)
 }
+func newTimelineEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TimelineEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TimelineEntriesTable, TimelineEntriesColumn),
+	)
+}
+func newTimelineActivitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TimelineActivitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TimelineActivitiesTable, TimelineActivitiesColumn),
+	)
+}
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+	)
+}
+func newLatestNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LatestNotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LatestNotificationsTable, LatestNotificationsColumn),
+	)
+}
+func newActedNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ActedNotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, ActedNotificationsTable, ActedNotificationsPrimaryKey...),
+	)
+}
+func newNotificationDeliveriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationDeliveriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationDeliveriesTable, NotificationDeliveriesColumn),
+	)
+}
 func newFollowersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -360,3 +972,17 @@ func newFollowingStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, FollowingTable, FollowingPrimaryKey...),
 	)
 }
+func newBlockedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, BlockedByTable, BlockedByPrimaryKey...),
+	)
+}
+func newBlockingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, BlockingTable, BlockingPrimaryKey...),
+	)
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+
+	"backend/ent"
+	"backend/ent/user"
+	"backend/security"
+
+	"github.com/google/uuid"
+)
+
+// currentUserID はcontextから認証済みユーザーのIDを取得します。
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	raw, ok := security.GetUserIDFromContext(ctx)
+	if !ok {
+		return uuid.Nil, ErrUnauthorized
+	}
+
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, ErrUnauthorized
+	}
+
+	return id, nil
+}
+
+// viewerID は認証済みであればユーザーIDを、未認証であればuuid.Nilを返します。
+// 認証が任意のAPIで閲覧者を判別するために使用します。
+func viewerID(ctx context.Context) uuid.UUID {
+	id, err := currentUserID(ctx)
+	if err != nil {
+		return uuid.Nil
+	}
+	return id
+}
+
+// requireAdmin は認証済みユーザーが管理者であることを確認し、そのユーザーIDを返します。
+func (h *Handler) requireAdmin(ctx context.Context) (uuid.UUID, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	u, err := h.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, ErrUnauthorized
+		}
+		return uuid.Nil, err
+	}
+	if u.Role != user.RoleAdmin {
+		return uuid.Nil, ErrForbidden
+	}
+
+	return userID, nil
+}
+
+// requireModerator は認証済みユーザーがモデレーターまたは管理者であることを確認し、そのユーザーを返します。
+func (h *Handler) requireModerator(ctx context.Context) (*ent.User, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := h.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUnauthorized
+		}
+		return nil, err
+	}
+	if u.Role != user.RoleModerator && u.Role != user.RoleAdmin {
+		return nil, ErrForbidden
+	}
+
+	return u, nil
+}
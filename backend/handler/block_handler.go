@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// BlocksGet は自分がブロックしているユーザーのID一覧を返します。
+func (h *Handler) BlocksGet(ctx context.Context) (api.BlocksGetRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := h.client.User.Query().
+		Where(user.HasBlockedByWith(user.ID(userID))).
+		Order(ent.Asc(user.FieldID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := api.BlocksGetOKApplicationJSON(ids)
+	return &res, nil
+}
+
+// BlocksPost はユーザーをブロックし、互いのフォローを解除します。
+func (h *Handler) BlocksPost(ctx context.Context, req *api.BlocksPostReq) (api.BlocksPostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID == userID {
+		return nil, fmt.Errorf("%w: cannot block yourself", ErrBadRequest)
+	}
+
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		exists, err := tx.User.Query().
+			Where(user.ID(req.UserID)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrNotFound
+		}
+
+		// 既にブロック済みでもエラーにしない
+		blocked, err := tx.User.Query().
+			Where(user.ID(userID), user.HasBlockingWith(user.ID(req.UserID))).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		update := tx.User.UpdateOneID(userID).
+			RemoveFollowingIDs(req.UserID).
+			RemoveFollowerIDs(req.UserID)
+		if !blocked {
+			update.AddBlockingIDs(req.UserID)
+		}
+		return update.Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.BlocksPostCreated{}, nil
+}
+
+// BlocksUserIDDelete はブロックを解除します。
+func (h *Handler) BlocksUserIDDelete(ctx context.Context, params api.BlocksUserIDDeleteParams) (api.BlocksUserIDDeleteRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := h.client.User.Query().
+		Where(user.ID(userID), user.HasBlockingWith(user.ID(params.UserID))).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !blocked {
+		return nil, ErrNotFound
+	}
+
+	err = h.client.User.UpdateOneID(userID).
+		RemoveBlockingIDs(params.UserID).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.BlocksUserIDDeleteNoContent{}, nil
+}
+
+// blockedUserIDs は閲覧者がブロックしている、または閲覧者をブロックしているユーザーのIDを返します。
+// 未認証の閲覧者にはブロック関係がないため空を返します。
+func (h *Handler) blockedUserIDs(ctx context.Context, viewer uuid.UUID) ([]uuid.UUID, error) {
+	if viewer == uuid.Nil {
+		return nil, nil
+	}
+
+	return h.client.User.Query().
+		Where(user.Or(
+			user.HasBlockingWith(user.ID(viewer)),
+			user.HasBlockedByWith(user.ID(viewer)),
+		)).
+		IDs(ctx)
+}
+
+// isBlocked は2人のユーザーのどちらかが相手をブロックしているかを返します。
+func (h *Handler) isBlocked(ctx context.Context, a, b uuid.UUID) (bool, error) {
+	if a == uuid.Nil || b == uuid.Nil || a == b {
+		return false, nil
+	}
+
+	return h.client.User.Query().
+		Where(
+			user.ID(a),
+			user.Or(
+				user.HasBlockingWith(user.ID(b)),
+				user.HasBlockedByWith(user.ID(b)),
+			),
+		).
+		Exist(ctx)
+}
@@ -0,0 +1,416 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/bookmark"
+	"backend/ent/bookmarkcollection"
+	"backend/ent/post"
+	"backend/ent/user"
+	"backend/internal/cursor"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PostsPostIDBookmarkPost は投稿をブックマークします。
+// 既にブックマークしている場合は保存先のコレクションを変更します。
+func (h *Handler) PostsPostIDBookmarkPost(ctx context.Context, req api.OptBookmarkRequest, params api.PostsPostIDBookmarkPostParams) (api.PostsPostIDBookmarkPostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.visiblePost(ctx, params.PostID, userID); err != nil {
+		return nil, err
+	}
+
+	var collectionID *uuid.UUID
+	if r, ok := req.Get(); ok {
+		if v, ok := r.CollectionID.Get(); ok {
+			err := h.requireBookmarkCollection(ctx, v, userID)
+			if errors.Is(err, ErrNotFound) {
+				return nil, fmt.Errorf("%w: unknown collection", ErrBadRequest)
+			}
+			if err != nil {
+				return nil, err
+			}
+			collectionID = &v
+		}
+	}
+
+	var id uuid.UUID
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		b, err := tx.Bookmark.Query().
+			Where(
+				bookmark.HasUserWith(user.ID(userID)),
+				bookmark.HasPostWith(post.ID(params.PostID)),
+			).
+			Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			b, err = tx.Bookmark.Create().
+				SetUserID(userID).
+				SetPostID(params.PostID).
+				SetNillableCollectionID(collectionID).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		default:
+			update := b.Update()
+			if collectionID != nil {
+				update.SetCollectionID(*collectionID)
+			} else {
+				update.ClearCollection()
+			}
+			if err := update.Exec(ctx); err != nil {
+				return err
+			}
+		}
+		id = b.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := h.client.Bookmark.Query().
+		Where(bookmark.ID(id)).
+		WithCollection(func(q *ent.BookmarkCollectionQuery) {
+			q.Select(bookmarkcollection.FieldID)
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.toAPIBookmarks(ctx, []*ent.Bookmark{b}, []uuid.UUID{params.PostID})
+	if err != nil {
+		return nil, err
+	}
+	return &res[0], nil
+}
+
+// PostsPostIDBookmarkDelete はブックマークを解除します。
+func (h *Handler) PostsPostIDBookmarkDelete(ctx context.Context, params api.PostsPostIDBookmarkDeleteParams) (api.PostsPostIDBookmarkDeleteRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := h.client.Bookmark.Delete().
+		Where(
+			bookmark.HasUserWith(user.ID(userID)),
+			bookmark.HasPostWith(post.ID(params.PostID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrNotFound
+	}
+
+	return &api.PostsPostIDBookmarkDeleteNoContent{}, nil
+}
+
+// BookmarksGet は自分のブックマークを新しい順に返します。
+// 前回のレスポンスのLinkヘッダーのcursorを指定するキーセットページネーションです。
+// 閲覧できなくなった投稿のブックマークは含めません。
+func (h *Handler) BookmarksGet(ctx context.Context, params api.BookmarksGetParams) (api.BookmarksGetRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := h.keysetPagination("bookmarks", params.Cursor, api.OptInt{}, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := h.blockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	q := h.client.Bookmark.Query().
+		Where(
+			bookmark.HasUserWith(user.ID(userID)),
+			bookmark.HasPostWith(visiblePosts(userID, blocked)),
+		)
+	query := url.Values{}
+	if v, ok := params.Collection.Get(); ok {
+		if err := h.requireBookmarkCollection(ctx, v, userID); err != nil {
+			return nil, err
+		}
+		q.Where(bookmark.HasCollectionWith(bookmarkcollection.ID(v)))
+		query.Set("collection", v.String())
+	}
+	if after, ok := params.After.Get(); ok {
+		b, err := h.client.Bookmark.Query().
+			Where(bookmark.ID(after), bookmark.HasUserWith(user.ID(userID))).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: unknown cursor", ErrBadRequest)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := page.setAfter(cursor.Position{Time: b.CreatedAt, ID: b.ID}); err != nil {
+			return nil, err
+		}
+	}
+	if p := page.after; p != nil {
+		q.Where(bookmark.Or(
+			bookmark.CreatedAtLT(p.Time),
+			bookmark.And(bookmark.CreatedAt(p.Time), bookmark.IDLT(p.ID)),
+		))
+	}
+
+	bookmarks, err := q.
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		WithCollection(func(q *ent.BookmarkCollectionQuery) {
+			q.Select(bookmarkcollection.FieldID)
+		}).
+		Order(bookmark.ByCreatedAt(sql.OrderDesc()), bookmark.ByID(sql.OrderDesc())).
+		Limit(page.limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	postIDs := make([]uuid.UUID, 0, len(bookmarks))
+	for _, b := range bookmarks {
+		postIDs = append(postIDs, b.Edges.Post.ID)
+	}
+	res, err := h.toAPIBookmarks(ctx, bookmarks, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	var last cursor.Position
+	if len(bookmarks) > 0 {
+		b := bookmarks[len(bookmarks)-1]
+		last = cursor.Position{Time: b.CreatedAt, ID: b.ID}
+	}
+	return &api.BookmarksGetOKHeaders{
+		Link:     page.next(len(bookmarks), last, query),
+		Response: res,
+	}, nil
+}
+
+// BookmarksCollectionsGet は自分のブックマークコレクションを作成順に返します。
+func (h *Handler) BookmarksCollectionsGet(ctx context.Context) (api.BookmarksCollectionsGetRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := h.client.BookmarkCollection.Query().
+		Where(bookmarkcollection.HasUserWith(user.ID(userID))).
+		Order(ent.Asc(bookmarkcollection.FieldCreatedAt), ent.Asc(bookmarkcollection.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.toAPIBookmarkCollections(ctx, userID, collections)
+	if err != nil {
+		return nil, err
+	}
+	list := api.BookmarksCollectionsGetOKApplicationJSON(res)
+	return &list, nil
+}
+
+// BookmarksCollectionsPost はブックマークコレクションを作成します。
+func (h *Handler) BookmarksCollectionsPost(ctx context.Context, req *api.BookmarkCollectionRequest) (api.BookmarksCollectionsPostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := h.client.BookmarkCollection.Create().
+		SetUserID(userID).
+		SetName(req.Name).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.toAPIBookmarkCollections(ctx, userID, []*ent.BookmarkCollection{c})
+	if err != nil {
+		return nil, err
+	}
+	return &res[0], nil
+}
+
+// BookmarksCollectionsCollectionIDPut はブックマークコレクションの名前を変更します。
+func (h *Handler) BookmarksCollectionsCollectionIDPut(ctx context.Context, req *api.BookmarkCollectionRequest, params api.BookmarksCollectionsCollectionIDPutParams) (api.BookmarksCollectionsCollectionIDPutRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.requireBookmarkCollection(ctx, params.CollectionID, userID); err != nil {
+		return nil, err
+	}
+
+	c, err := h.client.BookmarkCollection.UpdateOneID(params.CollectionID).
+		SetName(req.Name).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.toAPIBookmarkCollections(ctx, userID, []*ent.BookmarkCollection{c})
+	if err != nil {
+		return nil, err
+	}
+	return &res[0], nil
+}
+
+// BookmarksCollectionsCollectionIDDelete はブックマークコレクションを削除します。
+// コレクション内のブックマークは未分類として残ります。
+func (h *Handler) BookmarksCollectionsCollectionIDDelete(ctx context.Context, params api.BookmarksCollectionsCollectionIDDeleteParams) (api.BookmarksCollectionsCollectionIDDeleteRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.requireBookmarkCollection(ctx, params.CollectionID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := h.client.BookmarkCollection.DeleteOneID(params.CollectionID).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return &api.BookmarksCollectionsCollectionIDDeleteNoContent{}, nil
+}
+
+// requireBookmarkCollection はコレクションが存在し、ユーザーのものであることを確認します。
+// 他のユーザーのコレクションは存在しないものとして扱います。
+func (h *Handler) requireBookmarkCollection(ctx context.Context, id, userID uuid.UUID) error {
+	exists, err := h.client.BookmarkCollection.Query().
+		Where(
+			bookmarkcollection.ID(id),
+			bookmarkcollection.HasUserWith(user.ID(userID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// bookmarkedPostIDs は投稿のうち閲覧者がブックマークしているものを返します。
+func (h *Handler) bookmarkedPostIDs(ctx context.Context, viewer uuid.UUID, postIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	res := make(map[uuid.UUID]bool, len(postIDs))
+	if viewer == uuid.Nil || len(postIDs) == 0 {
+		return res, nil
+	}
+
+	ids, err := h.client.Bookmark.Query().
+		Where(
+			bookmark.HasUserWith(user.ID(viewer)),
+			bookmark.HasPostWith(post.IDIn(postIDs...)),
+		).
+		QueryPost().
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		res[id] = true
+	}
+	return res, nil
+}
+
+// toAPIBookmarks はブックマーク一覧をAPIレスポンスの形式に変換します。
+// postIDsはブックマークと同じ順の投稿IDで、投稿はまとめて取得します。
+func (h *Handler) toAPIBookmarks(ctx context.Context, bookmarks []*ent.Bookmark, postIDs []uuid.UUID) ([]api.Bookmark, error) {
+	posts, err := h.postQuery().
+		Where(post.IDIn(postIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiPosts, err := h.toAPIPosts(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]api.Post, len(apiPosts))
+	for _, p := range apiPosts {
+		byID[p.ID] = p
+	}
+
+	res := make([]api.Bookmark, 0, len(bookmarks))
+	for i, b := range bookmarks {
+		p, ok := byID[postIDs[i]]
+		if !ok {
+			// 取得の間に投稿が削除された場合は含めない
+			continue
+		}
+		ab := api.Bookmark{
+			ID:        b.ID,
+			Post:      p,
+			CreatedAt: b.CreatedAt,
+		}
+		if b.Edges.Collection != nil {
+			ab.CollectionID = api.NewOptUUID(b.Edges.Collection.ID)
+		}
+		res = append(res, ab)
+	}
+	return res, nil
+}
+
+// toAPIBookmarkCollections はコレクション一覧をAPIレスポンスの形式に変換します。
+// ブックマーク数は閲覧できる投稿のもののみを一括で集計します。
+func (h *Handler) toAPIBookmarkCollections(ctx context.Context, userID uuid.UUID, collections []*ent.BookmarkCollection) ([]api.BookmarkCollection, error) {
+	ids := make([]uuid.UUID, 0, len(collections))
+	for _, c := range collections {
+		ids = append(ids, c.ID)
+	}
+
+	blocked, err := h.blockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var counts []struct {
+		CollectionID uuid.UUID `json:"bookmark_collection_bookmarks"`
+		Count        int       `json:"count"`
+	}
+	err = h.client.Bookmark.Query().
+		Where(
+			bookmark.HasCollectionWith(bookmarkcollection.IDIn(ids...)),
+			bookmark.HasPostWith(visiblePosts(userID, blocked)),
+		).
+		GroupBy(bookmark.CollectionColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	bookmarkCounts := make(map[uuid.UUID]int, len(counts))
+	for _, c := range counts {
+		bookmarkCounts[c.CollectionID] = c.Count
+	}
+
+	res := make([]api.BookmarkCollection, 0, len(collections))
+	for _, c := range collections {
+		res = append(res, api.BookmarkCollection{
+			ID:            c.ID,
+			Name:          c.Name,
+			BookmarkCount: bookmarkCounts[c.ID],
+			CreatedAt:     c.CreatedAt,
+			UpdatedAt:     c.UpdatedAt,
+		})
+	}
+	return res, nil
+}
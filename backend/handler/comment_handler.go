@@ -0,0 +1,391 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/comment"
+	"backend/ent/post"
+	"backend/ent/predicate"
+	"backend/ent/report"
+	"backend/ent/user"
+	"backend/internal/cursor"
+	"backend/internal/realtime"
+	"backend/internal/spamfilter"
+
+	"github.com/google/uuid"
+)
+
+// PostsPostIDCommentsGet は投稿へのコメント、またはコメントへの返信を古い順に返します。
+// 前回のレスポンスのLinkヘッダーのcursorを指定するキーセットページネーションです。
+func (h *Handler) PostsPostIDCommentsGet(ctx context.Context, params api.PostsPostIDCommentsGetParams) (api.PostsPostIDCommentsGetRes, error) {
+	page, err := h.keysetPagination("comments", params.Cursor, api.OptInt{}, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	viewer := viewerID(ctx)
+	if _, err := h.visiblePost(ctx, params.PostID, viewer); err != nil {
+		return nil, err
+	}
+	blocked, err := h.blockedUserIDs(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	q := h.commentQuery().
+		Where(
+			comment.HasPostWith(post.ID(params.PostID)),
+			visibleComments(viewer, blocked),
+		)
+	query := url.Values{}
+	if parentID, ok := params.ParentID.Get(); ok {
+		exists, err := h.client.Comment.Query().
+			Where(
+				comment.ID(parentID),
+				comment.HasPostWith(post.ID(params.PostID)),
+				visibleComments(viewer, blocked),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrNotFound
+		}
+		q.Where(comment.HasParentWith(comment.ID(parentID)))
+		query.Set("parent_id", parentID.String())
+	} else {
+		q.Where(comment.Not(comment.HasParent()))
+	}
+	if after, ok := params.After.Get(); ok {
+		c, err := h.client.Comment.Query().
+			Where(comment.ID(after), comment.HasPostWith(post.ID(params.PostID))).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: unknown cursor", ErrBadRequest)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := page.setAfter(cursor.Position{Time: c.CreatedAt, ID: c.ID}); err != nil {
+			return nil, err
+		}
+	}
+	if p := page.after; p != nil {
+		q.Where(comment.Or(
+			comment.CreatedAtGT(p.Time),
+			comment.And(comment.CreatedAt(p.Time), comment.IDGT(p.ID)),
+		))
+	}
+
+	comments, err := q.
+		Order(ent.Asc(comment.FieldCreatedAt), ent.Asc(comment.FieldID)).
+		Limit(page.limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.toAPIComments(ctx, comments, viewer, blocked)
+	if err != nil {
+		return nil, err
+	}
+	var last cursor.Position
+	if len(comments) > 0 {
+		c := comments[len(comments)-1]
+		last = cursor.Position{Time: c.CreatedAt, ID: c.ID}
+	}
+	return &api.PostsPostIDCommentsGetOKHeaders{
+		Link:     page.next(len(comments), last, query),
+		Response: res,
+	}, nil
+}
+
+// PostsPostIDCommentsPost は投稿にコメント、またはコメントに返信します。
+func (h *Handler) PostsPostIDCommentsPost(ctx context.Context, req *api.CommentRequest, params api.PostsPostIDCommentsPostParams) (api.PostsPostIDCommentsPostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := h.visiblePost(ctx, params.PostID, userID)
+	if err != nil {
+		return nil, err
+	}
+	// 投稿したユーザーと、返信先のコメントをしたユーザーに知らせる
+	recipients := []uuid.UUID{p.Edges.User.ID}
+
+	parentID := optUUIDPtr(req.ParentID)
+	if parentID != nil {
+		parent, err := h.client.Comment.Query().
+			Where(comment.ID(*parentID)).
+			WithUser(func(q *ent.UserQuery) {
+				q.Select(user.FieldID)
+			}).
+			WithPost(func(q *ent.PostQuery) {
+				q.Select(post.FieldID)
+			}).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: parent comment not found", ErrBadRequest)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if parent.Edges.Post.ID != params.PostID {
+			return nil, fmt.Errorf("%w: parent comment belongs to another post", ErrBadRequest)
+		}
+		if parent.DeletedAt != nil {
+			return nil, fmt.Errorf("%w: cannot reply to a deleted comment", ErrBadRequest)
+		}
+		blocked, err := h.isBlocked(ctx, userID, parent.Edges.User.ID)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, ErrForbidden
+		}
+		recipients = append(recipients, parent.Edges.User.ID)
+	}
+	spam, err := h.checkSpam(ctx, spamfilter.KindComment, userID, uuid.Nil, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	held := heldAt(spam)
+
+	var commentID uuid.UUID
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		c, err := tx.Comment.Create().
+			SetContent(req.Content).
+			SetPostID(params.PostID).
+			SetUserID(userID).
+			SetNillableParentID(parentID).
+			SetNillableHiddenAt(held).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		commentID = c.ID
+		if held != nil {
+			return holdForReviewTx(ctx, tx, report.TargetTypeComment, c.ID, spam)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if held == nil {
+		recipients = slices.DeleteFunc(slices.Compact(recipients), func(id uuid.UUID) bool {
+			return id == userID
+		})
+		h.publishEvent(ctx, realtime.TypeComment, recipients, realtime.CommentData{
+			PostID:    params.PostID,
+			CommentID: commentID,
+			UserID:    userID,
+		})
+	}
+
+	return h.getAPIComment(ctx, commentID, userID)
+}
+
+// CommentsCommentIDPut はコメントを編集します。コメントした本人のみ実行できます。
+func (h *Handler) CommentsCommentIDPut(ctx context.Context, req *api.CommentUpdateRequest, params api.CommentsCommentIDPutParams) (api.CommentsCommentIDPutRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := h.commentQuery().
+		Where(comment.ID(params.CommentID), comment.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.Edges.User.ID != userID {
+		return nil, ErrForbidden
+	}
+	if _, err := h.visiblePost(ctx, c.Edges.Post.ID, userID); err != nil {
+		return nil, err
+	}
+	spam, err := h.checkSpam(ctx, spamfilter.KindComment, userID, c.ID, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	held := heldAt(spam)
+
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		update := tx.Comment.UpdateOneID(c.ID).
+			SetContent(req.Content)
+		// 既に非表示のコメントは非表示にした日時を変えずに、編集後の本文の確認を依頼する
+		if held != nil && c.HiddenAt == nil {
+			update.SetHiddenAt(*held)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return err
+		}
+		if held != nil {
+			return holdForReviewTx(ctx, tx, report.TargetTypeComment, c.ID, spam)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h.getAPIComment(ctx, c.ID, userID)
+}
+
+// CommentsCommentIDDelete はコメントを論理削除します。
+// コメントした本人に加えて、投稿者も自分の投稿へのコメントを削除できます。
+func (h *Handler) CommentsCommentIDDelete(ctx context.Context, params api.CommentsCommentIDDeleteParams) (api.CommentsCommentIDDeleteRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := h.commentQuery().
+		Where(comment.ID(params.CommentID), comment.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.Edges.User.ID != userID {
+		isPostAuthor, err := h.client.Post.Query().
+			Where(post.ID(c.Edges.Post.ID), post.HasUserWith(user.ID(userID))).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !isPostAuthor {
+			return nil, ErrForbidden
+		}
+	}
+
+	err = h.client.Comment.UpdateOneID(c.ID).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CommentsCommentIDDeleteNoContent{}, nil
+}
+
+// commentQuery はレスポンスに必要なエッジを読み込むコメントクエリを返します。
+func (h *Handler) commentQuery() *ent.CommentQuery {
+	return h.client.Comment.Query().
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		WithParent(func(q *ent.CommentQuery) {
+			q.Select(comment.FieldID)
+		})
+}
+
+// visibleComments は閲覧者に表示できるコメントの条件を返します。
+// ブロック関係にあるユーザーのコメントと、返信の残っていない削除済みコメント、
+// 運営により非表示にされた他のユーザーのコメントを除外します。
+func visibleComments(viewer uuid.UUID, blocked []uuid.UUID) predicate.Comment {
+	ps := []predicate.Comment{
+		comment.Or(
+			comment.DeletedAtIsNil(),
+			comment.HasRepliesWith(comment.DeletedAtIsNil()),
+		),
+		comment.Or(
+			comment.HiddenAtIsNil(),
+			comment.HasUserWith(user.ID(viewer)),
+		),
+	}
+	if len(blocked) > 0 {
+		ps = append(ps, comment.Not(comment.HasUserWith(user.IDIn(blocked...))))
+	}
+	return comment.And(ps...)
+}
+
+// getAPIComment はコメントを取得してAPIレスポンスの形式に変換します。
+func (h *Handler) getAPIComment(ctx context.Context, id, viewer uuid.UUID) (*api.Comment, error) {
+	c, err := h.commentQuery().
+		Where(comment.ID(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := h.blockedUserIDs(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.toAPIComments(ctx, []*ent.Comment{c}, viewer, blocked)
+	if err != nil {
+		return nil, err
+	}
+	return &res[0], nil
+}
+
+// toAPIComments はコメント一覧をAPIレスポンスの形式に変換します。
+// 返信数は閲覧者に表示できる返信のみを一括で集計します。
+func (h *Handler) toAPIComments(ctx context.Context, comments []*ent.Comment, viewer uuid.UUID, blocked []uuid.UUID) ([]api.Comment, error) {
+	ids := make([]uuid.UUID, 0, len(comments))
+	for _, c := range comments {
+		ids = append(ids, c.ID)
+	}
+
+	var counts []struct {
+		ParentID uuid.UUID `json:"comment_replies"`
+		Count    int       `json:"count"`
+	}
+	if len(ids) > 0 {
+		err := h.client.Comment.Query().
+			Where(
+				comment.HasParentWith(comment.IDIn(ids...)),
+				visibleComments(viewer, blocked),
+			).
+			GroupBy(comment.ParentColumn).
+			Aggregate(ent.Count()).
+			Scan(ctx, &counts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	replyCounts := make(map[uuid.UUID]int, len(counts))
+	for _, c := range counts {
+		replyCounts[c.ParentID] = c.Count
+	}
+
+	res := make([]api.Comment, 0, len(comments))
+	for _, c := range comments {
+		ac := api.Comment{
+			ID:         c.ID,
+			Content:    c.Content,
+			Deleted:    c.DeletedAt != nil,
+			ReplyCount: replyCounts[c.ID],
+			CreatedAt:  c.CreatedAt,
+			UpdatedAt:  c.UpdatedAt,
+		}
+		if c.Edges.User != nil && c.Edges.User.ID == viewer {
+			ac.ModerationNotice = moderationNotice(c.HiddenAt)
+		}
+		if ac.Deleted {
+			ac.Content = ""
+		}
+		if c.Edges.Post != nil {
+			ac.PostID = c.Edges.Post.ID
+		}
+		if c.Edges.User != nil {
+			ac.UserID = c.Edges.User.ID
+		}
+		if c.Edges.Parent != nil {
+			ac.ParentID = api.NewOptUUID(c.Edges.Parent.ID)
+		}
+		res = append(res, ac)
+	}
+	return res, nil
+}
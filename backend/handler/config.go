@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"log/slog"
+
+	"backend/api"
+	"backend/internal/other"
+)
+
+// Config はハンドラーの設定を保持します。
+type Config struct {
+	// ReactionKinds は使用できるリアクションの種類を表示順に並べたものです。
+	ReactionKinds []api.ReactionKind
+	// PublicPostRevisions が真の場合、投稿者以外も編集履歴を閲覧できます。
+	PublicPostRevisions bool
+	// MaxPinnedGoals は1ユーザーがピン留めできる目標の最大数です。
+	MaxPinnedGoals int
+	// MaxPostImages は1つの投稿に添付できる画像の最大枚数です。
+	MaxPostImages int
+}
+
+// NewConfig は環境変数からハンドラーの設定を作成します。
+// リアクションの種類は環境変数 REACTION_KINDS に "種類:絵文字" をカンマ区切りで指定し、不正な場合はデフォルトの種類を使用します。
+func NewConfig() *Config {
+	kinds, err := parseReactionKinds(other.GetEnv("REACTION_KINDS", defaultReactionKinds))
+	if err != nil {
+		slog.Warn("invalid REACTION_KINDS, using default", "error", err.Error())
+		kinds, _ = parseReactionKinds(defaultReactionKinds)
+	}
+	maxPinnedGoals := other.GetEnvInt("MAX_PINNED_GOALS", 3)
+	if maxPinnedGoals < 0 {
+		maxPinnedGoals = 3
+	}
+	maxPostImages := other.GetEnvInt("MAX_POST_IMAGES", 4)
+	if maxPostImages < 0 {
+		maxPostImages = 4
+	}
+
+	return &Config{
+		ReactionKinds:       kinds,
+		PublicPostRevisions: other.GetEnvBool("POST_REVISIONS_PUBLIC", false),
+		MaxPinnedGoals:      maxPinnedGoals,
+		MaxPostImages:       maxPostImages,
+	}
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"time"
+
+	"backend/api"
+
+	"github.com/google/uuid"
+)
+
+// optStringPtr はapi.OptStringをポインタに変換します。未設定の場合はnilを返します。
+func optStringPtr(o api.OptString) *string {
+	if v, ok := o.Get(); ok {
+		return &v
+	}
+	return nil
+}
+
+// optIntPtr はapi.OptIntをポインタに変換します。未設定の場合はnilを返します。
+func optIntPtr(o api.OptInt) *int {
+	if v, ok := o.Get(); ok {
+		return &v
+	}
+	return nil
+}
+
+// optDatePtr はapi.OptDateをポインタに変換します。未設定の場合はnilを返します。
+func optDatePtr(o api.OptDate) *time.Time {
+	if v, ok := o.Get(); ok {
+		return &v
+	}
+	return nil
+}
+
+// optFloat64Ptr はapi.OptFloat64をポインタに変換します。未設定の場合はnilを返します。
+func optFloat64Ptr(o api.OptFloat64) *float64 {
+	if v, ok := o.Get(); ok {
+		return &v
+	}
+	return nil
+}
+
+// optUUIDPtr はapi.OptUUIDをポインタに変換します。未設定の場合はnilを返します。
+func optUUIDPtr(o api.OptUUID) *uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return &v
+	}
+	return nil
+}
+
+// optNonEmptyString は空でない文字列をapi.OptStringに変換します。空文字列の場合は未設定として扱います。
+func optNonEmptyString(s string) api.OptString {
+	if s == "" {
+		return api.OptString{}
+	}
+	return api.NewOptString(s)
+}
+
+// optDateTimePtr はapi.OptDateTimeをポインタに変換します。未設定の場合はnilを返します。
+func optDateTimePtr(o api.OptDateTime) *time.Time {
+	if v, ok := o.Get(); ok {
+		return &v
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/genre"
+	"backend/ent/goal"
+	"backend/ent/post"
+	"backend/ent/predicate"
+	"backend/ent/reaction"
+	"backend/ent/user"
+	"backend/internal/cursor"
+	"backend/internal/ranking"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TimelineDiscoverGet はフォローしていないユーザーの最近の投稿をおすすめ順に返します。
+// cursorには最初のページを取得した時刻と前のページの最後の投稿が含まれ、その時刻の並び順で続きを返します。
+func (h *Handler) TimelineDiscoverGet(ctx context.Context, params api.TimelineDiscoverGetParams) (api.TimelineDiscoverGetRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := h.keysetPagination("discover", params.Cursor, api.OptInt{}, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	// 並び順が変わらないよう、続きのページでは最初のページの時刻までの投稿・リアクションで点数を付ける
+	asOf := time.Now()
+	if page.after != nil {
+		asOf = page.after.Time
+	}
+
+	candidates, err := h.discoverCandidates(ctx, userID, asOf)
+	if err != nil {
+		return nil, err
+	}
+	ranked := h.ranking.Rank(asOf, candidates, page.limit)
+	if page.after != nil {
+		i := slices.IndexFunc(ranked, func(s ranking.Scored) bool {
+			return s.PostID == page.after.ID
+		})
+		// 前のページの最後の投稿が削除・非表示にされた場合は続きを求められない
+		if i < 0 {
+			ranked = nil
+		} else {
+			ranked = ranked[i+1:]
+		}
+	}
+	ranked = ranked[:min(len(ranked), page.limit)]
+
+	ids := make([]uuid.UUID, 0, len(ranked))
+	for _, s := range ranked {
+		ids = append(ids, s.PostID)
+	}
+	posts, err := h.postQuery().
+		Where(post.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiPosts, err := h.toAPIPosts(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]api.Post, len(apiPosts))
+	for _, p := range apiPosts {
+		byID[p.ID] = p
+	}
+
+	res := make([]api.Post, 0, len(ranked))
+	for _, s := range ranked {
+		if p, ok := byID[s.PostID]; ok {
+			res = append(res, p)
+		}
+	}
+	var last cursor.Position
+	if len(ranked) > 0 {
+		last = cursor.Position{Time: asOf, ID: ranked[len(ranked)-1].PostID}
+	}
+	return &api.TimelineDiscoverGetOKHeaders{
+		Link:     page.next(len(ranked), last, nil),
+		Response: res,
+	}, nil
+}
+
+// discoverCandidates はおすすめの候補を集め、理由・リアクション数・共通のジャンルの割合を求めます。
+// 候補は期間内に公開された閲覧できる投稿のうち、自分とフォローしているユーザー以外のものです。
+func (h *Handler) discoverCandidates(ctx context.Context, userID uuid.UUID, asOf time.Time) ([]ranking.Candidate, error) {
+	following, err := h.client.User.Query().
+		Where(user.HasFollowersWith(user.ID(userID))).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := h.blockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	genres, err := h.client.Genre.Query().
+		Where(genre.HasUsersWith(user.ID(userID))).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	base := []predicate.Post{
+		post.StatusEQ(post.StatusPublished),
+		visiblePosts(userID, blocked),
+		post.CreatedAtGT(asOf.Add(-h.ranking.Window)),
+		post.CreatedAtLTE(asOf),
+		post.Not(post.HasUserWith(user.IDIn(append(following, userID)...))),
+	}
+	byID := make(map[uuid.UUID]*ranking.Candidate)
+	collect := func(source ranking.Source, preds ...predicate.Post) error {
+		posts, err := h.client.Post.Query().
+			Where(base...).
+			Where(preds...).
+			WithUser(func(q *ent.UserQuery) {
+				q.Select(user.FieldID)
+			}).
+			Order(post.ByCreatedAt(sql.OrderDesc()), post.ByID(sql.OrderDesc())).
+			Limit(h.ranking.CandidatesPerSource).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, p := range posts {
+			c, ok := byID[p.ID]
+			if !ok {
+				c = &ranking.Candidate{PostID: p.ID, AuthorID: p.Edges.User.ID, CreatedAt: p.CreatedAt}
+				byID[p.ID] = c
+			}
+			c.Sources |= source
+		}
+		return nil
+	}
+
+	if len(genres) > 0 {
+		err := collect(ranking.SourceGenre, post.HasUserWith(user.HasGenresWith(genre.IDIn(genres...))))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(following) > 0 {
+		err := collect(ranking.SourceFriendOfFriend, post.HasUserWith(user.HasFollowersWith(user.IDIn(following...))))
+		if err != nil {
+			return nil, err
+		}
+	}
+	trending, err := h.trendingGoalIDs(ctx, asOf)
+	if err != nil {
+		return nil, err
+	}
+	if len(trending) > 0 {
+		if err := collect(ranking.SourceTrendingGoal, post.HasGoalWith(goal.IDIn(trending...))); err != nil {
+			return nil, err
+		}
+	}
+
+	candidates := make([]ranking.Candidate, 0, len(byID))
+	for _, c := range byID {
+		candidates = append(candidates, *c)
+	}
+	if err := h.scoreInputs(ctx, candidates, genres, asOf); err != nil {
+		return nil, err
+	}
+	return candidates, nil
+}
+
+// trendingGoalIDs は期間内に公開された投稿の多い目標のIDを、多い順に返します。
+func (h *Handler) trendingGoalIDs(ctx context.Context, asOf time.Time) ([]uuid.UUID, error) {
+	if h.ranking.TrendingGoals == 0 {
+		return nil, nil
+	}
+
+	type goalCount struct {
+		GoalID uuid.UUID `json:"goal_posts"`
+		Count  int       `json:"count"`
+	}
+	var counts []goalCount
+	err := h.client.Post.Query().
+		Where(
+			post.StatusEQ(post.StatusPublished),
+			post.HiddenAtIsNil(),
+			post.HasGoal(),
+			post.CreatedAtGT(asOf.Add(-h.ranking.Window)),
+			post.CreatedAtLTE(asOf),
+		).
+		GroupBy(post.GoalColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(counts, func(a, b goalCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return bytes.Compare(a.GoalID[:], b.GoalID[:])
+	})
+
+	ids := make([]uuid.UUID, 0, h.ranking.TrendingGoals)
+	for _, c := range counts[:min(len(counts), h.ranking.TrendingGoals)] {
+		ids = append(ids, c.GoalID)
+	}
+	return ids, nil
+}
+
+// scoreInputs は候補ごとのasOfまでのリアクション数と、閲覧者と作成者の共通のジャンルの割合を設定します。
+func (h *Handler) scoreInputs(ctx context.Context, candidates []ranking.Candidate, genres []uuid.UUID, asOf time.Time) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+	postIDs := make([]uuid.UUID, 0, len(candidates))
+	authorIDs := make([]uuid.UUID, 0, len(candidates))
+	for _, c := range candidates {
+		postIDs = append(postIDs, c.PostID)
+		authorIDs = append(authorIDs, c.AuthorID)
+	}
+
+	var counts []struct {
+		PostID uuid.UUID `json:"post_reactions"`
+		Count  int       `json:"count"`
+	}
+	err := h.client.Reaction.Query().
+		Where(
+			reaction.HasPostWith(post.IDIn(postIDs...)),
+			reaction.CreatedAtLTE(asOf),
+		).
+		GroupBy(reaction.PostColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return err
+	}
+	reactions := make(map[uuid.UUID]int, len(counts))
+	for _, c := range counts {
+		reactions[c.PostID] = c.Count
+	}
+
+	overlap := make(map[uuid.UUID]float64)
+	if len(genres) > 0 {
+		authors, err := h.client.User.Query().
+			Where(user.IDIn(authorIDs...)).
+			WithGenres(func(q *ent.GenreQuery) {
+				q.Where(genre.IDIn(genres...)).
+					Select(genre.FieldID)
+			}).
+			Select(user.FieldID).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, u := range authors {
+			overlap[u.ID] = float64(len(u.Edges.Genres)) / float64(len(genres))
+		}
+	}
+
+	for i := range candidates {
+		candidates[i].Reactions = reactions[candidates[i].PostID]
+		candidates[i].GenreOverlap = overlap[candidates[i].AuthorID]
+	}
+	return nil
+}
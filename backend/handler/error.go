@@ -8,15 +8,48 @@ import (
 
 	"backend/api"
 	"backend/ent"
+	"backend/security"
 )
 
 var (
+	// ErrConfigRequired はハンドラーの設定が必須であることを示すエラーです。
+	ErrConfigRequired = errors.New("handler config is required")
+
 	// ErrClientRequired はent.Clientが必須であることを示すエラーです。
 	ErrClientRequired = errors.New("ent client is required")
 
 	// ErrJWTHandlerRequired はJWTHandlerが必須であることを示すエラーです。
 	ErrJWTHandlerRequired = errors.New("JWT handler is required")
 
+	// ErrStorageRequired はStorageが必須であることを示すエラーです。
+	ErrStorageRequired = errors.New("storage is required")
+
+	// ErrNotifierRequired はNotifierが必須であることを示すエラーです。
+	ErrNotifierRequired = errors.New("notifier is required")
+
+	// ErrUnfurlerRequired はリンクプレビューの取得を依頼するEnqueuerが必須であることを示すエラーです。
+	ErrUnfurlerRequired = errors.New("unfurler is required")
+
+	// ErrSearcherRequired は全文検索を行うSearcherが必須であることを示すエラーです。
+	ErrSearcherRequired = errors.New("searcher is required")
+
+	// ErrSpamFilterRequired は投稿・コメントを判定する迷惑行為フィルタが必須であることを示すエラーです。
+	ErrSpamFilterRequired = errors.New("spam filter is required")
+
+	// ErrFanoutRequired はタイムラインの書き込みを依頼するFanoutが必須であることを示すエラーです。
+	ErrFanoutRequired = errors.New("timeline fanout is required")
+
+	// ErrEventsRequired はリアルタイムのイベントを発行するPublisherが必須であることを示すエラーです。
+	ErrEventsRequired = errors.New("event publisher is required")
+
+	// ErrWakerRequired は予約投稿の公開予定日時を公開ジョブに知らせるWakerが必須であることを示すエラーです。
+	ErrWakerRequired = errors.New("publisher waker is required")
+
+	// ErrCursorCodecRequired はページングのカーソルの署名を行うCodecが必須であることを示すエラーです。
+	ErrCursorCodecRequired = errors.New("cursor codec is required")
+	// ErrRankingConfigRequired はおすすめフィードの設定が必須であることを示すエラーです。
+	ErrRankingConfigRequired = errors.New("ranking config is required")
+
 	// ErrNotFound はリソースが見つからない場合のエラーです。
 	ErrNotFound = errors.New("resource not found")
 
@@ -68,6 +101,13 @@ func NewError(ctx context.Context, err error) *api.GeneralErrorStatusCode {
 				Message: "authentication required",
 			},
 		}
+	case errors.Is(err, security.ErrAccountSuspended):
+		return &api.GeneralErrorStatusCode{
+			StatusCode: http.StatusForbidden,
+			Response: api.Error{
+				Message: "account suspended",
+			},
+		}
 	case errors.Is(err, ErrForbidden):
 		return &api.GeneralErrorStatusCode{
 			StatusCode: http.StatusForbidden,
@@ -2,34 +2,185 @@ package handler
 
 import (
 	"context"
+	"fmt"
 
 	"backend/api"
+	"backend/ent"
+	"backend/ent/user"
+	"backend/internal/cursor"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 )
 
 // FriendsGet implements GET /friends operation.
 // 自分のフレンド（フォロー）一覧取得
-func (h *Handler) FriendsGet(ctx context.Context) (api.FriendsGetRes, error) {
-	// TODO: APIの処理を実装
-	return &api.FriendsGetOKApplicationJSON{}, nil
+// 前回のレスポンスのLinkヘッダーのcursorを指定するキーセットページネーションです。
+func (h *Handler) FriendsGet(ctx context.Context, params api.FriendsGetParams) (api.FriendsGetRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, link, err := h.followingPage(ctx, userID, userID, params.Cursor, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &api.FriendsGetOKHeaders{Link: link, Response: ids}, nil
 }
 
 // FriendsPost implements POST /friends operation.
 // フレンド追加（フォロー）
+// 既にフォローしている場合は何もしません。フォローしたユーザーの最近の投稿はタイムラインに書き込みます。
 func (h *Handler) FriendsPost(ctx context.Context, req *api.FriendsPostReq) (api.FriendsPostRes, error) {
-	// TODO: APIの処理を実装
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID == userID {
+		return nil, fmt.Errorf("%w: cannot follow yourself", ErrBadRequest)
+	}
+	exists, err := h.client.User.Query().
+		Where(user.ID(req.UserID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// ブロック関係にあるユーザーは存在しないものとして扱う
+	blocked, err := h.isBlocked(ctx, userID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists || blocked {
+		return nil, ErrNotFound
+	}
+
+	following, err := h.isFollowing(ctx, userID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if following {
+		return &api.FriendsPostCreated{}, nil
+	}
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		return tx.User.UpdateOneID(userID).
+			AddFollowingIDs(req.UserID).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &api.FriendsPostCreated{}, nil
 }
 
 // FriendsUserIDDelete implements DELETE /friends/{user_id} operation.
 // フレンド削除（フォロー解除）
+// フォローを解除したユーザーの投稿・リポストはタイムラインから削除します。
 func (h *Handler) FriendsUserIDDelete(ctx context.Context, params api.FriendsUserIDDeleteParams) (api.FriendsUserIDDeleteRes, error) {
-	// TODO: APIの処理を実装
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	following, err := h.isFollowing(ctx, userID, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !following {
+		return nil, ErrNotFound
+	}
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		return tx.User.UpdateOneID(userID).
+			RemoveFollowingIDs(params.UserID).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &api.FriendsUserIDDeleteNoContent{}, nil
 }
 
 // UsersUserIDFriendsGet implements GET /users/{user_id}/friends operation.
 // ユーザーのフレンド（フォロー）一覧取得
+// 閲覧者とブロック関係にあるユーザーの一覧は存在しないものとして扱い、一覧からも除きます。
 func (h *Handler) UsersUserIDFriendsGet(ctx context.Context, params api.UsersUserIDFriendsGetParams) (api.UsersUserIDFriendsGetRes, error) {
-	// TODO: APIの処理を実装
-	return &api.UsersUserIDFriendsGetOKApplicationJSON{}, nil
+	viewer, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := h.client.User.Query().
+		Where(user.ID(params.UserID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := h.isBlocked(ctx, viewer, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists || blocked {
+		return nil, ErrNotFound
+	}
+
+	ids, link, err := h.followingPage(ctx, params.UserID, viewer, params.Cursor, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &api.UsersUserIDFriendsGetOKHeaders{Link: link, Response: ids}, nil
+}
+
+// followingPage はユーザーがフォローしているユーザーのIDを、登録日時の新しい順に1ページ分返します。
+// 閲覧者とブロック関係にあるユーザーは含めません。
+func (h *Handler) followingPage(ctx context.Context, userID, viewer uuid.UUID, c api.OptString, limit api.OptInt) ([]uuid.UUID, api.OptString, error) {
+	page, err := h.keysetPagination("friends", c, api.OptInt{}, limit)
+	if err != nil {
+		return nil, api.OptString{}, err
+	}
+	blocked, err := h.blockedUserIDs(ctx, viewer)
+	if err != nil {
+		return nil, api.OptString{}, err
+	}
+
+	q := h.client.User.Query().
+		Where(user.HasFollowersWith(user.ID(userID)))
+	if len(blocked) > 0 {
+		q.Where(user.IDNotIn(blocked...))
+	}
+	if p := page.after; p != nil {
+		q.Where(user.Or(
+			user.CreatedAtLT(p.Time),
+			user.And(user.CreatedAt(p.Time), user.IDLT(p.ID)),
+		))
+	}
+	users, err := q.
+		Select(user.FieldID, user.FieldCreatedAt).
+		Order(user.ByCreatedAt(sql.OrderDesc()), user.ByID(sql.OrderDesc())).
+		Limit(page.limit).
+		All(ctx)
+	if err != nil {
+		return nil, api.OptString{}, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	var last cursor.Position
+	if len(users) > 0 {
+		u := users[len(users)-1]
+		last = cursor.Position{Time: u.CreatedAt, ID: u.ID}
+	}
+	return ids, page.next(len(users), last, nil), nil
+}
+
+// isFollowing はユーザーが相手をフォローしているかを返します。
+func (h *Handler) isFollowing(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error) {
+	return h.client.User.Query().
+		Where(
+			user.ID(followerID),
+			user.HasFollowingWith(user.ID(followeeID)),
+		).
+		Exist(ctx)
 }
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/api"
+	"backend/internal/analytics"
+)
+
+// GoalsGoalIDAnalyticsGet implements GET /goals/{goal_id}/analytics operation.
+// 目標の進捗分析取得
+func (h *Handler) GoalsGoalIDAnalyticsGet(ctx context.Context, params api.GoalsGoalIDAnalyticsGetParams) (api.GoalsGoalIDAnalyticsGetRes, error) {
+	report, err := h.analytics.GoalReport(ctx, params.GoalID, analytics.Range{
+		From: optDatePtr(params.From),
+		To:   optDatePtr(params.To),
+	})
+	if err != nil {
+		if errors.Is(err, analytics.ErrInvalidRange) {
+			return nil, fmt.Errorf("%w: from must not be after to and the range must be at most %d days", ErrBadRequest, analytics.MaxRangeDays)
+		}
+		return nil, err
+	}
+
+	res := &api.GoalAnalytics{
+		GoalID:      report.GoalID,
+		TimeZone:    report.Location.String(),
+		From:        report.From,
+		To:          report.To,
+		PostCount:   report.PostCount,
+		TotalAmount: report.TotalAmount,
+		ActiveDays:  report.ActiveDays,
+		Heatmap:     make([]api.AnalyticsDay, 0, len(report.Heatmap)),
+		Reactions:   make([]api.AnalyticsReactionDay, 0, len(report.Reactions)),
+	}
+	for _, d := range report.Heatmap {
+		res.Heatmap = append(res.Heatmap, api.AnalyticsDay{
+			Date:      d.Date,
+			PostCount: d.Count,
+			Amount:    d.Amount,
+		})
+	}
+	for _, d := range report.Reactions {
+		res.Reactions = append(res.Reactions, api.AnalyticsReactionDay{
+			Date:  d.Date,
+			Count: d.Count,
+		})
+	}
+	if report.AverageGap != nil {
+		res.AverageGapHours = api.NewOptFloat64(report.AverageGap.Hours())
+	}
+	if report.LongestGap != nil {
+		res.LongestGapHours = api.NewOptFloat64(report.LongestGap.Hours())
+	}
+	if d := report.Deadline; d != nil {
+		res.Deadline = api.NewOptDeadlineProgress(api.DeadlineProgress{
+			Deadline:      d.Deadline,
+			DaysRemaining: d.DaysRemaining,
+			ElapsedRatio:  d.ElapsedRatio,
+			Overdue:       d.DaysRemaining < 0,
+		})
+	}
+
+	return res, nil
+}
@@ -2,44 +2,580 @@ package handler
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"time"
 
 	"backend/api"
+	"backend/ent"
+	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/milestone"
+	"backend/ent/post"
+	"backend/ent/predicate"
+	"backend/ent/user"
+	"backend/internal/cursor"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 )
 
-// GoalsGet は現在のユーザーの目標一覧取得のモック実装です
+// GoalsGet は現在のユーザーが参加している目標の一覧を取得します。
 func (h *Handler) GoalsGet(ctx context.Context, params api.GoalsGetParams) (api.GoalsGetRes, error) {
-	// TODO: 実装
-	goals := api.GoalsGetOKApplicationJSON([]api.Goal{})
-	return &goals, nil
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	goals, link, err := h.listGoals(ctx, userID, params.Sort, params.Cursor, params.Page, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GoalsGetOKHeaders{Link: link, Response: goals}, nil
 }
 
-// GoalsPost は新規目標作成のモック実装です
+// GoalsPost は新規目標を作成します。作成者は目標のオーナーになります。
 func (h *Handler) GoalsPost(ctx context.Context, req *api.GoalRequest) (api.GoalsPostRes, error) {
-	// TODO: 実装
-	return &api.Goal{}, nil
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned := req.Pinned.Or(false)
+	var goalID uuid.UUID
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		if pinned {
+			if err := checkPinLimitTx(ctx, tx, userID, uuid.Nil, h.config.MaxPinnedGoals); err != nil {
+				return err
+			}
+		}
+		position, err := nextGoalPositionTx(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		g, err := tx.Goal.Create().
+			SetTitle(req.Title).
+			SetNillableDeadline(optDatePtr(req.Deadline)).
+			SetPinned(pinned).
+			SetPosition(position).
+			SetUserID(userID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		goalID = g.ID
+		return addGoalOwnerTx(ctx, tx, g.ID, userID, position)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := h.getGoal(ctx, goalID)
+	if err != nil {
+		return nil, err
+	}
+	return toAPIGoal(g), nil
 }
 
-// GoalsGoalIDDelete は目標削除のモック実装です
+// GoalsGoalIDDelete は目標を削除します。オーナーのみ実行できます。
+// 目標に紐づく投稿も同時に削除されます。
 func (h *Handler) GoalsGoalIDDelete(ctx context.Context, params api.GoalsGoalIDDeleteParams) (api.GoalsGoalIDDeleteRes, error) {
-	// TODO: 実装
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.requireGoalOwner(ctx, params.GoalID, userID); err != nil {
+		return nil, err
+	}
+
+	var objects []string
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		postIDs, err := tx.Post.Query().
+			Where(post.HasGoalWith(goal.ID(params.GoalID))).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if objects, err = deletePostsTx(ctx, tx, postIDs); err != nil {
+			return err
+		}
+		return tx.Goal.DeleteOneID(params.GoalID).Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.deleteObjects(ctx, objects)
+
 	return &api.GoalsGoalIDDeleteNoContent{}, nil
 }
 
-// GoalsGoalIDGet は目標詳細取得のモック実装です
+// GoalsGoalIDGet は目標の詳細を参加者ごとの貢献数と合わせて取得します。
 func (h *Handler) GoalsGoalIDGet(ctx context.Context, params api.GoalsGoalIDGetParams) (api.GoalsGoalIDGetRes, error) {
-	// TODO: 実装
-	return &api.Error{}, nil
+	g, err := h.getGoal(ctx, params.GoalID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := h.goalParticipants(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toAPIGoal(g)
+	res.Participants = participants
+	return res, nil
 }
 
-// GoalsGoalIDPut は目標更新のモック実装です
+// GoalsGoalIDPut は目標を更新します。オーナーのみ実行できます。
 func (h *Handler) GoalsGoalIDPut(ctx context.Context, req *api.GoalRequest, params api.GoalsGoalIDPutParams) (api.GoalsGoalIDPutRes, error) {
-	// TODO: 実装
-	return &api.Goal{}, nil
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.requireGoalOwner(ctx, params.GoalID, userID); err != nil {
+		return nil, err
+	}
+
+	pinned, setPinned := req.Pinned.Get()
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		if setPinned && pinned {
+			if err := checkPinLimitTx(ctx, tx, userID, params.GoalID, h.config.MaxPinnedGoals); err != nil {
+				return err
+			}
+		}
+		upd := tx.Goal.UpdateOneID(params.GoalID).
+			SetTitle(req.Title)
+		if d, ok := req.Deadline.Get(); ok {
+			upd.SetDeadline(d)
+		} else {
+			upd.ClearDeadline()
+		}
+		if setPinned {
+			upd.SetPinned(pinned)
+		}
+		return upd.Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := h.getGoal(ctx, params.GoalID)
+	if err != nil {
+		return nil, err
+	}
+	return toAPIGoal(g), nil
 }
 
-// UsersUserIDGoalsGet は指定ユーザーの目標一覧取得のモック実装です
+// UsersUserIDGoalsGet は指定ユーザーが参加している目標の一覧を取得します。
 func (h *Handler) UsersUserIDGoalsGet(ctx context.Context, params api.UsersUserIDGoalsGetParams) (api.UsersUserIDGoalsGetRes, error) {
-	// TODO: 実装
-	goals := api.UsersUserIDGoalsGetOKApplicationJSON([]api.Goal{})
-	return &goals, nil
+	exists, err := h.client.User.Query().
+		Where(user.ID(params.UserID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	goals, link, err := h.listGoals(ctx, params.UserID, params.Sort, params.Cursor, params.Page, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.UsersUserIDGoalsGetOKHeaders{Link: link, Response: goals}, nil
+}
+
+// GoalsOrderPut は自分が参加している目標の並び順を一括で更新します。
+// 並び順は参加者ごとに保存するため、他の参加者の並び順は変わりません。
+func (h *Handler) GoalsOrderPut(ctx context.Context, req *api.GoalOrderRequest) (api.GoalsOrderPutRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		participating, err := tx.Goal.Query().
+			Where(participatingIn(userID)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if err := sameGoalSet(participating, req.GoalIds); err != nil {
+			return err
+		}
+
+		for i, id := range req.GoalIds {
+			err := tx.GoalParticipant.Update().
+				Where(
+					goalparticipant.HasGoalWith(goal.ID(id)),
+					goalparticipant.HasUserWith(user.ID(userID)),
+				).
+				SetPosition(i).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			// 目標の表示順はオーナーの並び順として、参加者の記録がない目標の並び替えと一覧以外のレスポンスに使う
+			err = tx.Goal.Update().
+				Where(goal.ID(id), goal.HasUserWith(user.ID(userID))).
+				SetPosition(i).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	goals, err := h.goalQuery().
+		Where(participatingIn(userID)).
+		WithParticipants(participantOf(userID)).
+		Order(goalOrder(api.GoalSortPosition, userID)...).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(api.GoalsOrderPutOKApplicationJSON, 0, len(goals))
+	for _, g := range goals {
+		res = append(res, *toAPIGoalFor(g))
+	}
+	return &res, nil
+}
+
+// listGoals はユーザーが参加している目標を指定された並び順で取得します。並び順の省略時は表示順です。
+// カーソルによるページングは作成日時の並び順でのみ使え、次のページのLinkヘッダーもその場合にのみ返します。
+// 並び順を省略してカーソルを指定した場合は作成日時の並び順にします。
+func (h *Handler) listGoals(ctx context.Context, userID uuid.UUID, s api.OptGoalSort, c api.OptString, page, limit api.OptInt) ([]api.Goal, api.OptString, error) {
+	sort := s.Or(api.GoalSortPosition)
+	if c.Set {
+		sort = s.Or(api.GoalSortCreated)
+	}
+	if c.Set && sort != api.GoalSortCreated {
+		return nil, api.OptString{}, fmt.Errorf("%w: cursor can only be used with sort=created", ErrBadRequest)
+	}
+	pr, err := h.keysetPagination("goals", c, page, limit)
+	if err != nil {
+		return nil, api.OptString{}, err
+	}
+
+	q := h.goalQuery().
+		Where(participatingIn(userID)).
+		WithParticipants(participantOf(userID))
+	if p := pr.after; p != nil {
+		q.Where(goal.Or(
+			goal.CreatedAtLT(p.Time),
+			goal.And(goal.CreatedAt(p.Time), goal.IDLT(p.ID)),
+		))
+	}
+	goals, err := q.
+		Order(goalOrder(sort, userID)...).
+		Offset(pr.offset).
+		Limit(pr.limit).
+		All(ctx)
+	if err != nil {
+		return nil, api.OptString{}, err
+	}
+
+	res := make([]api.Goal, 0, len(goals))
+	var last cursor.Position
+	for _, g := range goals {
+		res = append(res, *toAPIGoalFor(g))
+		last = cursor.Position{Time: g.CreatedAt, ID: g.ID}
+	}
+	var link api.OptString
+	if sort == api.GoalSortCreated {
+		link = pr.next(len(goals), last, url.Values{"sort": {string(sort)}})
+	}
+	return res, link, nil
+}
+
+// goalOrder はuserIDのユーザーの目標一覧の並び順に対応するORDER BY句を返します。
+// いずれの並び順でも最後にIDで並べ、ページングの結果を安定させます。
+func goalOrder(sort api.GoalSort, userID uuid.UUID) []goal.OrderOption {
+	switch sort {
+	case api.GoalSortDeadline:
+		return []goal.OrderOption{
+			goal.ByDeadline(sql.OrderNullsLast()),
+			goal.ByCreatedAt(sql.OrderDesc()),
+			goal.ByID(),
+		}
+	case api.GoalSortCreated:
+		// カーソルによるページングの位置と同じ向きにIDも降順で並べる
+		return []goal.OrderOption{
+			goal.ByCreatedAt(sql.OrderDesc()),
+			goal.ByID(sql.OrderDesc()),
+		}
+	case api.GoalSortRecentActivity:
+		return []goal.OrderOption{
+			byLatestPost(),
+			goal.ByCreatedAt(sql.OrderDesc()),
+			goal.ByID(),
+		}
+	default:
+		return []goal.OrderOption{
+			goal.ByPinned(sql.OrderDesc()),
+			byParticipantPosition(userID),
+			goal.ByCreatedAt(sql.OrderDesc()),
+			goal.ByID(),
+		}
+	}
+}
+
+// byParticipantPosition はユーザーが指定した表示順で並べます。
+// 参加者の記録がない目標では、目標の表示順をオーナーの表示順として使います。
+// byLatestPostと同じく、副問い合わせの引数を引き継ぐExprFuncで組み立てます。
+func byParticipantPosition(userID uuid.UUID) goal.OrderOption {
+	return func(s *sql.Selector) {
+		t := sql.Table(goalparticipant.Table)
+		position := sql.Select(t.C(goalparticipant.FieldPosition)).
+			From(t).
+			Where(sql.And(
+				sql.ColumnsEQ(t.C(goalparticipant.GoalColumn), s.C(goal.FieldID)),
+				sql.EQ(t.C(goalparticipant.UserColumn), userID),
+			))
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("COALESCE((").Join(position).WriteString("), ").WriteString(s.C(goal.FieldPosition)).WriteString(")")
+		}))
+	}
+}
+
+// byLatestPost は目標への最新の投稿日時の降順（投稿のない目標は最後）で並べます。
+// OrderExprFuncでは副問い合わせの引数が失われるため、引数を引き継ぐExprFuncで組み立てます。
+func byLatestPost() goal.OrderOption {
+	return func(s *sql.Selector) {
+		t := sql.Table(post.Table)
+		latest := sql.Select(sql.Max(t.C(post.FieldCreatedAt))).
+			From(t).
+			Where(sql.And(
+				sql.ColumnsEQ(t.C(post.GoalColumn), s.C(goal.FieldID)),
+				sql.EQ(t.C(post.FieldStatus), post.StatusPublished),
+				sql.IsNull(t.C(post.FieldHiddenAt)),
+			))
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("(").Join(latest).WriteString(") DESC NULLS LAST")
+		}))
+	}
+}
+
+// sameGoalSet は並び替えのリクエストが参加している目標をちょうど1回ずつ含むかを検証します。
+func sameGoalSet(participating, requested []uuid.UUID) error {
+	if len(participating) != len(requested) {
+		return fmt.Errorf("%w: goal_ids must contain every goal you participate in exactly once", ErrBadRequest)
+	}
+
+	remaining := make(map[uuid.UUID]bool, len(participating))
+	for _, id := range participating {
+		remaining[id] = true
+	}
+	for _, id := range requested {
+		if !remaining[id] {
+			return fmt.Errorf("%w: goal_ids must contain every goal you participate in exactly once", ErrBadRequest)
+		}
+		delete(remaining, id)
+	}
+	return nil
+}
+
+// checkPinLimitTx はピン留めした目標がlimitを超える場合にErrBadRequestを返します。
+// exceptには更新対象の目標を指定し、上限の計算から除外します。
+// 同時にピン留めして上限を超えないよう、ユーザーの行をロックしてから数えます。
+func checkPinLimitTx(ctx context.Context, tx *ent.Tx, userID, except uuid.UUID, limit int) error {
+	if _, err := tx.User.Query().Where(user.ID(userID)).ForUpdate().Only(ctx); err != nil {
+		return err
+	}
+	count, err := tx.Goal.Query().
+		Where(
+			goal.HasUserWith(user.ID(userID)),
+			goal.Pinned(true),
+			goal.IDNEQ(except),
+		).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if count >= limit {
+		return fmt.Errorf("%w: at most %d goals can be pinned", ErrBadRequest, limit)
+	}
+	return nil
+}
+
+// nextGoalPositionTx は目標をユーザーの一覧の末尾に置くための表示順を返します。
+// 参加者の記録がない目標は、目標の表示順をオーナーの表示順として数えます。
+func nextGoalPositionTx(ctx context.Context, tx *ent.Tx, userID uuid.UUID) (int, error) {
+	next := 0
+	owned, err := tx.Goal.Query().
+		Where(goal.HasUserWith(user.ID(userID))).
+		Order(goal.ByPosition(sql.OrderDesc())).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, err
+	}
+	if owned != nil {
+		next = owned.Position + 1
+	}
+	joined, err := tx.GoalParticipant.Query().
+		Where(goalparticipant.HasUserWith(user.ID(userID))).
+		Order(goalparticipant.ByPosition(sql.OrderDesc())).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, err
+	}
+	if joined != nil {
+		next = max(next, joined.Position+1)
+	}
+	return next, nil
+}
+
+// participantOf はユーザー自身の参加者の記録だけを読み込むよう、参加者のエッジのクエリを絞り込みます。
+func participantOf(userID uuid.UUID) func(*ent.GoalParticipantQuery) {
+	return func(q *ent.GoalParticipantQuery) {
+		q.Where(goalparticipant.HasUserWith(user.ID(userID)))
+	}
+}
+
+// participatingIn はユーザーがオーナーまたは参加中の目標に一致する述語を返します。
+func participatingIn(userID uuid.UUID) predicate.Goal {
+	return goal.Or(
+		goal.HasUserWith(user.ID(userID)),
+		goal.HasParticipantsWith(
+			goalparticipant.HasUserWith(user.ID(userID)),
+			goalparticipant.StatusEQ(goalparticipant.StatusActive),
+		),
+	)
+}
+
+// goalQuery はレスポンスに必要なエッジを読み込む目標クエリを返します。
+func (h *Handler) goalQuery() *ent.GoalQuery {
+	return h.client.Goal.Query().
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		WithMilestones(func(q *ent.MilestoneQuery) {
+			q.Order(milestone.ByPosition())
+		})
+}
+
+// getGoal はレスポンスに必要なエッジを含めて目標を取得します。
+func (h *Handler) getGoal(ctx context.Context, id uuid.UUID) (*ent.Goal, error) {
+	return h.goalQuery().
+		Where(goal.ID(id)).
+		Only(ctx)
+}
+
+// goalRole は目標におけるユーザーの役割を返します。
+// 参加していない、または招待を承認していないユーザーにはErrForbiddenを返します。
+func (h *Handler) goalRole(ctx context.Context, goalID, userID uuid.UUID) (goalparticipant.Role, error) {
+	p, err := h.client.GoalParticipant.Query().
+		Where(
+			goalparticipant.HasGoalWith(goal.ID(goalID)),
+			goalparticipant.HasUserWith(user.ID(userID)),
+			goalparticipant.StatusEQ(goalparticipant.StatusActive),
+		).
+		Only(ctx)
+	if err == nil {
+		return p.Role, nil
+	}
+	if !ent.IsNotFound(err) {
+		return "", err
+	}
+
+	// 参加者の記録がない目標は作成者をオーナーとして扱う
+	g, err := h.getGoal(ctx, goalID)
+	if err != nil {
+		return "", err
+	}
+	if g.Edges.User != nil && g.Edges.User.ID == userID {
+		return goalparticipant.RoleOwner, nil
+	}
+	return "", ErrForbidden
+}
+
+// requireGoalOwner はユーザーが目標のオーナーでない場合にErrForbiddenを返します。
+func (h *Handler) requireGoalOwner(ctx context.Context, goalID, userID uuid.UUID) error {
+	role, err := h.goalRole(ctx, goalID, userID)
+	if err != nil {
+		return err
+	}
+	if role != goalparticipant.RoleOwner {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// addGoalOwnerTx は目標の作成者をオーナーとして、一覧の表示順をpositionにして参加者に追加します。
+func addGoalOwnerTx(ctx context.Context, tx *ent.Tx, goalID, userID uuid.UUID, position int) error {
+	return tx.GoalParticipant.Create().
+		SetGoalID(goalID).
+		SetUserID(userID).
+		SetRole(goalparticipant.RoleOwner).
+		SetStatus(goalparticipant.StatusActive).
+		SetJoinedAt(time.Now()).
+		SetPosition(position).
+		Exec(ctx)
+}
+
+// toAPIGoal はentの目標をAPIレスポンスの形式に変換します。
+// 所有者とマイルストーンはエッジが読み込まれている場合のみ設定されます。
+func toAPIGoal(g *ent.Goal) *api.Goal {
+	res := &api.Goal{
+		ID:        g.ID,
+		Title:     g.Title,
+		Pinned:    g.Pinned,
+		Position:  g.Position,
+		CreatedAt: g.CreatedAt,
+		HabitDays: toAPIWeekdays(g.HabitDays),
+	}
+	if g.Edges.User != nil {
+		res.UserID = g.Edges.User.ID
+	}
+	if g.Deadline != nil {
+		res.Deadline = api.NewOptDate(*g.Deadline)
+	}
+	for _, m := range g.Edges.Milestones {
+		am := api.Milestone{
+			ID:       m.ID,
+			Title:    m.Title,
+			Position: m.Position,
+		}
+		if m.DueDate != nil {
+			am.DueDate = api.NewOptDate(*m.DueDate)
+		}
+		res.Milestones = append(res.Milestones, am)
+	}
+	return res
+}
+
+// toAPIGoalFor は一覧の目標をAPIレスポンスの形式に変換します。
+// 表示順は一覧のユーザーの参加者の記録 (participantOfで読み込んだもの) があれば、そのユーザーが指定したものにします。
+func toAPIGoalFor(g *ent.Goal) *api.Goal {
+	res := toAPIGoal(g)
+	if len(g.Edges.Participants) > 0 {
+		res.Position = g.Edges.Participants[0].Position
+	}
+	return res
+}
+
+// toAPIWeekdays は曜日の文字列をAPIの曜日型に変換します。
+func toAPIWeekdays(days []string) []api.Weekday {
+	res := make([]api.Weekday, 0, len(days))
+	for _, d := range days {
+		res = append(res, api.Weekday(d))
+	}
+	return res
+}
+
+// fromAPIWeekdays はAPIの曜日型を文字列に変換します。
+func fromAPIWeekdays(days []api.Weekday) []string {
+	res := make([]string, 0, len(days))
+	for _, d := range days {
+		res = append(res, string(d))
+	}
+	return res
 }
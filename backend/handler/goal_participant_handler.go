@@ -0,0 +1,319 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/post"
+	"backend/ent/predicate"
+	"backend/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// GoalsInvitationsGet implements GET /goals/invitations operation.
+// 自分宛ての目標への招待一覧取得
+func (h *Handler) GoalsInvitationsGet(ctx context.Context) (api.GoalsInvitationsGetRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	goals, err := h.goalQuery().
+		Where(goal.HasParticipantsWith(
+			goalparticipant.HasUserWith(user.ID(userID)),
+			goalparticipant.StatusEQ(goalparticipant.StatusInvited),
+		)).
+		Order(goal.ByCreatedAt(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(api.GoalsInvitationsGetOKApplicationJSON, 0, len(goals))
+	for _, g := range goals {
+		res = append(res, *toAPIGoal(g))
+	}
+	return &res, nil
+}
+
+// GoalsGoalIDParticipantsGet implements GET /goals/{goal_id}/participants operation.
+// 目標の参加者一覧取得
+func (h *Handler) GoalsGoalIDParticipantsGet(ctx context.Context, params api.GoalsGoalIDParticipantsGetParams) (api.GoalsGoalIDParticipantsGetRes, error) {
+	g, err := h.getGoal(ctx, params.GoalID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := h.goalParticipants(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	res := api.GoalsGoalIDParticipantsGetOKApplicationJSON(participants)
+	return &res, nil
+}
+
+// GoalsGoalIDParticipantsPost implements POST /goals/{goal_id}/participants operation.
+// 目標にユーザーを招待
+func (h *Handler) GoalsGoalIDParticipantsPost(ctx context.Context, req *api.GoalsGoalIDParticipantsPostReq, params api.GoalsGoalIDParticipantsPostParams) (api.GoalsGoalIDParticipantsPostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.requireGoalOwner(ctx, params.GoalID, userID); err != nil {
+		return nil, err
+	}
+	if req.UserID == userID {
+		return nil, fmt.Errorf("%w: cannot invite yourself", ErrBadRequest)
+	}
+
+	exists, err := h.client.User.Query().
+		Where(user.ID(req.UserID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	p, err := h.client.GoalParticipant.Create().
+		SetGoalID(params.GoalID).
+		SetUserID(req.UserID).
+		SetRole(goalparticipant.RoleMember).
+		SetStatus(goalparticipant.StatusInvited).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIGoalParticipant(p, req.UserID, contribution{}), nil
+}
+
+// GoalsGoalIDParticipantsAcceptPost implements POST /goals/{goal_id}/participants/accept operation.
+// 目標への招待を承認
+func (h *Handler) GoalsGoalIDParticipantsAcceptPost(ctx context.Context, params api.GoalsGoalIDParticipantsAcceptPostParams) (api.GoalsGoalIDParticipantsAcceptPostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := h.client.GoalParticipant.Query().
+		Where(
+			goalparticipant.HasGoalWith(goal.ID(params.GoalID)),
+			goalparticipant.HasUserWith(user.ID(userID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Status == goalparticipant.StatusInvited {
+		// 参加した目標は一覧の末尾に置く
+		err = h.withTx(ctx, func(tx *ent.Tx) error {
+			position, err := nextGoalPositionTx(ctx, tx, userID)
+			if err != nil {
+				return err
+			}
+			p, err = tx.GoalParticipant.UpdateOne(p).
+				SetStatus(goalparticipant.StatusActive).
+				SetJoinedAt(time.Now()).
+				SetPosition(position).
+				Save(ctx)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	totals, err := h.goalContributions(ctx, params.GoalID, post.HasUserWith(user.ID(userID)))
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIGoalParticipant(p, userID, totals[userID]), nil
+}
+
+// GoalsGoalIDParticipantsUserIDDelete implements DELETE /goals/{goal_id}/participants/{user_id} operation.
+// 目標の参加者を削除
+func (h *Handler) GoalsGoalIDParticipantsUserIDDelete(ctx context.Context, params api.GoalsGoalIDParticipantsUserIDDeleteParams) (api.GoalsGoalIDParticipantsUserIDDeleteRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 本人以外を削除できるのはオーナーのみ
+	if params.UserID != userID {
+		if err := h.requireGoalOwner(ctx, params.GoalID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := h.client.GoalParticipant.Query().
+		Where(
+			goalparticipant.HasGoalWith(goal.ID(params.GoalID)),
+			goalparticipant.HasUserWith(user.ID(params.UserID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p.Role == goalparticipant.RoleOwner {
+		return nil, fmt.Errorf("%w: the owner cannot leave the goal", ErrBadRequest)
+	}
+
+	if err := h.client.GoalParticipant.DeleteOne(p).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return &api.GoalsGoalIDParticipantsUserIDDeleteNoContent{}, nil
+}
+
+// GoalsGoalIDPostsGet implements GET /goals/{goal_id}/posts operation.
+// 目標の投稿フィード取得
+func (h *Handler) GoalsGoalIDPostsGet(ctx context.Context, params api.GoalsGoalIDPostsGetParams) (api.GoalsGoalIDPostsGetRes, error) {
+	page, err := h.keysetPagination("goal_posts", params.Cursor, params.Page, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := h.client.Goal.Query().
+		Where(goal.ID(params.GoalID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	// 閲覧者とブロック関係にあるユーザーの投稿は含めない
+	viewer := viewerID(ctx)
+	blocked, err := h.blockedUserIDs(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+	q := h.postQuery().
+		Where(
+			post.HasGoalWith(goal.ID(params.GoalID)),
+			post.StatusEQ(post.StatusPublished),
+			visiblePosts(viewer, blocked),
+		)
+	posts, err := h.postPage(ctx, q, page)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.toAPIPosts(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GoalsGoalIDPostsGetOKHeaders{
+		Link:     page.next(len(posts), lastPostPosition(posts), nil),
+		Response: res,
+	}, nil
+}
+
+// goalParticipants は目標の参加者と、参加者ごとの投稿数・進捗量の合計を取得します。
+// 参加者の記録がない目標では作成者をオーナーとして含めます。
+func (h *Handler) goalParticipants(ctx context.Context, g *ent.Goal) ([]api.GoalParticipant, error) {
+	participants, err := h.client.GoalParticipant.Query().
+		Where(goalparticipant.HasGoalWith(goal.ID(g.ID))).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		Order(goalparticipant.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := h.goalContributions(ctx, g.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.GoalParticipant, 0, len(participants)+1)
+	hasOwner := false
+	for _, p := range participants {
+		if p.Role == goalparticipant.RoleOwner {
+			hasOwner = true
+		}
+		res = append(res, *toAPIGoalParticipant(p, p.Edges.User.ID, totals[p.Edges.User.ID]))
+	}
+	if !hasOwner && g.Edges.User != nil {
+		total := totals[g.Edges.User.ID]
+		owner := api.GoalParticipant{
+			UserID:      g.Edges.User.ID,
+			Role:        api.GoalParticipantRoleOwner,
+			Status:      api.GoalParticipantStatusActive,
+			PostCount:   total.postCount,
+			TotalAmount: total.amount,
+			JoinedAt:    api.NewOptDateTime(g.CreatedAt),
+		}
+		res = append([]api.GoalParticipant{owner}, res...)
+	}
+	return res, nil
+}
+
+// contribution は参加者の目標への投稿数と進捗量の合計です。
+type contribution struct {
+	postCount int
+	amount    float64
+}
+
+// goalContributions は目標への公開済みの投稿を、投稿したユーザーごとに投稿数と進捗量の合計に集計します。
+// 確認のために保留中の投稿は数えません。
+func (h *Handler) goalContributions(ctx context.Context, goalID uuid.UUID, where ...predicate.Post) (map[uuid.UUID]contribution, error) {
+	var rows []struct {
+		UserID uuid.UUID `json:"user_posts"`
+		Count  int       `json:"count"`
+		// 進捗量のない投稿だけの場合はNULLになる
+		Sum *float64 `json:"sum"`
+	}
+	err := h.client.Post.Query().
+		Where(
+			post.HasGoalWith(goal.ID(goalID)),
+			post.StatusEQ(post.StatusPublished),
+			post.HiddenAtIsNil(),
+		).
+		Where(where...).
+		GroupBy(post.UserColumn).
+		Aggregate(ent.Count(), ent.Sum(post.FieldAmount)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[uuid.UUID]contribution, len(rows))
+	for _, r := range rows {
+		c := contribution{postCount: r.Count}
+		if r.Sum != nil {
+			c.amount = *r.Sum
+		}
+		res[r.UserID] = c
+	}
+	return res, nil
+}
+
+// toAPIGoalParticipant はentの参加者をAPIレスポンスの形式に変換します。
+func toAPIGoalParticipant(p *ent.GoalParticipant, userID uuid.UUID, total contribution) *api.GoalParticipant {
+	res := &api.GoalParticipant{
+		UserID:      userID,
+		Role:        api.GoalParticipantRole(p.Role),
+		Status:      api.GoalParticipantStatus(p.Status),
+		PostCount:   total.postCount,
+		TotalAmount: total.amount,
+	}
+	if p.JoinedAt != nil {
+		res.JoinedAt = api.NewOptDateTime(*p.JoinedAt)
+	}
+	return res
+}
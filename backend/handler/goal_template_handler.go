@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/genre"
+	"backend/ent/goaltemplate"
+	"backend/ent/schema/types"
+
+	"github.com/google/uuid"
+)
+
+// GenresGenreIDTemplatesGet implements GET /genres/{genre_id}/templates operation.
+// ジャンル別の目標テンプレート一覧取得
+func (h *Handler) GenresGenreIDTemplatesGet(ctx context.Context, params api.GenresGenreIDTemplatesGetParams) (api.GenresGenreIDTemplatesGetRes, error) {
+	exists, err := h.client.Genre.Query().
+		Where(genre.ID(params.GenreID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	templates, err := h.client.GoalTemplate.Query().
+		Where(goaltemplate.HasGenreWith(genre.ID(params.GenreID))).
+		Order(goaltemplate.ByTitle()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(api.GenresGenreIDTemplatesGetOKApplicationJSON, 0, len(templates))
+	for _, t := range templates {
+		res = append(res, *toAPIGoalTemplate(t, params.GenreID))
+	}
+	return &res, nil
+}
+
+// GoalsFromTemplateTemplateIDPost implements POST /goals/from-template/{template_id} operation.
+// テンプレートから目標を作成
+func (h *Handler) GoalsFromTemplateTemplateIDPost(ctx context.Context, req api.OptGoalFromTemplateRequest, params api.GoalsFromTemplateTemplateIDPostParams) (api.GoalsFromTemplateTemplateIDPostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := h.client.GoalTemplate.Get(ctx, params.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	// マイルストーンの期日は作成日を起点に算出する
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	title := tmpl.Title
+	if t, ok := req.Value.Title.Get(); ok && t != "" {
+		title = t
+	}
+
+	var deadline *time.Time
+	if d, ok := req.Value.Deadline.Get(); ok {
+		deadline = &d
+	} else if tmpl.SuggestedDurationDays != nil {
+		d := start.AddDate(0, 0, *tmpl.SuggestedDurationDays)
+		deadline = &d
+	}
+
+	var goalID uuid.UUID
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		position, err := nextGoalPositionTx(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		g, err := tx.Goal.Create().
+			SetTitle(title).
+			SetNillableDeadline(deadline).
+			SetHabitDays(tmpl.HabitDays).
+			SetPosition(position).
+			SetUserID(userID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		goalID = g.ID
+		if err := addGoalOwnerTx(ctx, tx, g.ID, userID, position); err != nil {
+			return err
+		}
+
+		if len(tmpl.Milestones) == 0 {
+			return nil
+		}
+		builders := make([]*ent.MilestoneCreate, 0, len(tmpl.Milestones))
+		for i, m := range tmpl.Milestones {
+			builders = append(builders, tx.Milestone.Create().
+				SetTitle(m.Title).
+				SetDueDate(start.AddDate(0, 0, m.OffsetDays)).
+				SetPosition(i).
+				SetGoal(g))
+		}
+		return tx.Milestone.CreateBulk(builders...).Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := h.getGoal(ctx, goalID)
+	if err != nil {
+		return nil, err
+	}
+	return toAPIGoal(g), nil
+}
+
+// AdminTemplatesPost implements POST /admin/templates operation.
+// 目標テンプレート作成（管理者）
+func (h *Handler) AdminTemplatesPost(ctx context.Context, req *api.GoalTemplateRequest) (api.AdminTemplatesPostRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := validateMilestoneTemplates(req.Milestones); err != nil {
+		return nil, err
+	}
+	if err := h.ensureGenreExists(ctx, req.GenreID); err != nil {
+		return nil, err
+	}
+
+	t, err := h.client.GoalTemplate.Create().
+		SetGenreID(req.GenreID).
+		SetTitle(req.Title).
+		SetNillableDescription(optStringPtr(req.Description)).
+		SetNillableSuggestedDurationDays(optIntPtr(req.SuggestedDurationDays)).
+		SetMilestones(fromAPIMilestoneTemplates(req.Milestones)).
+		SetHabitDays(fromAPIWeekdays(req.HabitDays)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIGoalTemplate(t, req.GenreID), nil
+}
+
+// AdminTemplatesTemplateIDPut implements PUT /admin/templates/{template_id} operation.
+// 目標テンプレート更新（管理者）
+func (h *Handler) AdminTemplatesTemplateIDPut(ctx context.Context, req *api.GoalTemplateRequest, params api.AdminTemplatesTemplateIDPutParams) (api.AdminTemplatesTemplateIDPutRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := validateMilestoneTemplates(req.Milestones); err != nil {
+		return nil, err
+	}
+	if err := h.ensureGenreExists(ctx, req.GenreID); err != nil {
+		return nil, err
+	}
+
+	upd := h.client.GoalTemplate.UpdateOneID(params.TemplateID).
+		SetGenreID(req.GenreID).
+		SetTitle(req.Title).
+		SetMilestones(fromAPIMilestoneTemplates(req.Milestones)).
+		SetHabitDays(fromAPIWeekdays(req.HabitDays))
+	if v, ok := req.Description.Get(); ok {
+		upd.SetDescription(v)
+	} else {
+		upd.ClearDescription()
+	}
+	if v, ok := req.SuggestedDurationDays.Get(); ok {
+		upd.SetSuggestedDurationDays(v)
+	} else {
+		upd.ClearSuggestedDurationDays()
+	}
+
+	t, err := upd.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIGoalTemplate(t, req.GenreID), nil
+}
+
+// AdminTemplatesTemplateIDDelete implements DELETE /admin/templates/{template_id} operation.
+// 目標テンプレート削除（管理者）
+func (h *Handler) AdminTemplatesTemplateIDDelete(ctx context.Context, params api.AdminTemplatesTemplateIDDeleteParams) (api.AdminTemplatesTemplateIDDeleteRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := h.client.GoalTemplate.DeleteOneID(params.TemplateID).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return &api.AdminTemplatesTemplateIDDeleteNoContent{}, nil
+}
+
+// ensureGenreExists はジャンルが存在しない場合にErrNotFoundを返します。
+func (h *Handler) ensureGenreExists(ctx context.Context, id uuid.UUID) error {
+	exists, err := h.client.Genre.Query().
+		Where(genre.ID(id)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// validateMilestoneTemplates はマイルストーン雛形のタイトルが空でないことを検証します。
+func validateMilestoneTemplates(ms []api.MilestoneTemplate) error {
+	for i, m := range ms {
+		if m.Title == "" {
+			return fmt.Errorf("%w: milestones[%d].title must not be empty", ErrBadRequest, i)
+		}
+	}
+	return nil
+}
+
+// toAPIGoalTemplate はentの目標テンプレートをAPIレスポンスの形式に変換します。
+func toAPIGoalTemplate(t *ent.GoalTemplate, genreID uuid.UUID) *api.GoalTemplate {
+	res := &api.GoalTemplate{
+		ID:         t.ID,
+		GenreID:    genreID,
+		Title:      t.Title,
+		Milestones: make([]api.MilestoneTemplate, 0, len(t.Milestones)),
+		HabitDays:  toAPIWeekdays(t.HabitDays),
+	}
+	if t.Description != nil {
+		res.Description = api.NewOptString(*t.Description)
+	}
+	if t.SuggestedDurationDays != nil {
+		res.SuggestedDurationDays = api.NewOptInt(*t.SuggestedDurationDays)
+	}
+	for _, m := range t.Milestones {
+		res.Milestones = append(res.Milestones, api.MilestoneTemplate{
+			Title:      m.Title,
+			OffsetDays: m.OffsetDays,
+		})
+	}
+	return res
+}
+
+// fromAPIMilestoneTemplates はAPIのマイルストーン雛形をスキーマの形式に変換します。
+func fromAPIMilestoneTemplates(ms []api.MilestoneTemplate) []types.MilestoneTemplate {
+	res := make([]types.MilestoneTemplate, 0, len(ms))
+	for _, m := range ms {
+		res = append(res, types.MilestoneTemplate{
+			Title:      m.Title,
+			OffsetDays: m.OffsetDays,
+		})
+	}
+	return res
+}
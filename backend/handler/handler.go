@@ -5,29 +5,97 @@ import (
 
 	"backend/api"
 	"backend/ent"
+	"backend/internal/analytics"
+	"backend/internal/cursor"
 	"backend/internal/jwt"
+	"backend/internal/notification"
+	"backend/internal/publisher"
+	"backend/internal/ranking"
+	"backend/internal/realtime"
+	"backend/internal/search"
+	"backend/internal/spamfilter"
+	"backend/internal/storage"
+	"backend/internal/timeline"
+	"backend/internal/unfurl"
 )
 
 // Handler は api.Handler インターフェースを実装するメイン構造体です。
 // 各ドメインごとのハンドラーを保持し、メソッド呼び出しを委譲します。
 type Handler struct {
+	config     *Config
 	client     *ent.Client
 	jwtHandler *jwt.JwtHandler
+	storage    storage.Storage
+	notifier   notification.Notifier
+	analytics  *analytics.Service
+	unfurler   unfurl.Enqueuer
+	searcher   search.Searcher
+	spam       spamfilter.Filter
+	fanout     timeline.Fanout
+	events     realtime.Publisher
+	waker      publisher.Waker
+	cursors    *cursor.Codec
+	ranking    *ranking.Config
 }
 
 // NewHandler は新しいHandlerインスタンスを作成します。
 // 各ドメインハンドラーの初期化が必要な場合は、ここで行います。
-func NewHandler(client *ent.Client, jwtHandler *jwt.JwtHandler) (*Handler, error) {
+func NewHandler(config *Config, client *ent.Client, jwtHandler *jwt.JwtHandler, store storage.Storage, notifier notification.Notifier, unfurler unfurl.Enqueuer, searcher search.Searcher, spam spamfilter.Filter, fanout timeline.Fanout, events realtime.Publisher, waker publisher.Waker, cursors *cursor.Codec, rankingConfig *ranking.Config) (*Handler, error) {
+	if config == nil {
+		return nil, ErrConfigRequired
+	}
 	if client == nil {
 		return nil, ErrClientRequired
 	}
 	if jwtHandler == nil {
 		return nil, ErrJWTHandlerRequired
 	}
+	if store == nil {
+		return nil, ErrStorageRequired
+	}
+	if notifier == nil {
+		return nil, ErrNotifierRequired
+	}
+	if unfurler == nil {
+		return nil, ErrUnfurlerRequired
+	}
+	if searcher == nil {
+		return nil, ErrSearcherRequired
+	}
+	if spam == nil {
+		return nil, ErrSpamFilterRequired
+	}
+	if fanout == nil {
+		return nil, ErrFanoutRequired
+	}
+	if events == nil {
+		return nil, ErrEventsRequired
+	}
+	if waker == nil {
+		return nil, ErrWakerRequired
+	}
+	if cursors == nil {
+		return nil, ErrCursorCodecRequired
+	}
+	if rankingConfig == nil {
+		return nil, ErrRankingConfigRequired
+	}
 
 	h := &Handler{
+		config:     config,
 		client:     client,
 		jwtHandler: jwtHandler,
+		storage:    store,
+		notifier:   notifier,
+		analytics:  analytics.NewService(client),
+		unfurler:   unfurler,
+		searcher:   searcher,
+		spam:       spam,
+		fanout:     fanout,
+		events:     events,
+		waker:      waker,
+		cursors:    cursors,
+		ranking:    rankingConfig,
 	}
 
 	return h, nil
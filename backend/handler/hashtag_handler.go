@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/api"
+	"backend/ent/hashtag"
+	"backend/ent/post"
+	"backend/ent/user"
+	"backend/internal/richtext"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// defaultTrendingHours はトレンドの集計期間が指定されていない場合の時間数です。
+const defaultTrendingHours = 24
+
+// HashtagsTagPostsGet はハッシュタグの付いた投稿を新しい順に返します。
+func (h *Handler) HashtagsTagPostsGet(ctx context.Context, params api.HashtagsTagPostsGetParams) (api.HashtagsTagPostsGetRes, error) {
+	page, err := h.keysetPagination("hashtag_posts", params.Cursor, params.Page, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	tag := richtext.NormalizeHashtag(params.Tag)
+	if tag == "" {
+		return nil, fmt.Errorf("%w: tag is required", ErrBadRequest)
+	}
+
+	viewer := viewerID(ctx)
+	blocked, err := h.blockedUserIDs(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+	q := h.postQuery().
+		Where(
+			post.HasHashtagsWith(hashtag.Name(tag)),
+			post.StatusEQ(post.StatusPublished),
+			unhiddenPosts(viewer),
+		)
+	if len(blocked) > 0 {
+		q.Where(post.Not(post.HasUserWith(user.IDIn(blocked...))))
+	}
+
+	posts, err := h.postPage(ctx, q, page)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.toAPIPosts(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+	return &api.HashtagsTagPostsGetOKHeaders{
+		Link:     page.next(len(posts), lastPostPosition(posts), nil),
+		Response: res,
+	}, nil
+}
+
+// HashtagsTrendingGet は集計期間内に付けられた投稿の多い順にハッシュタグを返します。
+func (h *Handler) HashtagsTrendingGet(ctx context.Context, params api.HashtagsTrendingGetParams) (api.HashtagsTrendingGetRes, error) {
+	_, n, err := pagination(api.OptInt{}, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	since := time.Now().Add(-time.Duration(params.Hours.Or(defaultTrendingHours)) * time.Hour)
+
+	var rows []struct {
+		Name      string `json:"name"`
+		PostCount int    `json:"post_count"`
+	}
+	err = h.client.Hashtag.Query().
+		Modify(func(s *sql.Selector) {
+			ph := sql.Table(hashtag.PostsTable)
+			p := sql.Table(post.Table)
+			s.Join(ph).
+				On(s.C(hashtag.FieldID), ph.C(hashtag.PostsPrimaryKey[1])).
+				Join(p).
+				On(ph.C(hashtag.PostsPrimaryKey[0]), p.C(post.FieldID)).
+				Where(sql.And(
+					sql.GTE(p.C(post.FieldCreatedAt), since),
+					sql.EQ(p.C(post.FieldStatus), post.StatusPublished),
+					sql.IsNull(p.C(post.FieldHiddenAt)),
+				)).
+				GroupBy(s.C(hashtag.FieldName)).
+				OrderBy(sql.Desc("post_count"), s.C(hashtag.FieldName)).
+				Limit(n)
+			s.Select(
+				s.C(hashtag.FieldName),
+				sql.As(sql.Count(p.C(post.FieldID)), "post_count"),
+			)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(api.HashtagsTrendingGetOKApplicationJSON, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, api.Hashtag{
+			Name:      r.Name,
+			PostCount: r.PostCount,
+		})
+	}
+	return &res, nil
+}
@@ -1,21 +1,205 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
 
 	"backend/api"
+	"backend/ent"
+	"backend/ent/image"
+	"backend/ent/post"
+	"backend/ent/postrevision"
+	"backend/ent/predicate"
+	"backend/ent/user"
+	"backend/internal/other"
+	"backend/internal/storage"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+)
+
+var (
+	// imageBaseURL は画像取得APIのベースURLです。
+	imageBaseURL = other.GetEnv("IMAGE_BASE_URL", "/images")
+
+	// maxImageBytes はアップロードできる画像の最大サイズです。
+	maxImageBytes = int64(other.GetEnvInt("MAX_IMAGE_BYTES", 10<<20))
 )
 
+// imageExtensions はアップロードを許可するMIMEタイプと拡張子の対応です。
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
 // ImagesImageIDGet implements GET /images/{image_id} operation.
 // 画像取得
 func (h *Handler) ImagesImageIDGet(ctx context.Context, params api.ImagesImageIDGetParams) (api.ImagesImageIDGetRes, error) {
-	// TODO: APIの処理を実装
-	return &api.ImagesImageIDGetOK{}, nil
+	img, err := h.client.Image.Query().
+		Where(image.ID(params.ImageID)).
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		WithUploadedBy(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkImageVisible(ctx, img, viewerID(ctx)); err != nil {
+		return nil, err
+	}
+
+	r, err := h.storage.Open(ctx, img.ObjectName)
+	if errors.Is(err, storage.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ImagesImageIDGetOK{Data: bytes.NewReader(data)}, nil
+}
+
+// checkImageVisible は閲覧者が画像を閲覧できない場合にErrNotFoundを返します。
+// 投稿に添付された画像は投稿を閲覧できる場合のみ閲覧できます。
+// 投稿に紐付いていない画像はアップロードしたユーザーのほか、
+// その画像を含む編集履歴を閲覧できるユーザーのみ閲覧できます。
+func (h *Handler) checkImageVisible(ctx context.Context, img *ent.Image, viewer uuid.UUID) error {
+	if p := img.Edges.Post; p != nil {
+		_, err := h.visiblePost(ctx, p.ID, viewer)
+		return err
+	}
+	if img.Edges.UploadedBy.ID == viewer {
+		return nil
+	}
+
+	// 編集で外された画像は、その画像を含む版の投稿から閲覧可否を判定する
+	postIDs, err := h.client.PostRevision.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(postrevision.FieldImageIds, img.ID.String()))
+		}).
+		QueryPost().
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, postID := range postIDs {
+		p, err := h.visiblePost(ctx, postID, viewer)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if h.config.PublicPostRevisions || p.Edges.User.ID == viewer {
+			return nil
+		}
+	}
+	return ErrNotFound
 }
 
 // ImagesPost implements POST /images operation.
 // 画像をアップロードしてIDを発行
 func (h *Handler) ImagesPost(ctx context.Context, req api.OptImagesPostReq) (api.ImagesPostRes, error) {
-	// TODO: APIの処理を実装
-	return &api.Image{}, nil
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	file, ok := req.Value.Image.Get()
+	if !req.Set || !ok {
+		return nil, fmt.Errorf("%w: image is required", ErrBadRequest)
+	}
+
+	// 上限を1バイト超えて読み込み、サイズ超過を検出する
+	data, err := io.ReadAll(io.LimitReader(file.File, maxImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxImageBytes {
+		return nil, fmt.Errorf("%w: image must be at most %d bytes", ErrBadRequest, maxImageBytes)
+	}
+
+	// クライアントが申告したContent-Typeではなく内容から判定する
+	contentType := http.DetectContentType(data)
+	ext, ok := imageExtensions[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported image type %q", ErrBadRequest, contentType)
+	}
+
+	id := uuid.New()
+	objectName := "images/" + id.String() + ext
+	if err := h.storage.Save(ctx, objectName, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	img, err := h.client.Image.Create().
+		SetID(id).
+		SetObjectName(objectName).
+		SetContentType(contentType).
+		SetUploadedByID(userID).
+		Save(ctx)
+	if err != nil {
+		h.deleteObjects(ctx, []string{objectName})
+		return nil, err
+	}
+
+	return &api.Image{
+		ID:  img.ID,
+		URL: imageURL(img.ID),
+	}, nil
+}
+
+// imageURL は画像取得APIのURLを返します。
+func imageURL(id uuid.UUID) string {
+	return imageBaseURL + "/" + id.String()
+}
+
+// deleteImagesTx は画像をトランザクション内で削除し、ストレージ上のオブジェクト名を返します。
+// オブジェクトはコミット後に deleteObjects で削除してください。
+func deleteImagesTx(ctx context.Context, tx *ent.Tx, ps ...predicate.Image) ([]string, error) {
+	names, err := tx.Image.Query().
+		Where(ps...).
+		Select(image.FieldObjectName).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	_, err = tx.Image.Delete().
+		Where(ps...).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// deleteObjects はストレージ上のオブジェクトを削除します。
+// データベースの変更は確定済みのため、失敗してもログに残すだけにします。
+func (h *Handler) deleteObjects(ctx context.Context, names []string) {
+	for _, name := range names {
+		if err := h.storage.Delete(ctx, name); err != nil {
+			slog.ErrorContext(ctx, "failed to delete image object", "object", name, "error", err.Error())
+		}
+	}
 }
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/linkpreview"
+	"backend/internal/richtext"
+)
+
+// enqueueLinkPreview は本文の最初のURLのリンクプレビューの取得を依頼します。
+// 取得は非同期に行われるため、投稿の保存を待たせません。
+func (h *Handler) enqueueLinkPreview(content string) {
+	if u := richtext.FirstURL(content); u != "" {
+		h.unfurler.Enqueue(u)
+	}
+}
+
+// linkPreviews は投稿ごとに本文の最初のURLを取り出し、取得済みのリンクプレビューを一括で取得します。
+// 戻り値のキーはURLです。取得に失敗したURLや未取得のURLは含まれません。
+func (h *Handler) linkPreviews(ctx context.Context, posts []*ent.Post) (map[string]api.LinkPreview, error) {
+	res := make(map[string]api.LinkPreview)
+	urls := make([]string, 0, len(posts))
+	for _, p := range posts {
+		if u := richtext.FirstURL(p.Content); u != "" {
+			urls = append(urls, u)
+		}
+	}
+	if len(urls) == 0 {
+		return res, nil
+	}
+
+	previews, err := h.client.LinkPreview.Query().
+		Where(
+			linkpreview.URLIn(urls...),
+			linkpreview.StatusEQ(linkpreview.StatusOk),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, lp := range previews {
+		res[lp.URL] = api.LinkPreview{
+			URL:         lp.URL,
+			Title:       optNonEmptyString(lp.Title),
+			Description: optNonEmptyString(lp.Description),
+			ImageURL:    optNonEmptyString(lp.ImageURL),
+			SiteName:    optNonEmptyString(lp.SiteName),
+		}
+	}
+	return res, nil
+}
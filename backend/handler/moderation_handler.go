@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/moderationaction"
+	"backend/ent/report"
+	"backend/ent/user"
+	"backend/internal/cursor"
+	"backend/internal/notification"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// moderationNoticeMessage は運営により非表示にされた内容の作成者に表示する説明です。
+const moderationNoticeMessage = "この内容は運営により非表示にされたため、あなた以外には表示されません。"
+
+// AdminModerationActionsGet はモデレーターが行った操作の記録を新しい順に返します (モデレーターのみ)。
+// 前回のレスポンスのLinkヘッダーのcursorを指定するキーセットページネーションです。
+func (h *Handler) AdminModerationActionsGet(ctx context.Context, params api.AdminModerationActionsGetParams) (api.AdminModerationActionsGetRes, error) {
+	if _, err := h.requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	page, err := h.keysetPagination("moderation_actions", params.Cursor, api.OptInt{}, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	q := h.moderationActionQuery(h.client)
+	query := url.Values{}
+	if targetType, ok := params.TargetType.Get(); ok {
+		q.Where(moderationaction.TargetTypeEQ(moderationaction.TargetType(targetType)))
+		query.Set("target_type", string(targetType))
+	}
+	if targetID, ok := params.TargetID.Get(); ok {
+		q.Where(moderationaction.TargetID(targetID))
+		query.Set("target_id", targetID.String())
+	}
+	if moderatorID, ok := params.ModeratorID.Get(); ok {
+		q.Where(moderationaction.HasModeratorWith(user.ID(moderatorID)))
+		query.Set("moderator_id", moderatorID.String())
+	}
+	if after, ok := params.After.Get(); ok {
+		a, err := h.client.ModerationAction.Get(ctx, after)
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: unknown cursor", ErrBadRequest)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := page.setAfter(cursor.Position{Time: a.CreatedAt, ID: a.ID}); err != nil {
+			return nil, err
+		}
+	}
+	if p := page.after; p != nil {
+		q.Where(moderationaction.Or(
+			moderationaction.CreatedAtLT(p.Time),
+			moderationaction.And(moderationaction.CreatedAt(p.Time), moderationaction.IDLT(p.ID)),
+		))
+	}
+
+	actions, err := q.
+		Order(moderationaction.ByCreatedAt(sql.OrderDesc()), moderationaction.ByID(sql.OrderDesc())).
+		Limit(page.limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.ModerationAction, 0, len(actions))
+	var last cursor.Position
+	for _, a := range actions {
+		res = append(res, *toAPIModerationAction(a))
+		last = cursor.Position{Time: a.CreatedAt, ID: a.ID}
+	}
+	return &api.AdminModerationActionsGetOKHeaders{
+		Link:     page.next(len(actions), last, query),
+		Response: res,
+	}, nil
+}
+
+// AdminModerationActionsPost は投稿・コメント・ユーザーに措置を行い、操作を記録します (モデレーターのみ)。
+// 非表示にした内容は作成者本人にのみ表示され、停止中のユーザーは認証が必要な操作を行えなくなります。
+func (h *Handler) AdminModerationActionsPost(ctx context.Context, req *api.ModerationActionRequest) (api.AdminModerationActionsPostRes, error) {
+	moderator, err := h.requireModerator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	action := moderationaction.Action(req.Action)
+	suspendedUntil := optDateTimePtr(req.SuspendedUntil)
+	if suspendedUntil != nil {
+		if action != moderationaction.ActionSuspend {
+			return nil, fmt.Errorf("%w: suspended_until can only be used with suspend", ErrBadRequest)
+		}
+		if !suspendedUntil.After(time.Now()) {
+			return nil, fmt.Errorf("%w: suspended_until must be in the future", ErrBadRequest)
+		}
+	}
+	isUserAction := action == moderationaction.ActionWarn || action == moderationaction.ActionSuspend || action == moderationaction.ActionUnsuspend
+	if isUserAction && req.TargetType != api.ReportTargetTypeUser {
+		return nil, fmt.Errorf("%w: %s can only be applied to users", ErrBadRequest, action)
+	}
+	if req.TargetType == api.ReportTargetTypeUser {
+		if err := h.checkModerationTargetUser(ctx, moderator, req.TargetID); err != nil {
+			return nil, err
+		}
+	}
+
+	var res *api.ModerationAction
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		if err := applyModerationAction(ctx, tx, action, req.TargetType, req.TargetID, suspendedUntil); err != nil {
+			return err
+		}
+
+		create := tx.ModerationAction.Create().
+			SetAction(action).
+			SetTargetType(moderationaction.TargetType(req.TargetType)).
+			SetTargetID(req.TargetID).
+			SetNote(req.Note.Value).
+			SetNillableSuspendedUntil(suspendedUntil).
+			SetModeratorID(moderator.ID)
+		if reportID, ok := req.ReportID.Get(); ok {
+			exists, err := tx.Report.Query().
+				Where(report.ID(reportID)).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return ErrNotFound
+			}
+			create.SetReportID(reportID)
+		}
+		a, err := create.Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		a, err = h.moderationActionQuery(tx.Client()).
+			Where(moderationaction.ID(a.ID)).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		res = toAPIModerationAction(a)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 措置は記録済みのため、通知の失敗はログに残すだけにする
+	if action == moderationaction.ActionWarn {
+		err := h.notifier.Notify(ctx, notification.Notification{
+			Type:    notification.TypeModerationWarning,
+			UserID:  req.TargetID,
+			ActorID: moderator.ID,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to notify moderation warning", "user_id", req.TargetID.String(), "error", err.Error())
+		}
+	}
+
+	return res, nil
+}
+
+// checkModerationTargetUser はモデレーターがユーザーに措置を行えるかを確認します。
+// 自分自身には措置を行えず、モデレーター・管理者への措置は管理者のみが行えます。
+func (h *Handler) checkModerationTargetUser(ctx context.Context, moderator *ent.User, targetID uuid.UUID) error {
+	if targetID == moderator.ID {
+		return fmt.Errorf("%w: cannot moderate yourself", ErrBadRequest)
+	}
+	target, err := h.client.User.Get(ctx, targetID)
+	if err != nil {
+		return err
+	}
+	if target.Role != user.RoleUser && moderator.Role != user.RoleAdmin {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// applyModerationAction は措置を対象に反映します。警告は記録と通知のみで、対象は変更しません。
+func applyModerationAction(ctx context.Context, tx *ent.Tx, action moderationaction.Action, targetType api.ReportTargetType, targetID uuid.UUID, suspendedUntil *time.Time) error {
+	now := time.Now()
+	switch action {
+	case moderationaction.ActionHide, moderationaction.ActionUnhide:
+		hide := action == moderationaction.ActionHide
+		switch targetType {
+		case api.ReportTargetTypePost:
+			update := tx.Post.UpdateOneID(targetID)
+			if hide {
+				update.SetHiddenAt(now)
+			} else {
+				update.ClearHiddenAt()
+			}
+			return update.Exec(ctx)
+		case api.ReportTargetTypeComment:
+			update := tx.Comment.UpdateOneID(targetID)
+			if hide {
+				update.SetHiddenAt(now)
+			} else {
+				update.ClearHiddenAt()
+			}
+			return update.Exec(ctx)
+		case api.ReportTargetTypeUser:
+			update := tx.User.UpdateOneID(targetID)
+			if hide {
+				update.SetHiddenAt(now)
+			} else {
+				update.ClearHiddenAt()
+			}
+			return update.Exec(ctx)
+		}
+		return fmt.Errorf("%w: unsupported target_type", ErrBadRequest)
+	case moderationaction.ActionWarn:
+		return nil
+	case moderationaction.ActionSuspend:
+		update := tx.User.UpdateOneID(targetID).
+			SetSuspendedAt(now)
+		if suspendedUntil != nil {
+			update.SetSuspendedUntil(*suspendedUntil)
+		} else {
+			update.ClearSuspendedUntil()
+		}
+		return update.Exec(ctx)
+	case moderationaction.ActionUnsuspend:
+		return tx.User.UpdateOneID(targetID).
+			ClearSuspendedAt().
+			ClearSuspendedUntil().
+			Exec(ctx)
+	default:
+		return fmt.Errorf("%w: unsupported action", ErrBadRequest)
+	}
+}
+
+// moderationActionQuery はレスポンスに必要なエッジを読み込む操作記録のクエリを返します。
+func (h *Handler) moderationActionQuery(client *ent.Client) *ent.ModerationActionQuery {
+	return client.ModerationAction.Query().
+		WithModerator(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		WithReport(func(q *ent.ReportQuery) {
+			q.Select(report.FieldID)
+		})
+}
+
+// toAPIModerationAction は操作記録をAPIレスポンスの形式に変換します。
+func toAPIModerationAction(a *ent.ModerationAction) *api.ModerationAction {
+	res := &api.ModerationAction{
+		ID:         a.ID,
+		Action:     api.ModerationActionType(a.Action),
+		TargetType: api.ModerationTargetType(a.TargetType),
+		TargetID:   a.TargetID,
+		Note:       optNonEmptyString(a.Note),
+		CreatedAt:  a.CreatedAt,
+	}
+	if a.SuspendedUntil != nil {
+		res.SuspendedUntil = api.NewOptDateTime(*a.SuspendedUntil)
+	}
+	if a.Edges.Moderator != nil {
+		res.ModeratorID = api.NewOptUUID(a.Edges.Moderator.ID)
+	}
+	if a.Edges.Report != nil {
+		res.ReportID = api.NewOptUUID(a.Edges.Report.ID)
+	}
+	return res
+}
+
+// moderationNotice は運営により非表示にされた内容について、作成者に表示する説明を返します。
+// 非表示の内容は作成者本人にしか返さないため、非表示であれば常に設定します。
+func moderationNotice(hiddenAt *time.Time) api.OptModerationNotice {
+	if hiddenAt == nil {
+		return api.OptModerationNotice{}
+	}
+	return api.NewOptModerationNotice(api.ModerationNotice{
+		HiddenAt: *hiddenAt,
+		Message:  moderationNoticeMessage,
+	})
+}
@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"time"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/goal"
+	entnotification "backend/ent/notification"
+	"backend/ent/post"
+	"backend/ent/user"
+	"backend/internal/cursor"
+	"backend/internal/notification"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// NotificationsGet は自分の通知を最後にまとめた日時の新しい順に返します。
+// 前回のレスポンスのLinkヘッダーのcursorを指定するキーセットページネーションです。
+func (h *Handler) NotificationsGet(ctx context.Context, params api.NotificationsGetParams) (api.NotificationsGetRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := h.keysetPagination("notifications", params.Cursor, api.OptInt{}, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	q := h.client.Notification.Query().
+		Where(entnotification.HasRecipientWith(user.ID(userID)))
+	query := url.Values{}
+	if params.Unread.Or(false) {
+		q.Where(entnotification.ReadAtIsNil())
+		query.Set("unread", "true")
+	}
+	if p := page.after; p != nil {
+		q.Where(entnotification.Or(
+			entnotification.UpdatedAtLT(p.Time),
+			entnotification.And(entnotification.UpdatedAt(p.Time), entnotification.IDLT(p.ID)),
+		))
+	}
+
+	notifications, err := q.
+		WithActor(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		WithGoal(func(q *ent.GoalQuery) {
+			q.Select(goal.FieldID)
+		}).
+		Order(entnotification.ByUpdatedAt(sql.OrderDesc()), entnotification.ByID(sql.OrderDesc())).
+		Limit(page.limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		res = append(res, toAPINotification(n))
+	}
+	var last cursor.Position
+	if len(notifications) > 0 {
+		n := notifications[len(notifications)-1]
+		last = cursor.Position{Time: n.UpdatedAt, ID: n.ID}
+	}
+	return &api.NotificationsGetOKHeaders{
+		Link:     page.next(len(notifications), last, query),
+		Response: res,
+	}, nil
+}
+
+// NotificationsReadPost は自分の通知を既読にします。IDを指定しない場合は全ての未読の通知を既読にします。
+// 自分以外の通知や既読の通知のIDは無視します。
+func (h *Handler) NotificationsReadPost(ctx context.Context, req api.OptNotificationReadRequest) (api.NotificationsReadPostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := h.client.Notification.Update().
+		Where(
+			entnotification.HasRecipientWith(user.ID(userID)),
+			entnotification.ReadAtIsNil(),
+		)
+	if r, ok := req.Get(); ok && r.Ids != nil {
+		if len(r.Ids) == 0 {
+			return &api.NotificationsReadPostNoContent{}, nil
+		}
+		update.Where(entnotification.IDIn(r.Ids...))
+	}
+	if err := update.SetReadAt(time.Now()).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return &api.NotificationsReadPostNoContent{}, nil
+}
+
+// NotificationsUnreadCountGet は自分の未読の通知の件数を返します。まとめた通知は1件として数えます。
+func (h *Handler) NotificationsUnreadCountGet(ctx context.Context) (api.NotificationsUnreadCountGetRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := h.client.Notification.Query().
+		Where(
+			entnotification.HasRecipientWith(user.ID(userID)),
+			entnotification.ReadAtIsNil(),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.NotificationUnreadCount{Count: count}, nil
+}
+
+// NotificationsPreferencesGet は自分の通知の設定を返します。
+func (h *Handler) NotificationsPreferencesGet(ctx context.Context) (api.NotificationsPreferencesGetRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := h.client.User.Query().
+		Where(user.ID(userID)).
+		Select(user.FieldNotificationOptOuts).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPINotificationPreferences(u.NotificationOptOuts), nil
+}
+
+// NotificationsPreferencesPut は自分の通知の設定を更新します。
+// falseにした種類の通知は以降は保存せず、既にある通知はそのまま残します。
+func (h *Handler) NotificationsPreferencesPut(ctx context.Context, req *api.NotificationPreferences) (api.NotificationsPreferencesPutRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := map[notification.Type]bool{
+		notification.TypeMention:  req.Mention,
+		notification.TypeReaction: req.Reaction,
+		notification.TypeFollow:   req.Follow,
+		notification.TypeComment:  req.Comment,
+	}
+	optOuts := []string{}
+	for _, t := range notification.OptOutTypes {
+		if !enabled[t] {
+			optOuts = append(optOuts, string(t))
+		}
+	}
+	err = h.client.User.UpdateOneID(userID).
+		SetNotificationOptOuts(optOuts).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPINotificationPreferences(optOuts), nil
+}
+
+// toAPINotification は通知をAPIのレスポンスに変換します。
+// WithActor・WithPost・WithGoalで最後に操作をしたユーザーと対象の投稿・目標のIDを読み込んでおきます。
+func toAPINotification(n *ent.Notification) api.Notification {
+	res := api.Notification{
+		ID:         n.ID,
+		Type:       api.NotificationType(n.Type),
+		ActorCount: n.ActorCount,
+		Read:       n.ReadAt != nil,
+		CreatedAt:  n.CreatedAt,
+		UpdatedAt:  n.UpdatedAt,
+	}
+	if a := n.Edges.Actor; a != nil {
+		res.ActorID = api.NewOptUUID(a.ID)
+	}
+	if p := n.Edges.Post; p != nil {
+		res.PostID = api.NewOptUUID(p.ID)
+	}
+	if g := n.Edges.Goal; g != nil {
+		res.GoalID = api.NewOptUUID(g.ID)
+	}
+	if n.ReadAt != nil {
+		res.ReadAt = api.NewOptDateTime(*n.ReadAt)
+	}
+	return res
+}
+
+// toAPINotificationPreferences は受け取らない通知の種類から通知の設定のレスポンスを作成します。
+func toAPINotificationPreferences(optOuts []string) *api.NotificationPreferences {
+	enabled := func(t notification.Type) bool {
+		return !slices.Contains(optOuts, string(t))
+	}
+	return &api.NotificationPreferences{
+		Mention:  enabled(notification.TypeMention),
+		Reaction: enabled(notification.TypeReaction),
+		Follow:   enabled(notification.TypeFollow),
+		Comment:  enabled(notification.TypeComment),
+	}
+}
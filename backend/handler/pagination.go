@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"backend/api"
+	"backend/internal/cursor"
+)
+
+const (
+	// defaultPageLimit はlimitが指定されていない場合の1ページあたりの件数です。
+	defaultPageLimit = 20
+	// maxPageLimit は1ページあたりの最大件数です。
+	maxPageLimit = 100
+)
+
+// pagination はPage/LimitパラメーターからSQLのoffsetとlimitを算出します。
+// limitが最大件数を超える場合は最大件数に丸めます。
+func pagination(page, limit api.OptInt) (offset, n int, err error) {
+	p := page.Or(1)
+	n = limit.Or(defaultPageLimit)
+	if p < 1 {
+		return 0, 0, fmt.Errorf("%w: page must be greater than 0", ErrBadRequest)
+	}
+	if n < 1 {
+		return 0, 0, fmt.Errorf("%w: limit must be greater than 0", ErrBadRequest)
+	}
+	if n > maxPageLimit {
+		n = maxPageLimit
+	}
+	return (p - 1) * n, n, nil
+}
+
+// pageRequest はキーセットページネーションによる一覧取得の範囲です。
+type pageRequest struct {
+	// scope はカーソルを結び付ける一覧の種類です。
+	scope string
+	codec *cursor.Codec
+	// offset は非推奨のpageパラメーターで指定された、読み飛ばす件数です。
+	offset int
+	limit  int
+	// after はcursorパラメーターで指定された位置です。指定されていない場合は先頭から取得します。
+	after *cursor.Position
+}
+
+// keysetPagination はcursor/page/limitパラメーターから一覧取得の範囲を求めます。
+// pageは移行期間のために残しているもので、cursorと同時には指定できません。
+func (h *Handler) keysetPagination(scope string, c api.OptString, page, limit api.OptInt) (pageRequest, error) {
+	offset, n, err := pagination(page, limit)
+	if err != nil {
+		return pageRequest{}, err
+	}
+	r := pageRequest{scope: scope, codec: h.cursors, offset: offset, limit: n}
+
+	v, ok := c.Get()
+	if !ok {
+		return r, nil
+	}
+	if page.Set {
+		return pageRequest{}, fmt.Errorf("%w: cursor and page cannot be used together", ErrBadRequest)
+	}
+	pos, err := h.cursors.Decode(scope, v)
+	if err != nil {
+		return pageRequest{}, fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	r.after = &pos
+	return r, nil
+}
+
+// setAfter は非推奨のafterパラメーターで指定された要素の位置をページの開始位置にします。
+// afterはcursorと同時には指定できません。
+func (r *pageRequest) setAfter(p cursor.Position) error {
+	if r.after != nil {
+		return fmt.Errorf("%w: cursor and after cannot be used together", ErrBadRequest)
+	}
+	r.after = &p
+	return nil
+}
+
+// next は取得した件数がlimitに達していれば、最後の要素の位置から次のページを取得するLinkヘッダーを作成します。
+// queryにはcursor・limit以外に引き継ぐ絞り込みの条件を指定します。
+func (r pageRequest) next(got int, last cursor.Position, query url.Values) api.OptString {
+	if got < r.limit {
+		return api.OptString{}
+	}
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("cursor", r.codec.Encode(r.scope, last))
+	query.Set("limit", strconv.Itoa(r.limit))
+	// リクエストのパスに対する相対参照で、パスを繰り返さずに済む
+	return api.NewOptString(fmt.Sprintf(`<?%s>; rel="next"`, query.Encode()))
+}
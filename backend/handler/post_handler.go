@@ -2,48 +2,843 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
 
 	"backend/api"
+	"backend/ent"
+	"backend/ent/comment"
+	"backend/ent/goal"
+	"backend/ent/image"
+	"backend/ent/post"
+	"backend/ent/postrevision"
+	"backend/ent/predicate"
+	"backend/ent/reaction"
+	"backend/ent/report"
+	"backend/ent/user"
+	"backend/internal/cursor"
+	"backend/internal/richtext"
+	"backend/internal/spamfilter"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 )
 
+// errImagesUnavailable は投稿に紐付けられない画像が指定された場合のエラーです。
+var errImagesUnavailable = errors.New("images must be uploaded by you and not attached to another post")
+
 // PostsGet implements GET /posts operation.
-// 現在のユーザーの投稿一覧取得
+// 現在のユーザーの投稿一覧取得（下書き・予約投稿を含む）
 func (h *Handler) PostsGet(ctx context.Context, params api.PostsGetParams) (api.PostsGetRes, error) {
-	// TODO: APIの処理を実装
-	return &api.PostsGetOKApplicationJSON{}, nil
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := h.keysetPagination("posts", params.Cursor, params.Page, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	q := h.postQuery().
+		Where(post.HasUserWith(user.ID(userID)))
+	query := url.Values{}
+	if v, ok := params.GoalID.Get(); ok {
+		q.Where(post.HasGoalWith(goal.ID(v)))
+		query.Set("goal_id", v.String())
+	}
+	if v, ok := params.Status.Get(); ok {
+		q.Where(post.StatusEQ(post.Status(v)))
+		query.Set("status", string(v))
+	}
+
+	posts, err := h.postPage(ctx, q, page)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.toAPIPosts(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+	return &api.PostsGetOKHeaders{
+		Link:     page.next(len(posts), lastPostPosition(posts), query),
+		Response: res,
+	}, nil
 }
 
 // PostsPost implements POST /posts operation.
 // 進捗投稿作成
 func (h *Handler) PostsPost(ctx context.Context, req *api.PostRequest) (api.PostsPostRes, error) {
-	// TODO: APIの処理を実装
-	return &api.Post{}, nil
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	imageIDs, err := h.postImageIDs(req.ImageIds)
+	if err != nil {
+		return nil, err
+	}
+	status, publishAt, err := postSchedule(req, "", time.Now())
+	if err != nil {
+		return nil, err
+	}
+	// 目標の参加者であれば誰でも投稿できる
+	if _, err := h.goalRole(ctx, req.GoalID, userID); err != nil {
+		return nil, err
+	}
+	if v, ok := req.QuotePostID.Get(); ok {
+		if err := h.quotablePostID(ctx, v, userID); err != nil {
+			return nil, err
+		}
+	}
+	spam, err := h.checkSpam(ctx, spamfilter.KindPost, userID, uuid.Nil, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	held := heldAt(spam)
+
+	var postID uuid.UUID
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		if err := checkAttachableImagesTx(ctx, tx, userID, imageIDs); err != nil {
+			return err
+		}
+		p, err := tx.Post.Create().
+			SetContent(req.Content).
+			SetFormat(post.Format(req.Format.Or(api.PostFormatPlain))).
+			SetStatus(status).
+			SetNillablePublishAt(publishAt).
+			SetNillableQuoteOfID(optUUIDPtr(req.QuotePostID)).
+			SetNillableAmount(optFloat64Ptr(req.Amount)).
+			SetUserID(userID).
+			SetGoalID(req.GoalID).
+			AddImageIDs(imageIDs...).
+			SetNillableHiddenAt(held).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		postID = p.ID
+		if held != nil {
+			return holdForReviewTx(ctx, tx, report.TargetTypePost, p.ID, spam)
+		}
+		return nil
+	})
+	if errors.Is(err, errImagesUnavailable) {
+		return nil, fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if publishAt != nil {
+		h.waker.Wake(ctx, *publishAt)
+	}
+	h.enqueueLinkPreview(req.Content)
+
+	return h.getAPIPost(ctx, postID)
 }
 
 // PostsPostIDDelete implements DELETE /posts/{post_id} operation.
 // 投稿を削除（紐づいている画像も同時に削除）
 func (h *Handler) PostsPostIDDelete(ctx context.Context, params api.PostsPostIDDeleteParams) (api.PostsPostIDDeleteRes, error) {
-	// TODO: APIの処理を実装
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.requirePostAuthor(ctx, params.PostID, userID); err != nil {
+		return nil, err
+	}
+
+	var objects []string
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		objects, err = deletePostsTx(ctx, tx, []uuid.UUID{params.PostID})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.deleteObjects(ctx, objects)
+
 	return &api.PostsPostIDDeleteNoContent{}, nil
 }
 
 // PostsPostIDGet implements GET /posts/{post_id} operation.
 // 投稿詳細取得
 func (h *Handler) PostsPostIDGet(ctx context.Context, params api.PostsPostIDGetParams) (api.PostsPostIDGetRes, error) {
-	// TODO: APIの処理を実装
-	return &api.Post{}, nil
+	if _, err := h.visiblePost(ctx, params.PostID, viewerID(ctx)); err != nil {
+		return nil, err
+	}
+	return h.getAPIPost(ctx, params.PostID)
 }
 
 // PostsPostIDPut implements PUT /posts/{post_id} operation.
 // 投稿更新
 func (h *Handler) PostsPostIDPut(ctx context.Context, req *api.PostRequest, params api.PostsPostIDPutParams) (api.PostsPostIDPutRes, error) {
-	// TODO: APIの処理を実装
-	return &api.Post{}, nil
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	imageIDs, err := h.postImageIDs(req.ImageIds)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.requirePostAuthor(ctx, params.PostID, userID); err != nil {
+		return nil, err
+	}
+	// 別の目標へ付け替える場合も、その目標の参加者である必要がある
+	if _, err := h.goalRole(ctx, req.GoalID, userID); err != nil {
+		return nil, err
+	}
+	spam, err := h.checkSpam(ctx, spamfilter.KindPost, userID, params.PostID, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	held := heldAt(spam)
+
+	var publishAt *time.Time
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		current, err := tx.Post.Query().
+			Where(post.ID(params.PostID)).
+			WithQuoteOf(func(q *ent.PostQuery) {
+				q.Select(post.FieldID)
+			}).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		// 引用元は作成後に変更できない
+		if v, ok := req.QuotePostID.Get(); ok && (current.Edges.QuoteOf == nil || current.Edges.QuoteOf.ID != v) {
+			return fmt.Errorf("%w: quote_post_id cannot be changed", ErrBadRequest)
+		}
+		now := time.Now()
+		var status post.Status
+		status, publishAt, err = postSchedule(req, current.Status, now)
+		if err != nil {
+			return err
+		}
+		wasPublished := current.Status == post.StatusPublished
+		published := status == post.StatusPublished
+
+		// 予約投稿の公開ジョブと同時に更新した場合に、公開状態を上書きしないようにする
+		update := tx.Post.UpdateOneID(params.PostID).
+			Where(post.StatusEQ(current.Status)).
+			SetContent(req.Content).
+			SetGoalID(req.GoalID).
+			SetStatus(status).
+			SetNillablePublishAt(publishAt)
+		if publishAt == nil {
+			update.ClearPublishAt()
+		}
+		if published && !wasPublished {
+			update.SetCreatedAt(now)
+		}
+		if v, ok := req.Amount.Get(); ok {
+			update.SetAmount(v)
+		} else {
+			update.ClearAmount()
+		}
+		if v, ok := req.Format.Get(); ok {
+			update.SetFormat(post.Format(v))
+		}
+		// 既に非表示の投稿は非表示にした日時を変えずに、編集後の本文の確認を依頼する
+		if held != nil && current.HiddenAt == nil {
+			update.SetHiddenAt(*held)
+		}
+		if err := setPostImagesTx(ctx, tx, update, params.PostID, userID, imageIDs); err != nil {
+			return err
+		}
+		err = update.Exec(ctx)
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: the post status has been changed", ErrConflict)
+		}
+		if err != nil {
+			return err
+		}
+		if held != nil {
+			return holdForReviewTx(ctx, tx, report.TargetTypePost, params.PostID, spam)
+		}
+		return nil
+	})
+	if errors.Is(err, errImagesUnavailable) {
+		return nil, fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if publishAt != nil {
+		h.waker.Wake(ctx, *publishAt)
+	}
+	h.enqueueLinkPreview(req.Content)
+
+	return h.getAPIPost(ctx, params.PostID)
 }
 
 // UsersUserIDPostsGet implements GET /users/{user_id}/posts operation.
 // 指定ユーザーの投稿一覧取得
+// 閲覧者に表示できる投稿を新しい順に返します。閲覧者とブロック関係にあるユーザーは存在しないものとして扱います。
 func (h *Handler) UsersUserIDPostsGet(ctx context.Context, params api.UsersUserIDPostsGetParams) (api.UsersUserIDPostsGetRes, error) {
-	// TODO: APIの処理を実装
-	return &api.UsersUserIDPostsGetOKApplicationJSON{}, nil
+	page, err := h.keysetPagination("user_posts", params.Cursor, params.Page, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	viewer := viewerID(ctx)
+	exists, err := h.client.User.Query().
+		Where(user.ID(params.UserID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := h.blockedUserIDs(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if !exists || slices.Contains(blocked, params.UserID) {
+		return nil, ErrNotFound
+	}
+
+	q := h.postQuery().
+		Where(
+			post.HasUserWith(user.ID(params.UserID)),
+			visiblePosts(viewer, blocked),
+		)
+	query := url.Values{}
+	if v, ok := params.GoalID.Get(); ok {
+		q.Where(post.HasGoalWith(goal.ID(v)))
+		query.Set("goal_id", v.String())
+	}
+
+	posts, err := h.postPage(ctx, q, page)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.toAPIPosts(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+	return &api.UsersUserIDPostsGetOKHeaders{
+		Link:     page.next(len(posts), lastPostPosition(posts), query),
+		Response: res,
+	}, nil
+}
+
+// postQuery はレスポンスに必要なエッジを読み込む投稿クエリを返します。
+func (h *Handler) postQuery() *ent.PostQuery {
+	return h.client.Post.Query().
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		WithGoal(func(q *ent.GoalQuery) {
+			q.Select(goal.FieldID)
+		}).
+		WithImages(func(q *ent.ImageQuery) {
+			q.Order(ent.Asc(image.FieldCreatedAt), ent.Asc(image.FieldID))
+		}).
+		WithMentions(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldHandle)
+		}).
+		WithQuoteOf(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		})
+}
+
+// postPage は投稿を新しい順に並べ、ページの範囲を取得します。
+func (h *Handler) postPage(ctx context.Context, q *ent.PostQuery, page pageRequest) ([]*ent.Post, error) {
+	if p := page.after; p != nil {
+		q.Where(post.Or(
+			post.CreatedAtLT(p.Time),
+			post.And(post.CreatedAt(p.Time), post.IDLT(p.ID)),
+		))
+	}
+	return q.
+		Order(post.ByCreatedAt(sql.OrderDesc()), post.ByID(sql.OrderDesc())).
+		Offset(page.offset).
+		Limit(page.limit).
+		All(ctx)
+}
+
+// lastPostPosition は投稿一覧の最後の投稿の位置を返します。
+func lastPostPosition(posts []*ent.Post) cursor.Position {
+	if len(posts) == 0 {
+		return cursor.Position{}
+	}
+	p := posts[len(posts)-1]
+	return cursor.Position{Time: p.CreatedAt, ID: p.ID}
+}
+
+// getAPIPost は投稿を取得してAPIレスポンスの形式に変換します。
+func (h *Handler) getAPIPost(ctx context.Context, id uuid.UUID) (*api.Post, error) {
+	p, err := h.postQuery().
+		Where(post.ID(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.toAPIPosts(ctx, []*ent.Post{p})
+	if err != nil {
+		return nil, err
+	}
+	return &res[0], nil
+}
+
+// toAPIPosts は投稿一覧をAPIレスポンスの形式に変換します。
+// リアクション数・リポスト数や引用元、リンクプレビューは投稿ごとではなく一括で取得します。
+func (h *Handler) toAPIPosts(ctx context.Context, posts []*ent.Post) ([]api.Post, error) {
+	ids := make([]uuid.UUID, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, p.ID)
+	}
+
+	reactions, err := h.reactionSummaries(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	commentCounts, err := h.commentCounts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	viewer := viewerID(ctx)
+	bookmarked, err := h.bookmarkedPostIDs(ctx, viewer, ids)
+	if err != nil {
+		return nil, err
+	}
+	reposts, err := h.repostSummaries(ctx, viewer, ids)
+	if err != nil {
+		return nil, err
+	}
+	quoted, err := h.quotedPosts(ctx, viewer, posts)
+	if err != nil {
+		return nil, err
+	}
+	previews, err := h.linkPreviews(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.Post, 0, len(posts))
+	for _, p := range posts {
+		contentHTML, err := renderContent(p.Content, p.Format.String())
+		if err != nil {
+			return nil, err
+		}
+		ap := api.Post{
+			ID:           p.ID,
+			Content:      p.Content,
+			Format:       api.PostFormat(p.Format),
+			ContentHTML:  contentHTML,
+			Status:       api.PostStatus(p.Status),
+			ImageUrls:    make([]string, 0, len(p.Edges.Images)),
+			Reactions:    reactions[p.ID],
+			Bookmarked:   bookmarked[p.ID],
+			RepostCount:  reposts[p.ID].count,
+			QuoteCount:   reposts[p.ID].quoteCount,
+			Reposted:     reposts[p.ID].reposted,
+			CommentCount: commentCounts[p.ID],
+			Entities:     toAPIPostEntities(p),
+			Edited:       p.EditCount > 0,
+			EditCount:    p.EditCount,
+			CreatedAt:    p.CreatedAt,
+			UpdatedAt:    p.UpdatedAt,
+		}
+		if p.Amount != nil {
+			ap.Amount = api.NewOptFloat64(*p.Amount)
+		}
+		if p.PublishAt != nil {
+			ap.PublishAt = api.NewOptDateTime(*p.PublishAt)
+		}
+		if p.Edges.QuoteOf != nil {
+			if q, ok := quoted[p.Edges.QuoteOf.ID]; ok {
+				ap.QuotedPost = api.NewOptQuotedPost(q)
+			} else {
+				ap.QuoteUnavailable = api.NewOptBool(true)
+			}
+		}
+		if lp, ok := previews[richtext.FirstURL(p.Content)]; ok {
+			ap.LinkPreview = api.NewOptLinkPreview(lp)
+		}
+		if p.Edges.User != nil {
+			ap.UserID = p.Edges.User.ID
+			if ap.UserID == viewer {
+				ap.ModerationNotice = moderationNotice(p.HiddenAt)
+			}
+		}
+		if p.Edges.Goal != nil {
+			ap.GoalID = p.Edges.Goal.ID
+		}
+		for _, img := range p.Edges.Images {
+			ap.ImageUrls = append(ap.ImageUrls, imageURL(img.ID))
+		}
+		res = append(res, ap)
+	}
+	return res, nil
+}
+
+// renderContent は本文を書式に従って無害化済みのHTMLに変換します。
+func renderContent(content, format string) (string, error) {
+	html, err := richtext.RenderHTML(content, richtext.Format(format))
+	if err != nil {
+		return "", fmt.Errorf("render post content: %w", err)
+	}
+	return html, nil
+}
+
+// visiblePost は閲覧者に表示できる投稿を取得します。
+// 公開済みの投稿は誰でも閲覧できますが、投稿者とブロック関係にある閲覧者には存在しないものとして扱います。
+// 下書き・予約投稿と運営により非表示にされた投稿は投稿者本人以外には存在しないものとして扱います。
+func (h *Handler) visiblePost(ctx context.Context, postID, viewer uuid.UUID) (*ent.Post, error) {
+	p, err := h.client.Post.Query().
+		Where(post.ID(postID)).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if (p.Status != post.StatusPublished || p.HiddenAt != nil) && p.Edges.User.ID != viewer {
+		return nil, ErrNotFound
+	}
+
+	blocked, err := h.isBlocked(ctx, viewer, p.Edges.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, ErrNotFound
+	}
+	return p, nil
+}
+
+// visiblePosts は閲覧者に表示できる投稿の条件です。
+// 公開済みの投稿と閲覧者自身の投稿のうち、閲覧者とブロック関係にあるユーザーの投稿と
+// 運営により非表示にされた他のユーザーの投稿を除いたものが対象です。
+func visiblePosts(viewer uuid.UUID, blocked []uuid.UUID) predicate.Post {
+	ps := []predicate.Post{
+		post.Or(
+			post.StatusEQ(post.StatusPublished),
+			post.HasUserWith(user.ID(viewer)),
+		),
+		unhiddenPosts(viewer),
+	}
+	if len(blocked) > 0 {
+		ps = append(ps, post.Not(post.HasUserWith(user.IDIn(blocked...))))
+	}
+	return post.And(ps...)
+}
+
+// unhiddenPosts は運営により非表示にされた投稿を、投稿者である閲覧者以外には表示しない条件です。
+func unhiddenPosts(viewer uuid.UUID) predicate.Post {
+	return post.Or(
+		post.HiddenAtIsNil(),
+		post.HasUserWith(user.ID(viewer)),
+	)
+}
+
+// reactionSummaries は投稿ごとの種類別リアクション数と、閲覧者がリアクション済みの種類を一括で集計します。
+// 有効な種類はリアクションがなくても0件として表示順に含め、閲覧者とブロック関係にあるユーザーのリアクションは数えません。
+func (h *Handler) reactionSummaries(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]api.ReactionSummary, error) {
+	res := make(map[uuid.UUID][]api.ReactionSummary, len(postIDs))
+	if len(postIDs) == 0 {
+		return res, nil
+	}
+
+	viewer := viewerID(ctx)
+	blocked, err := h.blockedUserIDs(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+	q := h.client.Reaction.Query().
+		Where(reaction.HasPostWith(post.IDIn(postIDs...)))
+	if len(blocked) > 0 {
+		q.Where(reaction.Not(reaction.HasUserWith(user.IDIn(blocked...))))
+	}
+
+	type postKind struct {
+		PostID uuid.UUID `json:"post_reactions"`
+		Kind   string    `json:"kind"`
+	}
+	var counts []struct {
+		PostID uuid.UUID `json:"post_reactions"`
+		Kind   string    `json:"kind"`
+		Count  int       `json:"count"`
+	}
+	err = q.GroupBy(reaction.PostColumn, reaction.FieldKind).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	byKind := make(map[postKind]int, len(counts))
+	for _, c := range counts {
+		byKind[postKind{PostID: c.PostID, Kind: c.Kind}] = c.Count
+	}
+
+	reacted := make(map[postKind]bool)
+	if viewer != uuid.Nil {
+		var own []postKind
+		err := h.client.Reaction.Query().
+			Where(
+				reaction.HasPostWith(post.IDIn(postIDs...)),
+				reaction.HasUserWith(user.ID(viewer)),
+			).
+			GroupBy(reaction.PostColumn, reaction.FieldKind).
+			Scan(ctx, &own)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range own {
+			reacted[k] = true
+		}
+	}
+
+	for _, id := range postIDs {
+		summaries := make([]api.ReactionSummary, 0, len(h.config.ReactionKinds))
+		for _, k := range h.config.ReactionKinds {
+			key := postKind{PostID: id, Kind: k.Kind}
+			summaries = append(summaries, api.ReactionSummary{
+				Kind:    k.Kind,
+				Count:   byKind[key],
+				Reacted: reacted[key],
+			})
+		}
+		res[id] = summaries
+	}
+	return res, nil
+}
+
+// commentCounts は投稿ごとの削除されていないコメント数を一括で集計します。
+// 閲覧者とブロック関係にあるユーザーのコメントは数えません。
+func (h *Handler) commentCounts(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	res := make(map[uuid.UUID]int, len(postIDs))
+	if len(postIDs) == 0 {
+		return res, nil
+	}
+
+	blocked, err := h.blockedUserIDs(ctx, viewerID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	q := h.client.Comment.Query().
+		Where(
+			comment.HasPostWith(post.IDIn(postIDs...)),
+			comment.DeletedAtIsNil(),
+			comment.HiddenAtIsNil(),
+		)
+	if len(blocked) > 0 {
+		q.Where(comment.Not(comment.HasUserWith(user.IDIn(blocked...))))
+	}
+
+	var counts []struct {
+		PostID uuid.UUID `json:"post_comments"`
+		Count  int       `json:"count"`
+	}
+	err = q.GroupBy(comment.PostColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range counts {
+		res[c.PostID] = c.Count
+	}
+	return res, nil
+}
+
+// toAPIPostEntities は本文中のメンションとハッシュタグをAPIレスポンスの形式に変換します。
+// メンションは保存時にユーザーと紐付いたハンドルのみを含めます。
+func toAPIPostEntities(p *ent.Post) []api.PostEntity {
+	handles := make(map[string]uuid.UUID, len(p.Edges.Mentions))
+	for _, u := range p.Edges.Mentions {
+		if u.Handle != nil {
+			handles[*u.Handle] = u.ID
+		}
+	}
+
+	res := []api.PostEntity{}
+	for _, e := range richtext.Parse(p.Content) {
+		ae := api.PostEntity{
+			Start: e.Start,
+			End:   e.End,
+			Value: e.Value,
+		}
+		switch e.Type {
+		case richtext.EntityMention:
+			id, ok := handles[e.Value]
+			if !ok {
+				continue
+			}
+			ae.Type = api.PostEntityTypeMention
+			ae.UserID = api.NewOptUUID(id)
+		case richtext.EntityHashtag:
+			ae.Type = api.PostEntityTypeHashtag
+		}
+		res = append(res, ae)
+	}
+	return res
+}
+
+// requirePostAuthor はユーザーが投稿の作成者でない場合にErrForbiddenを返します。
+func (h *Handler) requirePostAuthor(ctx context.Context, postID, userID uuid.UUID) error {
+	p, err := h.client.Post.Query().
+		Where(post.ID(postID)).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if p.Edges.User == nil || p.Edges.User.ID != userID {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// postSchedule はリクエストの公開状態と公開予定日時を検証します。
+// currentは更新前の公開状態で、作成時は空文字列を指定します。公開状態が省略された場合は、
+// 作成時は公開済み、更新時は現在の状態になります。公開済みの投稿を下書き・予約投稿に戻すことはできません。
+func postSchedule(req *api.PostRequest, current post.Status, now time.Time) (post.Status, *time.Time, error) {
+	status := current
+	if status == "" {
+		status = post.StatusPublished
+	}
+	if v, ok := req.Status.Get(); ok {
+		status = post.Status(v)
+	}
+	if current == post.StatusPublished && status != post.StatusPublished {
+		return "", nil, fmt.Errorf("%w: a published post cannot be changed back to draft or scheduled", ErrBadRequest)
+	}
+
+	publishAt, ok := req.PublishAt.Get()
+	if status != post.StatusScheduled {
+		if ok {
+			return "", nil, fmt.Errorf("%w: publish_at can only be set for scheduled posts", ErrBadRequest)
+		}
+		return status, nil, nil
+	}
+	if !ok {
+		return "", nil, fmt.Errorf("%w: publish_at is required for scheduled posts", ErrBadRequest)
+	}
+	if !publishAt.After(now) {
+		return "", nil, fmt.Errorf("%w: publish_at must be in the future", ErrBadRequest)
+	}
+	return status, &publishAt, nil
+}
+
+// postImageIDs はリクエストの画像IDから重複を除き、枚数の上限を検証します。
+func (h *Handler) postImageIDs(ids []uuid.UUID) ([]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	res := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+	if len(res) > h.config.MaxPostImages {
+		return nil, fmt.Errorf("%w: a post can have at most %d images", ErrBadRequest, h.config.MaxPostImages)
+	}
+	return res, nil
+}
+
+// checkAttachableImagesTx は画像がすべて自分のアップロードした、
+// 他の投稿に紐付いていない画像であることを確認します。
+func checkAttachableImagesTx(ctx context.Context, tx *ent.Tx, userID uuid.UUID, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	n, err := tx.Image.Query().
+		Where(
+			image.IDIn(ids...),
+			image.HasUploadedByWith(user.ID(userID)),
+			image.Not(image.HasPost()),
+		).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if n != len(ids) {
+		return errImagesUnavailable
+	}
+	return nil
+}
+
+// setPostImagesTx は投稿の画像がidsと一致するよう、更新に画像の追加・削除を加えます。
+// 外した画像は削除せず残すため、編集履歴から復元できます。
+// 同時に別の投稿へ紐付けられた場合は、更新時に制約エラーになります。
+func setPostImagesTx(ctx context.Context, tx *ent.Tx, update *ent.PostUpdateOne, postID, userID uuid.UUID, ids []uuid.UUID) error {
+	current, err := tx.Image.Query().
+		Where(image.HasPostWith(post.ID(postID))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	var added, removed []uuid.UUID
+	for _, id := range ids {
+		if !slices.Contains(current, id) {
+			added = append(added, id)
+		}
+	}
+	for _, id := range current {
+		if !slices.Contains(ids, id) {
+			removed = append(removed, id)
+		}
+	}
+	if err := checkAttachableImagesTx(ctx, tx, userID, added); err != nil {
+		return err
+	}
+
+	update.AddImageIDs(added...).RemoveImageIDs(removed...)
+	return nil
+}
+
+// deletePostsTx は投稿とそのリアクション・画像・編集履歴をトランザクション内で削除し、
+// 削除した画像のオブジェクト名を返します。
+// オブジェクトはコミット後に deleteObjects で削除してください。
+func deletePostsTx(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	_, err := tx.Reaction.Delete().
+		Where(reaction.HasPostWith(post.IDIn(ids...))).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 過去の版だけで使われていた画像も、どの投稿にも紐付いていなければ削除する
+	revisions, err := tx.PostRevision.Query().
+		Where(postrevision.HasPostWith(post.IDIn(ids...))).
+		Select(postrevision.FieldImageIds).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var revisionImageIDs []uuid.UUID
+	for _, r := range revisions {
+		revisionImageIDs = append(revisionImageIDs, r.ImageIds...)
+	}
+
+	objects, err := deleteImagesTx(ctx, tx, image.Or(
+		image.HasPostWith(post.IDIn(ids...)),
+		image.And(image.IDIn(revisionImageIDs...), image.Not(image.HasPost())),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Post.Delete().
+		Where(post.IDIn(ids...)).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
 }
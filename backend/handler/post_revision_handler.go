@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/post"
+	"backend/ent/postrevision"
+	"backend/ent/report"
+	"backend/internal/spamfilter"
+)
+
+// PostsPostIDRevisionsGet は投稿の編集履歴を新しい順に返します。
+func (h *Handler) PostsPostIDRevisionsGet(ctx context.Context, params api.PostsPostIDRevisionsGetParams) (api.PostsPostIDRevisionsGetRes, error) {
+	viewer := viewerID(ctx)
+	p, err := h.visiblePost(ctx, params.PostID, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if !h.config.PublicPostRevisions && p.Edges.User.ID != viewer {
+		return nil, ErrForbidden
+	}
+
+	revisions, err := p.QueryRevisions().
+		Order(ent.Desc(postrevision.FieldRevision)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(api.PostsPostIDRevisionsGetOKApplicationJSON, 0, len(revisions))
+	for _, r := range revisions {
+		ar, err := toAPIPostRevision(r)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, ar)
+	}
+	return &res, nil
+}
+
+// PostsPostIDRevisionsRevisionRestorePost は投稿を指定した版の内容に戻します。
+// 復元も通常の編集と同じく、復元前の内容が新しい版として記録されます。
+func (h *Handler) PostsPostIDRevisionsRevisionRestorePost(ctx context.Context, params api.PostsPostIDRevisionsRevisionRestorePostParams) (api.PostsPostIDRevisionsRevisionRestorePostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.requirePostAuthor(ctx, params.PostID, userID); err != nil {
+		return nil, err
+	}
+
+	r, err := h.client.PostRevision.Query().
+		Where(
+			postrevision.HasPostWith(post.ID(params.PostID)),
+			postrevision.Revision(params.Revision),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 過去の版も、戻した時点の判定基準で改めて判定する
+	spam, err := h.checkSpam(ctx, spamfilter.KindPost, userID, params.PostID, r.Content)
+	if err != nil {
+		return nil, err
+	}
+	held := heldAt(spam)
+
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		current, err := tx.Post.Get(ctx, params.PostID)
+		if err != nil {
+			return err
+		}
+		update := tx.Post.UpdateOneID(params.PostID).
+			SetContent(r.Content).
+			SetFormat(post.Format(r.Format)).
+			SetNillableAmount(r.Amount)
+		if r.Amount == nil {
+			update.ClearAmount()
+		}
+		if held != nil && current.HiddenAt == nil {
+			update.SetHiddenAt(*held)
+		}
+		if err := setPostImagesTx(ctx, tx, update, params.PostID, userID, r.ImageIds); err != nil {
+			return err
+		}
+		if err := update.Exec(ctx); err != nil {
+			return err
+		}
+		if held != nil {
+			return holdForReviewTx(ctx, tx, report.TargetTypePost, params.PostID, spam)
+		}
+		return nil
+	})
+	// 過去の版の画像が削除済み、または他の投稿に使われている場合は復元できない
+	if errors.Is(err, errImagesUnavailable) {
+		return nil, fmt.Errorf("%w: %w", ErrConflict, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	h.enqueueLinkPreview(r.Content)
+
+	return h.getAPIPost(ctx, params.PostID)
+}
+
+// toAPIPostRevision は編集履歴をAPIレスポンスの形式に変換します。
+func toAPIPostRevision(r *ent.PostRevision) (api.PostRevision, error) {
+	contentHTML, err := renderContent(r.Content, r.Format.String())
+	if err != nil {
+		return api.PostRevision{}, err
+	}
+	res := api.PostRevision{
+		Revision:    r.Revision,
+		Content:     r.Content,
+		Format:      api.PostFormat(r.Format),
+		ContentHTML: contentHTML,
+		ImageUrls:   make([]string, 0, len(r.ImageIds)),
+		CreatedAt:   r.CreatedAt,
+		ReplacedAt:  r.ReplacedAt,
+	}
+	if r.Amount != nil {
+		res.Amount = api.NewOptFloat64(*r.Amount)
+	}
+	for _, id := range r.ImageIds {
+		res.ImageUrls = append(res.ImageUrls, imageURL(id))
+	}
+	return res, nil
+}
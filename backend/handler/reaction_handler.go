@@ -2,27 +2,150 @@ package handler
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 
 	"backend/api"
+	"backend/ent"
+	"backend/ent/post"
+	"backend/ent/reaction"
+	"backend/ent/user"
+	"backend/internal/cursor"
 )
 
-// PostsPostIDReactionsDelete implements DELETE /posts/{post_id}/reactions operation.
-// 現在のユーザー自身のリアクションを削除
+// ReactionsKindsGet は使用できるリアクションの種類を表示順で返します。
+func (h *Handler) ReactionsKindsGet(ctx context.Context) ([]api.ReactionKind, error) {
+	return h.config.ReactionKinds, nil
+}
+
+// PostsPostIDReactionsDelete は現在のユーザー自身のリアクションを削除します。
+// 種類を省略した場合は、すべての種類のリアクションを削除します。
 func (h *Handler) PostsPostIDReactionsDelete(ctx context.Context, params api.PostsPostIDReactionsDeleteParams) (api.PostsPostIDReactionsDeleteRes, error) {
-	// TODO: APIの処理を実装
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		q := tx.Reaction.Delete().
+			Where(
+				reaction.HasPostWith(post.ID(params.PostID)),
+				reaction.HasUserWith(user.ID(userID)),
+			)
+		if kind, ok := params.Kind.Get(); ok {
+			q.Where(reaction.Kind(kind))
+		}
+		n, err := q.Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &api.PostsPostIDReactionsDeleteNoContent{}, nil
 }
 
-// PostsPostIDReactionsGet implements GET /posts/{post_id}/reactions operation.
-// 投稿にリアクションしたユーザーの一覧取得
+// PostsPostIDReactionsGet は投稿へのリアクションを新しい順に返します。
+// 前回のレスポンスのLinkヘッダーのcursorを指定するキーセットページネーションです。
 func (h *Handler) PostsPostIDReactionsGet(ctx context.Context, params api.PostsPostIDReactionsGetParams) (api.PostsPostIDReactionsGetRes, error) {
-	// TODO: APIの処理を実装
-	return &api.PostsPostIDReactionsGetOKApplicationJSON{}, nil
+	page, err := h.keysetPagination("reactions", params.Cursor, api.OptInt{}, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	viewer := viewerID(ctx)
+	if _, err := h.visiblePost(ctx, params.PostID, viewer); err != nil {
+		return nil, err
+	}
+	blocked, err := h.blockedUserIDs(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	q := h.client.Reaction.Query().
+		Where(reaction.HasPostWith(post.ID(params.PostID))).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		})
+	query := url.Values{}
+	if kind, ok := params.Kind.Get(); ok {
+		if !h.validReactionKind(kind) {
+			return nil, fmt.Errorf("%w: unknown reaction kind %q", ErrBadRequest, kind)
+		}
+		q.Where(reaction.Kind(kind))
+		query.Set("kind", kind)
+	}
+	if len(blocked) > 0 {
+		q.Where(reaction.Not(reaction.HasUserWith(user.IDIn(blocked...))))
+	}
+	if p := page.after; p != nil {
+		q.Where(reaction.Or(
+			reaction.CreatedAtLT(p.Time),
+			reaction.And(reaction.CreatedAt(p.Time), reaction.IDLT(p.ID)),
+		))
+	}
+
+	reactions, err := q.
+		Order(ent.Desc(reaction.FieldCreatedAt), ent.Desc(reaction.FieldID)).
+		Limit(page.limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.Reaction, 0, len(reactions))
+	for _, r := range reactions {
+		res = append(res, api.Reaction{
+			UserID:    r.Edges.User.ID,
+			Kind:      r.Kind,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	var last cursor.Position
+	if len(reactions) > 0 {
+		r := reactions[len(reactions)-1]
+		last = cursor.Position{Time: r.CreatedAt, ID: r.ID}
+	}
+	return &api.PostsPostIDReactionsGetOKHeaders{
+		Link:     page.next(len(reactions), last, query),
+		Response: res,
+	}, nil
 }
 
-// PostsPostIDReactionsPost implements POST /posts/{post_id}/reactions operation.
-// 投稿にリアクション（いいね）を追加
-func (h *Handler) PostsPostIDReactionsPost(ctx context.Context, params api.PostsPostIDReactionsPostParams) (api.PostsPostIDReactionsPostRes, error) {
-	// TODO: APIの処理を実装
+// PostsPostIDReactionsPost は投稿にリアクションを追加します。
+// 同じ種類のリアクションが既にある場合は一意制約により競合エラーになります。
+func (h *Handler) PostsPostIDReactionsPost(ctx context.Context, req api.OptReactionRequest, params api.PostsPostIDReactionsPostParams) (api.PostsPostIDReactionsPostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := h.config.ReactionKinds[0].Kind
+	if v, ok := req.Value.Kind.Get(); req.Set && ok {
+		kind = v
+	}
+	if !h.validReactionKind(kind) {
+		return nil, fmt.Errorf("%w: unknown reaction kind %q", ErrBadRequest, kind)
+	}
+	if _, err := h.visiblePost(ctx, params.PostID, userID); err != nil {
+		return nil, err
+	}
+
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		return tx.Reaction.Create().
+			SetKind(kind).
+			SetUserID(userID).
+			SetPostID(params.PostID).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &api.PostsPostIDReactionsPostCreated{}, nil
 }
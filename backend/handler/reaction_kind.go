@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"backend/api"
+)
+
+// defaultReactionKinds はREACTION_KINDSが指定されていない場合のリアクションの種類です。
+const defaultReactionKinds = "clap:👏,fire:🔥,muscle:💪,tada:🎉"
+
+// parseReactionKinds は "clap:👏,fire:🔥" 形式の設定を解析します。
+// 種類か絵文字が空の項目や、種類が重複する項目がある場合はエラーを返します。
+func parseReactionKinds(s string) ([]api.ReactionKind, error) {
+	var kinds []api.ReactionKind
+	for _, item := range strings.Split(s, ",") {
+		kind, emoji, ok := strings.Cut(strings.TrimSpace(item), ":")
+		kind, emoji = strings.TrimSpace(kind), strings.TrimSpace(emoji)
+		if !ok || kind == "" || emoji == "" || slices.ContainsFunc(kinds, func(k api.ReactionKind) bool { return k.Kind == kind }) {
+			return nil, fmt.Errorf("invalid reaction kind %q", item)
+		}
+		kinds = append(kinds, api.ReactionKind{Kind: kind, Emoji: emoji})
+	}
+	return kinds, nil
+}
+
+// validReactionKind は種類が設定で有効になっているかを返します。
+func (h *Handler) validReactionKind(kind string) bool {
+	return slices.ContainsFunc(h.config.ReactionKinds, func(k api.ReactionKind) bool {
+		return k.Kind == kind
+	})
+}
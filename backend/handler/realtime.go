@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"backend/ent"
+	"backend/ent/post"
+	"backend/ent/user"
+	"backend/internal/outbox"
+	"backend/internal/realtime"
+
+	"github.com/google/uuid"
+)
+
+// publishEvent はユーザーへのリアルタイムのイベントを発行します。
+// 操作の保存は完了しているため、発行の失敗はログに残すだけにします。
+func (h *Handler) publishEvent(ctx context.Context, t realtime.Type, recipients []uuid.UUID, data any) {
+	if err := h.sendEvent(ctx, t, recipients, data); err != nil {
+		slog.ErrorContext(ctx, "failed to publish event", "type", string(t), "error", err.Error())
+	}
+}
+
+// sendEvent はユーザーへのリアルタイムのイベントを発行します。
+func (h *Handler) sendEvent(ctx context.Context, t realtime.Type, recipients []uuid.UUID, data any) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+	e, err := realtime.NewEvent(t, recipients, data)
+	if err != nil {
+		return err
+	}
+	return h.events.Publish(ctx, e)
+}
+
+// HandleOutboxEvent はドメインイベントから、関係するユーザーへのリアルタイムのイベントを発行します。
+// 失敗した場合はエラーを返し、配信ジョブが再試行します。
+func (h *Handler) HandleOutboxEvent(ctx context.Context, e outbox.Event) error {
+	switch e.Type {
+	case outbox.TypePostPublished:
+		var p outbox.PostPayload
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return err
+		}
+		return h.publishTimelinePost(ctx, p.PostID)
+	case outbox.TypeReactionCreated:
+		var r outbox.ReactionPayload
+		if err := json.Unmarshal(e.Payload, &r); err != nil {
+			return err
+		}
+		return h.publishReaction(ctx, r)
+	case outbox.TypeFollowCreated:
+		var f outbox.FollowPayload
+		if err := json.Unmarshal(e.Payload, &f); err != nil {
+			return err
+		}
+		return h.sendEvent(ctx, realtime.TypeFollower, []uuid.UUID{f.FolloweeID}, realtime.FollowerData{UserID: f.FollowerID})
+	}
+	return nil
+}
+
+// publishTimelinePost は公開された投稿を、投稿したユーザーのフォロワーに知らせます。
+// 確認のために保留中の投稿と、既に削除・非公開になった投稿は知らせません。
+func (h *Handler) publishTimelinePost(ctx context.Context, postID uuid.UUID) error {
+	p, err := h.client.Post.Query().
+		Where(post.ID(postID), post.StatusEQ(post.StatusPublished), post.HiddenAtIsNil()).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	followers, err := h.client.User.Query().
+		Where(user.HasFollowingWith(user.ID(p.Edges.User.ID))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	return h.sendEvent(ctx, realtime.TypeTimelinePost, followers, realtime.PostData{
+		PostID: postID,
+		UserID: p.Edges.User.ID,
+	})
+}
+
+// publishReaction はリアクションを、リアクションが付いた投稿の作成者に知らせます。
+// 自分の投稿へのリアクションと、既に削除された投稿へのリアクションは知らせません。
+func (h *Handler) publishReaction(ctx context.Context, r outbox.ReactionPayload) error {
+	author, err := h.client.Post.Query().
+		Where(post.ID(r.PostID)).
+		QueryUser().
+		OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if author == r.UserID {
+		return nil
+	}
+
+	return h.sendEvent(ctx, realtime.TypeReaction, []uuid.UUID{author}, realtime.ReactionData{
+		PostID: r.PostID,
+		UserID: r.UserID,
+		Kind:   r.Kind,
+	})
+}
@@ -0,0 +1,321 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/comment"
+	"backend/ent/moderationaction"
+	"backend/ent/report"
+	"backend/ent/user"
+	"backend/internal/cursor"
+
+	"github.com/google/uuid"
+)
+
+// ReportsPost は投稿・コメント・プロフィールを運営に報告します。
+// 同じ対象を同じユーザーが報告できるのは1回のみです。
+func (h *Handler) ReportsPost(ctx context.Context, req *api.ReportRequest) (api.ReportsPostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := h.reportTargetOwner(ctx, req.TargetType, req.TargetID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ownerID == userID {
+		return nil, fmt.Errorf("%w: cannot report your own content", ErrBadRequest)
+	}
+
+	r, err := h.client.Report.Create().
+		SetTargetType(report.TargetType(req.TargetType)).
+		SetTargetID(req.TargetID).
+		SetReason(report.Reason(req.Reason)).
+		SetText(req.Text.Value).
+		SetReporterID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.getAPIReport(ctx, r.ID)
+}
+
+// reportTargetOwner は報告の対象が閲覧者から見えることを確認し、対象の作成者のIDを返します。
+func (h *Handler) reportTargetOwner(ctx context.Context, targetType api.ReportTargetType, targetID, viewer uuid.UUID) (uuid.UUID, error) {
+	switch targetType {
+	case api.ReportTargetTypePost:
+		p, err := h.visiblePost(ctx, targetID, viewer)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return p.Edges.User.ID, nil
+	case api.ReportTargetTypeComment:
+		blocked, err := h.blockedUserIDs(ctx, viewer)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		c, err := h.commentQuery().
+			Where(
+				comment.ID(targetID),
+				comment.DeletedAtIsNil(),
+				visibleComments(viewer, blocked),
+			).
+			Only(ctx)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if _, err := h.visiblePost(ctx, c.Edges.Post.ID, viewer); err != nil {
+			return uuid.Nil, err
+		}
+		return c.Edges.User.ID, nil
+	case api.ReportTargetTypeUser:
+		exists, err := h.client.User.Query().
+			Where(user.ID(targetID)).
+			Exist(ctx)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if !exists {
+			return uuid.Nil, ErrNotFound
+		}
+		return targetID, nil
+	default:
+		return uuid.Nil, fmt.Errorf("%w: unsupported target_type", ErrBadRequest)
+	}
+}
+
+// AdminReportsGet は報告を作成日時の古い順に返します (モデレーターのみ)。
+// 前回のレスポンスのLinkヘッダーのcursorを指定するキーセットページネーションです。
+func (h *Handler) AdminReportsGet(ctx context.Context, params api.AdminReportsGetParams) (api.AdminReportsGetRes, error) {
+	if _, err := h.requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	page, err := h.keysetPagination("admin_reports", params.Cursor, api.OptInt{}, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	q := h.reportQuery(h.client)
+	query := url.Values{}
+	if source, ok := params.Source.Get(); ok {
+		q.Where(report.SourceEQ(report.Source(source)))
+		query.Set("source", string(source))
+	}
+	if status, ok := params.Status.Get(); ok {
+		q.Where(report.StatusEQ(report.Status(status)))
+		query.Set("status", string(status))
+	}
+	if targetType, ok := params.TargetType.Get(); ok {
+		q.Where(report.TargetTypeEQ(report.TargetType(targetType)))
+		query.Set("target_type", string(targetType))
+	}
+	if after, ok := params.After.Get(); ok {
+		r, err := h.client.Report.Get(ctx, after)
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: unknown cursor", ErrBadRequest)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := page.setAfter(cursor.Position{Time: r.CreatedAt, ID: r.ID}); err != nil {
+			return nil, err
+		}
+	}
+	if p := page.after; p != nil {
+		q.Where(report.Or(
+			report.CreatedAtGT(p.Time),
+			report.And(report.CreatedAt(p.Time), report.IDGT(p.ID)),
+		))
+	}
+
+	reports, err := q.
+		Order(report.ByCreatedAt(), report.ByID()).
+		Limit(page.limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.Report, 0, len(reports))
+	var last cursor.Position
+	for _, r := range reports {
+		res = append(res, *toAPIReport(r))
+		last = cursor.Position{Time: r.CreatedAt, ID: r.ID}
+	}
+	return &api.AdminReportsGetOKHeaders{
+		Link:     page.next(len(reports), last, query),
+		Response: res,
+	}, nil
+}
+
+// AdminReportsReportIDClaimPost は未対応の報告を自分の担当にします (モデレーターのみ)。
+// 既に自分が担当している場合はそのまま返し、他の担当者がいるか対応済みの場合は競合として扱います。
+func (h *Handler) AdminReportsReportIDClaimPost(ctx context.Context, params api.AdminReportsReportIDClaimPostParams) (api.AdminReportsReportIDClaimPostRes, error) {
+	moderator, err := h.requireModerator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *api.Report
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		// 同時に複数のモデレーターが担当しないよう、未対応の場合のみ更新する
+		n, err := tx.Report.Update().
+			Where(
+				report.ID(params.ReportID),
+				report.StatusEQ(report.StatusOpen),
+			).
+			SetStatus(report.StatusClaimed).
+			SetModeratorID(moderator.ID).
+			SetClaimedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		r, err := h.reportQuery(tx.Client()).
+			Where(report.ID(params.ReportID)).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			if r.Status == report.StatusClaimed && r.Edges.Moderator != nil && r.Edges.Moderator.ID == moderator.ID {
+				res = toAPIReport(r)
+				return nil
+			}
+			return fmt.Errorf("%w: report is already %s", ErrConflict, r.Status)
+		}
+
+		err = tx.ModerationAction.Create().
+			SetAction(moderationaction.ActionClaim).
+			SetTargetType(moderationaction.TargetTypeReport).
+			SetTargetID(r.ID).
+			SetModeratorID(moderator.ID).
+			SetReportID(r.ID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		res = toAPIReport(r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AdminReportsReportIDResolvePost は自分が担当している報告を対応済みにします (モデレーターのみ)。
+// 対象への措置はPOST /admin/moderation/actionsで別に行います。
+func (h *Handler) AdminReportsReportIDResolvePost(ctx context.Context, req *api.ReportResolveRequest, params api.AdminReportsReportIDResolvePostParams) (api.AdminReportsReportIDResolvePostRes, error) {
+	moderator, err := h.requireModerator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *api.Report
+	err = h.withTx(ctx, func(tx *ent.Tx) error {
+		n, err := tx.Report.Update().
+			Where(
+				report.ID(params.ReportID),
+				report.StatusEQ(report.StatusClaimed),
+				report.HasModeratorWith(user.ID(moderator.ID)),
+			).
+			SetStatus(report.StatusResolved).
+			SetResolution(report.Resolution(req.Resolution)).
+			SetResolutionNote(req.Note.Value).
+			SetResolvedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		r, err := h.reportQuery(tx.Client()).
+			Where(report.ID(params.ReportID)).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("%w: report must be claimed by you before resolving", ErrConflict)
+		}
+
+		err = tx.ModerationAction.Create().
+			SetAction(moderationaction.ActionResolve).
+			SetTargetType(moderationaction.TargetTypeReport).
+			SetTargetID(r.ID).
+			SetNote(req.Note.Value).
+			SetModeratorID(moderator.ID).
+			SetReportID(r.ID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		res = toAPIReport(r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// reportQuery はレスポンスに必要なエッジを読み込む報告クエリを返します。
+func (h *Handler) reportQuery(client *ent.Client) *ent.ReportQuery {
+	return client.Report.Query().
+		WithReporter(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		WithModerator(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		})
+}
+
+// getAPIReport は報告を取得してAPIレスポンスの形式に変換します。
+func (h *Handler) getAPIReport(ctx context.Context, id uuid.UUID) (*api.Report, error) {
+	r, err := h.reportQuery(h.client).
+		Where(report.ID(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toAPIReport(r), nil
+}
+
+// toAPIReport は報告をAPIレスポンスの形式に変換します。
+func toAPIReport(r *ent.Report) *api.Report {
+	res := &api.Report{
+		ID:             r.ID,
+		Source:         api.ReportSource(r.Source),
+		TargetType:     api.ReportTargetType(r.TargetType),
+		TargetID:       r.TargetID,
+		Reason:         api.ReportReason(r.Reason),
+		Text:           optNonEmptyString(r.Text),
+		Status:         api.ReportStatus(r.Status),
+		ResolutionNote: optNonEmptyString(r.ResolutionNote),
+		CreatedAt:      r.CreatedAt,
+	}
+	if r.Resolution != nil {
+		res.Resolution = api.NewOptReportResolution(api.ReportResolution(*r.Resolution))
+	}
+	if r.ClaimedAt != nil {
+		res.ClaimedAt = api.NewOptDateTime(*r.ClaimedAt)
+	}
+	if r.ResolvedAt != nil {
+		res.ResolvedAt = api.NewOptDateTime(*r.ResolvedAt)
+	}
+	if r.Edges.Reporter != nil {
+		res.ReporterID = api.NewOptUUID(r.Edges.Reporter.ID)
+	}
+	if r.Edges.Moderator != nil {
+		res.ModeratorID = api.NewOptUUID(r.Edges.Moderator.ID)
+	}
+	return res
+}
@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/api"
+	"backend/ent"
+	"backend/ent/post"
+	"backend/ent/repost"
+	"backend/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// PostsPostIDRepostPost は投稿をリポストします。既にリポストしている場合は何もしません。
+func (h *Handler) PostsPostIDRepostPost(ctx context.Context, params api.PostsPostIDRepostPostParams) (api.PostsPostIDRepostPostRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.publishedPost(ctx, params.PostID, userID); err != nil {
+		return nil, err
+	}
+
+	// 同時にリポストされても一意制約で失敗しないようにする
+	err = h.client.Repost.CreateBulk(
+		h.client.Repost.Create().
+			SetUserID(userID).
+			SetPostID(params.PostID),
+	).
+		OnConflictColumns(repost.UserColumn, repost.PostColumn).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	repostID, err := h.client.Repost.Query().
+		Where(
+			repost.HasUserWith(user.ID(userID)),
+			repost.HasPostWith(post.ID(params.PostID)),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h.fanout.Reposted(repostID)
+
+	return h.getAPIPost(ctx, params.PostID)
+}
+
+// PostsPostIDRepostDelete はリポストを取り消します。
+func (h *Handler) PostsPostIDRepostDelete(ctx context.Context, params api.PostsPostIDRepostDeleteParams) (api.PostsPostIDRepostDeleteRes, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := h.client.Repost.Delete().
+		Where(
+			repost.HasUserWith(user.ID(userID)),
+			repost.HasPostWith(post.ID(params.PostID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrNotFound
+	}
+
+	return &api.PostsPostIDRepostDeleteNoContent{}, nil
+}
+
+// publishedPost は閲覧者が閲覧できる公開済みの投稿を取得します。
+// リポスト・引用できるのは公開済みの投稿のみのため、閲覧者自身の下書き・予約投稿や非表示にされた投稿も存在しないものとして扱います。
+func (h *Handler) publishedPost(ctx context.Context, postID, viewer uuid.UUID) (*ent.Post, error) {
+	p, err := h.visiblePost(ctx, postID, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != post.StatusPublished || p.HiddenAt != nil {
+		return nil, ErrNotFound
+	}
+	return p, nil
+}
+
+// quotablePostID は引用元として指定された投稿が引用できるかを検証します。
+func (h *Handler) quotablePostID(ctx context.Context, id, userID uuid.UUID) error {
+	_, err := h.publishedPost(ctx, id, userID)
+	if ent.IsNotFound(err) || errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("%w: quote_post_id must refer to a published post you can view", ErrBadRequest)
+	}
+	return err
+}
+
+// repostSummary は投稿ごとのリポスト・引用の集計です。
+type repostSummary struct {
+	count      int
+	quoteCount int
+	reposted   bool
+}
+
+// repostSummaries は投稿ごとのリポスト数・公開済みの引用投稿数と、閲覧者がリポスト済みかを一括で集計します。
+func (h *Handler) repostSummaries(ctx context.Context, viewer uuid.UUID, postIDs []uuid.UUID) (map[uuid.UUID]repostSummary, error) {
+	res := make(map[uuid.UUID]repostSummary, len(postIDs))
+	if len(postIDs) == 0 {
+		return res, nil
+	}
+
+	var repostCounts []struct {
+		PostID uuid.UUID `json:"post_reposts"`
+		Count  int       `json:"count"`
+	}
+	err := h.client.Repost.Query().
+		Where(repost.HasPostWith(post.IDIn(postIDs...))).
+		GroupBy(repost.PostColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &repostCounts)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range repostCounts {
+		s := res[c.PostID]
+		s.count = c.Count
+		res[c.PostID] = s
+	}
+
+	var quoteCounts []struct {
+		PostID uuid.UUID `json:"post_quotes"`
+		Count  int       `json:"count"`
+	}
+	err = h.client.Post.Query().
+		Where(
+			post.HasQuoteOfWith(post.IDIn(postIDs...)),
+			post.StatusEQ(post.StatusPublished),
+			post.HiddenAtIsNil(),
+		).
+		GroupBy(post.QuoteOfColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &quoteCounts)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range quoteCounts {
+		s := res[c.PostID]
+		s.quoteCount = c.Count
+		res[c.PostID] = s
+	}
+
+	if viewer != uuid.Nil {
+		ids, err := h.client.Repost.Query().
+			Where(
+				repost.HasUserWith(user.ID(viewer)),
+				repost.HasPostWith(post.IDIn(postIDs...)),
+			).
+			QueryPost().
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			s := res[id]
+			s.reposted = true
+			res[id] = s
+		}
+	}
+
+	return res, nil
+}
+
+// quotedPosts は引用投稿の引用元のうち、閲覧者が閲覧できるものを一括で取得します。
+// 引用によって閲覧できる範囲が広がらないよう、引用元の公開状態とブロック関係は閲覧者を基準に判定します。
+func (h *Handler) quotedPosts(ctx context.Context, viewer uuid.UUID, posts []*ent.Post) (map[uuid.UUID]api.QuotedPost, error) {
+	res := make(map[uuid.UUID]api.QuotedPost)
+	var ids []uuid.UUID
+	for _, p := range posts {
+		if p.Edges.QuoteOf != nil {
+			ids = append(ids, p.Edges.QuoteOf.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	blocked, err := h.blockedUserIDs(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+	quoted, err := h.client.Post.Query().
+		Where(
+			post.IDIn(ids...),
+			post.StatusEQ(post.StatusPublished),
+			visiblePosts(viewer, blocked),
+		).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, q := range quoted {
+		contentHTML, err := renderContent(q.Content, q.Format.String())
+		if err != nil {
+			return nil, err
+		}
+		res[q.ID] = api.QuotedPost{
+			ID:          q.ID,
+			UserID:      q.Edges.User.ID,
+			Content:     q.Content,
+			Format:      api.PostFormat(q.Format),
+			ContentHTML: contentHTML,
+			CreatedAt:   q.CreatedAt,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"backend/api"
+	"backend/ent/goal"
+	"backend/ent/post"
+	"backend/internal/cursor"
+	"backend/internal/search"
+
+	"github.com/google/uuid"
+)
+
+// SearchGet は投稿の本文または目標のタイトルを全文検索し、作成日時の新しい順に返します。
+func (h *Handler) SearchGet(ctx context.Context, params api.SearchGetParams) (api.SearchGetRes, error) {
+	terms := search.Terms(params.Q)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("%w: q must contain letters or digits", ErrBadRequest)
+	}
+	kind := params.Type.Or(api.SearchTypePosts)
+	// 種類ごとに並びが異なるため、カーソルは種類ごとに分ける
+	page, err := h.keysetPagination("search_"+string(kind), params.Cursor, api.OptInt{}, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if page.after != nil && params.After.Set {
+		return nil, fmt.Errorf("%w: cursor and after cannot be used together", ErrBadRequest)
+	}
+	if kind == api.SearchTypeGoals && params.GoalID.Set {
+		return nil, fmt.Errorf("%w: goal_id can only be used when searching posts", ErrBadRequest)
+	}
+	from, hasFrom := params.From.Get()
+	to, hasTo := params.To.Get()
+	if hasFrom && hasTo && !from.Before(to) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrBadRequest)
+	}
+
+	viewer := viewerID(ctx)
+	blocked, err := h.blockedUserIDs(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+	hits, err := h.searcher.Search(ctx, search.Query{
+		Kind:    search.Kind(kind),
+		Terms:   terms,
+		Viewer:  viewer,
+		Blocked: blocked,
+		UserID:  optUUIDPtr(params.UserID),
+		GoalID:  optUUIDPtr(params.GoalID),
+		From:    optDateTimePtr(params.From),
+		To:      optDateTimePtr(params.To),
+		After:   optUUIDPtr(params.After),
+		Cursor:  page.after,
+		Limit:   page.limit,
+	})
+	if errors.Is(err, search.ErrUnknownCursor) {
+		return nil, fmt.Errorf("%w: unknown cursor", ErrBadRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+	var posts map[uuid.UUID]api.Post
+	var goals map[uuid.UUID]api.Goal
+	if kind == api.SearchTypePosts {
+		posts, err = h.searchedPosts(ctx, ids)
+	} else {
+		goals, err = h.searchedGoals(ctx, ids)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.SearchResult, 0, len(hits))
+	for _, hit := range hits {
+		r := api.SearchResult{
+			Type:    kind,
+			ID:      hit.ID,
+			Snippet: hit.Snippet,
+		}
+		// 検索後に削除されたものは含めない
+		if p, ok := posts[hit.ID]; ok {
+			r.Post = api.NewOptPost(p)
+		} else if g, ok := goals[hit.ID]; ok {
+			r.Goal = api.NewOptGoal(g)
+		} else {
+			continue
+		}
+		res = append(res, r)
+	}
+
+	query := url.Values{}
+	query.Set("q", params.Q)
+	query.Set("type", string(kind))
+	if v, ok := params.UserID.Get(); ok {
+		query.Set("user_id", v.String())
+	}
+	if v, ok := params.GoalID.Get(); ok {
+		query.Set("goal_id", v.String())
+	}
+	if hasFrom {
+		query.Set("from", from.Format(time.RFC3339Nano))
+	}
+	if hasTo {
+		query.Set("to", to.Format(time.RFC3339Nano))
+	}
+	var last cursor.Position
+	if len(hits) > 0 {
+		hit := hits[len(hits)-1]
+		last = cursor.Position{Time: hit.CreatedAt, ID: hit.ID}
+	}
+	return &api.SearchGetOKHeaders{
+		Link:     page.next(len(hits), last, query),
+		Response: res,
+	}, nil
+}
+
+// searchedPosts は検索結果の投稿をAPIレスポンスの形式で一括取得します。
+func (h *Handler) searchedPosts(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]api.Post, error) {
+	posts, err := h.postQuery().
+		Where(post.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiPosts, err := h.toAPIPosts(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[uuid.UUID]api.Post, len(apiPosts))
+	for _, p := range apiPosts {
+		res[p.ID] = p
+	}
+	return res, nil
+}
+
+// searchedGoals は検索結果の目標をAPIレスポンスの形式で一括取得します。
+func (h *Handler) searchedGoals(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]api.Goal, error) {
+	goals, err := h.goalQuery().
+		Where(goal.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[uuid.UUID]api.Goal, len(goals))
+	for _, g := range goals {
+		res[g.ID] = *toAPIGoal(g)
+	}
+	return res, nil
+}
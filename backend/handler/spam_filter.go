@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"backend/ent"
+	"backend/ent/report"
+	"backend/internal/spamfilter"
+
+	"github.com/google/uuid"
+)
+
+// checkSpam は作成・編集される投稿・コメントの本文を迷惑行為フィルタで判定します。
+// 編集の場合はeditingIDに対象のIDを、作成の場合はuuid.Nilを渡します。
+// 拒否された場合はErrBadRequestを返し、それ以外は判定結果を返します。
+func (h *Handler) checkSpam(ctx context.Context, kind spamfilter.Kind, userID, editingID uuid.UUID, text string) (spamfilter.Result, error) {
+	u, err := h.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return spamfilter.Result{}, ErrUnauthorized
+		}
+		return spamfilter.Result{}, err
+	}
+
+	res, err := h.spam.Check(ctx, spamfilter.Content{
+		Kind:             kind,
+		UserID:           userID,
+		EditingID:        editingID,
+		AccountCreatedAt: u.CreatedAt,
+		Text:             text,
+	})
+	if err != nil {
+		return spamfilter.Result{}, err
+	}
+	if res.Verdict != spamfilter.Allow {
+		slog.InfoContext(ctx, "spam filter verdict",
+			"verdict", res.Verdict.String(),
+			"rule", res.Rule,
+			"reason", res.Reason,
+			"kind", string(kind),
+			"user_id", userID.String(),
+		)
+	}
+	if res.Verdict == spamfilter.Reject {
+		return spamfilter.Result{}, fmt.Errorf("%w: %s", ErrBadRequest, res.Reason)
+	}
+	return res, nil
+}
+
+// holdForReviewTx は保留になった投稿・コメントをモデレーションキューに追加します。
+// 対象は作成・編集時に非表示にしておき、モデレーターが確認してから表示 (unhide) するかを決めます。
+func holdForReviewTx(ctx context.Context, tx *ent.Tx, targetType report.TargetType, targetID uuid.UUID, res spamfilter.Result) error {
+	return tx.Report.Create().
+		SetSource(report.SourceFilter).
+		SetTargetType(targetType).
+		SetTargetID(targetID).
+		SetReason(report.ReasonSpam).
+		SetText(fmt.Sprintf("%s: %s", res.Rule, res.Reason)).
+		Exec(ctx)
+}
+
+// heldAt は判定結果が保留であれば作成・編集時に設定する非表示の日時を、そうでなければnilを返します。
+func heldAt(res spamfilter.Result) *time.Time {
+	if res.Verdict != spamfilter.Hold {
+		return nil
+	}
+	now := time.Now()
+	return &now
+}
@@ -2,13 +2,178 @@ package handler
 
 import (
 	"context"
+	"net/url"
+	"time"
 
 	"backend/api"
+	"backend/ent"
+	"backend/ent/goal"
+	"backend/ent/post"
+	"backend/ent/predicate"
+	"backend/ent/repost"
+	"backend/ent/user"
+	"backend/internal/cursor"
+	"backend/internal/timeline"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 )
 
 // TimelineGet implements GET /timeline operation.
 // タイムライン取得（投稿は新しい順で返される）
+// 自分とフォローしているユーザーの投稿・リポストを合わせ、同じ投稿は最も新しい位置に1回だけ含めます。
+// 書き込んでおいたタイムライン (TimelineEntry) を使い、保持していない古いページはフォローと投稿を結合して求めます。
 func (h *Handler) TimelineGet(ctx context.Context, params api.TimelineGetParams) (api.TimelineGetRes, error) {
-	// TODO: APIの処理を実装
-	return &api.TimelineGetOKApplicationJSON{}, nil
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := h.keysetPagination("timeline", params.Cursor, params.Page, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	following, err := h.client.User.Query().
+		Where(user.HasFollowersWith(user.ID(userID))).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	authors := append(following, userID)
+	blocked, err := h.blockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	preds := []predicate.Post{
+		post.StatusEQ(post.StatusPublished),
+		visiblePosts(userID, blocked),
+	}
+	query := url.Values{}
+	if v, ok := params.GoalID.Get(); ok {
+		preds = append(preds, post.HasGoalWith(goal.ID(v)))
+		query.Set("goal_id", v.String())
+	}
+
+	horizon, err := h.timelineHorizon(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var rows []timeline.Row
+	// 書き込んでおいたタイムラインを使えるのは、保持している範囲より新しい位置から取得する場合のみ
+	if horizon != nil && page.offset == 0 && (page.after == nil || page.after.Time.After(*horizon)) {
+		rows, err = timeline.Materialized(ctx, h.client, userID, *horizon, authors, preds, page.after, page.limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// 保持している範囲を過ぎた古いページは、フォローと投稿を結合して求める
+	if len(rows) < page.limit {
+		after := page.after
+		if len(rows) > 0 {
+			last := rows[len(rows)-1]
+			after = &cursor.Position{Time: last.At, ID: last.PostID}
+		}
+		more, err := timeline.Joined(ctx, h.client, authors, preds, after, page.offset, page.limit-len(rows))
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, more...)
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.PostID)
+	}
+	posts, err := h.postQuery().
+		Where(post.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiPosts, err := h.toAPIPosts(ctx, posts)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]api.Post, len(apiPosts))
+	for _, p := range apiPosts {
+		byID[p.ID] = p
+	}
+	attributions, err := h.timelineReposts(ctx, ids, authors)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.Post, 0, len(rows))
+	for _, row := range rows {
+		p, ok := byID[row.PostID]
+		if !ok {
+			continue
+		}
+		// 最新の日時がリポストによるものであれば、リポストしたユーザーを表示する
+		if r, ok := attributions[row.PostID]; ok && r.CreatedAt.Equal(row.At) {
+			p.RepostedBy = api.NewOptUUID(r.Edges.User.ID)
+			p.RepostedAt = api.NewOptDateTime(r.CreatedAt)
+		}
+		res = append(res, p)
+	}
+	var last cursor.Position
+	if len(rows) > 0 {
+		row := rows[len(rows)-1]
+		last = cursor.Position{Time: row.At, ID: row.PostID}
+	}
+	return &api.TimelineGetOKHeaders{
+		Link:     page.next(len(rows), last, query),
+		Response: res,
+	}, nil
+}
+
+// timelineHorizon はユーザーのタイムラインを書き込んでおいている範囲の下限を返します。
+// まだ作成していない場合は作成を依頼し、nilを返します。
+func (h *Handler) timelineHorizon(ctx context.Context, userID uuid.UUID) (*time.Time, error) {
+	u, err := h.client.User.Query().
+		Where(user.ID(userID)).
+		Select(user.FieldTimelineHorizon).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrUnauthorized
+	}
+	if err != nil {
+		return nil, err
+	}
+	if u.TimelineHorizon == nil {
+		h.fanout.Rebuild(userID)
+	}
+	return u.TimelineHorizon, nil
+}
+
+// timelineReposts は投稿ごとに、タイムラインの対象ユーザーによる最新のリポストを返します。
+func (h *Handler) timelineReposts(ctx context.Context, postIDs, authors []uuid.UUID) (map[uuid.UUID]*ent.Repost, error) {
+	res := make(map[uuid.UUID]*ent.Repost, len(postIDs))
+	if len(postIDs) == 0 {
+		return res, nil
+	}
+
+	reposts, err := h.client.Repost.Query().
+		Where(
+			repost.HasPostWith(post.IDIn(postIDs...)),
+			repost.HasUserWith(user.IDIn(authors...)),
+		).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		Order(repost.ByCreatedAt(sql.OrderDesc()), repost.ByID(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range reposts {
+		if _, ok := res[r.Edges.Post.ID]; !ok {
+			res[r.Edges.Post.ID] = r
+		}
+	}
+	return res, nil
 }
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"backend/ent"
+)
+
+// withTx はfnをトランザクション内で実行します。
+// fnがエラーを返した場合やpanicした場合はロールバックし、それ以外はコミットします。
+func (h *Handler) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := h.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -4,6 +4,12 @@ import (
 	"context"
 
 	"backend/api"
+	"backend/ent"
+	"backend/ent/genre"
+	"backend/ent/user"
+	"backend/internal/richtext"
+
+	"github.com/google/uuid"
 )
 
 // UsersPost implements POST /users operation.
@@ -23,15 +29,50 @@ func (h *Handler) UsersUserIDDelete(ctx context.Context, params api.UsersUserIDD
 // UsersUserIDGet implements GET /users/{user_id} operation.
 // ユーザープロフィール取得
 func (h *Handler) UsersUserIDGet(ctx context.Context, params api.UsersUserIDGetParams) (api.UsersUserIDGetRes, error) {
-	// TODO: APIの処理を実装
-	return &api.User{}, nil
+	return h.getAPIUser(ctx, params.UserID)
 }
 
 // UsersUserIDPut implements PUT /users/{user_id} operation.
 // ユーザープロフィール更新
 func (h *Handler) UsersUserIDPut(ctx context.Context, req *api.UserRequest, params api.UsersUserIDPutParams) (api.UsersUserIDPutRes, error) {
-	// TODO: APIの処理を実装
-	return &api.User{}, nil
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.UserID != userID {
+		return nil, ErrForbidden
+	}
+
+	update := h.client.User.UpdateOneID(userID).
+		SetName(req.Name).
+		ClearGenres().
+		AddGenreIDs(req.Genres...)
+	if v, ok := req.Handle.Get(); ok {
+		// ハンドルは大文字・小文字を区別しないため小文字で保存する
+		update.SetHandle(richtext.NormalizeHandle(v))
+	} else {
+		update.ClearHandle()
+	}
+	if v, ok := req.Birthday.Get(); ok {
+		update.SetBirthday(v)
+	} else {
+		update.ClearBirthday()
+	}
+	if v, ok := req.Hometown.Get(); ok {
+		update.SetHometown(v)
+	} else {
+		update.ClearHometown()
+	}
+	if v, ok := req.Bio.Get(); ok {
+		update.SetBio(v)
+	} else {
+		update.ClearBio()
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return h.getAPIUser(ctx, userID)
 }
 
 // UsersUserIDIconDelete implements DELETE /users/{user_id}/icon operation.
@@ -54,3 +95,45 @@ func (h *Handler) UsersUserIDIconPost(ctx context.Context, req api.OptUsersUserI
 	// TODO: APIの処理を実装
 	return &api.UsersUserIDIconPostNoContent{}, nil
 }
+
+// getAPIUser はユーザーを取得してAPIレスポンスの形式に変換します。
+// 運営によりプロフィールが非表示にされている場合、本人以外には自己紹介・出身地・誕生日・ジャンルを返しません。
+func (h *Handler) getAPIUser(ctx context.Context, id uuid.UUID) (*api.User, error) {
+	u, err := h.client.User.Query().
+		Where(user.ID(id)).
+		WithGenres(func(q *ent.GenreQuery) {
+			q.Select(genre.FieldID)
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.User{
+		ID:     u.ID,
+		Name:   u.Name,
+		Genres: make([]uuid.UUID, 0, len(u.Edges.Genres)),
+	}
+	if u.Handle != nil {
+		res.Handle = api.NewOptString(*u.Handle)
+	}
+	if u.HiddenAt != nil {
+		if viewerID(ctx) != u.ID {
+			return res, nil
+		}
+		res.ModerationNotice = moderationNotice(u.HiddenAt)
+	}
+	if u.Birthday != nil {
+		res.Birthday = api.NewOptDate(*u.Birthday)
+	}
+	if u.Hometown != nil {
+		res.Hometown = api.NewOptString(*u.Hometown)
+	}
+	if u.Bio != nil {
+		res.Bio = api.NewOptString(*u.Bio)
+	}
+	for _, g := range u.Edges.Genres {
+		res.Genres = append(res.Genres, g.ID)
+	}
+	return res, nil
+}
@@ -0,0 +1,295 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/ent"
+	"backend/ent/goal"
+	"backend/ent/post"
+	"backend/ent/reaction"
+	"backend/ent/user"
+	"backend/internal/other"
+
+	"github.com/google/uuid"
+)
+
+// MaxRangeDays は1回の集計で指定できる最大日数です。
+const MaxRangeDays = 366
+
+var (
+	// ErrInvalidRange は集計期間が不正な場合のエラーです。
+	ErrInvalidRange = errors.New("invalid range")
+)
+
+// DayBucket は1日分の集計値です。Dateはユーザーのタイムゾーンでの日付（0時）です。
+type DayBucket struct {
+	Date   time.Time
+	Count  int
+	Amount float64
+}
+
+// DeadlineProgress は期限に対する進み具合です。
+type DeadlineProgress struct {
+	Deadline time.Time
+	// DaysRemaining は今日から期限までの日数です（期限切れの場合は負の値）。
+	DaysRemaining int
+	// ElapsedRatio は目標作成から期限までの期間のうち経過した割合です（0〜1）。
+	ElapsedRatio float64
+}
+
+// GoalReport は目標の進捗分析の結果です。
+type GoalReport struct {
+	GoalID   uuid.UUID
+	Location *time.Location
+	// From, To は集計期間（両端を含む日付）です。
+	From time.Time
+	To   time.Time
+	// Heatmap は期間内の日ごとの投稿数と進捗量です。投稿のない日も含みます。
+	Heatmap []DayBucket
+	// Reactions は期間内の日ごとに受け取ったリアクション数です。投稿のない日も含みます。
+	Reactions   []DayBucket
+	PostCount   int
+	TotalAmount float64
+	ActiveDays  int
+	// AverageGap, LongestGap は連続する投稿の間隔です。投稿が2件未満の場合はnilです。
+	AverageGap *time.Duration
+	LongestGap *time.Duration
+	// Deadline は期限が設定されていない場合はnilです。
+	Deadline *DeadlineProgress
+}
+
+// Range は集計期間の指定です。日付部分のみが使われ、未指定の値はデフォルトが使われます。
+type Range struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// Service は目標の進捗分析を行います。
+type Service struct {
+	client *ent.Client
+	now    func() time.Time
+}
+
+// NewService は新しいServiceインスタンスを作成します。
+func NewService(client *ent.Client) *Service {
+	return &Service{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// GoalReport は目標の投稿とリアクションを目標オーナーのタイムゾーンで日ごとに集計します。
+// 期間のデフォルトは目標の作成日から今日までで、MaxRangeDaysを超える場合は直近の期間に丸めます。
+func (s *Service) GoalReport(ctx context.Context, goalID uuid.UUID, r Range) (*GoalReport, error) {
+	g, err := s.client.Goal.Query().
+		Where(goal.ID(goalID)).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldTimeZone)
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.UTC
+	if g.Edges.User != nil {
+		loc = other.LoadLocation(g.Edges.User.TimeZone)
+	}
+	now := s.now().In(loc)
+	today := dateOf(now, loc)
+
+	to := today
+	if r.To != nil {
+		to = calendarDate(*r.To, loc)
+	}
+	from := dateOf(g.CreatedAt.In(loc), loc)
+	if r.From != nil {
+		from = calendarDate(*r.From, loc)
+	} else if days(from, to) >= MaxRangeDays {
+		from = to.AddDate(0, 0, -(MaxRangeDays - 1))
+	}
+	if to.Before(from) || days(from, to) >= MaxRangeDays {
+		return nil, ErrInvalidRange
+	}
+
+	// 日付の範囲を [from 0時, to翌日 0時) の時刻に変換する
+	start, end := from, to.AddDate(0, 0, 1)
+
+	postRows, err := s.postBuckets(ctx, goalID, start, end, loc)
+	if err != nil {
+		return nil, err
+	}
+	reactionRows, err := s.reactionBuckets(ctx, goalID, start, end, loc)
+	if err != nil {
+		return nil, err
+	}
+	times, err := s.postTimes(ctx, goalID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &GoalReport{
+		GoalID:    goalID,
+		Location:  loc,
+		From:      from,
+		To:        to,
+		Heatmap:   fillDays(postRows, from, to),
+		Reactions: fillDays(reactionRows, from, to),
+	}
+	for _, b := range postRows {
+		report.PostCount += b.Count
+		report.TotalAmount += b.Amount
+		if b.Count > 0 {
+			report.ActiveDays++
+		}
+	}
+	report.AverageGap, report.LongestGap = gaps(times)
+
+	if g.Deadline != nil {
+		report.Deadline = deadlineProgress(*g.Deadline, g.CreatedAt, now, loc)
+	}
+
+	return report, nil
+}
+
+// postTimes は期間内の投稿日時を古い順に取得します。
+func (s *Service) postTimes(ctx context.Context, goalID uuid.UUID, start, end time.Time) ([]time.Time, error) {
+	var rows []struct {
+		CreatedAt time.Time `json:"created_at"`
+	}
+	err := s.client.Post.Query().
+		Where(
+			post.HasGoalWith(goal.ID(goalID)),
+			post.StatusEQ(post.StatusPublished),
+			post.HiddenAtIsNil(),
+			post.CreatedAtGTE(start),
+			post.CreatedAtLT(end),
+		).
+		Order(post.ByCreatedAt()).
+		Select(post.FieldCreatedAt).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	times := make([]time.Time, 0, len(rows))
+	for _, r := range rows {
+		times = append(times, r.CreatedAt)
+	}
+	return times, nil
+}
+
+// reactionBuckets は目標の投稿が期間内に受け取ったリアクションを日ごとに集計します。
+func (s *Service) reactionBuckets(ctx context.Context, goalID uuid.UUID, start, end time.Time, loc *time.Location) ([]DayBucket, error) {
+	var rows []bucketRow
+	err := s.client.Reaction.Query().
+		Where(
+			reaction.HasPostWith(post.HasGoalWith(goal.ID(goalID)), post.StatusEQ(post.StatusPublished), post.HiddenAtIsNil()),
+			reaction.CreatedAtGTE(start),
+			reaction.CreatedAtLT(end),
+		).
+		Modify(bucketByDay(reaction.FieldCreatedAt, "", loc)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	return mergeRows(rows, loc), nil
+}
+
+// postBuckets は期間内の投稿数と進捗量を日ごとに集計します。
+func (s *Service) postBuckets(ctx context.Context, goalID uuid.UUID, start, end time.Time, loc *time.Location) ([]DayBucket, error) {
+	var rows []bucketRow
+	err := s.client.Post.Query().
+		Where(
+			post.HasGoalWith(goal.ID(goalID)),
+			post.StatusEQ(post.StatusPublished),
+			post.HiddenAtIsNil(),
+			post.CreatedAtGTE(start),
+			post.CreatedAtLT(end),
+		).
+		Modify(bucketByDay(post.FieldCreatedAt, post.FieldAmount, loc)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	return mergeRows(rows, loc), nil
+}
+
+// deadlineProgress は期限までの残り日数と経過割合を算出します。
+func deadlineProgress(deadline, createdAt, now time.Time, loc *time.Location) *DeadlineProgress {
+	d := deadline.UTC()
+	due := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)
+
+	res := &DeadlineProgress{
+		Deadline:      due,
+		DaysRemaining: days(dateOf(now, loc), due),
+	}
+
+	// 期限日の終わりまでを目標期間とする
+	total := due.AddDate(0, 0, 1).Sub(createdAt)
+	elapsed := now.Sub(createdAt)
+	switch {
+	case total <= 0 || elapsed >= total:
+		res.ElapsedRatio = 1
+	case elapsed <= 0:
+		res.ElapsedRatio = 0
+	default:
+		res.ElapsedRatio = elapsed.Seconds() / total.Seconds()
+	}
+	return res
+}
+
+// gaps は投稿日時の平均間隔と最長間隔を返します。投稿が2件未満の場合はnilを返します。
+func gaps(times []time.Time) (avg, longest *time.Duration) {
+	if len(times) < 2 {
+		return nil, nil
+	}
+
+	var max time.Duration
+	for i := 1; i < len(times); i++ {
+		if gap := times[i].Sub(times[i-1]); gap > max {
+			max = gap
+		}
+	}
+	a := times[len(times)-1].Sub(times[0]) / time.Duration(len(times)-1)
+	return &a, &max
+}
+
+// fillDays は集計結果を期間内の全日付に展開し、データのない日を0で埋めます。
+func fillDays(buckets []DayBucket, from, to time.Time) []DayBucket {
+	byDay := make(map[string]DayBucket, len(buckets))
+	for _, b := range buckets {
+		byDay[b.Date.Format(time.DateOnly)] = b
+	}
+
+	res := make([]DayBucket, 0, days(from, to)+1)
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		b, ok := byDay[d.Format(time.DateOnly)]
+		if !ok {
+			b = DayBucket{}
+		}
+		b.Date = d
+		res = append(res, b)
+	}
+	return res
+}
+
+// dateOf はtをlocでの日付（0時）に変換します。
+func dateOf(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// calendarDate はtの日付部分をそのままlocの日付（0時）として扱います。
+func calendarDate(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// days はfromからtoまでの日数を返します。夏時間による時刻のずれは無視します。
+func days(from, to time.Time) int {
+	f := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	t := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(t.Sub(f).Hours() / 24)
+}
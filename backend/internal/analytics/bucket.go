@@ -0,0 +1,88 @@
+package analytics
+
+import (
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// bucketRow は日付集計クエリの1行です。
+// PostgreSQLでは日付ごとに集計済みの行が、それ以外のDBでは集計前の1レコードごとの行が入ります。
+type bucketRow struct {
+	Day       string    `json:"day"`
+	CreatedAt time.Time `json:"created_at"`
+	Count     int       `json:"count"`
+	Amount    float64   `json:"amount"`
+}
+
+// bucketByDay は日付ごとの件数と合計値を選択するクエリ修飾子を返します。
+// PostgreSQLではタイムゾーンを考慮した日付でGROUP BYし、
+// タイムゾーン変換ができないSQLiteなどでは生の行を返してmergeRowsでGo側で集計します。
+// amountColumnが空の場合、合計値は常に0になります。
+func bucketByDay(timeColumn, amountColumn string, loc *time.Location) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		amount := sql.Expr("0")
+		if amountColumn != "" {
+			amount = sql.Expr("COALESCE(" + s.C(amountColumn) + ", 0)")
+		}
+
+		if s.Dialect() != dialect.Postgres {
+			s.Select().
+				AppendSelectAs(s.C(timeColumn), "created_at").
+				AppendSelectExprAs(sql.Expr("1"), "count").
+				AppendSelectExprAs(amount, "amount")
+			return
+		}
+
+		day := sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("to_char(" + s.C(timeColumn) + " AT TIME ZONE ").
+				Arg(loc.String()).
+				WriteString(", 'YYYY-MM-DD')")
+		})
+		sum := sql.Expr("0")
+		if amountColumn != "" {
+			sum = sql.Expr("COALESCE(SUM(" + s.C(amountColumn) + "), 0)")
+		}
+		s.Select().
+			AppendSelectExprAs(day, "day").
+			AppendSelectExprAs(sql.Expr("COUNT(*)"), "count").
+			AppendSelectExprAs(sum, "amount").
+			GroupBy("day")
+	}
+}
+
+// mergeRows は集計クエリの結果を日付ごとにまとめ、日付の昇順で返します。
+func mergeRows(rows []bucketRow, loc *time.Location) []DayBucket {
+	byDay := make(map[time.Time]*DayBucket)
+	for _, r := range rows {
+		var day time.Time
+		if r.Day != "" {
+			d, err := time.ParseInLocation(time.DateOnly, r.Day, loc)
+			if err != nil {
+				continue
+			}
+			day = d
+		} else {
+			day = dateOf(r.CreatedAt, loc)
+		}
+
+		b, ok := byDay[day]
+		if !ok {
+			b = &DayBucket{Date: day}
+			byDay[day] = b
+		}
+		b.Count += r.Count
+		b.Amount += r.Amount
+	}
+
+	res := make([]DayBucket, 0, len(byDay))
+	for _, b := range byDay {
+		res = append(res, *b)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Date.Before(res[j].Date)
+	})
+	return res
+}
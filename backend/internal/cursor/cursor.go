@@ -0,0 +1,98 @@
+// Package cursor はキーセットページネーションの位置を、改ざんできない不透明な文字列として表します。
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"backend/internal/other"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalid はカーソルの形式が不正、署名が一致しない、または別の一覧のカーソルである場合のエラーです。
+var ErrInvalid = errors.New("invalid cursor")
+
+// ErrSecretRequired は署名の鍵が設定されていない場合のエラーです。
+var ErrSecretRequired = errors.New("cursor secret is required")
+
+const (
+	// payloadSize は位置を表すバイト数です (日時のUnixナノ秒8バイトとID16バイト)。
+	payloadSize = 8 + 16
+	// macSize は署名のバイト数です (HMAC-SHA256の先頭16バイト)。
+	macSize = 16
+)
+
+// Position はキーセットページネーションの位置です。
+// 一覧の最後に返した要素の並び順の日時とIDで、次のページはこの位置より後の要素になります。
+type Position struct {
+	Time time.Time
+	ID   uuid.UUID
+}
+
+// Codec はPositionを署名付きの文字列に変換します。
+type Codec struct {
+	secret []byte
+}
+
+// Config はカーソルの署名の設定を保持します。
+type Config struct {
+	// Secret はカーソルの署名の鍵です。
+	Secret string
+}
+
+// NewConfig は環境変数からカーソルの署名の設定を作成します。
+func NewConfig() *Config {
+	return &Config{
+		Secret: other.GetEnv("CURSOR_SECRET", ""),
+	}
+}
+
+// NewCodec は新しいCodecインスタンスを作成します。
+// 鍵が推測できるとカーソルを偽造できるため、鍵が設定されていない場合はErrSecretRequiredを返します。
+func NewCodec(config *Config) (*Codec, error) {
+	if config.Secret == "" {
+		return nil, ErrSecretRequired
+	}
+	return &Codec{secret: []byte(config.Secret)}, nil
+}
+
+// Encode は位置をscopeに結び付けて署名し、URLにそのまま使える文字列にします。
+// scopeは一覧の種類 (例: "timeline") で、別の一覧のカーソルが流用されることを防ぎます。
+func (c *Codec) Encode(scope string, p Position) string {
+	buf := make([]byte, payloadSize, payloadSize+macSize)
+	binary.BigEndian.PutUint64(buf, uint64(p.Time.UnixNano()))
+	copy(buf[8:], p.ID[:])
+	buf = append(buf, c.sign(scope, buf)...)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// Decode はEncodeで作成した文字列を検証し、位置を取り出します。
+func (c *Codec) Decode(scope, s string) (Position, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(buf) != payloadSize+macSize {
+		return Position{}, ErrInvalid
+	}
+	payload, mac := buf[:payloadSize], buf[payloadSize:]
+	if !hmac.Equal(mac, c.sign(scope, payload)) {
+		return Position{}, ErrInvalid
+	}
+
+	var p Position
+	p.Time = time.Unix(0, int64(binary.BigEndian.Uint64(payload)))
+	copy(p.ID[:], payload[8:])
+	return p, nil
+}
+
+// sign はscopeと位置に対する署名を返します。
+func (c *Codec) sign(scope string, payload []byte) []byte {
+	h := hmac.New(sha256.New, c.secret)
+	h.Write([]byte(scope))
+	h.Write([]byte{0})
+	h.Write(payload)
+	return h.Sum(nil)[:macSize]
+}
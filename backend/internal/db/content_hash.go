@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"log"
+
+	"backend/ent"
+	"backend/ent/comment"
+	"backend/ent/post"
+	"backend/internal/spamfilter"
+)
+
+// BackfillContentHashes は本文のハッシュがまだない投稿とコメントにハッシュを記録します。
+// ハッシュの導入前に作成されたデータのためのもので、記録済みのデータは対象外のため起動のたびに実行できます。
+// 更新日時は変更しません。
+func BackfillContentHashes(ctx context.Context, client *ent.Client) error {
+	posts, comments := 0, 0
+	for {
+		ps, err := client.Post.Query().
+			Where(post.ContentHashIsNil()).
+			Select(post.FieldID, post.FieldContent, post.FieldUpdatedAt).
+			Limit(backfillBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, p := range ps {
+			err := client.Post.Update().
+				Where(post.ID(p.ID)).
+				SetContentHash(spamfilter.ContentHash(p.Content)).
+				SetUpdatedAt(p.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		posts += len(ps)
+		if len(ps) < backfillBatchSize {
+			break
+		}
+	}
+
+	for {
+		cs, err := client.Comment.Query().
+			Where(comment.ContentHashIsNil()).
+			Select(comment.FieldID, comment.FieldContent, comment.FieldUpdatedAt).
+			Limit(backfillBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, c := range cs {
+			err := client.Comment.Update().
+				Where(comment.ID(c.ID)).
+				SetContentHash(spamfilter.ContentHash(c.Content)).
+				SetUpdatedAt(c.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		comments += len(cs)
+		if len(cs) < backfillBatchSize {
+			break
+		}
+	}
+
+	if posts > 0 || comments > 0 {
+		log.Printf("Content hashes backfilled for %d posts and %d comments.", posts, comments)
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package db
 
 import (
 	"backend/ent"
+	"backend/ent/migrate"
+	_ "backend/ent/runtime"
 	"backend/internal/other"
 	"context"
 	"fmt"
@@ -9,7 +11,8 @@ import (
 	"log"
 )
 
-func CreateClient() (*ent.Client, error) {
+// DSN は環境変数からデータベースの接続文字列を作成します。
+func DSN() string {
 	// 環境変数から接続情報を取得（デフォルト値を設定）
 	dbHost := other.GetEnv("DB_HOST", "localhost")
 	dbPort := other.GetEnv("DB_PORT", "5432")
@@ -18,12 +21,14 @@ func CreateClient() (*ent.Client, error) {
 	dbName := other.GetEnv("DB_NAME", "p-log")
 	sslmode := other.GetEnv("DB_SSLMODE", "disable")
 
-	dsn := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s",
+	return fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s",
 		dbHost, dbPort, dbUser, dbName, dbPassword, sslmode)
+}
 
-	log.Printf("Connecting to database at %s:%s", dbHost, dbPort)
+func CreateClient() (*ent.Client, error) {
+	log.Printf("Connecting to database at %s:%s", other.GetEnv("DB_HOST", "localhost"), other.GetEnv("DB_PORT", "5432"))
 
-	client, err := ent.Open("postgres", dsn)
+	client, err := ent.Open("postgres", DSN())
 	if err != nil {
 		log.Fatalf("failed opening connection to postgres: %v", err)
 		return nil, err
@@ -34,7 +39,8 @@ func CreateClient() (*ent.Client, error) {
 
 func Migrate(client *ent.Client) {
 	// Run the auto migration tool.
-	if err := client.Schema.Create(context.Background()); err != nil {
+	// スキーマから削除・変更されたインデックス（ユニーク制約を含む）も反映する
+	if err := client.Schema.Create(context.Background(), migrate.WithDropIndex(true)); err != nil {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 	log.Println("Database migration completed successfully.")
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"log"
+
+	"backend/ent"
+	"backend/ent/goal"
+	"backend/ent/post"
+	"backend/internal/search"
+)
+
+// backfillBatchSize は全文検索用の字句を一度に作成する件数です。
+const backfillBatchSize = 500
+
+// BackfillSearchVectors は全文検索用の字句がまだない投稿と目標に字句を作成します。
+// 全文検索の導入前に作成されたデータのためのもので、作成済みのデータは対象外のため起動のたびに実行できます。
+// 更新日時は変更しません。
+func BackfillSearchVectors(ctx context.Context, client *ent.Client) error {
+	posts, goals := 0, 0
+	for {
+		ps, err := client.Post.Query().
+			Where(post.SearchVectorIsNil()).
+			Select(post.FieldID, post.FieldContent, post.FieldUpdatedAt).
+			Limit(backfillBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, p := range ps {
+			err := client.Post.Update().
+				Where(post.ID(p.ID)).
+				SetSearchVector(search.Vector(p.Content)).
+				SetUpdatedAt(p.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		posts += len(ps)
+		if len(ps) < backfillBatchSize {
+			break
+		}
+	}
+
+	for {
+		gs, err := client.Goal.Query().
+			Where(goal.SearchVectorIsNil()).
+			Select(goal.FieldID, goal.FieldTitle, goal.FieldUpdatedAt).
+			Limit(backfillBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, g := range gs {
+			err := client.Goal.Update().
+				Where(goal.ID(g.ID)).
+				SetSearchVector(search.Vector(g.Title)).
+				SetUpdatedAt(g.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		goals += len(gs)
+		if len(gs) < backfillBatchSize {
+			break
+		}
+	}
+
+	if posts > 0 || goals > 0 {
+		log.Printf("Search vectors backfilled for %d posts and %d goals.", posts, goals)
+	}
+	return nil
+}
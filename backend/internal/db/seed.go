@@ -0,0 +1,106 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"backend/ent"
+	"backend/ent/genre"
+	"backend/ent/goaltemplate"
+	"backend/ent/schema/types"
+
+	"gopkg.in/yaml.v3"
+)
+
+// templateSeedFile は目標テンプレートのシードファイルの形式です。
+type templateSeedFile struct {
+	Genres []struct {
+		Name      string `yaml:"name"`
+		Templates []struct {
+			Title                 string                    `yaml:"title"`
+			Description           *string                   `yaml:"description"`
+			SuggestedDurationDays *int                      `yaml:"suggested_duration_days"`
+			HabitDays             []string                  `yaml:"habit_days"`
+			Milestones            []types.MilestoneTemplate `yaml:"milestones"`
+		} `yaml:"templates"`
+	} `yaml:"genres"`
+}
+
+// SeedGoalTemplates はYAMLファイルから目標テンプレートの初期データを投入します。
+// 同じジャンル・タイトルのテンプレートが既にある場合は内容を上書きするため、起動のたびに実行できます。
+// ファイルが存在しない場合は何もしません。
+func SeedGoalTemplates(ctx context.Context, client *ent.Client, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Printf("Goal template seed file %s not found, skipping.", path)
+			return nil
+		}
+		return err
+	}
+
+	var seed templateSeedFile
+	if err := yaml.Unmarshal(data, &seed); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, gs := range seed.Genres {
+		g, err := tx.Genre.Query().
+			Where(genre.NameEQ(gs.Name)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			g, err = tx.Genre.Create().
+				SetName(gs.Name).
+				Save(ctx)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("seeding genre %q: %w", gs.Name, err)
+		}
+
+		for _, ts := range gs.Templates {
+			id, err := tx.GoalTemplate.Query().
+				Where(
+					goaltemplate.TitleEQ(ts.Title),
+					goaltemplate.HasGenreWith(genre.ID(g.ID)),
+				).
+				OnlyID(ctx)
+			switch {
+			case ent.IsNotFound(err):
+				err = tx.GoalTemplate.Create().
+					SetGenre(g).
+					SetTitle(ts.Title).
+					SetNillableDescription(ts.Description).
+					SetNillableSuggestedDurationDays(ts.SuggestedDurationDays).
+					SetHabitDays(ts.HabitDays).
+					SetMilestones(ts.Milestones).
+					Exec(ctx)
+			case err == nil:
+				err = tx.GoalTemplate.UpdateOneID(id).
+					SetNillableDescription(ts.Description).
+					SetNillableSuggestedDurationDays(ts.SuggestedDurationDays).
+					SetHabitDays(ts.HabitDays).
+					SetMilestones(ts.Milestones).
+					Exec(ctx)
+			}
+			if err != nil {
+				_ = tx.Rollback()
+				return fmt.Errorf("seeding goal template %q: %w", ts.Title, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	log.Println("Goal template seeding completed successfully.")
+	return nil
+}
@@ -0,0 +1,198 @@
+// Package eventbus は処理の間でイベントを受け渡すpub/subのバスを扱います。
+// レプリカが1つの場合はMemoryBusを、複数のレプリカの間で受け渡す場合はPostgresBusを使います。
+package eventbus
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"backend/internal/other"
+)
+
+// Message はバスで配信するメッセージです。
+type Message struct {
+	Topic   string
+	Payload []byte
+	// Lost はこのメッセージより前に、届けられなかったメッセージがあることを表します。
+	// Lostのメッセージには内容がなく、購読者は保持している状態を作り直します。
+	Lost bool
+}
+
+// Bus はトピックごとにメッセージを配信するインターフェースです。
+// 同じトピックのメッセージは、全ての購読者に同じ順番で届けます。
+type Bus interface {
+	// Publish はトピックの購読者にメッセージを配信します。
+	Publish(ctx context.Context, topic string, payload []byte) error
+	// Subscribe はトピックを購読します。返すチャネルはctxがキャンセルされると閉じられます。
+	Subscribe(ctx context.Context, topic string) (<-chan Message, error)
+}
+
+// Config はバスの設定を保持します。
+type Config struct {
+	// BufferSize は購読者ごとに受け取り待ちのメッセージを保持する件数です。
+	BufferSize int
+	// ChunkSize はPostgresBusで1回の通知に含めるメッセージの最大バイト数です。これを超えるメッセージは分割して通知します。
+	ChunkSize int
+	// MinReconnectInterval はPostgresBusの接続が切れた場合に、再接続を試みるまでの最初の間隔です。
+	MinReconnectInterval time.Duration
+	// MaxReconnectInterval は再接続に失敗し続けた場合の、再接続を試みる間隔の上限です。
+	MaxReconnectInterval time.Duration
+	// PingInterval はPostgresBusの接続が切れていないかを確認する間隔です。
+	PingInterval time.Duration
+}
+
+// maxChunkSize はChunkSizeの上限です。
+// PostgreSQLの通知の内容は8000バイト未満である必要があり、base64への変換と見出しの分を除いています。
+const maxChunkSize = 5600
+
+// NewConfig は環境変数からバスの設定を作成します。
+func NewConfig() *Config {
+	bufferSize := other.GetEnvInt("EVENTBUS_BUFFER_SIZE", 256)
+	if bufferSize <= 0 {
+		bufferSize = 256
+	}
+	chunkSize := other.GetEnvInt("EVENTBUS_CHUNK_SIZE", 4000)
+	if chunkSize <= 0 || chunkSize > maxChunkSize {
+		chunkSize = 4000
+	}
+	minReconnect, err := time.ParseDuration(other.GetEnv("EVENTBUS_MIN_RECONNECT_INTERVAL", "1s"))
+	if err != nil || minReconnect <= 0 {
+		minReconnect = time.Second
+	}
+	maxReconnect, err := time.ParseDuration(other.GetEnv("EVENTBUS_MAX_RECONNECT_INTERVAL", "1m"))
+	if err != nil || maxReconnect < minReconnect {
+		maxReconnect = max(time.Minute, minReconnect)
+	}
+	pingInterval, err := time.ParseDuration(other.GetEnv("EVENTBUS_PING_INTERVAL", "90s"))
+	if err != nil || pingInterval <= 0 {
+		pingInterval = 90 * time.Second
+	}
+
+	return &Config{
+		BufferSize:           bufferSize,
+		ChunkSize:            chunkSize,
+		MinReconnectInterval: minReconnect,
+		MaxReconnectInterval: maxReconnect,
+		PingInterval:         pingInterval,
+	}
+}
+
+// subscriber は1つの購読です。
+type subscriber struct {
+	ch chan Message
+	// lost は受け取り待ちが一杯でメッセージを破棄したかです。次に届けるメッセージの前にLostを届けます。
+	lost bool
+}
+
+// registry はトピックごとの購読者を管理し、メッセージを届けます。
+type registry struct {
+	bufferSize int
+
+	mu          sync.Mutex
+	subscribers map[string]map[*subscriber]struct{}
+}
+
+// newRegistry は新しいregistryインスタンスを作成します。
+func newRegistry(bufferSize int) *registry {
+	return &registry{
+		bufferSize:  bufferSize,
+		subscribers: make(map[string]map[*subscriber]struct{}),
+	}
+}
+
+// add はトピックの購読者を登録します。トピックの最初の購読者であればfirstにtrueを返します。
+func (r *registry) add(topic string) (s *subscriber, first bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s = &subscriber{ch: make(chan Message, r.bufferSize)}
+	if r.subscribers[topic] == nil {
+		r.subscribers[topic] = make(map[*subscriber]struct{})
+		first = true
+	}
+	r.subscribers[topic][s] = struct{}{}
+	return s, first
+}
+
+// remove は購読者の登録を解除してチャネルを閉じます。トピックの最後の購読者であればtrueを返します。
+func (r *registry) remove(topic string, s *subscriber) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.subscribers[topic], s)
+	close(s.ch)
+	if len(r.subscribers[topic]) > 0 {
+		return false
+	}
+	delete(r.subscribers, topic)
+	return true
+}
+
+// deliver はトピックの購読者にメッセージを届けます。
+// 発行元を待たせないよう、受け取り待ちが一杯の購読者には届けずに破棄し、後でLostを届けます。
+func (r *registry) deliver(m Message) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for s := range r.subscribers[m.Topic] {
+		if s.lost {
+			select {
+			case s.ch <- Message{Topic: m.Topic, Lost: true}:
+				s.lost = false
+			default:
+				continue
+			}
+		}
+		select {
+		case s.ch <- m:
+		default:
+			s.lost = true
+			slog.Warn("event bus subscriber is full", "topic", m.Topic)
+		}
+	}
+}
+
+// lose は全ての購読者に、届けられなかったメッセージがあることを知らせます。
+func (r *registry) lose() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for topic, subscribers := range r.subscribers {
+		for s := range subscribers {
+			select {
+			case s.ch <- Message{Topic: topic, Lost: true}:
+			default:
+				s.lost = true
+			}
+		}
+	}
+}
+
+// MemoryBus は同じプロセス内の購読者にだけ配信するBusです。
+// 配信は発行したゴルーチンで行うため、同じトピックのメッセージは発行した順に届きます。
+type MemoryBus struct {
+	registry *registry
+}
+
+// NewMemoryBus は新しいMemoryBusインスタンスを作成します。
+func NewMemoryBus(config *Config) *MemoryBus {
+	return &MemoryBus{registry: newRegistry(config.BufferSize)}
+}
+
+// Publish はトピックの購読者にメッセージを配信します。
+func (b *MemoryBus) Publish(_ context.Context, topic string, payload []byte) error {
+	b.registry.deliver(Message{Topic: topic, Payload: payload})
+	return nil
+}
+
+// Subscribe はトピックを購読します。
+func (b *MemoryBus) Subscribe(ctx context.Context, topic string) (<-chan Message, error) {
+	s, _ := b.registry.add(topic)
+	go func() {
+		<-ctx.Done()
+		b.registry.remove(topic, s)
+	}()
+	return s.ch, nil
+}
@@ -0,0 +1,204 @@
+package eventbus
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// channelPrefix はトピックに対応するPostgreSQLの通知のチャネル名の接頭辞です。
+const channelPrefix = "eventbus."
+
+// envelope はPostgreSQLの通知の内容です。
+// 通知の大きさには上限があるため、大きなメッセージは同じIDの複数の通知に分割します。
+type envelope struct {
+	ID    string `json:"id"`
+	Index int    `json:"i"`
+	Count int    `json:"n"`
+	Data  []byte `json:"d"`
+}
+
+// assembly は分割して通知されたメッセージの、受け取り途中の内容です。
+type assembly struct {
+	id   string
+	next int
+	data []byte
+}
+
+// PostgresBus はPostgreSQLのLISTEN/NOTIFYで、同じデータベースを使う全てのレプリカの購読者に配信するBusです。
+// 分割した通知は1つのトランザクションで送るため、途中で他のメッセージが混ざることはありません。
+// PostgreSQLは通知をコミットの順に届け、受け取った通知は1つのゴルーチンで購読者に渡すため、
+// 同じトピックのメッセージは全てのレプリカの購読者に同じ順番で届きます。
+type PostgresBus struct {
+	config   *Config
+	db       *sql.DB
+	listener *pq.Listener
+	registry *registry
+
+	// mu はLISTEN/UNLISTENを購読の登録・解除と同じ順番で行うためのロックです。
+	mu sync.Mutex
+	// partial はトピックごとの受け取り途中のメッセージです。Runのゴルーチンだけが使います。
+	partial map[string]*assembly
+}
+
+// NewPostgresBus は新しいPostgresBusインスタンスを作成します。
+// 通知の受け取りはRunで行います。
+func NewPostgresBus(config *Config, dsn string) (*PostgresBus, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	listener := pq.NewListener(dsn, config.MinReconnectInterval, config.MaxReconnectInterval, func(ev pq.ListenerEventType, err error) {
+		switch ev {
+		case pq.ListenerEventDisconnected:
+			slog.Warn("event bus disconnected", "error", err.Error())
+		case pq.ListenerEventConnectionAttemptFailed:
+			slog.Warn("event bus connection attempt failed", "error", err.Error())
+		case pq.ListenerEventReconnected:
+			slog.Info("event bus reconnected")
+		}
+	})
+
+	return &PostgresBus{
+		config:   config,
+		db:       db,
+		listener: listener,
+		registry: newRegistry(config.BufferSize),
+		partial:  make(map[string]*assembly),
+	}, nil
+}
+
+// Publish はメッセージをトピックのチャネルに通知します。
+// ChunkSizeを超えるメッセージは分割し、1つのトランザクションでまとめて通知します。
+func (b *PostgresBus) Publish(ctx context.Context, topic string, payload []byte) error {
+	size := b.config.ChunkSize
+	count := max(1, (len(payload)+size-1)/size)
+	id := uuid.NewString()
+
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for i := range count {
+		extra, err := json.Marshal(envelope{
+			ID:    id,
+			Index: i,
+			Count: count,
+			Data:  payload[i*size : min(len(payload), (i+1)*size)],
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "SELECT pg_notify($1, $2)", channelPrefix+topic, string(extra)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Subscribe はトピックを購読します。トピックの最初の購読者であればチャネルをLISTENします。
+// データベースに接続できない間は、接続できるまで待ちます。
+func (b *PostgresBus) Subscribe(ctx context.Context, topic string) (<-chan Message, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s, first := b.registry.add(topic)
+	if first {
+		err := b.listener.Listen(channelPrefix + topic)
+		if err != nil && !errors.Is(err, pq.ErrChannelAlreadyOpen) {
+			b.registry.remove(topic, s)
+			return nil, err
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if !b.registry.remove(topic, s) {
+			return
+		}
+		err := b.listener.Unlisten(channelPrefix + topic)
+		if err != nil && !errors.Is(err, pq.ErrChannelNotOpen) {
+			slog.Warn("failed to unlisten event bus channel", "topic", topic, "error", err.Error())
+		}
+	}()
+	return s.ch, nil
+}
+
+// Run はctxがキャンセルされるまで通知を受け取り、購読者に届けます。
+// 接続が切れている間の通知は届かないため、再接続した時点で全ての購読者にLostを届けます。
+func (b *PostgresBus) Run(ctx context.Context) {
+	defer b.db.Close()
+	defer b.listener.Close()
+
+	ticker := time.NewTicker(b.config.PingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// 通知がない間に接続が切れていても気付けるよう、定期的に確認する
+			go func() {
+				if err := b.listener.Ping(); err != nil {
+					slog.Warn("event bus ping failed", "error", err.Error())
+				}
+			}()
+		case n := <-b.listener.Notify:
+			if n == nil {
+				clear(b.partial)
+				b.registry.lose()
+				continue
+			}
+			b.receive(n)
+		}
+	}
+}
+
+// receive は1件の通知を受け取り、メッセージの全体が揃っていれば購読者に届けます。
+func (b *PostgresBus) receive(n *pq.Notification) {
+	topic, ok := strings.CutPrefix(n.Channel, channelPrefix)
+	if !ok {
+		return
+	}
+	var e envelope
+	if err := json.Unmarshal([]byte(n.Extra), &e); err != nil {
+		slog.Error("invalid event bus notification", "topic", topic, "error", err.Error())
+		return
+	}
+	if e.Count <= 1 {
+		b.registry.deliver(Message{Topic: topic, Payload: e.Data})
+		return
+	}
+
+	a := b.partial[topic]
+	if e.Index == 0 {
+		if a != nil {
+			b.registry.deliver(Message{Topic: topic, Lost: true})
+		}
+		a = &assembly{id: e.ID}
+		b.partial[topic] = a
+	}
+	if a == nil || a.id != e.ID || a.next != e.Index {
+		// 分割した通知の一部を受け取れなかった
+		delete(b.partial, topic)
+		b.registry.deliver(Message{Topic: topic, Lost: true})
+		return
+	}
+	a.data = append(a.data, e.Data...)
+	a.next++
+	if a.next == e.Count {
+		delete(b.partial, topic)
+		b.registry.deliver(Message{Topic: topic, Payload: a.data})
+	}
+}
@@ -0,0 +1,294 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"backend/ent"
+	"backend/ent/comment"
+	entnotification "backend/ent/notification"
+	"backend/ent/notificationdelivery"
+	"backend/ent/post"
+	"backend/ent/user"
+	"backend/internal/outbox"
+
+	"github.com/google/uuid"
+)
+
+// Inbox は通知をユーザーの通知一覧 (Notification) に保存するNotifierです。
+// 未読の間は同じ種類・同じ投稿への通知を1件にまとめ、操作をしたユーザーの人数を数えます。
+// 運営からの警告と目標の期限のリマインダーはまとめません。
+// ドメインイベントからの通知は反映したイベントを受け取るユーザーごとに記録し (NotificationDelivery)、
+// 既読にした後に同じイベントが再配信されても通知を作成し直しません。
+type Inbox struct {
+	client *ent.Client
+	now    func() time.Time
+}
+
+// NewInbox は新しいInboxインスタンスを作成します。
+func NewInbox(client *ent.Client) *Inbox {
+	return &Inbox{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// Notify は通知を通知一覧に保存します。
+// 自分自身の操作、受け取らない設定にしている種類、操作をしたユーザーとブロック関係にある場合は保存しません。
+func (i *Inbox) Notify(ctx context.Context, n Notification) error {
+	if n.UserID == n.ActorID {
+		return nil
+	}
+	tx, err := i.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := i.notify(ctx, tx, n); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// notify はトランザクションの中で通知を保存し、まとめられる未読の通知があればそれに加えます。
+func (i *Inbox) notify(ctx context.Context, tx *ent.Tx, n Notification) error {
+	// 同じユーザーへの通知を同時に作成して、まとめられるものが別々にならないよう受け取るユーザーの行をロックする
+	recipient, err := tx.User.Query().
+		Where(user.ID(n.UserID)).
+		ForUpdate().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if n.EventID != uuid.Nil {
+		// 受け取るユーザーの行をロックしているため、同じイベントを同時に反映することはない
+		delivered, err := tx.NotificationDelivery.Query().
+			Where(
+				notificationdelivery.EventID(n.EventID),
+				notificationdelivery.HasRecipientWith(user.ID(n.UserID)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if delivered {
+			return nil
+		}
+		err = tx.NotificationDelivery.Create().
+			SetEventID(n.EventID).
+			SetRecipientID(n.UserID).
+			SetDeliveredAt(i.now()).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	grouped := n.Type != TypeModerationWarning && n.Type != TypeGoalReminder
+	if grouped {
+		if slices.Contains(recipient.NotificationOptOuts, string(n.Type)) {
+			return nil
+		}
+		blocked, err := tx.User.Query().
+			Where(
+				user.ID(n.UserID),
+				user.Or(
+					user.HasBlockingWith(user.ID(n.ActorID)),
+					user.HasBlockedByWith(user.ID(n.ActorID)),
+				),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return nil
+		}
+	}
+
+	now := i.now()
+	if grouped {
+		q := tx.Notification.Query().
+			Where(
+				entnotification.HasRecipientWith(user.ID(n.UserID)),
+				entnotification.TypeEQ(entnotification.Type(n.Type)),
+				entnotification.ReadAtIsNil(),
+			)
+		if n.PostID == uuid.Nil {
+			q.Where(entnotification.Not(entnotification.HasPost()))
+		} else {
+			q.Where(entnotification.HasPostWith(post.ID(n.PostID)))
+		}
+		existing, err := q.
+			Order(entnotification.ByUpdatedAt(), entnotification.ByID()).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if existing != nil {
+			// 同じユーザーの操作は人数に数えない (同じ投稿への別の種類のリアクションや、イベントの再配信など)
+			acted, err := tx.Notification.Query().
+				Where(
+					entnotification.ID(existing.ID),
+					entnotification.HasActorsWith(user.ID(n.ActorID)),
+				).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			update := tx.Notification.UpdateOne(existing).
+				SetActorID(n.ActorID).
+				SetUpdatedAt(now)
+			if !acted {
+				update.
+					AddActorIDs(n.ActorID).
+					AddActorCount(1)
+			}
+			return update.Exec(ctx)
+		}
+	}
+
+	create := tx.Notification.Create().
+		SetType(entnotification.Type(n.Type)).
+		SetRecipientID(n.UserID).
+		SetCreatedAt(now).
+		SetUpdatedAt(now)
+	if n.ActorID != uuid.Nil {
+		create.
+			SetActorID(n.ActorID).
+			AddActorIDs(n.ActorID)
+	}
+	if n.PostID != uuid.Nil {
+		create.SetPostID(n.PostID)
+	}
+	if n.GoalID != uuid.Nil {
+		create.SetGoalID(n.GoalID)
+	}
+	return create.Exec(ctx)
+}
+
+// HandleOutboxEvent はメンション・コメント・リアクション・フォローのドメインイベントから通知を保存します。
+// 同じイベントが再配信されても、通知一覧に反映済みのイベントは無視します。
+func (i *Inbox) HandleOutboxEvent(ctx context.Context, e outbox.Event) error {
+	switch e.Type {
+	case outbox.TypePostMentioned:
+		var m outbox.MentionPayload
+		if err := json.Unmarshal(e.Payload, &m); err != nil {
+			return err
+		}
+		return i.notifyMentions(ctx, e.ID, m)
+	case outbox.TypeCommentCreated:
+		var c outbox.CommentPayload
+		if err := json.Unmarshal(e.Payload, &c); err != nil {
+			return err
+		}
+		return i.notifyComment(ctx, e.ID, c)
+	case outbox.TypeReactionCreated:
+		var r outbox.ReactionPayload
+		if err := json.Unmarshal(e.Payload, &r); err != nil {
+			return err
+		}
+		author, err := i.client.Post.Query().
+			Where(post.ID(r.PostID)).
+			QueryUser().
+			OnlyID(ctx)
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return i.Notify(ctx, Notification{
+			Type:    TypeReaction,
+			UserID:  author,
+			ActorID: r.UserID,
+			PostID:  r.PostID,
+			EventID: e.ID,
+		})
+	case outbox.TypeFollowCreated:
+		var f outbox.FollowPayload
+		if err := json.Unmarshal(e.Payload, &f); err != nil {
+			return err
+		}
+		return i.Notify(ctx, Notification{
+			Type:    TypeFollow,
+			UserID:  f.FolloweeID,
+			ActorID: f.FollowerID,
+			EventID: e.ID,
+		})
+	}
+	return nil
+}
+
+// notifyMentions は投稿で新たにメンションされたユーザーに通知します。
+// 確認のために保留中の投稿と、既に削除・非公開になった投稿では通知しません。
+func (i *Inbox) notifyMentions(ctx context.Context, eventID uuid.UUID, m outbox.MentionPayload) error {
+	visible, err := i.client.Post.Query().
+		Where(post.ID(m.PostID), post.StatusEQ(post.StatusPublished), post.HiddenAtIsNil()).
+		Exist(ctx)
+	if err != nil || !visible {
+		return err
+	}
+	for _, userID := range m.MentionedUserIDs {
+		err := i.Notify(ctx, Notification{
+			Type:    TypeMention,
+			UserID:  userID,
+			ActorID: m.UserID,
+			PostID:  m.PostID,
+			EventID: eventID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notifyComment はコメントされた投稿の作成者と、返信先のコメントをしたユーザーに通知します。
+// 確認のために保留中のコメントと、既に削除されたコメントでは通知しません。
+func (i *Inbox) notifyComment(ctx context.Context, eventID uuid.UUID, c outbox.CommentPayload) error {
+	visible, err := i.client.Comment.Query().
+		Where(comment.ID(c.CommentID), comment.HiddenAtIsNil(), comment.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil || !visible {
+		return err
+	}
+	recipients, err := i.client.User.Query().
+		Where(user.Or(
+			user.HasPostsWith(post.ID(c.PostID)),
+			user.HasCommentsWith(comment.HasRepliesWith(comment.ID(c.CommentID))),
+		)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, userID := range recipients {
+		err := i.Notify(ctx, Notification{
+			Type:    TypeComment,
+			UserID:  userID,
+			ActorID: c.UserID,
+			PostID:  c.PostID,
+			EventID: eventID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PurgeOutboxDeliveries はbeforeより前に通知一覧に反映したドメインイベントの記録を削除し、削除した件数を返します。
+func (i *Inbox) PurgeOutboxDeliveries(ctx context.Context, before time.Time) (int, error) {
+	return i.client.NotificationDelivery.Delete().
+		Where(notificationdelivery.DeliveredAtLT(before)).
+		Exec(ctx)
+}
@@ -0,0 +1,50 @@
+// Package notification はユーザーへの通知の配信を扱います。
+package notification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Type は通知の種類です。
+type Type string
+
+const (
+	// TypeMention は投稿でメンションされたことを表します。
+	TypeMention Type = "mention"
+	// TypeReaction は自分の投稿にリアクションが付いたことを表します。
+	TypeReaction Type = "reaction"
+	// TypeFollow はフォローされたことを表します。
+	TypeFollow Type = "follow"
+	// TypeComment は自分の投稿にコメント、または自分のコメントに返信が付いたことを表します。
+	TypeComment Type = "comment"
+	// TypeModerationWarning は運営から警告を受けたことを表します。
+	TypeModerationWarning Type = "moderation_warning"
+	// TypeGoalReminder は参加している目標の期限が近い、または期限を過ぎたことを表します。
+	TypeGoalReminder Type = "goal_reminder"
+)
+
+// OptOutTypes はユーザーが通知の設定で受け取らないようにできる種類です。運営からの警告は常に通知します。
+var OptOutTypes = []Type{TypeMention, TypeReaction, TypeFollow, TypeComment}
+
+// Notification は1件の通知の内容です。
+type Notification struct {
+	Type Type
+	// UserID は通知を受け取るユーザーのIDです。
+	UserID uuid.UUID
+	// ActorID は通知のきっかけとなった操作をしたユーザーのIDです (ユーザーの操作によらない通知の場合はuuid.Nil)。
+	ActorID uuid.UUID
+	// PostID は通知に関係する投稿のIDです (投稿に関係しない通知の場合はuuid.Nil)。
+	PostID uuid.UUID
+	// GoalID は通知に関係する目標のIDです (目標に関係しない通知の場合はuuid.Nil)。
+	GoalID uuid.UUID
+	// EventID は通知のきっかけとなったドメインイベントのIDです (イベントから作成しない通知の場合はuuid.Nil)。
+	EventID uuid.UUID
+}
+
+// Notifier は通知を配信するインターフェースです。
+// 配信手段（アプリ内の通知一覧、プッシュ通知など）はこのインターフェースを実装して差し替えます。
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
@@ -2,6 +2,7 @@ package other
 
 import (
 	"os"
+	"strconv"
 )
 
 // GetEnv は環境変数を取得し、存在しない場合はデフォルト値を返す
@@ -11,3 +12,27 @@ func GetEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// GetEnvInt は環境変数を整数として取得し、存在しないか整数でない場合はデフォルト値を返す
+func GetEnvInt(key string, defaultValue int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return v
+	}
+	return defaultValue
+}
+
+// GetEnvBool は環境変数を真偽値として取得し、存在しないか真偽値でない場合はデフォルト値を返す
+func GetEnvBool(key string, defaultValue bool) bool {
+	if v, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return v
+	}
+	return defaultValue
+}
+
+// GetEnvFloat は環境変数を浮動小数点数として取得し、存在しないか数値でない場合はデフォルト値を返す
+func GetEnvFloat(key string, defaultValue float64) float64 {
+	if v, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil {
+		return v
+	}
+	return defaultValue
+}
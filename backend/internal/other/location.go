@@ -0,0 +1,22 @@
+package other
+
+import (
+	"time"
+	// alpineイメージなどtzdataが無い環境でもタイムゾーンを解決できるようにする
+	_ "time/tzdata"
+)
+
+// DefaultTimeZone はユーザーのタイムゾーンが不正な場合に使用するタイムゾーンです。
+const DefaultTimeZone = "Asia/Tokyo"
+
+// LoadLocation はタイムゾーン名からLocationを取得します。不正な場合はデフォルトを返します。
+func LoadLocation(name string) *time.Location {
+	if loc, err := time.LoadLocation(name); err == nil {
+		return loc
+	}
+	loc, err := time.LoadLocation(DefaultTimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
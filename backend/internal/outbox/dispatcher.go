@@ -0,0 +1,249 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"backend/ent"
+	"backend/ent/outboxevent"
+	"backend/internal/other"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Config はドメインイベントの配信ジョブの設定を保持します。
+type Config struct {
+	// Interval は配信待ちのイベントを確認する間隔です。
+	Interval time.Duration
+	// BatchSize は1回の配信で取得する最大件数です。
+	BatchSize int
+	// MaxAttempts は配信を試みる最大回数です。超えたイベントは失敗として残し、再試行しません。
+	MaxAttempts int
+	// Backoff は最初の再試行までの間隔です。再試行のたびに2倍にします。
+	Backoff time.Duration
+	// MaxBackoff は再試行までの間隔の上限です。
+	MaxBackoff time.Duration
+	// Retention は配信済みのイベントを保持する期間です。
+	Retention time.Duration
+}
+
+// NewConfig は環境変数からドメインイベントの配信ジョブの設定を作成します。
+func NewConfig() *Config {
+	interval, err := time.ParseDuration(other.GetEnv("OUTBOX_INTERVAL", "1s"))
+	if err != nil || interval <= 0 {
+		interval = time.Second
+	}
+	batchSize := other.GetEnvInt("OUTBOX_BATCH_SIZE", 100)
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	maxAttempts := other.GetEnvInt("OUTBOX_MAX_ATTEMPTS", 10)
+	if maxAttempts <= 0 {
+		maxAttempts = 10
+	}
+	backoff, err := time.ParseDuration(other.GetEnv("OUTBOX_BACKOFF", "1s"))
+	if err != nil || backoff <= 0 {
+		backoff = time.Second
+	}
+	maxBackoff, err := time.ParseDuration(other.GetEnv("OUTBOX_MAX_BACKOFF", "1h"))
+	if err != nil || maxBackoff <= 0 {
+		maxBackoff = time.Hour
+	}
+	retention, err := time.ParseDuration(other.GetEnv("OUTBOX_RETENTION", "168h"))
+	if err != nil || retention <= 0 {
+		retention = 7 * 24 * time.Hour
+	}
+
+	return &Config{
+		Interval:    interval,
+		BatchSize:   batchSize,
+		MaxAttempts: maxAttempts,
+		Backoff:     backoff,
+		MaxBackoff:  max(maxBackoff, backoff),
+		Retention:   retention,
+	}
+}
+
+// Subscriber はドメインイベントを受け取るインターフェースです。
+// 同じイベントが複数回届くことがあるため、冪等に処理します。
+type Subscriber interface {
+	HandleOutboxEvent(ctx context.Context, e Event) error
+}
+
+// Purger は冪等に処理するために配信済みのイベントを記録する購読者が実装するインターフェースです。
+// 配信済みのイベントを削除した後は再配信されないため、同じ保持期間を過ぎた記録を削除します。
+type Purger interface {
+	PurgeOutboxDeliveries(ctx context.Context, before time.Time) (int, error)
+}
+
+// purgeInterval は配信済みの古いイベントを削除する間隔です。
+const purgeInterval = time.Hour
+
+// Dispatcher は配信待ちのOutboxEventを購読者に届けます。
+// イベントは FOR UPDATE SKIP LOCKED で取得するため、複数のレプリカで同時に実行しても同じイベントを同時に配信しません。
+type Dispatcher struct {
+	config      *Config
+	client      *ent.Client
+	subscribers []Subscriber
+	now         func() time.Time
+}
+
+// NewDispatcher は新しいDispatcherインスタンスを作成します。
+func NewDispatcher(config *Config, client *ent.Client, subscribers ...Subscriber) *Dispatcher {
+	return &Dispatcher{
+		config:      config,
+		client:      client,
+		subscribers: subscribers,
+		now:         time.Now,
+	}
+}
+
+// Run はctxがキャンセルされるまで一定間隔でDispatchを実行し、配信済みの古いイベントを削除します。
+// 取得したイベントが上限に達した場合は、間隔を空けずに続けて配信します。
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.config.Interval)
+	defer ticker.Stop()
+
+	var purged time.Time
+	for {
+		for {
+			n, err := d.Dispatch(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "outbox dispatch failed", "error", err.Error())
+				break
+			}
+			if n < d.config.BatchSize {
+				break
+			}
+		}
+		if d.now().Sub(purged) >= purgeInterval {
+			if _, err := d.Purge(ctx); err != nil {
+				slog.ErrorContext(ctx, "outbox purge failed", "error", err.Error())
+			} else {
+				purged = d.now()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch は配信待ちのイベントを古い順に購読者に届け、取得した件数を返します。
+// 全ての購読者が処理したイベントは配信済みにし、失敗したイベントは間隔を空けて再試行します。
+// 試行回数が上限に達したイベントは失敗として残します。
+func (d *Dispatcher) Dispatch(ctx context.Context) (int, error) {
+	tx, err := d.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n, err := d.dispatch(ctx, tx)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// dispatch はトランザクションの中でイベントを取得し、配信の結果を記録します。
+func (d *Dispatcher) dispatch(ctx context.Context, tx *ent.Tx) (int, error) {
+	rows, err := tx.OutboxEvent.Query().
+		Where(
+			outboxevent.StatusEQ(outboxevent.StatusPending),
+			outboxevent.NextAttemptAtLTE(d.now()),
+		).
+		Order(outboxevent.ByCreatedAt(), outboxevent.ByID()).
+		Limit(d.config.BatchSize).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, row := range rows {
+		e := Event{
+			ID:          row.ID,
+			Type:        Type(row.Type),
+			AggregateID: row.AggregateID,
+			Payload:     row.Payload,
+			CreatedAt:   row.CreatedAt,
+		}
+		attempts := row.Attempts + 1
+		update := tx.OutboxEvent.UpdateOne(row).
+			SetAttempts(attempts)
+		if err := d.deliver(ctx, e); err != nil {
+			slog.WarnContext(ctx, "outbox delivery failed", "event_id", row.ID.String(), "type", row.Type, "attempts", attempts, "error", err.Error())
+			update.SetLastError(err.Error())
+			if attempts >= d.config.MaxAttempts {
+				update.SetStatus(outboxevent.StatusFailed)
+			} else {
+				update.SetNextAttemptAt(d.now().Add(d.backoff(attempts)))
+			}
+		} else {
+			update.
+				SetStatus(outboxevent.StatusDone).
+				SetDispatchedAt(d.now()).
+				ClearLastError()
+		}
+		if err := update.Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return len(rows), nil
+}
+
+// deliver はイベントを全ての購読者に届けます。
+// 一部の購読者が失敗した場合も残りの購読者には届け、再試行では全ての購読者に届け直します。
+func (d *Dispatcher) deliver(ctx context.Context, e Event) error {
+	var errs []error
+	for _, s := range d.subscribers {
+		if err := s.HandleOutboxEvent(ctx, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// backoff はattempts回目の失敗の後、次に配信を試みるまでの間隔を返します。
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	wait := d.config.Backoff
+	for i := 1; i < attempts && wait < d.config.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, d.config.MaxBackoff)
+}
+
+// Purge は保持期間を過ぎた配信済みのイベントを削除し、削除した件数を返します。
+// 失敗したイベントは原因の調査のために残します。
+// Purgerを実装する購読者の記録も、同じ保持期間を過ぎたものを削除します。
+func (d *Dispatcher) Purge(ctx context.Context) (int, error) {
+	before := d.now().Add(-d.config.Retention)
+	n, err := d.client.OutboxEvent.Delete().
+		Where(
+			outboxevent.StatusEQ(outboxevent.StatusDone),
+			outboxevent.DispatchedAtLT(before),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, s := range d.subscribers {
+		if p, ok := s.(Purger); ok {
+			if _, err := p.PurgeOutboxDeliveries(ctx, before); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,102 @@
+// Package outbox はドメインイベントを、変更と同じトランザクションで保存したOutboxEventから配信します (transactional outbox)。
+// 変更がコミットされた場合にだけイベントが残り、配信に失敗したイベントは再試行するため、購読者には少なくとも1回届きます。
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Type はドメインイベントの種類です。
+type Type string
+
+const (
+	// TypePostCreated は投稿が作成されたことを表します。
+	TypePostCreated Type = "post.created"
+	// TypePostPublished は投稿が公開されたことを表します。公開済みで作成された場合と、下書き・予約投稿が公開された場合に記録します。
+	TypePostPublished Type = "post.published"
+	// TypePostUpdated は投稿が更新されたことを表します。
+	TypePostUpdated Type = "post.updated"
+	// TypePostDeleted は投稿が削除されたことを表します。
+	TypePostDeleted Type = "post.deleted"
+	// TypePostMentioned は公開済みの投稿で新たにユーザーがメンションされたことを表します。
+	// 公開済みで作成された場合と、公開された場合、公開済みの投稿の本文で新しくメンションした場合に記録します。
+	TypePostMentioned Type = "post.mentioned"
+	// TypeCommentCreated はコメントが作成されたことを表します。
+	TypeCommentCreated Type = "comment.created"
+	// TypeReactionCreated はリアクションが追加されたことを表します。
+	TypeReactionCreated Type = "reaction.created"
+	// TypeReactionDeleted はリアクションが削除されたことを表します。
+	TypeReactionDeleted Type = "reaction.deleted"
+	// TypeFollowCreated はユーザーが他のユーザーをフォローしたことを表します。
+	TypeFollowCreated Type = "follow.created"
+	// TypeFollowDeleted はフォローが解除されたことを表します。
+	TypeFollowDeleted Type = "follow.deleted"
+	// TypeGoalCreated は目標が作成されたことを表します。
+	TypeGoalCreated Type = "goal.created"
+	// TypeGoalUpdated は目標が更新されたことを表します。
+	TypeGoalUpdated Type = "goal.updated"
+	// TypeGoalDeleted は目標が削除されたことを表します。
+	TypeGoalDeleted Type = "goal.deleted"
+)
+
+// PostPayload は投稿のイベントの内容です。
+type PostPayload struct {
+	PostID uuid.UUID `json:"post_id"`
+	// UserID は投稿したユーザーのIDです。
+	UserID uuid.UUID `json:"user_id"`
+	Status string    `json:"status"`
+}
+
+// MentionPayload は投稿のメンションのイベントの内容です。
+type MentionPayload struct {
+	PostID uuid.UUID `json:"post_id"`
+	// UserID は投稿したユーザーのIDです。
+	UserID uuid.UUID `json:"user_id"`
+	// MentionedUserIDs は新たにメンションされたユーザーのIDです。
+	MentionedUserIDs []uuid.UUID `json:"mentioned_user_ids"`
+}
+
+// CommentPayload はコメントのイベントの内容です。
+type CommentPayload struct {
+	CommentID uuid.UUID `json:"comment_id"`
+	PostID    uuid.UUID `json:"post_id"`
+	// UserID はコメントしたユーザーのIDです。
+	UserID uuid.UUID `json:"user_id"`
+	// ParentID は返信先のコメントのIDです (返信でない場合はuuid.Nil)。
+	ParentID uuid.UUID `json:"parent_id"`
+}
+
+// ReactionPayload はリアクションのイベントの内容です。
+type ReactionPayload struct {
+	ReactionID uuid.UUID `json:"reaction_id"`
+	PostID     uuid.UUID `json:"post_id"`
+	// UserID はリアクションしたユーザーのIDです。
+	UserID uuid.UUID `json:"user_id"`
+	Kind   string    `json:"kind"`
+}
+
+// FollowPayload はフォローのイベントの内容です。
+type FollowPayload struct {
+	FollowerID uuid.UUID `json:"follower_id"`
+	FolloweeID uuid.UUID `json:"followee_id"`
+}
+
+// GoalPayload は目標のイベントの内容です。
+type GoalPayload struct {
+	GoalID uuid.UUID `json:"goal_id"`
+	// UserID は目標を作成したユーザーのIDです。
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Event は購読者に届けるドメインイベントです。
+type Event struct {
+	ID   uuid.UUID `json:"id"`
+	Type Type      `json:"type"`
+	// AggregateID はイベントの対象 (投稿・コメント・リアクション・目標、フォローの場合はフォローしたユーザー) のIDです。
+	AggregateID uuid.UUID       `json:"aggregate_id"`
+	Payload     json.RawMessage `json:"payload"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
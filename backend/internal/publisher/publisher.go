@@ -0,0 +1,210 @@
+// Package publisher は公開予定日時を過ぎた予約投稿を公開します。
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"backend/ent"
+	"backend/ent/post"
+	"backend/internal/eventbus"
+	"backend/internal/other"
+
+	"github.com/google/uuid"
+)
+
+// Config は予約投稿の公開ジョブの設定を保持します。
+type Config struct {
+	// Interval は予約投稿のスキャンの実行間隔です。
+	Interval time.Duration
+	// BatchSize は1回のスキャンで公開する最大件数です。
+	BatchSize int
+}
+
+// NewConfig は環境変数から予約投稿の公開ジョブの設定を作成します。
+func NewConfig() *Config {
+	interval, err := time.ParseDuration(other.GetEnv("PUBLISH_INTERVAL", "1m"))
+	if err != nil || interval <= 0 {
+		interval = time.Minute
+	}
+	batchSize := other.GetEnvInt("PUBLISH_BATCH_SIZE", 100)
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	return &Config{
+		Interval:  interval,
+		BatchSize: batchSize,
+	}
+}
+
+// WakeTopic は予約投稿の公開予定日時を公開ジョブに知らせるメッセージのトピックです。
+const WakeTopic = "publisher.wake"
+
+// Waker は予約投稿の公開予定日時を公開ジョブに知らせるインターフェースです。
+type Waker interface {
+	Wake(ctx context.Context, publishAt time.Time)
+}
+
+// BusWaker はバスを通して全てのレプリカの公開ジョブに公開予定日時を知らせるWakerです。
+type BusWaker struct {
+	bus eventbus.Bus
+}
+
+// NewBusWaker は新しいBusWakerインスタンスを作成します。
+func NewBusWaker(bus eventbus.Bus) *BusWaker {
+	return &BusWaker{bus: bus}
+}
+
+// Wake は公開予定日時をバスに発行します。
+// 知らせられなくても次のスキャンで公開されるため、失敗はログに残すだけにします。
+func (w *BusWaker) Wake(ctx context.Context, publishAt time.Time) {
+	if err := w.bus.Publish(ctx, WakeTopic, []byte(publishAt.Format(time.RFC3339Nano))); err != nil {
+		slog.ErrorContext(ctx, "failed to wake publisher", "error", err.Error())
+	}
+}
+
+// Publisher は公開予定日時を過ぎた予約投稿を定期的に公開します。
+// 公開は状態が予約中であることを条件とした更新で行うため、複数のレプリカで同時に実行されても
+// 1つの投稿は1回だけ公開されます。タイムラインへの書き込みやメンションの通知は、
+// 公開と同じトランザクションで記録したドメインイベント (post.published / post.mentioned) から行います。
+type Publisher struct {
+	config *Config
+	client *ent.Client
+	bus    eventbus.Bus
+	now    func() time.Time
+}
+
+// NewPublisher は新しいPublisherインスタンスを作成します。
+func NewPublisher(config *Config, client *ent.Client, bus eventbus.Bus) *Publisher {
+	return &Publisher{
+		config: config,
+		client: client,
+		bus:    bus,
+		now:    time.Now,
+	}
+}
+
+// Run はctxがキャンセルされるまで一定間隔でScanを実行します。
+// WakeTopicで知らされた公開予定日時が次のスキャンより前であれば、その日時にもScanを実行します。
+func (p *Publisher) Run(ctx context.Context) {
+	wake, err := p.bus.Subscribe(ctx, WakeTopic)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to subscribe publisher wake-ups", "error", err.Error())
+	}
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Scan(ctx); err != nil {
+			slog.ErrorContext(ctx, "publish scan failed", "error", err.Error())
+		}
+		if !p.wait(ctx, ticker, wake) {
+			return
+		}
+	}
+}
+
+// wait は次にScanを実行する時刻まで待ちます。ctxがキャンセルされた場合はfalseを返します。
+func (p *Publisher) wait(ctx context.Context, ticker *time.Ticker, wake <-chan eventbus.Message) bool {
+	var timer *time.Timer
+	var due time.Time
+	var fired <-chan time.Time
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			return true
+		case <-fired:
+			return true
+		case m, ok := <-wake:
+			if !ok {
+				wake = nil
+				continue
+			}
+			// 知らせを受け取れなかった場合は、公開予定日時を過ぎた投稿がないかすぐに確認する
+			if m.Lost {
+				return true
+			}
+			at, err := time.Parse(time.RFC3339Nano, string(m.Payload))
+			if err != nil {
+				slog.ErrorContext(ctx, "invalid publisher wake-up", "error", err.Error())
+				continue
+			}
+			if timer != nil && !at.Before(due) {
+				continue
+			}
+			d := at.Sub(p.now())
+			if d <= 0 {
+				return true
+			}
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = time.NewTimer(d)
+			due = at
+			fired = timer.C
+		}
+	}
+}
+
+// Scan は公開予定日時を過ぎた予約投稿を古い順に公開します。
+// 件数が上限に達した場合、残りは次回のスキャンで公開されます。
+func (p *Publisher) Scan(ctx context.Context) error {
+	now := p.now()
+
+	ids, err := p.client.Post.Query().
+		Where(
+			post.StatusEQ(post.StatusScheduled),
+			post.PublishAtLTE(now),
+		).
+		Order(post.ByPublishAt(), post.ByID()).
+		Limit(p.config.BatchSize).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := p.publish(ctx, id, now); err != nil {
+			slog.ErrorContext(ctx, "failed to publish post", "post_id", id.String(), "error", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// publish は予約中の投稿を公開済みにし、投稿日時を公開した日時に更新します。
+// 他のレプリカや投稿者の操作で既に状態が変わっていた場合や、公開予定日時が先に変更された場合は何もしません。
+// 公開のイベントをOutboxEventに記録するため、トランザクションの中で更新します。
+func (p *Publisher) publish(ctx context.Context, id uuid.UUID, now time.Time) error {
+	tx, err := p.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	err = tx.Post.Update().
+		Where(
+			post.ID(id),
+			post.StatusEQ(post.StatusScheduled),
+			post.PublishAtLTE(now),
+		).
+		SetStatus(post.StatusPublished).
+		SetCreatedAt(now).
+		Exec(ctx)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,205 @@
+// Package ranking はおすすめフィードの投稿に点数を付け、並び順を決めます。
+// 点数は与えられた時刻と候補のみから求めるため、同じ入力に対しては常に同じ並び順になります。
+package ranking
+
+import (
+	"bytes"
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	"backend/internal/other"
+
+	"github.com/google/uuid"
+)
+
+// Source は候補がおすすめに含まれた理由です。複数の理由を組み合わせて持ちます。
+type Source uint8
+
+const (
+	// SourceGenre は閲覧者と共通のジャンルに興味を持つユーザーの投稿です。
+	SourceGenre Source = 1 << iota
+	// SourceFriendOfFriend はフォローしているユーザーがフォローしているユーザーの投稿です。
+	SourceFriendOfFriend
+	// SourceTrendingGoal は最近投稿の多い目標への投稿です。
+	SourceTrendingGoal
+)
+
+// Config はおすすめフィードの候補の集め方と点数の重みを保持します。
+type Config struct {
+	// Window は候補にする投稿の期間です。
+	Window time.Duration
+	// CandidatesPerSource は理由ごとに集める候補の最大件数です。
+	CandidatesPerSource int
+	// TrendingGoals は候補にする、最近投稿の多い目標の数です。
+	TrendingGoals int
+	// MaxPerAuthor は1ページに含める同じユーザーの投稿の最大件数です。
+	MaxPerAuthor int
+
+	// HalfLife は新しさの点数が半分になるまでの時間です。
+	HalfLife time.Duration
+	// RecencyWeight は新しさの重みです。
+	RecencyWeight float64
+	// ReactionWeight は1時間あたりのリアクション数の重みです。
+	ReactionWeight float64
+	// GenreWeight は共通のジャンルの割合の重みです。
+	GenreWeight float64
+	// FriendOfFriendWeight はフォローしているユーザーがフォローしているユーザーの投稿の重みです。
+	FriendOfFriendWeight float64
+	// TrendingGoalWeight は最近投稿の多い目標への投稿の重みです。
+	TrendingGoalWeight float64
+	// DiversityPenalty は同じページに同じユーザーの投稿が既にある場合に、1件ごとに点数に掛ける値 (0〜1) です。
+	DiversityPenalty float64
+}
+
+// NewConfig は環境変数からおすすめフィードの設定を作成します。
+func NewConfig() *Config {
+	window, err := time.ParseDuration(other.GetEnv("DISCOVER_WINDOW", "72h"))
+	if err != nil || window <= 0 {
+		window = 72 * time.Hour
+	}
+	candidates := other.GetEnvInt("DISCOVER_CANDIDATES_PER_SOURCE", 300)
+	if candidates <= 0 {
+		candidates = 300
+	}
+	trendingGoals := other.GetEnvInt("DISCOVER_TRENDING_GOALS", 10)
+	if trendingGoals < 0 {
+		trendingGoals = 10
+	}
+	maxPerAuthor := other.GetEnvInt("DISCOVER_MAX_PER_AUTHOR", 2)
+	if maxPerAuthor <= 0 {
+		maxPerAuthor = 2
+	}
+	halfLife, err := time.ParseDuration(other.GetEnv("RANK_HALF_LIFE", "12h"))
+	if err != nil || halfLife <= 0 {
+		halfLife = 12 * time.Hour
+	}
+	penalty := other.GetEnvFloat("RANK_DIVERSITY_PENALTY", 0.7)
+	if penalty < 0 || penalty > 1 {
+		penalty = 0.7
+	}
+
+	return &Config{
+		Window:               window,
+		CandidatesPerSource:  candidates,
+		TrendingGoals:        trendingGoals,
+		MaxPerAuthor:         maxPerAuthor,
+		HalfLife:             halfLife,
+		RecencyWeight:        other.GetEnvFloat("RANK_WEIGHT_RECENCY", 1.0),
+		ReactionWeight:       other.GetEnvFloat("RANK_WEIGHT_REACTIONS", 1.0),
+		GenreWeight:          other.GetEnvFloat("RANK_WEIGHT_GENRE", 0.5),
+		FriendOfFriendWeight: other.GetEnvFloat("RANK_WEIGHT_FRIEND_OF_FRIEND", 0.5),
+		TrendingGoalWeight:   other.GetEnvFloat("RANK_WEIGHT_TRENDING_GOAL", 0.3),
+		DiversityPenalty:     penalty,
+	}
+}
+
+// Candidate はおすすめの候補の投稿です。
+type Candidate struct {
+	PostID    uuid.UUID
+	AuthorID  uuid.UUID
+	CreatedAt time.Time
+	// Reactions は投稿が受けたリアクションの数です。
+	Reactions int
+	// GenreOverlap は閲覧者の興味のあるジャンルのうち、作成者と共通のものの割合 (0〜1) です。
+	GenreOverlap float64
+	Sources      Source
+}
+
+// Scored は点数を付けた候補です。
+type Scored struct {
+	Candidate
+	Score float64
+}
+
+// Score は時刻nowにおける候補の点数を返します。
+// 新しさ (半減期による減衰)、1時間あたりのリアクション数、共通のジャンルの割合、おすすめの理由の重み付きの和です。
+func (c *Config) Score(now time.Time, cand Candidate) float64 {
+	age := max(now.Sub(cand.CreatedAt), 0)
+	recency := math.Exp2(-age.Hours() / c.HalfLife.Hours())
+	// 投稿直後に数件のリアクションで過大にならないよう、経過時間は1時間以上として扱う
+	velocity := float64(cand.Reactions) / max(age.Hours(), 1)
+
+	score := c.RecencyWeight*recency +
+		c.ReactionWeight*math.Log1p(velocity) +
+		c.GenreWeight*cand.GenreOverlap
+	if cand.Sources&SourceFriendOfFriend != 0 {
+		score += c.FriendOfFriendWeight
+	}
+	if cand.Sources&SourceTrendingGoal != 0 {
+		score += c.TrendingGoalWeight
+	}
+	return score
+}
+
+// Rank は候補をpageSize件ずつのページに分けて並べます。
+// 各ページでは点数の高い順に選びますが、同じページに同じユーザーの投稿が既にあるほど点数を下げ、
+// MaxPerAuthor件に達したユーザーの投稿はそのページには含めず、次のページ以降に回します。
+func (c *Config) Rank(now time.Time, candidates []Candidate, pageSize int) []Scored {
+	remaining := make([]Scored, 0, len(candidates))
+	for _, cand := range candidates {
+		remaining = append(remaining, Scored{Candidate: cand, Score: c.Score(now, cand)})
+	}
+	slices.SortFunc(remaining, compareScored)
+	if pageSize <= 0 {
+		return remaining
+	}
+
+	res := make([]Scored, 0, len(remaining))
+	for len(remaining) > 0 {
+		page := c.page(remaining, pageSize)
+		if len(page) == 0 {
+			break
+		}
+		picked := make(map[uuid.UUID]bool, len(page))
+		for _, s := range page {
+			picked[s.PostID] = true
+		}
+		remaining = slices.DeleteFunc(remaining, func(s Scored) bool {
+			return picked[s.PostID]
+		})
+		res = append(res, page...)
+	}
+	return res
+}
+
+// page は点数順に並んだ候補から1ページ分を選びます。
+func (c *Config) page(sorted []Scored, pageSize int) []Scored {
+	perAuthor := make(map[uuid.UUID]int)
+	used := make([]bool, len(sorted))
+	page := make([]Scored, 0, pageSize)
+	for len(page) < pageSize {
+		best := -1
+		var bestScore float64
+		for i, s := range sorted {
+			n := perAuthor[s.AuthorID]
+			if used[i] || n >= c.MaxPerAuthor {
+				continue
+			}
+			score := s.Score * math.Pow(c.DiversityPenalty, float64(n))
+			// 同点の場合は並び順が先のものを選ぶ
+			if best < 0 || score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		if best < 0 {
+			break
+		}
+		used[best] = true
+		perAuthor[sorted[best].AuthorID]++
+		page = append(page, sorted[best])
+	}
+	return page
+}
+
+// compareScored は点数の高い順、作成日時の新しい順、投稿IDの順に比較します。
+func compareScored(a, b Scored) int {
+	if c := cmp.Compare(b.Score, a.Score); c != 0 {
+		return c
+	}
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+	return bytes.Compare(a.PostID[:], b.PostID[:])
+}
@@ -0,0 +1,256 @@
+package ranking
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// postID はテストで候補を識別しやすいよう、番号から投稿IDを作成します。
+func postID(n int) uuid.UUID {
+	return uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0000-%012d", n))
+}
+
+// authorID は番号からユーザーIDを作成します。
+func authorID(n int) uuid.UUID {
+	return uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0001-%012d", n))
+}
+
+func TestScore(t *testing.T) {
+	c := &Config{
+		HalfLife:             10 * time.Hour,
+		RecencyWeight:        1,
+		ReactionWeight:       1,
+		GenreWeight:          0.5,
+		FriendOfFriendWeight: 0.25,
+		TrendingGoalWeight:   0.125,
+	}
+
+	tests := []struct {
+		name string
+		cand Candidate
+		want float64
+	}{
+		{
+			name: "投稿直後",
+			cand: Candidate{CreatedAt: now},
+			want: 1,
+		},
+		{
+			name: "半減期で新しさが半分になる",
+			cand: Candidate{CreatedAt: now.Add(-10 * time.Hour)},
+			want: 0.5,
+		},
+		{
+			name: "半減期の2倍で新しさが4分の1になる",
+			cand: Candidate{CreatedAt: now.Add(-20 * time.Hour)},
+			want: 0.25,
+		},
+		{
+			name: "未来の日時は投稿直後として扱う",
+			cand: Candidate{CreatedAt: now.Add(time.Hour)},
+			want: 1,
+		},
+		{
+			name: "1時間未満のリアクション数は1時間あたりとして数える",
+			cand: Candidate{CreatedAt: now.Add(-30 * time.Minute), Reactions: 3},
+			want: math.Exp2(-0.05) + math.Log(4),
+		},
+		{
+			name: "1時間あたりのリアクション数",
+			cand: Candidate{CreatedAt: now.Add(-20 * time.Hour), Reactions: 10},
+			want: 0.25 + math.Log1p(0.5),
+		},
+		{
+			name: "共通のジャンルの割合",
+			cand: Candidate{CreatedAt: now.Add(-10 * time.Hour), GenreOverlap: 0.5},
+			want: 0.5 + 0.25,
+		},
+		{
+			name: "ジャンルの理由には重みを加えない",
+			cand: Candidate{CreatedAt: now.Add(-10 * time.Hour), Sources: SourceGenre},
+			want: 0.5,
+		},
+		{
+			name: "おすすめの理由の重みを合わせる",
+			cand: Candidate{CreatedAt: now.Add(-10 * time.Hour), Sources: SourceGenre | SourceFriendOfFriend | SourceTrendingGoal},
+			want: 0.5 + 0.25 + 0.125,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Score(now, tt.cand); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// scored は点数が共通のジャンルの割合と等しくなる候補を作成します。
+func scored(id, author int, score float64) Candidate {
+	return Candidate{
+		PostID:       postID(id),
+		AuthorID:     authorID(author),
+		CreatedAt:    now.Add(-time.Duration(id) * time.Minute),
+		GenreOverlap: score,
+	}
+}
+
+func TestRank(t *testing.T) {
+	// 点数がGenreOverlapそのものになるよう、新しさとリアクションの重みは0にする
+	base := Config{HalfLife: time.Hour, GenreWeight: 1, MaxPerAuthor: 2, DiversityPenalty: 1}
+
+	tests := []struct {
+		name       string
+		config     func(c *Config)
+		candidates []Candidate
+		pageSize   int
+		want       []int
+	}{
+		{
+			name: "点数の高い順",
+			candidates: []Candidate{
+				scored(1, 1, 0.2),
+				scored(2, 2, 0.9),
+				scored(3, 3, 0.5),
+			},
+			pageSize: 10,
+			want:     []int{2, 3, 1},
+		},
+		{
+			name: "同じユーザーの投稿はページごとにMaxPerAuthor件まで",
+			candidates: []Candidate{
+				scored(1, 1, 0.9),
+				scored(2, 1, 0.8),
+				scored(3, 1, 0.7),
+				scored(4, 2, 0.2),
+				scored(5, 2, 0.1),
+			},
+			pageSize: 3,
+			want:     []int{1, 2, 4, 3, 5},
+		},
+		{
+			name:   "上限に達したユーザーしか残らない場合はページを短くする",
+			config: func(c *Config) { c.MaxPerAuthor = 1 },
+			candidates: []Candidate{
+				scored(1, 1, 0.9),
+				scored(2, 1, 0.8),
+				scored(3, 1, 0.7),
+			},
+			pageSize: 2,
+			want:     []int{1, 2, 3},
+		},
+		{
+			name:   "同じページの同じユーザーの投稿は点数を下げる",
+			config: func(c *Config) { c.MaxPerAuthor, c.DiversityPenalty = 3, 0.5 },
+			candidates: []Candidate{
+				scored(1, 1, 1.0),
+				scored(2, 1, 0.9),
+				scored(3, 2, 0.6),
+				scored(4, 2, 0.4),
+			},
+			pageSize: 4,
+			// 2: 0.9×0.5=0.45 < 3: 0.6、4: 0.4×0.5=0.2 < 2: 0.45
+			want: []int{1, 3, 2, 4},
+		},
+		{
+			name:   "点数を下げるのは同じページの中だけ",
+			config: func(c *Config) { c.MaxPerAuthor, c.DiversityPenalty = 3, 0.5 },
+			candidates: []Candidate{
+				scored(1, 1, 1.0),
+				scored(2, 1, 0.9),
+				scored(3, 2, 0.6),
+				scored(4, 2, 0.5),
+			},
+			pageSize: 2,
+			want:     []int{1, 3, 2, 4},
+		},
+		{
+			name: "同点の場合は作成日時の新しい順",
+			candidates: []Candidate{
+				scored(3, 1, 0.5),
+				scored(1, 2, 0.5),
+				scored(2, 3, 0.5),
+			},
+			pageSize: 10,
+			want:     []int{1, 2, 3},
+		},
+		{
+			name: "作成日時も同じ場合は投稿IDの順",
+			candidates: []Candidate{
+				{PostID: postID(3), AuthorID: authorID(1), CreatedAt: now, GenreOverlap: 0.5},
+				{PostID: postID(1), AuthorID: authorID(2), CreatedAt: now, GenreOverlap: 0.5},
+				{PostID: postID(2), AuthorID: authorID(3), CreatedAt: now, GenreOverlap: 0.5},
+			},
+			pageSize: 10,
+			want:     []int{1, 2, 3},
+		},
+		{
+			name: "ページの大きさが0以下の場合は点数順のみ",
+			candidates: []Candidate{
+				scored(1, 1, 0.9),
+				scored(2, 1, 0.8),
+				scored(3, 1, 0.7),
+				scored(4, 2, 0.2),
+			},
+			pageSize: 0,
+			want:     []int{1, 2, 3, 4},
+		},
+		{
+			name:     "候補がない",
+			pageSize: 10,
+			want:     []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := base
+			if tt.config != nil {
+				tt.config(&c)
+			}
+			got := rankedIDs(c.Rank(now, tt.candidates, tt.pageSize))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Rank() = %v, want %v", got, tt.want)
+			}
+
+			// 候補の順序によらず同じ並び順になる
+			reversed := slices.Clone(tt.candidates)
+			slices.Reverse(reversed)
+			if got := rankedIDs(c.Rank(now, reversed, tt.pageSize)); !slices.Equal(got, tt.want) {
+				t.Errorf("Rank() with reversed candidates = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankKeepsScores(t *testing.T) {
+	c := &Config{HalfLife: 10 * time.Hour, RecencyWeight: 1, MaxPerAuthor: 1, DiversityPenalty: 0.5}
+	candidates := []Candidate{
+		{PostID: postID(1), AuthorID: authorID(1), CreatedAt: now},
+		{PostID: postID(2), AuthorID: authorID(1), CreatedAt: now.Add(-10 * time.Hour)},
+	}
+
+	// 多様性による減点はページの選択のみに使い、返す点数はScoreのまま
+	for _, s := range c.Rank(now, candidates, 1) {
+		if want := c.Score(now, s.Candidate); s.Score != want {
+			t.Errorf("Rank() score of %s = %v, want %v", s.PostID, s.Score, want)
+		}
+	}
+}
+
+// rankedIDs は並べた候補の投稿の番号を返します。
+func rankedIDs(ranked []Scored) []int {
+	res := make([]int, 0, len(ranked))
+	for _, s := range ranked {
+		var n int
+		fmt.Sscanf(s.PostID.String()[24:], "%d", &n)
+		res = append(res, n)
+	}
+	return res
+}
@@ -0,0 +1,108 @@
+// Package realtime はユーザーへのイベントを、Server-Sent Events (SSE) で接続中のクライアントに届けます。
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+
+	"backend/internal/eventbus"
+
+	"github.com/google/uuid"
+)
+
+// Topic はイベントを受け渡すバスのトピックです。
+const Topic = "realtime"
+
+// Type はイベントの種類です。SSEのevent欄に使います。
+type Type string
+
+const (
+	// TypeTimelinePost はフォローしているユーザーが投稿を公開したことを表します。
+	TypeTimelinePost Type = "timeline.post"
+	// TypeReaction は自分の投稿にリアクションが付いたことを表します。
+	TypeReaction Type = "reaction"
+	// TypeFollower は新しくフォローされたことを表します。
+	TypeFollower Type = "follower"
+	// TypeComment は自分の投稿・コメントにコメントが付いたことを表します。
+	TypeComment Type = "comment"
+	// TypeReset は再開位置からの続きを届けられないことを表します。クライアントは一覧を取得し直します。
+	TypeReset Type = "reset"
+)
+
+// PostData はTypeTimelinePostのイベントの内容です。
+type PostData struct {
+	PostID uuid.UUID `json:"post_id"`
+	// UserID は投稿したユーザーのIDです。
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// ReactionData はTypeReactionのイベントの内容です。
+type ReactionData struct {
+	PostID uuid.UUID `json:"post_id"`
+	// UserID はリアクションしたユーザーのIDです。
+	UserID uuid.UUID `json:"user_id"`
+	Kind   string    `json:"kind"`
+}
+
+// FollowerData はTypeFollowerのイベントの内容です。
+type FollowerData struct {
+	// UserID はフォローしたユーザーのIDです。
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// CommentData はTypeCommentのイベントの内容です。
+type CommentData struct {
+	PostID    uuid.UUID `json:"post_id"`
+	CommentID uuid.UUID `json:"comment_id"`
+	// UserID はコメントしたユーザーのIDです。
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Event はバスで受け渡す、1人以上のユーザーに届けるイベントです。
+type Event struct {
+	// ID はSSEのid欄に使うイベントのIDです。
+	// 発行時に付けたIDを全てのレプリカで使うため、再接続で別のレプリカにつながっても続きから再開できます。
+	// 時刻順に並ぶよう、UUIDv7を使います。
+	ID   uuid.UUID `json:"id"`
+	Type Type      `json:"type"`
+	// Recipients はイベントを受け取るユーザーのIDです。
+	Recipients []uuid.UUID     `json:"recipients"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// NewEvent はdataをJSONに変換し、IDを付けたイベントを作成します。
+func NewEvent(t Type, recipients []uuid.UUID, data any) (Event, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, err
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return Event{}, err
+	}
+	return Event{ID: id, Type: t, Recipients: recipients, Data: b}, nil
+}
+
+// Publisher はイベントを発行するインターフェースです。
+type Publisher interface {
+	Publish(ctx context.Context, e Event) error
+}
+
+// BusPublisher はイベントをバスのTopicに発行するPublisherです。
+type BusPublisher struct {
+	bus eventbus.Bus
+}
+
+// NewBusPublisher は新しいBusPublisherインスタンスを作成します。
+func NewBusPublisher(bus eventbus.Bus) *BusPublisher {
+	return &BusPublisher{bus: bus}
+}
+
+// Publish はイベントをJSONに変換してバスに発行します。
+func (p *BusPublisher) Publish(ctx context.Context, e Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return p.bus.Publish(ctx, Topic, b)
+}
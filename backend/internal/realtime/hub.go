@@ -0,0 +1,323 @@
+package realtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"backend/internal/eventbus"
+	"backend/internal/other"
+
+	"github.com/google/uuid"
+)
+
+// ErrTooManyConnections はユーザーの同時接続数が上限に達している場合のエラーです。
+var ErrTooManyConnections = errors.New("too many connections")
+
+// Config はイベントの配信の設定を保持します。
+type Config struct {
+	// ReplaySize は再接続時に再送するため、ユーザーごとに保持する最大件数です。
+	ReplaySize int
+	// ReplayTTL は再送のために保持する期間です。
+	ReplayTTL time.Duration
+	// Heartbeat は接続を保つためにコメント行を送る間隔です。
+	Heartbeat time.Duration
+	// MaxConnectionsPerUser は1ユーザーの同時接続数の上限です。
+	MaxConnectionsPerUser int
+	// SendBuffer は接続ごとに送信待ちのイベントを保持する件数です。超えた接続は切断し、再接続時に再送します。
+	SendBuffer int
+}
+
+// NewConfig は環境変数からイベントの配信の設定を作成します。
+func NewConfig() *Config {
+	replaySize := other.GetEnvInt("REALTIME_REPLAY_SIZE", 100)
+	if replaySize <= 0 {
+		replaySize = 100
+	}
+	replayTTL, err := time.ParseDuration(other.GetEnv("REALTIME_REPLAY_TTL", "5m"))
+	if err != nil || replayTTL <= 0 {
+		replayTTL = 5 * time.Minute
+	}
+	heartbeat, err := time.ParseDuration(other.GetEnv("REALTIME_HEARTBEAT", "25s"))
+	if err != nil || heartbeat <= 0 {
+		heartbeat = 25 * time.Second
+	}
+	maxConnections := other.GetEnvInt("REALTIME_MAX_CONNECTIONS_PER_USER", 5)
+	if maxConnections <= 0 {
+		maxConnections = 5
+	}
+	sendBuffer := other.GetEnvInt("REALTIME_SEND_BUFFER", 64)
+	if sendBuffer <= 0 {
+		sendBuffer = 64
+	}
+
+	return &Config{
+		ReplaySize:            replaySize,
+		ReplayTTL:             replayTTL,
+		Heartbeat:             heartbeat,
+		MaxConnectionsPerUser: maxConnections,
+		SendBuffer:            sendBuffer,
+	}
+}
+
+// delivery は1人のユーザーに届ける、IDを付けたイベントです。
+type delivery struct {
+	id   uuid.UUID
+	typ  Type
+	data json.RawMessage
+	at   time.Time
+}
+
+// replayBuffer はユーザーごとの再送のためのイベントです。
+type replayBuffer struct {
+	deliveries []delivery
+	// dropped は保持しきれずに捨てたイベントの最大のIDです。これより前からの再開は続きを届けられません。
+	dropped uuid.UUID
+}
+
+// conn は1つのSSEの接続です。
+type conn struct {
+	userID uuid.UUID
+	ch     chan delivery
+}
+
+// Hub はバスのイベントを受け取り、宛先のユーザーの接続に届けます。
+// 再接続したクライアントにはLast-Event-ID以降のイベントを、保持している範囲で再送します。
+// イベントのIDは発行時に付けたUUIDv7で、全てのレプリカに同じ順番で届くため、別のレプリカへの再接続でも再開できます。
+// 保持している範囲に含まれないIDは時刻順の位置で比べ、このHubが購読を始める前や、
+// 捨てたイベントより前からの再開であればTypeResetを送ります。
+type Hub struct {
+	config *Config
+	bus    eventbus.Bus
+	now    func() time.Time
+
+	mu sync.Mutex
+	// started はこのHubが購読を始めた位置です。これより前からの再開は、その間のイベントを知らないため続きを届けられません。
+	started uuid.UUID
+	replay  map[uuid.UUID]*replayBuffer
+	// forgotten は再送のイベントを持たないユーザーが、続きを受け取れない再開位置の上限です。
+	// 期限切れで削除したユーザーのイベントと、バスから届かなかったイベントの分を含みます。
+	forgotten uuid.UUID
+	conns     map[uuid.UUID]map[*conn]struct{}
+}
+
+// NewHub は新しいHubインスタンスを作成します。
+func NewHub(config *Config, bus eventbus.Bus) *Hub {
+	return &Hub{
+		config:  config,
+		bus:     bus,
+		now:     time.Now,
+		started: newEventID(),
+		replay:  make(map[uuid.UUID]*replayBuffer),
+		conns:   make(map[uuid.UUID]map[*conn]struct{}),
+	}
+}
+
+// Run はctxがキャンセルされるまで、バスのイベントを宛先のユーザーに届けます。
+func (h *Hub) Run(ctx context.Context) {
+	messages, err := h.bus.Subscribe(ctx, Topic)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to subscribe realtime events", "error", err.Error())
+		return
+	}
+	h.mu.Lock()
+	h.started = newEventID()
+	h.mu.Unlock()
+
+	ticker := time.NewTicker(h.config.ReplayTTL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.sweep()
+		case m, ok := <-messages:
+			if !ok {
+				return
+			}
+			if m.Lost {
+				h.lose()
+				continue
+			}
+			var e Event
+			if err := json.Unmarshal(m.Payload, &e); err != nil {
+				slog.ErrorContext(ctx, "invalid realtime event", "error", err.Error())
+				continue
+			}
+			if e.ID == uuid.Nil {
+				// IDを付けずに発行されたイベントは、このレプリカでのみ通用するIDを付ける
+				e.ID = newEventID()
+			}
+			h.dispatch(e)
+		}
+	}
+}
+
+// dispatch はイベントを宛先のユーザーごとに再送のために保持し、接続中のクライアントに送ります。
+func (h *Hub) dispatch(e Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	d := delivery{id: e.ID, typ: e.Type, data: e.Data, at: now}
+	for _, userID := range e.Recipients {
+		buf, ok := h.replay[userID]
+		if !ok {
+			buf = &replayBuffer{}
+			h.replay[userID] = buf
+		}
+		buf.deliveries = append(buf.deliveries, d)
+		h.expire(buf, now)
+
+		for c := range h.conns[userID] {
+			select {
+			case c.ch <- d:
+			default:
+				// 受け取りの遅いクライアントは切断し、再接続時に再送する
+				h.remove(c)
+			}
+		}
+	}
+}
+
+// lose はバスから届かなかったイベントがある場合に、それ以前からの再開を取り消し、接続中のクライアントにTypeResetを送ります。
+func (h *Hub) lose() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	clear(h.replay)
+	h.forgotten = newEventID()
+	d := delivery{id: h.forgotten, typ: TypeReset, data: json.RawMessage("{}"), at: h.now()}
+	for _, conns := range h.conns {
+		for c := range conns {
+			select {
+			case c.ch <- d:
+			default:
+				h.remove(c)
+			}
+		}
+	}
+}
+
+// expire は保持する件数・期間を超えたイベントを捨てます。
+func (h *Hub) expire(buf *replayBuffer, now time.Time) {
+	n := max(0, len(buf.deliveries)-h.config.ReplaySize)
+	for n < len(buf.deliveries) && now.Sub(buf.deliveries[n].at) > h.config.ReplayTTL {
+		n++
+	}
+	if n == 0 {
+		return
+	}
+	for _, d := range buf.deliveries[:n] {
+		buf.dropped = maxID(buf.dropped, d.id)
+	}
+	buf.deliveries = append([]delivery(nil), buf.deliveries[n:]...)
+}
+
+// sweep は期限切れのイベントだけになったユーザーの再送のイベントを削除します。
+func (h *Hub) sweep() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	for userID, buf := range h.replay {
+		h.expire(buf, now)
+		if len(buf.deliveries) == 0 {
+			h.forgotten = maxID(h.forgotten, buf.dropped)
+			delete(h.replay, userID)
+		}
+	}
+}
+
+// connect は接続を登録し、lastEventIDより後の再送するイベントを返します。
+// 続きを届けられない場合はresetにtrueを返します。
+func (h *Hub) connect(userID uuid.UUID, lastEventID string) (c *conn, replay []delivery, reset bool, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.conns[userID]) >= h.config.MaxConnectionsPerUser {
+		return nil, nil, false, ErrTooManyConnections
+	}
+
+	if lastEventID != "" {
+		last, err := uuid.Parse(lastEventID)
+		buf := h.replay[userID]
+		var i int
+		if buf != nil {
+			i = slices.IndexFunc(buf.deliveries, func(d delivery) bool { return d.id == last })
+		}
+		switch {
+		case err != nil || last.Version() != 7:
+			reset = true
+		case buf != nil && i >= 0:
+			// 保持しているイベントからの再開は、届いた順番でその後のイベントを再送する
+			replay = append(replay, buf.deliveries[i+1:]...)
+		case compareID(last, h.started) < 0 || compareID(last, h.forgotten) < 0:
+			reset = true
+		case buf == nil:
+			// 再開位置の後にこのユーザーへのイベントはない
+		case compareID(last, buf.dropped) < 0:
+			reset = true
+		default:
+			// 別のレプリカが付けた位置からの再開は、それより後のIDのイベントを再送する
+			for _, d := range buf.deliveries {
+				if compareID(d.id, last) > 0 {
+					replay = append(replay, d)
+				}
+			}
+		}
+	}
+
+	c = &conn{userID: userID, ch: make(chan delivery, h.config.SendBuffer)}
+	if h.conns[userID] == nil {
+		h.conns[userID] = make(map[*conn]struct{})
+	}
+	h.conns[userID][c] = struct{}{}
+	return c, replay, reset, nil
+}
+
+// disconnect は接続の登録を解除します。
+func (h *Hub) disconnect(c *conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(c)
+}
+
+// remove は接続の登録を解除し、送信のチャネルを閉じます。h.muを保持して呼び出します。
+func (h *Hub) remove(c *conn) {
+	conns := h.conns[c.userID]
+	if _, ok := conns[c]; !ok {
+		return
+	}
+	delete(conns, c)
+	if len(conns) == 0 {
+		delete(h.conns, c.userID)
+	}
+	close(c.ch)
+}
+
+// newEventID は現在の時刻の位置を表すイベントのIDを作成します。
+// TypeResetのIDに使い、クライアントはその後に届いたイベントから再開します。
+func newEventID() uuid.UUID {
+	// NewV7は乱数の読み込みに失敗した場合にのみエラーを返す
+	return uuid.Must(uuid.NewV7())
+}
+
+// compareID はイベントのIDを時刻順に比較します。
+func compareID(a, b uuid.UUID) int {
+	return bytes.Compare(a[:], b[:])
+}
+
+// maxID は時刻順で後のイベントのIDを返します。
+func maxID(a, b uuid.UUID) uuid.UUID {
+	if compareID(a, b) < 0 {
+		return b
+	}
+	return a
+}
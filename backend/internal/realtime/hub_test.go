@@ -0,0 +1,143 @@
+package realtime
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"backend/internal/eventbus"
+
+	"github.com/google/uuid"
+)
+
+// startHubs は同じバスを購読するレプリカのHubを起動します。
+func startHubs(t *testing.T, bus eventbus.Bus, n int) []*Hub {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	config := &Config{ReplaySize: 10, ReplayTTL: time.Minute, MaxConnectionsPerUser: 5, SendBuffer: 10}
+	hubs := make([]*Hub, 0, n)
+	for range n {
+		h := NewHub(config, bus)
+		go h.Run(ctx)
+		hubs = append(hubs, h)
+	}
+	// 購読を始める前に発行したイベントは届かないため、Runが購読するまで待つ
+	time.Sleep(10 * time.Millisecond)
+	return hubs
+}
+
+// publish はイベントを発行し、全てのHubが保持するまで待ちます。
+func publish(t *testing.T, bus eventbus.Bus, hubs []*Hub, userID uuid.UUID) uuid.UUID {
+	t.Helper()
+	e, err := NewEvent(TypeFollower, []uuid.UUID{userID}, FollowerData{UserID: uuid.New()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewBusPublisher(bus).Publish(context.Background(), e); err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range hubs {
+		waitFor(t, func() bool {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			buf := h.replay[userID]
+			return buf != nil && slices.ContainsFunc(buf.deliveries, func(d delivery) bool { return d.id == e.ID })
+		})
+	}
+	return e.ID
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func deliveryIDs(deliveries []delivery) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(deliveries))
+	for _, d := range deliveries {
+		ids = append(ids, d.id)
+	}
+	return ids
+}
+
+func TestHubResumesOnAnotherReplica(t *testing.T) {
+	bus := eventbus.NewMemoryBus(&eventbus.Config{BufferSize: 16})
+	hubs := startHubs(t, bus, 2)
+	userID := uuid.New()
+
+	first := publish(t, bus, hubs, userID)
+	second := publish(t, bus, hubs, userID)
+	third := publish(t, bus, hubs, userID)
+
+	// 1つ目のレプリカで受け取ったIDで、2つ目のレプリカに再接続する
+	c, replay, reset, err := hubs[1].connect(userID, first.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hubs[1].disconnect(c)
+	if reset {
+		t.Error("reset = true, want false")
+	}
+	if got, want := deliveryIDs(replay), []uuid.UUID{second, third}; !slices.Equal(got, want) {
+		t.Errorf("replay = %v, want %v", got, want)
+	}
+}
+
+func TestHubConnectReset(t *testing.T) {
+	bus := eventbus.NewMemoryBus(&eventbus.Config{BufferSize: 16})
+	before := newEventID()
+	hubs := startHubs(t, bus, 1)
+	h := hubs[0]
+	userID, idle := uuid.New(), uuid.New()
+	last := publish(t, bus, hubs, userID)
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		lastEventID string
+		wantReset   bool
+		wantReplay  int
+	}{
+		{name: "最後のイベント", userID: userID, lastEventID: last.String()},
+		{name: "購読を始めた後の位置", userID: idle, lastEventID: last.String()},
+		{name: "購読を始める前の位置", userID: idle, lastEventID: before.String(), wantReset: true},
+		{name: "購読を始める前の位置からの続き", userID: userID, lastEventID: before.String(), wantReset: true},
+		{name: "IDの形式が異なる", userID: userID, lastEventID: "abc-1", wantReset: true},
+		{name: "UUIDv7ではない", userID: userID, lastEventID: uuid.NewString(), wantReset: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, replay, reset, err := h.connect(tt.userID, tt.lastEventID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer h.disconnect(c)
+			if reset != tt.wantReset {
+				t.Errorf("reset = %v, want %v", reset, tt.wantReset)
+			}
+			if len(replay) != tt.wantReplay {
+				t.Errorf("len(replay) = %d, want %d", len(replay), tt.wantReplay)
+			}
+		})
+	}
+
+	// バスから届かなかったイベントがある場合は、それより前からの再開を取り消す
+	h.lose()
+	c, _, reset, err := h.connect(userID, last.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.disconnect(c)
+	if !reset {
+		t.Error("reset after lost messages = false, want true")
+	}
+}
@@ -0,0 +1,118 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/security"
+
+	"github.com/google/uuid"
+)
+
+// Authenticator はBearerトークンを検証し、ユーザーのIDを返す関数です。
+type Authenticator func(ctx context.Context, token string) (uuid.UUID, error)
+
+// StreamHandler はGET /events/streamで、ユーザーへのイベントをSSEで送り続けます。
+// ogenの生成するサーバーはレスポンスを逐次送信できないため、net/httpのハンドラーとして実装します。
+type StreamHandler struct {
+	hub          *Hub
+	authenticate Authenticator
+}
+
+// NewStreamHandler は新しいStreamHandlerインスタンスを作成します。
+func NewStreamHandler(hub *Hub, authenticate Authenticator) *StreamHandler {
+	return &StreamHandler{hub: hub, authenticate: authenticate}
+}
+
+// ServeHTTP はAPIと同じBearerトークンで認証し、接続が閉じられるまでイベントを送ります。
+// Last-Event-IDヘッダーが指定されている場合は、そのイベントより後のイベントを再送してから送り始めます。
+func (s *StreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+	userID, err := s.authenticate(r.Context(), token)
+	if errors.Is(err, security.ErrAccountSuspended) {
+		writeError(w, http.StatusForbidden, "account suspended")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	c, replay, reset, err := s.hub.connect(userID, r.Header.Get("Last-Event-ID"))
+	if errors.Is(err, ErrTooManyConnections) {
+		writeError(w, http.StatusTooManyRequests, "too many connections")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	defer s.hub.disconnect(c)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// リバースプロキシにバッファリングさせない
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+
+	if reset {
+		// 再送できない範囲があるため、現在の位置から再開させる
+		writeEvent(w, newEventID().String(), TypeReset, json.RawMessage("{}"))
+	}
+	for _, d := range replay {
+		writeEvent(w, d.id.String(), d.typ, d.data)
+	}
+	if err := rc.Flush(); err != nil {
+		slog.ErrorContext(r.Context(), "failed to flush event stream", "error", err.Error())
+		return
+	}
+
+	ticker := time.NewTicker(s.hub.config.Heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		case d, ok := <-c.ch:
+			if !ok {
+				return
+			}
+			writeEvent(w, d.id.String(), d.typ, d.data)
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeEvent は1件のイベントをSSEの形式で書き込みます。
+func writeEvent(w io.Writer, id string, t Type, data json.RawMessage) {
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", id, t, data)
+}
+
+// writeError はAPIのエラーと同じ形式のJSONでエラーを返します。
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": message})
+}
@@ -0,0 +1,18 @@
+package reminder
+
+import "time"
+
+// Clock は現在時刻を取得するためのインターフェースです。
+// テストでは固定時刻を返す実装に差し替えられます。
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock はシステム時刻を返すClockです。
+var SystemClock Clock = systemClock{}
@@ -0,0 +1,85 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/ent"
+	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/reminderlog"
+	"backend/ent/user"
+	"backend/internal/notification"
+
+	"github.com/google/uuid"
+)
+
+// Reminder は1件の期限リマインダーの内容です。
+type Reminder struct {
+	GoalID    uuid.UUID
+	UserID    uuid.UUID
+	Title     string
+	Deadline  time.Time
+	Threshold reminderlog.Threshold
+	// DaysLeft は期限までの残り日数です（期限切れの場合は負の値）。
+	DaysLeft int
+}
+
+// Notifier はリマインダーを配信するインターフェースです。
+// 配信手段（通知一覧、メール、プッシュ通知など）はこのインターフェースを実装して差し替えます。
+type Notifier interface {
+	Notify(ctx context.Context, r Reminder) error
+}
+
+// InboxNotifier はリマインダーを目標の参加者全員の通知一覧に届けるNotifierです。
+// 共有の目標では、オーナーに加えて招待を承認したメンバーにも届けます。
+type InboxNotifier struct {
+	client   *ent.Client
+	notifier notification.Notifier
+}
+
+// NewInboxNotifier は新しいInboxNotifierインスタンスを作成します。
+func NewInboxNotifier(client *ent.Client, notifier notification.Notifier) *InboxNotifier {
+	return &InboxNotifier{
+		client:   client,
+		notifier: notifier,
+	}
+}
+
+// Notify はリマインダーを目標の参加者ごとの通知として届けます。
+// 一部の参加者に届けられなかった場合はエラーを返し、次回のスキャンで再送します。
+// 同じ期限・しきい値のリマインダーには同じEventIDを付けるため、再送で届け済みの参加者に重複して届くことはありません。
+func (n *InboxNotifier) Notify(ctx context.Context, r Reminder) error {
+	recipients, err := n.client.User.Query().
+		Where(user.Or(
+			user.HasGoalsWith(goal.ID(r.GoalID)),
+			user.HasGoalParticipationsWith(
+				goalparticipant.HasGoalWith(goal.ID(r.GoalID)),
+				goalparticipant.StatusEQ(goalparticipant.StatusActive),
+			),
+		)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	eventID := uuid.NewSHA1(reminderNamespace, []byte(fmt.Sprintf("%s:%s:%s", r.GoalID, r.Threshold, r.Deadline.UTC().Format(time.RFC3339))))
+	var errs []error
+	for _, userID := range recipients {
+		err := n.notifier.Notify(ctx, notification.Notification{
+			Type:    notification.TypeGoalReminder,
+			UserID:  userID,
+			GoalID:  r.GoalID,
+			EventID: eventID,
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// reminderNamespace はリマインダーのEventIDを作成するための名前空間です。
+var reminderNamespace = uuid.MustParse("5d0c3a4e-6f1b-4c57-9a2e-8b7d1f3e2a60")
@@ -0,0 +1,104 @@
+package reminder
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"backend/ent/goal"
+	"backend/ent/goalparticipant"
+	"backend/ent/reminderlog"
+	"backend/internal/notification"
+
+	"github.com/google/uuid"
+)
+
+// recordingInbox は受け取った通知を記録するnotification.Notifierです。
+type recordingInbox struct {
+	sent []notification.Notification
+}
+
+func (n *recordingInbox) Notify(_ context.Context, v notification.Notification) error {
+	n.sent = append(n.sent, v)
+	return nil
+}
+
+func TestInboxNotifierSendsToEveryParticipant(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	g := createGoal(t, client, testUser{}, date(2026, 3, 17))
+	owner := client.Goal.Query().Where(goal.ID(g.ID)).QueryUser().OnlyX(ctx)
+
+	newUser := func(name string) uuid.UUID {
+		return client.User.Create().
+			SetName(name).
+			SetEmail(name + "@example.com").
+			SaveX(ctx).ID
+	}
+	member, invited := newUser("member"), newUser("invited")
+	newUser("stranger")
+	client.GoalParticipant.Create().
+		SetGoalID(g.ID).
+		SetUserID(owner.ID).
+		SetRole(goalparticipant.RoleOwner).
+		SetStatus(goalparticipant.StatusActive).
+		SaveX(ctx)
+	client.GoalParticipant.Create().
+		SetGoalID(g.ID).
+		SetUserID(member).
+		SetStatus(goalparticipant.StatusActive).
+		SaveX(ctx)
+	client.GoalParticipant.Create().
+		SetGoalID(g.ID).
+		SetUserID(invited).
+		SaveX(ctx)
+
+	inbox := &recordingInbox{}
+	r := Reminder{
+		GoalID:    g.ID,
+		UserID:    owner.ID,
+		Title:     g.Title,
+		Deadline:  *g.Deadline,
+		Threshold: reminderlog.ThresholdWeek,
+		DaysLeft:  7,
+	}
+	if err := NewInboxNotifier(client, inbox).Notify(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []uuid.UUID
+	for _, n := range inbox.sent {
+		got = append(got, n.UserID)
+		if n.Type != notification.TypeGoalReminder || n.GoalID != g.ID || n.ActorID != uuid.Nil {
+			t.Errorf("notification = %+v, want goal reminder for %s", n, g.ID)
+		}
+		if n.EventID != inbox.sent[0].EventID {
+			t.Errorf("EventID = %s, want %s for every participant", n.EventID, inbox.sent[0].EventID)
+		}
+	}
+	want := []uuid.UUID{owner.ID, member}
+	slices.SortFunc(got, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	slices.SortFunc(want, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	if !slices.Equal(got, want) {
+		t.Errorf("recipients = %v, want %v", got, want)
+	}
+
+	// 再送では同じEventIDを付け、期限が変わった場合は別のEventIDを付ける
+	first := inbox.sent[0].EventID
+	inbox.sent = nil
+	if err := NewInboxNotifier(client, inbox).Notify(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+	if inbox.sent[0].EventID != first {
+		t.Errorf("EventID on retry = %s, want %s", inbox.sent[0].EventID, first)
+	}
+	r.Deadline = r.Deadline.Add(24 * time.Hour)
+	inbox.sent = nil
+	if err := NewInboxNotifier(client, inbox).Notify(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+	if inbox.sent[0].EventID == first {
+		t.Error("EventID did not change with the deadline")
+	}
+}
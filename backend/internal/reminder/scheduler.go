@@ -0,0 +1,206 @@
+package reminder
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"backend/ent"
+	"backend/ent/goal"
+	"backend/ent/reminderlog"
+	"backend/internal/other"
+)
+
+// Config はリマインダースケジューラーの設定を保持します。
+type Config struct {
+	// Interval は期限スキャンの実行間隔です。
+	Interval time.Duration
+}
+
+// NewConfig は環境変数からリマインダースケジューラーの設定を作成します。
+func NewConfig() *Config {
+	interval, err := time.ParseDuration(other.GetEnv("REMINDER_INTERVAL", "15m"))
+	if err != nil || interval <= 0 {
+		interval = 15 * time.Minute
+	}
+
+	return &Config{
+		Interval: interval,
+	}
+}
+
+// Scheduler は目標の期限を定期的にスキャンし、リマインダーを送信します。
+// 送信済みのリマインダーはReminderLogに記録され、1目標・1しきい値につき1回だけ送信されます。
+// 残り日数と通知停止時間帯は、目標のオーナーのタイムゾーンで判定します。
+type Scheduler struct {
+	config   *Config
+	client   *ent.Client
+	notifier Notifier
+	clock    Clock
+}
+
+// NewScheduler は新しいSchedulerインスタンスを作成します。
+func NewScheduler(config *Config, client *ent.Client, notifier Notifier, clock Clock) *Scheduler {
+	return &Scheduler{
+		config:   config,
+		client:   client,
+		notifier: notifier,
+		clock:    clock,
+	}
+}
+
+// Run はctxがキャンセルされるまで一定間隔でScanを実行します。
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Scan(ctx); err != nil {
+			slog.ErrorContext(ctx, "reminder scan failed", "error", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan は期限が近い、または期限切れの目標を1回スキャンしてリマインダーを送信します。
+func (s *Scheduler) Scan(ctx context.Context) error {
+	now := s.clock.Now()
+
+	// タイムゾーン差を考慮して8日先までの目標を対象とする
+	goals, err := s.client.Goal.Query().
+		Where(
+			goal.DeadlineNotNil(),
+			goal.DeadlineLTE(now.AddDate(0, 0, 8)),
+			goal.Not(goal.HasReminderLogsWith(reminderlog.ThresholdEQ(reminderlog.ThresholdOverdue))),
+		).
+		WithUser().
+		WithReminderLogs().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range goals {
+		u := g.Edges.User
+		if u == nil {
+			continue
+		}
+
+		local := now.In(other.LoadLocation(u.TimeZone))
+		if inQuietHours(u, local.Hour()) {
+			continue
+		}
+
+		daysLeft := daysUntil(*g.Deadline, local)
+		threshold, ok := thresholdFor(daysLeft)
+		if !ok || alreadySent(g, threshold) {
+			continue
+		}
+
+		r := Reminder{
+			GoalID:    g.ID,
+			UserID:    u.ID,
+			Title:     g.Title,
+			Deadline:  *g.Deadline,
+			Threshold: threshold,
+			DaysLeft:  daysLeft,
+		}
+		if err := s.send(ctx, r, now); err != nil {
+			slog.ErrorContext(ctx, "failed to send reminder",
+				"goal_id", g.ID.String(),
+				"threshold", threshold.String(),
+				"error", err.Error(),
+			)
+		}
+	}
+
+	return nil
+}
+
+// send はReminderLogへの記録と通知を同一トランザクションで行います。
+// 通知に失敗した場合は記録をロールバックし、次回のスキャンで再送します。
+func (s *Scheduler) send(ctx context.Context, r Reminder, now time.Time) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ReminderLog.Create().
+		SetGoalID(r.GoalID).
+		SetThreshold(r.Threshold).
+		SetSentAt(now).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		// 他のスキャンが先に記録した場合は送信済みとして扱う
+		if ent.IsConstraintError(err) {
+			return nil
+		}
+		return err
+	}
+
+	if err := s.notifier.Notify(ctx, r); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// thresholdFor は期限までの残り日数に対応するしきい値を返します。
+func thresholdFor(daysLeft int) (reminderlog.Threshold, bool) {
+	switch {
+	case daysLeft < 0:
+		return reminderlog.ThresholdOverdue, true
+	case daysLeft == 0:
+		return reminderlog.ThresholdToday, true
+	case daysLeft == 1:
+		return reminderlog.ThresholdDay, true
+	case daysLeft <= 7:
+		return reminderlog.ThresholdWeek, true
+	default:
+		return "", false
+	}
+}
+
+// daysUntil はユーザーのローカル日付から期限日までの日数を返します。
+// 期限は日付として扱い、時刻部分は無視します。
+func daysUntil(deadline time.Time, local time.Time) int {
+	d := deadline.UTC()
+	due := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	return int(due.Sub(today).Hours() / 24)
+}
+
+// inQuietHours はローカル時刻の時がユーザーの通知停止時間帯に含まれるかを判定します。
+// 開始時刻が終了時刻より大きい場合は日付をまたぐ時間帯として扱います。
+func inQuietHours(u *ent.User, hour int) bool {
+	if u.QuietHoursStart == nil || u.QuietHoursEnd == nil {
+		return false
+	}
+
+	start, end := *u.QuietHoursStart, *u.QuietHoursEnd
+	switch {
+	case start == end:
+		return false
+	case start < end:
+		return hour >= start && hour < end
+	default:
+		return hour >= start || hour < end
+	}
+}
+
+// alreadySent は目標に対してしきい値のリマインダーが送信済みかを判定します。
+func alreadySent(g *ent.Goal, threshold reminderlog.Threshold) bool {
+	for _, l := range g.Edges.ReminderLogs {
+		if l.Threshold == threshold {
+			return true
+		}
+	}
+	return false
+}
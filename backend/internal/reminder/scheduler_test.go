@@ -0,0 +1,437 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"backend/ent"
+	"backend/ent/enttest"
+	"backend/ent/goal"
+	"backend/ent/reminderlog"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// fakeClock はテストで指定した時刻を返すClockです。
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+// recordingNotifier は受け取ったリマインダーを記録するNotifierです。
+// errが設定されている場合は記録せずにエラーを返します。
+type recordingNotifier struct {
+	sent []Reminder
+	err  error
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, r Reminder) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.sent = append(n.sent, r)
+	return nil
+}
+
+// testUser はテスト用のユーザーの設定です。
+type testUser struct {
+	timeZone   string
+	quietStart *int
+	quietEnd   *int
+}
+
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", uuid.NewString())
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// createGoal はユーザーと期限付きの目標を作成します。
+// 目標の作成はドメインイベントを記録するため、トランザクション内で行います。
+func createGoal(t *testing.T, client *ent.Client, u testUser, deadline time.Time) *ent.Goal {
+	t.Helper()
+	ctx := context.Background()
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tz := u.timeZone
+	if tz == "" {
+		tz = "UTC"
+	}
+	id := uuid.New()
+	owner, err := tx.User.Create().
+		SetID(id).
+		SetName("user").
+		SetEmail(id.String() + "@example.com").
+		SetTimeZone(tz).
+		SetNillableQuietHoursStart(u.quietStart).
+		SetNillableQuietHoursEnd(u.quietEnd).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		t.Fatal(err)
+	}
+	g, err := tx.Goal.Create().
+		SetTitle("goal").
+		SetDeadline(deadline).
+		SetUser(owner).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func newTestScheduler(client *ent.Client, notifier Notifier, clock Clock) *Scheduler {
+	return NewScheduler(&Config{Interval: time.Minute}, client, notifier, clock)
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestScanThresholds(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		deadline time.Time
+		want     reminderlog.Threshold
+		daysLeft int
+	}{
+		{name: "8日前は送信しない", deadline: date(2026, 3, 18)},
+		{name: "7日前", deadline: date(2026, 3, 17), want: reminderlog.ThresholdWeek, daysLeft: 7},
+		{name: "2日前", deadline: date(2026, 3, 12), want: reminderlog.ThresholdWeek, daysLeft: 2},
+		{name: "前日", deadline: date(2026, 3, 11), want: reminderlog.ThresholdDay, daysLeft: 1},
+		{name: "当日", deadline: date(2026, 3, 10), want: reminderlog.ThresholdToday, daysLeft: 0},
+		{name: "当日の時刻を過ぎても当日として扱う", deadline: time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC), want: reminderlog.ThresholdToday, daysLeft: 0},
+		{name: "期限切れ", deadline: date(2026, 3, 9), want: reminderlog.ThresholdOverdue, daysLeft: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t)
+			g := createGoal(t, client, testUser{}, tt.deadline)
+			notifier := &recordingNotifier{}
+
+			if err := newTestScheduler(client, notifier, &fakeClock{now: now}).Scan(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.want == "" {
+				if len(notifier.sent) != 0 {
+					t.Fatalf("sent = %+v, want none", notifier.sent)
+				}
+				return
+			}
+			if len(notifier.sent) != 1 {
+				t.Fatalf("sent %d reminders, want 1", len(notifier.sent))
+			}
+			r := notifier.sent[0]
+			if r.GoalID != g.ID || r.Threshold != tt.want || r.DaysLeft != tt.daysLeft {
+				t.Errorf("sent = {goal: %s, threshold: %s, days_left: %d}, want {goal: %s, threshold: %s, days_left: %d}",
+					r.GoalID, r.Threshold, r.DaysLeft, g.ID, tt.want, tt.daysLeft)
+			}
+		})
+	}
+}
+
+func TestScanQuietHours(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    *int
+		end      *int
+		hour     int
+		wantSent bool
+	}{
+		{name: "設定なし", hour: 3, wantSent: true},
+		{name: "開始と終了が同じ場合は停止しない", start: intPtr(5), end: intPtr(5), hour: 5, wantSent: true},
+		{name: "日中の時間帯内", start: intPtr(9), end: intPtr(17), hour: 9, wantSent: false},
+		{name: "日中の時間帯の終了時刻", start: intPtr(9), end: intPtr(17), hour: 17, wantSent: true},
+		{name: "日付をまたぐ時間帯の開始後", start: intPtr(22), end: intPtr(7), hour: 23, wantSent: false},
+		{name: "日付をまたぐ時間帯の日付変更後", start: intPtr(22), end: intPtr(7), hour: 3, wantSent: false},
+		{name: "日付をまたぐ時間帯の外", start: intPtr(22), end: intPtr(7), hour: 12, wantSent: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t)
+			createGoal(t, client, testUser{quietStart: tt.start, quietEnd: tt.end}, date(2026, 3, 20))
+			notifier := &recordingNotifier{}
+			now := time.Date(2026, 3, 15, tt.hour, 30, 0, 0, time.UTC)
+
+			if err := newTestScheduler(client, notifier, &fakeClock{now: now}).Scan(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := len(notifier.sent) == 1; got != tt.wantSent {
+				t.Errorf("sent = %v, want %v", got, tt.wantSent)
+			}
+		})
+	}
+}
+
+func TestScanSendsAfterQuietHours(t *testing.T) {
+	client := newTestClient(t)
+	createGoal(t, client, testUser{timeZone: "Asia/Tokyo", quietStart: intPtr(22), quietEnd: intPtr(7)}, date(2026, 3, 20))
+	notifier := &recordingNotifier{}
+	// 2026-03-15 23:00 (Asia/Tokyo)
+	clock := &fakeClock{now: time.Date(2026, 3, 15, 14, 0, 0, 0, time.UTC)}
+	s := newTestScheduler(client, notifier, clock)
+
+	if err := s.Scan(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(notifier.sent) != 0 {
+		t.Fatalf("sent %d reminders during quiet hours, want 0", len(notifier.sent))
+	}
+
+	// 2026-03-16 07:00 (Asia/Tokyo)
+	clock.now = time.Date(2026, 3, 15, 22, 0, 0, 0, time.UTC)
+	if err := s.Scan(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(notifier.sent) != 1 {
+		t.Fatalf("sent %d reminders after quiet hours, want 1", len(notifier.sent))
+	}
+}
+
+func TestScanTimeZones(t *testing.T) {
+	// Asia/Tokyoでは2026-03-11 01:00、America/Los_Angelesでは2026-03-10 09:00
+	now := time.Date(2026, 3, 10, 16, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		timeZone string
+		want     reminderlog.Threshold
+	}{
+		{timeZone: "Asia/Tokyo", want: reminderlog.ThresholdToday},
+		{timeZone: "America/Los_Angeles", want: reminderlog.ThresholdDay},
+		{timeZone: "UTC", want: reminderlog.ThresholdDay},
+		{timeZone: "Pacific/Kiritimati", want: reminderlog.ThresholdToday},
+	}
+	for _, tt := range tests {
+		t.Run(tt.timeZone, func(t *testing.T) {
+			client := newTestClient(t)
+			createGoal(t, client, testUser{timeZone: tt.timeZone}, date(2026, 3, 11))
+			notifier := &recordingNotifier{}
+
+			if err := newTestScheduler(client, notifier, &fakeClock{now: now}).Scan(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(notifier.sent) != 1 {
+				t.Fatalf("sent %d reminders, want 1", len(notifier.sent))
+			}
+			if got := notifier.sent[0].Threshold; got != tt.want {
+				t.Errorf("threshold = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanSendsEachThresholdOnce(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	g := createGoal(t, client, testUser{}, date(2026, 3, 17))
+	notifier := &recordingNotifier{}
+	clock := &fakeClock{now: time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)}
+	s := newTestScheduler(client, notifier, clock)
+
+	steps := []struct {
+		now  time.Time
+		want []reminderlog.Threshold
+	}{
+		{now: time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC), want: []reminderlog.Threshold{reminderlog.ThresholdWeek}},
+		// 同じしきい値のままスキャンを繰り返しても再送しない
+		{now: time.Date(2026, 3, 10, 12, 15, 0, 0, time.UTC)},
+		{now: time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)},
+		{now: time.Date(2026, 3, 16, 12, 0, 0, 0, time.UTC), want: []reminderlog.Threshold{reminderlog.ThresholdDay}},
+		{now: time.Date(2026, 3, 17, 0, 0, 0, 0, time.UTC), want: []reminderlog.Threshold{reminderlog.ThresholdToday}},
+		{now: time.Date(2026, 3, 17, 23, 0, 0, 0, time.UTC)},
+		{now: time.Date(2026, 3, 18, 0, 0, 0, 0, time.UTC), want: []reminderlog.Threshold{reminderlog.ThresholdOverdue}},
+		// 期限切れを送信した目標はスキャンの対象から外れる
+		{now: time.Date(2026, 3, 25, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, step := range steps {
+		clock.now = step.now
+		notifier.sent = nil
+		if err := s.Scan(ctx); err != nil {
+			t.Fatal(err)
+		}
+		var got []reminderlog.Threshold
+		for _, r := range notifier.sent {
+			got = append(got, r.Threshold)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(step.want) {
+			t.Errorf("at %s: sent %v, want %v", step.now.Format(time.RFC3339), got, step.want)
+		}
+	}
+
+	logs, err := client.ReminderLog.Query().
+		Where(reminderlog.HasGoalWith(goal.ID(g.ID))).
+		All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 4 {
+		t.Errorf("reminder logs = %d, want 4", len(logs))
+	}
+}
+
+// updateDeadline は目標の期限を変更します。deadlineがnilの場合は期限を削除します。
+func updateDeadline(t *testing.T, client *ent.Client, g *ent.Goal, deadline *time.Time) {
+	t.Helper()
+	ctx := context.Background()
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	upd := tx.Goal.UpdateOneID(g.ID)
+	if deadline != nil {
+		upd.SetDeadline(*deadline)
+	} else {
+		upd.ClearDeadline()
+	}
+	if err := upd.Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScanRemindsAgainAfterDeadlineChanges(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	g := createGoal(t, client, testUser{}, date(2026, 3, 9))
+	notifier := &recordingNotifier{}
+	clock := &fakeClock{now: time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)}
+	s := newTestScheduler(client, notifier, clock)
+
+	extended := date(2026, 3, 17)
+	steps := []struct {
+		name     string
+		deadline *time.Time
+		clear    bool
+		now      time.Time
+		want     []reminderlog.Threshold
+	}{
+		{name: "期限切れ", now: time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC), want: []reminderlog.Threshold{reminderlog.ThresholdOverdue}},
+		// 期限を延ばすと、新しい期限に対するリマインダーを最初から送る
+		{name: "期限を延長", deadline: &extended, now: time.Date(2026, 3, 10, 12, 15, 0, 0, time.UTC), want: []reminderlog.Threshold{reminderlog.ThresholdWeek}},
+		// 同じ期限で更新しても送信済みの記録は残す
+		{name: "同じ期限で更新", deadline: &extended, now: time.Date(2026, 3, 10, 12, 30, 0, 0, time.UTC)},
+		{name: "前日", now: time.Date(2026, 3, 16, 12, 0, 0, 0, time.UTC), want: []reminderlog.Threshold{reminderlog.ThresholdDay}},
+		// 期限を削除してから設定し直した場合も最初から送る
+		{name: "期限を削除", clear: true, now: time.Date(2026, 3, 16, 12, 15, 0, 0, time.UTC)},
+		{name: "期限を再設定", deadline: &extended, now: time.Date(2026, 3, 16, 12, 30, 0, 0, time.UTC), want: []reminderlog.Threshold{reminderlog.ThresholdDay}},
+	}
+	for _, step := range steps {
+		if step.deadline != nil || step.clear {
+			updateDeadline(t, client, g, step.deadline)
+		}
+		clock.now = step.now
+		notifier.sent = nil
+		if err := s.Scan(ctx); err != nil {
+			t.Fatal(err)
+		}
+		var got []reminderlog.Threshold
+		for _, r := range notifier.sent {
+			got = append(got, r.Threshold)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(step.want) {
+			t.Errorf("%s: sent %v, want %v", step.name, got, step.want)
+		}
+	}
+}
+
+func TestScanSkipsThresholdAlreadyLogged(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	g := createGoal(t, client, testUser{}, date(2026, 3, 11))
+	// 他のレプリカが先に送信した場合を想定して記録だけを作る
+	client.ReminderLog.Create().
+		SetGoalID(g.ID).
+		SetThreshold(reminderlog.ThresholdDay).
+		SetSentAt(now).
+		SaveX(ctx)
+	notifier := &recordingNotifier{}
+
+	if err := newTestScheduler(client, notifier, &fakeClock{now: now}).Scan(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(notifier.sent) != 0 {
+		t.Errorf("sent %d reminders, want 0", len(notifier.sent))
+	}
+}
+
+func TestSendTreatsConstraintErrorAsSent(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	g := createGoal(t, client, testUser{}, date(2026, 3, 11))
+	client.ReminderLog.Create().
+		SetGoalID(g.ID).
+		SetThreshold(reminderlog.ThresholdDay).
+		SetSentAt(now).
+		SaveX(ctx)
+	notifier := &recordingNotifier{}
+	s := newTestScheduler(client, notifier, &fakeClock{now: now})
+
+	// スキャンで読み込んだ後に他のレプリカが記録した場合も、送信せずに成功として扱う
+	r := Reminder{GoalID: g.ID, Threshold: reminderlog.ThresholdDay, DaysLeft: 1}
+	if err := s.send(ctx, r, now); err != nil {
+		t.Fatalf("send() error = %v, want nil", err)
+	}
+	if len(notifier.sent) != 0 {
+		t.Errorf("sent %d reminders, want 0", len(notifier.sent))
+	}
+}
+
+func TestScanRetriesWhenNotifyFails(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	g := createGoal(t, client, testUser{}, date(2026, 3, 11))
+	notifier := &recordingNotifier{err: errors.New("unavailable")}
+	s := newTestScheduler(client, notifier, &fakeClock{now: time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)})
+
+	if err := s.Scan(ctx); err != nil {
+		t.Fatal(err)
+	}
+	n, err := client.ReminderLog.Query().Where(reminderlog.HasGoalWith(goal.ID(g.ID))).Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Fatalf("reminder logs after failed notify = %d, want 0", n)
+	}
+
+	notifier.err = nil
+	if err := s.Scan(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(notifier.sent) != 1 || notifier.sent[0].Threshold != reminderlog.ThresholdDay {
+		t.Errorf("sent = %+v, want one day reminder", notifier.sent)
+	}
+}
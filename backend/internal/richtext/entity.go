@@ -0,0 +1,141 @@
+// Package richtext は投稿本文からメンションやハッシュタグなどの構造を取り出します。
+package richtext
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// EntityType は本文中の要素の種類です。
+type EntityType string
+
+const (
+	// EntityMention は "@handle" 形式のメンションです。
+	EntityMention EntityType = "mention"
+	// EntityHashtag は "#tag" 形式のハッシュタグです。
+	EntityHashtag EntityType = "hashtag"
+)
+
+const (
+	// MaxHandleLength はハンドルの最大文字数です。
+	MaxHandleLength = 30
+	// MaxHashtagLength はハッシュタグの最大文字数です。
+	MaxHashtagLength = 50
+)
+
+// Entity は本文中のメンションまたはハッシュタグです。
+// Start と End はUnicodeコードポイント単位のオフセットで、記号 (@, #) を含む範囲 [Start, End) を表します。
+type Entity struct {
+	Type EntityType
+	// Value は正規化した値です (メンションはハンドル、ハッシュタグはタグ名。どちらも記号を含まない)。
+	Value string
+	Start int
+	End   int
+}
+
+// Parse は本文からメンションとハッシュタグを出現順に取り出します。
+// 英数字の直後の "@" (メールアドレスなど) と、文字の直後の "#" は対象外です。
+func Parse(content string) []Entity {
+	runes := []rune(content)
+
+	var entities []Entity
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '@', '＠':
+			if i > 0 && isHandleRune(runes[i-1]) {
+				continue
+			}
+			end := i + 1
+			for end < len(runes) && isHandleRune(runes[end]) {
+				end++
+			}
+			if n := end - i - 1; n > 0 && n <= MaxHandleLength {
+				entities = append(entities, Entity{
+					Type:  EntityMention,
+					Value: NormalizeHandle(string(runes[i+1 : end])),
+					Start: i,
+					End:   end,
+				})
+				i = end - 1
+			}
+		case '#', '＃':
+			if i > 0 && isWordRune(runes[i-1]) {
+				continue
+			}
+			end := i + 1
+			for end < len(runes) && isWordRune(runes[end]) {
+				end++
+			}
+			tag := string(runes[i+1 : end])
+			if n := end - i - 1; n > 0 && n <= MaxHashtagLength && !isDigits(tag) {
+				entities = append(entities, Entity{
+					Type:  EntityHashtag,
+					Value: NormalizeHashtag(tag),
+					Start: i,
+					End:   end,
+				})
+				i = end - 1
+			}
+		}
+	}
+	return entities
+}
+
+// Values は指定した種類の要素の値を重複なく出現順に返します。
+func Values(entities []Entity, typ EntityType) []string {
+	var values []string
+	seen := make(map[string]struct{})
+	for _, e := range entities {
+		if e.Type != typ {
+			continue
+		}
+		if _, ok := seen[e.Value]; ok {
+			continue
+		}
+		seen[e.Value] = struct{}{}
+		values = append(values, e.Value)
+	}
+	return values
+}
+
+// NormalizeHandle はハンドルを比較用に正規化します (全角英数字を半角に、小文字に揃える)。
+func NormalizeHandle(handle string) string {
+	return strings.ToLower(norm.NFKC.String(handle))
+}
+
+// NormalizeHashtag はハッシュタグ名を比較用に正規化します。
+// 全角英数字・半角カナを揃え、英字は小文字にします。先頭の記号は取り除きます。
+func NormalizeHashtag(tag string) string {
+	tag = strings.TrimLeft(tag, "#＃")
+	return strings.ToLower(norm.NFKC.String(tag))
+}
+
+// isHandleRune はハンドルに使用できる文字かを返します (全角英数字を含む)。
+func isHandleRune(r rune) bool {
+	switch {
+	case r == '_' || r == '＿':
+		return true
+	case r <= unicode.MaxASCII:
+		return 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9'
+	default:
+		return 'ａ' <= r && r <= 'ｚ' || 'Ａ' <= r && r <= 'Ｚ' || '０' <= r && r <= '９'
+	}
+}
+
+// isWordRune はハッシュタグに使用できる文字かを返します。
+// 日本語の長音符 (ー) や濁点などの結合文字も含みます。
+func isWordRune(r rune) bool {
+	return r == '_' || r == '＿' || unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.Is(unicode.Mn, r)
+}
+
+// isDigits は文字列が数字のみで構成されているかを返します ("#1" のような番号はタグにしない)。
+func isDigits(s string) bool {
+	for _, r := range s {
+		if !unicode.IsNumber(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package richtext
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	gmhtml "github.com/yuin/goldmark/renderer/html"
+)
+
+// Format は投稿本文の書式です。
+type Format string
+
+const (
+	// FormatPlain は書式なしのテキストです。
+	FormatPlain Format = "plain"
+	// FormatMarkdown はMarkdown (CommonMark + 打ち消し線・表・自動リンク) です。
+	FormatMarkdown Format = "markdown"
+)
+
+// markdown は本文をHTMLに変換するMarkdownパーサーです。
+// 生のHTMLは出力しない設定 (goldmarkの既定) のまま使用し、変換後にさらに許可リストで無害化します。
+var markdown = goldmark.New(
+	goldmark.WithExtensions(
+		extension.Strikethrough,
+		extension.Table,
+		extension.Linkify,
+	),
+	goldmark.WithRendererOptions(
+		gmhtml.WithHardWraps(),
+	),
+)
+
+// policy は出力を許可するHTMLの許可リストです。
+// 画像は投稿の添付画像として扱うため、本文中の<img>は許可しません。
+var policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements(
+		"p", "br", "hr", "blockquote", "pre",
+		"h1", "h2", "h3", "h4", "h5", "h6",
+		"ul", "ol", "li",
+		"em", "strong", "del", "code",
+		"table", "thead", "tbody", "tr", "th", "td",
+	)
+	p.AllowAttrs("start").Matching(regexp.MustCompile(`^[0-9]+$`)).OnElements("ol")
+	p.AllowAttrs("align").Matching(regexp.MustCompile(`^(left|center|right)$`)).OnElements("th", "td")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+-]+$`)).OnElements("code")
+	p.AllowAttrs("href").OnElements("a")
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.RequireParseableURLs(true)
+	p.AllowRelativeURLs(false)
+	p.RequireNoFollowOnLinks(true)
+	p.RequireNoReferrerOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+// ValidFormat は書式が対応しているものかを返します。
+func ValidFormat(f Format) bool {
+	return f == FormatPlain || f == FormatMarkdown
+}
+
+// RenderHTML は本文を書式に従って無害化済みのHTMLに変換します。
+// 書式なしの場合はHTMLエスケープし、段落と改行のみを変換します。
+func RenderHTML(content string, format Format) (string, error) {
+	switch format {
+	case FormatPlain:
+		return renderPlain(content), nil
+	case FormatMarkdown:
+		var buf bytes.Buffer
+		if err := markdown.Convert([]byte(content), &buf); err != nil {
+			return "", fmt.Errorf("convert markdown: %w", err)
+		}
+		return policy.Sanitize(buf.String()), nil
+	default:
+		return "", fmt.Errorf("unsupported format: %q", format)
+	}
+}
+
+// renderPlain は空行で区切られた部分を段落に、それ以外の改行を<br>に変換します。
+func renderPlain(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	var b strings.Builder
+	for _, para := range strings.Split(content, "\n\n") {
+		para = strings.Trim(para, "\n")
+		if strings.TrimSpace(para) == "" {
+			continue
+		}
+		lines := strings.Split(para, "\n")
+		for i, line := range lines {
+			lines[i] = html.EscapeString(line)
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.Join(lines, "<br>\n"))
+		b.WriteString("</p>\n")
+	}
+	return b.String()
+}
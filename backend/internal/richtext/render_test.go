@@ -0,0 +1,147 @@
+package richtext
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// formatsByExt はテストデータの拡張子と書式の対応です。
+var formatsByExt = map[string]Format{
+	".md":  FormatMarkdown,
+	".txt": FormatPlain,
+}
+
+// TestRenderHTMLGolden はtestdata以下の本文を変換し、同名の.goldenファイルと比較します。
+// 期待値を更新する場合は go test -run TestRenderHTMLGolden -update を実行します。
+func TestRenderHTMLGolden(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		format, ok := formatsByExt[filepath.Ext(file)]
+		if !ok {
+			continue
+		}
+		name := filepath.Base(file)
+		t.Run(name, func(t *testing.T) {
+			src, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := RenderHTML(string(src), format)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			golden := strings.TrimSuffix(file, filepath.Ext(file)) + ".golden"
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("RenderHTML(%s) mismatch\ngot:\n%s\nwant:\n%s", name, got, want)
+			}
+		})
+	}
+}
+
+func TestRenderHTMLStripsUnsafeMarkup(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "script", content: "<script>alert(1)</script>"},
+		{name: "event handler", content: `<p onmouseover="alert(1)">text</p>`},
+		{name: "javascript link", content: "[x](javascript:alert(1))"},
+		{name: "javascript autolink", content: "<javascript:alert(1)>"},
+		{name: "mixed case scheme", content: "[x](JaVaScRiPt:alert(1))"},
+		{name: "vbscript link", content: "[x](vbscript:msgbox(1))"},
+		{name: "data link", content: "[x](data:text/html,<script>alert(1)</script>)"},
+		{name: "image", content: `![x](https://example.com/a.png "t")`},
+	}
+	// リンクにならなかったURLは本文のテキストとして残るため、属性の値だけを確認する
+	unsafe := regexp.MustCompile(`(?i)<script|<img|\son\w+=|(href|src)="\s*(javascript|vbscript|data):`)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderHTML(tt.content, FormatMarkdown)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m := unsafe.FindString(got); m != "" {
+				t.Errorf("RenderHTML(%q) = %q, contains %q", tt.content, got, m)
+			}
+		})
+	}
+}
+
+func TestRenderHTMLLinksAreNoFollow(t *testing.T) {
+	content := "[a](https://example.com) https://example.com/auto <mailto:taro@example.com>"
+	got, err := RenderHTML(content, FormatMarkdown)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	anchors := regexp.MustCompile(`<a\s[^>]*>`).FindAllString(got, -1)
+	if len(anchors) != 3 {
+		t.Fatalf("RenderHTML(%q) = %q, want 3 links", content, got)
+	}
+	rel := regexp.MustCompile(`\srel="([^"]*)"`)
+	for _, a := range anchors {
+		m := rel.FindStringSubmatch(a)
+		if m == nil {
+			t.Errorf("link %s has no rel", a)
+			continue
+		}
+		values := strings.Fields(m[1])
+		for _, want := range []string{"nofollow", "noreferrer"} {
+			if !slices.Contains(values, want) {
+				t.Errorf("link %s rel = %q, want %q", a, m[1], want)
+			}
+		}
+	}
+}
+
+func TestRenderHTMLPlainEscapesMarkup(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "empty", content: "", want: ""},
+		{name: "blank lines only", content: "\n\n  \n\n", want: ""},
+		{name: "markdown is not interpreted", content: "**a** [b](https://example.com)", want: "<p>**a** [b](https://example.com)</p>\n"},
+		{name: "html is escaped", content: `<a href="javascript:alert(1)">x</a>`, want: "<p>&lt;a href=&#34;javascript:alert(1)&#34;&gt;x&lt;/a&gt;</p>\n"},
+		{name: "line breaks", content: "a\nb\r\nc", want: "<p>a<br>\nb<br>\nc</p>\n"},
+		{name: "paragraphs", content: "a\n\nb", want: "<p>a</p>\n<p>b</p>\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderHTML(tt.content, FormatPlain)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("RenderHTML(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderHTMLUnsupportedFormat(t *testing.T) {
+	if _, err := RenderHTML("text", Format("html")); err == nil {
+		t.Error("RenderHTML with unsupported format returned nil error")
+	}
+}
@@ -0,0 +1,57 @@
+package richtext
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// MaxURLLength は本文から取り出すURLの最大長 (バイト) です。
+const MaxURLLength = 2048
+
+// urlPattern は本文中のhttp(s)のURLです。空白・引用符・山括弧・全角文字の直前までを対象とします。
+var urlPattern = regexp.MustCompile(`https?://[!#-;=?-\[\]_a-z~]+`)
+
+// FirstURL は本文に最初に現れるhttp(s)のURLを返します。URLがない場合は空文字列を返します。
+// Markdownのリンク記法 "[text](url)" や文末の句読点に続くURLでも、末尾の記号は含めません。
+func FirstURL(content string) string {
+	if urls := URLs(content); len(urls) > 0 {
+		return urls[0]
+	}
+	return ""
+}
+
+// URLs は本文に現れるhttp(s)のURLを出現順にすべて返します。取り出し方はFirstURLと同じです。
+func URLs(content string) []string {
+	var urls []string
+	for _, m := range urlPattern.FindAllString(content, -1) {
+		m = trimURL(m)
+		if len(m) > MaxURLLength {
+			continue
+		}
+		u, err := url.Parse(m)
+		if err != nil || u.Host == "" {
+			continue
+		}
+		urls = append(urls, m)
+	}
+	return urls
+}
+
+// trimURL はURLの末尾に付いた句読点と、対応する開き括弧のない閉じ括弧を取り除きます。
+func trimURL(s string) string {
+	for len(s) > 0 {
+		last := s[len(s)-1]
+		switch {
+		case strings.IndexByte(".,:;!?'*", last) >= 0:
+			s = s[:len(s)-1]
+		case last == ')' && strings.Count(s, "(") < strings.Count(s, ")"):
+			s = s[:len(s)-1]
+		case last == ']' && strings.Count(s, "[") < strings.Count(s, "]"):
+			s = s[:len(s)-1]
+		default:
+			return s
+		}
+	}
+	return s
+}
@@ -0,0 +1,140 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Document はMemorySearcherに登録する検索対象です。
+type Document struct {
+	Kind   Kind
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// GoalID は投稿の目標のIDです (目標の場合は使いません)。
+	GoalID uuid.UUID
+	// Text は投稿の本文または目標のタイトルです。
+	Text string
+	// Published は投稿が公開済みかです (目標の場合は使いません)。
+	Published bool
+	// Hidden は投稿が運営により非表示にされているかです (目標の場合は使いません)。
+	Hidden    bool
+	CreatedAt time.Time
+}
+
+// memoryDocument は字句に分割済みのDocumentです。
+type memoryDocument struct {
+	Document
+	tokens map[string]struct{}
+}
+
+// MemorySearcher はメモリ上の文書を検索するSearcherです。
+// データベースを使わずに検索の動作を確認するためのもので、PostgresSearcherと同じ分割・一致の規則で検索します。
+type MemorySearcher struct {
+	mu   sync.RWMutex
+	docs map[uuid.UUID]memoryDocument
+}
+
+// NewMemorySearcher は新しいMemorySearcherインスタンスを作成します。
+func NewMemorySearcher() *MemorySearcher {
+	return &MemorySearcher{docs: make(map[uuid.UUID]memoryDocument)}
+}
+
+// Put は文書を登録します。同じIDの文書が既にある場合は置き換えます。
+func (s *MemorySearcher) Put(doc Document) {
+	tokens := make(map[string]struct{})
+	for _, t := range Tokenize(doc.Text) {
+		tokens[t] = struct{}{}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.docs[doc.ID] = memoryDocument{Document: doc, tokens: tokens}
+}
+
+// Delete は文書を削除します。
+func (s *MemorySearcher) Delete(id uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.docs, id)
+}
+
+// Search は検索条件に一致する文書を作成日時の新しい順に返します。
+func (s *MemorySearcher) Search(_ context.Context, q Query) ([]Hit, error) {
+	if q.Kind != KindPosts && q.Kind != KindGoals {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedKind, q.Kind)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var after *memoryDocument
+	if q.Cursor != nil {
+		after = &memoryDocument{Document: Document{ID: q.Cursor.ID, CreatedAt: q.Cursor.Time}}
+	}
+	if q.After != nil {
+		d, ok := s.docs[*q.After]
+		if !ok || !s.visible(d, q) {
+			return nil, ErrUnknownCursor
+		}
+		after = &d
+	}
+
+	var found []memoryDocument
+	for _, d := range s.docs {
+		if !s.visible(d, q) || !matches(d.tokens, q.Terms) {
+			continue
+		}
+		if q.UserID != nil && d.UserID != *q.UserID {
+			continue
+		}
+		if q.Kind == KindPosts && q.GoalID != nil && d.GoalID != *q.GoalID {
+			continue
+		}
+		if q.From != nil && d.CreatedAt.Before(*q.From) {
+			continue
+		}
+		if q.To != nil && !d.CreatedAt.Before(*q.To) {
+			continue
+		}
+		if after != nil && compareDocuments(d, *after) <= 0 {
+			continue
+		}
+		found = append(found, d)
+	}
+	slices.SortFunc(found, compareDocuments)
+	if len(found) > q.Limit {
+		found = found[:q.Limit]
+	}
+
+	res := make([]Hit, 0, len(found))
+	for _, d := range found {
+		res = append(res, Hit{
+			ID:        d.ID,
+			CreatedAt: d.CreatedAt,
+			Snippet:   Snippet(d.Text, q.Terms),
+		})
+	}
+	return res, nil
+}
+
+// visible は文書が検索対象の種類で、閲覧者が閲覧できるものかを返します。
+func (s *MemorySearcher) visible(d memoryDocument, q Query) bool {
+	if d.Kind != q.Kind || slices.Contains(q.Blocked, d.UserID) {
+		return false
+	}
+	return d.Kind != KindPosts || (d.Published && !d.Hidden) || d.UserID == q.Viewer
+}
+
+// compareDocuments は作成日時とIDの降順で文書を比較します。aがbより前に並ぶ場合に負の値を返します。
+func compareDocuments(a, b memoryDocument) int {
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+	return cmp.Compare(b.ID.String(), a.ID.String())
+}
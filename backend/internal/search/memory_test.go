@@ -0,0 +1,365 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/cursor"
+
+	"github.com/google/uuid"
+)
+
+var (
+	viewer   = uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	author   = uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+	blocked  = uuid.MustParse("00000000-0000-0000-0000-00000000000c")
+	goalA    = uuid.MustParse("00000000-0000-0000-0000-0000000000a1")
+	goalB    = uuid.MustParse("00000000-0000-0000-0000-0000000000b1")
+	baseTime = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+)
+
+// docID はテストで文書を識別しやすいよう、番号からIDを作成します。
+func docID(n int) uuid.UUID {
+	return uuid.MustParse(fmt.Sprintf("00000000-0000-0000-0001-%012d", n))
+}
+
+// newPost は公開済みの投稿の文書を作成します。nが大きいほど新しい投稿です。
+func newPost(n int, text string) Document {
+	return Document{
+		Kind:      KindPosts,
+		ID:        docID(n),
+		UserID:    author,
+		GoalID:    goalA,
+		Text:      text,
+		Published: true,
+		CreatedAt: baseTime.Add(time.Duration(n) * time.Minute),
+	}
+}
+
+func newSearcher(docs ...Document) *MemorySearcher {
+	s := NewMemorySearcher()
+	for _, d := range docs {
+		s.Put(d)
+	}
+	return s
+}
+
+// searchIDs は検索結果の文書の番号を返します。
+func searchIDs(t *testing.T, s *MemorySearcher, q Query) []int {
+	t.Helper()
+	if q.Kind == "" {
+		q.Kind = KindPosts
+	}
+	if q.Limit == 0 {
+		q.Limit = 100
+	}
+	if q.Viewer == uuid.Nil {
+		q.Viewer = viewer
+	}
+	hits, err := s.Search(context.Background(), q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hitNumbers(hits)
+}
+
+func hitNumbers(hits []Hit) []int {
+	res := make([]int, 0, len(hits))
+	for _, h := range hits {
+		var n int
+		fmt.Sscanf(h.ID.String()[24:], "%d", &n)
+		res = append(res, n)
+	}
+	return res
+}
+
+func TestMemorySearcherMatchesBigrams(t *testing.T) {
+	s := newSearcher(
+		newPost(1, "毎日ランニングを続ける"),
+		newPost(2, "ランチを食べた"),
+		newPost(3, "朝のランニング"),
+		newPost(4, "English running log"),
+	)
+
+	tests := []struct {
+		query string
+		want  []int
+	}{
+		{query: "ランニング", want: []int{3, 1}},
+		{query: "ラン", want: []int{3, 2, 1}},
+		// 本文で隣り合っていない2文字の組には一致しない
+		{query: "グ続", want: []int{}},
+		{query: "ランニング　続ける", want: []int{1}},
+		// 半角カタカナも全角と同じに扱う
+		{query: "ﾗﾝﾁ", want: []int{2}},
+		{query: "ランニング 朝", want: []int{3}},
+		{query: "水泳", want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got := searchIDs(t, s, Query{Terms: Terms(tt.query)})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemorySearcherMatchesPrefixTerms(t *testing.T) {
+	s := newSearcher(
+		newPost(1, "Morning running"),
+		newPost(2, "RUN 5km"),
+		newPost(3, "読書を続ける"),
+		newPost(4, "日記"),
+	)
+
+	tests := []struct {
+		query string
+		want  []int
+	}{
+		// 英数字の単語は前方一致で、大文字・小文字と全角・半角を区別しない
+		{query: "run", want: []int{2, 1}},
+		{query: "ＲＵＮＮ", want: []int{1}},
+		{query: "runs", want: []int{}},
+		{query: "5k", want: []int{2}},
+		// 1文字の漢字・かなは前方一致で、連続の途中と最後の文字にも一致する
+		{query: "続", want: []int{3}},
+		{query: "る", want: []int{3}},
+		{query: "記", want: []int{4}},
+		{query: "書", want: []int{3}},
+		{query: "走", want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got := searchIDs(t, s, Query{Terms: Terms(tt.query)})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemorySearcherVisibility(t *testing.T) {
+	published := newPost(1, "公開済み 目標")
+	draft := newPost(2, "下書き 目標")
+	draft.Published = false
+	ownDraft := newPost(3, "自分の下書き 目標")
+	ownDraft.UserID, ownDraft.Published = viewer, false
+	hidden := newPost(4, "非表示 目標")
+	hidden.Hidden = true
+	ownHidden := newPost(5, "自分の非表示 目標")
+	ownHidden.UserID, ownHidden.Hidden = viewer, true
+	fromBlocked := newPost(6, "ブロック 目標")
+	fromBlocked.UserID = blocked
+	goal := Document{Kind: KindGoals, ID: docID(7), UserID: author, Text: "目標のタイトル", CreatedAt: baseTime}
+	goalFromBlocked := Document{Kind: KindGoals, ID: docID(8), UserID: blocked, Text: "目標", CreatedAt: baseTime}
+	s := newSearcher(published, draft, ownDraft, hidden, ownHidden, fromBlocked, goal, goalFromBlocked)
+
+	tests := []struct {
+		name string
+		q    Query
+		want []int
+	}{
+		{name: "閲覧者", q: Query{Viewer: viewer, Blocked: []uuid.UUID{blocked}}, want: []int{5, 3, 1}},
+		{name: "ブロックなし", q: Query{Viewer: viewer}, want: []int{6, 5, 3, 1}},
+		{name: "作成者", q: Query{Viewer: author}, want: []int{6, 4, 2, 1}},
+		{name: "目標", q: Query{Kind: KindGoals, Viewer: viewer, Blocked: []uuid.UUID{blocked}}, want: []int{7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.q.Terms = Terms("目標")
+			got := searchIDs(t, s, tt.q)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Search() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// 未認証の閲覧者は公開済みの投稿のみ
+	hits, err := s.Search(context.Background(), Query{Kind: KindPosts, Terms: Terms("目標"), Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hitNumbers(hits); !slices.Equal(got, []int{6, 1}) {
+		t.Errorf("Search() without viewer = %v, want %v", got, []int{6, 1})
+	}
+}
+
+func TestMemorySearcherFilters(t *testing.T) {
+	other := newPost(2, "習慣")
+	other.UserID = viewer
+	otherGoal := newPost(3, "習慣")
+	otherGoal.GoalID = goalB
+	s := newSearcher(newPost(1, "習慣"), other, otherGoal, newPost(4, "習慣"))
+
+	from, to := baseTime.Add(2*time.Minute), baseTime.Add(4*time.Minute)
+	tests := []struct {
+		name string
+		q    Query
+		want []int
+	}{
+		{name: "ユーザー", q: Query{UserID: &viewer}, want: []int{2}},
+		{name: "目標", q: Query{GoalID: &goalB}, want: []int{3}},
+		{name: "期間は終了日時を含まない", q: Query{From: &from, To: &to}, want: []int{3, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.q.Terms = Terms("習慣")
+			got := searchIDs(t, s, tt.q)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Search() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemorySearcherKeysetPaging(t *testing.T) {
+	var docs []Document
+	for n := 1; n <= 7; n++ {
+		docs = append(docs, newPost(n, "ページング"))
+	}
+	// 同じ作成日時の文書はIDの降順に並ぶ
+	docs[4].CreatedAt = docs[3].CreatedAt
+	docs[5].CreatedAt = docs[3].CreatedAt
+	unrelated := newPost(8, "関係ない")
+	draft := newPost(9, "ページング")
+	draft.Published = false
+	s := newSearcher(append(docs, unrelated, draft)...)
+	want := []int{7, 6, 5, 4, 3, 2, 1}
+
+	t.Run("Cursor", func(t *testing.T) {
+		var got []int
+		q := Query{Kind: KindPosts, Terms: Terms("ページング"), Viewer: viewer, Limit: 3}
+		for range len(want) {
+			hits, err := s.Search(context.Background(), q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(hits) == 0 {
+				break
+			}
+			got = append(got, hitNumbers(hits)...)
+			last := hits[len(hits)-1]
+			q.Cursor = &cursor.Position{Time: last.CreatedAt, ID: last.ID}
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("pages = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("After", func(t *testing.T) {
+		var got []int
+		q := Query{Kind: KindPosts, Terms: Terms("ページング"), Viewer: viewer, Limit: 2}
+		for range len(want) {
+			hits, err := s.Search(context.Background(), q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(hits) == 0 {
+				break
+			}
+			got = append(got, hitNumbers(hits)...)
+			q.After = &hits[len(hits)-1].ID
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("pages = %v, want %v", got, want)
+		}
+	})
+
+	// 存在しない文書と、閲覧者に表示されない下書きは位置として使えない
+	for name, after := range map[string]uuid.UUID{
+		"存在しない":  uuid.New(),
+		"閲覧できない": draft.ID,
+	} {
+		t.Run("After/"+name, func(t *testing.T) {
+			_, err := s.Search(context.Background(), Query{Kind: KindPosts, Terms: Terms("ページング"), Viewer: viewer, After: &after, Limit: 10})
+			if !errors.Is(err, ErrUnknownCursor) {
+				t.Errorf("Search() error = %v, want %v", err, ErrUnknownCursor)
+			}
+		})
+	}
+}
+
+func TestMemorySearcherSnippets(t *testing.T) {
+	long := strings.Repeat("あ", 100) + "ランニング" + strings.Repeat("い", 100)
+	tests := []struct {
+		name  string
+		text  string
+		query string
+		want  string
+	}{
+		{
+			name:  "一致した箇所を囲む",
+			text:  "毎朝ランニングを続ける",
+			query: "ランニング",
+			want:  "毎朝<mark>ランニング</mark>を続ける",
+		},
+		{
+			name:  "全角・大文字の本文も元の表記のまま囲む",
+			text:  "ＲＵＮＮＩＮＧ and Running",
+			query: "run",
+			want:  "<mark>ＲＵＮ</mark>ＮＩＮＧ and <mark>Run</mark>ning",
+		},
+		{
+			name:  "本文のHTMLはエスケープする",
+			text:  "<b>running</b> & more",
+			query: "running",
+			want:  "&lt;b&gt;<mark>running</mark>&lt;/b&gt; &amp; more",
+		},
+		{
+			name:  "長い本文は一致した箇所の周辺を切り出す",
+			text:  long,
+			query: "ランニング",
+			want:  "…" + strings.Repeat("あ", 30) + "<mark>ランニング</mark>" + strings.Repeat("い", 85) + "…",
+		},
+		{
+			name:  "改行は空白にする",
+			text:  "今日も\nランニング",
+			query: "ランニング",
+			want:  "今日も <mark>ランニング</mark>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newSearcher(newPost(1, tt.text))
+			hits, err := s.Search(context.Background(), Query{Kind: KindPosts, Terms: Terms(tt.query), Viewer: viewer, Limit: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(hits) != 1 {
+				t.Fatalf("Search(%q) returned %d hits, want 1", tt.query, len(hits))
+			}
+			if hits[0].Snippet != tt.want {
+				t.Errorf("Snippet = %q, want %q", hits[0].Snippet, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemorySearcherPutReplacesAndDeleteRemoves(t *testing.T) {
+	s := newSearcher(newPost(1, "ランニング"))
+	s.Put(newPost(1, "読書"))
+	if got := searchIDs(t, s, Query{Terms: Terms("ランニング")}); len(got) != 0 {
+		t.Errorf("Search() after Put = %v, want none", got)
+	}
+	if got := searchIDs(t, s, Query{Terms: Terms("読書")}); !slices.Equal(got, []int{1}) {
+		t.Errorf("Search() after Put = %v, want [1]", got)
+	}
+
+	s.Delete(docID(1))
+	if got := searchIDs(t, s, Query{Terms: Terms("読書")}); len(got) != 0 {
+		t.Errorf("Search() after Delete = %v, want none", got)
+	}
+}
+
+func TestMemorySearcherUnsupportedKind(t *testing.T) {
+	_, err := NewMemorySearcher().Search(context.Background(), Query{Kind: "users", Limit: 10})
+	if !errors.Is(err, ErrUnsupportedKind) {
+		t.Errorf("Search() error = %v, want %v", err, ErrUnsupportedKind)
+	}
+}
@@ -0,0 +1,182 @@
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"backend/ent"
+	"backend/ent/goal"
+	"backend/ent/post"
+	"backend/ent/predicate"
+	"backend/ent/user"
+	"backend/internal/cursor"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// PostgresSearcher は投稿・目標のtsvector列とGINインデックスを使って検索します。
+type PostgresSearcher struct {
+	client *ent.Client
+}
+
+// NewPostgresSearcher は新しいPostgresSearcherインスタンスを作成します。
+func NewPostgresSearcher(client *ent.Client) *PostgresSearcher {
+	return &PostgresSearcher{client: client}
+}
+
+// Search は検索条件に一致する投稿または目標を作成日時の新しい順に返します。
+func (s *PostgresSearcher) Search(ctx context.Context, q Query) ([]Hit, error) {
+	switch q.Kind {
+	case KindPosts:
+		return s.searchPosts(ctx, q)
+	case KindGoals:
+		return s.searchGoals(ctx, q)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedKind, q.Kind)
+	}
+}
+
+func (s *PostgresSearcher) searchPosts(ctx context.Context, q Query) ([]Hit, error) {
+	visible := []predicate.Post{
+		post.Or(
+			post.StatusEQ(post.StatusPublished),
+			post.HasUserWith(user.ID(q.Viewer)),
+		),
+		post.Or(
+			post.HiddenAtIsNil(),
+			post.HasUserWith(user.ID(q.Viewer)),
+		),
+	}
+	if len(q.Blocked) > 0 {
+		visible = append(visible, post.Not(post.HasUserWith(user.IDIn(q.Blocked...))))
+	}
+
+	query := s.client.Post.Query().
+		Where(
+			matchVector(post.FieldSearchVector, TSQuery(q.Terms)),
+			post.And(visible...),
+		)
+	if q.UserID != nil {
+		query.Where(post.HasUserWith(user.ID(*q.UserID)))
+	}
+	if q.GoalID != nil {
+		query.Where(post.HasGoalWith(goal.ID(*q.GoalID)))
+	}
+	if q.From != nil {
+		query.Where(post.CreatedAtGTE(*q.From))
+	}
+	if q.To != nil {
+		query.Where(post.CreatedAtLT(*q.To))
+	}
+	after := q.Cursor
+	if q.After != nil {
+		p, err := s.client.Post.Query().
+			Where(post.ID(*q.After), post.And(visible...)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, ErrUnknownCursor
+		}
+		if err != nil {
+			return nil, err
+		}
+		after = &cursor.Position{Time: p.CreatedAt, ID: p.ID}
+	}
+	if after != nil {
+		query.Where(post.Or(
+			post.CreatedAtLT(after.Time),
+			post.And(post.CreatedAt(after.Time), post.IDLT(after.ID)),
+		))
+	}
+
+	posts, err := query.
+		Select(post.FieldID, post.FieldContent, post.FieldCreatedAt).
+		Order(post.ByCreatedAt(sql.OrderDesc()), post.ByID(sql.OrderDesc())).
+		Limit(q.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Hit, 0, len(posts))
+	for _, p := range posts {
+		res = append(res, Hit{
+			ID:        p.ID,
+			CreatedAt: p.CreatedAt,
+			Snippet:   Snippet(p.Content, q.Terms),
+		})
+	}
+	return res, nil
+}
+
+func (s *PostgresSearcher) searchGoals(ctx context.Context, q Query) ([]Hit, error) {
+	// 目標は誰でも閲覧できるため、ブロック関係のみを考慮する
+	visible := func(*sql.Selector) {}
+	if len(q.Blocked) > 0 {
+		visible = goal.Not(goal.HasUserWith(user.IDIn(q.Blocked...)))
+	}
+
+	query := s.client.Goal.Query().
+		Where(
+			matchVector(goal.FieldSearchVector, TSQuery(q.Terms)),
+			visible,
+		)
+	if q.UserID != nil {
+		query.Where(goal.HasUserWith(user.ID(*q.UserID)))
+	}
+	if q.From != nil {
+		query.Where(goal.CreatedAtGTE(*q.From))
+	}
+	if q.To != nil {
+		query.Where(goal.CreatedAtLT(*q.To))
+	}
+	after := q.Cursor
+	if q.After != nil {
+		g, err := s.client.Goal.Query().
+			Where(goal.ID(*q.After), visible).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, ErrUnknownCursor
+		}
+		if err != nil {
+			return nil, err
+		}
+		after = &cursor.Position{Time: g.CreatedAt, ID: g.ID}
+	}
+	if after != nil {
+		query.Where(goal.Or(
+			goal.CreatedAtLT(after.Time),
+			goal.And(goal.CreatedAt(after.Time), goal.IDLT(after.ID)),
+		))
+	}
+
+	goals, err := query.
+		Select(goal.FieldID, goal.FieldTitle, goal.FieldCreatedAt).
+		Order(goal.ByCreatedAt(sql.OrderDesc()), goal.ByID(sql.OrderDesc())).
+		Limit(q.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Hit, 0, len(goals))
+	for _, g := range goals {
+		res = append(res, Hit{
+			ID:        g.ID,
+			CreatedAt: g.CreatedAt,
+			Snippet:   Snippet(g.Title, q.Terms),
+		})
+	}
+	return res, nil
+}
+
+// matchVector はtsvector列がtsqueryに一致する条件を返します。
+func matchVector(column, tsquery string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString(s.C(column)).
+				WriteString(" @@ ").
+				Arg(tsquery).
+				WriteString("::tsquery")
+		}))
+	}
+}
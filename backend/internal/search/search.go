@@ -0,0 +1,66 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/internal/cursor"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrUnknownCursor はページングの基準に指定した検索結果が見つからない場合のエラーです。
+	ErrUnknownCursor = errors.New("unknown cursor")
+	// ErrUnsupportedKind は検索対象の種類が不正な場合のエラーです。
+	ErrUnsupportedKind = errors.New("unsupported search kind")
+)
+
+// Kind は検索対象の種類です。
+type Kind string
+
+const (
+	// KindPosts は投稿を検索します。
+	KindPosts Kind = "posts"
+	// KindGoals は目標を検索します。
+	KindGoals Kind = "goals"
+)
+
+// Query は検索条件です。
+type Query struct {
+	Kind Kind
+	// Terms はTermsで作成した検索条件の語です。すべての語を含むものが一致します。
+	Terms []Term
+	// Viewer は検索するユーザーのIDです (未認証の場合はuuid.Nil)。
+	// 投稿は公開済みのものと、閲覧者自身の下書き・予約投稿が対象です。
+	// 運営により非表示にされた投稿は閲覧者自身のもののみが対象です。
+	Viewer uuid.UUID
+	// Blocked は閲覧者とブロック関係にあり、結果から除くユーザーのIDです。
+	Blocked []uuid.UUID
+	// UserID は作成したユーザーで絞り込みます。
+	UserID *uuid.UUID
+	// GoalID は投稿の目標で絞り込みます (投稿の検索のみ)。
+	GoalID *uuid.UUID
+	// From と To は作成日時で [From, To) に絞り込みます。
+	From *time.Time
+	To   *time.Time
+	// After はこのIDの検索結果より後 (古い) の結果を返します。
+	After *uuid.UUID
+	// Cursor はこの位置より後 (古い) の結果を返します。Afterと同時には指定できません。
+	Cursor *cursor.Position
+	Limit  int
+}
+
+// Hit は検索結果の1件です。結果は作成日時の新しい順に並びます。
+type Hit struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	// Snippet は一致した箇所を<mark>で囲んだ本文の抜粋です (HTMLとしてエスケープ済み)。
+	Snippet string
+}
+
+// Searcher は全文検索を行うインターフェースです。
+type Searcher interface {
+	Search(ctx context.Context, q Query) ([]Hit, error)
+}
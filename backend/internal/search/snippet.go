@@ -0,0 +1,89 @@
+package search
+
+import (
+	"html"
+	"slices"
+	"strings"
+)
+
+const (
+	// snippetLength はスニペットの最大文字数です。
+	snippetLength = 120
+	// snippetContext はスニペットで最初に一致した箇所より前に含める文字数です。
+	snippetContext = 30
+)
+
+// span は文字列中の [start, end) の文字の範囲です。
+type span struct {
+	start, end int
+}
+
+// Snippet は本文のうち検索条件の語に一致した箇所の周辺を切り出し、一致した箇所を<mark>で囲みます。
+// 戻り値はHTMLとしてエスケープ済みで、<mark>と</mark>以外のタグは含みません。
+func Snippet(text string, terms []Term) string {
+	runes := []rune(text)
+	normalized := make([]rune, len(runes))
+	for i, r := range runes {
+		normalized[i] = normalize(r)
+		if r == '\n' || r == '\r' || r == '\t' {
+			runes[i] = ' '
+		}
+	}
+
+	var spans []span
+	for _, t := range terms {
+		term := []rune(t.Text)
+		for i := 0; i+len(term) <= len(normalized); i++ {
+			if slices.Equal(normalized[i:i+len(term)], term) {
+				spans = append(spans, span{i, i + len(term)})
+			}
+		}
+	}
+	spans = mergeSpans(spans)
+
+	start := 0
+	if len(spans) > 0 {
+		start = max(0, spans[0].start-snippetContext)
+	}
+	end := min(len(runes), start+snippetLength)
+	// 末尾に余裕がある場合は、前方の文脈を増やして長さを揃える
+	start = max(0, min(start, end-snippetLength))
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, s := range spans {
+		if s.end <= start || s.start >= end {
+			continue
+		}
+		s.start, s.end = max(s.start, start), min(s.end, end)
+		b.WriteString(html.EscapeString(string(runes[pos:s.start])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(runes[s.start:s.end])))
+		b.WriteString("</mark>")
+		pos = s.end
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// mergeSpans は範囲を開始位置の順に並べ、重なる範囲や隣接する範囲をまとめます。
+func mergeSpans(spans []span) []span {
+	slices.SortFunc(spans, func(a, b span) int {
+		return a.start - b.start
+	})
+	var res []span
+	for _, s := range spans {
+		if n := len(res); n > 0 && s.start <= res[n-1].end {
+			res[n-1].end = max(res[n-1].end, s.end)
+			continue
+		}
+		res = append(res, s)
+	}
+	return res
+}
@@ -0,0 +1,192 @@
+// Package search は投稿と目標の全文検索を提供します。
+//
+// 日本語は単語の区切りが空白で表されないため、漢字・かな・ハングルの連続は2文字ずつの組 (バイグラム) に、
+// それ以外の英数字の連続は1つの単語に分割して索引を作成します。
+// 分割はアプリケーション側で行い、PostgreSQLにはtsvector/tsqueryのテキスト表現として渡します。
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"backend/ent/schema/types"
+
+	"golang.org/x/text/width"
+)
+
+// maxWordLength は索引に含める英数字の単語の最大文字数です。これより長い単語は含めません。
+const maxWordLength = 64
+
+// Term は検索語から作成した検索条件の1語です。
+type Term struct {
+	Text string
+	// Prefix は前方一致で検索するかです。
+	// 英数字の単語と、1文字だけの漢字・かなは前方一致で検索します。
+	Prefix bool
+}
+
+// Vector は本文から索引に保存するtsvectorを作成します。
+func Vector(text string) types.TSVector {
+	tokens := Tokenize(text)
+	seen := make(map[string]struct{}, len(tokens))
+	var b strings.Builder
+	for _, t := range tokens {
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		writeLexeme(&b, t)
+	}
+	return types.TSVector(b.String())
+}
+
+// Tokenize は文字列を索引の字句に分割します。
+// 漢字・かなの連続はバイグラムと最後の1文字に、英数字の連続は小文字の単語になります。
+func Tokenize(text string) []string {
+	var tokens []string
+	forEachRun(text, func(run []rune, cjk bool) {
+		if !cjk {
+			if len(run) <= maxWordLength {
+				tokens = append(tokens, string(run))
+			}
+			return
+		}
+		for i := 0; i+1 < len(run); i++ {
+			tokens = append(tokens, string(run[i:i+2]))
+		}
+		// 1文字での前方一致検索で、連続の最後の文字にも一致させる
+		tokens = append(tokens, string(run[len(run)-1:]))
+	})
+	return tokens
+}
+
+// Terms は検索語を検索条件の語に分割します。すべての語を含む文書が一致します。
+func Terms(query string) []Term {
+	var terms []Term
+	seen := make(map[Term]struct{})
+	add := func(t Term) {
+		if _, ok := seen[t]; !ok {
+			seen[t] = struct{}{}
+			terms = append(terms, t)
+		}
+	}
+	forEachRun(query, func(run []rune, cjk bool) {
+		switch {
+		case !cjk:
+			if len(run) <= maxWordLength {
+				add(Term{Text: string(run), Prefix: true})
+			}
+		case len(run) == 1:
+			add(Term{Text: string(run), Prefix: true})
+		default:
+			for i := 0; i+1 < len(run); i++ {
+				add(Term{Text: string(run[i : i+2])})
+			}
+		}
+	})
+	return terms
+}
+
+// TSQuery は検索条件の語からtsqueryのテキスト表現を作成します。
+func TSQuery(terms []Term) string {
+	var b strings.Builder
+	for i, t := range terms {
+		if i > 0 {
+			b.WriteString(" & ")
+		}
+		writeLexeme(&b, t.Text)
+		if t.Prefix {
+			b.WriteString(":*")
+		}
+	}
+	return b.String()
+}
+
+// writeLexeme は字句を引用符で囲んでtsvector/tsqueryのテキスト表現に書き込みます。
+func writeLexeme(b *strings.Builder, s string) {
+	b.WriteByte('\'')
+	for _, r := range s {
+		switch r {
+		case '\'':
+			b.WriteString("''")
+		case '\\':
+			b.WriteString(`\\`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('\'')
+}
+
+// forEachRun は正規化した文字列を、漢字・かなの連続と英数字の連続に分けてfnを呼び出します。
+func forEachRun(text string, fn func(run []rune, cjk bool)) {
+	var run []rune
+	runCJK := false
+	flush := func() {
+		if len(run) > 0 {
+			fn(run, runCJK)
+			run = nil
+		}
+	}
+	for _, r := range text {
+		r = normalize(r)
+		switch {
+		case isCJK(r):
+			if !runCJK {
+				flush()
+			}
+			runCJK = true
+			run = append(run, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if runCJK {
+				flush()
+			}
+			runCJK = false
+			run = append(run, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+}
+
+// normalize は全角英数字を半角に、半角カタカナを全角にし、小文字にします。
+// 1文字ずつ変換するため、変換前後で文字の位置は変わりません。
+func normalize(r rune) rune {
+	if folded := width.LookupRune(r).Folded(); folded != 0 {
+		r = folded
+	}
+	return unicode.ToLower(r)
+}
+
+// isCJK は文字がバイグラムで分割する文字 (漢字・ひらがな・カタカナ・ハングル) かを返します。
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) ||
+		r == 'ー' || r == '々'
+}
+
+// matches は字句の集合が検索条件のすべての語を含むかを返します。
+func matches(tokens map[string]struct{}, terms []Term) bool {
+	for _, t := range terms {
+		if _, ok := tokens[t.Text]; ok {
+			continue
+		}
+		if !t.Prefix {
+			return false
+		}
+		found := false
+		for token := range tokens {
+			if strings.HasPrefix(token, t.Text) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package spamfilter
+
+import (
+	"strings"
+	"time"
+
+	"backend/internal/other"
+)
+
+// Config は迷惑行為フィルタの設定を保持します。
+type Config struct {
+	// RejectWords は含まれていると作成を拒否する語です。
+	RejectWords []string
+	// HoldWords は含まれていると保留にする語です。
+	HoldWords []string
+	// MaxLinks は1件の本文に含められるURLの数です。超えると保留にします。
+	MaxLinks int
+	// DuplicateWindow は重複を判定する期間です。
+	DuplicateWindow time.Duration
+	// DuplicateOthersThreshold は保留にする、他のユーザーによる同じ本文の数です。
+	DuplicateOthersThreshold int
+	// NewAccountAge はこの期間内に作成されたアカウントを新しいアカウントとして扱います。
+	NewAccountAge time.Duration
+	// NewAccountMaxPerHour は新しいアカウントが1時間に作成できる投稿・コメントの数 (種類ごと) です。
+	NewAccountMaxPerHour int
+}
+
+// NewConfig は環境変数から迷惑行為フィルタの設定を作成します。
+func NewConfig() *Config {
+	maxLinks := other.GetEnvInt("SPAM_MAX_LINKS", 3)
+	if maxLinks < 0 {
+		maxLinks = 3
+	}
+	duplicateWindow, err := time.ParseDuration(other.GetEnv("SPAM_DUPLICATE_WINDOW", "24h"))
+	if err != nil || duplicateWindow <= 0 {
+		duplicateWindow = 24 * time.Hour
+	}
+	duplicateOthers := other.GetEnvInt("SPAM_DUPLICATE_OTHERS_THRESHOLD", 3)
+	if duplicateOthers <= 0 {
+		duplicateOthers = 3
+	}
+	newAccountAge, err := time.ParseDuration(other.GetEnv("SPAM_NEW_ACCOUNT_AGE", "72h"))
+	if err != nil || newAccountAge < 0 {
+		newAccountAge = 72 * time.Hour
+	}
+	maxPerHour := other.GetEnvInt("SPAM_NEW_ACCOUNT_MAX_PER_HOUR", 5)
+	if maxPerHour <= 0 {
+		maxPerHour = 5
+	}
+
+	return &Config{
+		RejectWords:              splitWords(other.GetEnv("SPAM_REJECT_WORDS", "")),
+		HoldWords:                splitWords(other.GetEnv("SPAM_HOLD_WORDS", "")),
+		MaxLinks:                 maxLinks,
+		DuplicateWindow:          duplicateWindow,
+		DuplicateOthersThreshold: duplicateOthers,
+		NewAccountAge:            newAccountAge,
+		NewAccountMaxPerHour:     maxPerHour,
+	}
+}
+
+// NewDefaultPipeline は設定に従って組み込みの規則をすべて適用するPipelineを作成します。
+// 本文だけで判定できる規則を先に、データベースを参照する規則を後に適用します。
+func NewDefaultPipeline(config *Config, history History) *Pipeline {
+	return NewPipeline(
+		NewWordListRule(config.RejectWords, config.HoldWords),
+		NewLinkLimitRule(config.MaxLinks),
+		NewVelocityRule(history, config.NewAccountAge, config.NewAccountMaxPerHour),
+		NewDuplicateRule(history, config.DuplicateWindow, config.DuplicateOthersThreshold),
+	)
+}
+
+// splitWords はカンマ区切りの語の一覧を分割します。
+func splitWords(s string) []string {
+	var words []string
+	for _, w := range strings.Split(s, ",") {
+		if w = strings.TrimSpace(w); w != "" {
+			words = append(words, w)
+		}
+	}
+	return words
+}
@@ -0,0 +1,112 @@
+// Package spamfilter は作成・編集される投稿・コメントを迷惑行為の規則で判定します。
+package spamfilter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Verdict は判定結果です。値が大きいほど厳しい判定です。
+type Verdict int
+
+const (
+	// Allow はそのまま公開します。
+	Allow Verdict = iota
+	// Hold は作成者本人以外には非表示にして、モデレーターの確認を待ちます。
+	Hold
+	// Reject は作成を拒否します。
+	Reject
+)
+
+// String は判定結果の名前を返します。
+func (v Verdict) String() string {
+	switch v {
+	case Allow:
+		return "allow"
+	case Hold:
+		return "hold"
+	case Reject:
+		return "reject"
+	default:
+		return fmt.Sprintf("Verdict(%d)", int(v))
+	}
+}
+
+// Kind は判定する内容の種類です。
+type Kind string
+
+const (
+	// KindPost は投稿です。
+	KindPost Kind = "post"
+	// KindComment はコメントです。
+	KindComment Kind = "comment"
+)
+
+// Content は判定する投稿・コメントです。
+type Content struct {
+	Kind Kind
+	// UserID は作成するユーザーのIDです。
+	UserID uuid.UUID
+	// EditingID は編集する投稿・コメントのIDです。作成の場合はuuid.Nilです。
+	EditingID uuid.UUID
+	// AccountCreatedAt は作成するユーザーのアカウントの作成日時です。
+	AccountCreatedAt time.Time
+	// Text は本文です。
+	Text string
+}
+
+// Result は規則による判定結果です。
+type Result struct {
+	Verdict Verdict
+	// Rule は判定した規則の名前です (Allowの場合は空)。
+	Rule string
+	// Reason は判定の理由です (Allowの場合は空)。
+	Reason string
+}
+
+// Rule は1つの判定規則です。
+type Rule interface {
+	// Name は規則の名前を返します。判定結果とモデレーションキューに記録されます。
+	Name() string
+	// Check は内容を判定します。
+	Check(ctx context.Context, c Content) (Result, error)
+}
+
+// Filter は投稿・コメントを判定するインターフェースです。
+type Filter interface {
+	Check(ctx context.Context, c Content) (Result, error)
+}
+
+// Pipeline は複数の規則を順に適用するFilterです。
+// 最も厳しい判定を結果とし、拒否された時点で残りの規則は適用しません。
+type Pipeline struct {
+	rules []Rule
+}
+
+// NewPipeline は新しいPipelineインスタンスを作成します。
+func NewPipeline(rules ...Rule) *Pipeline {
+	return &Pipeline{rules: rules}
+}
+
+// Check はすべての規則で内容を判定します。
+func (p *Pipeline) Check(ctx context.Context, c Content) (Result, error) {
+	res := Result{Verdict: Allow}
+	for _, rule := range p.rules {
+		r, err := rule.Check(ctx, c)
+		if err != nil {
+			return Result{}, fmt.Errorf("spam rule %s: %w", rule.Name(), err)
+		}
+		if r.Verdict <= res.Verdict {
+			continue
+		}
+		r.Rule = rule.Name()
+		res = r
+		if res.Verdict == Reject {
+			break
+		}
+	}
+	return res, nil
+}
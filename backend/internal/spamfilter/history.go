@@ -0,0 +1,114 @@
+package spamfilter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/ent"
+	"backend/ent/comment"
+	"backend/ent/post"
+	"backend/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// History は重複・投稿頻度の判定に使う、最近作成された投稿・コメントを取得するインターフェースです。
+type History interface {
+	// RecentTexts はユーザーがsince以降に作成した本文を新しい順に最大limit件返します。
+	// excludeIDがuuid.Nilでなければ、そのIDのもの (編集中のもの) は除きます。
+	RecentTexts(ctx context.Context, kind Kind, userID, excludeID uuid.UUID, since time.Time, limit int) ([]string, error)
+	// CountByUser はユーザーがsince以降に作成した数を返します。
+	CountByUser(ctx context.Context, kind Kind, userID uuid.UUID, since time.Time) (int, error)
+	// CountSameTextByOthers はユーザー以外がsince以降に作成した、Normalizeで揃えた本文が一致するものの数を返します。
+	CountSameTextByOthers(ctx context.Context, kind Kind, userID uuid.UUID, text string, since time.Time) (int, error)
+}
+
+// EntHistory はデータベースの投稿・コメントを参照するHistoryです。
+type EntHistory struct {
+	client *ent.Client
+}
+
+// NewEntHistory は新しいEntHistoryインスタンスを作成します。
+func NewEntHistory(client *ent.Client) *EntHistory {
+	return &EntHistory{client: client}
+}
+
+// RecentTexts はユーザーがsince以降に作成した本文を新しい順に最大limit件返します。
+func (h *EntHistory) RecentTexts(ctx context.Context, kind Kind, userID, excludeID uuid.UUID, since time.Time, limit int) ([]string, error) {
+	switch kind {
+	case KindPost:
+		return h.client.Post.Query().
+			Where(
+				post.HasUserWith(user.ID(userID)),
+				post.CreatedAtGTE(since),
+				post.IDNEQ(excludeID),
+			).
+			Order(post.ByCreatedAt(sql.OrderDesc())).
+			Limit(limit).
+			Select(post.FieldContent).
+			Strings(ctx)
+	case KindComment:
+		return h.client.Comment.Query().
+			Where(
+				comment.HasUserWith(user.ID(userID)),
+				comment.CreatedAtGTE(since),
+				comment.IDNEQ(excludeID),
+			).
+			Order(comment.ByCreatedAt(sql.OrderDesc())).
+			Limit(limit).
+			Select(comment.FieldContent).
+			Strings(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported kind %q", kind)
+	}
+}
+
+// CountByUser はユーザーがsince以降に作成した数を返します。
+func (h *EntHistory) CountByUser(ctx context.Context, kind Kind, userID uuid.UUID, since time.Time) (int, error) {
+	switch kind {
+	case KindPost:
+		return h.client.Post.Query().
+			Where(
+				post.HasUserWith(user.ID(userID)),
+				post.CreatedAtGTE(since),
+			).
+			Count(ctx)
+	case KindComment:
+		return h.client.Comment.Query().
+			Where(
+				comment.HasUserWith(user.ID(userID)),
+				comment.CreatedAtGTE(since),
+			).
+			Count(ctx)
+	default:
+		return 0, fmt.Errorf("unsupported kind %q", kind)
+	}
+}
+
+// CountSameTextByOthers はユーザー以外がsince以降に作成した、Normalizeで揃えた本文が一致するものの数を返します。
+// 本文そのものではなく、保存時に記録した本文のハッシュ (ContentHash) で比較します。
+func (h *EntHistory) CountSameTextByOthers(ctx context.Context, kind Kind, userID uuid.UUID, text string, since time.Time) (int, error) {
+	hash := ContentHash(text)
+	switch kind {
+	case KindPost:
+		return h.client.Post.Query().
+			Where(
+				post.ContentHash(hash),
+				post.CreatedAtGTE(since),
+				post.Not(post.HasUserWith(user.ID(userID))),
+			).
+			Count(ctx)
+	case KindComment:
+		return h.client.Comment.Query().
+			Where(
+				comment.ContentHash(hash),
+				comment.CreatedAtGTE(since),
+				comment.Not(comment.HasUserWith(user.ID(userID))),
+			).
+			Count(ctx)
+	default:
+		return 0, fmt.Errorf("unsupported kind %q", kind)
+	}
+}
@@ -0,0 +1,38 @@
+package spamfilter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize は表記の揺れで規則を回避されないよう、本文を比較用の形に揃えます。
+// 全角英数字・半角カタカナはNFKCで揃え、カタカナはひらがなに、英字は小文字にし、
+// 空白とゼロ幅文字などの書式文字は取り除きます。
+func Normalize(s string) string {
+	s = norm.NFKC.String(s)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if unicode.IsSpace(r) || unicode.Is(unicode.Cf, r) {
+			continue
+		}
+		// カタカナ (ァ〜ヶ) をひらがな (ぁ〜ゖ) に揃える
+		if r >= 'ァ' && r <= 'ヶ' {
+			r -= 'ァ' - 'ぁ'
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// ContentHash はNormalizeで揃えた本文のSHA-256ハッシュを16進数の文字列で返します。
+// 投稿・コメントの保存時に記録しておき、他のユーザーによる同じ本文をインデックスで数えるために使います。
+func ContentHash(s string) string {
+	sum := sha256.Sum256([]byte(Normalize(s)))
+	return hex.EncodeToString(sum[:])
+}
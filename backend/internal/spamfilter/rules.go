@@ -0,0 +1,175 @@
+package spamfilter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/richtext"
+
+	"github.com/google/uuid"
+)
+
+// WordListRule は禁止語を含む内容を判定します。
+// 語と本文はどちらもNormalizeで揃えてから比較するため、全角・半角やカタカナ・ひらがなの違いでは回避できません。
+type WordListRule struct {
+	rejectWords []string
+	holdWords   []string
+}
+
+// NewWordListRule は新しいWordListRuleインスタンスを作成します。
+// rejectWordsを含む内容は拒否し、holdWordsを含む内容は保留にします。
+func NewWordListRule(rejectWords, holdWords []string) *WordListRule {
+	return &WordListRule{
+		rejectWords: normalizeWords(rejectWords),
+		holdWords:   normalizeWords(holdWords),
+	}
+}
+
+// Name は規則の名前を返します。
+func (r *WordListRule) Name() string {
+	return "word_list"
+}
+
+// Check は本文が禁止語を含むかを判定します。
+func (r *WordListRule) Check(_ context.Context, c Content) (Result, error) {
+	text := Normalize(c.Text)
+	for _, w := range r.rejectWords {
+		if strings.Contains(text, w) {
+			return Result{Verdict: Reject, Reason: "contains a blocked word"}, nil
+		}
+	}
+	for _, w := range r.holdWords {
+		if strings.Contains(text, w) {
+			return Result{Verdict: Hold, Reason: fmt.Sprintf("contains a watched word %q", w)}, nil
+		}
+	}
+	return Result{Verdict: Allow}, nil
+}
+
+// normalizeWords は語をNormalizeで揃え、空になった語を除きます。
+func normalizeWords(words []string) []string {
+	res := make([]string, 0, len(words))
+	for _, w := range words {
+		if w = Normalize(w); w != "" {
+			res = append(res, w)
+		}
+	}
+	return res
+}
+
+// LinkLimitRule は本文のURLが多すぎる内容を保留にします。
+type LinkLimitRule struct {
+	max int
+}
+
+// NewLinkLimitRule は新しいLinkLimitRuleインスタンスを作成します。
+func NewLinkLimitRule(max int) *LinkLimitRule {
+	return &LinkLimitRule{max: max}
+}
+
+// Name は規則の名前を返します。
+func (r *LinkLimitRule) Name() string {
+	return "link_limit"
+}
+
+// Check は本文のURLの数を判定します。
+func (r *LinkLimitRule) Check(_ context.Context, c Content) (Result, error) {
+	if n := len(richtext.URLs(c.Text)); n > r.max {
+		return Result{Verdict: Hold, Reason: fmt.Sprintf("contains %d links (max %d)", n, r.max)}, nil
+	}
+	return Result{Verdict: Allow}, nil
+}
+
+// DuplicateRule は最近作成された内容と同じ内容を判定します。
+// 同じユーザーが同じ内容を繰り返す場合は拒否し、他のユーザーが同じ内容を一定数以上作成している場合は保留にします。
+type DuplicateRule struct {
+	history History
+	// window は比較の対象にする期間です。
+	window time.Duration
+	// othersThreshold は保留にする、他のユーザーによる同じ内容の数です。
+	othersThreshold int
+}
+
+// duplicateLookback は同じユーザーの内容を比較する最大件数です。
+const duplicateLookback = 50
+
+// NewDuplicateRule は新しいDuplicateRuleインスタンスを作成します。
+func NewDuplicateRule(history History, window time.Duration, othersThreshold int) *DuplicateRule {
+	return &DuplicateRule{
+		history:         history,
+		window:          window,
+		othersThreshold: othersThreshold,
+	}
+}
+
+// Name は規則の名前を返します。
+func (r *DuplicateRule) Name() string {
+	return "duplicate"
+}
+
+// Check は最近の内容との重複を判定します。
+func (r *DuplicateRule) Check(ctx context.Context, c Content) (Result, error) {
+	since := time.Now().Add(-r.window)
+	text := Normalize(c.Text)
+
+	recent, err := r.history.RecentTexts(ctx, c.Kind, c.UserID, c.EditingID, since, duplicateLookback)
+	if err != nil {
+		return Result{}, err
+	}
+	for _, t := range recent {
+		if Normalize(t) == text {
+			return Result{Verdict: Reject, Reason: "duplicate of your recent " + string(c.Kind)}, nil
+		}
+	}
+
+	n, err := r.history.CountSameTextByOthers(ctx, c.Kind, c.UserID, c.Text, since)
+	if err != nil {
+		return Result{}, err
+	}
+	if n >= r.othersThreshold {
+		return Result{Verdict: Hold, Reason: fmt.Sprintf("same text was posted by %d other accounts", n)}, nil
+	}
+	return Result{Verdict: Allow}, nil
+}
+
+// VelocityRule は作成されて間もないアカウントの投稿・コメントの頻度を制限します。
+type VelocityRule struct {
+	history History
+	// newAccountAge はこの期間内に作成されたアカウントを新しいアカウントとして扱います。
+	newAccountAge time.Duration
+	// maxPerHour は新しいアカウントが1時間に作成できる投稿・コメントの数 (種類ごと) です。
+	maxPerHour int
+}
+
+// NewVelocityRule は新しいVelocityRuleインスタンスを作成します。
+func NewVelocityRule(history History, newAccountAge time.Duration, maxPerHour int) *VelocityRule {
+	return &VelocityRule{
+		history:       history,
+		newAccountAge: newAccountAge,
+		maxPerHour:    maxPerHour,
+	}
+}
+
+// Name は規則の名前を返します。
+func (r *VelocityRule) Name() string {
+	return "velocity"
+}
+
+// Check は新しいアカウントが直近1時間に作成した数を判定します。編集は作成の数に含めません。
+func (r *VelocityRule) Check(ctx context.Context, c Content) (Result, error) {
+	now := time.Now()
+	if c.EditingID != uuid.Nil || now.Sub(c.AccountCreatedAt) >= r.newAccountAge {
+		return Result{Verdict: Allow}, nil
+	}
+
+	n, err := r.history.CountByUser(ctx, c.Kind, c.UserID, now.Add(-time.Hour))
+	if err != nil {
+		return Result{}, err
+	}
+	if n >= r.maxPerHour {
+		return Result{Verdict: Reject, Reason: fmt.Sprintf("new accounts can create up to %d %ss per hour", r.maxPerHour, c.Kind)}, nil
+	}
+	return Result{Verdict: Allow}, nil
+}
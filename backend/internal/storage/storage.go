@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"backend/internal/other"
+)
+
+var (
+	// ErrNotExist はオブジェクトが存在しない場合のエラーです。
+	ErrNotExist = errors.New("object does not exist")
+
+	// ErrInvalidName はオブジェクト名が不正な場合のエラーです。
+	ErrInvalidName = errors.New("invalid object name")
+)
+
+// Storage はアップロードされたファイルを保存するオブジェクトストレージのインターフェースです。
+// オブジェクト名は "images/{uuid}.jpg" のようなスラッシュ区切りのパスです。
+type Storage interface {
+	// Save はオブジェクトを保存します。同名のオブジェクトは上書きされます。
+	Save(ctx context.Context, name string, r io.Reader) error
+	// Open はオブジェクトを読み込みます。存在しない場合はErrNotExistを返します。
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+	// Delete はオブジェクトを削除します。存在しない場合は何もしません。
+	Delete(ctx context.Context, name string) error
+}
+
+// LocalStorage はローカルファイルシステムにオブジェクトを保存するStorageです。
+type LocalStorage struct {
+	dir string
+}
+
+// NewLocalStorage は新しいLocalStorageインスタンスを作成します。
+// 保存先ディレクトリは環境変数 STORAGE_DIR で指定します。
+func NewLocalStorage() (*LocalStorage, error) {
+	dir := other.GetEnv("STORAGE_DIR", "uploads")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &LocalStorage{
+		dir: dir,
+	}, nil
+}
+
+// Save はオブジェクトを一時ファイルに書き込んでから置き換えます。
+func (s *LocalStorage) Save(ctx context.Context, name string, r io.Reader) error {
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// Open はオブジェクトを読み込みます。
+func (s *LocalStorage) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	path, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotExist
+	}
+	return f, err
+}
+
+// Delete はオブジェクトを削除します。
+func (s *LocalStorage) Delete(ctx context.Context, name string) error {
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path はオブジェクト名を保存先ディレクトリ内のファイルパスに変換します。
+// ディレクトリの外を指す名前はErrInvalidNameになります。
+func (s *LocalStorage) path(name string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(name))
+	if name == "" || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	return filepath.Join(s.dir, clean), nil
+}
@@ -0,0 +1,261 @@
+// Package timeline はホームタイムラインを、投稿・リポストの作成時にフォロワーごとに書き込んでおく (fan-out-on-write) 処理を行います。
+package timeline
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"backend/ent"
+	"backend/ent/post"
+	"backend/ent/repost"
+	"backend/ent/user"
+	"backend/internal/other"
+	"backend/internal/outbox"
+
+	"github.com/google/uuid"
+)
+
+// Config はタイムラインの書き込みの設定を保持します。
+type Config struct {
+	// MaxEntries は1ユーザーのタイムラインに保持する最大件数です。これより古い範囲はフォローと投稿を結合して求めます。
+	MaxEntries int
+	// Workers は同時に書き込みを行うワーカーの数です。
+	Workers int
+	// QueueSize は書き込み待ちの処理を保持するキューの大きさです。
+	QueueSize int
+	// BatchSize は1回の一括作成で書き込む最大件数です。
+	BatchSize int
+	// EnqueueTimeout はキューが一杯の場合に空きを待つ最大時間です。
+	// 待っても空かない場合は書き込みを破棄し、対象のタイムラインを作成し直すよう印を付けます。
+	EnqueueTimeout time.Duration
+}
+
+// NewConfig は環境変数からタイムラインの書き込みの設定を作成します。
+func NewConfig() *Config {
+	maxEntries := other.GetEnvInt("TIMELINE_MAX_ENTRIES", 800)
+	if maxEntries <= 0 {
+		maxEntries = 800
+	}
+	workers := other.GetEnvInt("TIMELINE_FANOUT_WORKERS", 2)
+	if workers <= 0 {
+		workers = 2
+	}
+	queueSize := other.GetEnvInt("TIMELINE_FANOUT_QUEUE_SIZE", 1000)
+	if queueSize <= 0 {
+		queueSize = 1000
+	}
+	batchSize := other.GetEnvInt("TIMELINE_FANOUT_BATCH_SIZE", 1000)
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+	enqueueTimeout, err := time.ParseDuration(other.GetEnv("TIMELINE_FANOUT_ENQUEUE_TIMEOUT", "200ms"))
+	if err != nil || enqueueTimeout <= 0 {
+		enqueueTimeout = 200 * time.Millisecond
+	}
+
+	return &Config{
+		MaxEntries:     maxEntries,
+		Workers:        workers,
+		QueueSize:      queueSize,
+		BatchSize:      batchSize,
+		EnqueueTimeout: enqueueTimeout,
+	}
+}
+
+// Fanout はタイムラインの書き込みを非同期に依頼するインターフェースです。
+// 投稿の公開とフォロー・フォロー解除による書き込みは、変更と同じトランザクションで記録したドメインイベントから依頼します (Worker.HandleOutboxEvent)。
+// リポストは一意制約の衝突を無視して作成するためドメインイベントを記録しておらず、作成後にこのインターフェースで依頼します。
+type Fanout interface {
+	// Reposted はリポストをリポストしたユーザーと各フォロワーのタイムラインに書き込みます。
+	Reposted(repostID uuid.UUID)
+	// Rebuild はまだ作成していないユーザーのタイムラインを作成します。
+	Rebuild(userID uuid.UUID)
+}
+
+// task はキューに入れる書き込みの処理です。
+type task struct {
+	// key は重複を避ける処理の識別子です。空の場合は重複を確認しません。
+	key string
+	run func(ctx context.Context) error
+	// stale は処理を破棄した場合に、書き込まれなかったタイムラインに作成し直す印を付けます。
+	// nilの場合は何もしません。
+	stale func(ctx context.Context) error
+}
+
+// staleTimeout はタイムラインに作成し直す印を付ける処理にかける最大時間です。
+const staleTimeout = 5 * time.Second
+
+// Worker はキューに入った書き込みをStoreで実行します。
+// 投稿の作成などを待たせないよう、書き込みは呼び出し元とは別のゴルーチンで行います。
+type Worker struct {
+	config *Config
+	store  *Store
+	queue  chan task
+
+	mu sync.Mutex
+	// pending はキューに入っているか実行中の処理の識別子です。
+	pending map[string]struct{}
+}
+
+// NewWorker は新しいWorkerインスタンスを作成します。
+func NewWorker(config *Config, client *ent.Client) *Worker {
+	return &Worker{
+		config:  config,
+		store:   NewStore(config, client),
+		queue:   make(chan task, config.QueueSize),
+		pending: make(map[string]struct{}),
+	}
+}
+
+// PostPublished は投稿の書き込みをキューに追加します。
+func (w *Worker) PostPublished(postID uuid.UUID) {
+	w.enqueue(task{
+		key: "post:" + postID.String(),
+		run: func(ctx context.Context) error { return w.store.PostPublished(ctx, postID) },
+		stale: func(ctx context.Context) error {
+			return w.store.MarkStale(ctx, user.Or(
+				user.HasPostsWith(post.ID(postID)),
+				user.HasFollowingWith(user.HasPostsWith(post.ID(postID))),
+			))
+		},
+	})
+}
+
+// HandleOutboxEvent は投稿の公開とフォロー・フォロー解除のドメインイベントから、タイムラインの書き込み・削除をキューに追加します。
+// キューが一杯で破棄した場合もタイムラインに作成し直す印を付けるため、イベントの配信は失敗にしません。
+func (w *Worker) HandleOutboxEvent(ctx context.Context, e outbox.Event) error {
+	switch e.Type {
+	case outbox.TypePostPublished:
+		var p outbox.PostPayload
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return err
+		}
+		w.PostPublished(p.PostID)
+	case outbox.TypeFollowCreated, outbox.TypeFollowDeleted:
+		var f outbox.FollowPayload
+		if err := json.Unmarshal(e.Payload, &f); err != nil {
+			return err
+		}
+		if e.Type == outbox.TypeFollowCreated {
+			w.Followed(f.FollowerID, f.FolloweeID)
+		} else {
+			w.Unfollowed(f.FollowerID, f.FolloweeID)
+		}
+	}
+	return nil
+}
+
+// Reposted はリポストの書き込みをキューに追加します。
+func (w *Worker) Reposted(repostID uuid.UUID) {
+	w.enqueue(task{
+		key: "repost:" + repostID.String(),
+		run: func(ctx context.Context) error { return w.store.Reposted(ctx, repostID) },
+		stale: func(ctx context.Context) error {
+			return w.store.MarkStale(ctx, user.Or(
+				user.HasRepostsWith(repost.ID(repostID)),
+				user.HasFollowingWith(user.HasRepostsWith(repost.ID(repostID))),
+			))
+		},
+	})
+}
+
+// Followed はフォロー時の書き込みをキューに追加します。
+func (w *Worker) Followed(followerID, followeeID uuid.UUID) {
+	w.enqueue(task{
+		run:   func(ctx context.Context) error { return w.store.Followed(ctx, followerID, followeeID) },
+		stale: func(ctx context.Context) error { return w.store.MarkStale(ctx, user.ID(followerID)) },
+	})
+}
+
+// Unfollowed はフォロー解除時の削除をキューに追加します。
+func (w *Worker) Unfollowed(followerID, followeeID uuid.UUID) {
+	w.enqueue(task{
+		run:   func(ctx context.Context) error { return w.store.Unfollowed(ctx, followerID, followeeID) },
+		stale: func(ctx context.Context) error { return w.store.MarkStale(ctx, user.ID(followerID)) },
+	})
+}
+
+// Rebuild はタイムラインの作成をキューに追加します。
+func (w *Worker) Rebuild(userID uuid.UUID) {
+	w.enqueue(task{
+		key: "rebuild:" + userID.String(),
+		run: func(ctx context.Context) error { return w.store.Rebuild(ctx, userID) },
+	})
+}
+
+// enqueue は処理をキューに追加します。
+// キューが一杯の場合はEnqueueTimeoutまで空きを待ち、それでも空かない場合は実行せずに破棄します。
+// 破棄した書き込みの分はタイムラインに現れないため、対象のタイムラインに作成し直す印を付け、
+// 次の取得ではフォローと投稿を結合して求めるようにします。
+func (w *Worker) enqueue(t task) {
+	if t.key != "" {
+		w.mu.Lock()
+		if _, ok := w.pending[t.key]; ok {
+			w.mu.Unlock()
+			return
+		}
+		w.pending[t.key] = struct{}{}
+		w.mu.Unlock()
+	}
+
+	timer := time.NewTimer(w.config.EnqueueTimeout)
+	defer timer.Stop()
+	select {
+	case w.queue <- t:
+		return
+	case <-timer.C:
+	}
+
+	if t.key != "" {
+		w.mu.Lock()
+		delete(w.pending, t.key)
+		w.mu.Unlock()
+	}
+	slog.Warn("timeline fanout queue is full", "task", t.key)
+	w.markStale(t)
+}
+
+// markStale は実行しなかった、または失敗した処理の対象のタイムラインに作成し直す印を付けます。
+func (w *Worker) markStale(t task) {
+	if t.stale == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), staleTimeout)
+	defer cancel()
+	if err := t.stale(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to mark timelines stale", "task", t.key, "error", err.Error())
+	}
+}
+
+// Run はctxがキャンセルされるまで、設定された数のワーカーでキューの処理を実行します。
+// 失敗した処理は再試行せず、対象のタイムラインに作成し直す印を付けます。
+func (w *Worker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range w.config.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case t := <-w.queue:
+					// 途中まで書き込んで失敗した場合も、残りはタイムラインに現れないため作成し直す
+					if err := t.run(ctx); err != nil {
+						slog.ErrorContext(ctx, "timeline fanout failed", "task", t.key, "error", err.Error())
+						w.markStale(t)
+					}
+					if t.key != "" {
+						w.mu.Lock()
+						delete(w.pending, t.key)
+						w.mu.Unlock()
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
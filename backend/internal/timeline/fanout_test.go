@@ -0,0 +1,172 @@
+package timeline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"backend/ent"
+	"backend/ent/enttest"
+	"backend/internal/outbox"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestEnqueueMarksTimelinesStaleWhenQueueIsFull(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", uuid.NewString()))
+	defer client.Close()
+
+	horizon := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newUser := func(name string) *ent.User {
+		return tx.User.Create().
+			SetName(name).
+			SetEmail(name + "@example.com").
+			SetTimelineHorizon(horizon).
+			SaveX(ctx)
+	}
+	author, follower, stranger := newUser("author"), newUser("follower"), newUser("stranger")
+	follower.Update().AddFollowing(author).ExecX(ctx)
+	g := tx.Goal.Create().SetTitle("goal").SetUser(author).SaveX(ctx)
+	p := tx.Post.Create().SetContent("post").SetUser(author).SetGoal(g).SaveX(ctx)
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	// ワーカーを動かさず、キューを一杯にしておく
+	w := NewWorker(&Config{MaxEntries: 10, Workers: 1, QueueSize: 1, BatchSize: 10, EnqueueTimeout: 10 * time.Millisecond}, client)
+	w.Rebuild(uuid.New())
+
+	start := time.Now()
+	w.PostPublished(p.ID)
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Errorf("PostPublished returned after %s, want to wait EnqueueTimeout", elapsed)
+	}
+
+	for _, tt := range []struct {
+		user      *ent.User
+		wantStale bool
+	}{
+		{user: author, wantStale: true},
+		{user: follower, wantStale: true},
+		{user: stranger, wantStale: false},
+	} {
+		u := client.User.GetX(ctx, tt.user.ID)
+		if stale := u.TimelineHorizon == nil; stale != tt.wantStale {
+			t.Errorf("%s: stale = %v, want %v", u.Name, stale, tt.wantStale)
+		}
+	}
+	// 破棄した処理は重複の確認から外し、次の依頼を受け付ける
+	if _, ok := w.pending["post:"+p.ID.String()]; ok {
+		t.Error("dropped task is still pending")
+	}
+}
+
+func TestEnqueueWaitsForSpace(t *testing.T) {
+	w := &Worker{
+		config:  &Config{EnqueueTimeout: time.Second},
+		queue:   make(chan task, 1),
+		pending: make(map[string]struct{}),
+	}
+	w.queue <- task{}
+
+	stale := false
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		<-w.queue
+	}()
+	w.enqueue(task{
+		key:   "k",
+		run:   func(context.Context) error { return nil },
+		stale: func(context.Context) error { stale = true; return nil },
+	})
+
+	if stale {
+		t.Error("task was dropped although the queue had space before EnqueueTimeout")
+	}
+	if len(w.queue) != 1 {
+		t.Errorf("queue length = %d, want 1", len(w.queue))
+	}
+}
+
+func TestRunMarksTimelinesStaleWhenTaskFails(t *testing.T) {
+	w := &Worker{
+		config:  &Config{Workers: 1},
+		queue:   make(chan task, 1),
+		pending: make(map[string]struct{}),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		w.Run(ctx)
+		close(done)
+	}()
+
+	stale := make(chan struct{})
+	w.enqueue(task{
+		key:   "k",
+		run:   func(context.Context) error { return errors.New("write failed") },
+		stale: func(context.Context) error { close(stale); return nil },
+	})
+
+	select {
+	case <-stale:
+	case <-time.After(time.Second):
+		t.Fatal("failed task did not mark timelines stale")
+	}
+	cancel()
+	<-done
+}
+
+func TestHandleOutboxEventEnqueuesPublishedPost(t *testing.T) {
+	w := &Worker{
+		config:  &Config{EnqueueTimeout: time.Second},
+		queue:   make(chan task, 1),
+		pending: make(map[string]struct{}),
+	}
+	postID := uuid.New()
+	payload, err := json.Marshal(outbox.PostPayload{PostID: postID, UserID: uuid.New(), Status: "published"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.HandleOutboxEvent(context.Background(), outbox.Event{ID: uuid.New(), Type: outbox.TypePostPublished, Payload: payload}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := w.pending["post:"+postID.String()]; !ok {
+		t.Error("published post was not enqueued")
+	}
+	if len(w.queue) != 1 {
+		t.Errorf("queue length = %d, want 1", len(w.queue))
+	}
+}
+
+func TestHandleOutboxEventEnqueuesFollowChanges(t *testing.T) {
+	for _, typ := range []outbox.Type{outbox.TypeFollowCreated, outbox.TypeFollowDeleted} {
+		w := &Worker{
+			config:  &Config{EnqueueTimeout: time.Second},
+			queue:   make(chan task, 1),
+			pending: make(map[string]struct{}),
+		}
+		payload, err := json.Marshal(outbox.FollowPayload{FollowerID: uuid.New(), FolloweeID: uuid.New()})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := w.HandleOutboxEvent(context.Background(), outbox.Event{ID: uuid.New(), Type: typ, Payload: payload}); err != nil {
+			t.Fatal(err)
+		}
+		if len(w.queue) != 1 {
+			t.Errorf("%s: queue length = %d, want 1", typ, len(w.queue))
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package timeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/ent"
+	"backend/ent/post"
+	"backend/ent/predicate"
+	"backend/ent/repost"
+	"backend/ent/timelineentry"
+	"backend/ent/user"
+	"backend/internal/cursor"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Row はタイムラインに含める投稿と、その投稿がタイムラインに現れた最新の日時です。
+type Row struct {
+	PostID uuid.UUID
+	At     time.Time
+}
+
+// scannedRow はRowを読み込むための行です。
+type scannedRow struct {
+	PostID uuid.UUID     `json:"post_id"`
+	At     aggregateTime `json:"at"`
+}
+
+// aggregateTime は集約関数で求めた日時を読み込みます。
+// SQLite (テストで使用) では集約関数の結果に列の型がなく、日時が文字列で返るため解析します。
+type aggregateTime time.Time
+
+// aggregateTimeLayouts はSQLiteのドライバーが日時を保存する書式です。
+var aggregateTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+}
+
+// Scan はデータベースから読み込んだ日時を設定します。
+func (t *aggregateTime) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case time.Time:
+		*t = aggregateTime(v)
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("unexpected type %T for time", src)
+	}
+	for _, layout := range aggregateTimeLayouts {
+		if v, err := time.Parse(layout, s); err == nil {
+			*t = aggregateTime(v)
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot parse %q as time", s)
+}
+
+// toRows は読み込んだ行をRowに変換します。
+func toRows(scanned []scannedRow) []Row {
+	rows := make([]Row, 0, len(scanned))
+	for _, r := range scanned {
+		rows = append(rows, Row{PostID: r.PostID, At: time.Time(r.At)})
+	}
+	return rows
+}
+
+// Materialized は書き込んでおいたタイムラインから、horizonより新しい投稿を新しい順に取得します。
+// フォローを解除した直後などの書き込みの遅れに備え、投稿・リポストしたユーザーと投稿の状態は改めて絞り込みます。
+func Materialized(ctx context.Context, client *ent.Client, userID uuid.UUID, horizon time.Time, authors []uuid.UUID, preds []predicate.Post, after *cursor.Position, limit int) ([]Row, error) {
+	var rows []scannedRow
+	err := client.TimelineEntry.Query().
+		Where(
+			timelineentry.HasUserWith(user.ID(userID)),
+			timelineentry.HasActorWith(user.IDIn(authors...)),
+			timelineentry.HasPostWith(preds...),
+			timelineentry.AtGT(horizon),
+		).
+		Modify(func(s *sql.Selector) {
+			postID := s.C(timelineentry.PostColumn)
+			at := sql.Max(s.C(timelineentry.FieldAt))
+			s.GroupBy(postID).
+				OrderBy(sql.Desc("at"), sql.Desc(postID)).
+				Limit(limit)
+			if after != nil {
+				s.Having(sql.Or(
+					sql.LT(at, after.Time),
+					sql.And(sql.EQ(at, after.Time), sql.LT(postID, after.ID)),
+				))
+			}
+			s.Select(
+				sql.As(postID, "post_id"),
+				sql.As(at, "at"),
+			)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	return toRows(rows), nil
+}
+
+// Joined は自分とフォローしているユーザーの投稿・リポストを結合し、投稿を新しい順に取得します。
+func Joined(ctx context.Context, client *ent.Client, authors []uuid.UUID, preds []predicate.Post, after *cursor.Position, offset, limit int) ([]Row, error) {
+	var rows []scannedRow
+	err := client.Post.Query().
+		Where(preds...).
+		Modify(func(s *sql.Selector) {
+			args := make([]any, 0, len(authors))
+			for _, id := range authors {
+				args = append(args, id)
+			}
+			// 投稿とリポストを (投稿ID, 日時) の組として合わせ、投稿ごとに最新の日時を使う
+			p := sql.Table(post.Table).As("p")
+			r := sql.Table(repost.Table).As("r")
+			events := sql.Select(
+				sql.As(p.C(post.FieldID), "post_id"),
+				sql.As(p.C(post.FieldCreatedAt), "at"),
+			).
+				From(p).
+				Where(sql.In(p.C(post.UserColumn), args...)).
+				UnionAll(
+					sql.Select(
+						sql.As(r.C(repost.PostColumn), "post_id"),
+						sql.As(r.C(repost.FieldCreatedAt), "at"),
+					).
+						From(r).
+						Where(sql.In(r.C(repost.UserColumn), args...)),
+				).
+				As("events")
+			s.Join(events).
+				On(s.C(post.FieldID), events.C("post_id")).
+				GroupBy(s.C(post.FieldID)).
+				OrderBy(sql.Desc("at"), sql.Desc(s.C(post.FieldID))).
+				Offset(offset).
+				Limit(limit)
+			// 投稿ごとの最新の日時で並べているため、位置の比較は集約後に行う
+			if after != nil {
+				at := sql.Max(events.C("at"))
+				s.Having(sql.Or(
+					sql.LT(at, after.Time),
+					sql.And(sql.EQ(at, after.Time), sql.LT(s.C(post.FieldID), after.ID)),
+				))
+			}
+			s.Select(
+				sql.As(s.C(post.FieldID), "post_id"),
+				sql.As(sql.Max(events.C("at")), "at"),
+			)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	return toRows(rows), nil
+}
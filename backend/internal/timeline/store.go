@@ -0,0 +1,326 @@
+package timeline
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"backend/ent"
+	"backend/ent/post"
+	"backend/ent/predicate"
+	"backend/ent/repost"
+	"backend/ent/timelineentry"
+	"backend/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Store はタイムラインの書き込み・削除をデータベースに対して行います。
+// 書き込みは投稿の状態や閲覧者との関係を確認せずに行うため、タイムラインの取得時に改めて絞り込みます。
+type Store struct {
+	config *Config
+	client *ent.Client
+}
+
+// NewStore は新しいStoreインスタンスを作成します。
+func NewStore(config *Config, client *ent.Client) *Store {
+	return &Store{config: config, client: client}
+}
+
+// event はタイムラインに現れる投稿またはリポストです。
+type event struct {
+	postID  uuid.UUID
+	actorID uuid.UUID
+	// repostID はリポストによる場合のリポストのIDです。
+	repostID *uuid.UUID
+	at       time.Time
+}
+
+// PostPublished は公開済みの投稿を作成者と各フォロワーのタイムラインに書き込みます。
+// 投稿が削除された、または公開済みでない場合は何もしません。
+func (s *Store) PostPublished(ctx context.Context, postID uuid.UUID) error {
+	p, err := s.client.Post.Query().
+		Where(post.ID(postID), post.StatusEQ(post.StatusPublished)).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	author := p.Edges.User.ID
+	owners, err := s.audience(ctx, author)
+	if err != nil {
+		return err
+	}
+	if err := s.write(ctx, owners, []event{{postID: p.ID, actorID: author, at: p.CreatedAt}}); err != nil {
+		return err
+	}
+	return s.trim(ctx, owners...)
+}
+
+// Reposted はリポストをリポストしたユーザーと各フォロワーのタイムラインに書き込みます。
+// リポストが取り消されている場合は何もしません。
+func (s *Store) Reposted(ctx context.Context, repostID uuid.UUID) error {
+	r, err := s.client.Repost.Query().
+		Where(repost.ID(repostID)).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	actor := r.Edges.User.ID
+	owners, err := s.audience(ctx, actor)
+	if err != nil {
+		return err
+	}
+	if err := s.write(ctx, owners, []event{{postID: r.Edges.Post.ID, actorID: actor, repostID: &r.ID, at: r.CreatedAt}}); err != nil {
+		return err
+	}
+	return s.trim(ctx, owners...)
+}
+
+// Followed はフォローしたユーザーのタイムラインに、フォローされたユーザーの投稿・リポストのうち
+// タイムラインを保持している範囲のものを書き込みます。
+// 最大件数を超える場合は新しいものから書き込み、保持している範囲をその分狭めます。
+func (s *Store) Followed(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	u, err := s.client.User.Get(ctx, followerID)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// まだタイムラインを作成していない場合は、作成時にフォローを含めて求める
+	if u.TimelineHorizon == nil {
+		return nil
+	}
+
+	events, err := s.recentEvents(ctx, []uuid.UUID{followeeID}, *u.TimelineHorizon)
+	if err != nil {
+		return err
+	}
+	if err := s.write(ctx, []uuid.UUID{followerID}, events); err != nil {
+		return err
+	}
+	if len(events) == s.config.MaxEntries {
+		if err := s.raiseHorizon(ctx, followerID, events[len(events)-1].at); err != nil {
+			return err
+		}
+	}
+	return s.trim(ctx, followerID)
+}
+
+// Unfollowed はフォローを解除したユーザーのタイムラインから、解除されたユーザーの投稿・リポストを削除します。
+func (s *Store) Unfollowed(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	_, err := s.client.TimelineEntry.Delete().
+		Where(
+			timelineentry.HasUserWith(user.ID(followerID)),
+			timelineentry.HasActorWith(user.ID(followeeID)),
+		).
+		Exec(ctx)
+	return err
+}
+
+// Rebuild はまだ作成していないユーザーのタイムラインを、自分とフォローしているユーザーの
+// 最近の投稿・リポストから作成します。既に作成している場合は何もしません。
+func (s *Store) Rebuild(ctx context.Context, userID uuid.UUID) error {
+	u, err := s.client.User.Get(ctx, userID)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if u.TimelineHorizon != nil {
+		return nil
+	}
+
+	following, err := s.client.User.Query().
+		Where(user.HasFollowersWith(user.ID(userID))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	events, err := s.recentEvents(ctx, append(following, userID), time.Time{})
+	if err != nil {
+		return err
+	}
+	if err := s.write(ctx, []uuid.UUID{userID}, events); err != nil {
+		return err
+	}
+
+	// 最大件数に満たない場合はすべての投稿・リポストを書き込んでいる
+	var horizon time.Time
+	if len(events) == s.config.MaxEntries {
+		horizon = events[len(events)-1].at
+	}
+	err = s.client.User.Update().
+		Where(user.ID(userID), user.TimelineHorizonIsNil()).
+		SetTimelineHorizon(horizon).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return s.trim(ctx, userID)
+}
+
+// MarkStale は条件に合うユーザーのタイムラインを、まだ作成していない状態に戻します。
+// 書き込みを破棄した場合に使い、次の取得ではフォローと投稿を結合して求めながら作成し直します。
+// 書き込んである分は作成し直しの際に重複を無視するため、削除しません。
+func (s *Store) MarkStale(ctx context.Context, where ...predicate.User) error {
+	return s.client.User.Update().
+		Where(append(where, user.TimelineHorizonNotNil())...).
+		ClearTimelineHorizon().
+		Exec(ctx)
+}
+
+// audience は投稿・リポストを書き込むタイムラインの持ち主 (本人と各フォロワー) を返します。
+func (s *Store) audience(ctx context.Context, actorID uuid.UUID) ([]uuid.UUID, error) {
+	followers, err := s.client.User.Query().
+		Where(user.HasFollowingWith(user.ID(actorID))).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append(followers, actorID), nil
+}
+
+// recentEvents はユーザーの公開済みの投稿とリポストのうち、sinceより新しいものを新しい順に最大件数まで返します。
+func (s *Store) recentEvents(ctx context.Context, actorIDs []uuid.UUID, since time.Time) ([]event, error) {
+	posts, err := s.client.Post.Query().
+		Where(
+			post.HasUserWith(user.IDIn(actorIDs...)),
+			post.StatusEQ(post.StatusPublished),
+			post.CreatedAtGT(since),
+		).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		Order(post.ByCreatedAt(sql.OrderDesc()), post.ByID(sql.OrderDesc())).
+		Limit(s.config.MaxEntries).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reposts, err := s.client.Repost.Query().
+		Where(
+			repost.HasUserWith(user.IDIn(actorIDs...)),
+			repost.CreatedAtGT(since),
+		).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		WithPost(func(q *ent.PostQuery) {
+			q.Select(post.FieldID)
+		}).
+		Order(repost.ByCreatedAt(sql.OrderDesc()), repost.ByID(sql.OrderDesc())).
+		Limit(s.config.MaxEntries).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]event, 0, len(posts)+len(reposts))
+	for _, p := range posts {
+		events = append(events, event{postID: p.ID, actorID: p.Edges.User.ID, at: p.CreatedAt})
+	}
+	for _, r := range reposts {
+		events = append(events, event{postID: r.Edges.Post.ID, actorID: r.Edges.User.ID, repostID: &r.ID, at: r.CreatedAt})
+	}
+	slices.SortFunc(events, func(a, b event) int {
+		return b.at.Compare(a.at)
+	})
+	if len(events) > s.config.MaxEntries {
+		events = events[:s.config.MaxEntries]
+	}
+	return events, nil
+}
+
+// write は投稿・リポストを各持ち主のタイムラインに書き込みます。既に書き込まれているものは無視します。
+func (s *Store) write(ctx context.Context, owners []uuid.UUID, events []event) error {
+	creates := make([]*ent.TimelineEntryCreate, 0, min(len(owners)*len(events), s.config.BatchSize))
+	flush := func() error {
+		if len(creates) == 0 {
+			return nil
+		}
+		err := s.client.TimelineEntry.CreateBulk(creates...).
+			OnConflictColumns(timelineentry.UserColumn, timelineentry.PostColumn, timelineentry.ActorColumn).
+			Ignore().
+			Exec(ctx)
+		creates = creates[:0]
+		return err
+	}
+
+	for _, owner := range owners {
+		for _, e := range events {
+			creates = append(creates, s.client.TimelineEntry.Create().
+				SetUserID(owner).
+				SetActorID(e.actorID).
+				SetPostID(e.postID).
+				SetNillableRepostID(e.repostID).
+				SetAt(e.at))
+			if len(creates) == s.config.BatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return flush()
+}
+
+// trim は各持ち主のタイムラインのうち、最大件数を超えた古いものを削除し、保持している範囲を狭めます。
+// 同じ日時のものは最大件数以内でもまとめて削除します。
+func (s *Store) trim(ctx context.Context, owners ...uuid.UUID) error {
+	for _, owner := range owners {
+		boundary, err := s.client.TimelineEntry.Query().
+			Where(timelineentry.HasUserWith(user.ID(owner))).
+			Order(timelineentry.ByAt(sql.OrderDesc()), timelineentry.ByID(sql.OrderDesc())).
+			Offset(s.config.MaxEntries).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = s.client.TimelineEntry.Delete().
+			Where(
+				timelineentry.HasUserWith(user.ID(owner)),
+				timelineentry.AtLTE(boundary.At),
+			).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := s.raiseHorizon(ctx, owner, boundary.At); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// raiseHorizon はタイムラインを保持している範囲を、atより新しいものに狭めます。
+// まだタイムラインを作成していない場合や、既にatより狭い場合は何もしません。
+func (s *Store) raiseHorizon(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	return s.client.User.Update().
+		Where(user.ID(userID), user.TimelineHorizonLT(at)).
+		SetTimelineHorizon(at).
+		Exec(ctx)
+}
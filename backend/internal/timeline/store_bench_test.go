@@ -0,0 +1,154 @@
+package timeline
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"backend/ent"
+	"backend/ent/enttest"
+	"backend/ent/post"
+	"backend/ent/predicate"
+	"backend/internal/cursor"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// benchSeed はベンチマーク用に作成したデータです。
+type benchSeed struct {
+	client  *ent.Client
+	viewer  uuid.UUID
+	authors []uuid.UUID
+	horizon time.Time
+}
+
+// seedTimeline はfollowing人のユーザーをフォローする閲覧者と、各ユーザーのposts件の投稿、
+// 一部の投稿へのリポストを作成し、閲覧者のタイムラインを書き込みます。
+func seedTimeline(b *testing.B, following, posts int) *benchSeed {
+	b.Helper()
+	ctx := context.Background()
+	client := enttest.Open(b, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", uuid.NewString()))
+	b.Cleanup(func() { client.Close() })
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	newUser := func(name string) *ent.User {
+		return tx.User.Create().
+			SetName(name).
+			SetEmail(name + "@example.com").
+			SaveX(ctx)
+	}
+	viewer := newUser("viewer")
+	// フォローしていないユーザーの投稿も、結合による取得では絞り込みの対象になる
+	stranger := newUser("stranger")
+	g := tx.Goal.Create().
+		SetTitle("goal").
+		SetUser(viewer).
+		SaveX(ctx)
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	authors := []uuid.UUID{viewer.ID}
+	for i := range following {
+		u := newUser(fmt.Sprintf("author%d", i))
+		viewer.Update().AddFollowing(u).ExecX(ctx)
+		authors = append(authors, u.ID)
+
+		for _, owner := range []*ent.User{u, stranger} {
+			creates := make([]*ent.PostCreate, 0, posts)
+			for j := range posts {
+				creates = append(creates, tx.Post.Create().
+					SetContent(fmt.Sprintf("post %d-%d", i, j)).
+					SetUser(owner).
+					SetGoal(g).
+					SetCreatedAt(base.Add(time.Duration(j*following+i)*time.Minute)))
+			}
+			created := tx.Post.CreateBulk(creates...).SaveX(ctx)
+			if owner != u {
+				continue
+			}
+			// 前のユーザーの投稿の一部をリポストする
+			for j := 0; j < len(created); j += 10 {
+				tx.Repost.Create().
+					SetUser(u).
+					SetPost(created[(j+1)%len(created)]).
+					SaveX(ctx)
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		b.Fatal(err)
+	}
+
+	config := &Config{MaxEntries: 800, BatchSize: 1000}
+	if err := NewStore(config, client).Rebuild(ctx, viewer.ID); err != nil {
+		b.Fatal(err)
+	}
+	u := client.User.GetX(ctx, viewer.ID)
+	if u.TimelineHorizon == nil {
+		b.Fatal("timeline was not rebuilt")
+	}
+	return &benchSeed{client: client, viewer: viewer.ID, authors: authors, horizon: *u.TimelineHorizon}
+}
+
+// BenchmarkTimeline は書き込んでおいたタイムラインからの取得と、フォローと投稿の結合による取得を比べます。
+func BenchmarkTimeline(b *testing.B) {
+	const limit = 20
+	preds := []predicate.Post{post.StatusEQ(post.StatusPublished)}
+
+	for _, size := range []struct{ following, posts int }{{10, 50}, {50, 100}} {
+		seed := seedTimeline(b, size.following, size.posts)
+		ctx := context.Background()
+
+		// 2ページ目以降の位置として、書き込んでおいた範囲の中ほどを使う
+		rows, err := Materialized(ctx, seed.client, seed.viewer, seed.horizon, seed.authors, preds, nil, 200)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(rows) == 0 {
+			b.Fatal("materialized timeline is empty")
+		}
+		mid := rows[len(rows)-1]
+		pages := []struct {
+			name  string
+			after *cursor.Position
+		}{
+			{name: "first", after: nil},
+			{name: "middle", after: &cursor.Position{Time: mid.At, ID: mid.PostID}},
+		}
+
+		for _, page := range pages {
+			// 比べる2つの取得方法が同じ結果を返すことを確認しておく
+			want, err := Joined(ctx, seed.client, seed.authors, preds, page.after, 0, limit)
+			if err != nil {
+				b.Fatal(err)
+			}
+			got, err := Materialized(ctx, seed.client, seed.viewer, seed.horizon, seed.authors, preds, page.after, limit)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				b.Fatalf("%s: materialized = %v, joined = %v", page.name, got, want)
+			}
+
+			prefix := fmt.Sprintf("following=%d/posts=%d/%s", size.following, size.posts, page.name)
+			b.Run(prefix+"/materialized", func(b *testing.B) {
+				for b.Loop() {
+					if _, err := Materialized(ctx, seed.client, seed.viewer, seed.horizon, seed.authors, preds, page.after, limit); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+			b.Run(prefix+"/joined", func(b *testing.B) {
+				for b.Loop() {
+					if _, err := Joined(ctx, seed.client, seed.authors, preds, page.after, 0, limit); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
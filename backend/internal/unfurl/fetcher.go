@@ -0,0 +1,218 @@
+// Package unfurl は投稿本文のURLからリンクプレビューの情報を取得します。
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	"backend/internal/other"
+)
+
+var (
+	// ErrForbiddenAddress はプライベートアドレスなど、接続を許可しない宛先の場合のエラーです。
+	ErrForbiddenAddress = errors.New("forbidden address")
+	// ErrUnsupportedURL はhttp(s)以外、または標準以外のポートのURLの場合のエラーです。
+	ErrUnsupportedURL = errors.New("unsupported url")
+	// ErrNotHTML はレスポンスがHTMLでない場合のエラーです。
+	ErrNotHTML = errors.New("response is not html")
+)
+
+// blockedPrefixes はIsGlobalUnicast・IsPrivateで判定できない、接続を許可しないアドレス範囲です。
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "このネットワーク"
+	netip.MustParsePrefix("100.64.0.0/10"),   // キャリアグレードNAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETFプロトコル割り当て
+	netip.MustParsePrefix("192.0.2.0/24"),    // ドキュメント用
+	netip.MustParsePrefix("198.18.0.0/15"),   // ベンチマーク用
+	netip.MustParsePrefix("198.51.100.0/24"), // ドキュメント用
+	netip.MustParsePrefix("203.0.113.0/24"),  // ドキュメント用
+	netip.MustParsePrefix("240.0.0.0/4"),     // 予約済み
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64 (IPv4アドレスへの変換)
+	netip.MustParsePrefix("2001:db8::/32"),   // ドキュメント用
+}
+
+// Config はリンクプレビュー取得の設定を保持します。
+type Config struct {
+	// Timeout は1回の取得 (リダイレクトを含む) にかける最大時間です。
+	Timeout time.Duration
+	// MaxBytes は読み込むレスポンスボディの最大バイト数です。
+	MaxBytes int64
+	// MaxRedirects は追跡するリダイレクトの最大回数です。
+	MaxRedirects int
+	// UserAgent は取得時に送信するUser-Agentです。
+	UserAgent string
+	// TTL は取得結果をキャッシュとして再利用する期間です。
+	TTL time.Duration
+	// Workers は同時に取得を行うワーカーの数です。
+	Workers int
+	// QueueSize は取得待ちのURLを保持するキューの大きさです。
+	QueueSize int
+}
+
+// NewConfig は環境変数からリンクプレビュー取得の設定を作成します。
+func NewConfig() *Config {
+	timeout, err := time.ParseDuration(other.GetEnv("UNFURL_TIMEOUT", "5s"))
+	if err != nil || timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	maxBytes := other.GetEnvInt("UNFURL_MAX_BYTES", 1<<20)
+	if maxBytes <= 0 {
+		maxBytes = 1 << 20
+	}
+	ttl, err := time.ParseDuration(other.GetEnv("UNFURL_TTL", "24h"))
+	if err != nil || ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+	workers := other.GetEnvInt("UNFURL_WORKERS", 2)
+	if workers <= 0 {
+		workers = 2
+	}
+	queueSize := other.GetEnvInt("UNFURL_QUEUE_SIZE", 100)
+	if queueSize <= 0 {
+		queueSize = 100
+	}
+
+	return &Config{
+		Timeout:      timeout,
+		MaxBytes:     int64(maxBytes),
+		MaxRedirects: 3,
+		UserAgent:    other.GetEnv("UNFURL_USER_AGENT", "p-log-unfurler/1.0"),
+		TTL:          ttl,
+		Workers:      workers,
+		QueueSize:    queueSize,
+	}
+}
+
+// Fetcher はURLを取得してリンクプレビューの情報を取り出します。
+// 接続先のアドレスは名前解決後に検証するため、DNSリバインディングによるプライベートアドレスへの接続も防ぎます。
+type Fetcher struct {
+	config *Config
+	client *http.Client
+	// allowAddr は接続を許可するアドレスかを判定します。
+	// テストではhttptestのサーバーに接続するため、ループバックアドレスを許可するものに差し替えます。
+	allowAddr func(netip.Addr) bool
+	// allowPort は取得を許可するURLのポートかを判定します (標準ポートの場合は空文字列)。
+	allowPort func(port string) bool
+}
+
+// NewFetcher は新しいFetcherインスタンスを作成します。
+func NewFetcher(config *Config) *Fetcher {
+	f := &Fetcher{
+		config:    config,
+		allowAddr: allowedAddr,
+		allowPort: allowedPort,
+	}
+	dialer := &net.Dialer{
+		Timeout: config.Timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+			}
+			if !f.allowAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+	transport := &http.Transport{
+		// 環境変数のプロキシ設定は使わず、必ず検証したアドレスへ直接接続する
+		Proxy:                  nil,
+		DialContext:            dialer.DialContext,
+		TLSHandshakeTimeout:    config.Timeout,
+		ResponseHeaderTimeout:  config.Timeout,
+		MaxResponseHeaderBytes: 64 << 10,
+		DisableKeepAlives:      true,
+	}
+	f.client = &http.Client{
+		Transport: transport,
+		Timeout:   config.Timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > config.MaxRedirects {
+				return fmt.Errorf("stopped after %d redirects", config.MaxRedirects)
+			}
+			return f.checkURL(req.URL)
+		},
+	}
+	return f
+}
+
+// Fetch はURLのHTMLを取得し、OpenGraph/Twitterカードのメタタグからプレビューを取り出します。
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (*Preview, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedURL, err)
+	}
+	if err := f.checkURL(u); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", f.config.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || (mediaType != "text/html" && mediaType != "application/xhtml+xml") {
+		return nil, fmt.Errorf("%w: %s", ErrNotHTML, contentType)
+	}
+
+	return parse(io.LimitReader(resp.Body, f.config.MaxBytes), contentType, resp.Request.URL)
+}
+
+// checkURL はURLのスキームとポートが取得を許可するものかを検証します。
+// 内部サービスへの接続を防ぐため、http(s)の標準ポートのみを許可します。
+func (f *Fetcher) checkURL(u *url.URL) error {
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		return fmt.Errorf("%w: scheme %q", ErrUnsupportedURL, u.Scheme)
+	case u.Hostname() == "":
+		return fmt.Errorf("%w: missing host", ErrUnsupportedURL)
+	case u.User != nil:
+		return fmt.Errorf("%w: userinfo is not allowed", ErrUnsupportedURL)
+	}
+	if !f.allowPort(u.Port()) {
+		return fmt.Errorf("%w: port %s", ErrUnsupportedURL, u.Port())
+	}
+	return nil
+}
+
+// allowedPort はポートがhttp(s)の標準ポートかを返します。
+func allowedPort(port string) bool {
+	return port == "" || port == "80" || port == "443"
+}
+
+// allowedAddr はグローバルユニキャストアドレスのうち、プライベート・予約済みの範囲を除いたものかを返します。
+func allowedAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, p := range blockedPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
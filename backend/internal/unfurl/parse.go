@@ -0,0 +1,135 @@
+package unfurl
+
+import (
+	"io"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"golang.org/x/net/html/charset"
+)
+
+const (
+	// maxTitleLength はタイトルの最大文字数です。
+	maxTitleLength = 200
+	// maxDescriptionLength は説明文の最大文字数です。
+	maxDescriptionLength = 500
+	// maxSiteNameLength はサイト名の最大文字数です。
+	maxSiteNameLength = 100
+)
+
+// Preview はURLから取り出したリンクプレビューの情報です。値がない項目は空文字列です。
+type Preview struct {
+	Title       string
+	Description string
+	// ImageURL は画像の絶対URLです (http(s)のみ)。
+	ImageURL string
+	SiteName string
+}
+
+// parse はHTMLの<head>からメタタグを読み取り、プレビューを作成します。
+// OpenGraph、Twitterカード、<title>と<meta name="description">の順に優先します。
+func parse(r io.Reader, contentType string, base *url.URL) (*Preview, error) {
+	r, err := charset.NewReader(r, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := make(map[string]string)
+	var title string
+	inTitle := false
+
+	z := html.NewTokenizer(r)
+loop:
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			// 上限サイズで打ち切られた場合もそれまでの内容を使う
+			break loop
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Body:
+				break loop
+			case atom.Title:
+				inTitle = tt == html.StartTagToken
+			case atom.Meta:
+				if !hasAttr {
+					continue
+				}
+				var key, content string
+				for {
+					k, v, more := z.TagAttr()
+					switch string(k) {
+					case "property", "name":
+						key = strings.ToLower(strings.TrimSpace(string(v)))
+					case "content":
+						content = string(v)
+					}
+					if !more {
+						break
+					}
+				}
+				if _, ok := meta[key]; key != "" && !ok {
+					meta[key] = content
+				}
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Head:
+				break loop
+			case atom.Title:
+				inTitle = false
+			}
+		case html.TextToken:
+			if inTitle && title == "" {
+				title = string(z.Text())
+			}
+		}
+	}
+
+	p := &Preview{
+		Title:       clean(first(meta["og:title"], meta["twitter:title"], title), maxTitleLength),
+		Description: clean(first(meta["og:description"], meta["twitter:description"], meta["description"]), maxDescriptionLength),
+		SiteName:    clean(meta["og:site_name"], maxSiteNameLength),
+		ImageURL:    resolveImage(base, first(meta["og:image:secure_url"], meta["og:image"], meta["twitter:image"], meta["twitter:image:src"])),
+	}
+	return p, nil
+}
+
+// first は空でない最初の値を返します。
+func first(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// clean は連続する空白を1つにまとめ、最大文字数で切り詰めます。
+func clean(s string, maxLen int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if utf8.RuneCountInString(s) <= maxLen {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:maxLen-1]) + "…"
+}
+
+// resolveImage は画像のURLをページのURLを基準に絶対URLにします。http(s)以外は使いません。
+func resolveImage(base *url.URL, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return ""
+	}
+	u, err := base.Parse(ref)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+	return u.String()
+}
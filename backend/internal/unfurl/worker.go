@@ -0,0 +1,126 @@
+package unfurl
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"backend/ent"
+	"backend/ent/linkpreview"
+)
+
+// Enqueuer はリンクプレビューの取得を非同期に依頼するインターフェースです。
+type Enqueuer interface {
+	Enqueue(url string)
+}
+
+// Worker はキューに入ったURLのリンクプレビューを取得し、LinkPreviewとして保存します。
+// 投稿の作成・更新を待たせないよう、取得は呼び出し元とは別のゴルーチンで行います。
+type Worker struct {
+	config  *Config
+	client  *ent.Client
+	fetcher *Fetcher
+	queue   chan string
+	now     func() time.Time
+
+	mu sync.Mutex
+	// pending はキューに入っているか取得中のURLです。同じURLを重複して取得しないために使います。
+	pending map[string]struct{}
+}
+
+// NewWorker は新しいWorkerインスタンスを作成します。
+func NewWorker(config *Config, client *ent.Client) *Worker {
+	return &Worker{
+		config:  config,
+		client:  client,
+		fetcher: NewFetcher(config),
+		queue:   make(chan string, config.QueueSize),
+		now:     time.Now,
+		pending: make(map[string]struct{}),
+	}
+}
+
+// Enqueue はURLのリンクプレビューの取得をキューに追加します。
+// 呼び出し元を待たせないよう、キューが一杯の場合は取得せずに破棄します。
+func (w *Worker) Enqueue(url string) {
+	if url == "" {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.pending[url]; ok {
+		return
+	}
+	select {
+	case w.queue <- url:
+		w.pending[url] = struct{}{}
+	default:
+		slog.Warn("link preview queue is full", "url", url)
+	}
+}
+
+// Run はctxがキャンセルされるまで、設定された数のワーカーでキューのURLを取得します。
+func (w *Worker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range w.config.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case url := <-w.queue:
+					if err := w.Process(ctx, url); err != nil {
+						slog.ErrorContext(ctx, "link preview failed", "url", url, "error", err.Error())
+					}
+					w.mu.Lock()
+					delete(w.pending, url)
+					w.mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Process はURLのリンクプレビューを取得して保存します。
+// TTL内に取得済みの場合は取得しません。取得に失敗した場合も、繰り返し取得しないよう失敗として記録します。
+func (w *Worker) Process(ctx context.Context, url string) error {
+	now := w.now()
+	fresh, err := w.client.LinkPreview.Query().
+		Where(
+			linkpreview.URL(url),
+			linkpreview.FetchedAtGT(now.Add(-w.config.TTL)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if fresh {
+		return nil
+	}
+
+	create := w.client.LinkPreview.Create().
+		SetURL(url).
+		SetFetchedAt(now)
+	preview, err := w.fetcher.Fetch(ctx, url)
+	if err != nil {
+		slog.InfoContext(ctx, "link preview unavailable", "url", url, "error", err.Error())
+		create.SetStatus(linkpreview.StatusFailed)
+	} else {
+		create.
+			SetStatus(linkpreview.StatusOk).
+			SetTitle(preview.Title).
+			SetDescription(preview.Description).
+			SetImageURL(preview.ImageURL).
+			SetSiteName(preview.SiteName)
+	}
+
+	return w.client.LinkPreview.CreateBulk(create).
+		OnConflictColumns(linkpreview.FieldURL).
+		UpdateNewValues().
+		Exec(ctx)
+}
@@ -3,12 +3,28 @@ package main
 //go:generate go run github.com/ogen-go/ogen/cmd/ogen@latest --target api --clean ../docs/api.yaml
 
 import (
+	"context"
+	"fmt"
 	"log"
 
 	"backend/api"
 	"backend/handler"
+	"backend/internal/cursor"
 	"backend/internal/db"
+	"backend/internal/eventbus"
 	"backend/internal/jwt"
+	"backend/internal/notification"
+	"backend/internal/other"
+	"backend/internal/outbox"
+	"backend/internal/publisher"
+	"backend/internal/ranking"
+	"backend/internal/realtime"
+	"backend/internal/reminder"
+	"backend/internal/search"
+	"backend/internal/spamfilter"
+	"backend/internal/storage"
+	"backend/internal/timeline"
+	"backend/internal/unfurl"
 	"backend/security"
 	"net/http"
 
@@ -25,7 +41,23 @@ func main() {
 	// ハンドラーとセキュリティハンドラーの作成
 	jwtConfig := jwt.NewJWTConfig()
 	jwtHandler := jwt.NewJwtHandler(jwtConfig, client)
-	h, err := handler.NewHandler(client, jwtHandler)
+	store, err := storage.NewLocalStorage()
+	if err != nil {
+		log.Fatalf("failed to create storage: %v", err)
+	}
+	unfurler := unfurl.NewWorker(unfurl.NewConfig(), client)
+	spam := spamfilter.NewDefaultPipeline(spamfilter.NewConfig(), spamfilter.NewEntHistory(client))
+	fanout := timeline.NewWorker(timeline.NewConfig(), client)
+	bus, err := newEventBus()
+	if err != nil {
+		log.Fatalf("failed to create event bus: %v", err)
+	}
+	cursors, err := cursor.NewCodec(cursor.NewConfig())
+	if err != nil {
+		log.Fatalf("failed to create cursor codec: %v", err)
+	}
+	inbox := notification.NewInbox(client)
+	h, err := handler.NewHandler(handler.NewConfig(), client, jwtHandler, store, inbox, unfurler, search.NewPostgresSearcher(client), spam, fanout, realtime.NewBusPublisher(bus), publisher.NewBusWaker(bus), cursors, ranking.NewConfig())
 	if err != nil {
 		log.Fatalf("failed to create handler: %v", err)
 	}
@@ -41,9 +73,70 @@ func main() {
 	// データベースのマイグレーション
 	db.Migrate(client)
 
+	// 目標テンプレートの初期データ投入
+	if err := db.SeedGoalTemplates(context.Background(), client, other.GetEnv("GOAL_TEMPLATE_SEED", "seed/goal_templates.yaml")); err != nil {
+		log.Printf("failed to seed goal templates: %v", err)
+	}
+
+	// 全文検索の導入前に作成された投稿・目標の索引を作成
+	if err := db.BackfillSearchVectors(context.Background(), client); err != nil {
+		log.Printf("failed to backfill search vectors: %v", err)
+	}
+
+	// 本文のハッシュの導入前に作成された投稿・コメントのハッシュを記録
+	if err := db.BackfillContentHashes(context.Background(), client); err != nil {
+		log.Printf("failed to backfill content hashes: %v", err)
+	}
+
+	// 目標期限リマインダーのスケジューラーを起動
+	scheduler := reminder.NewScheduler(reminder.NewConfig(), client, reminder.NewInboxNotifier(client, inbox), reminder.SystemClock)
+	go scheduler.Run(context.Background())
+
+	// 予約投稿の公開ジョブを起動
+	pub := publisher.NewPublisher(publisher.NewConfig(), client, bus)
+	go pub.Run(context.Background())
+
+	// リンクプレビューの取得ワーカーを起動
+	go unfurler.Run(context.Background())
+
+	// ホームタイムラインの書き込みワーカーを起動
+	go fanout.Run(context.Background())
+
+	// ドメインイベントの配信ジョブを起動
+	dispatcher := outbox.NewDispatcher(outbox.NewConfig(), client, h, inbox, fanout)
+	go dispatcher.Run(context.Background())
+
+	// リアルタイムのイベントの配信を起動
+	hub := realtime.NewHub(realtime.NewConfig(), bus)
+	go hub.Run(context.Background())
+
+	// イベントのストリームは逐次送信が必要なため、APIのサーバーとは別のハンドラーで扱う
+	mux := http.NewServeMux()
+	mux.Handle("/events/stream", realtime.NewStreamHandler(hub, sec.Authenticate))
+	mux.Handle("/", srv)
+
 	// サーバーの起動
 	log.Println("Starting server on :8080")
-	if err := http.ListenAndServe(":8080", srv); err != nil {
+	if err := http.ListenAndServe(":8080", mux); err != nil {
 		log.Fatalf("failed to start server: %v", err)
 	}
 }
+
+// newEventBus は環境変数EVENTBUS_DRIVERで指定されたイベントバスを作成します。
+// 複数のレプリカで動かす場合は、既定のpostgresでレプリカの間でイベントを受け渡します。
+func newEventBus() (eventbus.Bus, error) {
+	config := eventbus.NewConfig()
+	switch driver := other.GetEnv("EVENTBUS_DRIVER", "postgres"); driver {
+	case "memory":
+		return eventbus.NewMemoryBus(config), nil
+	case "postgres":
+		bus, err := eventbus.NewPostgresBus(config, db.DSN())
+		if err != nil {
+			return nil, err
+		}
+		go bus.Run(context.Background())
+		return bus, nil
+	default:
+		return nil, fmt.Errorf("unknown event bus driver %q", driver)
+	}
+}
@@ -3,15 +3,21 @@ package security
 import (
 	"context"
 	"errors"
+	"time"
 
 	"backend/api"
 	"backend/ent"
+	"backend/ent/user"
 	"backend/internal/jwt"
+
+	"github.com/google/uuid"
 )
 
 var (
 	// ErrMissingToken はトークンが存在しない場合のエラーです。
 	ErrMissingToken = errors.New("missing token")
+	// ErrAccountSuspended はアカウントが運営により停止されている場合のエラーです。
+	ErrAccountSuspended = errors.New("account suspended")
 )
 
 // SecurityHandler はJWT認証を処理するセキュリティハンドラーです。
@@ -42,12 +48,49 @@ func (s *SecurityHandler) HandleBearerAuth(ctx context.Context, operationName ap
 		return ctx, err
 	}
 
+	// 停止中のアカウントは認証が必要な操作を行えない
+	suspended, err := s.suspended(ctx, claims.UserID)
+	if err != nil {
+		return ctx, err
+	}
+	if suspended {
+		return ctx, ErrAccountSuspended
+	}
+
 	// 検証成功後、ユーザー情報をcontextに保存
 	ctx = context.WithValue(ctx, userIDKey, claims.UserID)
 
 	return ctx, nil
 }
 
+// Authenticate はogenのサーバーを通らないリクエストのBearerトークンを、HandleBearerAuthと同じ規則で検証します。
+func (s *SecurityHandler) Authenticate(ctx context.Context, token string) (uuid.UUID, error) {
+	ctx, err := s.HandleBearerAuth(ctx, "", api.BearerAuth{Token: token})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	userID, _ := GetUserIDFromContext(ctx)
+	return uuid.Parse(userID)
+}
+
+// suspended はユーザーのアカウントが現在停止されているかを返します。
+func (s *SecurityHandler) suspended(ctx context.Context, userID string) (bool, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return false, err
+	}
+	return s.client.User.Query().
+		Where(
+			user.ID(id),
+			user.SuspendedAtNotNil(),
+			user.Or(
+				user.SuspendedUntilIsNil(),
+				user.SuspendedUntilGT(time.Now()),
+			),
+		).
+		Exist(ctx)
+}
+
 // ContextKeys for storing user information
 type contextKey string
 